@@ -56,6 +56,7 @@ type stream struct {
 	insertAndCompressCounter int        // insertion and compression counter
 	flushCounter             int        // flush frequency counter
 	numValues                int64      // number of values inserted into the sorted stream
+	weighted                 bool       // whether weighted samples were inserted into the sorted stream
 	bufLess                  minHeap    // sample buffer whose value is less than that at the insertion cursor
 	bufMore                  minHeap    // sample buffer whose value is more than that at the insertion cursor
 	samples                  sampleList // sample list
@@ -120,6 +121,68 @@ func (s *stream) Add(value float64) {
 	}
 }
 
+func (s *stream) AddWeighted(value float64, weight int64) {
+	if weight <= 0 {
+		return
+	}
+	if weight == 1 {
+		s.Add(value)
+		return
+	}
+
+	// Flush the buffered values so the weighted samples are inserted in
+	// rank order with respect to every value previously added.
+	s.Flush()
+
+	var (
+		next    = s.samples.Front()
+		atFront = true
+		rank    int64
+	)
+	for next != nil && next.value <= value {
+		rank += next.numRanks
+		next = next.next
+		atFront = false
+	}
+
+	// NB: The weight is split across samples no heavier than half of the
+	// compression threshold so that the rank error bound of the stream holds.
+	s.numValues += weight
+	maxWeight := s.threshold(rank+weight) / 2
+	if maxWeight < 1 {
+		maxWeight = 1
+	}
+	for weight > 0 {
+		numRanks := weight
+		if numRanks > maxWeight {
+			numRanks = maxWeight
+		}
+		weight -= numRanks
+
+		sample := s.acquireSampleFn()
+		switch {
+		case next == nil:
+			sample.setData(value, numRanks, 0)
+			s.samples.PushBack(sample)
+		case atFront:
+			// The rank of a new minimum value is known exactly.
+			sample.setData(value, numRanks, 0)
+			s.samples.InsertBefore(sample, next)
+		default:
+			sample.setData(value, numRanks, next.numRanks+next.delta-1)
+			s.samples.InsertBefore(sample, next)
+		}
+	}
+
+	// The ranks tracked by the cursors no longer account for the inserted
+	// samples, restart the insertion and compression passes.
+	s.weighted = true
+	s.insertCursor = nil
+	s.compressCursor = nil
+	s.compressMinRank = 0
+	s.compress()
+}
+
 func (s *stream) Flush() {
 	for s.bufLess.Len() > 0 || s.bufMore.Len() > 0 {
 		if s.bufMore.Len() == 0 {
@@ -179,6 +242,7 @@ func (s *stream) ResetSetData(quantiles []float64) {
 	s.insertAndCompressCounter = 0
 	s.flushCounter = 0
 	s.numValues = 0
+	s.weighted = false
 	s.bufLess = minHeap(s.floatsPool.Get(s.capacity))
 	s.bufMore = minHeap(s.floatsPool.Get(s.capacity))
 	s.samples.Reset()
@@ -277,7 +341,13 @@ func (s *stream) compress() {
 
 	if s.compressCursor == nil {
 		s.compressCursor = s.samples.Back().prev
-		s.compressMinRank = s.numValues - 1 - s.compressCursor.numRanks
+		if s.weighted {
+			// NB: The last sample may hold more than one rank once weighted
+			// samples are inserted.
+			s.compressMinRank = s.numValues - s.samples.Back().numRanks - s.compressCursor.numRanks
+		} else {
+			s.compressMinRank = s.numValues - 1 - s.compressCursor.numRanks
+		}
 		s.compressCursor = s.compressCursor.prev
 	}
 
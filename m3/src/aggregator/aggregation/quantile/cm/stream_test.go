@@ -185,6 +185,62 @@ func TestStreamWithSkewedDistributionPeriodicInsertCompressPeriodicFlush(t *test
 	testStreamWithSkewedDistribution(t, opts)
 }
 
+func TestStreamAddWeighted(t *testing.T) {
+	opts := testStreamOptions()
+	weighted := NewStream(testQuantiles, opts)
+	unweighted := NewStream(testQuantiles, opts)
+	for i := 0; i < 1000; i++ {
+		weight := int64(i%10 + 1)
+		weighted.AddWeighted(float64(i), weight)
+		for j := int64(0); j < weight; j++ {
+			unweighted.Add(float64(i))
+		}
+	}
+	weighted.Flush()
+	unweighted.Flush()
+
+	require.Equal(t, int64(5500), weighted.(*stream).numValues)
+	require.Equal(t, unweighted.Min(), weighted.Min())
+	require.Equal(t, unweighted.Max(), weighted.Max())
+	margin := 1000 * 2 * opts.Eps()
+	for _, q := range testQuantiles {
+		require.InDelta(t, unweighted.Quantile(q), weighted.Quantile(q), margin)
+	}
+}
+
+func TestStreamAddWeightedInterleaved(t *testing.T) {
+	opts := testStreamOptions()
+	s := NewStream(testQuantiles, opts)
+	s.Add(100.0)
+	s.AddWeighted(300.0, 10)
+	s.Add(400.0)
+	s.AddWeighted(0.0, 0)
+	s.AddWeighted(200.0, 7)
+	s.Flush()
+
+	require.Equal(t, int64(19), s.(*stream).numValues)
+	require.Equal(t, 100.0, s.Min())
+	require.Equal(t, 400.0, s.Max())
+	require.Equal(t, 300.0, s.Quantile(0.5))
+}
+
+func TestStreamAddWeightedHeavySample(t *testing.T) {
+	opts := testStreamOptions()
+	s := NewStream(testQuantiles, opts)
+	for i := 0; i < 1000; i++ {
+		s.Add(float64(i))
+	}
+	s.AddWeighted(250.5, 1000000)
+	s.Flush()
+
+	require.Equal(t, int64(1001000), s.(*stream).numValues)
+	require.Equal(t, 0.0, s.Min())
+	require.Equal(t, 999.0, s.Max())
+	require.Equal(t, 250.5, s.Quantile(0.5))
+	require.Equal(t, 250.5, s.Quantile(0.9))
+	require.Equal(t, 250.5, s.Quantile(0.99))
+}
+
 func TestStreamClose(t *testing.T) {
 	opts := testStreamOptions()
 	s := NewStream(testQuantiles, opts).(*stream)
@@ -48,6 +48,10 @@ type Stream interface {
 	// Add adds a sample value.
 	Add(value float64)
 
+	// AddWeighted adds a sample value that stands for weight occurrences
+	// of the value.
+	AddWeighted(value float64, weight int64)
+
 	// Flush flushes the internal buffer.
 	Flush()
 
@@ -0,0 +1,109 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	minRelativeAccuracy     = 0.0
+	maxRelativeAccuracy     = 1.0
+	defaultRelativeAccuracy = 0.01
+	minMaxNumBins           = 16
+	defaultMaxNumBins       = 2048
+)
+
+var (
+	errInvalidRelativeAccuracy = fmt.Errorf("relative accuracy must be between %f and %f", minRelativeAccuracy, maxRelativeAccuracy)
+	errInvalidMaxNumBins       = fmt.Errorf("max number of bins must be at least %d", minMaxNumBins)
+	errNoSketchPool            = errors.New("no sketch pool set")
+)
+
+type options struct {
+	relativeAccuracy float64
+	maxNumBins       int
+	sketchPool       SketchPool
+}
+
+// NewOptions creates a new options.
+func NewOptions() Options {
+	o := &options{
+		relativeAccuracy: defaultRelativeAccuracy,
+		maxNumBins:       defaultMaxNumBins,
+	}
+
+	o.initPools()
+	return o
+}
+
+func (o *options) SetRelativeAccuracy(value float64) Options {
+	opts := *o
+	opts.relativeAccuracy = value
+	opts.initPools()
+	return &opts
+}
+
+func (o *options) RelativeAccuracy() float64 {
+	return o.relativeAccuracy
+}
+
+func (o *options) SetMaxNumBins(value int) Options {
+	opts := *o
+	opts.maxNumBins = value
+	opts.initPools()
+	return &opts
+}
+
+func (o *options) MaxNumBins() int {
+	return o.maxNumBins
+}
+
+func (o *options) SetSketchPool(value SketchPool) Options {
+	opts := *o
+	opts.sketchPool = value
+	return &opts
+}
+
+func (o *options) SketchPool() SketchPool {
+	return o.sketchPool
+}
+
+func (o *options) Validate() error {
+	if o.relativeAccuracy <= minRelativeAccuracy || o.relativeAccuracy >= maxRelativeAccuracy {
+		return errInvalidRelativeAccuracy
+	}
+	if o.maxNumBins < minMaxNumBins {
+		return errInvalidMaxNumBins
+	}
+	if o.sketchPool == nil {
+		return errNoSketchPool
+	}
+	return nil
+}
+
+// NB: the sketches allocated by the default pool capture the options they were
+// allocated with, so the pool is recreated whenever the sketch parameters change.
+func (o *options) initPools() {
+	o.sketchPool = NewSketchPool(nil)
+	o.sketchPool.Init(func() Sketch { return NewSketch(o) })
+}
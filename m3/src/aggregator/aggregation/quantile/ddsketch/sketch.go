@@ -0,0 +1,451 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	encodingVersion = 1
+
+	// Values whose magnitude is below this threshold are tracked by the zero
+	// bin rather than by the logarithmically sized bins.
+	minIndexableValue = 1e-9
+)
+
+var (
+	nan = math.NaN()
+
+	errMismatchedRelativeAccuracy = errors.New("sketches have mismatched relative accuracy")
+	errUnknownSketchType          = errors.New("unknown sketch type")
+	errTruncatedSketch            = errors.New("truncated sketch encoding")
+)
+
+// sketch is a DDSketch that maps positive and negative values into
+// logarithmically sized bins, such that the representative value of
+// each bin is within the relative accuracy of every value in the bin.
+type sketch struct {
+	relativeAccuracy float64
+	gamma            float64
+	multiplier       float64
+	sketchPool       SketchPool
+
+	closed    bool
+	count     uint64
+	zeroCount uint64
+	sum       float64
+	min       float64
+	max       float64
+	positive  denseStore
+	negative  denseStore
+}
+
+// NewSketch creates a new sketch.
+func NewSketch(opts Options) Sketch {
+	if opts == nil {
+		opts = NewOptions()
+	}
+	var (
+		relativeAccuracy = opts.RelativeAccuracy()
+		gamma            = (1 + relativeAccuracy) / (1 - relativeAccuracy)
+	)
+	return &sketch{
+		relativeAccuracy: relativeAccuracy,
+		gamma:            gamma,
+		multiplier:       1 / math.Log(gamma),
+		sketchPool:       opts.SketchPool(),
+		positive:         denseStore{maxNumBins: opts.MaxNumBins()},
+		negative:         denseStore{maxNumBins: opts.MaxNumBins()},
+	}
+}
+
+func (s *sketch) Add(value float64) {
+	s.add(value, 1)
+}
+
+func (s *sketch) Count() uint64 { return s.count }
+
+func (s *sketch) Sum() float64 { return s.sum }
+
+func (s *sketch) Min() float64 {
+	if s.count == 0 {
+		return 0.0
+	}
+	return s.min
+}
+
+func (s *sketch) Max() float64 {
+	if s.count == 0 {
+		return 0.0
+	}
+	return s.max
+}
+
+func (s *sketch) Quantile(q float64) float64 {
+	if q < 0.0 || q > 1.0 {
+		return nan
+	}
+	if s.count == 0 {
+		return 0.0
+	}
+
+	var (
+		rank       = q * float64(s.count-1)
+		cumulative float64
+	)
+	// Negative values are visited from the largest magnitude to the smallest
+	// so that values are visited in ascending order.
+	for i := len(s.negative.bins) - 1; i >= 0; i-- {
+		cumulative += float64(s.negative.bins[i])
+		if cumulative > rank {
+			return s.clamp(-s.value(s.negative.offset + i))
+		}
+	}
+	cumulative += float64(s.zeroCount)
+	if cumulative > rank {
+		return s.clamp(0.0)
+	}
+	for i := 0; i < len(s.positive.bins); i++ {
+		cumulative += float64(s.positive.bins[i])
+		if cumulative > rank {
+			return s.clamp(s.value(s.positive.offset + i))
+		}
+	}
+	return s.max
+}
+
+func (s *sketch) ForEachBin(fn BinFn) {
+	for i := len(s.negative.bins) - 1; i >= 0; i-- {
+		if count := s.negative.bins[i]; count > 0 {
+			fn(-s.value(s.negative.offset+i), count)
+		}
+	}
+	if s.zeroCount > 0 {
+		fn(0.0, s.zeroCount)
+	}
+	for i := 0; i < len(s.positive.bins); i++ {
+		if count := s.positive.bins[i]; count > 0 {
+			fn(s.value(s.positive.offset+i), count)
+		}
+	}
+}
+
+func (s *sketch) Merge(other Sketch) error {
+	o, ok := other.(*sketch)
+	if !ok {
+		return errUnknownSketchType
+	}
+	if o.relativeAccuracy != s.relativeAccuracy {
+		return errMismatchedRelativeAccuracy
+	}
+	if o.count == 0 {
+		return nil
+	}
+	s.mergeSummary(o.count, o.sum, o.min, o.max)
+	s.zeroCount += o.zeroCount
+	s.positive.merge(&o.positive)
+	s.negative.merge(&o.negative)
+	return nil
+}
+
+func (s *sketch) Clone() Sketch {
+	var cloned *sketch
+	if s.sketchPool != nil {
+		cloned = s.sketchPool.Get().(*sketch)
+		cloned.Reset()
+	} else {
+		cloned = &sketch{
+			relativeAccuracy: s.relativeAccuracy,
+			gamma:            s.gamma,
+			multiplier:       s.multiplier,
+			positive:         denseStore{maxNumBins: s.positive.maxNumBins},
+			negative:         denseStore{maxNumBins: s.negative.maxNumBins},
+		}
+	}
+	// NB: merging into an empty sketch with the same relative accuracy never fails.
+	_ = cloned.Merge(s)
+	return cloned
+}
+
+// Encode appends the encoded sketch to the buffer, the encoding is made of
+// the encoding version, the relative accuracy, the summary statistics, the
+// zero bin count and finally the positive and negative bins.
+func (s *sketch) Encode(buf []byte) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+	buf = append(buf, encodingVersion)
+	buf = appendFloat64(buf, scratch[:], s.relativeAccuracy)
+	buf = appendFloat64(buf, scratch[:], s.sum)
+	buf = appendFloat64(buf, scratch[:], s.min)
+	buf = appendFloat64(buf, scratch[:], s.max)
+	buf = appendUvarint(buf, scratch[:], s.zeroCount)
+	buf = s.positive.encode(buf, scratch[:])
+	buf = s.negative.encode(buf, scratch[:])
+	return buf
+}
+
+func (s *sketch) Decode(data []byte) error {
+	s.Reset()
+	if len(data) == 0 {
+		return errTruncatedSketch
+	}
+	if version := data[0]; version != encodingVersion {
+		return fmt.Errorf("unknown sketch encoding version %d", version)
+	}
+	d := decoder{data: data[1:]}
+	if relativeAccuracy := d.float64(); d.err == nil && relativeAccuracy != s.relativeAccuracy {
+		return errMismatchedRelativeAccuracy
+	}
+	s.sum = d.float64()
+	s.min = d.float64()
+	s.max = d.float64()
+	s.zeroCount = d.uvarint()
+	d.store(&s.positive)
+	d.store(&s.negative)
+	if d.err != nil {
+		s.Reset()
+		return d.err
+	}
+	s.count = s.zeroCount + s.positive.count + s.negative.count
+	return nil
+}
+
+func (s *sketch) Reset() {
+	s.closed = false
+	s.count = 0
+	s.zeroCount = 0
+	s.sum = 0.0
+	s.min = 0.0
+	s.max = 0.0
+	s.positive.reset()
+	s.negative.reset()
+}
+
+func (s *sketch) Close() {
+	if s.closed {
+		return
+	}
+	s.closed = true
+	if s.sketchPool != nil {
+		s.sketchPool.Put(s)
+	}
+}
+
+func (s *sketch) add(value float64, count uint64) {
+	s.mergeSummary(count, value*float64(count), value, value)
+	switch {
+	case value > minIndexableValue:
+		s.positive.add(s.index(value), count)
+	case value < -minIndexableValue:
+		s.negative.add(s.index(-value), count)
+	default:
+		s.zeroCount += count
+	}
+}
+
+func (s *sketch) mergeSummary(count uint64, sum, min, max float64) {
+	if s.count == 0 || min < s.min {
+		s.min = min
+	}
+	if s.count == 0 || max > s.max {
+		s.max = max
+	}
+	s.count += count
+	s.sum += sum
+}
+
+// index returns the index of the bin containing the given positive value.
+func (s *sketch) index(value float64) int {
+	return int(math.Ceil(math.Log(value) * s.multiplier))
+}
+
+// value returns the representative value of the bin at the given index,
+// which is within the relative accuracy of both of the bin boundaries.
+func (s *sketch) value(index int) float64 {
+	return 2 * math.Pow(s.gamma, float64(index)) / (1 + s.gamma)
+}
+
+func (s *sketch) clamp(value float64) float64 {
+	return math.Max(s.min, math.Min(s.max, value))
+}
+
+// denseStore stores the counts of a contiguous range of bins starting at
+// the offset index, once the maximum number of bins is reached the lowest
+// bins are collapsed together.
+type denseStore struct {
+	maxNumBins int
+	offset     int
+	count      uint64
+	bins       []uint64
+}
+
+func (s *denseStore) add(index int, count uint64) {
+	if len(s.bins) == 0 {
+		s.offset = index
+		s.bins = append(s.bins, count)
+		s.count += count
+		return
+	}
+
+	minIndex, maxIndex := s.offset, s.offset+len(s.bins)-1
+	if index < minIndex {
+		minIndex = index
+	}
+	if index > maxIndex {
+		maxIndex = index
+	}
+	if maxIndex-minIndex+1 > s.maxNumBins {
+		minIndex = maxIndex - s.maxNumBins + 1
+		if index < minIndex {
+			index = minIndex
+		}
+	}
+	s.extendRange(minIndex, maxIndex)
+	s.bins[index-s.offset] += count
+	s.count += count
+}
+
+// extendRange resizes the store to cover the range between the min and the
+// max index, the counts of bins below the min index are collapsed into the
+// lowest bin.
+func (s *denseStore) extendRange(minIndex, maxIndex int) {
+	numBins := maxIndex - minIndex + 1
+	if minIndex == s.offset {
+		for len(s.bins) < numBins {
+			s.bins = append(s.bins, 0)
+		}
+		return
+	}
+
+	bins := make([]uint64, numBins)
+	for i, count := range s.bins {
+		index := s.offset + i
+		if index < minIndex {
+			index = minIndex
+		}
+		bins[index-minIndex] += count
+	}
+	s.offset = minIndex
+	s.bins = bins
+}
+
+func (s *denseStore) merge(other *denseStore) {
+	for i, count := range other.bins {
+		if count > 0 {
+			s.add(other.offset+i, count)
+		}
+	}
+}
+
+func (s *denseStore) encode(buf []byte, scratch []byte) []byte {
+	buf = appendVarint(buf, scratch, int64(s.offset))
+	buf = appendUvarint(buf, scratch, uint64(len(s.bins)))
+	for _, count := range s.bins {
+		buf = appendUvarint(buf, scratch, count)
+	}
+	return buf
+}
+
+func (s *denseStore) reset() {
+	s.offset = 0
+	s.count = 0
+	s.bins = s.bins[:0]
+}
+
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) float64() float64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data) < 8 {
+		d.err = errTruncatedSketch
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.data))
+	d.data = d.data[8:]
+	return v
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errTruncatedSketch
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.err = errTruncatedSketch
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) store(s *denseStore) {
+	offset := int(d.varint())
+	numBins := d.uvarint()
+	if d.err != nil {
+		return
+	}
+	// Every bin takes at least a byte, which bounds the allocation below.
+	if numBins > uint64(len(d.data)) {
+		d.err = errTruncatedSketch
+		return
+	}
+	for i := 0; i < int(numBins); i++ {
+		if count := d.uvarint(); count > 0 {
+			s.add(offset+i, count)
+		}
+	}
+}
+
+func appendFloat64(buf []byte, scratch []byte, v float64) []byte {
+	binary.LittleEndian.PutUint64(scratch, math.Float64bits(v))
+	return append(buf, scratch[:8]...)
+}
+
+func appendUvarint(buf []byte, scratch []byte, v uint64) []byte {
+	n := binary.PutUvarint(scratch, v)
+	return append(buf, scratch[:n]...)
+}
+
+func appendVarint(buf []byte, scratch []byte, v int64) []byte {
+	n := binary.PutVarint(scratch, v)
+	return append(buf, scratch[:n]...)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testQuantiles = []float64{0.1, 0.5, 0.75, 0.9, 0.95, 0.99, 0.995, 0.999}
+)
+
+func testSketchOptions() Options {
+	return NewOptions()
+}
+
+// exactQuantile returns the quantile of the sorted values using the same
+// rank definition as the sketch.
+func exactQuantile(sorted []float64, q float64) float64 {
+	return sorted[int(q*float64(len(sorted)-1))]
+}
+
+func requireWithinRelativeAccuracy(
+	t *testing.T,
+	expected, actual, relativeAccuracy float64,
+) {
+	require.InDelta(t, expected, actual, math.Abs(expected)*relativeAccuracy+1e-12)
+}
+
+func TestEmptySketch(t *testing.T) {
+	s := NewSketch(testSketchOptions())
+	require.Equal(t, uint64(0), s.Count())
+	require.Equal(t, 0.0, s.Min())
+	require.Equal(t, 0.0, s.Max())
+	for _, q := range testQuantiles {
+		require.Equal(t, 0.0, s.Quantile(q))
+	}
+}
+
+func TestSketchWithOutOfBoundsQuantile(t *testing.T) {
+	s := NewSketch(testSketchOptions())
+	s.Add(1.0)
+	require.True(t, math.IsNaN(s.Quantile(-1.0)))
+	require.True(t, math.IsNaN(s.Quantile(10.0)))
+}
+
+func TestSketchWithOneValue(t *testing.T) {
+	s := NewSketch(testSketchOptions())
+	s.Add(100.0)
+	require.Equal(t, uint64(1), s.Count())
+	require.Equal(t, 100.0, s.Sum())
+	require.Equal(t, 100.0, s.Min())
+	require.Equal(t, 100.0, s.Max())
+	for _, q := range testQuantiles {
+		require.Equal(t, 100.0, s.Quantile(q))
+	}
+}
+
+func TestSketchRelativeAccuracy(t *testing.T) {
+	opts := testSketchOptions()
+	s := NewSketch(opts)
+
+	rnd := rand.New(rand.NewSource(0))
+	values := make([]float64, 0, 100000)
+	for i := 0; i < 100000; i++ {
+		v := rnd.ExpFloat64() * 100
+		if i%10 == 0 {
+			v = -v
+		}
+		if i%100 == 0 {
+			v = 0
+		}
+		values = append(values, v)
+		s.Add(v)
+	}
+	sort.Float64s(values)
+
+	require.Equal(t, uint64(len(values)), s.Count())
+	require.Equal(t, values[0], s.Min())
+	require.Equal(t, values[len(values)-1], s.Max())
+	for _, q := range testQuantiles {
+		requireWithinRelativeAccuracy(t, exactQuantile(values, q), s.Quantile(q), opts.RelativeAccuracy())
+	}
+}
+
+func TestSketchMerge(t *testing.T) {
+	opts := testSketchOptions()
+	var (
+		merged   = NewSketch(opts)
+		combined = NewSketch(opts)
+		rnd      = rand.New(rand.NewSource(0))
+		values   []float64
+	)
+	for i := 0; i < 10; i++ {
+		s := NewSketch(opts)
+		for j := 0; j < 1000; j++ {
+			// Each sketch covers a different range of values.
+			v := rnd.Float64() * math.Pow(10, float64(i))
+			values = append(values, v)
+			s.Add(v)
+			combined.Add(v)
+		}
+		require.NoError(t, merged.Merge(s))
+	}
+	sort.Float64s(values)
+
+	require.Equal(t, combined.Count(), merged.Count())
+	require.InDelta(t, combined.Sum(), merged.Sum(), 1e-6)
+	require.Equal(t, combined.Min(), merged.Min())
+	require.Equal(t, combined.Max(), merged.Max())
+	for _, q := range testQuantiles {
+		require.Equal(t, combined.Quantile(q), merged.Quantile(q))
+		requireWithinRelativeAccuracy(t, exactQuantile(values, q), merged.Quantile(q), opts.RelativeAccuracy())
+	}
+}
+
+func TestSketchMergeMismatchedRelativeAccuracy(t *testing.T) {
+	s := NewSketch(testSketchOptions())
+	other := NewSketch(testSketchOptions().SetRelativeAccuracy(0.05))
+	other.Add(1.0)
+	require.Equal(t, errMismatchedRelativeAccuracy, s.Merge(other))
+}
+
+func TestSketchClone(t *testing.T) {
+	for _, s := range []Sketch{
+		NewSketch(testSketchOptions()),
+		NewSketch(testSketchOptions().SetSketchPool(nil)),
+	} {
+		for i := 1; i <= 100; i++ {
+			s.Add(float64(i))
+		}
+		cloned := s.Clone()
+		require.Equal(t, s.Encode(nil), cloned.Encode(nil))
+
+		// Assert that modifying the clone does not mutate the original sketch.
+		cloned.Add(1000)
+		require.Equal(t, uint64(100), s.Count())
+		require.Equal(t, 100.0, s.Max())
+		require.Equal(t, uint64(101), cloned.Count())
+	}
+}
+
+func TestSketchCollapsesLowestBins(t *testing.T) {
+	opts := testSketchOptions().SetMaxNumBins(minMaxNumBins)
+	s := NewSketch(opts)
+	for i := 0; i < 1000; i++ {
+		s.Add(float64(i + 1))
+	}
+
+	require.Equal(t, uint64(1000), s.Count())
+	require.Equal(t, 1.0, s.Min())
+	require.Equal(t, 1000.0, s.Max())
+
+	var numBins int
+	s.ForEachBin(func(float64, uint64) { numBins++ })
+	require.Equal(t, minMaxNumBins, numBins)
+
+	// The highest quantiles are unaffected by the collapsing.
+	requireWithinRelativeAccuracy(t, 990.0, s.Quantile(0.99), opts.RelativeAccuracy())
+}
+
+func TestSketchEncodeDecodeRoundtrip(t *testing.T) {
+	opts := testSketchOptions()
+	s := NewSketch(opts)
+	rnd := rand.New(rand.NewSource(0))
+	for i := 0; i < 1000; i++ {
+		s.Add(rnd.NormFloat64() * 100)
+	}
+	s.Add(0)
+
+	decoded := NewSketch(opts)
+	require.NoError(t, decoded.Decode(s.Encode(nil)))
+	require.Equal(t, s.Count(), decoded.Count())
+	require.Equal(t, s.Sum(), decoded.Sum())
+	require.Equal(t, s.Min(), decoded.Min())
+	require.Equal(t, s.Max(), decoded.Max())
+	for _, q := range testQuantiles {
+		require.Equal(t, s.Quantile(q), decoded.Quantile(q))
+	}
+}
+
+func TestSketchDecodeErrors(t *testing.T) {
+	opts := testSketchOptions()
+	s := NewSketch(opts)
+	s.Add(1.0)
+	encoded := s.Encode(nil)
+
+	decoded := NewSketch(opts)
+	require.Equal(t, errTruncatedSketch, decoded.Decode(nil))
+	require.Equal(t, errTruncatedSketch, decoded.Decode(encoded[:len(encoded)-1]))
+	require.Equal(t, uint64(0), decoded.Count())
+
+	other := NewSketch(opts.SetRelativeAccuracy(0.05))
+	require.Equal(t, errMismatchedRelativeAccuracy, other.Decode(encoded))
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ddsketch
+
+// Sketch is a mergeable quantile sketch with relative-error guarantees.
+type Sketch interface {
+	// Add adds a value.
+	Add(value float64)
+
+	// Count returns the number of values added.
+	Count() uint64
+
+	// Sum returns the sum of the values added.
+	Sum() float64
+
+	// Min returns the minimum value.
+	Min() float64
+
+	// Max returns the maximum value.
+	Max() float64
+
+	// Quantile returns the quantile value.
+	Quantile(q float64) float64
+
+	// ForEachBin calls the function for the representative value and count
+	// of every non-empty bin in ascending order of values.
+	ForEachBin(fn BinFn)
+
+	// Merge merges another sketch, both sketches must have the same
+	// relative accuracy.
+	Merge(other Sketch) error
+
+	// Clone returns a copy of the sketch, allocated from the sketch pool
+	// if the sketch has one.
+	Clone() Sketch
+
+	// Encode appends the binary encoding of the sketch to the buffer.
+	Encode(buf []byte) []byte
+
+	// Decode resets the sketch and sets its data from the binary encoding.
+	Decode(data []byte) error
+
+	// Reset resets the sketch.
+	Reset()
+
+	// Close closes the sketch.
+	Close()
+}
+
+// BinFn is called with the representative value and count of a bin.
+type BinFn func(value float64, count uint64)
+
+// SketchAlloc allocates a sketch.
+type SketchAlloc func() Sketch
+
+// SketchPool provides a pool for sketches.
+type SketchPool interface {
+	// Init initializes the pool.
+	Init(alloc SketchAlloc)
+
+	// Get provides a sketch from the pool.
+	Get() Sketch
+
+	// Put returns a sketch to the pool.
+	Put(value Sketch)
+}
+
+// Options provides a set of sketch options.
+type Options interface {
+	// SetRelativeAccuracy sets the relative accuracy guaranteed for quantiles.
+	SetRelativeAccuracy(value float64) Options
+
+	// RelativeAccuracy returns the relative accuracy guaranteed for quantiles.
+	RelativeAccuracy() float64
+
+	// SetMaxNumBins sets the maximum number of bins per sign, once reached
+	// the lowest bins are collapsed together.
+	SetMaxNumBins(value int) Options
+
+	// MaxNumBins returns the maximum number of bins per sign, once reached
+	// the lowest bins are collapsed together.
+	MaxNumBins() int
+
+	// SetSketchPool sets the sketch pool.
+	SetSketchPool(value SketchPool) Options
+
+	// SketchPool returns the sketch pool.
+	SketchPool() SketchPool
+
+	// Validate validates the options.
+	Validate() error
+}
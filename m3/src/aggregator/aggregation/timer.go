@@ -21,9 +21,11 @@
 package aggregation
 
 import (
+	"math"
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/metrics/aggregation"
 )
 
@@ -36,6 +38,14 @@ type Timer struct {
 	sum    float64   // Sum of the values.
 	sumSq  float64   // Sum of squared values.
 	stream cm.Stream // Stream of values received.
+
+	// sumSqUnknown is set once a sketch has been merged into the timer,
+	// sketches do not carry the squared sum of their values.
+	sumSqUnknown bool
+
+	// Sketch of values received, if set the timer is backed by the sketch
+	// instead of the stream.
+	sketch ddsketch.Sketch
 }
 
 // NewTimer creates a new timer
@@ -48,6 +58,18 @@ func NewTimer(quantiles []float64, streamOpts cm.Options, opts Options) Timer {
 	}
 }
 
+// NewSketchTimer creates a new timer backed by a sketch, which supports
+// quantiles of arbitrary values and can be merged with other sketches
+// without losing accuracy.
+func NewSketchTimer(sketchOpts ddsketch.Options, opts Options) Timer {
+	sketch := sketchOpts.SketchPool().Get()
+	sketch.Reset()
+	return Timer{
+		Options: opts,
+		sketch:  sketch,
+	}
+}
+
 // Add adds a timer value.
 func (t *Timer) Add(timestamp time.Time, value float64) {
 	t.recordLastAt(timestamp)
@@ -72,10 +94,38 @@ func (t *Timer) recordLastAt(timestamp time.Time) {
 	}
 }
 
+// Merge merges the values of a sketch into the timer. If the timer is not
+// backed by a sketch, the representative value of each sketch bin is added
+// to the stream weighted by the bin count.
+// NB: The squared sum of the values cannot be recovered from the sketch, so
+// the squared sum and standard deviation of the timer become unavailable.
+func (t *Timer) Merge(timestamp time.Time, sketch ddsketch.Sketch) error {
+	if t.sketch != nil {
+		if err := t.sketch.Merge(sketch); err != nil {
+			return err
+		}
+	} else {
+		sketch.ForEachBin(func(value float64, count uint64) {
+			t.stream.AddWeighted(value, int64(count))
+		})
+	}
+	t.recordLastAt(timestamp)
+	t.count += int64(sketch.Count())
+	t.sum += sketch.Sum()
+	if sketch.Count() > 0 {
+		t.sumSqUnknown = true
+	}
+	return nil
+}
+
 func (t *Timer) addValue(value float64) {
 	t.count++
 	t.sum += value
-	t.stream.Add(value)
+	if t.sketch != nil {
+		t.sketch.Add(value)
+	} else {
+		t.stream.Add(value)
+	}
 
 	if t.HasExpensiveAggregations {
 		t.sumSq += value * value
@@ -85,8 +135,15 @@ func (t *Timer) addValue(value float64) {
 // LastAt returns the time of the last value received.
 func (t *Timer) LastAt() time.Time { return t.lastAt }
 
+// Sketch returns the sketch backing the timer, or nil if the timer
+// is backed by a stream.
+func (t *Timer) Sketch() ddsketch.Sketch { return t.sketch }
+
 // Quantile returns the value at a given quantile.
 func (t *Timer) Quantile(q float64) float64 {
+	if t.sketch != nil {
+		return t.sketch.Quantile(q)
+	}
 	t.stream.Flush()
 	return t.stream.Quantile(q)
 }
@@ -96,12 +153,18 @@ func (t *Timer) Count() int64 { return t.count }
 
 // Min returns the minimum timer value.
 func (t *Timer) Min() float64 {
+	if t.sketch != nil {
+		return t.sketch.Min()
+	}
 	t.stream.Flush()
 	return t.stream.Min()
 }
 
 // Max returns the maximum timer value.
 func (t *Timer) Max() float64 {
+	if t.sketch != nil {
+		return t.sketch.Max()
+	}
 	t.stream.Flush()
 	return t.stream.Max()
 }
@@ -109,8 +172,14 @@ func (t *Timer) Max() float64 {
 // Sum returns the sum of timer values.
 func (t *Timer) Sum() float64 { return t.sum }
 
-// SumSq returns the squared sum of timer values.
-func (t *Timer) SumSq() float64 { return t.sumSq }
+// SumSq returns the squared sum of timer values, or NaN if it is unknown
+// since a sketch was merged into the timer.
+func (t *Timer) SumSq() float64 {
+	if t.sumSqUnknown {
+		return math.NaN()
+	}
+	return t.sumSq
+}
 
 // Mean returns the mean timer value.
 func (t *Timer) Mean() float64 {
@@ -120,8 +189,12 @@ func (t *Timer) Mean() float64 {
 	return t.sum / float64(t.count)
 }
 
-// Stdev returns the standard deviation timer value.
+// Stdev returns the standard deviation timer value, or NaN if it is unknown
+// since a sketch was merged into the timer.
 func (t *Timer) Stdev() float64 {
+	if t.sumSqUnknown {
+		return math.NaN()
+	}
 	return stdev(t.count, t.sumSq, t.sum)
 }
 
@@ -151,4 +224,10 @@ func (t *Timer) ValueOf(aggType aggregation.Type) float64 {
 }
 
 // Close closes the timer.
-func (t *Timer) Close() { t.stream.Close() }
+func (t *Timer) Close() {
+	if t.sketch != nil {
+		t.sketch.Close()
+		return
+	}
+	t.stream.Close()
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/pool"
@@ -173,3 +174,120 @@ func TestTimerAggregationsNotExpensive(t *testing.T) {
 	// Closing the timer a second time should be a no op.
 	timer.Close()
 }
+
+func TestSketchTimerAggregations(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.ResetSetData(testAggTypes)
+
+	sketchOpts := ddsketch.NewOptions()
+	timer := NewSketchTimer(sketchOpts, opts)
+	require.NotNil(t, timer.Sketch())
+
+	// Add values.
+	at := time.Now()
+	for i := 1; i <= 1000; i++ {
+		timer.Add(at, float64(i))
+	}
+
+	// Validate the timer values match expectations.
+	relativeAccuracy := sketchOpts.RelativeAccuracy()
+	require.Equal(t, int64(1000), timer.Count())
+	require.Equal(t, 500500.0, timer.Sum())
+	require.Equal(t, 333833500.0, timer.SumSq())
+	require.Equal(t, 1.0, timer.Min())
+	require.Equal(t, 1000.0, timer.Max())
+	require.Equal(t, 500.5, timer.Mean())
+	require.InEpsilon(t, 500.0, timer.Quantile(0.5), relativeAccuracy)
+	require.InEpsilon(t, 750.0, timer.Quantile(0.75), relativeAccuracy)
+	require.InEpsilon(t, 995.0, timer.Quantile(0.995), relativeAccuracy)
+	require.InEpsilon(t, 990.0, timer.ValueOf(aggregation.P99), relativeAccuracy)
+
+	timer.Close()
+}
+
+func TestSketchTimerMerge(t *testing.T) {
+	var (
+		opts       = NewOptions(instrument.NewOptions())
+		sketchOpts = ddsketch.NewOptions()
+		timer      = NewSketchTimer(sketchOpts, opts)
+		at         = time.Now()
+	)
+	for i := 1; i <= 500; i++ {
+		timer.Add(at, float64(i))
+	}
+
+	other := NewSketchTimer(sketchOpts, opts)
+	for i := 501; i <= 1000; i++ {
+		other.Add(at, float64(i))
+	}
+	mergeAt := at.Add(time.Second)
+	require.NoError(t, timer.Merge(mergeAt, other.Sketch()))
+	other.Close()
+
+	relativeAccuracy := sketchOpts.RelativeAccuracy()
+	require.Equal(t, mergeAt, timer.LastAt())
+	require.Equal(t, int64(1000), timer.Count())
+	require.Equal(t, 500500.0, timer.Sum())
+	require.Equal(t, 1.0, timer.Min())
+	require.Equal(t, 1000.0, timer.Max())
+	require.InEpsilon(t, 750.0, timer.Quantile(0.75), relativeAccuracy)
+	require.InEpsilon(t, 995.0, timer.Quantile(0.995), relativeAccuracy)
+
+	timer.Close()
+}
+
+func TestTimerMergeSketchIntoStream(t *testing.T) {
+	var (
+		opts   = NewOptions(instrument.NewOptions())
+		timer  = NewTimer(testQuantiles, cm.NewOptions(), opts)
+		sketch = ddsketch.NewSketch(ddsketch.NewOptions())
+		at     = time.Now()
+	)
+	for i := 1; i <= 100; i++ {
+		sketch.Add(float64(i))
+	}
+	require.NoError(t, timer.Merge(at, sketch))
+
+	require.Equal(t, int64(100), timer.Count())
+	require.Equal(t, 5050.0, timer.Sum())
+	require.InEpsilon(t, 1.0, timer.Min(), 0.01)
+	require.InEpsilon(t, 100.0, timer.Max(), 0.01)
+	require.InEpsilon(t, 50.0, timer.Quantile(0.5), 0.05)
+
+	// The squared sum of the merged values is unknown.
+	require.True(t, math.IsNaN(timer.Stdev()))
+
+	timer.Close()
+}
+
+func TestTimerMergeHeavySketchIntoStream(t *testing.T) {
+	opts := NewOptions(instrument.NewOptions())
+	opts.ResetSetData(testAggTypes)
+
+	var (
+		timer  = NewTimer(testQuantiles, cm.NewOptions(), opts)
+		sketch = ddsketch.NewSketch(ddsketch.NewOptions())
+		at     = time.Now()
+	)
+	for i := 1; i <= 100; i++ {
+		timer.Add(at, float64(i))
+	}
+	require.Equal(t, 338350.0, timer.SumSq())
+
+	// Merge bins with large counts which are added to the stream by weight.
+	for i := 0; i < 5000; i++ {
+		sketch.Add(75.0)
+	}
+	require.NoError(t, timer.Merge(at, sketch))
+
+	require.Equal(t, int64(5100), timer.Count())
+	require.Equal(t, 1.0, timer.Min())
+	require.Equal(t, 100.0, timer.Max())
+	require.InEpsilon(t, 75.0, timer.Quantile(0.5), 0.01)
+	require.InEpsilon(t, 75.0, timer.Quantile(0.99), 0.01)
+	require.True(t, math.IsNaN(timer.SumSq()))
+	require.True(t, math.IsNaN(timer.Stdev()))
+	require.True(t, math.IsNaN(timer.ValueOf(aggregation.Stdev)))
+
+	timer.Close()
+}
@@ -21,12 +21,18 @@
 package aggregator
 
 import (
+	"errors"
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
 )
 
+var (
+	errSketchNotSupported = errors.New("sketches are only supported for timers")
+)
+
 // counterAggregation is a counter aggregation.
 type counterAggregation struct {
 	aggregation.Counter
@@ -44,6 +50,14 @@ func (a *counterAggregation) AddUnion(t time.Time, mu unaggregated.MetricUnion)
 	a.Counter.Update(t, mu.CounterVal)
 }
 
+func (a *counterAggregation) Merge(time.Time, ddsketch.Sketch) error {
+	return errSketchNotSupported
+}
+
+func (a *counterAggregation) Sketch() ddsketch.Sketch { return nil }
+
+func (a *counterAggregation) ValueOfQuantile(float64) float64 { return nan }
+
 // timerAggregation is a timer aggregation.
 type timerAggregation struct {
 	aggregation.Timer
@@ -61,6 +75,10 @@ func (a *timerAggregation) AddUnion(timestamp time.Time, mu unaggregated.MetricU
 	a.Timer.AddBatch(timestamp, mu.BatchTimerVal)
 }
 
+func (a *timerAggregation) ValueOfQuantile(q float64) float64 {
+	return a.Timer.Quantile(q)
+}
+
 // gaugeAggregation is a gauge aggregation.
 type gaugeAggregation struct {
 	aggregation.Gauge
@@ -77,3 +95,11 @@ func (a *gaugeAggregation) Add(t time.Time, value float64) {
 func (a *gaugeAggregation) AddUnion(t time.Time, mu unaggregated.MetricUnion) {
 	a.Gauge.Update(t, mu.GaugeVal)
 }
+
+func (a *gaugeAggregation) Merge(time.Time, ddsketch.Sketch) error {
+	return errSketchNotSupported
+}
+
+func (a *gaugeAggregation) Sketch() ddsketch.Sketch { return nil }
+
+func (a *gaugeAggregation) ValueOfQuantile(float64) float64 { return nan }
@@ -28,6 +28,7 @@ import (
 
 type aggregationKey struct {
 	aggregationID      aggregation.ID
+	quantiles          aggregation.Quantiles
 	storagePolicy      policy.StoragePolicy
 	pipeline           applied.Pipeline
 	numForwardedTimes  int
@@ -36,6 +37,7 @@ type aggregationKey struct {
 
 func (k aggregationKey) Equal(other aggregationKey) bool {
 	return k.aggregationID == other.aggregationID &&
+		k.quantiles.Equal(other.quantiles) &&
 		k.storagePolicy == other.storagePolicy &&
 		k.pipeline.Equal(other.pipeline) &&
 		k.numForwardedTimes == other.numForwardedTimes &&
@@ -58,6 +58,32 @@ func TestAggregationKeyEqual(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			a: aggregationKey{
+				aggregationID: aggregation.DefaultID,
+				quantiles:     aggregation.MustNewQuantiles(0.75, 0.995),
+				storagePolicy: policy.NewStoragePolicy(10*time.Second, xtime.Second, 48*time.Hour),
+			},
+			b: aggregationKey{
+				aggregationID: aggregation.DefaultID,
+				quantiles:     aggregation.MustNewQuantiles(0.75, 0.995),
+				storagePolicy: policy.NewStoragePolicy(10*time.Second, xtime.Second, 48*time.Hour),
+			},
+			expected: true,
+		},
+		{
+			a: aggregationKey{
+				aggregationID: aggregation.DefaultID,
+				quantiles:     aggregation.MustNewQuantiles(0.75, 0.995),
+				storagePolicy: policy.NewStoragePolicy(10*time.Second, xtime.Second, 48*time.Hour),
+			},
+			b: aggregationKey{
+				aggregationID: aggregation.DefaultID,
+				quantiles:     aggregation.MustNewQuantiles(0.75),
+				storagePolicy: policy.NewStoragePolicy(10*time.Second, xtime.Second, 48*time.Hour),
+			},
+			expected: false,
+		},
 		{
 			a: aggregationKey{
 				aggregationID:      aggregation.DefaultID,
@@ -26,6 +26,7 @@ import (
 
 	"github.com/m3db/m3/src/aggregator/aggregation"
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/metrics/metric"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
 	"github.com/m3db/m3/src/x/instrument"
@@ -90,6 +91,35 @@ func TestTimerAggregationAddUnion(t *testing.T) {
 	require.Equal(t, 18.0, tm.Sum())
 }
 
+func TestTimerAggregationMerge(t *testing.T) {
+	sketchOpts := ddsketch.NewOptions()
+	tm := newTimerAggregation(aggregation.NewSketchTimer(sketchOpts, aggregation.NewOptions(instrument.NewOptions())))
+	for _, v := range testAggregationValues {
+		tm.Add(time.Now(), v)
+	}
+	sketch := ddsketch.NewSketch(sketchOpts)
+	for _, v := range testAggregationValues {
+		sketch.Add(v)
+	}
+	require.NoError(t, tm.Merge(time.Now(), sketch))
+	require.Equal(t, int64(8), tm.Count())
+	require.Equal(t, 1598.4, tm.Sum())
+	require.Equal(t, uint64(8), tm.Sketch().Count())
+	require.InEpsilon(t, 789.0, tm.ValueOfQuantile(0.99), sketchOpts.RelativeAccuracy())
+}
+
+func TestCounterAndGaugeAggregationMergeNotSupported(t *testing.T) {
+	var (
+		sketch = ddsketch.NewSketch(ddsketch.NewOptions())
+		c      = newCounterAggregation(aggregation.NewCounter(aggregation.NewOptions(instrument.NewOptions())))
+		g      = newGaugeAggregation(aggregation.NewGauge(aggregation.NewOptions(instrument.NewOptions())))
+	)
+	require.Equal(t, errSketchNotSupported, c.Merge(time.Now(), sketch))
+	require.Nil(t, c.Sketch())
+	require.Equal(t, errSketchNotSupported, g.Merge(time.Now(), sketch))
+	require.Nil(t, g.Sketch())
+}
+
 func TestGaugeAggregationAdd(t *testing.T) {
 	g := newGaugeAggregation(aggregation.NewGauge(aggregation.NewOptions(instrument.NewOptions())))
 	for _, v := range testAggregationValues {
@@ -106,6 +106,7 @@ type metricElem interface {
 		id id.RawID,
 		sp policy.StoragePolicy,
 		aggTypes maggregation.Types,
+		quantiles maggregation.Quantiles,
 		pipeline applied.Pipeline,
 		numForwardedTimes int,
 		idPrefixSuffixType IDPrefixSuffixType,
@@ -129,6 +130,11 @@ type metricElem interface {
 	// same aggregation, the incoming value is discarded.
 	AddUnique(timestamp time.Time, values []float64, sourceID uint32) error
 
+	// AddUniqueSketch merges an encoded sketch from a given source at a given
+	// timestamp. If previous values from the same source have already been added
+	// to the same aggregation, the incoming sketch is discarded.
+	AddUniqueSketch(timestamp time.Time, sketch []byte, sourceID uint32) error
+
 	// Consume consumes values before a given time and removes
 	// them from the element after they are consumed, returning whether
 	// the element can be collected after the consumption is completed.
@@ -162,6 +168,7 @@ type elemBase struct {
 	aggTypes                        maggregation.Types
 	aggOpts                         raggregation.Options
 	parsedPipeline                  parsedPipeline
+	forwardSketch                   bool
 	numForwardedTimes               int
 	idPrefixSuffixType              IDPrefixSuffixType
 	writeForwardedMetricFn          writeForwardedMetricFn
@@ -204,6 +211,12 @@ func (e *elemBase) resetSetData(
 	e.useDefaultAggregation = useDefaultAggregation
 	e.aggOpts.ResetSetData(aggTypes)
 	e.parsedPipeline = parsed
+	// NB: The sketch is only forwarded if the rollup computes arbitrary quantiles
+	// and the values are not transformed before the rollup, in which case the
+	// sketch no longer describes the forwarded values.
+	e.forwardSketch = parsed.HasRollup &&
+		!parsed.Rollup.Quantiles.IsEmpty() &&
+		len(parsed.Transformations) == 0
 	e.numForwardedTimes = numForwardedTimes
 	e.tombstoned = false
 	e.closed = false
@@ -234,6 +247,7 @@ func (e *elemBase) ForwardedAggregationKey() (aggregationKey, bool) {
 	}
 	return aggregationKey{
 		aggregationID:     e.parsedPipeline.Rollup.AggregationID,
+		quantiles:         e.parsedPipeline.Rollup.Quantiles,
 		storagePolicy:     e.sp,
 		pipeline:          e.parsedPipeline.Remainder,
 		numForwardedTimes: e.numForwardedTimes + 1,
@@ -266,6 +280,10 @@ func (e counterElemBase) TypeStringFor(aggTypesOpts maggregation.TypesOptions, a
 	return aggTypesOpts.TypeStringForCounter(aggType)
 }
 
+func (e counterElemBase) Quantiles() maggregation.Quantiles { return nil }
+
+func (e counterElemBase) TypeStringForQuantile(maggregation.TypesOptions, float64) []byte { return nil }
+
 func (e counterElemBase) ElemPool(opts Options) CounterElemPool { return opts.CounterElemPool() }
 
 func (e counterElemBase) NewAggregation(_ Options, aggOpts raggregation.Options) counterAggregation {
@@ -275,6 +293,8 @@ func (e counterElemBase) NewAggregation(_ Options, aggOpts raggregation.Options)
 func (e *counterElemBase) ResetSetData(
 	_ maggregation.TypesOptions,
 	aggTypes maggregation.Types,
+	_ maggregation.Quantiles,
+	_ bool,
 	_ bool,
 ) error {
 	if !aggTypes.IsValidForCounter() {
@@ -288,6 +308,11 @@ func (e *counterElemBase) Close() {}
 type timerElemBase struct {
 	quantiles     []float64
 	quantilesPool pool.FloatsPool
+
+	// Arbitrary quantiles computed in addition to the aggregation types,
+	// and whether the timer is backed by a sketch.
+	customQuantiles maggregation.Quantiles
+	useSketch       bool
 }
 
 func (e timerElemBase) Type() metric.Type { return metric.TimerType }
@@ -302,9 +327,18 @@ func (e timerElemBase) TypeStringFor(aggTypesOpts maggregation.TypesOptions, agg
 	return aggTypesOpts.TypeStringForTimer(aggType)
 }
 
+func (e timerElemBase) Quantiles() maggregation.Quantiles { return e.customQuantiles }
+
+func (e timerElemBase) TypeStringForQuantile(aggTypesOpts maggregation.TypesOptions, quantile float64) []byte {
+	return aggTypesOpts.TypeStringForTimerQuantile(quantile)
+}
+
 func (e timerElemBase) ElemPool(opts Options) TimerElemPool { return opts.TimerElemPool() }
 
 func (e timerElemBase) NewAggregation(opts Options, aggOpts raggregation.Options) timerAggregation {
+	if e.useSketch {
+		return newTimerAggregation(raggregation.NewSketchTimer(opts.SketchOptions(), aggOpts))
+	}
 	newTimer := raggregation.NewTimer(e.quantiles, opts.StreamOptions(), aggOpts)
 	return newTimerAggregation(newTimer)
 }
@@ -312,11 +346,20 @@ func (e timerElemBase) NewAggregation(opts Options, aggOpts raggregation.Options
 func (e *timerElemBase) ResetSetData(
 	aggTypesOpts maggregation.TypesOptions,
 	aggTypes maggregation.Types,
+	quantiles maggregation.Quantiles,
 	useDefaultAggregation bool,
+	forwardSketch bool,
 ) error {
 	if !aggTypes.IsValidForTimer() {
 		return fmt.Errorf("invalid aggregation types %s for timer", aggTypes.String())
 	}
+	if quantiles.IsEmpty() && useDefaultAggregation {
+		quantiles = aggTypesOpts.DefaultTimerQuantiles()
+	}
+	e.customQuantiles = quantiles
+	// Timers computing arbitrary quantiles or forwarding their sketch are backed
+	// by sketches, which unlike streams are mergeable without losing accuracy.
+	e.useSketch = !quantiles.IsEmpty() || forwardSketch
 	if useDefaultAggregation {
 		e.quantiles = aggTypesOpts.Quantiles()
 		e.quantilesPool = nil
@@ -342,6 +385,8 @@ func (e *timerElemBase) Close() {
 	}
 	e.quantiles = nil
 	e.quantilesPool = nil
+	e.customQuantiles = nil
+	e.useSketch = false
 }
 
 type gaugeElemBase struct{}
@@ -358,6 +403,10 @@ func (e gaugeElemBase) TypeStringFor(aggTypesOpts maggregation.TypesOptions, agg
 	return aggTypesOpts.TypeStringForGauge(aggType)
 }
 
+func (e gaugeElemBase) Quantiles() maggregation.Quantiles { return nil }
+
+func (e gaugeElemBase) TypeStringForQuantile(maggregation.TypesOptions, float64) []byte { return nil }
+
 func (e gaugeElemBase) ElemPool(opts Options) GaugeElemPool { return opts.GaugeElemPool() }
 
 func (e gaugeElemBase) NewAggregation(_ Options, aggOpts raggregation.Options) gaugeAggregation {
@@ -367,6 +416,8 @@ func (e gaugeElemBase) NewAggregation(_ Options, aggOpts raggregation.Options) g
 func (e *gaugeElemBase) ResetSetData(
 	_ maggregation.TypesOptions,
 	aggTypes maggregation.Types,
+	_ maggregation.Quantiles,
+	_ bool,
 	_ bool,
 ) error {
 	if !aggTypes.IsValidForGauge() {
@@ -198,12 +198,12 @@ func TestCounterElemBaseNewAggregation(t *testing.T) {
 
 func TestCounterElemBaseResetSetData(t *testing.T) {
 	e := counterElemBase{}
-	require.NoError(t, e.ResetSetData(nil, maggregation.Types{maggregation.Sum}, false))
+	require.NoError(t, e.ResetSetData(nil, maggregation.Types{maggregation.Sum}, nil, false, false))
 }
 
 func TestCounterElemBaseResetSetDataInvalidTypes(t *testing.T) {
 	e := counterElemBase{}
-	err := e.ResetSetData(nil, maggregation.Types{maggregation.Last}, false)
+	err := e.ResetSetData(nil, maggregation.Types{maggregation.Last}, nil, false, false)
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "invalid aggregation types Last for counter"))
 }
@@ -250,7 +250,7 @@ func TestTimerElemBaseResetSetDataWithDefaultAggregation(t *testing.T) {
 	e := timerElemBase{}
 	typesOpts := maggregation.NewTypesOptions()
 	aggTypes := typesOpts.DefaultTimerAggregationTypes()
-	require.NoError(t, e.ResetSetData(typesOpts, aggTypes, true))
+	require.NoError(t, e.ResetSetData(typesOpts, aggTypes, nil, true, false))
 	require.Equal(t, typesOpts.Quantiles(), e.quantiles)
 	require.Nil(t, e.quantilesPool)
 
@@ -263,7 +263,7 @@ func TestTimerElemBaseResetSetDataWithCustomAggregation(t *testing.T) {
 	e := timerElemBase{}
 	typesOpts := maggregation.NewTypesOptions()
 	aggTypes := maggregation.Types{maggregation.P99, maggregation.P9999}
-	require.NoError(t, e.ResetSetData(typesOpts, aggTypes, false))
+	require.NoError(t, e.ResetSetData(typesOpts, aggTypes, nil, false, false))
 	require.Equal(t, []float64{0.99, 0.9999}, e.quantiles)
 	require.NotNil(t, e.quantilesPool)
 
@@ -272,9 +272,53 @@ func TestTimerElemBaseResetSetDataWithCustomAggregation(t *testing.T) {
 	require.Nil(t, e.quantilesPool)
 }
 
+func TestTimerElemBaseResetSetDataWithDefaultTimerQuantiles(t *testing.T) {
+	e := timerElemBase{}
+	quantiles := maggregation.MustNewQuantiles(0.75, 0.995)
+	typesOpts := maggregation.NewTypesOptions().SetDefaultTimerQuantiles(quantiles)
+	aggTypes := typesOpts.DefaultTimerAggregationTypes()
+	require.NoError(t, e.ResetSetData(typesOpts, aggTypes, nil, true, false))
+	require.Equal(t, quantiles, e.Quantiles())
+	require.True(t, e.useSketch)
+	require.Equal(t, []byte("p75"), e.TypeStringForQuantile(typesOpts, 0.75))
+
+	e.Close()
+	require.Nil(t, e.Quantiles())
+	require.False(t, e.useSketch)
+}
+
+func TestTimerElemBaseResetSetDataWithCustomQuantiles(t *testing.T) {
+	e := timerElemBase{}
+	quantiles := maggregation.MustNewQuantiles(0.999)
+	typesOpts := maggregation.NewTypesOptions().SetDefaultTimerQuantiles(maggregation.MustNewQuantiles(0.75))
+	aggTypes := maggregation.Types{maggregation.Max}
+	require.NoError(t, e.ResetSetData(typesOpts, aggTypes, quantiles, false, false))
+	require.Equal(t, quantiles, e.Quantiles())
+	require.True(t, e.useSketch)
+
+	agg := e.NewAggregation(NewOptions(), raggregation.Options{})
+	require.NotNil(t, agg.Sketch())
+}
+
+func TestTimerElemBaseResetSetDataWithForwardSketch(t *testing.T) {
+	e := timerElemBase{}
+	typesOpts := maggregation.NewTypesOptions()
+	aggTypes := maggregation.Types{maggregation.Max}
+	require.NoError(t, e.ResetSetData(typesOpts, aggTypes, nil, false, false))
+	require.False(t, e.useSketch)
+	agg := e.NewAggregation(NewOptions(), raggregation.Options{})
+	require.Nil(t, agg.Sketch())
+
+	require.NoError(t, e.ResetSetData(typesOpts, aggTypes, nil, false, true))
+	require.True(t, e.useSketch)
+	require.Nil(t, e.Quantiles())
+	agg = e.NewAggregation(NewOptions(), raggregation.Options{})
+	require.NotNil(t, agg.Sketch())
+}
+
 func TestTimerElemBaseResetSetDataInvalidTypes(t *testing.T) {
 	e := timerElemBase{}
-	err := e.ResetSetData(nil, maggregation.Types{maggregation.Last}, false)
+	err := e.ResetSetData(nil, maggregation.Types{maggregation.Last}, nil, false, false)
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "invalid aggregation types Last for timer"))
 }
@@ -307,12 +351,12 @@ func TestGaugeElemBaseNewLockedAggregation(t *testing.T) {
 
 func TestGaugeElemBaseResetSetData(t *testing.T) {
 	e := gaugeElemBase{}
-	require.NoError(t, e.ResetSetData(nil, maggregation.Types{maggregation.Sum}, false))
+	require.NoError(t, e.ResetSetData(nil, maggregation.Types{maggregation.Sum}, nil, false, false))
 }
 
 func TestGaugeElemBaseResetSetDataInvalidTypes(t *testing.T) {
 	e := gaugeElemBase{}
-	err := e.ResetSetData(nil, maggregation.Types{maggregation.P99}, false)
+	err := e.ResetSetData(nil, maggregation.Types{maggregation.P99}, nil, false, false)
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "invalid aggregation types P99 for gauge"))
 }
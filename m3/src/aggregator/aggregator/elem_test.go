@@ -27,6 +27,7 @@ import (
 
 	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	maggregation "github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metric"
 	"github.com/m3db/m3/src/metrics/metric/id"
@@ -104,7 +105,7 @@ var (
 
 func TestCounterResetSetData(t *testing.T) {
 	opts := NewOptions()
-	ce, err := NewCounterElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, 1, NoPrefixNoSuffix, opts)
+	ce, err := NewCounterElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, 1, NoPrefixNoSuffix, opts)
 	require.NoError(t, err)
 	require.Equal(t, opts.AggregationTypesOptions().DefaultCounterAggregationTypes(), ce.aggTypes)
 	require.True(t, ce.useDefaultAggregation)
@@ -112,7 +113,7 @@ func TestCounterResetSetData(t *testing.T) {
 	require.Equal(t, 1, ce.numForwardedTimes)
 
 	// Reset element with a default pipeline.
-	err = ce.ResetSetData(testCounterID, testStoragePolicy, testAggregationTypesExpensive, applied.DefaultPipeline, 2, NoPrefixNoSuffix)
+	err = ce.ResetSetData(testCounterID, testStoragePolicy, testAggregationTypesExpensive, nil, applied.DefaultPipeline, 2, NoPrefixNoSuffix)
 	require.NoError(t, err)
 	require.Equal(t, testCounterID, ce.id)
 	require.Equal(t, testStoragePolicy, ce.sp)
@@ -147,7 +148,7 @@ func TestCounterResetSetData(t *testing.T) {
 			},
 		}),
 	}
-	err = ce.ResetSetData(testCounterID, testStoragePolicy, testAggregationTypesExpensive, testPipeline, 0, NoPrefixNoSuffix)
+	err = ce.ResetSetData(testCounterID, testStoragePolicy, testAggregationTypesExpensive, nil, testPipeline, 0, NoPrefixNoSuffix)
 	require.NoError(t, err)
 	requirePipelinesMatch(t, expectedParsedPipeline, ce.parsedPipeline)
 	require.Equal(t, len(testAggregationTypesExpensive), len(ce.lastConsumedValues))
@@ -158,14 +159,14 @@ func TestCounterResetSetData(t *testing.T) {
 
 func TestCounterResetSetDataInvalidAggregationType(t *testing.T) {
 	opts := NewOptions()
-	ce := MustNewCounterElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
-	err := ce.ResetSetData(testCounterID, testStoragePolicy, maggregation.Types{maggregation.Last}, applied.DefaultPipeline, 0, NoPrefixNoSuffix)
+	ce := MustNewCounterElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
+	err := ce.ResetSetData(testCounterID, testStoragePolicy, maggregation.Types{maggregation.Last}, nil, applied.DefaultPipeline, 0, NoPrefixNoSuffix)
 	require.Error(t, err)
 }
 
 func TestCounterResetSetDataNoRollup(t *testing.T) {
 	opts := NewOptions()
-	ce := MustNewCounterElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
+	ce := MustNewCounterElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
 
 	pipelineNoRollup := applied.NewPipeline([]applied.OpUnion{
 		{
@@ -173,12 +174,12 @@ func TestCounterResetSetDataNoRollup(t *testing.T) {
 			Transformation: pipeline.TransformationOp{Type: transformation.Absolute},
 		},
 	})
-	err := ce.ResetSetData(testCounterID, testStoragePolicy, maggregation.DefaultTypes, pipelineNoRollup, 0, NoPrefixNoSuffix)
+	err := ce.ResetSetData(testCounterID, testStoragePolicy, maggregation.DefaultTypes, nil, pipelineNoRollup, 0, NoPrefixNoSuffix)
 	require.NoError(t, err)
 }
 
 func TestCounterElemAddUnion(t *testing.T) {
-	e, err := NewCounterElem(testCounterID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewCounterElem(testCounterID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a counter metric.
@@ -214,7 +215,7 @@ func TestCounterElemAddUnion(t *testing.T) {
 }
 
 func TestCounterElemAddUnionWithCustomAggregation(t *testing.T) {
-	e, err := NewCounterElem(testCounterID, testStoragePolicy, testAggregationTypesExpensive, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewCounterElem(testCounterID, testStoragePolicy, testAggregationTypesExpensive, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a counter metric.
@@ -248,7 +249,7 @@ func TestCounterElemAddUnionWithCustomAggregation(t *testing.T) {
 }
 
 func TestCounterElemAddUnique(t *testing.T) {
-	e, err := NewCounterElem(testCounterID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewCounterElem(testCounterID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a metric.
@@ -301,7 +302,7 @@ func TestCounterElemAddUnique(t *testing.T) {
 }
 
 func TestCounterElemAddUniqueWithCustomAggregation(t *testing.T) {
-	e, err := NewCounterElem(testCounterID, testStoragePolicy, testAggregationTypesExpensive, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewCounterElem(testCounterID, testStoragePolicy, testAggregationTypesExpensive, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a counter metric.
@@ -603,7 +604,7 @@ func TestCounterElemClose(t *testing.T) {
 }
 
 func TestCounterFindOrCreateNoSourceSet(t *testing.T) {
-	e, err := NewCounterElem(testCounterID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewCounterElem(testCounterID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	inputs := []int64{10, 10, 20, 10, 15}
@@ -628,7 +629,7 @@ func TestCounterFindOrCreateNoSourceSet(t *testing.T) {
 }
 
 func TestCounterFindOrCreateWithSourceSet(t *testing.T) {
-	e, err := NewCounterElem(testCounterID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewCounterElem(testCounterID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 	e.cachedSourceSets = []*bitset.BitSet{bitset.New(0)}
 
@@ -653,7 +654,7 @@ func TestCounterFindOrCreateWithSourceSet(t *testing.T) {
 
 func TestTimerResetSetData(t *testing.T) {
 	opts := NewOptions()
-	te, err := NewTimerElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
+	te, err := NewTimerElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
 	require.NoError(t, err)
 	require.Nil(t, te.quantilesPool)
 	require.NotNil(t, te.quantiles)
@@ -662,7 +663,7 @@ func TestTimerResetSetData(t *testing.T) {
 	require.True(t, te.useDefaultAggregation)
 
 	// Reset element with a default pipeline.
-	err = te.ResetSetData(testBatchTimerID, testStoragePolicy, maggregation.Types{maggregation.Max, maggregation.P999}, applied.DefaultPipeline, 0, NoPrefixNoSuffix)
+	err = te.ResetSetData(testBatchTimerID, testStoragePolicy, maggregation.Types{maggregation.Max, maggregation.P999}, nil, applied.DefaultPipeline, 0, NoPrefixNoSuffix)
 	require.NoError(t, err)
 	require.Equal(t, testBatchTimerID, te.id)
 	require.Equal(t, testStoragePolicy, te.sp)
@@ -698,7 +699,7 @@ func TestTimerResetSetData(t *testing.T) {
 			},
 		}),
 	}
-	err = te.ResetSetData(testBatchTimerID, testStoragePolicy, testAggregationTypesExpensive, testPipeline, 0, NoPrefixNoSuffix)
+	err = te.ResetSetData(testBatchTimerID, testStoragePolicy, testAggregationTypesExpensive, nil, testPipeline, 0, NoPrefixNoSuffix)
 	require.NoError(t, err)
 	requirePipelinesMatch(t, expectedParsedPipeline, te.parsedPipeline)
 	require.Equal(t, len(testAggregationTypesExpensive), len(te.lastConsumedValues))
@@ -709,14 +710,14 @@ func TestTimerResetSetData(t *testing.T) {
 
 func TestTimerResetSetDataInvalidAggregationType(t *testing.T) {
 	opts := NewOptions()
-	te := MustNewTimerElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
-	err := te.ResetSetData(testBatchTimerID, testStoragePolicy, maggregation.Types{maggregation.Last}, applied.DefaultPipeline, 0, NoPrefixNoSuffix)
+	te := MustNewTimerElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
+	err := te.ResetSetData(testBatchTimerID, testStoragePolicy, maggregation.Types{maggregation.Last}, nil, applied.DefaultPipeline, 0, NoPrefixNoSuffix)
 	require.Error(t, err)
 }
 
 func TestTimerResetSetDataNoRollup(t *testing.T) {
 	opts := NewOptions()
-	te := MustNewTimerElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
+	te := MustNewTimerElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
 
 	pipelineNoRollup := applied.NewPipeline([]applied.OpUnion{
 		{
@@ -724,12 +725,12 @@ func TestTimerResetSetDataNoRollup(t *testing.T) {
 			Transformation: pipeline.TransformationOp{Type: transformation.Absolute},
 		},
 	})
-	err := te.ResetSetData(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, pipelineNoRollup, 0, NoPrefixNoSuffix)
+	err := te.ResetSetData(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, nil, pipelineNoRollup, 0, NoPrefixNoSuffix)
 	require.NoError(t, err)
 }
 
 func TestTimerElemAddUnion(t *testing.T) {
-	e, err := NewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a timer metric.
@@ -774,7 +775,7 @@ func TestTimerElemAddUnion(t *testing.T) {
 }
 
 func TestTimerElemAddUnique(t *testing.T) {
-	e, err := NewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a metric.
@@ -1074,6 +1075,163 @@ func TestTimerElemConsumeCustomAggregationCustomPipeline(t *testing.T) {
 	require.Equal(t, 0, len(e.values))
 }
 
+func TestTimerElemAddUniqueSketch(t *testing.T) {
+	quantiles := maggregation.MustNewQuantiles(0.75, 0.995)
+	e, err := NewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, quantiles, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	require.NoError(t, err)
+
+	sketchOpts := e.opts.SketchOptions()
+	encodedSketch := func(from, to int) []byte {
+		sketch := ddsketch.NewSketch(sketchOpts)
+		for i := from; i <= to; i++ {
+			sketch.Add(float64(i))
+		}
+		return sketch.Encode(nil)
+	}
+
+	// Add sketches from different sources.
+	require.NoError(t, e.AddUniqueSketch(testTimestamps[0], encodedSketch(1, 500), 1))
+	require.NoError(t, e.AddUniqueSketch(testTimestamps[1], encodedSketch(501, 1000), 2))
+	require.Equal(t, 1, len(e.values))
+	require.Equal(t, testAlignedStarts[0], e.values[0].startAtNanos)
+	timer := e.values[0].lockedAgg.aggregation
+	require.NotNil(t, timer.Sketch())
+	require.Equal(t, int64(1000), timer.Count())
+	require.Equal(t, 500500.0, timer.Sum())
+	require.Equal(t, 1.0, timer.Min())
+	require.Equal(t, 1000.0, timer.Max())
+	require.InEpsilon(t, 750.0, timer.ValueOfQuantile(0.75), sketchOpts.RelativeAccuracy())
+	require.InEpsilon(t, 995.0, timer.ValueOfQuantile(0.995), sketchOpts.RelativeAccuracy())
+
+	// Add the sketch in the same aggregation interval with the same
+	// source results in an error.
+	require.Equal(t, errDuplicateForwardingSource, e.AddUniqueSketch(testTimestamps[1], encodedSketch(1, 10), 1))
+	require.Equal(t, int64(1000), timer.Count())
+
+	// Adding an invalid sketch results in an error.
+	require.Error(t, e.AddUniqueSketch(testTimestamps[0], []byte{0x1, 0x2}, 3))
+	require.Equal(t, int64(1000), timer.Count())
+
+	// Adding the sketch to a closed element results in an error.
+	e.closed = true
+	require.Equal(t, errElemClosed, e.AddUniqueSketch(testTimestamps[2], encodedSketch(1, 10), 3))
+}
+
+func TestTimerElemConsumeCustomQuantiles(t *testing.T) {
+	var (
+		quantiles = maggregation.MustNewQuantiles(0.75, 0.995)
+		aggTypes  = maggregation.Types{maggregation.Max, maggregation.P99}
+		opts      = NewOptions()
+	)
+	e := MustNewTimerElem(testBatchTimerID, testStoragePolicy, aggTypes, quantiles, applied.DefaultPipeline, testNumForwardedTimes, WithPrefixWithSuffix, opts)
+	for i := 1; i <= 1000; i++ {
+		require.NoError(t, e.AddValue(testTimestamps[0], float64(i)))
+	}
+	require.NotNil(t, e.values[0].lockedAgg.aggregation.Sketch())
+
+	localFn, localRes := testFlushLocalMetricFn()
+	forwardFn, forwardRes := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, _ := testOnForwardedFlushedFn()
+	require.False(t, e.Consume(testAlignedStarts[1], isStandardMetricEarlierThan, standardMetricTimestampNanos, localFn, forwardFn, onForwardedFlushedFn))
+	require.Equal(t, 0, len(*forwardRes))
+	require.Equal(t, 4, len(*localRes))
+
+	var (
+		aggTypesOpts     = opts.AggregationTypesOptions()
+		relativeAccuracy = opts.SketchOptions().RelativeAccuracy()
+		expected         = []struct {
+			suffix []byte
+			value  float64
+		}{
+			{suffix: aggTypesOpts.TypeStringForTimer(maggregation.Max), value: 1000},
+			{suffix: aggTypesOpts.TypeStringForTimer(maggregation.P99), value: 990},
+			{suffix: aggTypesOpts.TypeStringForTimerQuantile(0.75), value: 750},
+			{suffix: aggTypesOpts.TypeStringForTimerQuantile(0.995), value: 995},
+		}
+	)
+	for i, res := range *localRes {
+		require.Equal(t, opts.FullTimerPrefix(), res.idPrefix)
+		require.Equal(t, testBatchTimerID, res.id)
+		require.Equal(t, expected[i].suffix, res.idSuffix)
+		require.InEpsilon(t, expected[i].value, res.value, relativeAccuracy)
+	}
+	require.Equal(t, []byte(".p75"), (*localRes)[2].idSuffix)
+	require.Equal(t, []byte(".p995"), (*localRes)[3].idSuffix)
+}
+
+func TestTimerElemConsumeForwardsSketch(t *testing.T) {
+	quantiles := maggregation.MustNewQuantiles(0.75, 0.995)
+	rollupPipeline := applied.NewPipeline([]applied.OpUnion{
+		{
+			Type: pipeline.RollupOpType,
+			Rollup: applied.RollupOp{
+				ID:            []byte("foo.bar"),
+				AggregationID: maggregation.MustCompressTypes(maggregation.Count),
+				Quantiles:     quantiles,
+			},
+		},
+	})
+	transformedPipeline := applied.NewPipeline([]applied.OpUnion{
+		{
+			Type:           pipeline.TransformationOpType,
+			Transformation: pipeline.TransformationOp{Type: transformation.Absolute},
+		},
+		{
+			Type: pipeline.RollupOpType,
+			Rollup: applied.RollupOp{
+				ID:            []byte("foo.bar"),
+				AggregationID: maggregation.MustCompressTypes(maggregation.Count),
+				Quantiles:     quantiles,
+			},
+		},
+	})
+
+	inputs := []struct {
+		pipeline         applied.Pipeline
+		expectSketchUsed bool
+	}{
+		{pipeline: rollupPipeline, expectSketchUsed: true},
+		{pipeline: transformedPipeline, expectSketchUsed: false},
+	}
+	for _, input := range inputs {
+		e := MustNewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.Types{maggregation.Max}, nil, input.pipeline, testNumForwardedTimes, WithPrefixWithSuffix, NewOptions())
+		for i := 1; i <= 100; i++ {
+			require.NoError(t, e.AddValue(testTimestamps[0], float64(i)))
+		}
+
+		var sketchCount uint64
+		localFn, localRes := testFlushLocalMetricFn()
+		forwardFn, forwardRes := testFlushForwardedMetricFn()
+		onForwardedFlushedFn, onForwardedFlushedRes := testOnForwardedFlushedFn()
+		wrappedForwardFn := func(
+			writeFn writeForwardedMetricFn,
+			aggregationKey aggregationKey,
+			timeNanos int64,
+			value float64,
+			sketch ddsketch.Sketch,
+		) {
+			if sketch != nil {
+				sketchCount = sketch.Count()
+			}
+			forwardFn(writeFn, aggregationKey, timeNanos, value, sketch)
+		}
+		require.False(t, e.Consume(testAlignedStarts[1], isStandardMetricEarlierThan, standardMetricTimestampNanos, localFn, wrappedForwardFn, onForwardedFlushedFn))
+		require.Equal(t, 0, len(*localRes))
+		require.Equal(t, 1, len(*forwardRes))
+		require.Equal(t, 1, len(*onForwardedFlushedRes))
+
+		res := (*forwardRes)[0]
+		require.Equal(t, quantiles, res.aggregationKey.quantiles)
+		require.Equal(t, 100.0, res.value)
+		if input.expectSketchUsed {
+			require.NotNil(t, res.sketch)
+			require.Equal(t, uint64(100), sketchCount)
+		} else {
+			require.Nil(t, res.sketch)
+		}
+	}
+}
+
 func TestTimerElemClose(t *testing.T) {
 	// Set up stream options.
 	streamOpts, p, numAlloc := testStreamOptions(t, len(testAlignedStarts)-1)
@@ -1107,7 +1265,7 @@ func TestTimerElemClose(t *testing.T) {
 }
 
 func TestTimerFindOrCreateNoSourceSet(t *testing.T) {
-	e, err := NewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	inputs := []int64{10, 10, 20, 10, 15}
@@ -1131,7 +1289,7 @@ func TestTimerFindOrCreateNoSourceSet(t *testing.T) {
 }
 
 func TestTimerFindOrCreateWithSourceSet(t *testing.T) {
-	e, err := NewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewTimerElem(testBatchTimerID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 	e.cachedSourceSets = []*bitset.BitSet{bitset.New(0)}
 
@@ -1156,14 +1314,14 @@ func TestTimerFindOrCreateWithSourceSet(t *testing.T) {
 
 func TestGaugeResetSetData(t *testing.T) {
 	opts := NewOptions()
-	ge, err := NewGaugeElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
+	ge, err := NewGaugeElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
 	require.NoError(t, err)
 	require.Equal(t, opts.AggregationTypesOptions().DefaultGaugeAggregationTypes(), ge.aggTypes)
 	require.True(t, ge.useDefaultAggregation)
 	require.False(t, ge.aggOpts.HasExpensiveAggregations)
 
 	// Reset element with a default pipeline.
-	err = ge.ResetSetData(testGaugeID, testStoragePolicy, testAggregationTypesExpensive, applied.DefaultPipeline, 0, NoPrefixNoSuffix)
+	err = ge.ResetSetData(testGaugeID, testStoragePolicy, testAggregationTypesExpensive, nil, applied.DefaultPipeline, 0, NoPrefixNoSuffix)
 	require.NoError(t, err)
 	require.Equal(t, testGaugeID, ge.id)
 	require.Equal(t, testStoragePolicy, ge.sp)
@@ -1197,7 +1355,7 @@ func TestGaugeResetSetData(t *testing.T) {
 			},
 		}),
 	}
-	err = ge.ResetSetData(testGaugeID, testStoragePolicy, testAggregationTypesExpensive, testPipeline, 0, NoPrefixNoSuffix)
+	err = ge.ResetSetData(testGaugeID, testStoragePolicy, testAggregationTypesExpensive, nil, testPipeline, 0, NoPrefixNoSuffix)
 	require.NoError(t, err)
 	requirePipelinesMatch(t, expectedParsedPipeline, ge.parsedPipeline)
 	require.Equal(t, len(testAggregationTypesExpensive), len(ge.lastConsumedValues))
@@ -1207,7 +1365,7 @@ func TestGaugeResetSetData(t *testing.T) {
 }
 
 func TestGaugeElemAddUnion(t *testing.T) {
-	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a gauge metric.
@@ -1243,7 +1401,7 @@ func TestGaugeElemAddUnion(t *testing.T) {
 }
 
 func TestGaugeElemAddUnionWithCustomAggregation(t *testing.T) {
-	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, testAggregationTypesExpensive, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, testAggregationTypesExpensive, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a gauge metric.
@@ -1281,7 +1439,7 @@ func TestGaugeElemAddUnionWithCustomAggregation(t *testing.T) {
 }
 
 func TestGaugeElemAddUnique(t *testing.T) {
-	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a metric.
@@ -1334,7 +1492,7 @@ func TestGaugeElemAddUnique(t *testing.T) {
 }
 
 func TestGaugeElemAddUniqueWithCustomAggregation(t *testing.T) {
-	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, testAggregationTypesExpensive, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, testAggregationTypesExpensive, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	// Add a gauge metric.
@@ -1636,7 +1794,7 @@ func TestGaugeElemClose(t *testing.T) {
 }
 
 func TestGaugeFindOrCreateNoSourceSet(t *testing.T) {
-	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 
 	inputs := []int64{10, 10, 20, 10, 15}
@@ -1660,7 +1818,7 @@ func TestGaugeFindOrCreateNoSourceSet(t *testing.T) {
 }
 
 func TestGaugeFindOrCreateWithSourceSet(t *testing.T) {
-	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
+	e, err := NewGaugeElem(testGaugeID, testStoragePolicy, maggregation.DefaultTypes, nil, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, NewOptions())
 	require.NoError(t, err)
 	e.cachedSourceSets = []*bitset.BitSet{bitset.New(0)}
 
@@ -1706,6 +1864,7 @@ type testForwardedMetricWithMetadata struct {
 	aggregationKey aggregationKey
 	timeNanos      int64
 	value          float64
+	sketch         ddsketch.Sketch
 }
 
 type testOnForwardedFlushedData struct {
@@ -1746,11 +1905,13 @@ func testFlushForwardedMetricFn() (
 		aggregationKey aggregationKey,
 		timeNanos int64,
 		value float64,
+		sketch ddsketch.Sketch,
 	) {
 		result = append(result, testForwardedMetricWithMetadata{
 			aggregationKey: aggregationKey,
 			timeNanos:      timeNanos,
 			value:          value,
+			sketch:         sketch,
 		})
 	}, &result
 }
@@ -1789,7 +1950,7 @@ func testCounterElem(
 	pipeline applied.Pipeline,
 	opts Options,
 ) *CounterElem {
-	e := MustNewCounterElem(testCounterID, testStoragePolicy, aggTypes, pipeline, testNumForwardedTimes, WithPrefixWithSuffix, opts)
+	e := MustNewCounterElem(testCounterID, testStoragePolicy, aggTypes, nil, pipeline, testNumForwardedTimes, WithPrefixWithSuffix, opts)
 	for i, aligned := range alignedstartAtNanos {
 		counter := &lockedCounterAggregation{aggregation: newCounterAggregation(raggregation.NewCounter(e.aggOpts))}
 		counter.aggregation.Update(time.Unix(0, aligned), counterVals[i])
@@ -1808,7 +1969,7 @@ func testTimerElem(
 	pipeline applied.Pipeline,
 	opts Options,
 ) *TimerElem {
-	e := MustNewTimerElem(testBatchTimerID, testStoragePolicy, aggTypes, pipeline, testNumForwardedTimes, WithPrefixWithSuffix, opts)
+	e := MustNewTimerElem(testBatchTimerID, testStoragePolicy, aggTypes, nil, pipeline, testNumForwardedTimes, WithPrefixWithSuffix, opts)
 	for i, aligned := range alignedstartAtNanos {
 		newTimer := raggregation.NewTimer(opts.AggregationTypesOptions().Quantiles(), opts.StreamOptions(), e.aggOpts)
 		timer := &lockedTimerAggregation{aggregation: newTimerAggregation(newTimer)}
@@ -1828,7 +1989,7 @@ func testGaugeElem(
 	pipeline applied.Pipeline,
 	opts Options,
 ) *GaugeElem {
-	e := MustNewGaugeElem(testGaugeID, testStoragePolicy, aggTypes, pipeline, testNumForwardedTimes, WithPrefixWithSuffix, opts)
+	e := MustNewGaugeElem(testGaugeID, testStoragePolicy, aggTypes, nil, pipeline, testNumForwardedTimes, WithPrefixWithSuffix, opts)
 	for i, aligned := range alignedstartAtNanos {
 		gauge := &lockedGaugeAggregation{aggregation: newGaugeAggregation(raggregation.NewGauge(e.aggOpts))}
 		gauge.aggregation.Update(time.Unix(0, aligned), gaugeVals[i])
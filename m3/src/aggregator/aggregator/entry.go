@@ -495,6 +495,7 @@ func (e *Entry) shouldUpdateStagedMetadatasWithLock(sm metadata.StagedMetadata)
 		for _, storagePolicy := range storagePolicies {
 			key := aggregationKey{
 				aggregationID:      pipeline.AggregationID,
+				quantiles:          pipeline.Quantiles,
 				storagePolicy:      storagePolicy,
 				pipeline:           pipeline.Pipeline,
 				idPrefixSuffixType: WithPrefixWithSuffix,
@@ -560,9 +561,10 @@ func (e *Entry) addNewAggregationKeyWithLock(
 	default:
 		return nil, errInvalidMetricType
 	}
-	// NB: The pipeline may not be owned by us and as such we need to make a copy here.
+	// NB: The pipeline and quantiles may not be owned by us and as such we need to make a copy here.
 	key.pipeline = key.pipeline.Clone()
-	if err = newElem.ResetSetData(metricID, key.storagePolicy, aggTypes, key.pipeline, key.numForwardedTimes, key.idPrefixSuffixType); err != nil {
+	key.quantiles = key.quantiles.Clone()
+	if err = newElem.ResetSetData(metricID, key.storagePolicy, aggTypes, key.quantiles, key.pipeline, key.numForwardedTimes, key.idPrefixSuffixType); err != nil {
 		return nil, err
 	}
 	list, err := e.lists.FindOrCreate(listID)
@@ -602,6 +604,7 @@ func (e *Entry) updateStagedMetadatasWithLock(
 		for _, storagePolicy := range storagePolicies {
 			key := aggregationKey{
 				aggregationID:      pipeline.AggregationID,
+				quantiles:          pipeline.Quantiles,
 				storagePolicy:      storagePolicy,
 				pipeline:           pipeline.Pipeline,
 				idPrefixSuffixType: WithPrefixWithSuffix,
@@ -913,6 +916,7 @@ func (e *Entry) addForwarded(
 	// Check if we should update metadata, and add metric if not.
 	key := aggregationKey{
 		aggregationID:      metadata.AggregationID,
+		quantiles:          metadata.Quantiles,
 		storagePolicy:      metadata.StoragePolicy,
 		pipeline:           metadata.Pipeline,
 		numForwardedTimes:  metadata.NumForwardedTimes,
@@ -997,6 +1001,7 @@ func (e *Entry) updateForwardMetadataWithLock(
 	// Update the forward metadata.
 	key := aggregationKey{
 		aggregationID:      metadata.AggregationID,
+		quantiles:          metadata.Quantiles,
 		storagePolicy:      metadata.StoragePolicy,
 		pipeline:           metadata.Pipeline,
 		numForwardedTimes:  metadata.NumForwardedTimes,
@@ -1021,8 +1026,18 @@ func (e *Entry) addForwardedWithLock(
 	metric aggregated.ForwardedMetric,
 	sourceID uint32,
 ) error {
-	timestamp := time.Unix(0, metric.TimeNanos)
-	err := value.elem.Value.(metricElem).AddUnique(timestamp, metric.Values, sourceID)
+	var (
+		timestamp = time.Unix(0, metric.TimeNanos)
+		elem      = value.elem.Value.(metricElem)
+		err       error
+	)
+	// NB: The sketch, if present, describes all the values aggregated by the
+	// source and as such supersedes the forwarded values.
+	if len(metric.Sketch) > 0 {
+		err = elem.AddUniqueSketch(timestamp, metric.Sketch, sourceID)
+	} else {
+		err = elem.AddUnique(timestamp, metric.Values, sourceID)
+	}
 	if err == errDuplicateForwardingSource {
 		// Duplicate forwarding sources may occur during a leader re-election and is not
 		// considered an external facing error. Hence, we record it and move on.
@@ -23,6 +23,7 @@ package aggregator
 import (
 	"time"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/metrics/metric/id"
 	"github.com/m3db/m3/src/metrics/policy"
 )
@@ -92,12 +93,14 @@ type flushLocalMetricFn func(
 // A flushForwardedMetricFn flushes an aggregated metric datapoint eligible for
 // forwarding by either forwarding it (potentially to a different aggregation
 // server) or dropping it. Processing of the datapoint continues after it is
-// flushed as required by the pipeline.
+// flushed as required by the pipeline. The sketch of the aggregation producing
+// the datapoint, if any, is forwarded alongside the datapoint.
 type flushForwardedMetricFn func(
 	writeFn writeForwardedMetricFn,
 	aggregationKey aggregationKey,
 	timeNanos int64,
 	value float64,
+	sketch ddsketch.Sketch,
 )
 
 // An onForwardingElemFlushedFn is a callback function that should be called
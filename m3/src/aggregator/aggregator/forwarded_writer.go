@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/client"
 	"github.com/m3db/m3/src/aggregator/hash"
 	"github.com/m3db/m3/src/metrics/metadata"
@@ -49,6 +50,7 @@ type writeForwardedMetricFn func(
 	key aggregationKey,
 	timeNanos int64,
 	value float64,
+	sketch ddsketch.Sketch,
 )
 
 type onForwardedAggregationDoneFn func(key aggregationKey) error
@@ -252,6 +254,12 @@ func newIDKey(
 type forwardedAggregationBucket struct {
 	timeNanos int64
 	values    []float64
+
+	// sketch is the merged sketch of the aggregations producing the values,
+	// and lastSketch is the last sketch merged to avoid merging the same
+	// sketch more than once when it comes with multiple values.
+	sketch     ddsketch.Sketch
+	lastSketch ddsketch.Sketch
 }
 
 type forwardedAggregationBuckets []forwardedAggregationBucket
@@ -272,6 +280,7 @@ type forwardedAggregationWithKey struct {
 	currRefCnt        int
 	cachedValueArrays [][]float64
 	buckets           forwardedAggregationBuckets
+	encodedSketch     []byte
 }
 
 func (agg *forwardedAggregationWithKey) reset() {
@@ -280,15 +289,24 @@ func (agg *forwardedAggregationWithKey) reset() {
 		agg.buckets[i].values = agg.buckets[i].values[:0]
 		agg.cachedValueArrays = append(agg.cachedValueArrays, agg.buckets[i].values)
 		agg.buckets[i].values = nil
+		if agg.buckets[i].sketch != nil {
+			agg.buckets[i].sketch.Close()
+		}
+		agg.buckets[i].sketch = nil
+		agg.buckets[i].lastSketch = nil
 	}
 	agg.buckets = agg.buckets[:0]
 }
 
-func (agg *forwardedAggregationWithKey) add(timeNanos int64, value float64) {
+func (agg *forwardedAggregationWithKey) add(
+	timeNanos int64,
+	value float64,
+	sketch ddsketch.Sketch,
+) error {
 	for i := 0; i < len(agg.buckets); i++ {
 		if agg.buckets[i].timeNanos == timeNanos {
 			agg.buckets[i].values = append(agg.buckets[i].values, value)
-			return
+			return agg.buckets[i].addSketch(sketch)
 		}
 	}
 	var values []float64
@@ -304,13 +322,30 @@ func (agg *forwardedAggregationWithKey) add(timeNanos int64, value float64) {
 		timeNanos: timeNanos,
 		values:    values,
 	}
+	if err := bucket.addSketch(sketch); err != nil {
+		return err
+	}
 	agg.buckets = append(agg.buckets, bucket)
+	return nil
+}
+
+func (b *forwardedAggregationBucket) addSketch(sketch ddsketch.Sketch) error {
+	if sketch == nil || sketch == b.lastSketch {
+		return nil
+	}
+	b.lastSketch = sketch
+	if b.sketch == nil {
+		b.sketch = sketch.Clone()
+		return nil
+	}
+	return b.sketch.Merge(sketch)
 }
 
 type forwardedAggregationMetrics struct {
 	added                  tally.Counter
 	removed                tally.Counter
 	write                  tally.Counter
+	writeSketchErrors      tally.Counter
 	onDoneNoWrite          tally.Counter
 	onDoneWriteSuccess     tally.Counter
 	onDoneWriteErrors      tally.Counter
@@ -322,6 +357,7 @@ func newForwardedAggregationMetrics(scope tally.Scope) *forwardedAggregationMetr
 		added:                  scope.Counter("added"),
 		removed:                scope.Counter("removed"),
 		write:                  scope.Counter("write"),
+		writeSketchErrors:      scope.Counter("write-sketch-errors"),
 		onDoneNoWrite:          scope.Counter("on-done-not-write"),
 		onDoneWriteSuccess:     scope.Counter("on-done-write-success"),
 		onDoneWriteErrors:      scope.Counter("on-done-write-errors"),
@@ -417,9 +453,12 @@ func (agg *forwardedAggregation) write(
 	key aggregationKey,
 	timeNanos int64,
 	value float64,
+	sketch ddsketch.Sketch,
 ) {
 	idx := agg.index(key)
-	agg.byKey[idx].add(timeNanos, value)
+	if err := agg.byKey[idx].add(timeNanos, value, sketch); err != nil {
+		agg.metrics.writeSketchErrors.Inc(1)
+	}
 	agg.metrics.write.Inc(1)
 }
 
@@ -439,6 +478,7 @@ func (agg *forwardedAggregation) onDone(key aggregationKey) error {
 				Pipeline:          key.pipeline,
 				SourceID:          agg.shard,
 				NumForwardedTimes: key.numForwardedTimes,
+				Quantiles:         key.quantiles,
 			}
 		)
 		for _, b := range agg.byKey[idx].buckets {
@@ -451,6 +491,10 @@ func (agg *forwardedAggregation) onDone(key aggregationKey) error {
 				TimeNanos: b.timeNanos,
 				Values:    b.values,
 			}
+			if b.sketch != nil {
+				agg.byKey[idx].encodedSketch = b.sketch.Encode(agg.byKey[idx].encodedSketch[:0])
+				metric.Sketch = agg.byKey[idx].encodedSketch
+			}
 			if err := agg.client.WriteForwarded(metric, meta); err != nil {
 				multiErr = multiErr.Add(err)
 				agg.metrics.onDoneWriteErrors.Inc(1)
@@ -23,6 +23,7 @@ package aggregator
 import (
 	"testing"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/client"
 	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metadata"
@@ -97,17 +98,17 @@ func TestForwardedWriterRegisterNewAggregation(t *testing.T) {
 	require.Equal(t, 0, len(agg.byKey[0].buckets))
 
 	// Validate that writeFn can be used to write data to the aggregation.
-	writeFn(aggKey, 1234, 5.67)
+	writeFn(aggKey, 1234, 5.67, nil)
 	require.Equal(t, 1, len(agg.byKey[0].buckets))
 	require.Equal(t, int64(1234), agg.byKey[0].buckets[0].timeNanos)
 	require.Equal(t, []float64{5.67}, agg.byKey[0].buckets[0].values)
 
-	writeFn(aggKey, 1234, 1.78)
+	writeFn(aggKey, 1234, 1.78, nil)
 	require.Equal(t, 1, len(agg.byKey[0].buckets))
 	require.Equal(t, int64(1234), agg.byKey[0].buckets[0].timeNanos)
 	require.Equal(t, []float64{5.67, 1.78}, agg.byKey[0].buckets[0].values)
 
-	writeFn(aggKey, 1240, -2.95)
+	writeFn(aggKey, 1240, -2.95, nil)
 	require.Equal(t, 2, len(agg.byKey[0].buckets))
 	require.Equal(t, int64(1240), agg.byKey[0].buckets[1].timeNanos)
 	require.Equal(t, []float64{-2.95}, agg.byKey[0].buckets[1].values)
@@ -137,6 +138,65 @@ func TestForwardedWriterRegisterNewAggregation(t *testing.T) {
 	require.Equal(t, 1, agg.byKey[0].currRefCnt)
 }
 
+func TestForwardedWriterWriteSketch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		c          = client.NewMockAdminClient(ctrl)
+		w          = newForwardedWriter(0, c, tally.NoopScope)
+		mt         = metric.TimerType
+		mid        = id.RawID("foo")
+		quantiles  = aggregation.MustNewQuantiles(0.5, 0.99)
+		sketchOpts = ddsketch.NewOptions()
+		aggKey     = testForwardedWriterAggregationKey
+	)
+	aggKey.quantiles = quantiles
+
+	writeFn, onDoneFn, err := w.Register(mt, mid, aggKey)
+	require.NoError(t, err)
+
+	sketch1 := ddsketch.NewSketch(sketchOpts)
+	sketch1.Add(1.0)
+	sketch1.Add(2.0)
+	sketch2 := ddsketch.NewSketch(sketchOpts)
+	sketch2.Add(3.0)
+
+	// The same sketch is only merged into the bucket once.
+	writeFn(aggKey, 1234, 2.0, sketch1)
+	writeFn(aggKey, 1234, 2.0, sketch1)
+	writeFn(aggKey, 1234, 1.0, sketch2)
+
+	fw := w.(*forwardedWriter)
+	agg := fw.aggregations[newIDKey(mt, mid)]
+	require.Equal(t, 1, len(agg.byKey[0].buckets))
+	bucketSketch := agg.byKey[0].buckets[0].sketch
+	require.NotNil(t, bucketSketch)
+	require.False(t, bucketSketch == sketch1)
+	require.Equal(t, uint64(3), bucketSketch.Count())
+	require.Equal(t, uint64(2), sketch1.Count())
+
+	expectedSketch := bucketSketch.Encode(nil)
+	expectedMeta := metadata.ForwardMetadata{
+		AggregationID:     aggregation.MustCompressTypes(aggregation.Count),
+		StoragePolicy:     policy.MustParseStoragePolicy("10s:2d"),
+		SourceID:          0,
+		NumForwardedTimes: 1,
+		Quantiles:         quantiles,
+	}
+	c.EXPECT().
+		WriteForwarded(gomock.Any(), expectedMeta).
+		DoAndReturn(func(metric aggregated.ForwardedMetric, _ metadata.ForwardMetadata) error {
+			require.Equal(t, mt, metric.Type)
+			require.Equal(t, mid, metric.ID)
+			require.Equal(t, int64(1234), metric.TimeNanos)
+			require.Equal(t, []float64{2.0, 2.0, 1.0}, metric.Values)
+			require.Equal(t, expectedSketch, metric.Sketch)
+			return nil
+		})
+	require.NoError(t, onDoneFn(aggKey))
+}
+
 func TestForwardedWriterRegisterExistingAggregation(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -276,17 +336,17 @@ func TestForwardedWriterPrepare(t *testing.T) {
 	require.NoError(t, err)
 
 	// Write some datapoints.
-	writeFn(aggKey, 1234, 3.4)
-	writeFn(aggKey, 1234, 3.5)
-	writeFn(aggKey, 1240, 98.2)
+	writeFn(aggKey, 1234, 3.4, nil)
+	writeFn(aggKey, 1234, 3.5, nil)
+	writeFn(aggKey, 1240, 98.2, nil)
 
 	// Register another aggregation.
 	writeFn2, onDoneFn2, err := w.Register(mt, mid2, aggKey)
 	require.NoError(t, err)
 
 	// Write some more datapoints.
-	writeFn2(aggKey, 1238, 3.4)
-	writeFn2(aggKey, 1239, 3.5)
+	writeFn2(aggKey, 1238, 3.4, nil)
+	writeFn2(aggKey, 1239, 3.5, nil)
 
 	expectedMetric1 := aggregated.ForwardedMetric{
 		Type:      mt,
@@ -358,11 +418,11 @@ func TestForwardedWriterPrepare(t *testing.T) {
 	require.Equal(t, 2, len(agg.byKey[0].cachedValueArrays))
 
 	// Write datapoints again.
-	writeFn(aggKey, 1234, 3.4)
-	writeFn(aggKey, 1234, 3.5)
-	writeFn(aggKey, 1240, 98.2)
-	writeFn2(aggKey, 1238, 3.4)
-	writeFn2(aggKey, 1239, 3.5)
+	writeFn(aggKey, 1234, 3.4, nil)
+	writeFn(aggKey, 1234, 3.5, nil)
+	writeFn(aggKey, 1240, 98.2, nil)
+	writeFn2(aggKey, 1238, 3.4, nil)
+	writeFn2(aggKey, 1239, 3.5, nil)
 	require.NoError(t, onDoneFn(aggKey))
 	require.NoError(t, onDoneFn2(aggKey))
 
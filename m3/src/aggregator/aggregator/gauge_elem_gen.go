@@ -30,6 +30,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	maggregation "github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metric/id"
 	"github.com/m3db/m3/src/metrics/metric/unaggregated"
@@ -74,6 +75,7 @@ func NewGaugeElem(
 	id id.RawID,
 	sp policy.StoragePolicy,
 	aggTypes maggregation.Types,
+	quantiles maggregation.Quantiles,
 	pipeline applied.Pipeline,
 	numForwardedTimes int,
 	idPrefixSuffixType IDPrefixSuffixType,
@@ -83,7 +85,7 @@ func NewGaugeElem(
 		elemBase: newElemBase(opts),
 		values:   make([]timedGauge, 0, defaultNumAggregations), // in most cases values will have two entries
 	}
-	if err := e.ResetSetData(id, sp, aggTypes, pipeline, numForwardedTimes, idPrefixSuffixType); err != nil {
+	if err := e.ResetSetData(id, sp, aggTypes, quantiles, pipeline, numForwardedTimes, idPrefixSuffixType); err != nil {
 		return nil, err
 	}
 	return e, nil
@@ -94,12 +96,13 @@ func MustNewGaugeElem(
 	id id.RawID,
 	sp policy.StoragePolicy,
 	aggTypes maggregation.Types,
+	quantiles maggregation.Quantiles,
 	pipeline applied.Pipeline,
 	numForwardedTimes int,
 	idPrefixSuffixType IDPrefixSuffixType,
 	opts Options,
 ) *GaugeElem {
-	elem, err := NewGaugeElem(id, sp, aggTypes, pipeline, numForwardedTimes, idPrefixSuffixType, opts)
+	elem, err := NewGaugeElem(id, sp, aggTypes, quantiles, pipeline, numForwardedTimes, idPrefixSuffixType, opts)
 	if err != nil {
 		panic(fmt.Errorf("unable to create element: %v", err))
 	}
@@ -111,6 +114,7 @@ func (e *GaugeElem) ResetSetData(
 	id id.RawID,
 	sp policy.StoragePolicy,
 	aggTypes maggregation.Types,
+	quantiles maggregation.Quantiles,
 	pipeline applied.Pipeline,
 	numForwardedTimes int,
 	idPrefixSuffixType IDPrefixSuffixType,
@@ -122,7 +126,7 @@ func (e *GaugeElem) ResetSetData(
 	if err := e.elemBase.resetSetData(id, sp, aggTypes, useDefaultAggregation, pipeline, numForwardedTimes, idPrefixSuffixType); err != nil {
 		return err
 	}
-	if err := e.gaugeElemBase.ResetSetData(e.aggTypesOpts, aggTypes, useDefaultAggregation); err != nil {
+	if err := e.gaugeElemBase.ResetSetData(e.aggTypesOpts, aggTypes, quantiles, useDefaultAggregation, e.forwardSketch); err != nil {
 		return err
 	}
 	// If the pipeline contains derivative transformations, we need to store past
@@ -130,11 +134,11 @@ func (e *GaugeElem) ResetSetData(
 	if !e.parsedPipeline.HasDerivativeTransform {
 		return nil
 	}
-	numAggTypes := len(e.aggTypes)
-	if cap(e.lastConsumedValues) < numAggTypes {
-		e.lastConsumedValues = make([]transformation.Datapoint, numAggTypes)
+	numValues := len(e.aggTypes) + len(e.Quantiles())
+	if cap(e.lastConsumedValues) < numValues {
+		e.lastConsumedValues = make([]transformation.Datapoint, numValues)
 	}
-	e.lastConsumedValues = e.lastConsumedValues[:numAggTypes]
+	e.lastConsumedValues = e.lastConsumedValues[:numValues]
 	for i := 0; i < len(e.lastConsumedValues); i++ {
 		e.lastConsumedValues[i] = transformation.Datapoint{Value: nan}
 	}
@@ -202,6 +206,37 @@ func (e *GaugeElem) AddUnique(timestamp time.Time, values []float64, sourceID ui
 	return nil
 }
 
+// AddUniqueSketch merges an encoded sketch from a given source at a given
+// timestamp. If previous values from the same source have already been added
+// to the same aggregation, the incoming sketch is discarded.
+func (e *GaugeElem) AddUniqueSketch(timestamp time.Time, sketch []byte, sourceID uint32) error {
+	decoded := e.opts.SketchOptions().SketchPool().Get()
+	defer decoded.Close()
+	if err := decoded.Decode(sketch); err != nil {
+		return err
+	}
+
+	alignedStart := timestamp.Truncate(e.sp.Resolution().Window).UnixNano()
+	lockedAgg, err := e.findOrCreate(alignedStart, createAggregationOptions{initSourceSet: true})
+	if err != nil {
+		return err
+	}
+	lockedAgg.Lock()
+	if lockedAgg.closed {
+		lockedAgg.Unlock()
+		return errAggregationClosed
+	}
+	source := uint(sourceID)
+	if lockedAgg.sourcesSeen.Test(source) {
+		lockedAgg.Unlock()
+		return errDuplicateForwardingSource
+	}
+	lockedAgg.sourcesSeen.Set(source)
+	err = lockedAgg.aggregation.Merge(timestamp, decoded)
+	lockedAgg.Unlock()
+	return err
+}
+
 // Consume consumes values before a given time and removes them from the element
 // after they are consumed, returning whether the element can be collected after
 // the consumption is completed.
@@ -409,9 +444,22 @@ func (e *GaugeElem) processValueWithAggregationLock(
 	var (
 		transformations  = e.parsedPipeline.Transformations
 		discardNaNValues = e.opts.DiscardNaNAggregatedValues()
+		quantiles        = e.Quantiles()
+		numAggTypes      = len(e.aggTypes)
+		sketch           ddsketch.Sketch
 	)
-	for aggTypeIdx, aggType := range e.aggTypes {
-		value := lockedAgg.aggregation.ValueOf(aggType)
+	if e.forwardSketch {
+		sketch = lockedAgg.aggregation.Sketch()
+	}
+	// NB: The values of the aggregation types are followed by the values of
+	// the arbitrary quantiles if any.
+	for valueIdx := 0; valueIdx < numAggTypes+len(quantiles); valueIdx++ {
+		var value float64
+		if valueIdx < numAggTypes {
+			value = lockedAgg.aggregation.ValueOf(e.aggTypes[valueIdx])
+		} else {
+			value = lockedAgg.aggregation.ValueOfQuantile(quantiles[valueIdx-numAggTypes])
+		}
 		for _, transformOp := range transformations {
 			unaryOp, isUnaryOp := transformOp.UnaryTransform()
 			binaryOp, isBinaryOp := transformOp.BinaryTransform()
@@ -430,7 +478,7 @@ func (e *GaugeElem) processValueWithAggregationLock(
 				lastTimeNanos := e.lastConsumedAtNanos
 				prev := transformation.Datapoint{
 					TimeNanos: lastTimeNanos,
-					Value:     e.lastConsumedValues[aggTypeIdx].Value,
+					Value:     e.lastConsumedValues[valueIdx].Value,
 				}
 
 				currTimeNanos := timeNanos
@@ -446,7 +494,7 @@ func (e *GaugeElem) processValueWithAggregationLock(
 				// need to keep one value. In the future if we need to support higher-order
 				// derivative transformations, we need to store an array of values here.
 				if !math.IsNaN(curr.Value) {
-					e.lastConsumedValues[aggTypeIdx] = curr
+					e.lastConsumedValues[valueIdx] = curr
 				}
 
 				value = res.Value
@@ -463,11 +511,17 @@ func (e *GaugeElem) processValueWithAggregationLock(
 			case NoPrefixNoSuffix:
 				flushLocalFn(nil, e.id, nil, timeNanos, value, e.sp)
 			case WithPrefixWithSuffix:
-				flushLocalFn(e.FullPrefix(e.opts), e.id, e.TypeStringFor(e.aggTypesOpts, aggType), timeNanos, value, e.sp)
+				var typeString []byte
+				if valueIdx < numAggTypes {
+					typeString = e.TypeStringFor(e.aggTypesOpts, e.aggTypes[valueIdx])
+				} else {
+					typeString = e.TypeStringForQuantile(e.aggTypesOpts, quantiles[valueIdx-numAggTypes])
+				}
+				flushLocalFn(e.FullPrefix(e.opts), e.id, typeString, timeNanos, value, e.sp)
 			}
 		} else {
 			forwardedAggregationKey, _ := e.ForwardedAggregationKey()
-			flushForwardedFn(e.writeForwardedMetricFn, forwardedAggregationKey, timeNanos, value)
+			flushForwardedFn(e.writeForwardedMetricFn, forwardedAggregationKey, timeNanos, value, sketch)
 		}
 	}
 	e.lastConsumedAtNanos = timeNanos
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	raggregation "github.com/m3db/m3/src/aggregator/aggregation"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	maggregation "github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metric"
 	"github.com/m3db/m3/src/metrics/metric/id"
@@ -48,9 +49,18 @@ type typeSpecificAggregation interface {
 	// AddUnion adds a new metric value union.
 	AddUnion(t time.Time, mu unaggregated.MetricUnion)
 
+	// Merge merges the values of a sketch.
+	Merge(t time.Time, sketch ddsketch.Sketch) error
+
+	// Sketch returns the sketch backing the aggregation if any.
+	Sketch() ddsketch.Sketch
+
 	// ValueOf returns the value for the given aggregation type.
 	ValueOf(aggType maggregation.Type) float64
 
+	// ValueOfQuantile returns the value for the given quantile.
+	ValueOfQuantile(q float64) float64
+
 	// LastAt returns the time for last received value.
 	LastAt() time.Time
 
@@ -80,6 +90,13 @@ type typeSpecificElemBase interface {
 	// TypeStringFor returns the type string for the given aggregation type.
 	TypeStringFor(aggTypesOpts maggregation.TypesOptions, aggType maggregation.Type) []byte
 
+	// Quantiles returns the arbitrary quantiles computed in addition to the
+	// aggregation types.
+	Quantiles() maggregation.Quantiles
+
+	// TypeStringForQuantile returns the type string for the given quantile.
+	TypeStringForQuantile(aggTypesOpts maggregation.TypesOptions, quantile float64) []byte
+
 	// ElemPool returns the pool for the given element.
 	ElemPool(opts Options) genericElemPool
 
@@ -90,7 +107,9 @@ type typeSpecificElemBase interface {
 	ResetSetData(
 		aggTypesOpts maggregation.TypesOptions,
 		aggTypes maggregation.Types,
+		quantiles maggregation.Quantiles,
 		useDefaultAggregation bool,
+		forwardSketch bool,
 	) error
 
 	// Close closes the element.
@@ -131,6 +150,7 @@ func NewGenericElem(
 	id id.RawID,
 	sp policy.StoragePolicy,
 	aggTypes maggregation.Types,
+	quantiles maggregation.Quantiles,
 	pipeline applied.Pipeline,
 	numForwardedTimes int,
 	idPrefixSuffixType IDPrefixSuffixType,
@@ -140,7 +160,7 @@ func NewGenericElem(
 		elemBase: newElemBase(opts),
 		values:   make([]timedAggregation, 0, defaultNumAggregations), // in most cases values will have two entries
 	}
-	if err := e.ResetSetData(id, sp, aggTypes, pipeline, numForwardedTimes, idPrefixSuffixType); err != nil {
+	if err := e.ResetSetData(id, sp, aggTypes, quantiles, pipeline, numForwardedTimes, idPrefixSuffixType); err != nil {
 		return nil, err
 	}
 	return e, nil
@@ -151,12 +171,13 @@ func MustNewGenericElem(
 	id id.RawID,
 	sp policy.StoragePolicy,
 	aggTypes maggregation.Types,
+	quantiles maggregation.Quantiles,
 	pipeline applied.Pipeline,
 	numForwardedTimes int,
 	idPrefixSuffixType IDPrefixSuffixType,
 	opts Options,
 ) *GenericElem {
-	elem, err := NewGenericElem(id, sp, aggTypes, pipeline, numForwardedTimes, idPrefixSuffixType, opts)
+	elem, err := NewGenericElem(id, sp, aggTypes, quantiles, pipeline, numForwardedTimes, idPrefixSuffixType, opts)
 	if err != nil {
 		panic(fmt.Errorf("unable to create element: %v", err))
 	}
@@ -168,6 +189,7 @@ func (e *GenericElem) ResetSetData(
 	id id.RawID,
 	sp policy.StoragePolicy,
 	aggTypes maggregation.Types,
+	quantiles maggregation.Quantiles,
 	pipeline applied.Pipeline,
 	numForwardedTimes int,
 	idPrefixSuffixType IDPrefixSuffixType,
@@ -179,7 +201,7 @@ func (e *GenericElem) ResetSetData(
 	if err := e.elemBase.resetSetData(id, sp, aggTypes, useDefaultAggregation, pipeline, numForwardedTimes, idPrefixSuffixType); err != nil {
 		return err
 	}
-	if err := e.typeSpecificElemBase.ResetSetData(e.aggTypesOpts, aggTypes, useDefaultAggregation); err != nil {
+	if err := e.typeSpecificElemBase.ResetSetData(e.aggTypesOpts, aggTypes, quantiles, useDefaultAggregation, e.forwardSketch); err != nil {
 		return err
 	}
 	// If the pipeline contains derivative transformations, we need to store past
@@ -187,11 +209,11 @@ func (e *GenericElem) ResetSetData(
 	if !e.parsedPipeline.HasDerivativeTransform {
 		return nil
 	}
-	numAggTypes := len(e.aggTypes)
-	if cap(e.lastConsumedValues) < numAggTypes {
-		e.lastConsumedValues = make([]transformation.Datapoint, numAggTypes)
+	numValues := len(e.aggTypes) + len(e.Quantiles())
+	if cap(e.lastConsumedValues) < numValues {
+		e.lastConsumedValues = make([]transformation.Datapoint, numValues)
 	}
-	e.lastConsumedValues = e.lastConsumedValues[:numAggTypes]
+	e.lastConsumedValues = e.lastConsumedValues[:numValues]
 	for i := 0; i < len(e.lastConsumedValues); i++ {
 		e.lastConsumedValues[i] = transformation.Datapoint{Value: nan}
 	}
@@ -259,6 +281,37 @@ func (e *GenericElem) AddUnique(timestamp time.Time, values []float64, sourceID
 	return nil
 }
 
+// AddUniqueSketch merges an encoded sketch from a given source at a given
+// timestamp. If previous values from the same source have already been added
+// to the same aggregation, the incoming sketch is discarded.
+func (e *GenericElem) AddUniqueSketch(timestamp time.Time, sketch []byte, sourceID uint32) error {
+	decoded := e.opts.SketchOptions().SketchPool().Get()
+	defer decoded.Close()
+	if err := decoded.Decode(sketch); err != nil {
+		return err
+	}
+
+	alignedStart := timestamp.Truncate(e.sp.Resolution().Window).UnixNano()
+	lockedAgg, err := e.findOrCreate(alignedStart, createAggregationOptions{initSourceSet: true})
+	if err != nil {
+		return err
+	}
+	lockedAgg.Lock()
+	if lockedAgg.closed {
+		lockedAgg.Unlock()
+		return errAggregationClosed
+	}
+	source := uint(sourceID)
+	if lockedAgg.sourcesSeen.Test(source) {
+		lockedAgg.Unlock()
+		return errDuplicateForwardingSource
+	}
+	lockedAgg.sourcesSeen.Set(source)
+	err = lockedAgg.aggregation.Merge(timestamp, decoded)
+	lockedAgg.Unlock()
+	return err
+}
+
 // Consume consumes values before a given time and removes them from the element
 // after they are consumed, returning whether the element can be collected after
 // the consumption is completed.
@@ -466,9 +519,22 @@ func (e *GenericElem) processValueWithAggregationLock(
 	var (
 		transformations  = e.parsedPipeline.Transformations
 		discardNaNValues = e.opts.DiscardNaNAggregatedValues()
+		quantiles        = e.Quantiles()
+		numAggTypes      = len(e.aggTypes)
+		sketch           ddsketch.Sketch
 	)
-	for aggTypeIdx, aggType := range e.aggTypes {
-		value := lockedAgg.aggregation.ValueOf(aggType)
+	if e.forwardSketch {
+		sketch = lockedAgg.aggregation.Sketch()
+	}
+	// NB: The values of the aggregation types are followed by the values of
+	// the arbitrary quantiles if any.
+	for valueIdx := 0; valueIdx < numAggTypes+len(quantiles); valueIdx++ {
+		var value float64
+		if valueIdx < numAggTypes {
+			value = lockedAgg.aggregation.ValueOf(e.aggTypes[valueIdx])
+		} else {
+			value = lockedAgg.aggregation.ValueOfQuantile(quantiles[valueIdx-numAggTypes])
+		}
 		for _, transformOp := range transformations {
 			unaryOp, isUnaryOp := transformOp.UnaryTransform()
 			binaryOp, isBinaryOp := transformOp.BinaryTransform()
@@ -487,7 +553,7 @@ func (e *GenericElem) processValueWithAggregationLock(
 				lastTimeNanos := e.lastConsumedAtNanos
 				prev := transformation.Datapoint{
 					TimeNanos: lastTimeNanos,
-					Value:     e.lastConsumedValues[aggTypeIdx].Value,
+					Value:     e.lastConsumedValues[valueIdx].Value,
 				}
 
 				currTimeNanos := timeNanos
@@ -503,7 +569,7 @@ func (e *GenericElem) processValueWithAggregationLock(
 				// need to keep one value. In the future if we need to support higher-order
 				// derivative transformations, we need to store an array of values here.
 				if !math.IsNaN(curr.Value) {
-					e.lastConsumedValues[aggTypeIdx] = curr
+					e.lastConsumedValues[valueIdx] = curr
 				}
 
 				value = res.Value
@@ -520,11 +586,17 @@ func (e *GenericElem) processValueWithAggregationLock(
 			case NoPrefixNoSuffix:
 				flushLocalFn(nil, e.id, nil, timeNanos, value, e.sp)
 			case WithPrefixWithSuffix:
-				flushLocalFn(e.FullPrefix(e.opts), e.id, e.TypeStringFor(e.aggTypesOpts, aggType), timeNanos, value, e.sp)
+				var typeString []byte
+				if valueIdx < numAggTypes {
+					typeString = e.TypeStringFor(e.aggTypesOpts, e.aggTypes[valueIdx])
+				} else {
+					typeString = e.TypeStringForQuantile(e.aggTypesOpts, quantiles[valueIdx-numAggTypes])
+				}
+				flushLocalFn(e.FullPrefix(e.opts), e.id, typeString, timeNanos, value, e.sp)
 			}
 		} else {
 			forwardedAggregationKey, _ := e.ForwardedAggregationKey()
-			flushForwardedFn(e.writeForwardedMetricFn, forwardedAggregationKey, timeNanos, value)
+			flushForwardedFn(e.writeForwardedMetricFn, forwardedAggregationKey, timeNanos, value, sketch)
 		}
 	}
 	e.lastConsumedAtNanos = timeNanos
@@ -28,6 +28,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
@@ -476,8 +477,9 @@ func (l *baseMetricList) consumeForwardedMetric(
 	aggregationKey aggregationKey,
 	timeNanos int64,
 	value float64,
+	sketch ddsketch.Sketch,
 ) {
-	writeFn(aggregationKey, timeNanos, value)
+	writeFn(aggregationKey, timeNanos, value, sketch)
 	l.metrics.flushForwarded.metricConsumed.Inc(1)
 }
 
@@ -487,6 +489,7 @@ func (l *baseMetricList) discardForwardedMetric(
 	aggregationKey aggregationKey,
 	timeNanos int64,
 	value float64,
+	sketch ddsketch.Sketch,
 ) {
 	l.metrics.flushForwarded.metricDiscarded.Inc(1)
 }
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
 	"github.com/m3db/m3/src/aggregator/client"
@@ -140,6 +141,12 @@ type Options interface {
 	// StreamOptions returns the stream options.
 	StreamOptions() cm.Options
 
+	// SetSketchOptions sets the sketch options.
+	SetSketchOptions(value ddsketch.Options) Options
+
+	// SketchOptions returns the sketch options.
+	SketchOptions() ddsketch.Options
+
 	// SetAdminClient sets the administrative client.
 	SetAdminClient(value client.AdminClient) Options
 
@@ -327,6 +334,7 @@ type options struct {
 	clockOpts                        clock.Options
 	instrumentOpts                   instrument.Options
 	streamOpts                       cm.Options
+	sketchOpts                       ddsketch.Options
 	adminClient                      client.AdminClient
 	runtimeOptsManager               runtime.OptionsManager
 	placementManager                 PlacementManager
@@ -378,6 +386,7 @@ func NewOptions() Options {
 		clockOpts:                        clock.NewOptions(),
 		instrumentOpts:                   instrument.NewOptions(),
 		streamOpts:                       cm.NewOptions(),
+		sketchOpts:                       ddsketch.NewOptions(),
 		runtimeOptsManager:               runtime.NewOptionsManager(runtime.NewOptions()),
 		shardFn:                          sharding.Murmur32Hash.MustShardFn(),
 		bufferDurationBeforeShardCutover: defaultBufferDurationBeforeShardCutover,
@@ -500,6 +509,16 @@ func (o *options) StreamOptions() cm.Options {
 	return o.streamOpts
 }
 
+func (o *options) SetSketchOptions(value ddsketch.Options) Options {
+	opts := *o
+	opts.sketchOpts = value
+	return &opts
+}
+
+func (o *options) SketchOptions() ddsketch.Options {
+	return o.sketchOpts
+}
+
 func (o *options) SetAdminClient(value client.AdminClient) Options {
 	opts := *o
 	opts.adminClient = value
@@ -795,17 +814,17 @@ func (o *options) initPools() {
 
 	o.counterElemPool = NewCounterElemPool(nil)
 	o.counterElemPool.Init(func() *CounterElem {
-		return MustNewCounterElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, WithPrefixWithSuffix, o)
+		return MustNewCounterElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, nil, applied.DefaultPipeline, 0, WithPrefixWithSuffix, o)
 	})
 
 	o.timerElemPool = NewTimerElemPool(nil)
 	o.timerElemPool.Init(func() *TimerElem {
-		return MustNewTimerElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, WithPrefixWithSuffix, o)
+		return MustNewTimerElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, nil, applied.DefaultPipeline, 0, WithPrefixWithSuffix, o)
 	})
 
 	o.gaugeElemPool = NewGaugeElemPool(nil)
 	o.gaugeElemPool.Init(func() *GaugeElem {
-		return MustNewGaugeElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, WithPrefixWithSuffix, o)
+		return MustNewGaugeElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, nil, applied.DefaultPipeline, 0, WithPrefixWithSuffix, o)
 	})
 }
 
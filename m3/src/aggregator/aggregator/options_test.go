@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
 	"github.com/m3db/m3/src/aggregator/client"
@@ -64,6 +65,7 @@ func TestOptionsValidateDefault(t *testing.T) {
 	require.NotNil(t, o.InstrumentOptions())
 	require.NotNil(t, o.TimeLock())
 	require.NotNil(t, o.StreamOptions())
+	require.NotNil(t, o.SketchOptions())
 	require.NotNil(t, o.EntryPool())
 	require.NotNil(t, o.CounterElemPool())
 	require.NotNil(t, o.TimerElemPool())
@@ -123,6 +125,12 @@ func TestSetStreamOptions(t *testing.T) {
 	require.Equal(t, value, o.StreamOptions())
 }
 
+func TestSetSketchOptions(t *testing.T) {
+	value := ddsketch.NewOptions().SetRelativeAccuracy(0.005)
+	o := NewOptions().SetSketchOptions(value)
+	require.Equal(t, value, o.SketchOptions())
+}
+
 func TestSetAdminClient(t *testing.T) {
 	c, err := client.NewClient(client.NewOptions())
 	require.NoError(t, err)
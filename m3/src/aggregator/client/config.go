@@ -35,8 +35,7 @@ import (
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/pool"
 	"github.com/m3db/m3/src/x/retry"
-
-	"github.com/uber-go/tally"
+	xtls "github.com/m3db/m3/src/x/tls"
 )
 
 var (
@@ -133,7 +132,11 @@ func (c *Configuration) newClientOptions(
 		}
 
 		scope := instrumentOpts.MetricsScope()
-		connectionOpts := c.Connection.NewConnectionOptions(scope.SubScope("connection"))
+		connectionOpts, err := c.Connection.NewConnectionOptions(
+			instrumentOpts.SetMetricsScope(scope.SubScope("connection")))
+		if err != nil {
+			return nil, err
+		}
 		kvOpts, err := placementKV.NewOverrideOptions()
 		if err != nil {
 			return nil, err
@@ -210,10 +213,13 @@ type ConnectionConfiguration struct {
 	ReconnectThresholdMultiplier int                  `yaml:"reconnectThresholdMultiplier"`
 	MaxReconnectDuration         *time.Duration       `yaml:"maxReconnectDuration"`
 	WriteRetries                 *retry.Configuration `yaml:"writeRetries"`
+	TLS                          *xtls.Configuration  `yaml:"tls"`
 }
 
 // NewConnectionOptions creates new connection options.
-func (c *ConnectionConfiguration) NewConnectionOptions(scope tally.Scope) ConnectionOptions {
+func (c *ConnectionConfiguration) NewConnectionOptions(
+	instrumentOpts instrument.Options,
+) (ConnectionOptions, error) {
 	opts := NewConnectionOptions()
 	if c.ConnectionTimeout != 0 {
 		opts = opts.SetConnectionTimeout(c.ConnectionTimeout)
@@ -237,10 +243,17 @@ func (c *ConnectionConfiguration) NewConnectionOptions(scope tally.Scope) Connec
 		opts = opts.SetMaxReconnectDuration(*c.MaxReconnectDuration)
 	}
 	if c.WriteRetries != nil {
-		retryOpts := c.WriteRetries.NewOptions(scope)
+		retryOpts := c.WriteRetries.NewOptions(instrumentOpts.MetricsScope())
 		opts = opts.SetWriteRetryOptions(retryOpts)
 	}
-	return opts
+	if c.TLS != nil {
+		tlsConfig, err := c.TLS.NewClientConfig(instrumentOpts)
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetTLSConfig(tlsConfig)
+	}
+	return opts, nil
 }
 
 // EncoderConfiguration configures the encoder.
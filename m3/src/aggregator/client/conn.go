@@ -21,6 +21,7 @@
 package client
 
 import (
+	"crypto/tls"
 	"errors"
 	"math/rand"
 	"net"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/retry"
+	xtls "github.com/m3db/m3/src/x/tls"
 
 	"github.com/uber-go/tally"
 )
@@ -60,8 +62,9 @@ type connection struct {
 	maxDuration    time.Duration
 	writeRetryOpts retry.Options
 	rngFn          retry.RngFn
+	tlsConfig      *tls.Config
 
-	conn                    *net.TCPConn
+	conn                    net.Conn
 	numFailures             int
 	threshold               int
 	lastConnectAttemptNanos int64
@@ -86,6 +89,7 @@ func newConnection(addr string, opts ConnectionOptions) *connection {
 		maxThreshold:   opts.MaxReconnectThreshold(),
 		maxDuration:    opts.MaxReconnectDuration(),
 		writeRetryOpts: opts.WriteRetryOptions(),
+		tlsConfig:      opts.TLSConfig(),
 		rngFn:          rand.New(rand.NewSource(time.Now().UnixNano())).Int63n,
 		nowFn:          opts.ClockOptions().NowFn(),
 		sleepFn:        time.Sleep,
@@ -178,10 +182,20 @@ func (c *connection) connectWithLock() error {
 		c.metrics.setKeepAliveError.Inc(1)
 	}
 
+	conn = tcpConn
+	if c.tlsConfig != nil {
+		conn, err = xtls.NewClientConn(tcpConn, c.tlsConfig, c.addr, c.connTimeout)
+		if err != nil {
+			c.metrics.tlsHandshakeError.Inc(1)
+			tcpConn.Close() // nolint: errcheck
+			return err
+		}
+	}
+
 	if c.conn != nil {
 		c.conn.Close() // nolint: errcheck
 	}
-	c.conn = tcpConn
+	c.conn = conn
 	return nil
 }
 
@@ -245,6 +259,7 @@ type connectionMetrics struct {
 	writeRetries          tally.Counter
 	setKeepAliveError     tally.Counter
 	setWriteDeadlineError tally.Counter
+	tlsHandshakeError     tally.Counter
 }
 
 func newConnectionMetrics(scope tally.Scope) connectionMetrics {
@@ -258,5 +273,7 @@ func newConnectionMetrics(scope tally.Scope) connectionMetrics {
 			Counter(errorMetric),
 		setWriteDeadlineError: scope.Tagged(map[string]string{errorMetricType: "set-write-deadline"}).
 			Counter(errorMetric),
+		tlsHandshakeError: scope.Tagged(map[string]string{errorMetricType: "tls-handshake"}).
+			Counter(errorMetric),
 	}
 }
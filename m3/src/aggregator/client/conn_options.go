@@ -21,6 +21,7 @@
 package client
 
 import (
+	"crypto/tls"
 	"math"
 	"time"
 
@@ -105,6 +106,12 @@ type ConnectionOptions interface {
 
 	// WriteRetryOptions returns the retry options for retrying failed writes.
 	WriteRetryOptions() retry.Options
+
+	// SetTLSConfig sets the TLS config for connections, nil disables TLS.
+	SetTLSConfig(value *tls.Config) ConnectionOptions
+
+	// TLSConfig returns the TLS config for connections, nil disables TLS.
+	TLSConfig() *tls.Config
 }
 
 type connectionOptions struct {
@@ -118,6 +125,7 @@ type connectionOptions struct {
 	multiplier     int
 	maxDuration    time.Duration
 	writeRetryOpts retry.Options
+	tlsConfig      *tls.Config
 }
 
 // NewConnectionOptions create a new set of connection options.
@@ -241,3 +249,13 @@ func (o *connectionOptions) SetWriteRetryOptions(value retry.Options) Connection
 func (o *connectionOptions) WriteRetryOptions() retry.Options {
 	return o.writeRetryOpts
 }
+
+func (o *connectionOptions) SetTLSConfig(value *tls.Config) ConnectionOptions {
+	opts := *o
+	opts.tlsConfig = value
+	return &opts
+}
+
+func (o *connectionOptions) TLSConfig() *tls.Config {
+	return o.tlsConfig
+}
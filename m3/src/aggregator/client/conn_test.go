@@ -21,15 +21,21 @@
 package client
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math"
 	"net"
+	"os"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+	xtls "github.com/m3db/m3/src/x/tls"
+	"github.com/m3db/m3/src/x/tls/tlstest"
 
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
@@ -261,6 +267,72 @@ func TestConnectWriteToServer(t *testing.T) {
 	require.Nil(t, conn.conn)
 }
 
+func TestConnectWriteToTLSServer(t *testing.T) {
+	data := []byte("foobar")
+
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := tlstest.NewCA(t, 1)
+	serverFiles := tlstest.NewFiles(dir, "server")
+	serverFiles.WriteIssued(t, ca, 2)
+	clientFiles := tlstest.NewFiles(dir, "client")
+	clientFiles.WriteIssued(t, ca, 3)
+
+	clientAuth := xtls.RequireAndVerifyClientCert
+	serverConfig, err := xtls.Configuration{
+		CertFile:   serverFiles.CertFile,
+		KeyFile:    serverFiles.KeyFile,
+		CAFile:     serverFiles.CAFile,
+		ClientAuth: &clientAuth,
+	}.NewServerConfig(instrument.NewOptions())
+	require.NoError(t, err)
+	clientConfig, err := xtls.Configuration{
+		CertFile: clientFiles.CertFile,
+		KeyFile:  clientFiles.KeyFile,
+		CAFile:   clientFiles.CAFile,
+	}.NewClientConfig(instrument.NewOptions())
+	require.NoError(t, err)
+
+	// Start tls server.
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	l, err := tls.Listen(tcpProtocol, testLocalServerAddr, serverConfig)
+	require.NoError(t, err)
+	serverAddr := l.Addr().String()
+
+	go func() {
+		defer wg.Done()
+
+		conn, err := l.Accept()
+		require.NoError(t, err)
+		buf := make([]byte, 1024)
+		n, err := conn.Read(buf)
+		require.NoError(t, err)
+		require.Equal(t, data, buf[:n])
+		conn.Close() // nolint: errcheck
+	}()
+
+	// Create a new connection and assert we can write successfully.
+	opts := testConnectionOptions().
+		SetConnectionTimeout(time.Minute).
+		SetTLSConfig(clientConfig)
+	conn := newConnection(serverAddr, opts)
+	require.NoError(t, conn.Write(data))
+	require.Equal(t, 0, conn.numFailures)
+	require.NotNil(t, conn.conn)
+
+	// Stop the server.
+	wg.Wait()
+	l.Close() // nolint: errcheck
+
+	// Close the connection
+	conn.Close()
+	require.Nil(t, conn.conn)
+}
+
 func testConnectionOptions() ConnectionOptions {
 	return NewConnectionOptions().
 		SetClockOptions(clock.NewOptions()).
@@ -110,7 +110,10 @@ func Run(opts RunOptions) {
 			SetMetricsScope(scope.
 				SubScope("rawtcp-server").
 				Tagged(map[string]string{"server": "rawtcp"}))
-		rawTCPServerOpts = cfg.RawTCP.NewServerOptions(rawTCPInstrumentOpts)
+		rawTCPServerOpts, err = cfg.RawTCP.NewServerOptions(rawTCPInstrumentOpts)
+		if err != nil {
+			logger.Fatal("could not create raw TCP server options", zap.Error(err))
+		}
 	}
 
 	if cfg.HTTP != nil {
@@ -236,6 +236,7 @@ func (m *Instance) GetMetadata() *InstanceMetadata {
 
 type InstanceMetadata struct {
 	DebugPort uint32 `protobuf:"varint,1,opt,name=debug_port,json=debugPort,proto3" json:"debug_port,omitempty"`
+	GrpcPort  uint32 `protobuf:"varint,2,opt,name=grpc_port,json=grpcPort,proto3" json:"grpc_port,omitempty"`
 }
 
 func (m *InstanceMetadata) Reset()                    { *m = InstanceMetadata{} }
@@ -250,6 +251,13 @@ func (m *InstanceMetadata) GetDebugPort() uint32 {
 	return 0
 }
 
+func (m *InstanceMetadata) GetGrpcPort() uint32 {
+	if m != nil {
+		return m.GrpcPort
+	}
+	return 0
+}
+
 type Shard struct {
 	Id       uint32     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	State    ShardState `protobuf:"varint,2,opt,name=state,proto3,enum=placementpb.ShardState" json:"state,omitempty"`
@@ -518,6 +526,11 @@ func (m *InstanceMetadata) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPlacement(dAtA, i, uint64(m.DebugPort))
 	}
+	if m.GrpcPort != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPlacement(dAtA, i, uint64(m.GrpcPort))
+	}
 	return i, nil
 }
 
@@ -692,6 +705,9 @@ func (m *InstanceMetadata) Size() (n int) {
 	if m.DebugPort != 0 {
 		n += 1 + sovPlacement(uint64(m.DebugPort))
 	}
+	if m.GrpcPort != 0 {
+		n += 1 + sovPlacement(uint64(m.GrpcPort))
+	}
 	return n
 }
 
@@ -1395,6 +1411,25 @@ func (m *InstanceMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrpcPort", wireType)
+			}
+			m.GrpcPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlacement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrpcPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlacement(dAtA[iNdEx:])
@@ -1762,47 +1797,48 @@ func init() {
 }
 
 var fileDescriptorPlacement = []byte{
-	// 672 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xae, 0x93, 0x26, 0x8d, 0x27, 0x4d, 0xfe, 0x68, 0xa5, 0xbf, 0x58, 0x45, 0x0d, 0x21, 0xa8,
-	0x22, 0x2a, 0x22, 0x16, 0x2d, 0x07, 0xe8, 0x2d, 0x45, 0xa5, 0x72, 0x95, 0x56, 0xd5, 0xa6, 0xea,
-	0x81, 0x8b, 0xb5, 0xb1, 0x37, 0xc9, 0x8a, 0x78, 0xd7, 0xda, 0x5d, 0x97, 0x96, 0xa7, 0xe0, 0x3d,
-	0x78, 0x0d, 0x0e, 0x1c, 0x79, 0x04, 0x54, 0x1e, 0x82, 0x2b, 0xf2, 0xda, 0x4e, 0x52, 0xe8, 0x6d,
-	0xe6, 0x9b, 0x6f, 0x77, 0x66, 0xbe, 0x99, 0x5d, 0x38, 0x9d, 0x32, 0x3d, 0x4b, 0xc6, 0xfd, 0x40,
-	0x44, 0x6e, 0x74, 0x10, 0x8e, 0xdd, 0xe8, 0xc0, 0x55, 0x32, 0x70, 0x83, 0x79, 0xa2, 0x34, 0x95,
-	0xee, 0x94, 0x72, 0x2a, 0x89, 0xa6, 0xa1, 0x1b, 0x4b, 0xa1, 0x85, 0x1b, 0xcf, 0x49, 0x40, 0x23,
-	0xca, 0x75, 0x3c, 0x5e, 0xda, 0x7d, 0x13, 0x43, 0xf5, 0x95, 0x60, 0xf7, 0x77, 0x09, 0xec, 0x8b,
-	0xc2, 0x47, 0xef, 0xc0, 0x66, 0x5c, 0x69, 0xc2, 0x03, 0xaa, 0x1c, 0xab, 0x53, 0xee, 0xd5, 0xf7,
-	0x77, 0xfb, 0x2b, 0xf4, 0xfe, 0x82, 0xda, 0xf7, 0x0a, 0xde, 0x31, 0xd7, 0xf2, 0x16, 0x2f, 0xcf,
-	0xa1, 0x5d, 0x68, 0x4a, 0x1a, 0xcf, 0x59, 0x40, 0xfc, 0x09, 0x09, 0xb4, 0x90, 0x4e, 0xa9, 0x63,
-	0xf5, 0x1a, 0xb8, 0x91, 0xa3, 0xef, 0x0d, 0x88, 0x76, 0x00, 0x78, 0x12, 0xf9, 0x6a, 0x46, 0x64,
-	0xa8, 0x9c, 0xb2, 0xa1, 0xd8, 0x3c, 0x89, 0x46, 0x06, 0x48, 0xc3, 0x4c, 0x65, 0x51, 0x1a, 0x3a,
-	0xeb, 0x1d, 0xab, 0x57, 0xc3, 0x36, 0x53, 0xa3, 0x0c, 0x40, 0x4f, 0x61, 0x33, 0x48, 0xb4, 0xb8,
-	0xa6, 0xd2, 0xd7, 0x2c, 0xa2, 0x4e, 0xa5, 0x63, 0xf5, 0xca, 0xb8, 0x9e, 0x63, 0x97, 0x2c, 0xa2,
-	0xe8, 0x09, 0xd4, 0x99, 0xf2, 0x23, 0x26, 0xa5, 0x90, 0x34, 0x74, 0xaa, 0xe6, 0x0a, 0x60, 0xea,
-	0x2c, 0x47, 0xd0, 0x73, 0x68, 0x45, 0xe4, 0x26, 0xcb, 0xe1, 0x2b, 0xaa, 0x7d, 0x16, 0x3a, 0x1b,
-	0x59, 0xa9, 0x11, 0xb9, 0x31, 0x99, 0x46, 0x54, 0x7b, 0xe1, 0xf6, 0x08, 0x9a, 0xf7, 0xdb, 0x45,
-	0x2d, 0x28, 0x7f, 0xa4, 0xb7, 0x8e, 0xd5, 0xb1, 0x7a, 0x36, 0x4e, 0x4d, 0xf4, 0x02, 0x2a, 0xd7,
-	0x64, 0x9e, 0x50, 0xd3, 0x6c, 0x7d, 0xff, 0xff, 0x7b, 0xb2, 0x15, 0xa7, 0x71, 0xc6, 0x39, 0x2c,
-	0xbd, 0xb1, 0xba, 0xdf, 0x4a, 0x50, 0x2b, 0x70, 0xd4, 0x84, 0x12, 0x0b, 0xf3, 0xeb, 0x4a, 0x2c,
-	0x2d, 0xed, 0x3f, 0xa6, 0xc4, 0x9c, 0x68, 0x26, 0xb8, 0x3f, 0x95, 0x22, 0x89, 0xcd, 0xbd, 0x36,
-	0x6e, 0x2e, 0xe0, 0x93, 0x14, 0x45, 0x08, 0xd6, 0x3f, 0x0b, 0x4e, 0x8d, 0x7e, 0x36, 0x36, 0x36,
-	0xda, 0x82, 0xea, 0x27, 0xca, 0xa6, 0x33, 0x6d, 0x64, 0x6b, 0xe0, 0xdc, 0x43, 0xdb, 0x50, 0xa3,
-	0x3c, 0x8c, 0x05, 0xe3, 0xda, 0xe8, 0x65, 0xe3, 0x85, 0x8f, 0xf6, 0xa0, 0x9a, 0x4f, 0xa2, 0x6a,
-	0xc6, 0x8e, 0xee, 0xd5, 0x6f, 0xb4, 0xc0, 0x39, 0x03, 0x75, 0x60, 0xf3, 0x01, 0xcd, 0x40, 0x2d,
-	0x04, 0x4b, 0x33, 0xcd, 0x84, 0xd2, 0x9c, 0x44, 0xd4, 0xa9, 0x65, 0x99, 0x0a, 0x3f, 0xad, 0x38,
-	0x16, 0x52, 0x3b, 0xb6, 0x39, 0x65, 0x6c, 0xf4, 0x16, 0x6a, 0x11, 0xd5, 0x24, 0x24, 0x9a, 0x38,
-	0x60, 0xf4, 0xdb, 0x79, 0x50, 0xbf, 0xb3, 0x9c, 0x84, 0x17, 0xf4, 0xee, 0x2b, 0x68, 0xfd, 0x1d,
-	0x4d, 0x77, 0x27, 0xa4, 0xe3, 0x64, 0xea, 0x9b, 0x44, 0x56, 0xb6, 0x5a, 0x06, 0xb9, 0x10, 0x52,
-	0x77, 0xbf, 0x5a, 0x50, 0x31, 0x1d, 0xad, 0xc8, 0xde, 0x30, 0xb2, 0xbf, 0x84, 0x8a, 0xd2, 0x44,
-	0x67, 0x43, 0x6c, 0xee, 0x3f, 0xfa, 0x57, 0x84, 0x51, 0x1a, 0xc6, 0x19, 0x0b, 0x3d, 0x06, 0x5b,
-	0x89, 0x44, 0x06, 0x34, 0x55, 0x21, 0x9b, 0x40, 0x2d, 0x03, 0xbc, 0x10, 0x3d, 0x83, 0x46, 0xb1,
-	0xa1, 0x9c, 0x70, 0xa1, 0xcc, 0x30, 0xca, 0xb8, 0x58, 0xdb, 0xf3, 0x14, 0x2b, 0xd6, 0x78, 0x32,
-	0xc9, 0x39, 0x2b, 0x6b, 0x3c, 0x99, 0x18, 0x4a, 0xf7, 0x14, 0xd0, 0xe2, 0xd5, 0x8d, 0x38, 0x89,
-	0xd5, 0x4c, 0x68, 0x85, 0x5e, 0x83, 0xad, 0x0a, 0x27, 0x7f, 0xa9, 0x5b, 0x0f, 0xbf, 0x54, 0xbc,
-	0x24, 0xee, 0x1d, 0x02, 0x2c, 0xbb, 0x40, 0x2d, 0xd8, 0xf4, 0xce, 0xbd, 0x4b, 0x6f, 0x30, 0xf4,
-	0x3e, 0x78, 0xe7, 0x27, 0xad, 0x35, 0xd4, 0x00, 0x7b, 0x70, 0x35, 0xf0, 0x86, 0x83, 0xa3, 0xe1,
-	0x71, 0xcb, 0x42, 0x75, 0xd8, 0x18, 0x1e, 0x0f, 0xae, 0xd2, 0x58, 0xe9, 0xa8, 0xf5, 0xfd, 0xae,
-	0x6d, 0xfd, 0xb8, 0x6b, 0x5b, 0x3f, 0xef, 0xda, 0xd6, 0x97, 0x5f, 0xed, 0xb5, 0x71, 0xd5, 0xfc,
-	0x27, 0x07, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x13, 0xbd, 0x6b, 0xbf, 0x9d, 0x04, 0x00, 0x00,
+	// 683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x6e, 0xdb, 0x38,
+	0x10, 0x8d, 0xec, 0xd8, 0xb1, 0xc6, 0xb1, 0xd7, 0x20, 0xb0, 0x59, 0x21, 0x8b, 0x78, 0xbd, 0x5e,
+	0x04, 0x6b, 0x64, 0xb1, 0x36, 0x90, 0xec, 0x61, 0x9b, 0x9b, 0x53, 0xa4, 0x81, 0x02, 0xc7, 0x08,
+	0xe8, 0x20, 0x87, 0x5e, 0x04, 0x5a, 0xa2, 0x6d, 0xa2, 0x16, 0x29, 0x90, 0x54, 0x9a, 0xf4, 0x2b,
+	0xfa, 0x1f, 0xfd, 0x8d, 0x1e, 0x7a, 0xec, 0x27, 0x14, 0xe9, 0x47, 0xf4, 0x5a, 0x90, 0x92, 0x6c,
+	0xa7, 0xcd, 0x8d, 0xf3, 0xde, 0x13, 0x67, 0xe6, 0xcd, 0x50, 0x70, 0x39, 0x67, 0x7a, 0x91, 0x4e,
+	0xfb, 0xa1, 0x88, 0x07, 0xf1, 0x49, 0x34, 0x1d, 0xc4, 0x27, 0x03, 0x25, 0xc3, 0x41, 0xb8, 0x4c,
+	0x95, 0xa6, 0x72, 0x30, 0xa7, 0x9c, 0x4a, 0xa2, 0x69, 0x34, 0x48, 0xa4, 0xd0, 0x62, 0x90, 0x2c,
+	0x49, 0x48, 0x63, 0xca, 0x75, 0x32, 0x5d, 0x9f, 0xfb, 0x96, 0x43, 0xf5, 0x0d, 0xb2, 0xfb, 0xad,
+	0x04, 0xee, 0x75, 0x11, 0xa3, 0x97, 0xe0, 0x32, 0xae, 0x34, 0xe1, 0x21, 0x55, 0x9e, 0xd3, 0x29,
+	0xf7, 0xea, 0xc7, 0x87, 0xfd, 0x0d, 0x79, 0x7f, 0x25, 0xed, 0xfb, 0x85, 0xee, 0x9c, 0x6b, 0xf9,
+	0x80, 0xd7, 0xdf, 0xa1, 0x43, 0x68, 0x4a, 0x9a, 0x2c, 0x59, 0x48, 0x82, 0x19, 0x09, 0xb5, 0x90,
+	0x5e, 0xa9, 0xe3, 0xf4, 0x1a, 0xb8, 0x91, 0xa3, 0xaf, 0x2c, 0x88, 0x0e, 0x00, 0x78, 0x1a, 0x07,
+	0x6a, 0x41, 0x64, 0xa4, 0xbc, 0xb2, 0x95, 0xb8, 0x3c, 0x8d, 0x27, 0x16, 0x30, 0x34, 0x53, 0x19,
+	0x4b, 0x23, 0x6f, 0xbb, 0xe3, 0xf4, 0x6a, 0xd8, 0x65, 0x6a, 0x92, 0x01, 0xe8, 0x4f, 0xd8, 0x0d,
+	0x53, 0x2d, 0xee, 0xa8, 0x0c, 0x34, 0x8b, 0xa9, 0x57, 0xe9, 0x38, 0xbd, 0x32, 0xae, 0xe7, 0xd8,
+	0x0d, 0x8b, 0x29, 0xfa, 0x03, 0xea, 0x4c, 0x05, 0x31, 0x93, 0x52, 0x48, 0x1a, 0x79, 0x55, 0x7b,
+	0x05, 0x30, 0x75, 0x95, 0x23, 0xe8, 0x6f, 0x68, 0xc5, 0xe4, 0x3e, 0xcb, 0x11, 0x28, 0xaa, 0x03,
+	0x16, 0x79, 0x3b, 0x59, 0xa9, 0x31, 0xb9, 0xb7, 0x99, 0x26, 0x54, 0xfb, 0xd1, 0xfe, 0x04, 0x9a,
+	0x4f, 0xdb, 0x45, 0x2d, 0x28, 0xbf, 0xa1, 0x0f, 0x9e, 0xd3, 0x71, 0x7a, 0x2e, 0x36, 0x47, 0xf4,
+	0x0f, 0x54, 0xee, 0xc8, 0x32, 0xa5, 0xb6, 0xd9, 0xfa, 0xf1, 0xaf, 0x4f, 0x6c, 0x2b, 0xbe, 0xc6,
+	0x99, 0xe6, 0xb4, 0xf4, 0xbf, 0xd3, 0xfd, 0x58, 0x82, 0x5a, 0x81, 0xa3, 0x26, 0x94, 0x58, 0x94,
+	0x5f, 0x57, 0x62, 0xa6, 0xb4, 0x5f, 0x98, 0x12, 0x4b, 0xa2, 0x99, 0xe0, 0xc1, 0x5c, 0x8a, 0x34,
+	0xb1, 0xf7, 0xba, 0xb8, 0xb9, 0x82, 0x2f, 0x0c, 0x8a, 0x10, 0x6c, 0xbf, 0x13, 0x9c, 0x5a, 0xff,
+	0x5c, 0x6c, 0xcf, 0x68, 0x0f, 0xaa, 0x6f, 0x29, 0x9b, 0x2f, 0xb4, 0xb5, 0xad, 0x81, 0xf3, 0x08,
+	0xed, 0x43, 0x8d, 0xf2, 0x28, 0x11, 0x8c, 0x6b, 0xeb, 0x97, 0x8b, 0x57, 0x31, 0x3a, 0x82, 0x6a,
+	0x3e, 0x89, 0xaa, 0x1d, 0x3b, 0x7a, 0x52, 0xbf, 0xf5, 0x02, 0xe7, 0x0a, 0xd4, 0x81, 0xdd, 0x67,
+	0x3c, 0x03, 0xb5, 0x32, 0xcc, 0x64, 0x5a, 0x08, 0xa5, 0x39, 0x89, 0xa9, 0x57, 0xcb, 0x32, 0x15,
+	0xb1, 0xa9, 0x38, 0x11, 0x52, 0x7b, 0xae, 0xfd, 0xca, 0x9e, 0xd1, 0x0b, 0xa8, 0xc5, 0x54, 0x93,
+	0x88, 0x68, 0xe2, 0x81, 0xf5, 0xef, 0xe0, 0x59, 0xff, 0xae, 0x72, 0x11, 0x5e, 0xc9, 0xbb, 0x63,
+	0x68, 0xfd, 0xc8, 0x9a, 0xdd, 0x89, 0xe8, 0x34, 0x9d, 0x07, 0x36, 0x91, 0x93, 0xad, 0x96, 0x45,
+	0xae, 0x4d, 0xb6, 0xdf, 0xc1, 0x9d, 0xcb, 0x24, 0xcc, 0xd8, 0x6c, 0x37, 0x6b, 0x06, 0x30, 0x64,
+	0xf7, 0x83, 0x03, 0x15, 0xdb, 0xee, 0xc6, 0x4c, 0x1a, 0x76, 0x26, 0xff, 0x42, 0x45, 0x69, 0xa2,
+	0xb3, 0x09, 0x37, 0x8f, 0x7f, 0xfb, 0xd9, 0xa1, 0x89, 0xa1, 0x71, 0xa6, 0x32, 0x59, 0x94, 0x48,
+	0x65, 0x48, 0x8d, 0x45, 0xd9, 0x78, 0x6a, 0x19, 0xe0, 0x47, 0xe8, 0x2f, 0x68, 0x14, 0xeb, 0xcb,
+	0x09, 0x17, 0xca, 0x4e, 0xaa, 0x8c, 0x8b, 0x9d, 0x1e, 0x1b, 0xac, 0xd8, 0xf1, 0xd9, 0x2c, 0xd7,
+	0x6c, 0xec, 0xf8, 0x6c, 0x66, 0x25, 0xdd, 0x4b, 0x40, 0xab, 0x27, 0x39, 0xe1, 0x24, 0x51, 0x0b,
+	0xa1, 0x15, 0xfa, 0x0f, 0x5c, 0x55, 0x04, 0xf9, 0x33, 0xde, 0x7b, 0xfe, 0x19, 0xe3, 0xb5, 0xf0,
+	0xe8, 0x14, 0x60, 0xdd, 0x05, 0x6a, 0xc1, 0xae, 0x3f, 0xf6, 0x6f, 0xfc, 0xe1, 0xc8, 0x7f, 0xed,
+	0x8f, 0x2f, 0x5a, 0x5b, 0xa8, 0x01, 0xee, 0xf0, 0x76, 0xe8, 0x8f, 0x86, 0x67, 0xa3, 0xf3, 0x96,
+	0x83, 0xea, 0xb0, 0x33, 0x3a, 0x1f, 0xde, 0x1a, 0xae, 0x74, 0xd6, 0xfa, 0xf4, 0xd8, 0x76, 0x3e,
+	0x3f, 0xb6, 0x9d, 0x2f, 0x8f, 0x6d, 0xe7, 0xfd, 0xd7, 0xf6, 0xd6, 0xb4, 0x6a, 0x7f, 0x36, 0x27,
+	0xdf, 0x07, 0x00, 0xfa, 0x0a, 0xd7, 0xb4, 0xba, 0x04, 0x00, 0x00,
 }
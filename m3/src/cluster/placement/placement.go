@@ -429,9 +429,10 @@ func NewInstanceFromProto(instance *placementpb.Instance) (Instance, error) {
 	if err != nil {
 		return nil, err
 	}
-	debugPort := uint32(0)
+	var metadata InstanceMetadata
 	if instance.Metadata != nil {
-		debugPort = instance.Metadata.DebugPort
+		metadata.DebugPort = instance.Metadata.DebugPort
+		metadata.GRPCPort = instance.Metadata.GrpcPort
 	}
 
 	return NewInstance().
@@ -444,9 +445,7 @@ func NewInstanceFromProto(instance *placementpb.Instance) (Instance, error) {
 		SetShardSetID(instance.ShardSetId).
 		SetHostname(instance.Hostname).
 		SetPort(instance.Port).
-		SetMetadata(InstanceMetadata{
-			DebugPort: debugPort,
-		}), nil
+		SetMetadata(metadata), nil
 }
 
 type instance struct {
@@ -577,6 +576,7 @@ func (i *instance) Proto() (*placementpb.Instance, error) {
 		Port:           i.Port(),
 		Metadata: &placementpb.InstanceMetadata{
 			DebugPort: i.Metadata().DebugPort,
+			GrpcPort:  i.Metadata().GRPCPort,
 		},
 	}, nil
 }
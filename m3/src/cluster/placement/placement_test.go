@@ -396,7 +396,7 @@ func TestInstance(t *testing.T) {
 	})
 	i1.SetShards(s)
 	description := fmt.Sprintf(
-		"Instance[ID=id, IsolationGroup=isolationGroup, Zone=zone, Weight=1, Endpoint=endpoint, Hostname=host1, Port=123, ShardSetID=0, Shards=%s, Metadata={DebugPort:456}]",
+		"Instance[ID=id, IsolationGroup=isolationGroup, Zone=zone, Weight=1, Endpoint=endpoint, Hostname=host1, Port=123, ShardSetID=0, Shards=%s, Metadata={DebugPort:456 GRPCPort:0}]",
 		s.String())
 	assert.Equal(t, description, i1.String())
 
@@ -547,7 +547,7 @@ func TestConvertBetweenProtoAndPlacement(t *testing.T) {
 				Weight:         1,
 				Shards:         protoShards,
 				ShardSetId:     1,
-				Metadata:       &placementpb.InstanceMetadata{DebugPort: 456},
+				Metadata:       &placementpb.InstanceMetadata{DebugPort: 456, GrpcPort: 9010},
 			},
 		},
 		ReplicaFactor: 2,
@@ -570,6 +570,7 @@ func TestConvertBetweenProtoAndPlacement(t *testing.T) {
 	assert.Equal(t, uint32(123), instances[0].Metadata().DebugPort)
 	assert.Equal(t, uint32(1), instances[1].ShardSetID())
 	assert.Equal(t, uint32(456), instances[1].Metadata().DebugPort)
+	assert.Equal(t, uint32(9010), instances[1].Metadata().GRPCPort)
 
 	placementProtoNew, err := p.Proto()
 	assert.NoError(t, err)
@@ -647,6 +648,7 @@ func TestPlacementInstanceToProto(t *testing.T) {
 		SetShards(shards).
 		SetMetadata(InstanceMetadata{
 			DebugPort: 123,
+			GRPCPort:  9010,
 		})
 
 	instanceProto, err := instance.Proto()
@@ -666,6 +668,7 @@ func TestPlacementInstanceToProto(t *testing.T) {
 		Shards:         protoShards,
 		Metadata: &placementpb.InstanceMetadata{
 			DebugPort: 123,
+			GrpcPort:  9010,
 		},
 	}
 
@@ -0,0 +1,110 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package simulator
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/cluster/placement"
+)
+
+const (
+	defaultImbalanceTolerance = 0.1
+)
+
+var (
+	errNoPlacementOptions         = errors.New("no placement options set")
+	errNegativeShardSize          = errors.New("shard sizes must not be negative")
+	errNegativeImbalanceTolerance = errors.New("imbalance tolerance must not be negative")
+)
+
+type options struct {
+	placementOpts             placement.Options
+	estimatedShardSizes       map[uint32]int64
+	defaultEstimatedShardSize int64
+	imbalanceTolerance        float64
+}
+
+// NewOptions creates new simulator options.
+func NewOptions() Options {
+	return &options{
+		placementOpts:      placement.NewOptions(),
+		imbalanceTolerance: defaultImbalanceTolerance,
+	}
+}
+
+func (o *options) SetPlacementOptions(value placement.Options) Options {
+	opts := *o
+	opts.placementOpts = value
+	return &opts
+}
+
+func (o *options) PlacementOptions() placement.Options {
+	return o.placementOpts
+}
+
+func (o *options) SetEstimatedShardSizes(value map[uint32]int64) Options {
+	opts := *o
+	opts.estimatedShardSizes = value
+	return &opts
+}
+
+func (o *options) EstimatedShardSizes() map[uint32]int64 {
+	return o.estimatedShardSizes
+}
+
+func (o *options) SetDefaultEstimatedShardSize(value int64) Options {
+	opts := *o
+	opts.defaultEstimatedShardSize = value
+	return &opts
+}
+
+func (o *options) DefaultEstimatedShardSize() int64 {
+	return o.defaultEstimatedShardSize
+}
+
+func (o *options) SetImbalanceTolerance(value float64) Options {
+	opts := *o
+	opts.imbalanceTolerance = value
+	return &opts
+}
+
+func (o *options) ImbalanceTolerance() float64 {
+	return o.imbalanceTolerance
+}
+
+func (o *options) Validate() error {
+	if o.placementOpts == nil {
+		return errNoPlacementOptions
+	}
+	if o.defaultEstimatedShardSize < 0 {
+		return errNegativeShardSize
+	}
+	for _, size := range o.estimatedShardSizes {
+		if size < 0 {
+			return errNegativeShardSize
+		}
+	}
+	if o.imbalanceTolerance < 0 {
+		return errNegativeImbalanceTolerance
+	}
+	return nil
+}
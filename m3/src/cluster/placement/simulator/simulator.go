@@ -0,0 +1,481 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/placement/service"
+	"github.com/m3db/m3/src/cluster/placement/storage"
+	"github.com/m3db/m3/src/cluster/shard"
+)
+
+const (
+	simulationKey = "simulation"
+)
+
+var (
+	errNoSequences = errors.New("no sequences of changes to compare")
+)
+
+type simulator struct {
+	opts Options
+}
+
+// NewSimulator creates a new placement simulator.
+func NewSimulator(opts Options) (Simulator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return &simulator{opts: opts}, nil
+}
+
+func (s *simulator) Simulate(
+	p placement.Placement,
+	changes []Change,
+) (Report, error) {
+	ps, err := s.newService(p)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var (
+		report = Report{Steps: make([]StepReport, 0, len(changes))}
+		total  = newMovement()
+	)
+	for i, change := range changes {
+		step := newMovement()
+		if err := s.apply(ps, change, step); err != nil {
+			return Report{}, fmt.Errorf("could not apply change %d (%s): %v",
+				i, change.Type.String(), err)
+		}
+		total.merge(step)
+
+		current, err := ps.Placement()
+		if err != nil {
+			return Report{}, err
+		}
+		report.Steps = append(report.Steps, StepReport{
+			Change:      change,
+			ShardsMoved: step.shardsMoved,
+			BytesMoved:  step.bytesMoved,
+			Instances:   step.instanceReports(),
+			Violations:  s.violations(current),
+			Placement:   current,
+		})
+	}
+
+	current, err := ps.Placement()
+	if err != nil {
+		return Report{}, err
+	}
+	report.ShardsMoved = total.shardsMoved
+	report.BytesMoved = total.bytesMoved
+	report.Instances = total.instanceReports()
+	for _, instance := range report.Instances {
+		if instance.PeakBytes > report.PeakBytes {
+			report.PeakBytes = instance.PeakBytes
+		}
+	}
+	report.Violations = s.violations(current)
+	report.Placement = current
+	return report, nil
+}
+
+func (s *simulator) Compare(
+	p placement.Placement,
+	sequences []Sequence,
+) (Comparison, error) {
+	if len(sequences) == 0 {
+		return Comparison{}, errNoSequences
+	}
+
+	comparison := Comparison{Reports: make([]Report, 0, len(sequences))}
+	for _, sequence := range sequences {
+		report, err := s.Simulate(p, sequence.Changes)
+		if err != nil {
+			return Comparison{}, fmt.Errorf("could not simulate sequence %s: %v",
+				sequence.Name, err)
+		}
+		comparison.Reports = append(comparison.Reports, report)
+	}
+
+	for i, report := range comparison.Reports {
+		if isBetter(report, comparison.Reports[comparison.Best]) {
+			comparison.Best = i
+		}
+	}
+	return comparison, nil
+}
+
+func isBetter(r, than Report) bool {
+	if len(r.Violations) != len(than.Violations) {
+		return len(r.Violations) < len(than.Violations)
+	}
+	if r.BytesMoved != than.BytesMoved {
+		return r.BytesMoved < than.BytesMoved
+	}
+	return r.PeakBytes < than.PeakBytes
+}
+
+// newService returns a placement service backed by an in memory store
+// holding the placement, so changes can be applied without persisting them.
+func (s *simulator) newService(p placement.Placement) (placement.Service, error) {
+	opts := s.opts.PlacementOptions().
+		SetDryrun(false).
+		// NB: Staged placements require increasing cutover times, which are
+		// not meaningful in a simulation.
+		SetIsStaged(false).
+		// NB: Transitional shard states are kept to track the shards moved.
+		SetShardStateMode(placement.IncludeTransitionalShardStates).
+		// NB: Shard movement is completed after each change, regardless of
+		// the time the shards would be cutover or cutoff.
+		SetIsShardCutoverFn(allowShard).
+		SetIsShardCutoffFn(allowShard)
+
+	ps := service.NewPlacementService(
+		storage.NewPlacementStorage(mem.NewStore(), simulationKey, opts), opts)
+	if _, err := ps.Set(p.Clone()); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+func allowShard(shard.Shard) error {
+	return nil
+}
+
+func (s *simulator) apply(
+	ps placement.Service,
+	change Change,
+	m *movement,
+) error {
+	switch change.Type {
+	case AddInstancesChange:
+		return s.applyOp(ps, m, func() (placement.Placement, error) {
+			p, _, err := ps.AddInstances(change.Instances)
+			return p, err
+		})
+	case RemoveInstancesChange:
+		return s.applyOp(ps, m, func() (placement.Placement, error) {
+			return ps.RemoveInstances(change.LeavingInstanceIDs)
+		})
+	case ReplaceInstancesChange:
+		return s.applyOp(ps, m, func() (placement.Placement, error) {
+			p, _, err := ps.ReplaceInstances(change.LeavingInstanceIDs, change.Instances)
+			return p, err
+		})
+	case UpdateInstancesChange:
+		for _, update := range change.Instances {
+			if err := s.applyUpdate(ps, update, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown change type %d", change.Type)
+}
+
+// applyUpdate updates the weight and isolation group of an instance, the
+// shards moved are those owned by different instances once the update
+// completes rather than those moved by each operation of the update.
+func (s *simulator) applyUpdate(
+	ps placement.Service,
+	update placement.Instance,
+	m *movement,
+) error {
+	before, err := ps.Placement()
+	if err != nil {
+		return err
+	}
+	existing, ok := before.Instance(update.ID())
+	if !ok {
+		return fmt.Errorf("instance %s does not exist in placement", update.ID())
+	}
+
+	removed, err := ps.RemoveInstances([]string{update.ID()})
+	if err != nil {
+		return err
+	}
+
+	// NB: the algorithm has no update path so the instance is removed and
+	// added back, and only the net movement between the placements before
+	// and after is recorded.
+	var added placement.Instance
+	if update.Weight() != 0 && update.Weight() != existing.Weight() {
+		// Leaving instances are not loaded by their weight when added back,
+		// so the removal completes and the instance is added back as a new
+		// instance.
+		if _, err := ps.MarkAllShardsAvailable(); err != nil {
+			return err
+		}
+		added = existing.Clone().
+			SetShards(shard.NewShards(nil)).
+			SetWeight(update.Weight())
+		if update.IsolationGroup() != "" {
+			added.SetIsolationGroup(update.IsolationGroup())
+		}
+	} else {
+		// The instance is added back while leaving so it reclaims the shards
+		// it can keep in its new isolation group.
+		leaving, ok := removed.Instance(update.ID())
+		if !ok {
+			return fmt.Errorf("instance %s does not exist in placement", update.ID())
+		}
+		if update.IsolationGroup() != "" {
+			leaving.SetIsolationGroup(update.IsolationGroup())
+		}
+		if _, err := ps.CheckAndSet(removed, removed.Version()); err != nil {
+			return err
+		}
+		added = leaving
+	}
+	if _, _, err := ps.AddInstances([]placement.Instance{added}); err != nil {
+		return err
+	}
+	after, err := ps.MarkAllShardsAvailable()
+	if err != nil {
+		return err
+	}
+	s.recordDiff(before, after, m)
+	return nil
+}
+
+// applyOp applies a placement operation, records the shards it moves and
+// then completes the movement by marking all shards available.
+func (s *simulator) applyOp(
+	ps placement.Service,
+	m *movement,
+	op func() (placement.Placement, error),
+) error {
+	p, err := op()
+	if err != nil {
+		return err
+	}
+	s.record(p, m)
+	_, err = ps.MarkAllShardsAvailable()
+	return err
+}
+
+func (s *simulator) record(p placement.Placement, m *movement) {
+	for _, instance := range p.Instances() {
+		report := m.instance(instance)
+
+		var (
+			shards = instance.Shards().All()
+			bytes  int64
+		)
+		for _, sh := range shards {
+			size := s.shardSize(sh.ID())
+			bytes += size
+			if sh.State() != shard.Initializing {
+				continue
+			}
+
+			m.shardsMoved++
+			m.bytesMoved += size
+			report.ShardsIn++
+			report.BytesIn += size
+			if sourceID := sh.SourceID(); sourceID != "" {
+				if source, ok := p.Instance(sourceID); ok {
+					sourceReport := m.instance(source)
+					sourceReport.ShardsOut++
+					sourceReport.BytesOut += size
+				}
+			}
+		}
+
+		if len(shards) > report.PeakShards {
+			report.PeakShards = len(shards)
+		}
+		if bytes > report.PeakBytes {
+			report.PeakBytes = bytes
+		}
+	}
+}
+
+// recordDiff records the shards moved between two placements with all shards
+// available, a shard is moved to each instance that owns it after but not
+// before, from one of the instances that owned it before but not after.
+func (s *simulator) recordDiff(before, after placement.Placement, m *movement) {
+	var (
+		beforeOwners = shardOwners(before)
+		afterOwners  = shardOwners(after)
+	)
+	for _, instance := range after.Instances() {
+		report := m.instance(instance)
+
+		var (
+			shards   = instance.Shards().All()
+			numPeak  = len(shards)
+			bytes    int64
+			previous shard.Shards
+		)
+		if p, ok := before.Instance(instance.ID()); ok {
+			previous = p.Shards()
+		}
+		for _, sh := range shards {
+			size := s.shardSize(sh.ID())
+			bytes += size
+			if previous != nil && previous.Contains(sh.ID()) {
+				continue
+			}
+
+			m.shardsMoved++
+			m.bytesMoved += size
+			report.ShardsIn++
+			report.BytesIn += size
+
+			// NB: pair the new owners of the shard with the instances that
+			// no longer own it so each of those sends the shard once.
+			var (
+				lost   = difference(beforeOwners[sh.ID()], afterOwners[sh.ID()])
+				gained = difference(afterOwners[sh.ID()], beforeOwners[sh.ID()])
+			)
+			if len(lost) == 0 {
+				continue
+			}
+			source := lost[0]
+			if i := sort.SearchStrings(gained, instance.ID()); i < len(lost) {
+				source = lost[i]
+			}
+			if sourceInstance, ok := before.Instance(source); ok {
+				sourceReport := m.instance(sourceInstance)
+				sourceReport.ShardsOut++
+				sourceReport.BytesOut += size
+			}
+		}
+
+		// NB: the shards moved off the instance are held until the shards
+		// moved to the instance are available, so the peak is the union.
+		if previous != nil {
+			for _, sh := range previous.All() {
+				if !instance.Shards().Contains(sh.ID()) {
+					numPeak++
+					bytes += s.shardSize(sh.ID())
+				}
+			}
+		}
+		if numPeak > report.PeakShards {
+			report.PeakShards = numPeak
+		}
+		if bytes > report.PeakBytes {
+			report.PeakBytes = bytes
+		}
+	}
+}
+
+func shardOwners(p placement.Placement) map[uint32][]string {
+	owners := make(map[uint32][]string)
+	for _, instance := range p.Instances() {
+		for _, id := range instance.Shards().AllIDs() {
+			owners[id] = append(owners[id], instance.ID())
+		}
+	}
+	for _, ids := range owners {
+		sort.Strings(ids)
+	}
+	return owners
+}
+
+// difference returns the IDs in a that are not in b.
+func difference(a, b []string) []string {
+	var result []string
+	for _, id := range a {
+		found := false
+		for _, other := range b {
+			if id == other {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func (s *simulator) shardSize(id uint32) int64 {
+	if size, ok := s.opts.EstimatedShardSizes()[id]; ok {
+		return size
+	}
+	return s.opts.DefaultEstimatedShardSize()
+}
+
+type movement struct {
+	shardsMoved int
+	bytesMoved  int64
+	instances   map[string]*InstanceReport
+}
+
+func newMovement() *movement {
+	return &movement{instances: make(map[string]*InstanceReport)}
+}
+
+func (m *movement) instance(instance placement.Instance) *InstanceReport {
+	report, ok := m.instances[instance.ID()]
+	if !ok {
+		report = &InstanceReport{ID: instance.ID()}
+		m.instances[instance.ID()] = report
+	}
+	report.IsolationGroup = instance.IsolationGroup()
+	return report
+}
+
+func (m *movement) merge(other *movement) {
+	m.shardsMoved += other.shardsMoved
+	m.bytesMoved += other.bytesMoved
+	for id, o := range other.instances {
+		report, ok := m.instances[id]
+		if !ok {
+			r := *o
+			m.instances[id] = &r
+			continue
+		}
+		report.IsolationGroup = o.IsolationGroup
+		report.ShardsIn += o.ShardsIn
+		report.ShardsOut += o.ShardsOut
+		report.BytesIn += o.BytesIn
+		report.BytesOut += o.BytesOut
+		if o.PeakShards > report.PeakShards {
+			report.PeakShards = o.PeakShards
+		}
+		if o.PeakBytes > report.PeakBytes {
+			report.PeakBytes = o.PeakBytes
+		}
+	}
+}
+
+func (m *movement) instanceReports() []InstanceReport {
+	reports := make([]InstanceReport, 0, len(m.instances))
+	for _, report := range m.instances {
+		reports = append(reports, *report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].ID < reports[j].ID
+	})
+	return reports
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package simulator
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/placement/algo"
+	"github.com/m3db/m3/src/cluster/shard"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testShardSize = 100
+	testNumShards = 12
+)
+
+func newTestPlacement(t *testing.T, rf int, instances ...placement.Instance) placement.Placement {
+	shards := make([]uint32, testNumShards)
+	for i := range shards {
+		shards[i] = uint32(i)
+	}
+
+	a := algo.NewAlgorithm(placement.NewOptions())
+	p, err := a.InitialPlacement(instances, shards, rf)
+	require.NoError(t, err)
+	p, _, err = a.MarkAllShardsAvailable(p)
+	require.NoError(t, err)
+	return p
+}
+
+func newTestSimulator(t *testing.T) Simulator {
+	s, err := NewSimulator(NewOptions().SetDefaultEstimatedShardSize(testShardSize))
+	require.NoError(t, err)
+	return s
+}
+
+func newTestInstance(id, isolationGroup string, weight uint32) placement.Instance {
+	return placement.NewEmptyInstance(id, isolationGroup, "z1", id+":9000", weight)
+}
+
+func findInstanceReport(t *testing.T, reports []InstanceReport, id string) InstanceReport {
+	for _, report := range reports {
+		if report.ID == id {
+			return report
+		}
+	}
+	require.FailNow(t, "instance report not found", id)
+	return InstanceReport{}
+}
+
+func TestSimulateAddInstance(t *testing.T) {
+	p := newTestPlacement(t, 3,
+		newTestInstance("i1", "r1", 1),
+		newTestInstance("i2", "r2", 1),
+		newTestInstance("i3", "r3", 1))
+
+	report, err := newTestSimulator(t).Simulate(p, []Change{
+		{
+			Type:      AddInstancesChange,
+			Instances: []placement.Instance{newTestInstance("i4", "r1", 1)},
+		},
+	})
+	require.NoError(t, err)
+
+	// The new instance takes half of the shards of its isolation group.
+	assert.Equal(t, 6, report.ShardsMoved)
+	assert.Equal(t, int64(6*testShardSize), report.BytesMoved)
+	assert.Equal(t, int64(testNumShards*testShardSize), report.PeakBytes)
+	assert.Empty(t, report.Violations)
+	require.Len(t, report.Steps, 1)
+	assert.Equal(t, report.Instances, report.Steps[0].Instances)
+
+	i1 := findInstanceReport(t, report.Instances, "i1")
+	assert.Equal(t, 6, i1.ShardsOut)
+	assert.Equal(t, int64(6*testShardSize), i1.BytesOut)
+	assert.Equal(t, testNumShards, i1.PeakShards)
+	i4 := findInstanceReport(t, report.Instances, "i4")
+	assert.Equal(t, 6, i4.ShardsIn)
+	assert.Equal(t, int64(6*testShardSize), i4.BytesIn)
+	assert.Equal(t, 6, i4.PeakShards)
+	i2 := findInstanceReport(t, report.Instances, "i2")
+	assert.Equal(t, 0, i2.ShardsIn+i2.ShardsOut)
+
+	// The simulated placement has the shards available and the input
+	// placement is untouched.
+	added, ok := report.Placement.Instance("i4")
+	require.True(t, ok)
+	assert.Equal(t, 6, added.Shards().NumShardsForState(shard.Available))
+	_, ok = p.Instance("i4")
+	assert.False(t, ok)
+}
+
+func TestSimulateUpdateInstance(t *testing.T) {
+	p := newTestPlacement(t, 1,
+		newTestInstance("i1", "r1", 1),
+		newTestInstance("i2", "r2", 1))
+
+	result, err := newTestSimulator(t).Simulate(p, []Change{
+		{
+			Type:      UpdateInstancesChange,
+			Instances: []placement.Instance{newTestInstance("i1", "r3", 0)},
+		},
+	})
+	require.NoError(t, err)
+
+	updated, ok := result.Placement.Instance("i1")
+	require.True(t, ok)
+	assert.Equal(t, "r3", updated.IsolationGroup())
+	assert.Equal(t, uint32(1), updated.Weight())
+	assert.Equal(t, testNumShards/2, updated.Shards().NumShards())
+
+	// The instance keeps its shards since its new isolation group has no
+	// other replica of them.
+	i1 := findInstanceReport(t, result.Instances, "i1")
+	assert.Equal(t, 0, i1.ShardsOut)
+	assert.Equal(t, 0, i1.ShardsIn)
+	assert.Equal(t, testNumShards/2, i1.PeakShards)
+	assert.Equal(t, 0, result.ShardsMoved)
+	assert.Empty(t, result.Violations)
+}
+
+func TestSimulateUpdateInstanceWeight(t *testing.T) {
+	p := newTestPlacement(t, 1,
+		newTestInstance("i1", "r1", 1),
+		newTestInstance("i2", "r2", 1),
+		newTestInstance("i3", "r3", 1))
+
+	result, err := newTestSimulator(t).Simulate(p, []Change{
+		{
+			Type:      UpdateInstancesChange,
+			Instances: []placement.Instance{newTestInstance("i1", "", 2)},
+		},
+	})
+	require.NoError(t, err)
+
+	updated, ok := result.Placement.Instance("i1")
+	require.True(t, ok)
+	assert.Equal(t, "r1", updated.IsolationGroup())
+	assert.Equal(t, uint32(2), updated.Weight())
+	assert.Equal(t, testNumShards/2, updated.Shards().NumShards())
+
+	// The shards the instance is assigned when added back are picked by the
+	// algorithm, the movement is the net difference with the shards it owned.
+	i1 := findInstanceReport(t, result.Instances, "i1")
+	assert.Equal(t, 2, i1.ShardsIn-i1.ShardsOut)
+	assert.Equal(t, int64(i1.ShardsIn*testShardSize), i1.BytesIn)
+	assert.Equal(t, testNumShards/3+i1.ShardsIn, i1.PeakShards)
+	i2 := findInstanceReport(t, result.Instances, "i2")
+	i3 := findInstanceReport(t, result.Instances, "i3")
+	assert.Equal(t, i1.ShardsIn, i2.ShardsOut+i3.ShardsOut)
+	assert.Equal(t, i1.ShardsOut, i2.ShardsIn+i3.ShardsIn)
+	assert.Equal(t, i1.ShardsIn+i1.ShardsOut, result.ShardsMoved)
+	assert.Empty(t, result.Violations)
+}
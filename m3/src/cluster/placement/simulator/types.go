@@ -0,0 +1,286 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package simulator simulates placement changes without persisting them and
+// reports the cost of moving shards between instances based on estimated shard
+// sizes supplied by the caller.
+package simulator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/m3db/m3/src/cluster/placement"
+)
+
+// ChangeType is the type of a placement change.
+type ChangeType int
+
+const (
+	// AddInstancesChange adds the instances to the placement.
+	AddInstancesChange ChangeType = iota
+
+	// RemoveInstancesChange removes the leaving instances from the placement.
+	RemoveInstancesChange
+
+	// ReplaceInstancesChange replaces the leaving instances with the instances.
+	ReplaceInstancesChange
+
+	// UpdateInstancesChange updates the weight and isolation group of instances
+	// in the placement, a zero weight or an empty isolation group keeps the
+	// current value. Instances are removed and added back with the new values,
+	// and only the shards they own before or after but not both are moved.
+	UpdateInstancesChange
+)
+
+var (
+	validChangeTypes = []ChangeType{
+		AddInstancesChange,
+		RemoveInstancesChange,
+		ReplaceInstancesChange,
+		UpdateInstancesChange,
+	}
+)
+
+// String returns the change type as a string.
+func (t ChangeType) String() string {
+	switch t {
+	case AddInstancesChange:
+		return "add"
+	case RemoveInstancesChange:
+		return "remove"
+	case ReplaceInstancesChange:
+		return "replace"
+	case UpdateInstancesChange:
+		return "update"
+	}
+	return "unknown"
+}
+
+// ParseChangeType parses a change type from a string.
+func ParseChangeType(str string) (ChangeType, error) {
+	strs := make([]string, 0, len(validChangeTypes))
+	for _, valid := range validChangeTypes {
+		if str == valid.String() {
+			return valid, nil
+		}
+		strs = append(strs, "'"+valid.String()+"'")
+	}
+	return 0, fmt.Errorf("invalid ChangeType '%s' valid types are: %s",
+		str, strings.Join(strs, ", "))
+}
+
+// Change is a proposed change to a placement.
+type Change struct {
+	// Type is the type of the change.
+	Type ChangeType
+
+	// Instances are the candidate instances for adds and replaces, or the
+	// instances with the new weight and isolation group for updates.
+	Instances []placement.Instance
+
+	// LeavingInstanceIDs are the IDs of the instances leaving the placement
+	// for removes and replaces.
+	LeavingInstanceIDs []string
+}
+
+// Sequence is a named sequence of changes applied in order.
+type Sequence struct {
+	Name    string
+	Changes []Change
+}
+
+// InstanceReport is the shard movement to and from an instance.
+type InstanceReport struct {
+	// ID is the ID of the instance.
+	ID string
+
+	// IsolationGroup is the isolation group of the instance.
+	IsolationGroup string
+
+	// ShardsIn is the number of shards streamed to the instance.
+	ShardsIn int
+
+	// ShardsOut is the number of shards streamed from the instance.
+	ShardsOut int
+
+	// BytesIn is the number of bytes streamed to the instance.
+	BytesIn int64
+
+	// BytesOut is the number of bytes streamed from the instance.
+	BytesOut int64
+
+	// PeakShards is the most shards held by the instance while shards move,
+	// including both initializing and leaving shards.
+	PeakShards int
+
+	// PeakBytes is the most bytes held by the instance while shards move,
+	// including both initializing and leaving shards.
+	PeakBytes int64
+}
+
+// ViolationType is the type of a balance violation in a placement.
+type ViolationType int
+
+const (
+	// IsolationGroupConflict is a shard with more than one replica in the
+	// same isolation group.
+	IsolationGroupConflict ViolationType = iota
+
+	// InsufficientIsolationGroups is a placement with fewer isolation groups
+	// than replicas.
+	InsufficientIsolationGroups
+
+	// IsolationGroupImbalance is an isolation group with a share of shard
+	// replicas that differs from its share of weight by more than the
+	// imbalance tolerance.
+	IsolationGroupImbalance
+)
+
+// String returns the violation type as a string.
+func (t ViolationType) String() string {
+	switch t {
+	case IsolationGroupConflict:
+		return "isolationGroupConflict"
+	case InsufficientIsolationGroups:
+		return "insufficientIsolationGroups"
+	case IsolationGroupImbalance:
+		return "isolationGroupImbalance"
+	}
+	return "unknown"
+}
+
+// Violation is a balance violation in a placement.
+type Violation struct {
+	// Type is the type of the violation.
+	Type ViolationType
+
+	// IsolationGroup is the isolation group of the violation, if any.
+	IsolationGroup string
+
+	// Shard is the shard of an isolation group conflict.
+	Shard uint32
+
+	// Message describes the violation.
+	Message string
+}
+
+// StepReport is the result of simulating a single change.
+type StepReport struct {
+	// Change is the simulated change.
+	Change Change
+
+	// ShardsMoved is the number of shard replicas moved by the change.
+	ShardsMoved int
+
+	// BytesMoved is the number of bytes streamed by the change.
+	BytesMoved int64
+
+	// Instances is the shard movement per instance, sorted by ID.
+	Instances []InstanceReport
+
+	// Violations are the balance violations once the change is complete.
+	Violations []Violation
+
+	// Placement is the placement once the change is complete.
+	Placement placement.Placement
+}
+
+// Report is the result of simulating a sequence of changes.
+type Report struct {
+	// Steps are the results of each change in order.
+	Steps []StepReport
+
+	// ShardsMoved is the number of shard replicas moved by all changes.
+	ShardsMoved int
+
+	// BytesMoved is the number of bytes streamed by all changes.
+	BytesMoved int64
+
+	// PeakBytes is the most bytes held by any instance while shards move.
+	PeakBytes int64
+
+	// Instances is the shard movement per instance across all changes,
+	// sorted by ID.
+	Instances []InstanceReport
+
+	// Violations are the balance violations once all changes are complete.
+	Violations []Violation
+
+	// Placement is the placement once all changes are complete.
+	Placement placement.Placement
+}
+
+// Comparison is the result of simulating alternative sequences of changes.
+type Comparison struct {
+	// Reports are the reports of each sequence in order.
+	Reports []Report
+
+	// Best is the index of the sequence with the fewest violations, breaking
+	// ties by the fewest bytes moved and then the lowest peak bytes.
+	Best int
+}
+
+// Simulator simulates changes to a placement without persisting them.
+type Simulator interface {
+	// Simulate applies the changes in order to the placement, completing
+	// the shard movement of each change before the next, and reports the
+	// cost of each change.
+	Simulate(p placement.Placement, changes []Change) (Report, error)
+
+	// Compare simulates each sequence of changes against the placement.
+	Compare(p placement.Placement, sequences []Sequence) (Comparison, error)
+}
+
+// Options are the options for the simulator.
+type Options interface {
+	// SetPlacementOptions sets the options of the placement service the
+	// changes are applied with.
+	SetPlacementOptions(value placement.Options) Options
+
+	// PlacementOptions returns the options of the placement service the
+	// changes are applied with.
+	PlacementOptions() placement.Options
+
+	// SetEstimatedShardSizes sets the user supplied size in bytes of each shard.
+	SetEstimatedShardSizes(value map[uint32]int64) Options
+
+	// EstimatedShardSizes returns the user supplied size in bytes of each shard.
+	EstimatedShardSizes() map[uint32]int64
+
+	// SetDefaultEstimatedShardSize sets the size in bytes of shards without an
+	// estimated size.
+	SetDefaultEstimatedShardSize(value int64) Options
+
+	// DefaultEstimatedShardSize returns the size in bytes of shards without an
+	// estimated size.
+	DefaultEstimatedShardSize() int64
+
+	// SetImbalanceTolerance sets the fraction an isolation group's share of
+	// shard replicas may differ from its share of weight.
+	SetImbalanceTolerance(value float64) Options
+
+	// ImbalanceTolerance returns the fraction an isolation group's share of
+	// shard replicas may differ from its share of weight.
+	ImbalanceTolerance() float64
+
+	// Validate validates the options.
+	Validate() error
+}
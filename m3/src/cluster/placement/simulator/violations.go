@@ -0,0 +1,158 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/shard"
+)
+
+// violations returns the balance violations of a placement, leaving shards
+// are not counted as they are about to be removed.
+func (s *simulator) violations(p placement.Placement) []Violation {
+	if !p.IsSharded() {
+		return nil
+	}
+
+	var (
+		violations    []Violation
+		rf            = p.ReplicaFactor()
+		groupWeights  = make(map[string]uint32)
+		groupReplicas = make(map[string]int)
+		shardGroups   = make(map[uint32]map[string]int)
+		totalWeight   uint32
+	)
+	for _, instance := range p.Instances() {
+		group := instance.IsolationGroup()
+		groupWeights[group] += instance.Weight()
+		totalWeight += instance.Weight()
+		for _, sh := range instance.Shards().All() {
+			if sh.State() == shard.Leaving {
+				continue
+			}
+			groupReplicas[group]++
+			groups, ok := shardGroups[sh.ID()]
+			if !ok {
+				groups = make(map[string]int)
+				shardGroups[sh.ID()] = groups
+			}
+			groups[group]++
+		}
+	}
+
+	groups := make([]string, 0, len(groupWeights))
+	for group := range groupWeights {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	if len(groups) < rf {
+		violations = append(violations, Violation{
+			Type: InsufficientIsolationGroups,
+			Message: fmt.Sprintf("%d isolation groups for replica factor %d",
+				len(groups), rf),
+		})
+	}
+
+	shardIDs := make([]uint32, 0, len(shardGroups))
+	for id := range shardGroups {
+		shardIDs = append(shardIDs, id)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+	for _, id := range shardIDs {
+		for _, group := range groups {
+			if n := shardGroups[id][group]; n > 1 {
+				violations = append(violations, Violation{
+					Type:           IsolationGroupConflict,
+					IsolationGroup: group,
+					Shard:          id,
+					Message: fmt.Sprintf("shard %d has %d replicas in isolation group %s",
+						id, n, group),
+				})
+			}
+		}
+	}
+
+	targets := targetReplicas(groupWeights, totalWeight, float64(p.NumShards()), rf)
+	for _, group := range groups {
+		target, actual := targets[group], float64(groupReplicas[group])
+		diff := math.Abs(actual - target)
+		if diff < 1 || diff <= target*s.opts.ImbalanceTolerance() {
+			continue
+		}
+		violations = append(violations, Violation{
+			Type:           IsolationGroupImbalance,
+			IsolationGroup: group,
+			Message: fmt.Sprintf("isolation group %s has %d shard replicas, expected %.1f by weight",
+				group, groupReplicas[group], target),
+		})
+	}
+	return violations
+}
+
+// targetReplicas returns the number of shard replicas each isolation group
+// should hold by weight. An isolation group holds at most one replica of each
+// shard, so groups that would exceed it are capped and the remaining replicas
+// are spread across the other groups by weight.
+func targetReplicas(
+	groupWeights map[string]uint32,
+	totalWeight uint32,
+	numShards float64,
+	rf int,
+) map[string]float64 {
+	var (
+		targets         = make(map[string]float64, len(groupWeights))
+		remaining       = numShards * float64(rf)
+		remainingWeight = float64(totalWeight)
+	)
+	for {
+		capped := false
+		for group, weight := range groupWeights {
+			if _, ok := targets[group]; ok || remainingWeight == 0 {
+				continue
+			}
+			if remaining*float64(weight)/remainingWeight > numShards {
+				targets[group] = numShards
+				remaining -= numShards
+				remainingWeight -= float64(weight)
+				capped = true
+			}
+		}
+		if !capped {
+			break
+		}
+	}
+	for group, weight := range groupWeights {
+		if _, ok := targets[group]; ok {
+			continue
+		}
+		if remainingWeight == 0 {
+			targets[group] = 0
+			continue
+		}
+		targets[group] = remaining * float64(weight) / remainingWeight
+	}
+	return targets
+}
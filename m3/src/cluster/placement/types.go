@@ -117,6 +117,8 @@ type Instance interface {
 // InstanceMetadata represents the metadata for a single Instance in the placement.
 type InstanceMetadata struct {
 	DebugPort uint32
+	// GRPCPort is the port the instance serves its service on over gRPC, if any.
+	GRPCPort uint32
 }
 
 // Placement describes how instances are placed.
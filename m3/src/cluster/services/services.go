@@ -754,11 +754,17 @@ func NewServiceInstanceFromProto(
 	if err != nil {
 		return nil, err
 	}
+	var metadata placement.InstanceMetadata
+	if instance.Metadata != nil {
+		metadata.DebugPort = instance.Metadata.DebugPort
+		metadata.GRPCPort = instance.Metadata.GrpcPort
+	}
 	return NewServiceInstance().
 		SetServiceID(sid).
 		SetInstanceID(instance.Id).
 		SetEndpoint(instance.Endpoint).
-		SetShards(shards), nil
+		SetShards(shards).
+		SetInstanceMetadata(metadata), nil
 }
 
 // NewServiceInstanceFromPlacementInstance creates a new service instance from placement instance.
@@ -770,7 +776,8 @@ func NewServiceInstanceFromPlacementInstance(
 		SetServiceID(sid).
 		SetInstanceID(instance.ID()).
 		SetEndpoint(instance.Endpoint()).
-		SetShards(instance.Shards())
+		SetShards(instance.Shards()).
+		SetInstanceMetadata(instance.Metadata())
 }
 
 type serviceInstance struct {
@@ -778,6 +785,7 @@ type serviceInstance struct {
 	id       string
 	endpoint string
 	shards   shard.Shards
+	metadata placement.InstanceMetadata
 }
 
 func (i *serviceInstance) InstanceID() string                       { return i.id }
@@ -793,6 +801,15 @@ func (i *serviceInstance) SetServiceID(service ServiceID) ServiceInstance {
 	return i
 }
 
+func (i *serviceInstance) InstanceMetadata() placement.InstanceMetadata {
+	return i.metadata
+}
+
+func (i *serviceInstance) SetInstanceMetadata(value placement.InstanceMetadata) ServiceInstance {
+	i.metadata = value
+	return i
+}
+
 // NewAdvertisement creates a new Advertisement.
 func NewAdvertisement() Advertisement { return new(advertisement) }
 
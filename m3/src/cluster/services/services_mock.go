@@ -992,6 +992,34 @@ func (mr *MockServiceInstanceMockRecorder) SetShards(s interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShards", reflect.TypeOf((*MockServiceInstance)(nil).SetShards), s)
 }
 
+// InstanceMetadata mocks base method
+func (m *MockServiceInstance) InstanceMetadata() placement.InstanceMetadata {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceMetadata")
+	ret0, _ := ret[0].(placement.InstanceMetadata)
+	return ret0
+}
+
+// InstanceMetadata indicates an expected call of InstanceMetadata
+func (mr *MockServiceInstanceMockRecorder) InstanceMetadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceMetadata", reflect.TypeOf((*MockServiceInstance)(nil).InstanceMetadata))
+}
+
+// SetInstanceMetadata mocks base method
+func (m *MockServiceInstance) SetInstanceMetadata(value placement.InstanceMetadata) ServiceInstance {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInstanceMetadata", value)
+	ret0, _ := ret[0].(ServiceInstance)
+	return ret0
+}
+
+// SetInstanceMetadata indicates an expected call of SetInstanceMetadata
+func (mr *MockServiceInstanceMockRecorder) SetInstanceMetadata(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstanceMetadata", reflect.TypeOf((*MockServiceInstance)(nil).SetInstanceMetadata), value)
+}
+
 // MockAdvertisement is a mock of Advertisement interface
 type MockAdvertisement struct {
 	ctrl     *gomock.Controller
@@ -65,6 +65,7 @@ func TestConvertBetweenProtoAndService(t *testing.T) {
 				Endpoint:       "e2",
 				Weight:         1,
 				Shards:         protoShards,
+				Metadata:       &placementpb.InstanceMetadata{GrpcPort: 9010},
 			},
 		},
 		ReplicaFactor: 2,
@@ -87,6 +88,7 @@ func TestConvertBetweenProtoAndService(t *testing.T) {
 	assert.True(t, i1.Shards().Contains(0))
 	assert.True(t, i1.Shards().Contains(1))
 	assert.True(t, i1.Shards().Contains(2))
+	assert.Equal(t, placement.InstanceMetadata{}, i1.InstanceMetadata())
 
 	i2, err := s.Instance("i2")
 	assert.NoError(t, err)
@@ -97,6 +99,7 @@ func TestConvertBetweenProtoAndService(t *testing.T) {
 	assert.True(t, i2.Shards().Contains(0))
 	assert.True(t, i2.Shards().Contains(1))
 	assert.True(t, i2.Shards().Contains(2))
+	assert.Equal(t, uint32(9010), i2.InstanceMetadata().GRPCPort)
 }
 
 func getProtoShards(ids []uint32) []*placementpb.Shard {
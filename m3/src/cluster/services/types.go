@@ -229,6 +229,12 @@ type ServiceInstance interface {
 
 	// SetShards sets the shards of the instance.
 	SetShards(s shard.Shards) ServiceInstance
+
+	// InstanceMetadata returns the metadata of the instance in the placement.
+	InstanceMetadata() placement.InstanceMetadata
+
+	// SetInstanceMetadata sets the metadata of the instance in the placement.
+	SetInstanceMetadata(value placement.InstanceMetadata) ServiceInstance
 }
 
 // Advertisement advertises the availability of a given instance of a service.
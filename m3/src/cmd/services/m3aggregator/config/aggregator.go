@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/aggregator/aggregation/quantile/cm"
+	"github.com/m3db/m3/src/aggregator/aggregation/quantile/ddsketch"
 	"github.com/m3db/m3/src/aggregator/aggregator"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
@@ -92,6 +93,9 @@ type AggregatorConfiguration struct {
 	// Stream configuration for computing quantiles.
 	Stream streamConfiguration `yaml:"stream"`
 
+	// Sketch configuration for computing mergeable quantiles.
+	Sketch sketchConfiguration `yaml:"sketch"`
+
 	// Client configuration.
 	Client aggclient.Configuration `yaml:"client"`
 
@@ -279,6 +283,14 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	}
 	opts = opts.SetStreamOptions(streamOpts)
 
+	// Set sketch options.
+	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("sketch"))
+	sketchOpts, err := c.Sketch.NewSketchOptions(iOpts)
+	if err != nil {
+		return nil, err
+	}
+	opts = opts.SetSketchOptions(sketchOpts)
+
 	// Set administrative client.
 	// TODO(xichen): client retry threshold likely needs to be low for faster retries.
 	iOpts = instrumentOpts.SetMetricsScope(scope.SubScope("client"))
@@ -434,7 +446,7 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	counterElemPool := aggregator.NewCounterElemPool(counterElemPoolOpts)
 	opts = opts.SetCounterElemPool(counterElemPool)
 	counterElemPool.Init(func() *aggregator.CounterElem {
-		return aggregator.MustNewCounterElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, aggregator.NoPrefixNoSuffix, opts)
+		return aggregator.MustNewCounterElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, nil, applied.DefaultPipeline, 0, aggregator.NoPrefixNoSuffix, opts)
 	})
 
 	// Set timer elem pool.
@@ -443,7 +455,7 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	timerElemPool := aggregator.NewTimerElemPool(timerElemPoolOpts)
 	opts = opts.SetTimerElemPool(timerElemPool)
 	timerElemPool.Init(func() *aggregator.TimerElem {
-		return aggregator.MustNewTimerElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, aggregator.NoPrefixNoSuffix, opts)
+		return aggregator.MustNewTimerElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, nil, applied.DefaultPipeline, 0, aggregator.NoPrefixNoSuffix, opts)
 	})
 
 	// Set gauge elem pool.
@@ -452,7 +464,7 @@ func (c *AggregatorConfiguration) NewAggregatorOptions(
 	gaugeElemPool := aggregator.NewGaugeElemPool(gaugeElemPoolOpts)
 	opts = opts.SetGaugeElemPool(gaugeElemPool)
 	gaugeElemPool.Init(func() *aggregator.GaugeElem {
-		return aggregator.MustNewGaugeElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, applied.DefaultPipeline, 0, aggregator.NoPrefixNoSuffix, opts)
+		return aggregator.MustNewGaugeElem(nil, policy.EmptyStoragePolicy, aggregation.DefaultTypes, nil, applied.DefaultPipeline, 0, aggregator.NoPrefixNoSuffix, opts)
 	})
 
 	// Set entry pool.
@@ -563,6 +575,40 @@ func (c *streamConfiguration) NewStreamOptions(instrumentOpts instrument.Options
 	return opts, nil
 }
 
+// sketchConfiguration contains configuration for mergeable quantile sketches.
+type sketchConfiguration struct {
+	// Relative accuracy guaranteed for quantile values.
+	RelativeAccuracy float64 `yaml:"relativeAccuracy"`
+
+	// Maximum number of bins kept per sketch.
+	MaxNumBins int `yaml:"maxNumBins"`
+
+	// Pool of sketches.
+	SketchPool pool.ObjectPoolConfiguration `yaml:"sketchPool"`
+}
+
+func (c *sketchConfiguration) NewSketchOptions(instrumentOpts instrument.Options) (ddsketch.Options, error) {
+	opts := ddsketch.NewOptions()
+	if c.RelativeAccuracy != 0 {
+		opts = opts.SetRelativeAccuracy(c.RelativeAccuracy)
+	}
+	if c.MaxNumBins != 0 {
+		opts = opts.SetMaxNumBins(c.MaxNumBins)
+	}
+
+	scope := instrumentOpts.MetricsScope()
+	iOpts := instrumentOpts.SetMetricsScope(scope.SubScope("sketch-pool"))
+	sketchPoolOpts := c.SketchPool.NewObjectPoolOptions(iOpts)
+	sketchPool := ddsketch.NewSketchPool(sketchPoolOpts)
+	opts = opts.SetSketchPool(sketchPool)
+	sketchPool.Init(func() ddsketch.Sketch { return ddsketch.NewSketch(opts) })
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
 type placementManagerConfiguration struct {
 	KVConfig         kv.OverrideConfiguration       `yaml:"kvConfig"`
 	PlacementWatcher placement.WatcherConfiguration `yaml:"placementWatcher"`
@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/x/pool"
 	"github.com/m3db/m3/src/x/retry"
 	xserver "github.com/m3db/m3/src/x/server"
+	xtls "github.com/m3db/m3/src/x/tls"
 )
 
 // M3MsgServerConfiguration contains M3Msg server configuration.
@@ -48,9 +49,13 @@ type M3MsgServerConfiguration struct {
 func (c *M3MsgServerConfiguration) NewServerOptions(
 	instrumentOpts instrument.Options,
 ) (m3msg.Options, error) {
+	serverOpts, err := c.Server.NewOptions(instrumentOpts)
+	if err != nil {
+		return nil, err
+	}
 	opts := m3msg.NewOptions().
 		SetInstrumentOptions(instrumentOpts).
-		SetServerOptions(c.Server.NewOptions(instrumentOpts)).
+		SetServerOptions(serverOpts).
 		SetConsumerOptions(c.Consumer.NewOptions(instrumentOpts))
 	if err := opts.Validate(); err != nil {
 		return nil, err
@@ -83,12 +88,15 @@ type RawTCPServerConfiguration struct {
 
 	// Protobuf iterator configuration.
 	ProtobufIterator protobufUnaggregatedIteratorConfiguration `yaml:"protobufIterator"`
+
+	// TLS configuration, connections are plaintext if not set.
+	TLS *xtls.Configuration `yaml:"tls"`
 }
 
 // NewServerOptions create a new set of raw TCP server options.
 func (c *RawTCPServerConfiguration) NewServerOptions(
 	instrumentOpts instrument.Options,
-) rawtcp.Options {
+) (rawtcp.Options, error) {
 	opts := rawtcp.NewOptions().SetInstrumentOptions(instrumentOpts)
 
 	// Set server options.
@@ -101,6 +109,13 @@ func (c *RawTCPServerConfiguration) NewServerOptions(
 	if c.KeepAlivePeriod != nil {
 		serverOpts = serverOpts.SetTCPConnectionKeepAlivePeriod(*c.KeepAlivePeriod)
 	}
+	if c.TLS != nil {
+		tlsConfig, err := c.TLS.NewServerConfig(instrumentOpts)
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = serverOpts.SetTLSConfig(tlsConfig)
+	}
 	opts = opts.SetServerOptions(serverOpts)
 
 	// Set msgpack iterator options.
@@ -117,7 +132,7 @@ func (c *RawTCPServerConfiguration) NewServerOptions(
 	if c.ErrorLogLimitPerSecond != nil {
 		opts = opts.SetErrorLogLimitPerSecond(*c.ErrorLogLimitPerSecond)
 	}
-	return opts
+	return opts, nil
 }
 
 // msgpackUnaggregatedIteratorConfiguration contains configuration for msgpack unaggregated iterator.
@@ -793,6 +793,7 @@ func (cfg Configuration) newAggregator(o DownsamplerOptions) (agg, error) {
 			nil,
 			policy.EmptyStoragePolicy,
 			aggregation.DefaultTypes,
+			nil,
 			applied.DefaultPipeline,
 			0,
 			aggregator.WithPrefixWithSuffix,
@@ -811,6 +812,7 @@ func (cfg Configuration) newAggregator(o DownsamplerOptions) (agg, error) {
 			nil,
 			policy.EmptyStoragePolicy,
 			aggregation.DefaultTypes,
+			nil,
 			applied.DefaultPipeline,
 			0,
 			aggregator.WithPrefixWithSuffix,
@@ -829,6 +831,7 @@ func (cfg Configuration) newAggregator(o DownsamplerOptions) (agg, error) {
 			nil,
 			policy.EmptyStoragePolicy,
 			aggregation.DefaultTypes,
+			nil,
 			applied.DefaultPipeline,
 			0,
 			aggregator.WithPrefixWithSuffix,
@@ -57,7 +57,7 @@ func (c Configuration) NewServer(
 	return c.Server.NewServer(
 		h,
 		iOpts.SetMetricsScope(scope),
-	), nil
+	)
 }
 
 type handlerConfiguration struct {
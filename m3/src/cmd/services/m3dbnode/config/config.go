@@ -37,6 +37,7 @@ import (
 	"github.com/m3db/m3/src/x/instrument"
 	xlog "github.com/m3db/m3/src/x/log"
 	"github.com/m3db/m3/src/x/opentracing"
+	xtls "github.com/m3db/m3/src/x/tls"
 
 	"go.etcd.io/etcd/embed"
 	"go.etcd.io/etcd/pkg/transport"
@@ -92,6 +93,20 @@ type DBConfiguration struct {
 	// The HTTP host and port on which to listen for the cluster service.
 	HTTPClusterListenAddress string `yaml:"httpClusterListenAddress" validate:"nonzero"`
 
+	// The gRPC host and port on which to listen for the node service, if set.
+	GRPCNodeListenAddress string `yaml:"grpcNodeListenAddress"`
+
+	// The TLS configuration for the gRPC node service, if set.
+	GRPCNodeTLS *xtls.Configuration `yaml:"grpcNodeTLS"`
+
+	// Whether the gRPC server reflection service is registered, allowing
+	// tools such as grpcurl to list and call the node service methods.
+	GRPCNodeReflectionEnabled bool `yaml:"grpcNodeReflectionEnabled"`
+
+	// The max size of messages sent and received by the gRPC node service,
+	// the default is used if not set.
+	GRPCNodeMaxMessageSize int `yaml:"grpcNodeMaxMessageSize" validate:"min=0"`
+
 	// The host and port on which to listen for debug endpoints.
 	DebugListenAddress string `yaml:"debugListenAddress"`
 
@@ -369,6 +369,10 @@ func TestConfiguration(t *testing.T) {
   clusterListenAddress: 0.0.0.0:9001
   httpNodeListenAddress: 0.0.0.0:9002
   httpClusterListenAddress: 0.0.0.0:9003
+  grpcNodeListenAddress: ""
+  grpcNodeTLS: null
+  grpcNodeReflectionEnabled: false
+  grpcNodeMaxMessageSize: 0
   debugListenAddress: 0.0.0.0:9004
   hostID:
     resolver: config
@@ -407,6 +411,11 @@ func TestConfiguration(t *testing.T) {
     asyncWriteWorkerPoolSize: null
     asyncWriteMaxConcurrency: null
     useV2BatchAPIs: null
+    compression: null
+    transport: null
+    grpcPort: null
+    grpcMaxMessageSize: null
+    tls: null
     writeTimestampOffset: null
   gcPercentage: 100
   writeNewSeriesLimitPerSecond: 1048576
@@ -444,6 +453,7 @@ func TestConfiguration(t *testing.T) {
     force_index_summaries_mmap_memory: true
     force_bloom_filter_mmap_memory: true
     bloomFilterFalsePositivePercent: null
+    coldTier: null
   commitlog:
     flushMaxBytes: 524288
     flushEvery: 1s
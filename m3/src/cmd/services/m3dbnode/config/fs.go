@@ -23,6 +23,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
+
+	"github.com/m3db/m3/src/x/objectstore"
 )
 
 const (
@@ -98,6 +101,10 @@ type FilesystemConfiguration struct {
 	// BloomFilterFalsePositivePercent controls the target false positive percentage
 	// for the bloom filters for the fileset files.
 	BloomFilterFalsePositivePercent *float64 `yaml:"bloomFilterFalsePositivePercent"`
+
+	// ColdTier is the object store that the data filesets of namespaces with
+	// the cold tier enabled are offloaded to.
+	ColdTier *ColdTierConfiguration `yaml:"coldTier"`
 }
 
 // Validate validates the Filesystem configuration. We use this method to validate
@@ -257,6 +264,29 @@ func (f FilesystemConfiguration) BloomFilterFalsePositivePercentOrDefault() floa
 	return defaultBloomFilterFalsePositivePercent
 }
 
+// ColdTierConfiguration is the cold tier configuration.
+type ColdTierConfiguration struct {
+	// Store is the object store the files are offloaded to.
+	Store objectstore.Configuration `yaml:"store"`
+
+	// KeyPrefix is the prefix of the keys of the offloaded files, defaults
+	// to the host ID so that hosts sharing a store do not collide.
+	KeyPrefix *string `yaml:"keyPrefix"`
+
+	// CacheTTL is how long the offloaded files fetched back are kept
+	// locally after they were last accessed.
+	CacheTTL *time.Duration `yaml:"cacheTTL"`
+}
+
+// KeyPrefixOrDefault returns the configured key prefix if configured, or the
+// host ID otherwise.
+func (c ColdTierConfiguration) KeyPrefixOrDefault(hostID string) string {
+	if c.KeyPrefix != nil {
+		return *c.KeyPrefix
+	}
+	return hostID
+}
+
 // MmapConfiguration is the mmap configuration.
 type MmapConfiguration struct {
 	// HugeTLB is the huge pages configuration which will only take affect
@@ -30,13 +30,16 @@ import (
 	ingestm3msg "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/m3msg"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/server/m3msg"
 	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/query/api/v1/handler/frontend"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/rules"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/consolidators"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/tenant"
 	xconfig "github.com/m3db/m3/src/x/config"
 	"github.com/m3db/m3/src/x/config/listenaddress"
 	"github.com/m3db/m3/src/x/cost"
@@ -44,6 +47,7 @@ import (
 	"github.com/m3db/m3/src/x/instrument"
 	xlog "github.com/m3db/m3/src/x/log"
 	"github.com/m3db/m3/src/x/opentracing"
+	xtls "github.com/m3db/m3/src/x/tls"
 )
 
 // BackendStorageType is an enum for different backends.
@@ -106,6 +110,10 @@ type Configuration struct {
 	// ListenAddress is the server listen address.
 	ListenAddress *listenaddress.Configuration `yaml:"listenAddress" validate:"nonzero"`
 
+	// TLS is the TLS configuration for the server, if set the server only
+	// accepts HTTPS connections.
+	TLS *xtls.Configuration `yaml:"tls"`
+
 	// Filter is the read/write/complete tags filter configuration.
 	Filter FilterConfiguration `yaml:"filter"`
 
@@ -139,9 +147,16 @@ type Configuration struct {
 	// Query is the query configuration.
 	Query QueryConfiguration `yaml:"query"`
 
+	// Rules is the Prometheus recording and alerting rules configuration.
+	Rules *rules.Configuration `yaml:"rules"`
+
 	// Limits specifies limits on per-query resource usage.
 	Limits LimitsConfiguration `yaml:"limits"`
 
+	// Tenants configures the tenants of the coordinator, restricting the
+	// reads and writes of each tenant to its series and enforcing its limits.
+	Tenants *tenant.Configuration `yaml:"tenants"`
+
 	// LookbackDuration determines the lookback duration for queries
 	LookbackDuration *time.Duration `yaml:"lookbackDuration"`
 
@@ -221,6 +236,9 @@ type QueryConfiguration struct {
 	// RestrictTags is an optional configuration that can be set to restrict
 	// all queries with certain tags by.
 	RestrictTags *RestrictTagsConfiguration `yaml:"restrictTags"`
+	// Frontend is an optional configuration to split range queries into
+	// intervals executed in parallel and cache the results of the intervals.
+	Frontend *frontend.Configuration `yaml:"frontend"`
 }
 
 // TimeoutOrDefault returns the configured timeout or default value.
@@ -78,6 +78,7 @@ func main() {
 		showAll   bool
 		deleteAll bool
 		nodeName  string
+		simPath   string
 	)
 
 	logger := mustNewLogger(defaultLoggerOptions)
@@ -206,11 +207,42 @@ database creation, database init, adding a node, and replacing a node, are suppo
 		},
 	}
 
-	rootCmd.AddCommand(getCmd, applyCmd, deleteCmd)
+	simulateCmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate changes to resources on the remote without applying them",
+	}
+
+	simulatePlacementCmd := &cobra.Command{
+		Use:     "placement",
+		Short:   "Simulate sequences of placement changes against the placement of the remote endpoint",
+		Aliases: []string{"pl"},
+		Long: `This will take a yaml of alternative sequences of placement changes and
+report the shards and bytes each would move, the peak load per node while
+shards move and any isolation group balance violations.  See
+yaml/examples/simulate.yaml for an example.
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			logger.Debug("running command", zap.String("command", cmd.Name()))
+
+			if len(simPath) == 0 {
+				logger.Fatal("need to specify a path to YAML file")
+			}
+
+			resp, err := placements.DoSimulate(endPoint, headers, simPath, logger)
+			if err != nil {
+				logger.Fatal("simulate placement failed", zap.Error(err))
+			}
+
+			os.Stdout.Write(resp)
+		},
+	}
+
+	rootCmd.AddCommand(getCmd, applyCmd, deleteCmd, simulateCmd)
 	getCmd.AddCommand(getNamespaceCmd)
 	getCmd.AddCommand(getPlacementCmd)
 	deleteCmd.AddCommand(deletePlacementCmd)
 	deleteCmd.AddCommand(deleteNamespaceCmd)
+	simulateCmd.AddCommand(simulatePlacementCmd)
 
 	var headersSlice []string
 	rootCmd.PersistentFlags().BoolVarP(&debug, "debug", "d", false, "debug log output level (cannot use JSON output)")
@@ -219,6 +251,7 @@ database creation, database init, adding a node, and replacing a node, are suppo
 	applyCmd.Flags().StringVarP(&yamlPath, "file", "f", "", "times to echo the input")
 	getNamespaceCmd.Flags().BoolVarP(&showAll, "show-all", "a", false, "times to echo the input")
 	deletePlacementCmd.Flags().BoolVarP(&deleteAll, "delete-all", "a", false, "delete the entire placement")
+	simulatePlacementCmd.Flags().StringVarP(&simPath, "file", "f", "", "path to the YAML file of placement changes")
 	deleteCmd.PersistentFlags().StringVarP(&nodeName, "name", "n", "", "which namespace or node to delete")
 
 	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
@@ -0,0 +1,341 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/query/api/v1/handler/namespace"
+	"github.com/m3db/m3/src/query/api/v1/handler/placement"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/x/objectstore"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+const usage = `Usage: m3db-backup <command> [flags]
+
+Commands:
+  backup   upload the filesets of a node not uploaded by a previous backup
+  restore  restore the filesets of a node, or every node, from a backup
+  list     list the backed up hosts and their backups
+
+Run m3db-backup <command> -h for the flags of a command.
+`
+
+type storeFlags struct {
+	path        *string
+	s3Endpoint  *string
+	s3Bucket    *string
+	s3Region    *string
+	s3AccessKey *string
+	s3SecretKey *string
+}
+
+func newStoreFlags(fset *flag.FlagSet) storeFlags {
+	return storeFlags{
+		path:        fset.String("store-path", "", "Directory of a local filesystem store"),
+		s3Endpoint:  fset.String("s3-endpoint", "", "Endpoint of an S3 compatible store [e.g. http://localhost:9000]"),
+		s3Bucket:    fset.String("s3-bucket", "", "Bucket of the S3 compatible store"),
+		s3Region:    fset.String("s3-region", "", "Region of the S3 compatible store"),
+		s3AccessKey: fset.String("s3-access-key-id", os.Getenv("AWS_ACCESS_KEY_ID"), "Access key ID of the S3 compatible store"),
+		s3SecretKey: fset.String("s3-secret-access-key", os.Getenv("AWS_SECRET_ACCESS_KEY"), "Secret access key of the S3 compatible store"),
+	}
+}
+
+func (f storeFlags) store() (objectstore.Store, error) {
+	switch {
+	case *f.path != "" && *f.s3Endpoint != "":
+		return nil, fmt.Errorf("only one of -store-path and -s3-endpoint can be set")
+	case *f.path != "":
+		return objectstore.NewFilesystemStore(*f.path), nil
+	case *f.s3Endpoint != "":
+		return objectstore.NewS3Store(objectstore.S3Options{
+			Endpoint:        *f.s3Endpoint,
+			Bucket:          *f.s3Bucket,
+			Region:          *f.s3Region,
+			AccessKeyID:     *f.s3AccessKey,
+			SecretAccessKey: *f.s3SecretKey,
+		})
+	}
+	return nil, fmt.Errorf("one of -store-path or -s3-endpoint must be set")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(1)
+	}
+
+	rawLogger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalf("unable to create logger: %+v", err)
+	}
+	logger := rawLogger.Sugar()
+
+	switch os.Args[1] {
+	case "backup":
+		err = runBackup(rawLogger, os.Args[2:])
+	case "restore":
+		err = runRestore(rawLogger, os.Args[2:])
+	case "list":
+		err = runList(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(1)
+	}
+	if err != nil {
+		logger.Fatalf("%s failed: %v", os.Args[1], err)
+	}
+}
+
+func runBackup(logger *zap.Logger, args []string) error {
+	var (
+		fset           = flag.NewFlagSet("backup", flag.ExitOnError)
+		storeFlags     = newStoreFlags(fset)
+		optPathPrefix  = fset.String("path-prefix", "/var/lib/m3db", "Path prefix of the node")
+		optHostID      = fset.String("host-id", "", "Host ID of the node in the placement")
+		optCoordinator = fset.String("coordinator-url", "", "URL of a coordinator to record the namespaces and placement from [e.g. http://localhost:7201]")
+	)
+	fset.Parse(args)
+	if *optPathPrefix == "" || *optHostID == "" {
+		fset.Usage()
+		os.Exit(1)
+	}
+
+	store, err := storeFlags.store()
+	if err != nil {
+		return err
+	}
+
+	opts := backup.NewOptions().
+		SetStore(store).
+		SetHostID(*optHostID).
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(*optPathPrefix))
+	opts = opts.SetInstrumentOptions(opts.InstrumentOptions().SetLogger(logger))
+
+	if *optCoordinator != "" {
+		var namespaces admin.NamespaceGetResponse
+		if err := getJSONPB(*optCoordinator+namespace.M3DBGetURL, &namespaces); err != nil {
+			return fmt.Errorf("could not get namespaces: %v", err)
+		}
+		var placementResp admin.PlacementGetResponse
+		if err := getJSONPB(*optCoordinator+placement.M3DBGetURL, &placementResp); err != nil {
+			return fmt.Errorf("could not get placement: %v", err)
+		}
+		opts = opts.
+			SetNamespaceRegistry(namespaces.Registry).
+			SetPlacement(placementResp.Placement)
+	}
+
+	backuper, err := backup.NewBackuper(opts)
+	if err != nil {
+		return err
+	}
+	_, err = backuper.Backup()
+	return err
+}
+
+func runRestore(logger *zap.Logger, args []string) error {
+	var (
+		fset            = flag.NewFlagSet("restore", flag.ExitOnError)
+		storeFlags      = newStoreFlags(fset)
+		optPathPrefix   = fset.String("path-prefix", "", "Path prefix to restore to, every host is restored to a subdirectory named after it with -all-hosts")
+		optHostID       = fset.String("host-id", "", "Host ID of the node to restore")
+		optAllHosts     = fset.Bool("all-hosts", false, "Restore every backed up host")
+		optBackupID     = fset.String("backup-id", "", "ID of the backup to restore, defaults to the latest backup taken at or before -at")
+		optAt           = fset.String("at", "", "Restore the latest backup taken at or before this time [RFC3339], defaults to the latest backup")
+		optNamespaces   = fset.String("namespaces", "", "Comma separated namespaces to restore, defaults to all")
+		optShards       = fset.String("shards", "", "Comma separated shards to restore, defaults to all")
+		optBlockStart   = fset.String("block-start", "", "Restore blocks starting at or after this time [RFC3339]")
+		optBlockEnd     = fset.String("block-end", "", "Restore blocks starting before this time [RFC3339]")
+		optOverwrite    = fset.Bool("overwrite", false, "Overwrite files that already exist")
+		optMetadataPath = fset.String("metadata-path", "", "Directory to write the namespaces and placement recorded in the backup to")
+	)
+	fset.Parse(args)
+	if *optPathPrefix == "" || (*optHostID == "") == !*optAllHosts {
+		fset.Usage()
+		os.Exit(1)
+	}
+
+	store, err := storeFlags.store()
+	if err != nil {
+		return err
+	}
+
+	restoreOpts := backup.RestoreOptions{
+		BackupID:       *optBackupID,
+		FilePathPrefix: *optPathPrefix,
+		Overwrite:      *optOverwrite,
+	}
+	if restoreOpts.At, err = parseTime(*optAt); err != nil {
+		return err
+	}
+	if restoreOpts.Start, err = parseTime(*optBlockStart); err != nil {
+		return err
+	}
+	if restoreOpts.End, err = parseTime(*optBlockEnd); err != nil {
+		return err
+	}
+	if *optNamespaces != "" {
+		restoreOpts.Namespaces = strings.Split(*optNamespaces, ",")
+	}
+	if *optShards != "" {
+		for _, s := range strings.Split(*optShards, ",") {
+			shard, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid shard %q: %v", s, err)
+			}
+			restoreOpts.Shards = append(restoreOpts.Shards, uint32(shard))
+		}
+	}
+
+	opts := backup.NewOptions().SetStore(store)
+	opts = opts.SetInstrumentOptions(opts.InstrumentOptions().SetLogger(logger))
+	restorer, err := backup.NewRestorer(opts)
+	if err != nil {
+		return err
+	}
+
+	hosts := []string{*optHostID}
+	if *optAllHosts {
+		if hosts, err = restorer.Hosts(); err != nil {
+			return err
+		}
+	}
+	for _, host := range hosts {
+		hostOpts := restoreOpts
+		hostOpts.HostID = host
+		if *optAllHosts {
+			hostOpts.FilePathPrefix = filepath.Join(*optPathPrefix, host)
+		}
+
+		result, err := restorer.Restore(hostOpts)
+		if err != nil {
+			return fmt.Errorf("could not restore host %s: %v", host, err)
+		}
+		if *optMetadataPath != "" {
+			if err := writeMetadata(*optMetadataPath, result.Manifest); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func runList(args []string) error {
+	var (
+		fset       = flag.NewFlagSet("list", flag.ExitOnError)
+		storeFlags = newStoreFlags(fset)
+		optHostID  = fset.String("host-id", "", "Host ID to list the backups of, defaults to all")
+	)
+	fset.Parse(args)
+
+	store, err := storeFlags.store()
+	if err != nil {
+		return err
+	}
+	restorer, err := backup.NewRestorer(backup.NewOptions().SetStore(store))
+	if err != nil {
+		return err
+	}
+
+	hosts := []string{*optHostID}
+	if *optHostID == "" {
+		if hosts, err = restorer.Hosts(); err != nil {
+			return err
+		}
+	}
+	for _, host := range hosts {
+		ids, err := restorer.Backups(host)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			fmt.Printf("%s\t%s\n", host, id)
+		}
+	}
+	return nil
+}
+
+// writeMetadata writes the namespaces and placement of a backup in the
+// format of the coordinator namespace and placement APIs.
+func writeMetadata(dir string, manifest backup.Manifest) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for name, data := range map[string][]byte{
+		"namespaces.json": wrapJSON("registry", manifest.Namespaces),
+		"placement.json":  wrapJSON("placement", manifest.Placement),
+	} {
+		if data == nil {
+			continue
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func wrapJSON(field string, data []byte) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+	return []byte(fmt.Sprintf("{%q:%s}\n", field, bytes.TrimSpace(data)))
+}
+
+func getJSONPB(url string, msg proto.Message) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+	}
+	return (&jsonpb.Unmarshaler{AllowUnknownFields: true}).Unmarshal(resp.Body, msg)
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: %v", value, err)
+	}
+	return t, nil
+}
@@ -25,6 +25,7 @@
 package client
 
 import (
+	"crypto/tls"
 	"reflect"
 	"time"
 
@@ -965,6 +966,36 @@ func (mr *MockAdminSessionMockRecorder) Truncate(namespace interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockAdminSession)(nil).Truncate), namespace)
 }
 
+// DeleteSeries mocks base method
+func (m *MockAdminSession) DeleteSeries(namespace ident.ID, query index.Query, start, end time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", namespace, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries
+func (mr *MockAdminSessionMockRecorder) DeleteSeries(namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockAdminSession)(nil).DeleteSeries), namespace, query, start, end)
+}
+
+// IndexCardinality mocks base method
+func (m *MockAdminSession) IndexCardinality(namespace ident.ID, start, end time.Time, opts index.CardinalityOptions) ([]index.BlockCardinality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexCardinality", namespace, start, end, opts)
+	ret0, _ := ret[0].([]index.BlockCardinality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexCardinality indicates an expected call of IndexCardinality
+func (mr *MockAdminSessionMockRecorder) IndexCardinality(namespace, start, end, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexCardinality", reflect.TypeOf((*MockAdminSession)(nil).IndexCardinality), namespace, start, end, opts)
+}
+
 // FetchBootstrapBlocksFromPeers mocks base method
 func (m *MockAdminSession) FetchBootstrapBlocksFromPeers(namespace namespace.Metadata, shard uint32, start, end time.Time, opts result.Options) (result.ShardResult, error) {
 	m.ctrl.T.Helper()
@@ -2420,6 +2451,146 @@ func (mr *MockOptionsMockRecorder) UseV2BatchAPIs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseV2BatchAPIs", reflect.TypeOf((*MockOptions)(nil).UseV2BatchAPIs))
 }
 
+// SetCompression mocks base method
+func (m *MockOptions) SetCompression(value rpc.CompressionType) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompression", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetCompression indicates an expected call of SetCompression
+func (mr *MockOptionsMockRecorder) SetCompression(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompression", reflect.TypeOf((*MockOptions)(nil).SetCompression), value)
+}
+
+// Compression mocks base method
+func (m *MockOptions) Compression() rpc.CompressionType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compression")
+	ret0, _ := ret[0].(rpc.CompressionType)
+	return ret0
+}
+
+// Compression indicates an expected call of Compression
+func (mr *MockOptionsMockRecorder) Compression() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compression", reflect.TypeOf((*MockOptions)(nil).Compression))
+}
+
+// SetTransport mocks base method
+func (m *MockOptions) SetTransport(value TransportType) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransport", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTransport indicates an expected call of SetTransport
+func (mr *MockOptionsMockRecorder) SetTransport(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransport", reflect.TypeOf((*MockOptions)(nil).SetTransport), value)
+}
+
+// Transport mocks base method
+func (m *MockOptions) Transport() TransportType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transport")
+	ret0, _ := ret[0].(TransportType)
+	return ret0
+}
+
+// Transport indicates an expected call of Transport
+func (mr *MockOptionsMockRecorder) Transport() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transport", reflect.TypeOf((*MockOptions)(nil).Transport))
+}
+
+// SetGRPCPort mocks base method
+func (m *MockOptions) SetGRPCPort(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGRPCPort", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetGRPCPort indicates an expected call of SetGRPCPort
+func (mr *MockOptionsMockRecorder) SetGRPCPort(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCPort", reflect.TypeOf((*MockOptions)(nil).SetGRPCPort), value)
+}
+
+// GRPCPort mocks base method
+func (m *MockOptions) GRPCPort() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCPort")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GRPCPort indicates an expected call of GRPCPort
+func (mr *MockOptionsMockRecorder) GRPCPort() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCPort", reflect.TypeOf((*MockOptions)(nil).GRPCPort))
+}
+
+// SetGRPCMaxMessageSize mocks base method
+func (m *MockOptions) SetGRPCMaxMessageSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGRPCMaxMessageSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetGRPCMaxMessageSize indicates an expected call of SetGRPCMaxMessageSize
+func (mr *MockOptionsMockRecorder) SetGRPCMaxMessageSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCMaxMessageSize", reflect.TypeOf((*MockOptions)(nil).SetGRPCMaxMessageSize), value)
+}
+
+// GRPCMaxMessageSize mocks base method
+func (m *MockOptions) GRPCMaxMessageSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCMaxMessageSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GRPCMaxMessageSize indicates an expected call of GRPCMaxMessageSize
+func (mr *MockOptionsMockRecorder) GRPCMaxMessageSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCMaxMessageSize", reflect.TypeOf((*MockOptions)(nil).GRPCMaxMessageSize))
+}
+
+// SetTLSConfig mocks base method
+func (m *MockOptions) SetTLSConfig(value *tls.Config) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTLSConfig", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTLSConfig indicates an expected call of SetTLSConfig
+func (mr *MockOptionsMockRecorder) SetTLSConfig(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTLSConfig", reflect.TypeOf((*MockOptions)(nil).SetTLSConfig), value)
+}
+
+// TLSConfig mocks base method
+func (m *MockOptions) TLSConfig() *tls.Config {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TLSConfig")
+	ret0, _ := ret[0].(*tls.Config)
+	return ret0
+}
+
+// TLSConfig indicates an expected call of TLSConfig
+func (mr *MockOptionsMockRecorder) TLSConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TLSConfig", reflect.TypeOf((*MockOptions)(nil).TLSConfig))
+}
+
 // SetIterationOptions mocks base method
 func (m *MockOptions) SetIterationOptions(arg0 index.IterationOptions) Options {
 	m.ctrl.T.Helper()
@@ -3899,6 +4070,146 @@ func (mr *MockAdminOptionsMockRecorder) UseV2BatchAPIs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseV2BatchAPIs", reflect.TypeOf((*MockAdminOptions)(nil).UseV2BatchAPIs))
 }
 
+// SetCompression mocks base method
+func (m *MockAdminOptions) SetCompression(value rpc.CompressionType) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompression", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetCompression indicates an expected call of SetCompression
+func (mr *MockAdminOptionsMockRecorder) SetCompression(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompression", reflect.TypeOf((*MockAdminOptions)(nil).SetCompression), value)
+}
+
+// Compression mocks base method
+func (m *MockAdminOptions) Compression() rpc.CompressionType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compression")
+	ret0, _ := ret[0].(rpc.CompressionType)
+	return ret0
+}
+
+// Compression indicates an expected call of Compression
+func (mr *MockAdminOptionsMockRecorder) Compression() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compression", reflect.TypeOf((*MockAdminOptions)(nil).Compression))
+}
+
+// SetTransport mocks base method
+func (m *MockAdminOptions) SetTransport(value TransportType) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransport", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTransport indicates an expected call of SetTransport
+func (mr *MockAdminOptionsMockRecorder) SetTransport(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransport", reflect.TypeOf((*MockAdminOptions)(nil).SetTransport), value)
+}
+
+// Transport mocks base method
+func (m *MockAdminOptions) Transport() TransportType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transport")
+	ret0, _ := ret[0].(TransportType)
+	return ret0
+}
+
+// Transport indicates an expected call of Transport
+func (mr *MockAdminOptionsMockRecorder) Transport() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transport", reflect.TypeOf((*MockAdminOptions)(nil).Transport))
+}
+
+// SetGRPCPort mocks base method
+func (m *MockAdminOptions) SetGRPCPort(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGRPCPort", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetGRPCPort indicates an expected call of SetGRPCPort
+func (mr *MockAdminOptionsMockRecorder) SetGRPCPort(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCPort", reflect.TypeOf((*MockAdminOptions)(nil).SetGRPCPort), value)
+}
+
+// GRPCPort mocks base method
+func (m *MockAdminOptions) GRPCPort() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCPort")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GRPCPort indicates an expected call of GRPCPort
+func (mr *MockAdminOptionsMockRecorder) GRPCPort() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCPort", reflect.TypeOf((*MockAdminOptions)(nil).GRPCPort))
+}
+
+// SetGRPCMaxMessageSize mocks base method
+func (m *MockAdminOptions) SetGRPCMaxMessageSize(value int) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGRPCMaxMessageSize", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetGRPCMaxMessageSize indicates an expected call of SetGRPCMaxMessageSize
+func (mr *MockAdminOptionsMockRecorder) SetGRPCMaxMessageSize(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGRPCMaxMessageSize", reflect.TypeOf((*MockAdminOptions)(nil).SetGRPCMaxMessageSize), value)
+}
+
+// GRPCMaxMessageSize mocks base method
+func (m *MockAdminOptions) GRPCMaxMessageSize() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCMaxMessageSize")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GRPCMaxMessageSize indicates an expected call of GRPCMaxMessageSize
+func (mr *MockAdminOptionsMockRecorder) GRPCMaxMessageSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCMaxMessageSize", reflect.TypeOf((*MockAdminOptions)(nil).GRPCMaxMessageSize))
+}
+
+// SetTLSConfig mocks base method
+func (m *MockAdminOptions) SetTLSConfig(value *tls.Config) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTLSConfig", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTLSConfig indicates an expected call of SetTLSConfig
+func (mr *MockAdminOptionsMockRecorder) SetTLSConfig(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTLSConfig", reflect.TypeOf((*MockAdminOptions)(nil).SetTLSConfig), value)
+}
+
+// TLSConfig mocks base method
+func (m *MockAdminOptions) TLSConfig() *tls.Config {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TLSConfig")
+	ret0, _ := ret[0].(*tls.Config)
+	return ret0
+}
+
+// TLSConfig indicates an expected call of TLSConfig
+func (mr *MockAdminOptionsMockRecorder) TLSConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TLSConfig", reflect.TypeOf((*MockAdminOptions)(nil).TLSConfig))
+}
+
 // SetIterationOptions mocks base method
 func (m *MockAdminOptions) SetIterationOptions(arg0 index.IterationOptions) Options {
 	m.ctrl.T.Helper()
@@ -4438,6 +4749,36 @@ func (mr *MockclientSessionMockRecorder) Truncate(namespace interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockclientSession)(nil).Truncate), namespace)
 }
 
+// DeleteSeries mocks base method
+func (m *MockclientSession) DeleteSeries(namespace ident.ID, query index.Query, start, end time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", namespace, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries
+func (mr *MockclientSessionMockRecorder) DeleteSeries(namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockclientSession)(nil).DeleteSeries), namespace, query, start, end)
+}
+
+// IndexCardinality mocks base method
+func (m *MockclientSession) IndexCardinality(namespace ident.ID, start, end time.Time, opts index.CardinalityOptions) ([]index.BlockCardinality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexCardinality", namespace, start, end, opts)
+	ret0, _ := ret[0].([]index.BlockCardinality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexCardinality indicates an expected call of IndexCardinality
+func (mr *MockclientSessionMockRecorder) IndexCardinality(namespace, start, end, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexCardinality", reflect.TypeOf((*MockclientSession)(nil).IndexCardinality), namespace, start, end, opts)
+}
+
 // FetchBootstrapBlocksFromPeers mocks base method
 func (m *MockclientSession) FetchBootstrapBlocksFromPeers(namespace namespace.Metadata, shard uint32, start, end time.Time, opts result.Options) (result.ShardResult, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,88 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+
+	"github.com/uber/tchannel-go/thrift"
+)
+
+// compressingClient compresses the payloads of the RPCs that support
+// compression, it must only wrap clients of hosts that advertise support
+// for the compression type since hosts unaware of compression would
+// otherwise silently drop compressed writes.
+type compressingClient struct {
+	rpc.TChanNode
+
+	compression rpc.CompressionType
+}
+
+func newCompressingClient(
+	client rpc.TChanNode,
+	compression rpc.CompressionType,
+) rpc.TChanNode {
+	return &compressingClient{
+		TChanNode:   client,
+		compression: compression,
+	}
+}
+
+func (c *compressingClient) WriteTaggedBatchRawV2(
+	ctx thrift.Context,
+	req *rpc.WriteTaggedBatchRawV2Request,
+) error {
+	compressed, err := convert.CompressWriteTaggedBatchRawV2Request(req, c.compression)
+	if err != nil {
+		return err
+	}
+	return c.TChanNode.WriteTaggedBatchRawV2(ctx, compressed)
+}
+
+func (c *compressingClient) FetchTagged(
+	ctx thrift.Context,
+	req *rpc.FetchTaggedRequest,
+) (*rpc.FetchTaggedResult_, error) {
+	// Copy the request since it may be shared with requests to other hosts.
+	compressedReq := *req
+	compressedReq.AcceptCompression = c.compression
+	result, err := c.TChanNode.FetchTagged(ctx, &compressedReq)
+	if err != nil {
+		return nil, err
+	}
+	return convert.DecompressFetchTaggedResult(result)
+}
+
+// negotiateCompression returns a client compressing payloads if the host
+// supports the configured compression, otherwise the client itself.
+func negotiateCompression(client rpc.TChanNode, opts Options) rpc.TChanNode {
+	compression := opts.Compression()
+	if compression == rpc.CompressionType_NONE {
+		return client
+	}
+	tctx, _ := thrift.NewContext(opts.HostConnectTimeout())
+	result, err := client.Health(tctx)
+	if err != nil || !convert.IsSupportedCompression(compression, result.Compressions) {
+		return client
+	}
+	return newCompressingClient(client, compression)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go/thrift"
+)
+
+func TestNegotiateCompression(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := rpc.NewMockTChanNode(ctrl)
+	opts := newSessionTestOptions()
+
+	// No compression configured, the host is not asked.
+	require.True(t, negotiateCompression(client, opts) == client)
+
+	opts = opts.SetCompression(rpc.CompressionType_SNAPPY)
+
+	// Host predating compression.
+	client.EXPECT().Health(gomock.Any()).Return(&rpc.NodeHealthResult_{Ok: true}, nil)
+	require.True(t, negotiateCompression(client, opts) == client)
+
+	// Host supporting compression.
+	client.EXPECT().Health(gomock.Any()).Return(&rpc.NodeHealthResult_{
+		Ok:           true,
+		Compressions: []rpc.CompressionType{rpc.CompressionType_SNAPPY},
+	}, nil)
+	compressing, ok := negotiateCompression(client, opts).(*compressingClient)
+	require.True(t, ok)
+	require.Equal(t, rpc.CompressionType_SNAPPY, compressing.compression)
+
+	opts = opts.SetCompression(rpc.CompressionType_ZSTD)
+
+	// Host predating zstd.
+	client.EXPECT().Health(gomock.Any()).Return(&rpc.NodeHealthResult_{
+		Ok:           true,
+		Compressions: []rpc.CompressionType{rpc.CompressionType_SNAPPY},
+	}, nil)
+	require.True(t, negotiateCompression(client, opts) == client)
+
+	// Host supporting zstd.
+	client.EXPECT().Health(gomock.Any()).Return(&rpc.NodeHealthResult_{
+		Ok: true,
+		Compressions: []rpc.CompressionType{
+			rpc.CompressionType_SNAPPY,
+			rpc.CompressionType_ZSTD,
+		},
+	}, nil)
+	compressing, ok = negotiateCompression(client, opts).(*compressingClient)
+	require.True(t, ok)
+	require.Equal(t, rpc.CompressionType_ZSTD, compressing.compression)
+}
+
+func TestCompressingClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := rpc.NewMockTChanNode(ctrl)
+	client := newCompressingClient(mockClient, rpc.CompressionType_SNAPPY)
+	tctx, _ := thrift.NewContext(time.Minute)
+
+	writeReq := &rpc.WriteTaggedBatchRawV2Request{
+		NameSpaces: [][]byte{[]byte("metrics")},
+		Elements: []*rpc.WriteTaggedBatchRawV2RequestElement{
+			{
+				ID:          []byte("foo"),
+				EncodedTags: []byte("foo=bar"),
+				Datapoint:   &rpc.Datapoint{Timestamp: 1, Value: 42},
+			},
+		},
+	}
+	mockClient.EXPECT().WriteTaggedBatchRawV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ thrift.Context, req *rpc.WriteTaggedBatchRawV2Request) error {
+			require.Equal(t, rpc.CompressionType_SNAPPY, req.Compression)
+			require.Equal(t, 0, len(req.Elements))
+			decompressed, err := convert.DecompressWriteTaggedBatchRawV2Request(req)
+			require.NoError(t, err)
+			require.Equal(t, writeReq, decompressed)
+			return nil
+		})
+	require.NoError(t, client.WriteTaggedBatchRawV2(tctx, writeReq))
+
+	fetchReq := &rpc.FetchTaggedRequest{NameSpace: []byte("metrics")}
+	result := &rpc.FetchTaggedResult_{
+		Exhaustive: true,
+		Elements: []*rpc.FetchTaggedIDResult_{
+			{ID: []byte("foo"), NameSpace: []byte("metrics"), EncodedTags: []byte("foo=bar")},
+		},
+	}
+	mockClient.EXPECT().FetchTagged(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ thrift.Context, req *rpc.FetchTaggedRequest) (*rpc.FetchTaggedResult_, error) {
+			require.Equal(t, rpc.CompressionType_SNAPPY, req.AcceptCompression)
+			return convert.CompressFetchTaggedResult(result, req.AcceptCompression)
+		})
+	fetched, err := client.FetchTagged(tctx, fetchReq)
+	require.NoError(t, err)
+	require.Equal(t, result, fetched)
+	// The caller's request must not be modified.
+	require.Equal(t, rpc.CompressionType_NONE, fetchReq.AcceptCompression)
+}
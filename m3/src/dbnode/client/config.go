@@ -29,7 +29,9 @@ import (
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/environment"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
 	"github.com/m3db/m3/src/dbnode/topology"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/ident"
@@ -37,6 +39,7 @@ import (
 	"github.com/m3db/m3/src/x/retry"
 	"github.com/m3db/m3/src/x/sampler"
 	xsync "github.com/m3db/m3/src/x/sync"
+	xtls "github.com/m3db/m3/src/x/tls"
 )
 
 const (
@@ -104,6 +107,30 @@ type Configuration struct {
 	// have support for the V2 APIs in order for this feature to be used.
 	UseV2BatchAPIs *bool `yaml:"useV2BatchAPIs"`
 
+	// Compression is the compression, either NONE, SNAPPY or ZSTD, used for
+	// fetch tagged results and V2 batch tagged writes with nodes that support
+	// it, other compressions are rejected.
+	Compression *rpc.CompressionType `yaml:"compression"`
+
+	// Transport is the transport, either tchannel or grpc, used to connect
+	// to nodes. Nodes must serve the node service over gRPC in order for the
+	// grpc transport to be used, on the gRPC port of the instance in the
+	// placement or otherwise on GRPCPort.
+	Transport *TransportType `yaml:"transport"`
+
+	// GRPCPort is the port nodes serve the node service on over gRPC, used
+	// for nodes without a gRPC port in the placement.
+	GRPCPort *int `yaml:"grpcPort"`
+
+	// GRPCMaxMessageSize is the max size of messages sent to and received
+	// from nodes with the grpc transport, it must be at least the max
+	// message size of the nodes for the largest fetches to succeed.
+	GRPCMaxMessageSize *int `yaml:"grpcMaxMessageSize"`
+
+	// TLS is the TLS configuration used to connect to nodes with the grpc
+	// transport, connections made with the tchannel transport do not use TLS.
+	TLS *xtls.Configuration `yaml:"tls"`
+
 	// WriteTimestampOffset offsets all writes by specified duration into the past.
 	WriteTimestampOffset *time.Duration `yaml:"writeTimestampOffset"`
 }
@@ -197,9 +224,20 @@ func (c *Configuration) Validate() error {
 		return fmt.Errorf("error validating M3DB client proto configuration: %v", err)
 	}
 
+	if c.Compression != nil && *c.Compression != rpc.CompressionType_NONE &&
+		!convert.IsSupportedCompression(*c.Compression, convert.SupportedCompressions()) {
+		return fmt.Errorf("m3db client compression was: %v but must be one of: %v",
+			*c.Compression, validCompressions())
+	}
+
 	return nil
 }
 
+func validCompressions() []rpc.CompressionType {
+	return append([]rpc.CompressionType{rpc.CompressionType_NONE},
+		convert.SupportedCompressions()...)
+}
+
 // HashingConfiguration is the configuration for hashing
 type HashingConfiguration struct {
 	// Murmur32 seed value
@@ -313,6 +351,25 @@ func (c Configuration) NewAdminClient(
 	if c.UseV2BatchAPIs != nil {
 		v = v.SetUseV2BatchAPIs(*c.UseV2BatchAPIs)
 	}
+	if c.Compression != nil {
+		v = v.SetCompression(*c.Compression)
+	}
+	if c.Transport != nil {
+		v = v.SetTransport(*c.Transport)
+	}
+	if c.GRPCPort != nil {
+		v = v.SetGRPCPort(*c.GRPCPort)
+	}
+	if c.GRPCMaxMessageSize != nil {
+		v = v.SetGRPCMaxMessageSize(*c.GRPCMaxMessageSize)
+	}
+	if c.TLS != nil {
+		tlsConfig, err := c.TLS.NewClientConfig(iopts)
+		if err != nil {
+			return nil, err
+		}
+		v = v.SetTLSConfig(tlsConfig)
+	}
 
 	if buildAsyncPool {
 		var size int
@@ -26,12 +26,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/topology"
 	xconfig "github.com/m3db/m3/src/x/config"
 	"github.com/m3db/m3/src/x/retry"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 )
 
 func TestConfiguration(t *testing.T) {
@@ -54,6 +56,10 @@ fetchRetry:
     jitter: true
 backgroundHealthCheckFailLimit: 4
 backgroundHealthCheckFailThrottleFactor: 0.5
+compression: SNAPPY
+transport: grpc
+grpcPort: 9005
+grpcMaxMessageSize: 16777216
 hashing:
   seed: 42
 proto:
@@ -91,6 +97,10 @@ proto:
 		num4                 = 4
 		numHalf              = 0.5
 		boolTrue             = true
+		snappy               = rpc.CompressionType_SNAPPY
+		transportGRPC        = GRPCTransport
+		grpcPort             = 9005
+		grpcMaxMessageSize   = 16 << 20
 	)
 
 	expected := Configuration{
@@ -114,6 +124,10 @@ proto:
 		},
 		BackgroundHealthCheckFailLimit:          &num4,
 		BackgroundHealthCheckFailThrottleFactor: &numHalf,
+		Compression:                             &snappy,
+		Transport:                               &transportGRPC,
+		GRPCPort:                                &grpcPort,
+		GRPCMaxMessageSize:                      &grpcMaxMessageSize,
 		HashingConfiguration: &HashingConfiguration{
 			Seed: 42,
 		},
@@ -128,3 +142,22 @@ proto:
 
 	assert.Equal(t, expected, cfg)
 }
+
+func TestConfigurationValidateCompression(t *testing.T) {
+	for _, valid := range []rpc.CompressionType{
+		rpc.CompressionType_NONE,
+		rpc.CompressionType_SNAPPY,
+		rpc.CompressionType_ZSTD,
+	} {
+		valid := valid
+		cfg := Configuration{Compression: &valid}
+		require.NoError(t, cfg.Validate())
+	}
+
+	unsupported := rpc.CompressionType(3)
+	cfg := Configuration{Compression: &unsupported}
+	require.Error(t, cfg.Validate())
+
+	var parsed Configuration
+	require.Error(t, yaml.Unmarshal([]byte("compression: LZ4"), &parsed))
+}
@@ -168,7 +168,12 @@ func (p *connPool) connectEvery(interval time.Duration, stutter time.Duration) {
 			return
 		}
 
-		address := p.host.Address()
+		address, err := connectionAddress(p.host, p.opts)
+		if err != nil {
+			log.Error("could not resolve address", zap.Stringer("host", p.host), zap.Error(err))
+			p.sleepConnect(interval + randStutter(p.connectRand, stutter))
+			continue
+		}
 
 		var wg sync.WaitGroup
 		for i := 0; i < target-poolLen; i++ {
@@ -191,6 +196,13 @@ func (p *connPool) connectEvery(interval time.Duration, stutter time.Duration) {
 					return
 				}
 
+				// Compress payloads if the host supports it, hosts that
+				// predate compression do not advertise any. Payloads sent
+				// over gRPC are compressed by the gRPC compressor instead.
+				if p.opts.Transport() != GRPCTransport {
+					client = negotiateCompression(client, p.opts)
+				}
+
 				p.Lock()
 				if p.status == statusOpen {
 					p.pool = append(p.pool, conn{channel, client})
@@ -274,6 +274,10 @@ func (q *queue) drain() {
 				q.asyncAggregate(v)
 			case *truncateOp:
 				q.asyncTruncate(v)
+			case *deleteSeriesOp:
+				q.asyncDeleteSeries(v)
+			case *indexCardinalityOp:
+				q.asyncIndexCardinality(v)
 			default:
 				completionFn := ops[i].CompletionFn()
 				completionFn(nil, errQueueUnknownOperation(q.host.ID()))
@@ -954,6 +958,58 @@ func (q *queue) asyncTruncate(op *truncateOp) {
 	})
 }
 
+func (q *queue) asyncDeleteSeries(op *deleteSeriesOp) {
+	q.Add(1)
+
+	q.workerPool.Go(func() {
+		cleanup := q.Done
+
+		client, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.completionFn(nil, err)
+			cleanup()
+			return
+		}
+
+		// NB: Deleting series is an admin operation like truncation so it
+		// shares the same request timeout.
+		ctx, _ := thrift.NewContext(q.opts.TruncateRequestTimeout())
+		if res, err := client.DeleteSeries(ctx, &op.request); err != nil {
+			op.completionFn(nil, err)
+		} else {
+			op.completionFn(res, nil)
+		}
+
+		cleanup()
+	})
+}
+
+func (q *queue) asyncIndexCardinality(op *indexCardinalityOp) {
+	q.Add(1)
+
+	q.workerPool.Go(func() {
+		cleanup := q.Done
+
+		client, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.completionFn(nil, err)
+			cleanup()
+			return
+		}
+
+		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		if res, err := client.IndexCardinality(ctx, &op.request); err != nil {
+			op.completionFn(nil, err)
+		} else {
+			op.completionFn(res, nil)
+		}
+
+		cleanup()
+	})
+}
+
 func (q *queue) Len() int {
 	q.RLock()
 	v := q.opsSumSize
@@ -21,6 +21,7 @@
 package client
 
 import (
+	"crypto/tls"
 	"errors"
 	"io"
 	"math"
@@ -34,6 +35,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/environment"
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/network/server/grpcproto"
 	nchannel "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/node/channel"
 	m3dbruntime "github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/storage/index"
@@ -166,6 +168,12 @@ const (
 	// defaultUseV2BatchAPIs is the default setting for whether the v2 version of the batch APIs should
 	// be used.
 	defaultUseV2BatchAPIs = false
+
+	// defaultCompression is the default compression for payloads exchanged with hosts.
+	defaultCompression = rpc.CompressionType_NONE
+
+	// defaultTransport is the default transport used to connect to hosts.
+	defaultTransport = TChannelTransport
 )
 
 var (
@@ -213,6 +221,7 @@ var (
 
 	errNoTopologyInitializerSet    = errors.New("no topology initializer set")
 	errNoReaderIteratorAllocateSet = errors.New("no reader iterator allocator set, encoding not set")
+	errInvalidGRPCMaxMessageSize   = errors.New("grpc max message size must be positive")
 )
 
 type options struct {
@@ -273,6 +282,11 @@ type options struct {
 	asyncWriteWorkerPool                    xsync.PooledWorkerPool
 	asyncWriteMaxConcurrency                int
 	useV2BatchAPIs                          bool
+	compression                             rpc.CompressionType
+	transport                               TransportType
+	grpcPort                                int
+	grpcMaxMessageSize                      int
+	tlsConfig                               *tls.Config
 	iterationOptions                        index.IterationOptions
 	writeTimestampOffset                    time.Duration
 }
@@ -307,6 +321,9 @@ func NewOptionsForAsyncClusters(opts Options, topoInits []topology.Initializer,
 func defaultNewConnectionFn(
 	channelName string, address string, opts Options,
 ) (xclose.SimpleCloser, rpc.TChanNode, error) {
+	if opts.Transport() == GRPCTransport {
+		return newGRPCConnection(address, opts)
+	}
 	channel, err := tchannel.NewChannel(channelName, opts.ChannelOptions())
 	if err != nil {
 		return nil, nil, err
@@ -389,6 +406,9 @@ func newOptions() *options {
 		asyncTopologyInitializers:               []topology.Initializer{},
 		asyncWriteMaxConcurrency:                defaultAsyncWriteMaxConcurrency,
 		useV2BatchAPIs:                          defaultUseV2BatchAPIs,
+		compression:                             defaultCompression,
+		transport:                               defaultTransport,
+		grpcMaxMessageSize:                      grpcproto.DefaultMaxMessageSize,
 	}
 	return opts.SetEncodingM3TSZ().(*options)
 }
@@ -420,6 +440,12 @@ func validate(opts *options) error {
 	); err != nil {
 		return err
 	}
+	if err := validateTransport(opts.transport); err != nil {
+		return err
+	}
+	if opts.grpcMaxMessageSize <= 0 {
+		return errInvalidGRPCMaxMessageSize
+	}
 	return opts.logErrorSampleRate.Validate()
 }
 
@@ -1009,6 +1035,56 @@ func (o *options) UseV2BatchAPIs() bool {
 	return o.useV2BatchAPIs
 }
 
+func (o *options) SetCompression(value rpc.CompressionType) Options {
+	opts := *o
+	opts.compression = value
+	return &opts
+}
+
+func (o *options) Compression() rpc.CompressionType {
+	return o.compression
+}
+
+func (o *options) SetTransport(value TransportType) Options {
+	opts := *o
+	opts.transport = value
+	return &opts
+}
+
+func (o *options) Transport() TransportType {
+	return o.transport
+}
+
+func (o *options) SetGRPCPort(value int) Options {
+	opts := *o
+	opts.grpcPort = value
+	return &opts
+}
+
+func (o *options) GRPCPort() int {
+	return o.grpcPort
+}
+
+func (o *options) SetGRPCMaxMessageSize(value int) Options {
+	opts := *o
+	opts.grpcMaxMessageSize = value
+	return &opts
+}
+
+func (o *options) GRPCMaxMessageSize() int {
+	return o.grpcMaxMessageSize
+}
+
+func (o *options) SetTLSConfig(value *tls.Config) Options {
+	opts := *o
+	opts.tlsConfig = value
+	return &opts
+}
+
+func (o *options) TLSConfig() *tls.Config {
+	return o.tlsConfig
+}
+
 func (o *options) SetIterationOptions(value index.IterationOptions) Options {
 	opts := *o
 	opts.iterationOptions = value
@@ -289,6 +289,26 @@ func (s replicatedSession) Truncate(namespace ident.ID) (int64, error) {
 	return s.session.Truncate(namespace)
 }
 
+// DeleteSeries will tombstone the datapoints within [start, end) of all
+// series matching the query.
+func (s replicatedSession) DeleteSeries(
+	namespace ident.ID,
+	query index.Query,
+	start, end time.Time,
+) (int64, error) {
+	return s.session.DeleteSeries(namespace, query, start, end)
+}
+
+// IndexCardinality returns the cardinality of the index blocks of the
+// namespace overlapping [start, end), aggregated across all hosts.
+func (s replicatedSession) IndexCardinality(
+	namespace ident.ID,
+	start, end time.Time,
+	opts index.CardinalityOptions,
+) ([]index.BlockCardinality, error) {
+	return s.session.IndexCardinality(namespace, start, end, opts)
+}
+
 // FetchBootstrapBlocksFromPeers will fetch the most fulfilled block
 // for each series using the runtime configurable bootstrap level consistency.
 func (s replicatedSession) FetchBootstrapBlocksFromPeers(
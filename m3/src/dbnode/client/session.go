@@ -1920,6 +1920,182 @@ func (s *session) Truncate(namespace ident.ID) (int64, error) {
 	return truncated, resultErr.FinalError()
 }
 
+func (s *session) DeleteSeries(
+	namespace ident.ID,
+	query index.Query,
+	start, end time.Time,
+) (int64, error) {
+	request, err := convert.ToRPCDeleteSeriesRequest(namespace, query, start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		wg         sync.WaitGroup
+		resultLock sync.Mutex
+		// NB: Every replica of a shard reports the series it deleted from the
+		// shard, so the series of a shard are counted once by taking the
+		// largest count reported by the replicas of the shard.
+		deletedByShard = make(map[uint32]int64)
+	)
+
+	// NB: Every host deletes the matching series of the shards it owns, so
+	// the request is sent to all hosts and the delete succeeds once enough
+	// replicas of every shard succeeded to meet the write consistency level.
+	s.state.RLock()
+	var (
+		topoMap  = s.state.topoMap
+		level    = s.state.writeLevel
+		majority = s.state.majority
+		hostErrs = make([]error, len(s.state.queues))
+	)
+	for idx := range s.state.queues {
+		idx := idx
+		d := &deleteSeriesOp{request: request}
+		d.completionFn = func(result interface{}, err error) {
+			resultLock.Lock()
+			if err != nil {
+				hostErrs[idx] = err
+			} else {
+				res := result.(*rpc.DeleteSeriesResult_)
+				for _, shard := range res.Shards {
+					id := uint32(shard.Shard)
+					if shard.NumSeries > deletedByShard[id] {
+						deletedByShard[id] = shard.NumSeries
+					}
+				}
+			}
+			resultLock.Unlock()
+			wg.Done()
+		}
+
+		wg.Add(1)
+		if err := s.state.queues[idx].Enqueue(d); err != nil {
+			s.log.Error("failed to enqueue request", zap.Error(err))
+			d.completionFn(nil, err)
+		}
+	}
+	s.state.RUnlock()
+
+	// Wait for series to be deleted on all replicas
+	wg.Wait()
+
+	if topoMap == nil {
+		return 0, errSessionStatusNotOpen
+	}
+	for _, shard := range topoMap.ShardSet().AllIDs() {
+		var (
+			enqueued  int
+			shardErrs []error
+		)
+		err := topoMap.RouteShardForEach(shard, func(idx int, _ topology.Host) {
+			enqueued++
+			if err := hostErrs[idx]; err != nil {
+				shardErrs = append(shardErrs, err)
+			}
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		success := enqueued - len(shardErrs)
+		if !topology.WriteConsistencyAchieved(level, majority, enqueued, success) {
+			return 0, newConsistencyResultError(level, enqueued, enqueued, shardErrs)
+		}
+	}
+
+	var deleted int64
+	for _, numSeries := range deletedByShard {
+		deleted += numSeries
+	}
+	return deleted, nil
+}
+
+func (s *session) IndexCardinality(
+	namespace ident.ID,
+	start, end time.Time,
+	opts index.CardinalityOptions,
+) ([]index.BlockCardinality, error) {
+	request, err := convert.ToRPCIndexCardinalityRequest(namespace, start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg         sync.WaitGroup
+		enqueueErr xerrors.MultiError
+		resultLock sync.Mutex
+		resultErr  xerrors.MultiError
+		results    []index.BlockCardinality
+	)
+
+	c := &indexCardinalityOp{request: request}
+	c.completionFn = func(result interface{}, err error) {
+		var blocks []index.BlockCardinality
+		if err == nil {
+			blocks, err = convert.FromRPCIndexCardinalityResult(
+				result.(*rpc.IndexCardinalityResult_))
+		}
+
+		resultLock.Lock()
+		if err != nil {
+			resultErr = resultErr.Add(err)
+		} else {
+			results = append(results, blocks...)
+		}
+		resultLock.Unlock()
+		wg.Done()
+	}
+
+	// NB: Every host computes the cardinality of the shards it owns, so the
+	// request is sent to all hosts rather than a quorum per shard.
+	s.state.RLock()
+	replicas := s.state.replicas
+	for idx := range s.state.queues {
+		wg.Add(1)
+		if err := s.state.queues[idx].Enqueue(c); err != nil {
+			wg.Done()
+			enqueueErr = enqueueErr.Add(err)
+		}
+	}
+	s.state.RUnlock()
+
+	if err := enqueueErr.FinalError(); err != nil {
+		s.log.Error("failed to enqueue request", zap.Error(err))
+		return nil, err
+	}
+
+	wg.Wait()
+
+	if err := resultErr.FinalError(); err != nil {
+		return nil, err
+	}
+
+	// Every series is indexed by each of its replicas, so scale the summed
+	// series counts down by the replication factor. The label value counts
+	// are counted from the merged label values and are not scaled.
+	merged := index.MergeBlockCardinality(results, opts.Limit)
+	if replicas > 1 {
+		for i := range merged {
+			scaleCardinality(&merged[i], int64(replicas))
+		}
+	}
+	return merged, nil
+}
+
+func scaleCardinality(b *index.BlockCardinality, divisor int64) {
+	b.NumSeries /= divisor
+	for _, entries := range [][]index.CardinalityEntry{
+		b.SeriesCountByMetricName,
+		b.SeriesCountByLabelName,
+		b.SeriesCountByLabelValuePair,
+	} {
+		for i := range entries {
+			entries[i].Count /= divisor
+		}
+	}
+}
+
 // NB(r): Excluding maligned struct check here as we can
 // live with a few extra bytes since this struct is only
 // ever passed by stack, its much more readable not optimized
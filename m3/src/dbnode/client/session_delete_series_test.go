@@ -0,0 +1,153 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package client
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		start    = time.Now().Add(-time.Hour).Truncate(time.Second)
+		end      = start.Add(30 * time.Minute)
+		query    = index.Query{Query: idx.NewTermQuery([]byte("foo"), []byte("bar"))}
+		deleted  = make([]int64, sessionTestShards)
+		expected int64
+	)
+	for i := range deleted {
+		deleted[i] = 1 + rand.Int63n(128)
+		expected += deleted[i]
+	}
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			deleteSeries, ok := op.(*deleteSeriesOp)
+			assert.True(t, ok)
+			assert.Equal(t, []byte("metrics"), deleteSeries.request.NameSpace)
+			assert.Equal(t, start.UnixNano(), deleteSeries.request.RangeStart)
+			assert.Equal(t, end.UnixNano(), deleteSeries.request.RangeEnd)
+
+			// Every host owns every shard, the first host lags behind the
+			// other replicas and deletes fewer series of the first shard.
+			result := &rpc.DeleteSeriesResult_{}
+			for shard, n := range deleted {
+				if idx == 0 && shard == 0 {
+					n--
+				}
+				result.NumSeries += n
+				result.Shards = append(result.Shards, &rpc.DeleteSeriesShardResult{
+					Shard:     int32(shard),
+					NumSeries: n,
+				})
+			}
+			deleteSeries.completionFn(result, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	n, err := s.DeleteSeries(ident.StringID("metrics"), query, start, end)
+	require.NoError(t, err)
+	assert.Equal(t, expected, n)
+
+	assert.NoError(t, session.Close())
+}
+
+func TestDeleteSeriesConsistency(t *testing.T) {
+	tests := []struct {
+		name        string
+		failedHosts int
+		expectErr   bool
+	}{
+		{name: "majority succeeds", failedHosts: 1},
+		{name: "majority fails", failedHosts: 2, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			opts := newSessionTestOptions()
+			s, err := newSession(opts)
+			assert.NoError(t, err)
+			session := s.(*session)
+
+			var (
+				start = time.Now().Add(-time.Hour).Truncate(time.Second)
+				end   = start.Add(30 * time.Minute)
+				query = index.Query{Query: idx.NewTermQuery([]byte("foo"), []byte("bar"))}
+			)
+			mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+				func(idx int, op op) {
+					deleteSeries, ok := op.(*deleteSeriesOp)
+					assert.True(t, ok)
+					if idx < tt.failedHosts {
+						deleteSeries.completionFn(nil, errors.New("host down"))
+						return
+					}
+					result := &rpc.DeleteSeriesResult_{NumSeries: sessionTestShards}
+					for shard := 0; shard < sessionTestShards; shard++ {
+						result.Shards = append(result.Shards, &rpc.DeleteSeriesShardResult{
+							Shard:     int32(shard),
+							NumSeries: 1,
+						})
+					}
+					deleteSeries.completionFn(result, nil)
+				},
+			})
+
+			assert.NoError(t, session.Open())
+
+			n, err := s.DeleteSeries(ident.StringID("metrics"), query, start, end)
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.True(t, IsConsistencyResultError(err))
+				resultErr, ok := err.(consistencyResultError)
+				require.True(t, ok)
+				assert.Equal(t, sessionTestReplicas-tt.failedHosts, resultErr.numSuccess())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, int64(sessionTestShards), n)
+			}
+
+			assert.NoError(t, session.Close())
+		})
+	}
+}
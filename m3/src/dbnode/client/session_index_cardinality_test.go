@@ -0,0 +1,215 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexCardinality(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		start = time.Now().Add(-2 * time.Hour).Truncate(time.Hour)
+		end   = start.Add(2 * time.Hour)
+		copts = index.CardinalityOptions{
+			Limit:         10,
+			MetricNameTag: []byte("__name__"),
+		}
+	)
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			cardinality, ok := op.(*indexCardinalityOp)
+			assert.True(t, ok)
+			assert.Equal(t, []byte("metrics"), cardinality.request.NameSpace)
+			assert.Equal(t, start.UnixNano(), cardinality.request.RangeStart)
+			assert.Equal(t, end.UnixNano(), cardinality.request.RangeEnd)
+			assert.Equal(t, int64(10), cardinality.request.Limit)
+
+			// Each host owns all shards, so reports all of the series.
+			cardinality.completionFn(&rpc.IndexCardinalityResult_{
+				Blocks: []*rpc.IndexBlockCardinality{
+					{
+						BlockStart: start.UnixNano(),
+						NumSeries:  4,
+						SeriesCountByMetricName: []*rpc.IndexCardinalityEntry{
+							{Name: []byte("up"), Count: 3},
+							{Name: []byte("requests"), Count: 1},
+						},
+					},
+				},
+			}, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	blocks, err := s.IndexCardinality(ident.StringID("metrics"), start, end, copts)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blocks))
+	assert.True(t, start.Equal(blocks[0].BlockStart))
+	assert.Equal(t, int64(4), blocks[0].NumSeries)
+	assert.Equal(t, []index.CardinalityEntry{
+		{Name: []byte("up"), Count: 3},
+		{Name: []byte("requests"), Count: 1},
+	}, blocks[0].SeriesCountByMetricName)
+
+	assert.NoError(t, session.Close())
+}
+
+func TestIndexCardinalityHostError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions()
+	s, err := newSession(opts)
+	assert.NoError(t, err)
+	session := s.(*session)
+
+	mockHostQueues(ctrl, session, sessionTestReplicas, []testEnqueueFn{
+		func(idx int, op op) {
+			cardinality, ok := op.(*indexCardinalityOp)
+			assert.True(t, ok)
+			if idx == 0 {
+				cardinality.completionFn(nil, errors.New("host error"))
+				return
+			}
+			cardinality.completionFn(&rpc.IndexCardinalityResult_{}, nil)
+		},
+	})
+
+	assert.NoError(t, session.Open())
+
+	now := time.Now()
+	_, err = s.IndexCardinality(ident.StringID("metrics"), now.Add(-time.Hour), now,
+		index.CardinalityOptions{Limit: 10})
+	require.Error(t, err)
+
+	assert.NoError(t, session.Close())
+}
+
+func TestIndexCardinalityMergesLabelValuesAcrossShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Six hosts with a replication factor of three, the first three hosts own
+	// shard 0 and the last three own shard 1. Both shards index series with
+	// the job label value "api" which must only be counted once.
+	const replicas = 3
+	hashFn := func(id ident.ID) uint32 { return 0 }
+	newShardSet := func(ids ...uint32) sharding.ShardSet {
+		shardSet, err := sharding.NewShardSet(sharding.NewShards(ids, shard.Available), hashFn)
+		require.NoError(t, err)
+		return shardSet
+	}
+	var (
+		hostShardSets []topology.HostShardSet
+		hostShards    = make(map[string]uint32)
+	)
+	for i := 0; i < 2*replicas; i++ {
+		id := testHostName(i)
+		host := topology.NewHost(id, fmt.Sprintf("%s:9000", id))
+		hostShards[id] = uint32(i / replicas)
+		hostShardSets = append(hostShardSets,
+			topology.NewHostShardSet(host, newShardSet(hostShards[id])))
+	}
+	opts := newSessionTestOptions().SetTopologyInitializer(topology.NewStaticInitializer(
+		topology.NewStaticOptions().
+			SetReplicas(replicas).
+			SetShardSet(newShardSet(0, 1)).
+			SetHostShardSets(hostShardSets)))
+	s, err := newSession(opts)
+	require.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		start     = time.Now().Truncate(time.Hour)
+		jobValues = [][]string{{"api", "db"}, {"api", "web"}}
+	)
+	session.newHostQueueFn = func(host topology.Host, opts hostQueueOpts) (hostQueue, error) {
+		labelValues := &rpc.IndexCardinalityLabelValues{Name: []byte("job")}
+		for _, v := range jobValues[hostShards[host.ID()]] {
+			labelValues.Values = append(labelValues.Values, []byte(v))
+		}
+
+		hostQueue := NewMockhostQueue(ctrl)
+		hostQueue.EXPECT().Open()
+		hostQueue.EXPECT().Host().Return(host).AnyTimes()
+		hostQueue.EXPECT().ConnectionCount().Return(opts.opts.MinConnectionCount()).AnyTimes()
+		hostQueue.EXPECT().Enqueue(gomock.Any()).Do(func(op op) error {
+			cardinality, ok := op.(*indexCardinalityOp)
+			require.True(t, ok)
+			cardinality.completionFn(&rpc.IndexCardinalityResult_{
+				Blocks: []*rpc.IndexBlockCardinality{
+					{
+						BlockStart: start.UnixNano(),
+						NumSeries:  2,
+						SeriesCountByLabelName: []*rpc.IndexCardinalityEntry{
+							{Name: []byte("job"), Count: 2},
+						},
+						LabelValueCountByLabelName: []*rpc.IndexCardinalityEntry{
+							{Name: []byte("job"), Count: 2},
+						},
+						LabelValuesByLabelName: []*rpc.IndexCardinalityLabelValues{labelValues},
+					},
+				},
+			}, nil)
+			return nil
+		}).Return(nil)
+		hostQueue.EXPECT().Close()
+		return hostQueue, nil
+	}
+
+	require.NoError(t, session.Open())
+
+	blocks, err := s.IndexCardinality(ident.StringID("metrics"), start, start.Add(time.Hour),
+		index.CardinalityOptions{Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blocks))
+	assert.Equal(t, int64(4), blocks[0].NumSeries)
+	assert.Equal(t, []index.CardinalityEntry{
+		{Name: []byte("job"), Count: 4},
+	}, blocks[0].SeriesCountByLabelName)
+	assert.Equal(t, []index.CardinalityEntry{
+		{Name: []byte("job"), Count: 3},
+	}, blocks[0].LabelValueCountByLabelName)
+
+	assert.NoError(t, session.Close())
+}
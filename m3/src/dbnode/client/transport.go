@@ -0,0 +1,160 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/grpcproto"
+	grpcnode "github.com/m3db/m3/src/dbnode/network/server/grpcproto/node"
+	"github.com/m3db/m3/src/dbnode/topology"
+	xclose "github.com/m3db/m3/src/x/close"
+	xtls "github.com/m3db/m3/src/x/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// TransportType is the transport used to connect to hosts.
+type TransportType int
+
+const (
+	// TChannelTransport connects to hosts with TChannel, using the address
+	// of the host in the topology.
+	TChannelTransport TransportType = iota
+
+	// GRPCTransport connects to hosts with gRPC, using the gRPC address of
+	// the host in the topology, or the host of its address and the gRPC port
+	// if the topology has no gRPC address for the host.
+	GRPCTransport
+)
+
+var (
+	validTransportTypes = []TransportType{
+		TChannelTransport,
+		GRPCTransport,
+	}
+
+	errTransportTypeUnspecified = errors.New("transport type not specified")
+	errTransportTypeInvalid     = errors.New("transport type invalid")
+	errNoGRPCAddress            = errors.New("no gRPC address for host in topology and no gRPC port set")
+)
+
+// String returns the transport type as a string.
+func (t TransportType) String() string {
+	switch t {
+	case TChannelTransport:
+		return "tchannel"
+	case GRPCTransport:
+		return "grpc"
+	}
+	return "unknown"
+}
+
+// UnmarshalYAML unmarshals a TransportType into a valid type from string.
+func (t *TransportType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == "" {
+		return errTransportTypeUnspecified
+	}
+	strs := make([]string, 0, len(validTransportTypes))
+	for _, valid := range validTransportTypes {
+		if str == valid.String() {
+			*t = valid
+			return nil
+		}
+		strs = append(strs, "'"+valid.String()+"'")
+	}
+	return fmt.Errorf("invalid TransportType '%s' valid types are: %s",
+		str, strings.Join(strs, ", "))
+}
+
+func validateTransport(t TransportType) error {
+	switch t {
+	case TChannelTransport, GRPCTransport:
+		return nil
+	}
+	return errTransportTypeInvalid
+}
+
+// connectionAddress returns the address to connect to the host at with the
+// transport.
+func connectionAddress(host topology.Host, opts Options) (string, error) {
+	if opts.Transport() != GRPCTransport {
+		return host.Address(), nil
+	}
+	if address := host.GRPCAddress(); address != "" {
+		return address, nil
+	}
+	if opts.GRPCPort() <= 0 {
+		return "", errNoGRPCAddress
+	}
+	hostname, _, err := net.SplitHostPort(host.Address())
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(hostname, strconv.Itoa(opts.GRPCPort())), nil
+}
+
+type grpcConn struct {
+	conn *grpc.ClientConn
+}
+
+func (c grpcConn) Close() {
+	c.conn.Close()
+}
+
+func newGRPCConnection(
+	address string, opts Options,
+) (xclose.SimpleCloser, rpc.TChanNode, error) {
+	creds := grpc.WithInsecure()
+	if tlsConfig := opts.TLSConfig(); tlsConfig != nil {
+		tlsConfig, err := xtls.ClientConfigForAddress(tlsConfig, address)
+		if err != nil {
+			return nil, nil, err
+		}
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+
+	callOpts := []grpc.CallOption{
+		grpc.MaxCallRecvMsgSize(opts.GRPCMaxMessageSize()),
+		grpc.MaxCallSendMsgSize(opts.GRPCMaxMessageSize()),
+	}
+	// NB: payloads are compressed by gRPC rather than by the node service,
+	// the messages served over gRPC carry no compression.
+	if name, ok := grpcproto.CompressorName(opts.Compression()); ok {
+		callOpts = append(callOpts, grpc.UseCompressor(name))
+	}
+
+	conn, err := grpc.Dial(address, creds, grpc.WithDefaultCallOptions(callOpts...))
+	if err != nil {
+		return nil, nil, err
+	}
+	return grpcConn{conn: conn}, grpcnode.NewClient(conn), nil
+}
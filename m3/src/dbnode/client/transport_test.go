@@ -0,0 +1,81 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/dbnode/network/server/grpcproto"
+	"github.com/m3db/m3/src/dbnode/topology"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestTransportTypeUnmarshalYAML(t *testing.T) {
+	for _, valid := range validTransportTypes {
+		var transport TransportType
+		require.NoError(t, yaml.Unmarshal([]byte(valid.String()), &transport))
+		assert.Equal(t, valid, transport)
+	}
+
+	var transport TransportType
+	require.Error(t, yaml.Unmarshal([]byte("http"), &transport))
+	require.Error(t, yaml.Unmarshal([]byte(`""`), &transport))
+}
+
+func TestConnectionAddress(t *testing.T) {
+	host := topology.NewHost("h1", "h1:9000")
+	grpcHost := topology.NewHostWithGRPCAddress("h2", "h2:9000", "h2:9010")
+
+	opts := newSessionTestOptions()
+	address, err := connectionAddress(grpcHost, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "h2:9000", address)
+
+	opts = opts.SetTransport(GRPCTransport)
+	assert.NoError(t, opts.Validate())
+
+	address, err = connectionAddress(grpcHost, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "h2:9010", address)
+
+	_, err = connectionAddress(host, opts)
+	assert.Equal(t, errNoGRPCAddress, err)
+
+	opts = opts.SetGRPCPort(9005)
+	address, err = connectionAddress(host, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "h1:9005", address)
+}
+
+func TestGRPCMaxMessageSize(t *testing.T) {
+	opts := newSessionTestOptions()
+	assert.Equal(t, grpcproto.DefaultMaxMessageSize, opts.GRPCMaxMessageSize())
+
+	opts = opts.SetGRPCMaxMessageSize(16 << 20)
+	assert.Equal(t, 16<<20, opts.GRPCMaxMessageSize())
+	assert.NoError(t, opts.Validate())
+
+	opts = opts.SetGRPCMaxMessageSize(0)
+	assert.Equal(t, errInvalidGRPCMaxMessageSize, opts.Validate())
+}
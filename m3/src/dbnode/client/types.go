@@ -21,6 +21,7 @@
 package client
 
 import (
+	"crypto/tls"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/clock"
@@ -202,6 +203,25 @@ type AdminSession interface {
 	// Truncate will truncate the namespace for a given shard.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteSeries will tombstone the datapoints within [start, end) of all
+	// series matching the query, returning the number of series deleted
+	// summed across all replicas.
+	DeleteSeries(
+		namespace ident.ID,
+		query index.Query,
+		start, end time.Time,
+	) (int64, error)
+
+	// IndexCardinality returns the cardinality of the index blocks of the
+	// namespace overlapping [start, end), newest block first. The statistics
+	// of all hosts are summed and divided by the number of replicas, so they
+	// are an approximation of the cardinality of the namespace.
+	IndexCardinality(
+		namespace ident.ID,
+		start, end time.Time,
+		opts index.CardinalityOptions,
+	) ([]index.BlockCardinality, error)
+
 	// FetchBootstrapBlocksFromPeers will fetch the most fulfilled block
 	// for each series using the runtime configurable bootstrap level consistency.
 	FetchBootstrapBlocksFromPeers(
@@ -555,6 +575,46 @@ type Options interface {
 	// UseV2BatchAPIs returns whether the V2 batch APIs should be used.
 	UseV2BatchAPIs() bool
 
+	// SetCompression sets the compression used for fetch tagged results and
+	// V2 tagged batch writes with hosts that support it.
+	SetCompression(value rpc.CompressionType) Options
+
+	// Compression returns the compression used for fetch tagged results and
+	// V2 tagged batch writes with hosts that support it.
+	Compression() rpc.CompressionType
+
+	// SetTransport sets the transport used to connect to hosts.
+	SetTransport(value TransportType) Options
+
+	// Transport returns the transport used to connect to hosts.
+	Transport() TransportType
+
+	// SetGRPCPort sets the port hosts serve the node service on over gRPC,
+	// used with the gRPC transport for hosts without a gRPC address in the
+	// topology.
+	SetGRPCPort(value int) Options
+
+	// GRPCPort returns the port hosts serve the node service on over gRPC,
+	// used with the gRPC transport for hosts without a gRPC address in the
+	// topology.
+	GRPCPort() int
+
+	// SetGRPCMaxMessageSize sets the max size of messages sent to and
+	// received from hosts with the gRPC transport.
+	SetGRPCMaxMessageSize(value int) Options
+
+	// GRPCMaxMessageSize returns the max size of messages sent to and
+	// received from hosts with the gRPC transport.
+	GRPCMaxMessageSize() int
+
+	// SetTLSConfig sets the TLS config used to connect to hosts with the
+	// gRPC transport, nil connects without TLS.
+	SetTLSConfig(value *tls.Config) Options
+
+	// TLSConfig returns the TLS config used to connect to hosts with the
+	// gRPC transport, nil connects without TLS.
+	TLSConfig() *tls.Config
+
 	// SetIterationOptions sets experimental iteration options.
 	SetIterationOptions(index.IterationOptions) Options
 
@@ -418,7 +418,8 @@ func newStaticShardSet(numShards int, hosts []topology.HostShardConfig) (shardin
 	}
 
 	for _, i := range hosts {
-		host := topology.NewHost(i.HostID, i.ListenAddress)
+		host := topology.NewHostWithGRPCAddress(i.HostID, i.ListenAddress,
+			i.GRPCListenAddress)
 		hostShardSet := topology.NewHostShardSet(host, shardSet)
 		hostShardSets = append(hostShardSets, hostShardSet)
 	}
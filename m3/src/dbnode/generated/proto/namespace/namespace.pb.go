@@ -22,20 +22,23 @@
 // THE SOFTWARE.
 
 /*
-	Package namespace is a generated protocol buffer package.
-
-	It is generated from these files:
-		github.com/m3db/m3/src/dbnode/generated/proto/namespace/namespace.proto
-		github.com/m3db/m3/src/dbnode/generated/proto/namespace/schema.proto
-
-	It has these top-level messages:
-		RetentionOptions
-		IndexOptions
-		NamespaceOptions
-		Registry
-		SchemaOptions
-		SchemaHistory
-		FileDescriptorSet
+Package namespace is a generated protocol buffer package.
+
+It is generated from these files:
+
+	github.com/m3db/m3/src/dbnode/generated/proto/namespace/namespace.proto
+	github.com/m3db/m3/src/dbnode/generated/proto/namespace/schema.proto
+
+It has these top-level messages:
+
+	RetentionOptions
+	IndexOptions
+	NamespaceOptions
+	Registry
+	ColdTierOptions
+	SchemaOptions
+	SchemaHistory
+	FileDescriptorSet
 */
 package namespace
 
@@ -155,6 +158,7 @@ type NamespaceOptions struct {
 	IndexOptions      *IndexOptions     `protobuf:"bytes,8,opt,name=indexOptions" json:"indexOptions,omitempty"`
 	SchemaOptions     *SchemaOptions    `protobuf:"bytes,9,opt,name=schemaOptions" json:"schemaOptions,omitempty"`
 	ColdWritesEnabled bool              `protobuf:"varint,10,opt,name=coldWritesEnabled,proto3" json:"coldWritesEnabled,omitempty"`
+	ColdTierOptions   *ColdTierOptions  `protobuf:"bytes,11,opt,name=coldTierOptions" json:"coldTierOptions,omitempty"`
 }
 
 func (m *NamespaceOptions) Reset()                    { *m = NamespaceOptions{} }
@@ -232,6 +236,13 @@ func (m *NamespaceOptions) GetColdWritesEnabled() bool {
 	return false
 }
 
+func (m *NamespaceOptions) GetColdTierOptions() *ColdTierOptions {
+	if m != nil {
+		return m.ColdTierOptions
+	}
+	return nil
+}
+
 type Registry struct {
 	Namespaces map[string]*NamespaceOptions `protobuf:"bytes,1,rep,name=namespaces" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -248,11 +259,36 @@ func (m *Registry) GetNamespaces() map[string]*NamespaceOptions {
 	return nil
 }
 
+type ColdTierOptions struct {
+	Enabled           bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	OffloadAfterNanos int64 `protobuf:"varint,2,opt,name=offloadAfterNanos,proto3" json:"offloadAfterNanos,omitempty"`
+}
+
+func (m *ColdTierOptions) Reset()                    { *m = ColdTierOptions{} }
+func (m *ColdTierOptions) String() string            { return proto.CompactTextString(m) }
+func (*ColdTierOptions) ProtoMessage()               {}
+func (*ColdTierOptions) Descriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{4} }
+
+func (m *ColdTierOptions) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ColdTierOptions) GetOffloadAfterNanos() int64 {
+	if m != nil {
+		return m.OffloadAfterNanos
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*RetentionOptions)(nil), "namespace.RetentionOptions")
 	proto.RegisterType((*IndexOptions)(nil), "namespace.IndexOptions")
 	proto.RegisterType((*NamespaceOptions)(nil), "namespace.NamespaceOptions")
 	proto.RegisterType((*Registry)(nil), "namespace.Registry")
+	proto.RegisterType((*ColdTierOptions)(nil), "namespace.ColdTierOptions")
 }
 func (m *RetentionOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -460,6 +496,16 @@ func (m *NamespaceOptions) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.ColdTierOptions != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.ColdTierOptions.Size()))
+		n4, err := m.ColdTierOptions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
 	return i, nil
 }
 
@@ -509,6 +555,39 @@ func (m *Registry) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ColdTierOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ColdTierOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		dAtA[i] = 0x8
+		i++
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.OffloadAfterNanos != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.OffloadAfterNanos))
+	}
+	return i, nil
+}
+
 func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -593,6 +672,10 @@ func (m *NamespaceOptions) Size() (n int) {
 	if m.ColdWritesEnabled {
 		n += 2
 	}
+	if m.ColdTierOptions != nil {
+		l = m.ColdTierOptions.Size()
+		n += 1 + l + sovNamespace(uint64(l))
+	}
 	return n
 }
 
@@ -615,6 +698,18 @@ func (m *Registry) Size() (n int) {
 	return n
 }
 
+func (m *ColdTierOptions) Size() (n int) {
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.OffloadAfterNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.OffloadAfterNanos))
+	}
+	return n
+}
+
 func sovNamespace(x uint64) (n int) {
 	for {
 		n++
@@ -1169,6 +1264,39 @@ func (m *NamespaceOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ColdWritesEnabled = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdTierOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ColdTierOptions == nil {
+				m.ColdTierOptions = &ColdTierOptions{}
+			}
+			if err := m.ColdTierOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNamespace(dAtA[iNdEx:])
@@ -1363,6 +1491,95 @@ func (m *Registry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ColdTierOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColdTierOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColdTierOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffloadAfterNanos", wireType)
+			}
+			m.OffloadAfterNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffloadAfterNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNamespace(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,18446 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/m3db/m3/src/dbnode/generated/proto/nodepb/node.proto
+
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package nodepb
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TimeType int32
+
+const (
+	TimeType_UNIX_SECONDS      TimeType = 0
+	TimeType_UNIX_MICROSECONDS TimeType = 1
+	TimeType_UNIX_MILLISECONDS TimeType = 2
+	TimeType_UNIX_NANOSECONDS  TimeType = 3
+)
+
+var TimeType_name = map[int32]string{
+	0: "UNIX_SECONDS",
+	1: "UNIX_MICROSECONDS",
+	2: "UNIX_MILLISECONDS",
+	3: "UNIX_NANOSECONDS",
+}
+
+var TimeType_value = map[string]int32{
+	"UNIX_SECONDS":      0,
+	"UNIX_MICROSECONDS": 1,
+	"UNIX_MILLISECONDS": 2,
+	"UNIX_NANOSECONDS":  3,
+}
+
+func (x TimeType) String() string {
+	return proto.EnumName(TimeType_name, int32(x))
+}
+
+func (TimeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{0}
+}
+
+type ErrorType int32
+
+const (
+	ErrorType_INTERNAL_ERROR ErrorType = 0
+	ErrorType_BAD_REQUEST    ErrorType = 1
+)
+
+var ErrorType_name = map[int32]string{
+	0: "INTERNAL_ERROR",
+	1: "BAD_REQUEST",
+}
+
+var ErrorType_value = map[string]int32{
+	"INTERNAL_ERROR": 0,
+	"BAD_REQUEST":    1,
+}
+
+func (x ErrorType) String() string {
+	return proto.EnumName(ErrorType_name, int32(x))
+}
+
+func (ErrorType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{1}
+}
+
+type CompressionType int32
+
+const (
+	CompressionType_NONE   CompressionType = 0
+	CompressionType_SNAPPY CompressionType = 1
+	CompressionType_ZSTD   CompressionType = 2
+)
+
+var CompressionType_name = map[int32]string{
+	0: "NONE",
+	1: "SNAPPY",
+	2: "ZSTD",
+}
+
+var CompressionType_value = map[string]int32{
+	"NONE":   0,
+	"SNAPPY": 1,
+	"ZSTD":   2,
+}
+
+func (x CompressionType) String() string {
+	return proto.EnumName(CompressionType_name, int32(x))
+}
+
+func (CompressionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{2}
+}
+
+type AggregateQueryType int32
+
+const (
+	AggregateQueryType_AGGREGATE_BY_TAG_NAME       AggregateQueryType = 0
+	AggregateQueryType_AGGREGATE_BY_TAG_NAME_VALUE AggregateQueryType = 1
+)
+
+var AggregateQueryType_name = map[int32]string{
+	0: "AGGREGATE_BY_TAG_NAME",
+	1: "AGGREGATE_BY_TAG_NAME_VALUE",
+}
+
+var AggregateQueryType_value = map[string]int32{
+	"AGGREGATE_BY_TAG_NAME":       0,
+	"AGGREGATE_BY_TAG_NAME_VALUE": 1,
+}
+
+func (x AggregateQueryType) String() string {
+	return proto.EnumName(AggregateQueryType_name, int32(x))
+}
+
+func (AggregateQueryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{3}
+}
+
+// Error is returned in the trailer of failed calls and for the failed
+// elements of results.
+type Error struct {
+	Type    ErrorType `protobuf:"varint,1,opt,name=type,proto3,enum=node.ErrorType" json:"type,omitempty"`
+	Message string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *Error) Reset()         { *m = Error{} }
+func (m *Error) String() string { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()    {}
+func (*Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{0}
+}
+func (m *Error) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(m, src)
+}
+func (m *Error) XXX_Size() int {
+	return m.Size()
+}
+func (m *Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_Error.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Error proto.InternalMessageInfo
+
+func (m *Error) GetType() ErrorType {
+	if m != nil {
+		return m.Type
+	}
+	return ErrorType_INTERNAL_ERROR
+}
+
+func (m *Error) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// WriteBatchRawErrors is returned in the trailer of failed batch writes.
+type WriteBatchRawErrors struct {
+	Errors []*WriteBatchRawError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *WriteBatchRawErrors) Reset()         { *m = WriteBatchRawErrors{} }
+func (m *WriteBatchRawErrors) String() string { return proto.CompactTextString(m) }
+func (*WriteBatchRawErrors) ProtoMessage()    {}
+func (*WriteBatchRawErrors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{1}
+}
+func (m *WriteBatchRawErrors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteBatchRawErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteBatchRawErrors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteBatchRawErrors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteBatchRawErrors.Merge(m, src)
+}
+func (m *WriteBatchRawErrors) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteBatchRawErrors) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteBatchRawErrors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteBatchRawErrors proto.InternalMessageInfo
+
+func (m *WriteBatchRawErrors) GetErrors() []*WriteBatchRawError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type WriteBatchRawError struct {
+	Index int64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Err   *Error `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (m *WriteBatchRawError) Reset()         { *m = WriteBatchRawError{} }
+func (m *WriteBatchRawError) String() string { return proto.CompactTextString(m) }
+func (*WriteBatchRawError) ProtoMessage()    {}
+func (*WriteBatchRawError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{2}
+}
+func (m *WriteBatchRawError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteBatchRawError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteBatchRawError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteBatchRawError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteBatchRawError.Merge(m, src)
+}
+func (m *WriteBatchRawError) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteBatchRawError) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteBatchRawError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteBatchRawError proto.InternalMessageInfo
+
+func (m *WriteBatchRawError) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *WriteBatchRawError) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+type Datapoint struct {
+	Timestamp         int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value             float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Annotation        []byte   `protobuf:"bytes,3,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	TimestampTimeType TimeType `protobuf:"varint,4,opt,name=timestampTimeType,proto3,enum=node.TimeType" json:"timestampTimeType,omitempty"`
+}
+
+func (m *Datapoint) Reset()         { *m = Datapoint{} }
+func (m *Datapoint) String() string { return proto.CompactTextString(m) }
+func (*Datapoint) ProtoMessage()    {}
+func (*Datapoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{3}
+}
+func (m *Datapoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Datapoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Datapoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Datapoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Datapoint.Merge(m, src)
+}
+func (m *Datapoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Datapoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Datapoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Datapoint proto.InternalMessageInfo
+
+func (m *Datapoint) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Datapoint) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Datapoint) GetAnnotation() []byte {
+	if m != nil {
+		return m.Annotation
+	}
+	return nil
+}
+
+func (m *Datapoint) GetTimestampTimeType() TimeType {
+	if m != nil {
+		return m.TimestampTimeType
+	}
+	return TimeType_UNIX_SECONDS
+}
+
+type Tag struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Tag) Reset()         { *m = Tag{} }
+func (m *Tag) String() string { return proto.CompactTextString(m) }
+func (*Tag) ProtoMessage()    {}
+func (*Tag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{4}
+}
+func (m *Tag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tag.Merge(m, src)
+}
+func (m *Tag) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tag) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tag proto.InternalMessageInfo
+
+func (m *Tag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Tag) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type WriteRequest struct {
+	NameSpace string     `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Id        string     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Datapoint *Datapoint `protobuf:"bytes,3,opt,name=datapoint,proto3" json:"datapoint,omitempty"`
+}
+
+func (m *WriteRequest) Reset()         { *m = WriteRequest{} }
+func (m *WriteRequest) String() string { return proto.CompactTextString(m) }
+func (*WriteRequest) ProtoMessage()    {}
+func (*WriteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{5}
+}
+func (m *WriteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteRequest.Merge(m, src)
+}
+func (m *WriteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteRequest proto.InternalMessageInfo
+
+func (m *WriteRequest) GetNameSpace() string {
+	if m != nil {
+		return m.NameSpace
+	}
+	return ""
+}
+
+func (m *WriteRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WriteRequest) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+type WriteResult struct {
+}
+
+func (m *WriteResult) Reset()         { *m = WriteResult{} }
+func (m *WriteResult) String() string { return proto.CompactTextString(m) }
+func (*WriteResult) ProtoMessage()    {}
+func (*WriteResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{6}
+}
+func (m *WriteResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteResult.Merge(m, src)
+}
+func (m *WriteResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteResult proto.InternalMessageInfo
+
+type WriteTaggedRequest struct {
+	NameSpace string     `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Id        string     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Tags      []*Tag     `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Datapoint *Datapoint `protobuf:"bytes,4,opt,name=datapoint,proto3" json:"datapoint,omitempty"`
+}
+
+func (m *WriteTaggedRequest) Reset()         { *m = WriteTaggedRequest{} }
+func (m *WriteTaggedRequest) String() string { return proto.CompactTextString(m) }
+func (*WriteTaggedRequest) ProtoMessage()    {}
+func (*WriteTaggedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{7}
+}
+func (m *WriteTaggedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteTaggedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteTaggedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteTaggedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteTaggedRequest.Merge(m, src)
+}
+func (m *WriteTaggedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteTaggedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteTaggedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteTaggedRequest proto.InternalMessageInfo
+
+func (m *WriteTaggedRequest) GetNameSpace() string {
+	if m != nil {
+		return m.NameSpace
+	}
+	return ""
+}
+
+func (m *WriteTaggedRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WriteTaggedRequest) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *WriteTaggedRequest) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+type WriteTaggedResult struct {
+}
+
+func (m *WriteTaggedResult) Reset()         { *m = WriteTaggedResult{} }
+func (m *WriteTaggedResult) String() string { return proto.CompactTextString(m) }
+func (*WriteTaggedResult) ProtoMessage()    {}
+func (*WriteTaggedResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{8}
+}
+func (m *WriteTaggedResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteTaggedResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteTaggedResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteTaggedResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteTaggedResult.Merge(m, src)
+}
+func (m *WriteTaggedResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteTaggedResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteTaggedResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteTaggedResult proto.InternalMessageInfo
+
+type WriteBatchRawRequest struct {
+	NameSpace []byte                         `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Elements  []*WriteBatchRawRequestElement `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (m *WriteBatchRawRequest) Reset()         { *m = WriteBatchRawRequest{} }
+func (m *WriteBatchRawRequest) String() string { return proto.CompactTextString(m) }
+func (*WriteBatchRawRequest) ProtoMessage()    {}
+func (*WriteBatchRawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{9}
+}
+func (m *WriteBatchRawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteBatchRawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteBatchRawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteBatchRawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteBatchRawRequest.Merge(m, src)
+}
+func (m *WriteBatchRawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteBatchRawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteBatchRawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteBatchRawRequest proto.InternalMessageInfo
+
+func (m *WriteBatchRawRequest) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *WriteBatchRawRequest) GetElements() []*WriteBatchRawRequestElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type WriteBatchRawRequestElement struct {
+	Id        []byte     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Datapoint *Datapoint `protobuf:"bytes,2,opt,name=datapoint,proto3" json:"datapoint,omitempty"`
+}
+
+func (m *WriteBatchRawRequestElement) Reset()         { *m = WriteBatchRawRequestElement{} }
+func (m *WriteBatchRawRequestElement) String() string { return proto.CompactTextString(m) }
+func (*WriteBatchRawRequestElement) ProtoMessage()    {}
+func (*WriteBatchRawRequestElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{10}
+}
+func (m *WriteBatchRawRequestElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteBatchRawRequestElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteBatchRawRequestElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteBatchRawRequestElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteBatchRawRequestElement.Merge(m, src)
+}
+func (m *WriteBatchRawRequestElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteBatchRawRequestElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteBatchRawRequestElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteBatchRawRequestElement proto.InternalMessageInfo
+
+func (m *WriteBatchRawRequestElement) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *WriteBatchRawRequestElement) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+type WriteBatchRawV2Request struct {
+	NameSpaces [][]byte                         `protobuf:"bytes,1,rep,name=nameSpaces,proto3" json:"nameSpaces,omitempty"`
+	Elements   []*WriteBatchRawV2RequestElement `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (m *WriteBatchRawV2Request) Reset()         { *m = WriteBatchRawV2Request{} }
+func (m *WriteBatchRawV2Request) String() string { return proto.CompactTextString(m) }
+func (*WriteBatchRawV2Request) ProtoMessage()    {}
+func (*WriteBatchRawV2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{11}
+}
+func (m *WriteBatchRawV2Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteBatchRawV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteBatchRawV2Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteBatchRawV2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteBatchRawV2Request.Merge(m, src)
+}
+func (m *WriteBatchRawV2Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteBatchRawV2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteBatchRawV2Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteBatchRawV2Request proto.InternalMessageInfo
+
+func (m *WriteBatchRawV2Request) GetNameSpaces() [][]byte {
+	if m != nil {
+		return m.NameSpaces
+	}
+	return nil
+}
+
+func (m *WriteBatchRawV2Request) GetElements() []*WriteBatchRawV2RequestElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type WriteBatchRawV2RequestElement struct {
+	Id        []byte     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Datapoint *Datapoint `protobuf:"bytes,2,opt,name=datapoint,proto3" json:"datapoint,omitempty"`
+	NameSpace int64      `protobuf:"varint,3,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+}
+
+func (m *WriteBatchRawV2RequestElement) Reset()         { *m = WriteBatchRawV2RequestElement{} }
+func (m *WriteBatchRawV2RequestElement) String() string { return proto.CompactTextString(m) }
+func (*WriteBatchRawV2RequestElement) ProtoMessage()    {}
+func (*WriteBatchRawV2RequestElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{12}
+}
+func (m *WriteBatchRawV2RequestElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteBatchRawV2RequestElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteBatchRawV2RequestElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteBatchRawV2RequestElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteBatchRawV2RequestElement.Merge(m, src)
+}
+func (m *WriteBatchRawV2RequestElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteBatchRawV2RequestElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteBatchRawV2RequestElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteBatchRawV2RequestElement proto.InternalMessageInfo
+
+func (m *WriteBatchRawV2RequestElement) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *WriteBatchRawV2RequestElement) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+func (m *WriteBatchRawV2RequestElement) GetNameSpace() int64 {
+	if m != nil {
+		return m.NameSpace
+	}
+	return 0
+}
+
+type WriteTaggedBatchRawRequest struct {
+	NameSpace []byte                               `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Elements  []*WriteTaggedBatchRawRequestElement `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (m *WriteTaggedBatchRawRequest) Reset()         { *m = WriteTaggedBatchRawRequest{} }
+func (m *WriteTaggedBatchRawRequest) String() string { return proto.CompactTextString(m) }
+func (*WriteTaggedBatchRawRequest) ProtoMessage()    {}
+func (*WriteTaggedBatchRawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{13}
+}
+func (m *WriteTaggedBatchRawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteTaggedBatchRawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteTaggedBatchRawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteTaggedBatchRawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteTaggedBatchRawRequest.Merge(m, src)
+}
+func (m *WriteTaggedBatchRawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteTaggedBatchRawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteTaggedBatchRawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteTaggedBatchRawRequest proto.InternalMessageInfo
+
+func (m *WriteTaggedBatchRawRequest) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *WriteTaggedBatchRawRequest) GetElements() []*WriteTaggedBatchRawRequestElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type WriteTaggedBatchRawRequestElement struct {
+	Id          []byte     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EncodedTags []byte     `protobuf:"bytes,2,opt,name=encodedTags,proto3" json:"encodedTags,omitempty"`
+	Datapoint   *Datapoint `protobuf:"bytes,3,opt,name=datapoint,proto3" json:"datapoint,omitempty"`
+}
+
+func (m *WriteTaggedBatchRawRequestElement) Reset()         { *m = WriteTaggedBatchRawRequestElement{} }
+func (m *WriteTaggedBatchRawRequestElement) String() string { return proto.CompactTextString(m) }
+func (*WriteTaggedBatchRawRequestElement) ProtoMessage()    {}
+func (*WriteTaggedBatchRawRequestElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{14}
+}
+func (m *WriteTaggedBatchRawRequestElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteTaggedBatchRawRequestElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteTaggedBatchRawRequestElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteTaggedBatchRawRequestElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteTaggedBatchRawRequestElement.Merge(m, src)
+}
+func (m *WriteTaggedBatchRawRequestElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteTaggedBatchRawRequestElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteTaggedBatchRawRequestElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteTaggedBatchRawRequestElement proto.InternalMessageInfo
+
+func (m *WriteTaggedBatchRawRequestElement) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *WriteTaggedBatchRawRequestElement) GetEncodedTags() []byte {
+	if m != nil {
+		return m.EncodedTags
+	}
+	return nil
+}
+
+func (m *WriteTaggedBatchRawRequestElement) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+type WriteTaggedBatchRawV2Request struct {
+	NameSpaces [][]byte                               `protobuf:"bytes,1,rep,name=nameSpaces,proto3" json:"nameSpaces,omitempty"`
+	Elements   []*WriteTaggedBatchRawV2RequestElement `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (m *WriteTaggedBatchRawV2Request) Reset()         { *m = WriteTaggedBatchRawV2Request{} }
+func (m *WriteTaggedBatchRawV2Request) String() string { return proto.CompactTextString(m) }
+func (*WriteTaggedBatchRawV2Request) ProtoMessage()    {}
+func (*WriteTaggedBatchRawV2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{15}
+}
+func (m *WriteTaggedBatchRawV2Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteTaggedBatchRawV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteTaggedBatchRawV2Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteTaggedBatchRawV2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteTaggedBatchRawV2Request.Merge(m, src)
+}
+func (m *WriteTaggedBatchRawV2Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteTaggedBatchRawV2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteTaggedBatchRawV2Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteTaggedBatchRawV2Request proto.InternalMessageInfo
+
+func (m *WriteTaggedBatchRawV2Request) GetNameSpaces() [][]byte {
+	if m != nil {
+		return m.NameSpaces
+	}
+	return nil
+}
+
+func (m *WriteTaggedBatchRawV2Request) GetElements() []*WriteTaggedBatchRawV2RequestElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type WriteTaggedBatchRawV2RequestElement struct {
+	Id          []byte     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EncodedTags []byte     `protobuf:"bytes,2,opt,name=encodedTags,proto3" json:"encodedTags,omitempty"`
+	Datapoint   *Datapoint `protobuf:"bytes,3,opt,name=datapoint,proto3" json:"datapoint,omitempty"`
+	NameSpace   int64      `protobuf:"varint,4,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+}
+
+func (m *WriteTaggedBatchRawV2RequestElement) Reset()         { *m = WriteTaggedBatchRawV2RequestElement{} }
+func (m *WriteTaggedBatchRawV2RequestElement) String() string { return proto.CompactTextString(m) }
+func (*WriteTaggedBatchRawV2RequestElement) ProtoMessage()    {}
+func (*WriteTaggedBatchRawV2RequestElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{16}
+}
+func (m *WriteTaggedBatchRawV2RequestElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteTaggedBatchRawV2RequestElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteTaggedBatchRawV2RequestElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteTaggedBatchRawV2RequestElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteTaggedBatchRawV2RequestElement.Merge(m, src)
+}
+func (m *WriteTaggedBatchRawV2RequestElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteTaggedBatchRawV2RequestElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteTaggedBatchRawV2RequestElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteTaggedBatchRawV2RequestElement proto.InternalMessageInfo
+
+func (m *WriteTaggedBatchRawV2RequestElement) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *WriteTaggedBatchRawV2RequestElement) GetEncodedTags() []byte {
+	if m != nil {
+		return m.EncodedTags
+	}
+	return nil
+}
+
+func (m *WriteTaggedBatchRawV2RequestElement) GetDatapoint() *Datapoint {
+	if m != nil {
+		return m.Datapoint
+	}
+	return nil
+}
+
+func (m *WriteTaggedBatchRawV2RequestElement) GetNameSpace() int64 {
+	if m != nil {
+		return m.NameSpace
+	}
+	return 0
+}
+
+type WriteBatchRawResult struct {
+}
+
+func (m *WriteBatchRawResult) Reset()         { *m = WriteBatchRawResult{} }
+func (m *WriteBatchRawResult) String() string { return proto.CompactTextString(m) }
+func (*WriteBatchRawResult) ProtoMessage()    {}
+func (*WriteBatchRawResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{17}
+}
+func (m *WriteBatchRawResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WriteBatchRawResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WriteBatchRawResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WriteBatchRawResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WriteBatchRawResult.Merge(m, src)
+}
+func (m *WriteBatchRawResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *WriteBatchRawResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WriteBatchRawResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WriteBatchRawResult proto.InternalMessageInfo
+
+type FetchBatchRawRequest struct {
+	RangeStart    int64    `protobuf:"varint,1,opt,name=rangeStart,proto3" json:"rangeStart,omitempty"`
+	RangeEnd      int64    `protobuf:"varint,2,opt,name=rangeEnd,proto3" json:"rangeEnd,omitempty"`
+	NameSpace     []byte   `protobuf:"bytes,3,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Ids           [][]byte `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+	RangeTimeType TimeType `protobuf:"varint,5,opt,name=rangeTimeType,proto3,enum=node.TimeType" json:"rangeTimeType,omitempty"`
+}
+
+func (m *FetchBatchRawRequest) Reset()         { *m = FetchBatchRawRequest{} }
+func (m *FetchBatchRawRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchBatchRawRequest) ProtoMessage()    {}
+func (*FetchBatchRawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{18}
+}
+func (m *FetchBatchRawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBatchRawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBatchRawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBatchRawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBatchRawRequest.Merge(m, src)
+}
+func (m *FetchBatchRawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBatchRawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBatchRawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBatchRawRequest proto.InternalMessageInfo
+
+func (m *FetchBatchRawRequest) GetRangeStart() int64 {
+	if m != nil {
+		return m.RangeStart
+	}
+	return 0
+}
+
+func (m *FetchBatchRawRequest) GetRangeEnd() int64 {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return 0
+}
+
+func (m *FetchBatchRawRequest) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *FetchBatchRawRequest) GetIds() [][]byte {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *FetchBatchRawRequest) GetRangeTimeType() TimeType {
+	if m != nil {
+		return m.RangeTimeType
+	}
+	return TimeType_UNIX_SECONDS
+}
+
+type FetchBatchRawV2Request struct {
+	NameSpaces [][]byte                         `protobuf:"bytes,1,rep,name=nameSpaces,proto3" json:"nameSpaces,omitempty"`
+	Elements   []*FetchBatchRawV2RequestElement `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (m *FetchBatchRawV2Request) Reset()         { *m = FetchBatchRawV2Request{} }
+func (m *FetchBatchRawV2Request) String() string { return proto.CompactTextString(m) }
+func (*FetchBatchRawV2Request) ProtoMessage()    {}
+func (*FetchBatchRawV2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{19}
+}
+func (m *FetchBatchRawV2Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBatchRawV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBatchRawV2Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBatchRawV2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBatchRawV2Request.Merge(m, src)
+}
+func (m *FetchBatchRawV2Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBatchRawV2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBatchRawV2Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBatchRawV2Request proto.InternalMessageInfo
+
+func (m *FetchBatchRawV2Request) GetNameSpaces() [][]byte {
+	if m != nil {
+		return m.NameSpaces
+	}
+	return nil
+}
+
+func (m *FetchBatchRawV2Request) GetElements() []*FetchBatchRawV2RequestElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type FetchBatchRawV2RequestElement struct {
+	NameSpace     int64    `protobuf:"varint,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	RangeStart    int64    `protobuf:"varint,2,opt,name=rangeStart,proto3" json:"rangeStart,omitempty"`
+	RangeEnd      int64    `protobuf:"varint,3,opt,name=rangeEnd,proto3" json:"rangeEnd,omitempty"`
+	Id            []byte   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	RangeTimeType TimeType `protobuf:"varint,5,opt,name=rangeTimeType,proto3,enum=node.TimeType" json:"rangeTimeType,omitempty"`
+}
+
+func (m *FetchBatchRawV2RequestElement) Reset()         { *m = FetchBatchRawV2RequestElement{} }
+func (m *FetchBatchRawV2RequestElement) String() string { return proto.CompactTextString(m) }
+func (*FetchBatchRawV2RequestElement) ProtoMessage()    {}
+func (*FetchBatchRawV2RequestElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{20}
+}
+func (m *FetchBatchRawV2RequestElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBatchRawV2RequestElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBatchRawV2RequestElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBatchRawV2RequestElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBatchRawV2RequestElement.Merge(m, src)
+}
+func (m *FetchBatchRawV2RequestElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBatchRawV2RequestElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBatchRawV2RequestElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBatchRawV2RequestElement proto.InternalMessageInfo
+
+func (m *FetchBatchRawV2RequestElement) GetNameSpace() int64 {
+	if m != nil {
+		return m.NameSpace
+	}
+	return 0
+}
+
+func (m *FetchBatchRawV2RequestElement) GetRangeStart() int64 {
+	if m != nil {
+		return m.RangeStart
+	}
+	return 0
+}
+
+func (m *FetchBatchRawV2RequestElement) GetRangeEnd() int64 {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return 0
+}
+
+func (m *FetchBatchRawV2RequestElement) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *FetchBatchRawV2RequestElement) GetRangeTimeType() TimeType {
+	if m != nil {
+		return m.RangeTimeType
+	}
+	return TimeType_UNIX_SECONDS
+}
+
+type FetchBatchRawResult struct {
+	Elements []*FetchRawResult `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (m *FetchBatchRawResult) Reset()         { *m = FetchBatchRawResult{} }
+func (m *FetchBatchRawResult) String() string { return proto.CompactTextString(m) }
+func (*FetchBatchRawResult) ProtoMessage()    {}
+func (*FetchBatchRawResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{21}
+}
+func (m *FetchBatchRawResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBatchRawResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBatchRawResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBatchRawResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBatchRawResult.Merge(m, src)
+}
+func (m *FetchBatchRawResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBatchRawResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBatchRawResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBatchRawResult proto.InternalMessageInfo
+
+func (m *FetchBatchRawResult) GetElements() []*FetchRawResult {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type FetchRawResult struct {
+	Segments []*Segments `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	Err      *Error      `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (m *FetchRawResult) Reset()         { *m = FetchRawResult{} }
+func (m *FetchRawResult) String() string { return proto.CompactTextString(m) }
+func (*FetchRawResult) ProtoMessage()    {}
+func (*FetchRawResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{22}
+}
+func (m *FetchRawResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchRawResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchRawResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchRawResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchRawResult.Merge(m, src)
+}
+func (m *FetchRawResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchRawResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchRawResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchRawResult proto.InternalMessageInfo
+
+func (m *FetchRawResult) GetSegments() []*Segments {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *FetchRawResult) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+type Segments struct {
+	Merged   *Segment   `protobuf:"bytes,1,opt,name=merged,proto3" json:"merged,omitempty"`
+	Unmerged []*Segment `protobuf:"bytes,2,rep,name=unmerged,proto3" json:"unmerged,omitempty"`
+}
+
+func (m *Segments) Reset()         { *m = Segments{} }
+func (m *Segments) String() string { return proto.CompactTextString(m) }
+func (*Segments) ProtoMessage()    {}
+func (*Segments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{23}
+}
+func (m *Segments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Segments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Segments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Segments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Segments.Merge(m, src)
+}
+func (m *Segments) XXX_Size() int {
+	return m.Size()
+}
+func (m *Segments) XXX_DiscardUnknown() {
+	xxx_messageInfo_Segments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Segments proto.InternalMessageInfo
+
+func (m *Segments) GetMerged() *Segment {
+	if m != nil {
+		return m.Merged
+	}
+	return nil
+}
+
+func (m *Segments) GetUnmerged() []*Segment {
+	if m != nil {
+		return m.Unmerged
+	}
+	return nil
+}
+
+type Segment struct {
+	Head      []byte            `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Tail      []byte            `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty"`
+	StartTime int64             `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	BlockSize int64             `protobuf:"varint,4,opt,name=blockSize,proto3" json:"blockSize,omitempty"`
+	Checksum  *types.Int64Value `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *Segment) Reset()         { *m = Segment{} }
+func (m *Segment) String() string { return proto.CompactTextString(m) }
+func (*Segment) ProtoMessage()    {}
+func (*Segment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{24}
+}
+func (m *Segment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Segment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Segment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Segment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Segment.Merge(m, src)
+}
+func (m *Segment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Segment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Segment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Segment proto.InternalMessageInfo
+
+func (m *Segment) GetHead() []byte {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *Segment) GetTail() []byte {
+	if m != nil {
+		return m.Tail
+	}
+	return nil
+}
+
+func (m *Segment) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Segment) GetBlockSize() int64 {
+	if m != nil {
+		return m.BlockSize
+	}
+	return 0
+}
+
+func (m *Segment) GetChecksum() *types.Int64Value {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+type FetchTaggedRequest struct {
+	NameSpace         []byte   `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Query             []byte   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	RangeStart        int64    `protobuf:"varint,3,opt,name=rangeStart,proto3" json:"rangeStart,omitempty"`
+	RangeEnd          int64    `protobuf:"varint,4,opt,name=rangeEnd,proto3" json:"rangeEnd,omitempty"`
+	FetchData         bool     `protobuf:"varint,5,opt,name=fetchData,proto3" json:"fetchData,omitempty"`
+	Limit             int64    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	RangeTimeType     TimeType `protobuf:"varint,7,opt,name=rangeTimeType,proto3,enum=node.TimeType" json:"rangeTimeType,omitempty"`
+	RequireExhaustive bool     `protobuf:"varint,8,opt,name=requireExhaustive,proto3" json:"requireExhaustive,omitempty"`
+	DocsLimit         int64    `protobuf:"varint,9,opt,name=docsLimit,proto3" json:"docsLimit,omitempty"`
+}
+
+func (m *FetchTaggedRequest) Reset()         { *m = FetchTaggedRequest{} }
+func (m *FetchTaggedRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchTaggedRequest) ProtoMessage()    {}
+func (*FetchTaggedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{25}
+}
+func (m *FetchTaggedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchTaggedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchTaggedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchTaggedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchTaggedRequest.Merge(m, src)
+}
+func (m *FetchTaggedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchTaggedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchTaggedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchTaggedRequest proto.InternalMessageInfo
+
+func (m *FetchTaggedRequest) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *FetchTaggedRequest) GetQuery() []byte {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *FetchTaggedRequest) GetRangeStart() int64 {
+	if m != nil {
+		return m.RangeStart
+	}
+	return 0
+}
+
+func (m *FetchTaggedRequest) GetRangeEnd() int64 {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return 0
+}
+
+func (m *FetchTaggedRequest) GetFetchData() bool {
+	if m != nil {
+		return m.FetchData
+	}
+	return false
+}
+
+func (m *FetchTaggedRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *FetchTaggedRequest) GetRangeTimeType() TimeType {
+	if m != nil {
+		return m.RangeTimeType
+	}
+	return TimeType_UNIX_SECONDS
+}
+
+func (m *FetchTaggedRequest) GetRequireExhaustive() bool {
+	if m != nil {
+		return m.RequireExhaustive
+	}
+	return false
+}
+
+func (m *FetchTaggedRequest) GetDocsLimit() int64 {
+	if m != nil {
+		return m.DocsLimit
+	}
+	return 0
+}
+
+type FetchTaggedResult struct {
+	Elements   []*FetchTaggedIDResult `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+	Exhaustive bool                   `protobuf:"varint,2,opt,name=exhaustive,proto3" json:"exhaustive,omitempty"`
+}
+
+func (m *FetchTaggedResult) Reset()         { *m = FetchTaggedResult{} }
+func (m *FetchTaggedResult) String() string { return proto.CompactTextString(m) }
+func (*FetchTaggedResult) ProtoMessage()    {}
+func (*FetchTaggedResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{26}
+}
+func (m *FetchTaggedResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchTaggedResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchTaggedResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchTaggedResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchTaggedResult.Merge(m, src)
+}
+func (m *FetchTaggedResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchTaggedResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchTaggedResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchTaggedResult proto.InternalMessageInfo
+
+func (m *FetchTaggedResult) GetElements() []*FetchTaggedIDResult {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+func (m *FetchTaggedResult) GetExhaustive() bool {
+	if m != nil {
+		return m.Exhaustive
+	}
+	return false
+}
+
+type FetchTaggedIDResult struct {
+	Id          []byte      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NameSpace   []byte      `protobuf:"bytes,2,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	EncodedTags []byte      `protobuf:"bytes,3,opt,name=encodedTags,proto3" json:"encodedTags,omitempty"`
+	Segments    []*Segments `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	Err         *Error      `protobuf:"bytes,5,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (m *FetchTaggedIDResult) Reset()         { *m = FetchTaggedIDResult{} }
+func (m *FetchTaggedIDResult) String() string { return proto.CompactTextString(m) }
+func (*FetchTaggedIDResult) ProtoMessage()    {}
+func (*FetchTaggedIDResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{27}
+}
+func (m *FetchTaggedIDResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchTaggedIDResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchTaggedIDResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchTaggedIDResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchTaggedIDResult.Merge(m, src)
+}
+func (m *FetchTaggedIDResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchTaggedIDResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchTaggedIDResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchTaggedIDResult proto.InternalMessageInfo
+
+func (m *FetchTaggedIDResult) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *FetchTaggedIDResult) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *FetchTaggedIDResult) GetEncodedTags() []byte {
+	if m != nil {
+		return m.EncodedTags
+	}
+	return nil
+}
+
+func (m *FetchTaggedIDResult) GetSegments() []*Segments {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *FetchTaggedIDResult) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+type FetchBlocksRawRequest struct {
+	NameSpace []byte                          `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Shard     int32                           `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Elements  []*FetchBlocksRawRequestElement `protobuf:"bytes,3,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (m *FetchBlocksRawRequest) Reset()         { *m = FetchBlocksRawRequest{} }
+func (m *FetchBlocksRawRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchBlocksRawRequest) ProtoMessage()    {}
+func (*FetchBlocksRawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{28}
+}
+func (m *FetchBlocksRawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBlocksRawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBlocksRawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBlocksRawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBlocksRawRequest.Merge(m, src)
+}
+func (m *FetchBlocksRawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBlocksRawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBlocksRawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBlocksRawRequest proto.InternalMessageInfo
+
+func (m *FetchBlocksRawRequest) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *FetchBlocksRawRequest) GetShard() int32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *FetchBlocksRawRequest) GetElements() []*FetchBlocksRawRequestElement {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type FetchBlocksRawRequestElement struct {
+	Id     []byte  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Starts []int64 `protobuf:"varint,2,rep,packed,name=starts,proto3" json:"starts,omitempty"`
+}
+
+func (m *FetchBlocksRawRequestElement) Reset()         { *m = FetchBlocksRawRequestElement{} }
+func (m *FetchBlocksRawRequestElement) String() string { return proto.CompactTextString(m) }
+func (*FetchBlocksRawRequestElement) ProtoMessage()    {}
+func (*FetchBlocksRawRequestElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{29}
+}
+func (m *FetchBlocksRawRequestElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBlocksRawRequestElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBlocksRawRequestElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBlocksRawRequestElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBlocksRawRequestElement.Merge(m, src)
+}
+func (m *FetchBlocksRawRequestElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBlocksRawRequestElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBlocksRawRequestElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBlocksRawRequestElement proto.InternalMessageInfo
+
+func (m *FetchBlocksRawRequestElement) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *FetchBlocksRawRequestElement) GetStarts() []int64 {
+	if m != nil {
+		return m.Starts
+	}
+	return nil
+}
+
+type FetchBlocksRawResult struct {
+	Elements []*Blocks `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (m *FetchBlocksRawResult) Reset()         { *m = FetchBlocksRawResult{} }
+func (m *FetchBlocksRawResult) String() string { return proto.CompactTextString(m) }
+func (*FetchBlocksRawResult) ProtoMessage()    {}
+func (*FetchBlocksRawResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{30}
+}
+func (m *FetchBlocksRawResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBlocksRawResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBlocksRawResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBlocksRawResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBlocksRawResult.Merge(m, src)
+}
+func (m *FetchBlocksRawResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBlocksRawResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBlocksRawResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBlocksRawResult proto.InternalMessageInfo
+
+func (m *FetchBlocksRawResult) GetElements() []*Blocks {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+type Blocks struct {
+	Id     []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Blocks []*Block `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *Blocks) Reset()         { *m = Blocks{} }
+func (m *Blocks) String() string { return proto.CompactTextString(m) }
+func (*Blocks) ProtoMessage()    {}
+func (*Blocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{31}
+}
+func (m *Blocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Blocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Blocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Blocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Blocks.Merge(m, src)
+}
+func (m *Blocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *Blocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Blocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Blocks proto.InternalMessageInfo
+
+func (m *Blocks) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *Blocks) GetBlocks() []*Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type Block struct {
+	Start    int64             `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Segments *Segments         `protobuf:"bytes,2,opt,name=segments,proto3" json:"segments,omitempty"`
+	Err      *Error            `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	Checksum *types.Int64Value `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (m *Block) Reset()         { *m = Block{} }
+func (m *Block) String() string { return proto.CompactTextString(m) }
+func (*Block) ProtoMessage()    {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{32}
+}
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Block.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
+}
+func (m *Block) XXX_Size() int {
+	return m.Size()
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *Block) GetSegments() *Segments {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *Block) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *Block) GetChecksum() *types.Int64Value {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+type FetchBlocksMetadataRawV2Request struct {
+	NameSpace        []byte `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Shard            int32  `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	RangeStart       int64  `protobuf:"varint,3,opt,name=rangeStart,proto3" json:"rangeStart,omitempty"`
+	RangeEnd         int64  `protobuf:"varint,4,opt,name=rangeEnd,proto3" json:"rangeEnd,omitempty"`
+	Limit            int64  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken        []byte `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	IncludeSizes     bool   `protobuf:"varint,7,opt,name=includeSizes,proto3" json:"includeSizes,omitempty"`
+	IncludeChecksums bool   `protobuf:"varint,8,opt,name=includeChecksums,proto3" json:"includeChecksums,omitempty"`
+	IncludeLastRead  bool   `protobuf:"varint,9,opt,name=includeLastRead,proto3" json:"includeLastRead,omitempty"`
+}
+
+func (m *FetchBlocksMetadataRawV2Request) Reset()         { *m = FetchBlocksMetadataRawV2Request{} }
+func (m *FetchBlocksMetadataRawV2Request) String() string { return proto.CompactTextString(m) }
+func (*FetchBlocksMetadataRawV2Request) ProtoMessage()    {}
+func (*FetchBlocksMetadataRawV2Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{33}
+}
+func (m *FetchBlocksMetadataRawV2Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBlocksMetadataRawV2Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBlocksMetadataRawV2Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBlocksMetadataRawV2Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBlocksMetadataRawV2Request.Merge(m, src)
+}
+func (m *FetchBlocksMetadataRawV2Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBlocksMetadataRawV2Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBlocksMetadataRawV2Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBlocksMetadataRawV2Request proto.InternalMessageInfo
+
+func (m *FetchBlocksMetadataRawV2Request) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetShard() int32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetRangeStart() int64 {
+	if m != nil {
+		return m.RangeStart
+	}
+	return 0
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetRangeEnd() int64 {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return 0
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetPageToken() []byte {
+	if m != nil {
+		return m.PageToken
+	}
+	return nil
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetIncludeSizes() bool {
+	if m != nil {
+		return m.IncludeSizes
+	}
+	return false
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetIncludeChecksums() bool {
+	if m != nil {
+		return m.IncludeChecksums
+	}
+	return false
+}
+
+func (m *FetchBlocksMetadataRawV2Request) GetIncludeLastRead() bool {
+	if m != nil {
+		return m.IncludeLastRead
+	}
+	return false
+}
+
+type FetchBlocksMetadataRawV2Result struct {
+	Elements      []*BlockMetadataV2 `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+	NextPageToken []byte             `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (m *FetchBlocksMetadataRawV2Result) Reset()         { *m = FetchBlocksMetadataRawV2Result{} }
+func (m *FetchBlocksMetadataRawV2Result) String() string { return proto.CompactTextString(m) }
+func (*FetchBlocksMetadataRawV2Result) ProtoMessage()    {}
+func (*FetchBlocksMetadataRawV2Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{34}
+}
+func (m *FetchBlocksMetadataRawV2Result) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchBlocksMetadataRawV2Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchBlocksMetadataRawV2Result.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FetchBlocksMetadataRawV2Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchBlocksMetadataRawV2Result.Merge(m, src)
+}
+func (m *FetchBlocksMetadataRawV2Result) XXX_Size() int {
+	return m.Size()
+}
+func (m *FetchBlocksMetadataRawV2Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchBlocksMetadataRawV2Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchBlocksMetadataRawV2Result proto.InternalMessageInfo
+
+func (m *FetchBlocksMetadataRawV2Result) GetElements() []*BlockMetadataV2 {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+func (m *FetchBlocksMetadataRawV2Result) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type BlockMetadataV2 struct {
+	Id               []byte            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start            int64             `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Err              *Error            `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	Size_            *types.Int64Value `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum         *types.Int64Value `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	LastRead         *types.Int64Value `protobuf:"bytes,6,opt,name=lastRead,proto3" json:"lastRead,omitempty"`
+	LastReadTimeType TimeType          `protobuf:"varint,7,opt,name=lastReadTimeType,proto3,enum=node.TimeType" json:"lastReadTimeType,omitempty"`
+	EncodedTags      []byte            `protobuf:"bytes,8,opt,name=encodedTags,proto3" json:"encodedTags,omitempty"`
+}
+
+func (m *BlockMetadataV2) Reset()         { *m = BlockMetadataV2{} }
+func (m *BlockMetadataV2) String() string { return proto.CompactTextString(m) }
+func (*BlockMetadataV2) ProtoMessage()    {}
+func (*BlockMetadataV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{35}
+}
+func (m *BlockMetadataV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockMetadataV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockMetadataV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockMetadataV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockMetadataV2.Merge(m, src)
+}
+func (m *BlockMetadataV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockMetadataV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockMetadataV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockMetadataV2 proto.InternalMessageInfo
+
+func (m *BlockMetadataV2) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *BlockMetadataV2) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *BlockMetadataV2) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *BlockMetadataV2) GetSize_() *types.Int64Value {
+	if m != nil {
+		return m.Size_
+	}
+	return nil
+}
+
+func (m *BlockMetadataV2) GetChecksum() *types.Int64Value {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *BlockMetadataV2) GetLastRead() *types.Int64Value {
+	if m != nil {
+		return m.LastRead
+	}
+	return nil
+}
+
+func (m *BlockMetadataV2) GetLastReadTimeType() TimeType {
+	if m != nil {
+		return m.LastReadTimeType
+	}
+	return TimeType_UNIX_SECONDS
+}
+
+func (m *BlockMetadataV2) GetEncodedTags() []byte {
+	if m != nil {
+		return m.EncodedTags
+	}
+	return nil
+}
+
+type TermQuery struct {
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Term  string `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+}
+
+func (m *TermQuery) Reset()         { *m = TermQuery{} }
+func (m *TermQuery) String() string { return proto.CompactTextString(m) }
+func (*TermQuery) ProtoMessage()    {}
+func (*TermQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{36}
+}
+func (m *TermQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TermQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TermQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TermQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TermQuery.Merge(m, src)
+}
+func (m *TermQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *TermQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_TermQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TermQuery proto.InternalMessageInfo
+
+func (m *TermQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *TermQuery) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+type RegexpQuery struct {
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Regexp string `protobuf:"bytes,2,opt,name=regexp,proto3" json:"regexp,omitempty"`
+}
+
+func (m *RegexpQuery) Reset()         { *m = RegexpQuery{} }
+func (m *RegexpQuery) String() string { return proto.CompactTextString(m) }
+func (*RegexpQuery) ProtoMessage()    {}
+func (*RegexpQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{37}
+}
+func (m *RegexpQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegexpQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegexpQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegexpQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegexpQuery.Merge(m, src)
+}
+func (m *RegexpQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegexpQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegexpQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegexpQuery proto.InternalMessageInfo
+
+func (m *RegexpQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *RegexpQuery) GetRegexp() string {
+	if m != nil {
+		return m.Regexp
+	}
+	return ""
+}
+
+type NegationQuery struct {
+	Query *Query `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (m *NegationQuery) Reset()         { *m = NegationQuery{} }
+func (m *NegationQuery) String() string { return proto.CompactTextString(m) }
+func (*NegationQuery) ProtoMessage()    {}
+func (*NegationQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{38}
+}
+func (m *NegationQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NegationQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NegationQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NegationQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NegationQuery.Merge(m, src)
+}
+func (m *NegationQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *NegationQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_NegationQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NegationQuery proto.InternalMessageInfo
+
+func (m *NegationQuery) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type ConjunctionQuery struct {
+	Queries []*Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (m *ConjunctionQuery) Reset()         { *m = ConjunctionQuery{} }
+func (m *ConjunctionQuery) String() string { return proto.CompactTextString(m) }
+func (*ConjunctionQuery) ProtoMessage()    {}
+func (*ConjunctionQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{39}
+}
+func (m *ConjunctionQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConjunctionQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConjunctionQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConjunctionQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConjunctionQuery.Merge(m, src)
+}
+func (m *ConjunctionQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConjunctionQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConjunctionQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConjunctionQuery proto.InternalMessageInfo
+
+func (m *ConjunctionQuery) GetQueries() []*Query {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+type DisjunctionQuery struct {
+	Queries []*Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (m *DisjunctionQuery) Reset()         { *m = DisjunctionQuery{} }
+func (m *DisjunctionQuery) String() string { return proto.CompactTextString(m) }
+func (*DisjunctionQuery) ProtoMessage()    {}
+func (*DisjunctionQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{40}
+}
+func (m *DisjunctionQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisjunctionQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisjunctionQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DisjunctionQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisjunctionQuery.Merge(m, src)
+}
+func (m *DisjunctionQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisjunctionQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisjunctionQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisjunctionQuery proto.InternalMessageInfo
+
+func (m *DisjunctionQuery) GetQueries() []*Query {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+type AllQuery struct {
+}
+
+func (m *AllQuery) Reset()         { *m = AllQuery{} }
+func (m *AllQuery) String() string { return proto.CompactTextString(m) }
+func (*AllQuery) ProtoMessage()    {}
+func (*AllQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{41}
+}
+func (m *AllQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllQuery.Merge(m, src)
+}
+func (m *AllQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllQuery proto.InternalMessageInfo
+
+type FieldQuery struct {
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (m *FieldQuery) Reset()         { *m = FieldQuery{} }
+func (m *FieldQuery) String() string { return proto.CompactTextString(m) }
+func (*FieldQuery) ProtoMessage()    {}
+func (*FieldQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{42}
+}
+func (m *FieldQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldQuery.Merge(m, src)
+}
+func (m *FieldQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldQuery proto.InternalMessageInfo
+
+func (m *FieldQuery) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+type Query struct {
+	Term        *TermQuery        `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Regexp      *RegexpQuery      `protobuf:"bytes,2,opt,name=regexp,proto3" json:"regexp,omitempty"`
+	Negation    *NegationQuery    `protobuf:"bytes,3,opt,name=negation,proto3" json:"negation,omitempty"`
+	Conjunction *ConjunctionQuery `protobuf:"bytes,4,opt,name=conjunction,proto3" json:"conjunction,omitempty"`
+	Disjunction *DisjunctionQuery `protobuf:"bytes,5,opt,name=disjunction,proto3" json:"disjunction,omitempty"`
+	All         *AllQuery         `protobuf:"bytes,6,opt,name=all,proto3" json:"all,omitempty"`
+	Field       *FieldQuery       `protobuf:"bytes,7,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (m *Query) Reset()         { *m = Query{} }
+func (m *Query) String() string { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()    {}
+func (*Query) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{43}
+}
+func (m *Query) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Query.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Query) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Query.Merge(m, src)
+}
+func (m *Query) XXX_Size() int {
+	return m.Size()
+}
+func (m *Query) XXX_DiscardUnknown() {
+	xxx_messageInfo_Query.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Query proto.InternalMessageInfo
+
+func (m *Query) GetTerm() *TermQuery {
+	if m != nil {
+		return m.Term
+	}
+	return nil
+}
+
+func (m *Query) GetRegexp() *RegexpQuery {
+	if m != nil {
+		return m.Regexp
+	}
+	return nil
+}
+
+func (m *Query) GetNegation() *NegationQuery {
+	if m != nil {
+		return m.Negation
+	}
+	return nil
+}
+
+func (m *Query) GetConjunction() *ConjunctionQuery {
+	if m != nil {
+		return m.Conjunction
+	}
+	return nil
+}
+
+func (m *Query) GetDisjunction() *DisjunctionQuery {
+	if m != nil {
+		return m.Disjunction
+	}
+	return nil
+}
+
+func (m *Query) GetAll() *AllQuery {
+	if m != nil {
+		return m.All
+	}
+	return nil
+}
+
+func (m *Query) GetField() *FieldQuery {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
+type AggregateQueryRequest struct {
+	Query              *Query             `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	RangeStart         int64              `protobuf:"varint,2,opt,name=rangeStart,proto3" json:"rangeStart,omitempty"`
+	RangeEnd           int64              `protobuf:"varint,3,opt,name=rangeEnd,proto3" json:"rangeEnd,omitempty"`
+	NameSpace          string             `protobuf:"bytes,4,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Limit              int64              `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	TagNameFilter      []string           `protobuf:"bytes,6,rep,name=tagNameFilter,proto3" json:"tagNameFilter,omitempty"`
+	AggregateQueryType AggregateQueryType `protobuf:"varint,7,opt,name=aggregateQueryType,proto3,enum=node.AggregateQueryType" json:"aggregateQueryType,omitempty"`
+	RangeType          TimeType           `protobuf:"varint,8,opt,name=rangeType,proto3,enum=node.TimeType" json:"rangeType,omitempty"`
+}
+
+func (m *AggregateQueryRequest) Reset()         { *m = AggregateQueryRequest{} }
+func (m *AggregateQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregateQueryRequest) ProtoMessage()    {}
+func (*AggregateQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{44}
+}
+func (m *AggregateQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateQueryRequest.Merge(m, src)
+}
+func (m *AggregateQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateQueryRequest proto.InternalMessageInfo
+
+func (m *AggregateQueryRequest) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *AggregateQueryRequest) GetRangeStart() int64 {
+	if m != nil {
+		return m.RangeStart
+	}
+	return 0
+}
+
+func (m *AggregateQueryRequest) GetRangeEnd() int64 {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return 0
+}
+
+func (m *AggregateQueryRequest) GetNameSpace() string {
+	if m != nil {
+		return m.NameSpace
+	}
+	return ""
+}
+
+func (m *AggregateQueryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *AggregateQueryRequest) GetTagNameFilter() []string {
+	if m != nil {
+		return m.TagNameFilter
+	}
+	return nil
+}
+
+func (m *AggregateQueryRequest) GetAggregateQueryType() AggregateQueryType {
+	if m != nil {
+		return m.AggregateQueryType
+	}
+	return AggregateQueryType_AGGREGATE_BY_TAG_NAME
+}
+
+func (m *AggregateQueryRequest) GetRangeType() TimeType {
+	if m != nil {
+		return m.RangeType
+	}
+	return TimeType_UNIX_SECONDS
+}
+
+type AggregateQueryResult struct {
+	Results    []*AggregateQueryResultTagNameElement `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Exhaustive bool                                  `protobuf:"varint,2,opt,name=exhaustive,proto3" json:"exhaustive,omitempty"`
+}
+
+func (m *AggregateQueryResult) Reset()         { *m = AggregateQueryResult{} }
+func (m *AggregateQueryResult) String() string { return proto.CompactTextString(m) }
+func (*AggregateQueryResult) ProtoMessage()    {}
+func (*AggregateQueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{45}
+}
+func (m *AggregateQueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateQueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateQueryResult.Merge(m, src)
+}
+func (m *AggregateQueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateQueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateQueryResult proto.InternalMessageInfo
+
+func (m *AggregateQueryResult) GetResults() []*AggregateQueryResultTagNameElement {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *AggregateQueryResult) GetExhaustive() bool {
+	if m != nil {
+		return m.Exhaustive
+	}
+	return false
+}
+
+type AggregateQueryResultTagNameElement struct {
+	TagName   string                                 `protobuf:"bytes,1,opt,name=tagName,proto3" json:"tagName,omitempty"`
+	TagValues []*AggregateQueryResultTagValueElement `protobuf:"bytes,2,rep,name=tagValues,proto3" json:"tagValues,omitempty"`
+}
+
+func (m *AggregateQueryResultTagNameElement) Reset()         { *m = AggregateQueryResultTagNameElement{} }
+func (m *AggregateQueryResultTagNameElement) String() string { return proto.CompactTextString(m) }
+func (*AggregateQueryResultTagNameElement) ProtoMessage()    {}
+func (*AggregateQueryResultTagNameElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{46}
+}
+func (m *AggregateQueryResultTagNameElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateQueryResultTagNameElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateQueryResultTagNameElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateQueryResultTagNameElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateQueryResultTagNameElement.Merge(m, src)
+}
+func (m *AggregateQueryResultTagNameElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateQueryResultTagNameElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateQueryResultTagNameElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateQueryResultTagNameElement proto.InternalMessageInfo
+
+func (m *AggregateQueryResultTagNameElement) GetTagName() string {
+	if m != nil {
+		return m.TagName
+	}
+	return ""
+}
+
+func (m *AggregateQueryResultTagNameElement) GetTagValues() []*AggregateQueryResultTagValueElement {
+	if m != nil {
+		return m.TagValues
+	}
+	return nil
+}
+
+type AggregateQueryResultTagValueElement struct {
+	TagValue string `protobuf:"bytes,1,opt,name=tagValue,proto3" json:"tagValue,omitempty"`
+}
+
+func (m *AggregateQueryResultTagValueElement) Reset()         { *m = AggregateQueryResultTagValueElement{} }
+func (m *AggregateQueryResultTagValueElement) String() string { return proto.CompactTextString(m) }
+func (*AggregateQueryResultTagValueElement) ProtoMessage()    {}
+func (*AggregateQueryResultTagValueElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{47}
+}
+func (m *AggregateQueryResultTagValueElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateQueryResultTagValueElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateQueryResultTagValueElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateQueryResultTagValueElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateQueryResultTagValueElement.Merge(m, src)
+}
+func (m *AggregateQueryResultTagValueElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateQueryResultTagValueElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateQueryResultTagValueElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateQueryResultTagValueElement proto.InternalMessageInfo
+
+func (m *AggregateQueryResultTagValueElement) GetTagValue() string {
+	if m != nil {
+		return m.TagValue
+	}
+	return ""
+}
+
+type AggregateQueryRawRequest struct {
+	Query              []byte             `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	RangeStart         int64              `protobuf:"varint,2,opt,name=rangeStart,proto3" json:"rangeStart,omitempty"`
+	RangeEnd           int64              `protobuf:"varint,3,opt,name=rangeEnd,proto3" json:"rangeEnd,omitempty"`
+	NameSpace          []byte             `protobuf:"bytes,4,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Limit              int64              `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	TagNameFilter      [][]byte           `protobuf:"bytes,6,rep,name=tagNameFilter,proto3" json:"tagNameFilter,omitempty"`
+	AggregateQueryType AggregateQueryType `protobuf:"varint,7,opt,name=aggregateQueryType,proto3,enum=node.AggregateQueryType" json:"aggregateQueryType,omitempty"`
+	RangeType          TimeType           `protobuf:"varint,8,opt,name=rangeType,proto3,enum=node.TimeType" json:"rangeType,omitempty"`
+}
+
+func (m *AggregateQueryRawRequest) Reset()         { *m = AggregateQueryRawRequest{} }
+func (m *AggregateQueryRawRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregateQueryRawRequest) ProtoMessage()    {}
+func (*AggregateQueryRawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{48}
+}
+func (m *AggregateQueryRawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateQueryRawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateQueryRawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateQueryRawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateQueryRawRequest.Merge(m, src)
+}
+func (m *AggregateQueryRawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateQueryRawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateQueryRawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateQueryRawRequest proto.InternalMessageInfo
+
+func (m *AggregateQueryRawRequest) GetQuery() []byte {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *AggregateQueryRawRequest) GetRangeStart() int64 {
+	if m != nil {
+		return m.RangeStart
+	}
+	return 0
+}
+
+func (m *AggregateQueryRawRequest) GetRangeEnd() int64 {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return 0
+}
+
+func (m *AggregateQueryRawRequest) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *AggregateQueryRawRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *AggregateQueryRawRequest) GetTagNameFilter() [][]byte {
+	if m != nil {
+		return m.TagNameFilter
+	}
+	return nil
+}
+
+func (m *AggregateQueryRawRequest) GetAggregateQueryType() AggregateQueryType {
+	if m != nil {
+		return m.AggregateQueryType
+	}
+	return AggregateQueryType_AGGREGATE_BY_TAG_NAME
+}
+
+func (m *AggregateQueryRawRequest) GetRangeType() TimeType {
+	if m != nil {
+		return m.RangeType
+	}
+	return TimeType_UNIX_SECONDS
+}
+
+type AggregateQueryRawResult struct {
+	Results    []*AggregateQueryRawResultTagNameElement `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Exhaustive bool                                     `protobuf:"varint,2,opt,name=exhaustive,proto3" json:"exhaustive,omitempty"`
+}
+
+func (m *AggregateQueryRawResult) Reset()         { *m = AggregateQueryRawResult{} }
+func (m *AggregateQueryRawResult) String() string { return proto.CompactTextString(m) }
+func (*AggregateQueryRawResult) ProtoMessage()    {}
+func (*AggregateQueryRawResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{49}
+}
+func (m *AggregateQueryRawResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateQueryRawResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateQueryRawResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateQueryRawResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateQueryRawResult.Merge(m, src)
+}
+func (m *AggregateQueryRawResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateQueryRawResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateQueryRawResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateQueryRawResult proto.InternalMessageInfo
+
+func (m *AggregateQueryRawResult) GetResults() []*AggregateQueryRawResultTagNameElement {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *AggregateQueryRawResult) GetExhaustive() bool {
+	if m != nil {
+		return m.Exhaustive
+	}
+	return false
+}
+
+type AggregateQueryRawResultTagNameElement struct {
+	TagName   []byte                                    `protobuf:"bytes,1,opt,name=tagName,proto3" json:"tagName,omitempty"`
+	TagValues []*AggregateQueryRawResultTagValueElement `protobuf:"bytes,2,rep,name=tagValues,proto3" json:"tagValues,omitempty"`
+}
+
+func (m *AggregateQueryRawResultTagNameElement) Reset()         { *m = AggregateQueryRawResultTagNameElement{} }
+func (m *AggregateQueryRawResultTagNameElement) String() string { return proto.CompactTextString(m) }
+func (*AggregateQueryRawResultTagNameElement) ProtoMessage()    {}
+func (*AggregateQueryRawResultTagNameElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{50}
+}
+func (m *AggregateQueryRawResultTagNameElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateQueryRawResultTagNameElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateQueryRawResultTagNameElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateQueryRawResultTagNameElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateQueryRawResultTagNameElement.Merge(m, src)
+}
+func (m *AggregateQueryRawResultTagNameElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateQueryRawResultTagNameElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateQueryRawResultTagNameElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateQueryRawResultTagNameElement proto.InternalMessageInfo
+
+func (m *AggregateQueryRawResultTagNameElement) GetTagName() []byte {
+	if m != nil {
+		return m.TagName
+	}
+	return nil
+}
+
+func (m *AggregateQueryRawResultTagNameElement) GetTagValues() []*AggregateQueryRawResultTagValueElement {
+	if m != nil {
+		return m.TagValues
+	}
+	return nil
+}
+
+type AggregateQueryRawResultTagValueElement struct {
+	TagValue []byte `protobuf:"bytes,1,opt,name=tagValue,proto3" json:"tagValue,omitempty"`
+}
+
+func (m *AggregateQueryRawResultTagValueElement) Reset() {
+	*m = AggregateQueryRawResultTagValueElement{}
+}
+func (m *AggregateQueryRawResultTagValueElement) String() string { return proto.CompactTextString(m) }
+func (*AggregateQueryRawResultTagValueElement) ProtoMessage()    {}
+func (*AggregateQueryRawResultTagValueElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{51}
+}
+func (m *AggregateQueryRawResultTagValueElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateQueryRawResultTagValueElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateQueryRawResultTagValueElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateQueryRawResultTagValueElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateQueryRawResultTagValueElement.Merge(m, src)
+}
+func (m *AggregateQueryRawResultTagValueElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateQueryRawResultTagValueElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateQueryRawResultTagValueElement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateQueryRawResultTagValueElement proto.InternalMessageInfo
+
+func (m *AggregateQueryRawResultTagValueElement) GetTagValue() []byte {
+	if m != nil {
+		return m.TagValue
+	}
+	return nil
+}
+
+type TruncateRequest struct {
+	NameSpace []byte `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+}
+
+func (m *TruncateRequest) Reset()         { *m = TruncateRequest{} }
+func (m *TruncateRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateRequest) ProtoMessage()    {}
+func (*TruncateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{52}
+}
+func (m *TruncateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TruncateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TruncateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TruncateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateRequest.Merge(m, src)
+}
+func (m *TruncateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TruncateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateRequest proto.InternalMessageInfo
+
+func (m *TruncateRequest) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+type TruncateResult struct {
+	NumSeries int64 `protobuf:"varint,1,opt,name=numSeries,proto3" json:"numSeries,omitempty"`
+}
+
+func (m *TruncateResult) Reset()         { *m = TruncateResult{} }
+func (m *TruncateResult) String() string { return proto.CompactTextString(m) }
+func (*TruncateResult) ProtoMessage()    {}
+func (*TruncateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{53}
+}
+func (m *TruncateResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TruncateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TruncateResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TruncateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateResult.Merge(m, src)
+}
+func (m *TruncateResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TruncateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateResult proto.InternalMessageInfo
+
+func (m *TruncateResult) GetNumSeries() int64 {
+	if m != nil {
+		return m.NumSeries
+	}
+	return 0
+}
+
+type DeleteSeriesRequest struct {
+	NameSpace  []byte `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	Query      []byte `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	RangeStart int64  `protobuf:"varint,3,opt,name=rangeStart,proto3" json:"rangeStart,omitempty"`
+	RangeEnd   int64  `protobuf:"varint,4,opt,name=rangeEnd,proto3" json:"rangeEnd,omitempty"`
+}
+
+func (m *DeleteSeriesRequest) Reset()         { *m = DeleteSeriesRequest{} }
+func (m *DeleteSeriesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSeriesRequest) ProtoMessage()    {}
+func (*DeleteSeriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{54}
+}
+func (m *DeleteSeriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSeriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSeriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteSeriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSeriesRequest.Merge(m, src)
+}
+func (m *DeleteSeriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSeriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSeriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSeriesRequest proto.InternalMessageInfo
+
+func (m *DeleteSeriesRequest) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *DeleteSeriesRequest) GetQuery() []byte {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *DeleteSeriesRequest) GetRangeStart() int64 {
+	if m != nil {
+		return m.RangeStart
+	}
+	return 0
+}
+
+func (m *DeleteSeriesRequest) GetRangeEnd() int64 {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return 0
+}
+
+type DeleteSeriesResult struct {
+	NumSeries int64                      `protobuf:"varint,1,opt,name=numSeries,proto3" json:"numSeries,omitempty"`
+	Shards    []*DeleteSeriesShardResult `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+}
+
+func (m *DeleteSeriesResult) Reset()         { *m = DeleteSeriesResult{} }
+func (m *DeleteSeriesResult) String() string { return proto.CompactTextString(m) }
+func (*DeleteSeriesResult) ProtoMessage()    {}
+func (*DeleteSeriesResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{55}
+}
+func (m *DeleteSeriesResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSeriesResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSeriesResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteSeriesResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSeriesResult.Merge(m, src)
+}
+func (m *DeleteSeriesResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSeriesResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSeriesResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSeriesResult proto.InternalMessageInfo
+
+func (m *DeleteSeriesResult) GetNumSeries() int64 {
+	if m != nil {
+		return m.NumSeries
+	}
+	return 0
+}
+
+func (m *DeleteSeriesResult) GetShards() []*DeleteSeriesShardResult {
+	if m != nil {
+		return m.Shards
+	}
+	return nil
+}
+
+type DeleteSeriesShardResult struct {
+	Shard     uint32 `protobuf:"varint,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	NumSeries int64  `protobuf:"varint,2,opt,name=numSeries,proto3" json:"numSeries,omitempty"`
+}
+
+func (m *DeleteSeriesShardResult) Reset()         { *m = DeleteSeriesShardResult{} }
+func (m *DeleteSeriesShardResult) String() string { return proto.CompactTextString(m) }
+func (*DeleteSeriesShardResult) ProtoMessage()    {}
+func (*DeleteSeriesShardResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{56}
+}
+func (m *DeleteSeriesShardResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSeriesShardResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSeriesShardResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteSeriesShardResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSeriesShardResult.Merge(m, src)
+}
+func (m *DeleteSeriesShardResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSeriesShardResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSeriesShardResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSeriesShardResult proto.InternalMessageInfo
+
+func (m *DeleteSeriesShardResult) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *DeleteSeriesShardResult) GetNumSeries() int64 {
+	if m != nil {
+		return m.NumSeries
+	}
+	return 0
+}
+
+type IndexCardinalityRequest struct {
+	NameSpace     []byte `protobuf:"bytes,1,opt,name=nameSpace,proto3" json:"nameSpace,omitempty"`
+	RangeStart    int64  `protobuf:"varint,2,opt,name=rangeStart,proto3" json:"rangeStart,omitempty"`
+	RangeEnd      int64  `protobuf:"varint,3,opt,name=rangeEnd,proto3" json:"rangeEnd,omitempty"`
+	Limit         int64  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	MetricNameTag []byte `protobuf:"bytes,5,opt,name=metricNameTag,proto3" json:"metricNameTag,omitempty"`
+	Query         []byte `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (m *IndexCardinalityRequest) Reset()         { *m = IndexCardinalityRequest{} }
+func (m *IndexCardinalityRequest) String() string { return proto.CompactTextString(m) }
+func (*IndexCardinalityRequest) ProtoMessage()    {}
+func (*IndexCardinalityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{57}
+}
+func (m *IndexCardinalityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexCardinalityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexCardinalityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexCardinalityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexCardinalityRequest.Merge(m, src)
+}
+func (m *IndexCardinalityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexCardinalityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexCardinalityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexCardinalityRequest proto.InternalMessageInfo
+
+func (m *IndexCardinalityRequest) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
+	}
+	return nil
+}
+
+func (m *IndexCardinalityRequest) GetRangeStart() int64 {
+	if m != nil {
+		return m.RangeStart
+	}
+	return 0
+}
+
+func (m *IndexCardinalityRequest) GetRangeEnd() int64 {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return 0
+}
+
+func (m *IndexCardinalityRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *IndexCardinalityRequest) GetMetricNameTag() []byte {
+	if m != nil {
+		return m.MetricNameTag
+	}
+	return nil
+}
+
+func (m *IndexCardinalityRequest) GetQuery() []byte {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+type IndexCardinalityResult struct {
+	Blocks []*IndexBlockCardinality `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *IndexCardinalityResult) Reset()         { *m = IndexCardinalityResult{} }
+func (m *IndexCardinalityResult) String() string { return proto.CompactTextString(m) }
+func (*IndexCardinalityResult) ProtoMessage()    {}
+func (*IndexCardinalityResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{58}
+}
+func (m *IndexCardinalityResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexCardinalityResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexCardinalityResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexCardinalityResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexCardinalityResult.Merge(m, src)
+}
+func (m *IndexCardinalityResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexCardinalityResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexCardinalityResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexCardinalityResult proto.InternalMessageInfo
+
+func (m *IndexCardinalityResult) GetBlocks() []*IndexBlockCardinality {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type IndexBlockCardinality struct {
+	BlockStart                  int64                          `protobuf:"varint,1,opt,name=blockStart,proto3" json:"blockStart,omitempty"`
+	NumSeries                   int64                          `protobuf:"varint,2,opt,name=numSeries,proto3" json:"numSeries,omitempty"`
+	SeriesCountByMetricName     []*IndexCardinalityEntry       `protobuf:"bytes,3,rep,name=seriesCountByMetricName,proto3" json:"seriesCountByMetricName,omitempty"`
+	SeriesCountByLabelName      []*IndexCardinalityEntry       `protobuf:"bytes,4,rep,name=seriesCountByLabelName,proto3" json:"seriesCountByLabelName,omitempty"`
+	SeriesCountByLabelValuePair []*IndexCardinalityEntry       `protobuf:"bytes,5,rep,name=seriesCountByLabelValuePair,proto3" json:"seriesCountByLabelValuePair,omitempty"`
+	LabelValueCountByLabelName  []*IndexCardinalityEntry       `protobuf:"bytes,6,rep,name=labelValueCountByLabelName,proto3" json:"labelValueCountByLabelName,omitempty"`
+	LabelValuesByLabelName      []*IndexCardinalityLabelValues `protobuf:"bytes,7,rep,name=labelValuesByLabelName,proto3" json:"labelValuesByLabelName,omitempty"`
+}
+
+func (m *IndexBlockCardinality) Reset()         { *m = IndexBlockCardinality{} }
+func (m *IndexBlockCardinality) String() string { return proto.CompactTextString(m) }
+func (*IndexBlockCardinality) ProtoMessage()    {}
+func (*IndexBlockCardinality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{59}
+}
+func (m *IndexBlockCardinality) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexBlockCardinality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexBlockCardinality.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexBlockCardinality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexBlockCardinality.Merge(m, src)
+}
+func (m *IndexBlockCardinality) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexBlockCardinality) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexBlockCardinality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexBlockCardinality proto.InternalMessageInfo
+
+func (m *IndexBlockCardinality) GetBlockStart() int64 {
+	if m != nil {
+		return m.BlockStart
+	}
+	return 0
+}
+
+func (m *IndexBlockCardinality) GetNumSeries() int64 {
+	if m != nil {
+		return m.NumSeries
+	}
+	return 0
+}
+
+func (m *IndexBlockCardinality) GetSeriesCountByMetricName() []*IndexCardinalityEntry {
+	if m != nil {
+		return m.SeriesCountByMetricName
+	}
+	return nil
+}
+
+func (m *IndexBlockCardinality) GetSeriesCountByLabelName() []*IndexCardinalityEntry {
+	if m != nil {
+		return m.SeriesCountByLabelName
+	}
+	return nil
+}
+
+func (m *IndexBlockCardinality) GetSeriesCountByLabelValuePair() []*IndexCardinalityEntry {
+	if m != nil {
+		return m.SeriesCountByLabelValuePair
+	}
+	return nil
+}
+
+func (m *IndexBlockCardinality) GetLabelValueCountByLabelName() []*IndexCardinalityEntry {
+	if m != nil {
+		return m.LabelValueCountByLabelName
+	}
+	return nil
+}
+
+func (m *IndexBlockCardinality) GetLabelValuesByLabelName() []*IndexCardinalityLabelValues {
+	if m != nil {
+		return m.LabelValuesByLabelName
+	}
+	return nil
+}
+
+type IndexCardinalityEntry struct {
+	Name  []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *IndexCardinalityEntry) Reset()         { *m = IndexCardinalityEntry{} }
+func (m *IndexCardinalityEntry) String() string { return proto.CompactTextString(m) }
+func (*IndexCardinalityEntry) ProtoMessage()    {}
+func (*IndexCardinalityEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{60}
+}
+func (m *IndexCardinalityEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexCardinalityEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexCardinalityEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexCardinalityEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexCardinalityEntry.Merge(m, src)
+}
+func (m *IndexCardinalityEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexCardinalityEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexCardinalityEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexCardinalityEntry proto.InternalMessageInfo
+
+func (m *IndexCardinalityEntry) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *IndexCardinalityEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *IndexCardinalityEntry) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type IndexCardinalityLabelValues struct {
+	Name   []byte   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values [][]byte `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *IndexCardinalityLabelValues) Reset()         { *m = IndexCardinalityLabelValues{} }
+func (m *IndexCardinalityLabelValues) String() string { return proto.CompactTextString(m) }
+func (*IndexCardinalityLabelValues) ProtoMessage()    {}
+func (*IndexCardinalityLabelValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{61}
+}
+func (m *IndexCardinalityLabelValues) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexCardinalityLabelValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexCardinalityLabelValues.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexCardinalityLabelValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexCardinalityLabelValues.Merge(m, src)
+}
+func (m *IndexCardinalityLabelValues) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexCardinalityLabelValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexCardinalityLabelValues.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexCardinalityLabelValues proto.InternalMessageInfo
+
+func (m *IndexCardinalityLabelValues) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *IndexCardinalityLabelValues) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type NodeHealthRequest struct {
+}
+
+func (m *NodeHealthRequest) Reset()         { *m = NodeHealthRequest{} }
+func (m *NodeHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeHealthRequest) ProtoMessage()    {}
+func (*NodeHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{62}
+}
+func (m *NodeHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeHealthRequest.Merge(m, src)
+}
+func (m *NodeHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeHealthRequest proto.InternalMessageInfo
+
+type NodeHealthResult struct {
+	Ok           bool              `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Status       string            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Bootstrapped bool              `protobuf:"varint,3,opt,name=bootstrapped,proto3" json:"bootstrapped,omitempty"`
+	Metadata     map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compressions []CompressionType `protobuf:"varint,5,rep,packed,name=compressions,proto3,enum=node.CompressionType" json:"compressions,omitempty"`
+}
+
+func (m *NodeHealthResult) Reset()         { *m = NodeHealthResult{} }
+func (m *NodeHealthResult) String() string { return proto.CompactTextString(m) }
+func (*NodeHealthResult) ProtoMessage()    {}
+func (*NodeHealthResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{63}
+}
+func (m *NodeHealthResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeHealthResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeHealthResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeHealthResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeHealthResult.Merge(m, src)
+}
+func (m *NodeHealthResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeHealthResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeHealthResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeHealthResult proto.InternalMessageInfo
+
+func (m *NodeHealthResult) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *NodeHealthResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *NodeHealthResult) GetBootstrapped() bool {
+	if m != nil {
+		return m.Bootstrapped
+	}
+	return false
+}
+
+func (m *NodeHealthResult) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *NodeHealthResult) GetCompressions() []CompressionType {
+	if m != nil {
+		return m.Compressions
+	}
+	return nil
+}
+
+type NodeBootstrappedRequest struct {
+}
+
+func (m *NodeBootstrappedRequest) Reset()         { *m = NodeBootstrappedRequest{} }
+func (m *NodeBootstrappedRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeBootstrappedRequest) ProtoMessage()    {}
+func (*NodeBootstrappedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{64}
+}
+func (m *NodeBootstrappedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeBootstrappedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeBootstrappedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeBootstrappedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeBootstrappedRequest.Merge(m, src)
+}
+func (m *NodeBootstrappedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeBootstrappedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeBootstrappedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeBootstrappedRequest proto.InternalMessageInfo
+
+type NodeBootstrappedResult struct {
+}
+
+func (m *NodeBootstrappedResult) Reset()         { *m = NodeBootstrappedResult{} }
+func (m *NodeBootstrappedResult) String() string { return proto.CompactTextString(m) }
+func (*NodeBootstrappedResult) ProtoMessage()    {}
+func (*NodeBootstrappedResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab6472786da60c46, []int{65}
+}
+func (m *NodeBootstrappedResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeBootstrappedResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeBootstrappedResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeBootstrappedResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeBootstrappedResult.Merge(m, src)
+}
+func (m *NodeBootstrappedResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeBootstrappedResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeBootstrappedResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeBootstrappedResult proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("node.TimeType", TimeType_name, TimeType_value)
+	proto.RegisterEnum("node.ErrorType", ErrorType_name, ErrorType_value)
+	proto.RegisterEnum("node.CompressionType", CompressionType_name, CompressionType_value)
+	proto.RegisterEnum("node.AggregateQueryType", AggregateQueryType_name, AggregateQueryType_value)
+	proto.RegisterType((*Error)(nil), "node.Error")
+	proto.RegisterType((*WriteBatchRawErrors)(nil), "node.WriteBatchRawErrors")
+	proto.RegisterType((*WriteBatchRawError)(nil), "node.WriteBatchRawError")
+	proto.RegisterType((*Datapoint)(nil), "node.Datapoint")
+	proto.RegisterType((*Tag)(nil), "node.Tag")
+	proto.RegisterType((*WriteRequest)(nil), "node.WriteRequest")
+	proto.RegisterType((*WriteResult)(nil), "node.WriteResult")
+	proto.RegisterType((*WriteTaggedRequest)(nil), "node.WriteTaggedRequest")
+	proto.RegisterType((*WriteTaggedResult)(nil), "node.WriteTaggedResult")
+	proto.RegisterType((*WriteBatchRawRequest)(nil), "node.WriteBatchRawRequest")
+	proto.RegisterType((*WriteBatchRawRequestElement)(nil), "node.WriteBatchRawRequestElement")
+	proto.RegisterType((*WriteBatchRawV2Request)(nil), "node.WriteBatchRawV2Request")
+	proto.RegisterType((*WriteBatchRawV2RequestElement)(nil), "node.WriteBatchRawV2RequestElement")
+	proto.RegisterType((*WriteTaggedBatchRawRequest)(nil), "node.WriteTaggedBatchRawRequest")
+	proto.RegisterType((*WriteTaggedBatchRawRequestElement)(nil), "node.WriteTaggedBatchRawRequestElement")
+	proto.RegisterType((*WriteTaggedBatchRawV2Request)(nil), "node.WriteTaggedBatchRawV2Request")
+	proto.RegisterType((*WriteTaggedBatchRawV2RequestElement)(nil), "node.WriteTaggedBatchRawV2RequestElement")
+	proto.RegisterType((*WriteBatchRawResult)(nil), "node.WriteBatchRawResult")
+	proto.RegisterType((*FetchBatchRawRequest)(nil), "node.FetchBatchRawRequest")
+	proto.RegisterType((*FetchBatchRawV2Request)(nil), "node.FetchBatchRawV2Request")
+	proto.RegisterType((*FetchBatchRawV2RequestElement)(nil), "node.FetchBatchRawV2RequestElement")
+	proto.RegisterType((*FetchBatchRawResult)(nil), "node.FetchBatchRawResult")
+	proto.RegisterType((*FetchRawResult)(nil), "node.FetchRawResult")
+	proto.RegisterType((*Segments)(nil), "node.Segments")
+	proto.RegisterType((*Segment)(nil), "node.Segment")
+	proto.RegisterType((*FetchTaggedRequest)(nil), "node.FetchTaggedRequest")
+	proto.RegisterType((*FetchTaggedResult)(nil), "node.FetchTaggedResult")
+	proto.RegisterType((*FetchTaggedIDResult)(nil), "node.FetchTaggedIDResult")
+	proto.RegisterType((*FetchBlocksRawRequest)(nil), "node.FetchBlocksRawRequest")
+	proto.RegisterType((*FetchBlocksRawRequestElement)(nil), "node.FetchBlocksRawRequestElement")
+	proto.RegisterType((*FetchBlocksRawResult)(nil), "node.FetchBlocksRawResult")
+	proto.RegisterType((*Blocks)(nil), "node.Blocks")
+	proto.RegisterType((*Block)(nil), "node.Block")
+	proto.RegisterType((*FetchBlocksMetadataRawV2Request)(nil), "node.FetchBlocksMetadataRawV2Request")
+	proto.RegisterType((*FetchBlocksMetadataRawV2Result)(nil), "node.FetchBlocksMetadataRawV2Result")
+	proto.RegisterType((*BlockMetadataV2)(nil), "node.BlockMetadataV2")
+	proto.RegisterType((*TermQuery)(nil), "node.TermQuery")
+	proto.RegisterType((*RegexpQuery)(nil), "node.RegexpQuery")
+	proto.RegisterType((*NegationQuery)(nil), "node.NegationQuery")
+	proto.RegisterType((*ConjunctionQuery)(nil), "node.ConjunctionQuery")
+	proto.RegisterType((*DisjunctionQuery)(nil), "node.DisjunctionQuery")
+	proto.RegisterType((*AllQuery)(nil), "node.AllQuery")
+	proto.RegisterType((*FieldQuery)(nil), "node.FieldQuery")
+	proto.RegisterType((*Query)(nil), "node.Query")
+	proto.RegisterType((*AggregateQueryRequest)(nil), "node.AggregateQueryRequest")
+	proto.RegisterType((*AggregateQueryResult)(nil), "node.AggregateQueryResult")
+	proto.RegisterType((*AggregateQueryResultTagNameElement)(nil), "node.AggregateQueryResultTagNameElement")
+	proto.RegisterType((*AggregateQueryResultTagValueElement)(nil), "node.AggregateQueryResultTagValueElement")
+	proto.RegisterType((*AggregateQueryRawRequest)(nil), "node.AggregateQueryRawRequest")
+	proto.RegisterType((*AggregateQueryRawResult)(nil), "node.AggregateQueryRawResult")
+	proto.RegisterType((*AggregateQueryRawResultTagNameElement)(nil), "node.AggregateQueryRawResultTagNameElement")
+	proto.RegisterType((*AggregateQueryRawResultTagValueElement)(nil), "node.AggregateQueryRawResultTagValueElement")
+	proto.RegisterType((*TruncateRequest)(nil), "node.TruncateRequest")
+	proto.RegisterType((*TruncateResult)(nil), "node.TruncateResult")
+	proto.RegisterType((*DeleteSeriesRequest)(nil), "node.DeleteSeriesRequest")
+	proto.RegisterType((*DeleteSeriesResult)(nil), "node.DeleteSeriesResult")
+	proto.RegisterType((*DeleteSeriesShardResult)(nil), "node.DeleteSeriesShardResult")
+	proto.RegisterType((*IndexCardinalityRequest)(nil), "node.IndexCardinalityRequest")
+	proto.RegisterType((*IndexCardinalityResult)(nil), "node.IndexCardinalityResult")
+	proto.RegisterType((*IndexBlockCardinality)(nil), "node.IndexBlockCardinality")
+	proto.RegisterType((*IndexCardinalityEntry)(nil), "node.IndexCardinalityEntry")
+	proto.RegisterType((*IndexCardinalityLabelValues)(nil), "node.IndexCardinalityLabelValues")
+	proto.RegisterType((*NodeHealthRequest)(nil), "node.NodeHealthRequest")
+	proto.RegisterType((*NodeHealthResult)(nil), "node.NodeHealthResult")
+	proto.RegisterMapType((map[string]string)(nil), "node.NodeHealthResult.MetadataEntry")
+	proto.RegisterType((*NodeBootstrappedRequest)(nil), "node.NodeBootstrappedRequest")
+	proto.RegisterType((*NodeBootstrappedResult)(nil), "node.NodeBootstrappedResult")
+}
+
+func init() {
+	proto.RegisterFile("github.com/m3db/m3/src/dbnode/generated/proto/nodepb/node.proto", fileDescriptor_ab6472786da60c46)
+}
+
+var fileDescriptor_ab6472786da60c46 = []byte{
+	// 2756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x73, 0x23, 0x47,
+	0xfd, 0xdf, 0xd1, 0xcb, 0xd2, 0x57, 0xb2, 0x2d, 0xb7, 0x65, 0x5b, 0x2b, 0xdb, 0x8a, 0x33, 0xbb,
+	0x9b, 0x9f, 0xb3, 0xbf, 0x60, 0x25, 0xde, 0x04, 0xf2, 0x20, 0x64, 0x65, 0x5b, 0x76, 0x1c, 0x6c,
+	0xed, 0xa6, 0xa5, 0xdd, 0x90, 0x07, 0x65, 0xc6, 0x52, 0x47, 0x9e, 0x58, 0x1a, 0x29, 0x33, 0xa3,
+	0x64, 0x9d, 0xa2, 0x0a, 0x0e, 0x50, 0x1c, 0x28, 0xaa, 0x72, 0xcc, 0x85, 0x03, 0x55, 0x14, 0x47,
+	0x8a, 0x23, 0xc5, 0x81, 0x9c, 0xa0, 0x72, 0xcc, 0x0d, 0x8e, 0x54, 0xf2, 0x8f, 0x50, 0xfd, 0x1a,
+	0xf5, 0x3c, 0x24, 0x39, 0x5e, 0x52, 0x70, 0xb2, 0xfa, 0xfb, 0x9a, 0x6f, 0x7f, 0xfa, 0xd3, 0xdf,
+	0x7e, 0x19, 0x5e, 0xeb, 0x98, 0xee, 0xd9, 0xf0, 0x74, 0xab, 0xd5, 0xef, 0x55, 0x7a, 0x77, 0xda,
+	0xa7, 0x95, 0xde, 0x9d, 0x8a, 0x63, 0xb7, 0x2a, 0xed, 0x53, 0xab, 0xdf, 0x26, 0x95, 0x0e, 0xb1,
+	0x88, 0x6d, 0xb8, 0xa4, 0x5d, 0x19, 0xd8, 0x7d, 0xb7, 0x5f, 0xa1, 0xc2, 0xc1, 0x29, 0xfb, 0xb3,
+	0xc5, 0x24, 0x28, 0x41, 0x7f, 0x97, 0xca, 0x9d, 0x7e, 0xbf, 0xd3, 0x25, 0xdc, 0xea, 0x74, 0xf8,
+	0x7e, 0xe5, 0x63, 0xdb, 0x18, 0x0c, 0x88, 0xed, 0x70, 0x2b, 0x7d, 0x1f, 0x92, 0x35, 0xdb, 0xee,
+	0xdb, 0xe8, 0x06, 0x24, 0xdc, 0x8b, 0x01, 0x29, 0x6a, 0x1b, 0xda, 0xe6, 0xdc, 0xf6, 0xfc, 0x16,
+	0x8b, 0xc4, 0x54, 0xcd, 0x8b, 0x01, 0xc1, 0x4c, 0x89, 0x8a, 0x30, 0xd3, 0x23, 0x8e, 0x63, 0x74,
+	0x48, 0x31, 0xb6, 0xa1, 0x6d, 0x66, 0xb0, 0x6c, 0xea, 0x07, 0xb0, 0xf8, 0x96, 0x6d, 0xba, 0x64,
+	0xc7, 0x70, 0x5b, 0x67, 0xd8, 0xf8, 0x98, 0x79, 0x3a, 0xe8, 0x59, 0x48, 0x11, 0xf6, 0xab, 0xa8,
+	0x6d, 0xc4, 0x37, 0xb3, 0xdb, 0x45, 0x1e, 0x37, 0x6c, 0x8a, 0x85, 0x9d, 0x7e, 0x08, 0x28, 0xac,
+	0x45, 0x05, 0x48, 0x9a, 0x56, 0x9b, 0x3c, 0x62, 0xe9, 0xc5, 0x31, 0x6f, 0xa0, 0x75, 0x88, 0x13,
+	0xdb, 0x66, 0xa9, 0x64, 0xb7, 0xb3, 0x4a, 0xca, 0x98, 0xca, 0xf5, 0xdf, 0x6a, 0x90, 0xd9, 0x33,
+	0x5c, 0x63, 0xd0, 0x37, 0x2d, 0x17, 0xad, 0x41, 0xc6, 0x35, 0x7b, 0xc4, 0x71, 0x8d, 0xde, 0x40,
+	0x84, 0x19, 0x09, 0xe8, 0x07, 0x3e, 0x32, 0xba, 0x43, 0xde, 0x2f, 0x0d, 0xf3, 0x06, 0x2a, 0x03,
+	0x18, 0x96, 0xd5, 0x77, 0x0d, 0xd7, 0xec, 0x5b, 0xc5, 0xf8, 0x86, 0xb6, 0x99, 0xc3, 0x8a, 0x04,
+	0x7d, 0x1f, 0x16, 0xbc, 0x10, 0x4d, 0xb3, 0x47, 0x28, 0x54, 0xc5, 0x04, 0x43, 0x70, 0x8e, 0xa7,
+	0x23, 0xa5, 0x38, 0x6c, 0xa8, 0x57, 0x20, 0xde, 0x34, 0x3a, 0x08, 0x41, 0xc2, 0x32, 0x7a, 0x1c,
+	0xf9, 0x0c, 0x66, 0xbf, 0xfd, 0xe9, 0x64, 0x44, 0x3a, 0xfa, 0x39, 0xe4, 0x18, 0x36, 0x98, 0x7c,
+	0x38, 0x24, 0x0e, 0xeb, 0x12, 0xb5, 0x6e, 0x0c, 0x8c, 0x96, 0x74, 0x1f, 0x09, 0xd0, 0x1c, 0xc4,
+	0xcc, 0xb6, 0x08, 0x10, 0x33, 0xdb, 0xe8, 0x3b, 0x90, 0x69, 0x4b, 0x34, 0x58, 0x5f, 0xb2, 0x72,
+	0x98, 0x3d, 0x90, 0xf0, 0xc8, 0x42, 0x9f, 0x85, 0xac, 0xf8, 0x98, 0x33, 0xec, 0xba, 0xfa, 0xa7,
+	0x9a, 0x18, 0x98, 0xa6, 0xd1, 0xe9, 0x90, 0xf6, 0xd5, 0x52, 0x58, 0x87, 0x84, 0x6b, 0x74, 0x9c,
+	0x62, 0x9c, 0x91, 0x21, 0x23, 0x20, 0x32, 0x3a, 0x98, 0x89, 0xfd, 0x19, 0x26, 0xa6, 0x66, 0xb8,
+	0x08, 0x0b, 0xbe, 0x8c, 0x58, 0x9e, 0x0e, 0x14, 0x7c, 0xfc, 0x19, 0x9b, 0x68, 0x4e, 0x4d, 0xf4,
+	0x55, 0x48, 0x93, 0x2e, 0xe9, 0x11, 0xcb, 0x75, 0x8a, 0x31, 0x96, 0xdc, 0x93, 0x11, 0x4c, 0x15,
+	0xb1, 0x6a, 0xdc, 0x12, 0x7b, 0x2e, 0xfa, 0x7b, 0xb0, 0x3a, 0xc1, 0x50, 0xc0, 0xc0, 0x3f, 0x1a,
+	0x1a, 0x89, 0xd8, 0xd4, 0x7e, 0x5e, 0xc0, 0xb2, 0x2f, 0xfa, 0xc3, 0x6d, 0xd9, 0xa9, 0x32, 0x80,
+	0xd7, 0x07, 0x3e, 0xc5, 0x72, 0x58, 0x91, 0xa0, 0xd7, 0x42, 0xdd, 0xba, 0x11, 0xd1, 0xad, 0x87,
+	0xdb, 0xfe, 0x7c, 0x95, 0x8e, 0xfd, 0x14, 0xd6, 0x27, 0x9a, 0x3e, 0x66, 0xd7, 0xfc, 0xa3, 0x12,
+	0xe7, 0x93, 0xd2, 0x13, 0xe8, 0x3f, 0x83, 0x92, 0x32, 0xc0, 0xdf, 0x6c, 0x44, 0x77, 0x43, 0x5d,
+	0xff, 0x3f, 0xa5, 0xeb, 0x91, 0x11, 0xc3, 0xdd, 0xff, 0x85, 0x06, 0x4f, 0x4e, 0xb5, 0x0f, 0x61,
+	0xb0, 0x01, 0x59, 0x62, 0xb5, 0xfa, 0x6d, 0xd2, 0x6e, 0x52, 0xb2, 0xc7, 0x98, 0x42, 0x15, 0x7d,
+	0xd3, 0xa9, 0xf8, 0x4b, 0x0d, 0xd6, 0x22, 0xd2, 0xb8, 0x3c, 0x0f, 0x6a, 0x21, 0x30, 0x9e, 0x1e,
+	0x0b, 0xc6, 0x04, 0x36, 0xfc, 0x5e, 0x83, 0x1b, 0x97, 0xf0, 0xf8, 0xd6, 0x01, 0xf1, 0x0f, 0x7d,
+	0x22, 0x48, 0x9b, 0xa5, 0xc0, 0x5a, 0x24, 0x2a, 0xc3, 0x9f, 0x35, 0x28, 0xec, 0x13, 0xb7, 0x75,
+	0x16, 0x24, 0x52, 0x19, 0xc0, 0x36, 0xac, 0x0e, 0x69, 0xb8, 0x86, 0xed, 0x8a, 0xa5, 0x41, 0x91,
+	0xa0, 0x12, 0xa4, 0x59, 0xab, 0x66, 0xf1, 0x5a, 0x16, 0xc7, 0x5e, 0x3b, 0x4c, 0x60, 0x1f, 0x09,
+	0xf3, 0x10, 0x37, 0xdb, 0x4e, 0x31, 0xc1, 0x06, 0x84, 0xfe, 0x44, 0xcf, 0xc3, 0x2c, 0xf3, 0xf5,
+	0x56, 0x8b, 0x64, 0xe4, 0x6a, 0xe1, 0x37, 0xa2, 0x15, 0xc0, 0x97, 0xf9, 0x7f, 0xa0, 0x02, 0x44,
+	0xc7, 0x0b, 0x8f, 0xf9, 0xe7, 0x1a, 0xac, 0x4f, 0xb4, 0x0d, 0xcf, 0x43, 0x75, 0x30, 0x02, 0xe0,
+	0xc6, 0x26, 0x82, 0x1b, 0x0f, 0x80, 0xcb, 0x79, 0x94, 0xf0, 0x78, 0x74, 0x35, 0xf0, 0x0e, 0x60,
+	0x31, 0x30, 0xec, 0x94, 0x0e, 0xe8, 0x59, 0x05, 0x19, 0xbe, 0x39, 0x29, 0x28, 0xc8, 0x78, 0x76,
+	0x0a, 0x14, 0xef, 0xc2, 0x9c, 0x5f, 0x87, 0x6e, 0x43, 0xda, 0x21, 0x1d, 0x35, 0x86, 0xc8, 0xa5,
+	0x21, 0xa4, 0xd8, 0xd3, 0x4f, 0xdb, 0xac, 0xbc, 0x07, 0x69, 0xe9, 0x84, 0x6e, 0x41, 0xaa, 0x47,
+	0xec, 0x0e, 0xe1, 0x73, 0x28, 0xbb, 0x3d, 0xeb, 0x0b, 0x8a, 0x85, 0x12, 0x3d, 0x0d, 0xe9, 0xa1,
+	0x25, 0x0c, 0xf9, 0xd8, 0x06, 0x0c, 0x3d, 0xb5, 0xfe, 0x07, 0x0d, 0x66, 0x84, 0x94, 0xee, 0x37,
+	0xce, 0x88, 0x21, 0xe7, 0x27, 0xfb, 0x4d, 0x65, 0xae, 0x61, 0x76, 0xc5, 0xd4, 0x64, 0xbf, 0xe9,
+	0xb8, 0x3a, 0x74, 0x88, 0x28, 0x90, 0xb2, 0x36, 0x7b, 0x02, 0xaa, 0x3d, 0xed, 0xf6, 0x5b, 0xe7,
+	0x0d, 0xf3, 0x13, 0x6f, 0x0a, 0x7a, 0x02, 0xf4, 0x3d, 0x48, 0xb7, 0xce, 0x48, 0xeb, 0xdc, 0x19,
+	0xf6, 0xd8, 0x20, 0x65, 0xb7, 0x57, 0xb7, 0xf8, 0x4e, 0x74, 0x4b, 0xee, 0x44, 0xb7, 0x0e, 0x2d,
+	0xf7, 0xbb, 0xcf, 0x3f, 0x34, 0xba, 0x43, 0x82, 0x3d, 0x63, 0xfd, 0x2f, 0x31, 0x40, 0x0c, 0xe4,
+	0x29, 0xdb, 0x0c, 0xdf, 0x34, 0x2b, 0x40, 0xf2, 0xc3, 0x21, 0xb1, 0x2f, 0x44, 0xfa, 0xbc, 0x11,
+	0x60, 0x5e, 0x7c, 0x22, 0xf3, 0x12, 0xe1, 0x69, 0xfd, 0x3e, 0xcd, 0x82, 0x56, 0x1f, 0xd6, 0x81,
+	0x34, 0x1e, 0x09, 0xe8, 0xf7, 0xba, 0x66, 0xcf, 0x74, 0x8b, 0x29, 0xbe, 0x1b, 0x65, 0x8d, 0x30,
+	0x3b, 0x67, 0x2e, 0xc1, 0x4e, 0xf4, 0x0c, 0x2c, 0xd8, 0xe4, 0xc3, 0xa1, 0x69, 0x93, 0xda, 0xa3,
+	0x33, 0x63, 0xe8, 0xb8, 0xe6, 0x47, 0xa4, 0x98, 0x66, 0x5f, 0x0c, 0x2b, 0x68, 0x5e, 0xed, 0x7e,
+	0xcb, 0x39, 0x62, 0x5f, 0xcf, 0x70, 0xd4, 0x3d, 0x81, 0xfe, 0x01, 0x2c, 0xf8, 0xb0, 0x63, 0x1c,
+	0x7d, 0x21, 0xc4, 0xf3, 0xeb, 0x0a, 0xcf, 0xb9, 0xe9, 0xe1, 0x5e, 0x90, 0xec, 0x14, 0x3d, 0x32,
+	0x4a, 0x28, 0xc6, 0x12, 0x52, 0x24, 0xfa, 0x9f, 0x34, 0x58, 0x8c, 0x88, 0x10, 0xaa, 0xfd, 0xbe,
+	0x91, 0x8b, 0x05, 0x47, 0x2e, 0xb0, 0x32, 0xc4, 0xc3, 0x2b, 0x83, 0x3a, 0xc5, 0x12, 0x97, 0x9b,
+	0x62, 0xc9, 0x31, 0x53, 0xec, 0xd7, 0x1a, 0x2c, 0xf1, 0x4a, 0x40, 0x79, 0xea, 0x5c, 0x7a, 0x2b,
+	0x51, 0x80, 0xa4, 0x73, 0x66, 0xd8, 0xbc, 0xf8, 0x27, 0x31, 0x6f, 0xa0, 0x1f, 0x28, 0xb8, 0xf2,
+	0xfd, 0xac, 0xae, 0x56, 0xd6, 0xc0, 0x27, 0xc2, 0x85, 0x75, 0x1f, 0xd6, 0x26, 0x59, 0x86, 0x80,
+	0x5c, 0x86, 0x14, 0x9b, 0x7d, 0xbc, 0x8e, 0xc7, 0xb1, 0x68, 0xe9, 0x77, 0xa1, 0x10, 0x8c, 0xc3,
+	0x06, 0x62, 0x33, 0x34, 0xee, 0x39, 0x9e, 0x9f, 0x30, 0x1c, 0x65, 0xf2, 0x2a, 0xa4, 0xb8, 0x2c,
+	0xf4, 0xcd, 0x1b, 0x90, 0x62, 0x73, 0x5a, 0xae, 0x1d, 0x59, 0x25, 0x02, 0x16, 0x2a, 0xfd, 0x77,
+	0x1a, 0x24, 0x99, 0x84, 0x01, 0xa5, 0xac, 0xa1, 0xbc, 0xe1, 0x1b, 0x41, 0x5e, 0xfd, 0xa6, 0x8e,
+	0x60, 0x3c, 0x7a, 0x04, 0x7d, 0x65, 0x25, 0xf1, 0x4d, 0xca, 0xca, 0xe7, 0x31, 0x78, 0x42, 0x41,
+	0xe9, 0x98, 0xb8, 0x46, 0xdb, 0x70, 0x0d, 0xdf, 0x52, 0x7a, 0x15, 0x12, 0x3c, 0x4e, 0x8d, 0xf1,
+	0xaa, 0x48, 0x52, 0xad, 0x22, 0x6b, 0x90, 0x19, 0x18, 0x1d, 0xd2, 0xec, 0x9f, 0x13, 0x8b, 0xd5,
+	0x97, 0x1c, 0x1e, 0x09, 0x90, 0x0e, 0x39, 0xd3, 0x6a, 0x75, 0x87, 0x6d, 0x42, 0xcb, 0xac, 0xc3,
+	0x4a, 0x4c, 0x1a, 0xfb, 0x64, 0xe8, 0x36, 0xe4, 0x45, 0x7b, 0x57, 0x74, 0xdf, 0x11, 0x05, 0x25,
+	0x24, 0x47, 0x9b, 0x30, 0x2f, 0x64, 0x47, 0x86, 0xe3, 0x62, 0xba, 0x2c, 0x64, 0x98, 0x69, 0x50,
+	0xac, 0x5f, 0x40, 0x79, 0x3c, 0x80, 0x8c, 0x70, 0xcf, 0x85, 0x08, 0xb7, 0xa4, 0xd0, 0x45, 0x7a,
+	0x3c, 0xdc, 0x56, 0x8a, 0xcc, 0x4d, 0x98, 0xb5, 0xc8, 0x23, 0xf7, 0xbe, 0xd7, 0x61, 0x5e, 0x20,
+	0xfc, 0x42, 0xfd, 0x1f, 0x31, 0x98, 0x0f, 0xc4, 0x08, 0x31, 0xd5, 0xa3, 0x5e, 0x4c, 0xa5, 0xde,
+	0x14, 0x3a, 0x55, 0x20, 0xe1, 0xc8, 0xe5, 0x6b, 0x0a, 0x95, 0x98, 0xe1, 0x95, 0x97, 0x35, 0xea,
+	0xd8, 0x95, 0x00, 0xa7, 0x2e, 0xe1, 0x28, 0x8d, 0xd1, 0xcb, 0x90, 0x97, 0xbf, 0xa7, 0xac, 0x2b,
+	0x21, 0xbb, 0x60, 0x71, 0x4d, 0x87, 0x8a, 0xab, 0xfe, 0x02, 0x64, 0x9a, 0xc4, 0xee, 0xbd, 0xc9,
+	0xd6, 0xcb, 0x02, 0x24, 0xdf, 0x37, 0x49, 0xb7, 0x2d, 0x8e, 0xf1, 0xbc, 0xc1, 0x76, 0x06, 0xc4,
+	0xee, 0x89, 0x43, 0x3c, 0xfb, 0xad, 0xbf, 0x02, 0x59, 0x4c, 0x3a, 0xe4, 0xd1, 0x60, 0x92, 0xe3,
+	0x32, 0xa4, 0x6c, 0x66, 0x24, 0x5c, 0x45, 0x4b, 0xdf, 0x86, 0xd9, 0x3a, 0xe9, 0xb0, 0xfb, 0x13,
+	0xee, 0xfe, 0xa4, 0x5c, 0xbd, 0x35, 0x75, 0x98, 0x98, 0x4e, 0x2c, 0xe5, 0xfa, 0x4b, 0x90, 0xdf,
+	0xed, 0x5b, 0x1f, 0x0c, 0xad, 0xd6, 0xc8, 0xed, 0x16, 0xcc, 0x50, 0xa5, 0x49, 0x24, 0xdb, 0x7c,
+	0x8e, 0x52, 0x47, 0x5d, 0xf7, 0x4c, 0xe7, 0x4a, 0xae, 0x00, 0xe9, 0x6a, 0xb7, 0xcb, 0x84, 0xba,
+	0x0e, 0xb0, 0x4f, 0xbb, 0x35, 0xa1, 0xc7, 0xfa, 0xdf, 0x63, 0x90, 0xe4, 0xfa, 0x1b, 0x02, 0x34,
+	0x4d, 0x3d, 0xc9, 0x78, 0x48, 0x73, 0x14, 0xd1, 0xd3, 0x3e, 0x80, 0xb2, 0xdb, 0x0b, 0xdc, 0x4c,
+	0x41, 0x56, 0x62, 0x86, 0x2a, 0x90, 0xb6, 0x04, 0x66, 0x82, 0xcc, 0x8b, 0xdc, 0xd8, 0x87, 0x24,
+	0xf6, 0x8c, 0xd0, 0x8b, 0x90, 0x6d, 0x8d, 0x00, 0x13, 0x04, 0x5f, 0xe6, 0x3e, 0x41, 0x24, 0xb1,
+	0x6a, 0x4a, 0x3d, 0xdb, 0x23, 0xbc, 0x8a, 0x49, 0xd5, 0x33, 0x08, 0x24, 0x56, 0x4d, 0xd1, 0x06,
+	0xc4, 0x8d, 0x6e, 0x57, 0xd0, 0x5b, 0xb0, 0x53, 0xe2, 0x87, 0xa9, 0x0a, 0x3d, 0x25, 0x61, 0x9b,
+	0x61, 0x36, 0x79, 0xb1, 0x5e, 0x7a, 0xb8, 0x4a, 0x20, 0xbf, 0x88, 0xc1, 0x52, 0xb5, 0xd3, 0xb1,
+	0x69, 0x6f, 0x08, 0xd7, 0x88, 0x1a, 0x3d, 0x9d, 0x2b, 0x8f, 0x75, 0xe0, 0x08, 0x9d, 0x2b, 0x33,
+	0x81, 0x25, 0x20, 0xa2, 0x60, 0xdf, 0x84, 0x59, 0xd7, 0xe8, 0xd4, 0x8d, 0x1e, 0xd9, 0x37, 0xbb,
+	0x2e, 0xb1, 0x8b, 0xa9, 0x8d, 0xf8, 0x66, 0x06, 0xfb, 0x85, 0xe8, 0x75, 0x40, 0x86, 0xaf, 0x47,
+	0xca, 0x4c, 0x16, 0x97, 0xa2, 0xd5, 0x90, 0x1e, 0x47, 0xf8, 0xa0, 0x67, 0x20, 0xc3, 0x77, 0x90,
+	0x34, 0x40, 0x3a, 0xb2, 0x14, 0x8c, 0x0c, 0xf4, 0x4f, 0xa0, 0x10, 0x44, 0x92, 0x15, 0xeb, 0x1d,
+	0x98, 0xb1, 0xd9, 0x2f, 0x39, 0x05, 0x36, 0xa3, 0x92, 0xe0, 0xc6, 0x4d, 0xde, 0x13, 0xb9, 0x85,
+	0x91, 0x8e, 0x53, 0xb7, 0x88, 0xbf, 0xd2, 0x40, 0x9f, 0x1e, 0x8f, 0x5e, 0x2a, 0x0b, 0xac, 0xc4,
+	0x74, 0x92, 0x4d, 0x74, 0x00, 0x19, 0xd7, 0xe8, 0xb0, 0x92, 0x18, 0xb8, 0xb7, 0x18, 0x13, 0x96,
+	0x99, 0xca, 0x3c, 0x47, 0xbe, 0x7a, 0x15, 0x6e, 0x5c, 0xc2, 0x83, 0x52, 0x43, 0xfa, 0x88, 0x54,
+	0xbc, 0xb6, 0xfe, 0xd7, 0x18, 0x14, 0x03, 0x31, 0x46, 0xfb, 0xc7, 0x82, 0x4a, 0xcb, 0xdc, 0xb7,
+	0xc2, 0xc4, 0xdc, 0x15, 0x99, 0x98, 0xfb, 0x5f, 0x61, 0xe2, 0xcf, 0x35, 0x58, 0x89, 0x00, 0x90,
+	0xb1, 0xb1, 0x16, 0x64, 0xe3, 0xff, 0x47, 0x0e, 0xb3, 0xf1, 0x71, 0x14, 0x81, 0x2e, 0x4f, 0xc8,
+	0xdf, 0x68, 0x70, 0xeb, 0x52, 0x21, 0x83, 0x9c, 0xcc, 0x8d, 0x38, 0xf9, 0x46, 0x98, 0x93, 0xcf,
+	0x4c, 0x4b, 0x76, 0x1c, 0x2d, 0xf7, 0xe0, 0xa9, 0xcb, 0x39, 0x85, 0x98, 0x99, 0x53, 0x98, 0x59,
+	0x81, 0xf9, 0xa6, 0x3d, 0xb4, 0x5a, 0xc6, 0x84, 0x87, 0x01, 0x95, 0x3d, 0xfa, 0x16, 0xcc, 0x8d,
+	0x1c, 0x18, 0xfe, 0xd4, 0x7e, 0xd8, 0x6b, 0xc8, 0x25, 0x91, 0x5f, 0xe1, 0x48, 0x01, 0xbd, 0x7e,
+	0x5c, 0xdc, 0x23, 0x5d, 0xe2, 0x12, 0x2e, 0xf8, 0x2f, 0x1d, 0xca, 0x75, 0x13, 0x90, 0x3f, 0x8d,
+	0xe9, 0xb9, 0xa3, 0x17, 0x20, 0xc5, 0x76, 0xea, 0x72, 0xac, 0xd6, 0xc5, 0x4a, 0xa6, 0xc4, 0x69,
+	0x50, 0x3d, 0x0f, 0x86, 0x85, 0xb1, 0x7e, 0x0c, 0x2b, 0x63, 0x4c, 0x46, 0x07, 0x01, 0xfa, 0xad,
+	0x59, 0x79, 0x10, 0xf0, 0x65, 0x11, 0x0b, 0x22, 0xf8, 0x37, 0x0d, 0x56, 0x0e, 0xe9, 0x93, 0xd5,
+	0xae, 0x61, 0xb7, 0x4d, 0xcb, 0xe8, 0x9a, 0xee, 0xc5, 0xe5, 0x50, 0x7c, 0x9c, 0x1a, 0xe2, 0x55,
+	0x89, 0x44, 0xa0, 0x4a, 0xf4, 0x88, 0x6b, 0x9b, 0x2d, 0x4a, 0xe7, 0xa6, 0xd1, 0x61, 0x35, 0x24,
+	0x87, 0xfd, 0xc2, 0xd1, 0xe8, 0xa5, 0x94, 0xd1, 0xd3, 0x8f, 0x61, 0x39, 0xdc, 0x0d, 0x86, 0xca,
+	0x1d, 0xef, 0xa4, 0xc8, 0x27, 0xf0, 0x2a, 0xc7, 0x99, 0x59, 0xb3, 0xbd, 0xbb, 0xea, 0x22, 0x4f,
+	0x8e, 0x9f, 0x25, 0x60, 0x29, 0xd2, 0x82, 0x76, 0x9b, 0xd9, 0xf8, 0xae, 0x64, 0x47, 0x92, 0xc9,
+	0x70, 0xa3, 0x07, 0xb0, 0xe2, 0xb0, 0x5f, 0xbb, 0xfd, 0xa1, 0xe5, 0xee, 0x5c, 0x1c, 0x7b, 0x5d,
+	0x2b, 0xc6, 0x43, 0xd9, 0x29, 0x9f, 0xad, 0x59, 0xae, 0x7d, 0x81, 0xc7, 0xf9, 0xa2, 0x06, 0x2c,
+	0xfb, 0x54, 0x47, 0xc6, 0x29, 0xe9, 0xb2, 0xa8, 0x89, 0xe9, 0x51, 0xc7, 0xb8, 0xa2, 0x1f, 0xc3,
+	0x6a, 0x58, 0xc3, 0x26, 0xf6, 0x7d, 0xc3, 0xa4, 0x77, 0x19, 0x53, 0x23, 0x4f, 0xf2, 0x47, 0xef,
+	0x42, 0xa9, 0xeb, 0x49, 0x42, 0x79, 0xa7, 0xa6, 0x47, 0x9f, 0xe0, 0x8e, 0xde, 0x86, 0xe5, 0x91,
+	0xd6, 0x51, 0x03, 0xcf, 0xa8, 0x6f, 0x68, 0xc1, 0xc0, 0xa3, 0x14, 0x1d, 0x3c, 0x26, 0x80, 0xfe,
+	0x16, 0x2c, 0x05, 0xdd, 0x58, 0x3e, 0xbe, 0xd7, 0xd2, 0x5c, 0xd4, 0x6b, 0x69, 0x4e, 0x3e, 0xde,
+	0x16, 0x20, 0xd9, 0xa2, 0x19, 0x8b, 0x79, 0xc1, 0x1b, 0xfa, 0x21, 0xac, 0x4e, 0xc8, 0x27, 0x32,
+	0xfc, 0x32, 0xa4, 0x3e, 0x1a, 0xd5, 0xfb, 0x1c, 0x16, 0x2d, 0xfa, 0xfe, 0x58, 0xef, 0xb7, 0xc9,
+	0xeb, 0xc4, 0xe8, 0xba, 0x67, 0x62, 0x3a, 0xeb, 0x9f, 0xc5, 0x20, 0xaf, 0x4a, 0xe5, 0xa5, 0x58,
+	0xff, 0x9c, 0xc5, 0x4c, 0xe3, 0x58, 0xff, 0x5c, 0xdc, 0xe5, 0xb8, 0x43, 0x47, 0x9e, 0x8d, 0x78,
+	0x8b, 0x1e, 0xef, 0x4f, 0xfb, 0x7d, 0xd7, 0x71, 0xd9, 0x23, 0x3d, 0x9f, 0xd1, 0x69, 0xec, 0x93,
+	0xa1, 0xbb, 0x90, 0xee, 0x89, 0x73, 0xb0, 0xe0, 0xdd, 0x4d, 0x71, 0x16, 0x08, 0x7c, 0x75, 0x4b,
+	0x1e, 0x97, 0xf9, 0x40, 0x7a, 0x5e, 0xe8, 0x25, 0xc8, 0xb5, 0xfa, 0xbd, 0x81, 0x4d, 0x1c, 0xc7,
+	0xec, 0x5b, 0x0e, 0xe3, 0xd8, 0x9c, 0x3c, 0xac, 0xef, 0x8e, 0x34, 0x6c, 0x05, 0xf7, 0x99, 0x96,
+	0x5e, 0x81, 0x59, 0x5f, 0x54, 0xfa, 0xc2, 0x71, 0x4e, 0x2e, 0xc4, 0x6e, 0x89, 0xfe, 0x8c, 0x7e,
+	0xba, 0x7e, 0x39, 0xf6, 0xa2, 0xa6, 0x5f, 0x87, 0x15, 0x9a, 0xe3, 0x8e, 0xd2, 0x1b, 0x89, 0x5a,
+	0x11, 0x96, 0xc3, 0x2a, 0xda, 0x89, 0xdb, 0x3f, 0x81, 0xb4, 0x77, 0xa0, 0xcd, 0x43, 0xee, 0x41,
+	0xfd, 0xf0, 0x47, 0x27, 0x8d, 0xda, 0xee, 0xbd, 0xfa, 0x5e, 0x23, 0x7f, 0x0d, 0x2d, 0xc1, 0x02,
+	0x93, 0x1c, 0x1f, 0xee, 0xe2, 0x7b, 0x52, 0xac, 0x29, 0xe2, 0xa3, 0xa3, 0x43, 0x29, 0x8e, 0xa1,
+	0x02, 0xe4, 0x99, 0xb8, 0x5e, 0xad, 0x7b, 0xc6, 0xf1, 0xdb, 0xcf, 0x42, 0xc6, 0xfb, 0x3f, 0x07,
+	0x84, 0x60, 0xee, 0xb0, 0xde, 0xac, 0xe1, 0x7a, 0xf5, 0xe8, 0xa4, 0x86, 0xf1, 0x3d, 0x9c, 0xbf,
+	0x86, 0xe6, 0x21, 0xbb, 0x53, 0xdd, 0x3b, 0xc1, 0xb5, 0x37, 0x1f, 0xd4, 0x1a, 0xcd, 0xbc, 0x76,
+	0xfb, 0x39, 0x98, 0x0f, 0xc0, 0x84, 0xd2, 0x90, 0xa8, 0xdf, 0xab, 0xd7, 0xf2, 0xd7, 0x10, 0x40,
+	0xaa, 0x51, 0xaf, 0xde, 0xbf, 0xff, 0x76, 0x5e, 0xa3, 0xd2, 0x77, 0x1a, 0xcd, 0xbd, 0x7c, 0xec,
+	0xf6, 0x7d, 0x40, 0xe1, 0x5d, 0x15, 0xba, 0x0e, 0x4b, 0xd5, 0x83, 0x03, 0x5c, 0x3b, 0xa8, 0x36,
+	0x6b, 0x27, 0x3b, 0x6f, 0x9f, 0x34, 0xab, 0x07, 0x27, 0xf5, 0xea, 0x31, 0x0d, 0xf3, 0x04, 0xac,
+	0x46, 0xaa, 0x4e, 0x1e, 0x56, 0x8f, 0x1e, 0xd4, 0xf2, 0xda, 0xf6, 0x1f, 0x01, 0x12, 0x14, 0x33,
+	0xb4, 0x05, 0x49, 0xf6, 0xdc, 0x85, 0x90, 0xf2, 0xa6, 0x27, 0x80, 0x2d, 0x2d, 0xf8, 0x64, 0x8c,
+	0x8c, 0x77, 0xc5, 0xc3, 0x3e, 0xbf, 0xb8, 0x45, 0xc5, 0xd0, 0x4b, 0xa0, 0xf4, 0x5d, 0x89, 0xd0,
+	0xb0, 0x08, 0xfb, 0x30, 0xeb, 0x7b, 0x60, 0x43, 0xa5, 0xf1, 0x8f, 0xe5, 0xa5, 0xeb, 0x91, 0x3a,
+	0x16, 0xe7, 0x0d, 0x98, 0x0f, 0xbc, 0x2e, 0xa3, 0xb5, 0x49, 0xef, 0xd3, 0x93, 0x62, 0x61, 0x58,
+	0x54, 0x12, 0xf5, 0x32, 0xdb, 0x98, 0xf6, 0xe8, 0x3b, 0x29, 0xe6, 0x43, 0x58, 0x8a, 0x7c, 0xee,
+	0x44, 0xfa, 0xf4, 0xd7, 0xd3, 0x49, 0x71, 0xf7, 0x61, 0xd6, 0xf7, 0x22, 0x25, 0xf1, 0x8b, 0x7a,
+	0x9d, 0x2c, 0x5d, 0x8f, 0xd4, 0x49, 0xfc, 0x02, 0x4f, 0x73, 0x12, 0xbf, 0xe8, 0x17, 0xbb, 0x49,
+	0xb1, 0xee, 0x42, 0x56, 0xb9, 0xce, 0x97, 0xac, 0x08, 0x3f, 0xc5, 0x94, 0x56, 0x22, 0x34, 0x2c,
+	0xc2, 0xa1, 0x78, 0x1e, 0xf3, 0x2e, 0xa2, 0xd1, 0xea, 0x84, 0x0b, 0xf1, 0x52, 0x29, 0x5a, 0xc9,
+	0x42, 0x75, 0xa0, 0x38, 0xee, 0xb2, 0x11, 0xdd, 0x0a, 0xf9, 0x45, 0xdd, 0xe6, 0x96, 0x6e, 0x4e,
+	0x33, 0x63, 0x1f, 0xda, 0x83, 0x8c, 0x37, 0x2d, 0x65, 0xba, 0x91, 0x37, 0x0f, 0xa5, 0x52, 0xb4,
+	0x92, 0x45, 0x39, 0x86, 0x9c, 0x27, 0xa7, 0xfd, 0x2e, 0x8f, 0x3d, 0x10, 0xf0, 0x58, 0xeb, 0x13,
+	0x0f, 0x0c, 0xf4, 0xb2, 0x50, 0xee, 0xcf, 0x91, 0xa8, 0xca, 0x81, 0x0d, 0x7e, 0xa9, 0x10, 0x14,
+	0x33, 0xc7, 0x5d, 0xc8, 0xa9, 0xbb, 0x56, 0x74, 0x3d, 0xbc, 0xd9, 0x95, 0x01, 0x8a, 0x51, 0x2a,
+	0x16, 0xe4, 0x1e, 0xe4, 0x83, 0x0b, 0x24, 0x5a, 0x8f, 0x5e, 0xc8, 0x65, 0xb0, 0xb5, 0x71, 0x6a,
+	0x16, 0xf0, 0x25, 0x48, 0xf1, 0x65, 0x09, 0xad, 0x84, 0x17, 0x2a, 0x1e, 0x60, 0x39, 0x7a, 0x05,
+	0x43, 0x3f, 0x84, 0x9c, 0xba, 0x24, 0xa0, 0xf5, 0x91, 0x5d, 0xc4, 0x2a, 0x52, 0x5a, 0x1b, 0xa7,
+	0xa6, 0xc1, 0x76, 0x36, 0xbe, 0xf8, 0xaa, 0xac, 0x7d, 0xf9, 0x55, 0x59, 0xfb, 0xd7, 0x57, 0x65,
+	0xed, 0xd3, 0xaf, 0xcb, 0xd7, 0xbe, 0xfc, 0xba, 0x7c, 0xed, 0x9f, 0x5f, 0x97, 0xaf, 0xbd, 0x93,
+	0xe2, 0xff, 0x3d, 0x77, 0x9a, 0x62, 0x77, 0xb1, 0x77, 0xfe, 0x3d, 0x00, 0x1f, 0x6e, 0xf2, 0x70,
+	0x7c, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeClient interface {
+	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResult, error)
+	WriteTagged(ctx context.Context, in *WriteTaggedRequest, opts ...grpc.CallOption) (*WriteTaggedResult, error)
+	WriteBatchRaw(ctx context.Context, in *WriteBatchRawRequest, opts ...grpc.CallOption) (*WriteBatchRawResult, error)
+	WriteBatchRawV2(ctx context.Context, in *WriteBatchRawV2Request, opts ...grpc.CallOption) (*WriteBatchRawResult, error)
+	WriteTaggedBatchRaw(ctx context.Context, in *WriteTaggedBatchRawRequest, opts ...grpc.CallOption) (*WriteBatchRawResult, error)
+	WriteTaggedBatchRawV2(ctx context.Context, in *WriteTaggedBatchRawV2Request, opts ...grpc.CallOption) (*WriteBatchRawResult, error)
+	FetchBatchRaw(ctx context.Context, in *FetchBatchRawRequest, opts ...grpc.CallOption) (*FetchBatchRawResult, error)
+	FetchBatchRawV2(ctx context.Context, in *FetchBatchRawV2Request, opts ...grpc.CallOption) (*FetchBatchRawResult, error)
+	FetchTagged(ctx context.Context, in *FetchTaggedRequest, opts ...grpc.CallOption) (*FetchTaggedResult, error)
+	FetchBlocksRaw(ctx context.Context, in *FetchBlocksRawRequest, opts ...grpc.CallOption) (*FetchBlocksRawResult, error)
+	FetchBlocksMetadataRawV2(ctx context.Context, in *FetchBlocksMetadataRawV2Request, opts ...grpc.CallOption) (*FetchBlocksMetadataRawV2Result, error)
+	Aggregate(ctx context.Context, in *AggregateQueryRequest, opts ...grpc.CallOption) (*AggregateQueryResult, error)
+	AggregateRaw(ctx context.Context, in *AggregateQueryRawRequest, opts ...grpc.CallOption) (*AggregateQueryRawResult, error)
+	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResult, error)
+	DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResult, error)
+	IndexCardinality(ctx context.Context, in *IndexCardinalityRequest, opts ...grpc.CallOption) (*IndexCardinalityResult, error)
+	Health(ctx context.Context, in *NodeHealthRequest, opts ...grpc.CallOption) (*NodeHealthResult, error)
+	Bootstrapped(ctx context.Context, in *NodeBootstrappedRequest, opts ...grpc.CallOption) (*NodeBootstrappedResult, error)
+}
+
+type nodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeClient(cc *grpc.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResult, error) {
+	out := new(WriteResult)
+	err := c.cc.Invoke(ctx, "/node.Node/Write", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) WriteTagged(ctx context.Context, in *WriteTaggedRequest, opts ...grpc.CallOption) (*WriteTaggedResult, error) {
+	out := new(WriteTaggedResult)
+	err := c.cc.Invoke(ctx, "/node.Node/WriteTagged", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) WriteBatchRaw(ctx context.Context, in *WriteBatchRawRequest, opts ...grpc.CallOption) (*WriteBatchRawResult, error) {
+	out := new(WriteBatchRawResult)
+	err := c.cc.Invoke(ctx, "/node.Node/WriteBatchRaw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) WriteBatchRawV2(ctx context.Context, in *WriteBatchRawV2Request, opts ...grpc.CallOption) (*WriteBatchRawResult, error) {
+	out := new(WriteBatchRawResult)
+	err := c.cc.Invoke(ctx, "/node.Node/WriteBatchRawV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) WriteTaggedBatchRaw(ctx context.Context, in *WriteTaggedBatchRawRequest, opts ...grpc.CallOption) (*WriteBatchRawResult, error) {
+	out := new(WriteBatchRawResult)
+	err := c.cc.Invoke(ctx, "/node.Node/WriteTaggedBatchRaw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) WriteTaggedBatchRawV2(ctx context.Context, in *WriteTaggedBatchRawV2Request, opts ...grpc.CallOption) (*WriteBatchRawResult, error) {
+	out := new(WriteBatchRawResult)
+	err := c.cc.Invoke(ctx, "/node.Node/WriteTaggedBatchRawV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchBatchRaw(ctx context.Context, in *FetchBatchRawRequest, opts ...grpc.CallOption) (*FetchBatchRawResult, error) {
+	out := new(FetchBatchRawResult)
+	err := c.cc.Invoke(ctx, "/node.Node/FetchBatchRaw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchBatchRawV2(ctx context.Context, in *FetchBatchRawV2Request, opts ...grpc.CallOption) (*FetchBatchRawResult, error) {
+	out := new(FetchBatchRawResult)
+	err := c.cc.Invoke(ctx, "/node.Node/FetchBatchRawV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchTagged(ctx context.Context, in *FetchTaggedRequest, opts ...grpc.CallOption) (*FetchTaggedResult, error) {
+	out := new(FetchTaggedResult)
+	err := c.cc.Invoke(ctx, "/node.Node/FetchTagged", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchBlocksRaw(ctx context.Context, in *FetchBlocksRawRequest, opts ...grpc.CallOption) (*FetchBlocksRawResult, error) {
+	out := new(FetchBlocksRawResult)
+	err := c.cc.Invoke(ctx, "/node.Node/FetchBlocksRaw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) FetchBlocksMetadataRawV2(ctx context.Context, in *FetchBlocksMetadataRawV2Request, opts ...grpc.CallOption) (*FetchBlocksMetadataRawV2Result, error) {
+	out := new(FetchBlocksMetadataRawV2Result)
+	err := c.cc.Invoke(ctx, "/node.Node/FetchBlocksMetadataRawV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Aggregate(ctx context.Context, in *AggregateQueryRequest, opts ...grpc.CallOption) (*AggregateQueryResult, error) {
+	out := new(AggregateQueryResult)
+	err := c.cc.Invoke(ctx, "/node.Node/Aggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) AggregateRaw(ctx context.Context, in *AggregateQueryRawRequest, opts ...grpc.CallOption) (*AggregateQueryRawResult, error) {
+	out := new(AggregateQueryRawResult)
+	err := c.cc.Invoke(ctx, "/node.Node/AggregateRaw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateResult, error) {
+	out := new(TruncateResult)
+	err := c.cc.Invoke(ctx, "/node.Node/Truncate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResult, error) {
+	out := new(DeleteSeriesResult)
+	err := c.cc.Invoke(ctx, "/node.Node/DeleteSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) IndexCardinality(ctx context.Context, in *IndexCardinalityRequest, opts ...grpc.CallOption) (*IndexCardinalityResult, error) {
+	out := new(IndexCardinalityResult)
+	err := c.cc.Invoke(ctx, "/node.Node/IndexCardinality", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Health(ctx context.Context, in *NodeHealthRequest, opts ...grpc.CallOption) (*NodeHealthResult, error) {
+	out := new(NodeHealthResult)
+	err := c.cc.Invoke(ctx, "/node.Node/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Bootstrapped(ctx context.Context, in *NodeBootstrappedRequest, opts ...grpc.CallOption) (*NodeBootstrappedResult, error) {
+	out := new(NodeBootstrappedResult)
+	err := c.cc.Invoke(ctx, "/node.Node/Bootstrapped", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeServer is the server API for Node service.
+type NodeServer interface {
+	Write(context.Context, *WriteRequest) (*WriteResult, error)
+	WriteTagged(context.Context, *WriteTaggedRequest) (*WriteTaggedResult, error)
+	WriteBatchRaw(context.Context, *WriteBatchRawRequest) (*WriteBatchRawResult, error)
+	WriteBatchRawV2(context.Context, *WriteBatchRawV2Request) (*WriteBatchRawResult, error)
+	WriteTaggedBatchRaw(context.Context, *WriteTaggedBatchRawRequest) (*WriteBatchRawResult, error)
+	WriteTaggedBatchRawV2(context.Context, *WriteTaggedBatchRawV2Request) (*WriteBatchRawResult, error)
+	FetchBatchRaw(context.Context, *FetchBatchRawRequest) (*FetchBatchRawResult, error)
+	FetchBatchRawV2(context.Context, *FetchBatchRawV2Request) (*FetchBatchRawResult, error)
+	FetchTagged(context.Context, *FetchTaggedRequest) (*FetchTaggedResult, error)
+	FetchBlocksRaw(context.Context, *FetchBlocksRawRequest) (*FetchBlocksRawResult, error)
+	FetchBlocksMetadataRawV2(context.Context, *FetchBlocksMetadataRawV2Request) (*FetchBlocksMetadataRawV2Result, error)
+	Aggregate(context.Context, *AggregateQueryRequest) (*AggregateQueryResult, error)
+	AggregateRaw(context.Context, *AggregateQueryRawRequest) (*AggregateQueryRawResult, error)
+	Truncate(context.Context, *TruncateRequest) (*TruncateResult, error)
+	DeleteSeries(context.Context, *DeleteSeriesRequest) (*DeleteSeriesResult, error)
+	IndexCardinality(context.Context, *IndexCardinalityRequest) (*IndexCardinalityResult, error)
+	Health(context.Context, *NodeHealthRequest) (*NodeHealthResult, error)
+	Bootstrapped(context.Context, *NodeBootstrappedRequest) (*NodeBootstrappedResult, error)
+}
+
+// UnimplementedNodeServer can be embedded to have forward compatible implementations.
+type UnimplementedNodeServer struct {
+}
+
+func (*UnimplementedNodeServer) Write(ctx context.Context, req *WriteRequest) (*WriteResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
+}
+func (*UnimplementedNodeServer) WriteTagged(ctx context.Context, req *WriteTaggedRequest) (*WriteTaggedResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteTagged not implemented")
+}
+func (*UnimplementedNodeServer) WriteBatchRaw(ctx context.Context, req *WriteBatchRawRequest) (*WriteBatchRawResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteBatchRaw not implemented")
+}
+func (*UnimplementedNodeServer) WriteBatchRawV2(ctx context.Context, req *WriteBatchRawV2Request) (*WriteBatchRawResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteBatchRawV2 not implemented")
+}
+func (*UnimplementedNodeServer) WriteTaggedBatchRaw(ctx context.Context, req *WriteTaggedBatchRawRequest) (*WriteBatchRawResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteTaggedBatchRaw not implemented")
+}
+func (*UnimplementedNodeServer) WriteTaggedBatchRawV2(ctx context.Context, req *WriteTaggedBatchRawV2Request) (*WriteBatchRawResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteTaggedBatchRawV2 not implemented")
+}
+func (*UnimplementedNodeServer) FetchBatchRaw(ctx context.Context, req *FetchBatchRawRequest) (*FetchBatchRawResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchBatchRaw not implemented")
+}
+func (*UnimplementedNodeServer) FetchBatchRawV2(ctx context.Context, req *FetchBatchRawV2Request) (*FetchBatchRawResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchBatchRawV2 not implemented")
+}
+func (*UnimplementedNodeServer) FetchTagged(ctx context.Context, req *FetchTaggedRequest) (*FetchTaggedResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchTagged not implemented")
+}
+func (*UnimplementedNodeServer) FetchBlocksRaw(ctx context.Context, req *FetchBlocksRawRequest) (*FetchBlocksRawResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchBlocksRaw not implemented")
+}
+func (*UnimplementedNodeServer) FetchBlocksMetadataRawV2(ctx context.Context, req *FetchBlocksMetadataRawV2Request) (*FetchBlocksMetadataRawV2Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchBlocksMetadataRawV2 not implemented")
+}
+func (*UnimplementedNodeServer) Aggregate(ctx context.Context, req *AggregateQueryRequest) (*AggregateQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
+func (*UnimplementedNodeServer) AggregateRaw(ctx context.Context, req *AggregateQueryRawRequest) (*AggregateQueryRawResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateRaw not implemented")
+}
+func (*UnimplementedNodeServer) Truncate(ctx context.Context, req *TruncateRequest) (*TruncateResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Truncate not implemented")
+}
+func (*UnimplementedNodeServer) DeleteSeries(ctx context.Context, req *DeleteSeriesRequest) (*DeleteSeriesResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeries not implemented")
+}
+func (*UnimplementedNodeServer) IndexCardinality(ctx context.Context, req *IndexCardinalityRequest) (*IndexCardinalityResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexCardinality not implemented")
+}
+func (*UnimplementedNodeServer) Health(ctx context.Context, req *NodeHealthRequest) (*NodeHealthResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (*UnimplementedNodeServer) Bootstrapped(ctx context.Context, req *NodeBootstrappedRequest) (*NodeBootstrappedResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bootstrapped not implemented")
+}
+
+func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/Write",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Write(ctx, req.(*WriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_WriteTagged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteTaggedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).WriteTagged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/WriteTagged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).WriteTagged(ctx, req.(*WriteTaggedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_WriteBatchRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteBatchRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).WriteBatchRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/WriteBatchRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).WriteBatchRaw(ctx, req.(*WriteBatchRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_WriteBatchRawV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteBatchRawV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).WriteBatchRawV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/WriteBatchRawV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).WriteBatchRawV2(ctx, req.(*WriteBatchRawV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_WriteTaggedBatchRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteTaggedBatchRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).WriteTaggedBatchRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/WriteTaggedBatchRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).WriteTaggedBatchRaw(ctx, req.(*WriteTaggedBatchRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_WriteTaggedBatchRawV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteTaggedBatchRawV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).WriteTaggedBatchRawV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/WriteTaggedBatchRawV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).WriteTaggedBatchRawV2(ctx, req.(*WriteTaggedBatchRawV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchBatchRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBatchRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchBatchRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/FetchBatchRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchBatchRaw(ctx, req.(*FetchBatchRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchBatchRawV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBatchRawV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchBatchRawV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/FetchBatchRawV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchBatchRawV2(ctx, req.(*FetchBatchRawV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchTagged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchTaggedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchTagged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/FetchTagged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchTagged(ctx, req.(*FetchTaggedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchBlocksRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBlocksRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchBlocksRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/FetchBlocksRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchBlocksRaw(ctx, req.(*FetchBlocksRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_FetchBlocksMetadataRawV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBlocksMetadataRawV2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FetchBlocksMetadataRawV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/FetchBlocksMetadataRawV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FetchBlocksMetadataRawV2(ctx, req.(*FetchBlocksMetadataRawV2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/Aggregate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Aggregate(ctx, req.(*AggregateQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_AggregateRaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateQueryRawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).AggregateRaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/AggregateRaw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).AggregateRaw(ctx, req.(*AggregateQueryRawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Truncate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Truncate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/Truncate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Truncate(ctx, req.(*TruncateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_DeleteSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).DeleteSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/DeleteSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).DeleteSeries(ctx, req.(*DeleteSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_IndexCardinality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexCardinalityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).IndexCardinality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/IndexCardinality",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).IndexCardinality(ctx, req.(*IndexCardinalityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Health(ctx, req.(*NodeHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Bootstrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeBootstrappedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Bootstrapped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.Node/Bootstrapped",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Bootstrapped(ctx, req.(*NodeBootstrappedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "node.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Write",
+			Handler:    _Node_Write_Handler,
+		},
+		{
+			MethodName: "WriteTagged",
+			Handler:    _Node_WriteTagged_Handler,
+		},
+		{
+			MethodName: "WriteBatchRaw",
+			Handler:    _Node_WriteBatchRaw_Handler,
+		},
+		{
+			MethodName: "WriteBatchRawV2",
+			Handler:    _Node_WriteBatchRawV2_Handler,
+		},
+		{
+			MethodName: "WriteTaggedBatchRaw",
+			Handler:    _Node_WriteTaggedBatchRaw_Handler,
+		},
+		{
+			MethodName: "WriteTaggedBatchRawV2",
+			Handler:    _Node_WriteTaggedBatchRawV2_Handler,
+		},
+		{
+			MethodName: "FetchBatchRaw",
+			Handler:    _Node_FetchBatchRaw_Handler,
+		},
+		{
+			MethodName: "FetchBatchRawV2",
+			Handler:    _Node_FetchBatchRawV2_Handler,
+		},
+		{
+			MethodName: "FetchTagged",
+			Handler:    _Node_FetchTagged_Handler,
+		},
+		{
+			MethodName: "FetchBlocksRaw",
+			Handler:    _Node_FetchBlocksRaw_Handler,
+		},
+		{
+			MethodName: "FetchBlocksMetadataRawV2",
+			Handler:    _Node_FetchBlocksMetadataRawV2_Handler,
+		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _Node_Aggregate_Handler,
+		},
+		{
+			MethodName: "AggregateRaw",
+			Handler:    _Node_AggregateRaw_Handler,
+		},
+		{
+			MethodName: "Truncate",
+			Handler:    _Node_Truncate_Handler,
+		},
+		{
+			MethodName: "DeleteSeries",
+			Handler:    _Node_DeleteSeries_Handler,
+		},
+		{
+			MethodName: "IndexCardinality",
+			Handler:    _Node_IndexCardinality_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _Node_Health_Handler,
+		},
+		{
+			MethodName: "Bootstrapped",
+			Handler:    _Node_Bootstrapped_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/m3db/m3/src/dbnode/generated/proto/nodepb/node.proto",
+}
+
+func (m *Error) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteBatchRawErrors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteBatchRawErrors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteBatchRawErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteBatchRawError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteBatchRawError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteBatchRawError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Datapoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Datapoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Datapoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampTimeType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.TimestampTimeType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Annotation) > 0 {
+		i -= len(m.Annotation)
+		copy(dAtA[i:], m.Annotation)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Annotation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Datapoint != nil {
+		{
+			size, err := m.Datapoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteTaggedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteTaggedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteTaggedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Datapoint != nil {
+		{
+			size, err := m.Datapoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteTaggedResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteTaggedResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteTaggedResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteBatchRawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteBatchRawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteBatchRawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteBatchRawRequestElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteBatchRawRequestElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteBatchRawRequestElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Datapoint != nil {
+		{
+			size, err := m.Datapoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteBatchRawV2Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteBatchRawV2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteBatchRawV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NameSpaces) > 0 {
+		for iNdEx := len(m.NameSpaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NameSpaces[iNdEx])
+			copy(dAtA[i:], m.NameSpaces[iNdEx])
+			i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpaces[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteBatchRawV2RequestElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteBatchRawV2RequestElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteBatchRawV2RequestElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NameSpace != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.NameSpace))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Datapoint != nil {
+		{
+			size, err := m.Datapoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteTaggedBatchRawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteTaggedBatchRawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteTaggedBatchRawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteTaggedBatchRawRequestElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteTaggedBatchRawRequestElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteTaggedBatchRawRequestElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Datapoint != nil {
+		{
+			size, err := m.Datapoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EncodedTags) > 0 {
+		i -= len(m.EncodedTags)
+		copy(dAtA[i:], m.EncodedTags)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.EncodedTags)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteTaggedBatchRawV2Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteTaggedBatchRawV2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteTaggedBatchRawV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NameSpaces) > 0 {
+		for iNdEx := len(m.NameSpaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NameSpaces[iNdEx])
+			copy(dAtA[i:], m.NameSpaces[iNdEx])
+			i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpaces[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteTaggedBatchRawV2RequestElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteTaggedBatchRawV2RequestElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteTaggedBatchRawV2RequestElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NameSpace != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.NameSpace))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Datapoint != nil {
+		{
+			size, err := m.Datapoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EncodedTags) > 0 {
+		i -= len(m.EncodedTags)
+		copy(dAtA[i:], m.EncodedTags)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.EncodedTags)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WriteBatchRawResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteBatchRawResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WriteBatchRawResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBatchRawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBatchRawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBatchRawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RangeTimeType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeTimeType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintNode(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RangeEnd != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeEnd))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RangeStart != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBatchRawV2Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBatchRawV2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBatchRawV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NameSpaces) > 0 {
+		for iNdEx := len(m.NameSpaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NameSpaces[iNdEx])
+			copy(dAtA[i:], m.NameSpaces[iNdEx])
+			i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpaces[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBatchRawV2RequestElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBatchRawV2RequestElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBatchRawV2RequestElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RangeTimeType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeTimeType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RangeEnd != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RangeStart != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NameSpace != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.NameSpace))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBatchRawResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBatchRawResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBatchRawResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchRawResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchRawResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchRawResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Segments) > 0 {
+		for iNdEx := len(m.Segments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Segments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Segments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Segments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Segments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unmerged) > 0 {
+		for iNdEx := len(m.Unmerged) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unmerged[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Merged != nil {
+		{
+			size, err := m.Merged.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Segment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Segment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Segment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Checksum != nil {
+		{
+			size, err := m.Checksum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockSize != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.BlockSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Tail) > 0 {
+		i -= len(m.Tail)
+		copy(dAtA[i:], m.Tail)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Tail)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Head) > 0 {
+		i -= len(m.Head)
+		copy(dAtA[i:], m.Head)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Head)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchTaggedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchTaggedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchTaggedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DocsLimit != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.DocsLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RequireExhaustive {
+		i--
+		if m.RequireExhaustive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RangeTimeType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeTimeType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Limit != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FetchData {
+		i--
+		if m.FetchData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RangeEnd != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RangeStart != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchTaggedResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchTaggedResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchTaggedResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exhaustive {
+		i--
+		if m.Exhaustive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchTaggedIDResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchTaggedIDResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchTaggedIDResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Segments) > 0 {
+		for iNdEx := len(m.Segments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Segments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EncodedTags) > 0 {
+		i -= len(m.EncodedTags)
+		copy(dAtA[i:], m.EncodedTags)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.EncodedTags)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBlocksRawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBlocksRawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBlocksRawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Shard != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBlocksRawRequestElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBlocksRawRequestElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBlocksRawRequestElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Starts) > 0 {
+		dAtA13 := make([]byte, len(m.Starts)*10)
+		var j12 int
+		for _, num1 := range m.Starts {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintNode(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBlocksRawResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBlocksRawResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBlocksRawResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Blocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Blocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Blocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Block) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Block) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Checksum != nil {
+		{
+			size, err := m.Checksum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Segments != nil {
+		{
+			size, err := m.Segments.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Start != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBlocksMetadataRawV2Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBlocksMetadataRawV2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBlocksMetadataRawV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeLastRead {
+		i--
+		if m.IncludeLastRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IncludeChecksums {
+		i--
+		if m.IncludeChecksums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IncludeSizes {
+		i--
+		if m.IncludeSizes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Limit != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RangeEnd != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RangeStart != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Shard != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchBlocksMetadataRawV2Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchBlocksMetadataRawV2Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchBlocksMetadataRawV2Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockMetadataV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockMetadataV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockMetadataV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncodedTags) > 0 {
+		i -= len(m.EncodedTags)
+		copy(dAtA[i:], m.EncodedTags)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.EncodedTags)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.LastReadTimeType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.LastReadTimeType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastRead != nil {
+		{
+			size, err := m.LastRead.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Checksum != nil {
+		{
+			size, err := m.Checksum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Size_ != nil {
+		{
+			size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Start != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TermQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TermQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegexpQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegexpQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegexpQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regexp) > 0 {
+		i -= len(m.Regexp)
+		copy(dAtA[i:], m.Regexp)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Regexp)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NegationQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NegationQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NegationQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConjunctionQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConjunctionQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConjunctionQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisjunctionQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisjunctionQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisjunctionQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Query) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Query) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Field != nil {
+		{
+			size, err := m.Field.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.All != nil {
+		{
+			size, err := m.All.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Disjunction != nil {
+		{
+			size, err := m.Disjunction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Conjunction != nil {
+		{
+			size, err := m.Conjunction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Negation != nil {
+		{
+			size, err := m.Negation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Regexp != nil {
+		{
+			size, err := m.Regexp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Term != nil {
+		{
+			size, err := m.Term.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RangeType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeType))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AggregateQueryType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.AggregateQueryType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TagNameFilter) > 0 {
+		for iNdEx := len(m.TagNameFilter) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TagNameFilter[iNdEx])
+			copy(dAtA[i:], m.TagNameFilter[iNdEx])
+			i = encodeVarintNode(dAtA, i, uint64(len(m.TagNameFilter[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Limit != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RangeEnd != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RangeStart != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNode(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateQueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateQueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exhaustive {
+		i--
+		if m.Exhaustive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateQueryResultTagNameElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateQueryResultTagNameElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateQueryResultTagNameElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TagValues) > 0 {
+		for iNdEx := len(m.TagValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TagValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TagName) > 0 {
+		i -= len(m.TagName)
+		copy(dAtA[i:], m.TagName)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.TagName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateQueryResultTagValueElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateQueryResultTagValueElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateQueryResultTagValueElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TagValue) > 0 {
+		i -= len(m.TagValue)
+		copy(dAtA[i:], m.TagValue)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.TagValue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateQueryRawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateQueryRawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateQueryRawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RangeType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeType))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AggregateQueryType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.AggregateQueryType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TagNameFilter) > 0 {
+		for iNdEx := len(m.TagNameFilter) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TagNameFilter[iNdEx])
+			copy(dAtA[i:], m.TagNameFilter[iNdEx])
+			i = encodeVarintNode(dAtA, i, uint64(len(m.TagNameFilter[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Limit != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RangeEnd != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RangeStart != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateQueryRawResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateQueryRawResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateQueryRawResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exhaustive {
+		i--
+		if m.Exhaustive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateQueryRawResultTagNameElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateQueryRawResultTagNameElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateQueryRawResultTagNameElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TagValues) > 0 {
+		for iNdEx := len(m.TagValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TagValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TagName) > 0 {
+		i -= len(m.TagName)
+		copy(dAtA[i:], m.TagName)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.TagName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateQueryRawResultTagValueElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateQueryRawResultTagValueElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateQueryRawResultTagValueElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TagValue) > 0 {
+		i -= len(m.TagValue)
+		copy(dAtA[i:], m.TagValue)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.TagValue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TruncateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TruncateResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncateResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumSeries != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.NumSeries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSeriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSeriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSeriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RangeEnd != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RangeStart != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSeriesResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSeriesResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSeriesResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shards) > 0 {
+		for iNdEx := len(m.Shards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NumSeries != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.NumSeries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSeriesShardResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSeriesShardResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSeriesShardResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumSeries != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.NumSeries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Shard != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexCardinalityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexCardinalityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexCardinalityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MetricNameTag) > 0 {
+		i -= len(m.MetricNameTag)
+		copy(dAtA[i:], m.MetricNameTag)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.MetricNameTag)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RangeEnd != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RangeStart != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.RangeStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexCardinalityResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexCardinalityResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexCardinalityResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexBlockCardinality) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexBlockCardinality) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexBlockCardinality) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LabelValuesByLabelName) > 0 {
+		for iNdEx := len(m.LabelValuesByLabelName) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelValuesByLabelName[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LabelValueCountByLabelName) > 0 {
+		for iNdEx := len(m.LabelValueCountByLabelName) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelValueCountByLabelName[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SeriesCountByLabelValuePair) > 0 {
+		for iNdEx := len(m.SeriesCountByLabelValuePair) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeriesCountByLabelValuePair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SeriesCountByLabelName) > 0 {
+		for iNdEx := len(m.SeriesCountByLabelName) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeriesCountByLabelName[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SeriesCountByMetricName) > 0 {
+		for iNdEx := len(m.SeriesCountByMetricName) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeriesCountByMetricName[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumSeries != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.NumSeries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockStart != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.BlockStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexCardinalityEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexCardinalityEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexCardinalityEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexCardinalityLabelValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexCardinalityLabelValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexCardinalityLabelValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintNode(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeHealthResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeHealthResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeHealthResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Compressions) > 0 {
+		dAtA31 := make([]byte, len(m.Compressions)*10)
+		var j30 int
+		for _, num := range m.Compressions {
+			for num >= 1<<7 {
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j30++
+			}
+			dAtA31[j30] = uint8(num)
+			j30++
+		}
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintNode(dAtA, i, uint64(j30))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintNode(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintNode(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintNode(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Bootstrapped {
+		i--
+		if m.Bootstrapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeBootstrappedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeBootstrappedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeBootstrappedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeBootstrappedResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeBootstrappedResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeBootstrappedResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNode(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Error) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovNode(uint64(m.Type))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteBatchRawErrors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WriteBatchRawError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovNode(uint64(m.Index))
+	}
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *Datapoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovNode(uint64(m.Timestamp))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.TimestampTimeType != 0 {
+		n += 1 + sovNode(uint64(m.TimestampTimeType))
+	}
+	return n
+}
+
+func (m *Tag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Datapoint != nil {
+		l = m.Datapoint.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WriteTaggedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.Datapoint != nil {
+		l = m.Datapoint.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteTaggedResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WriteBatchRawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WriteBatchRawRequestElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Datapoint != nil {
+		l = m.Datapoint.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteBatchRawV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NameSpaces) > 0 {
+		for _, b := range m.NameSpaces {
+			l = len(b)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WriteBatchRawV2RequestElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Datapoint != nil {
+		l = m.Datapoint.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.NameSpace != 0 {
+		n += 1 + sovNode(uint64(m.NameSpace))
+	}
+	return n
+}
+
+func (m *WriteTaggedBatchRawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WriteTaggedBatchRawRequestElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.EncodedTags)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Datapoint != nil {
+		l = m.Datapoint.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteTaggedBatchRawV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NameSpaces) > 0 {
+		for _, b := range m.NameSpaces {
+			l = len(b)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WriteTaggedBatchRawV2RequestElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.EncodedTags)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Datapoint != nil {
+		l = m.Datapoint.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.NameSpace != 0 {
+		n += 1 + sovNode(uint64(m.NameSpace))
+	}
+	return n
+}
+
+func (m *WriteBatchRawResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FetchBatchRawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RangeStart != 0 {
+		n += 1 + sovNode(uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		n += 1 + sovNode(uint64(m.RangeEnd))
+	}
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		for _, b := range m.Ids {
+			l = len(b)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.RangeTimeType != 0 {
+		n += 1 + sovNode(uint64(m.RangeTimeType))
+	}
+	return n
+}
+
+func (m *FetchBatchRawV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NameSpaces) > 0 {
+		for _, b := range m.NameSpaces {
+			l = len(b)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FetchBatchRawV2RequestElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NameSpace != 0 {
+		n += 1 + sovNode(uint64(m.NameSpace))
+	}
+	if m.RangeStart != 0 {
+		n += 1 + sovNode(uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		n += 1 + sovNode(uint64(m.RangeEnd))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.RangeTimeType != 0 {
+		n += 1 + sovNode(uint64(m.RangeTimeType))
+	}
+	return n
+}
+
+func (m *FetchBatchRawResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FetchRawResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *Segments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Merged != nil {
+		l = m.Merged.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Unmerged) > 0 {
+		for _, e := range m.Unmerged {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Segment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Head)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Tail)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovNode(uint64(m.StartTime))
+	}
+	if m.BlockSize != 0 {
+		n += 1 + sovNode(uint64(m.BlockSize))
+	}
+	if m.Checksum != nil {
+		l = m.Checksum.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *FetchTaggedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.RangeStart != 0 {
+		n += 1 + sovNode(uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		n += 1 + sovNode(uint64(m.RangeEnd))
+	}
+	if m.FetchData {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovNode(uint64(m.Limit))
+	}
+	if m.RangeTimeType != 0 {
+		n += 1 + sovNode(uint64(m.RangeTimeType))
+	}
+	if m.RequireExhaustive {
+		n += 2
+	}
+	if m.DocsLimit != 0 {
+		n += 1 + sovNode(uint64(m.DocsLimit))
+	}
+	return n
+}
+
+func (m *FetchTaggedResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.Exhaustive {
+		n += 2
+	}
+	return n
+}
+
+func (m *FetchTaggedIDResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.EncodedTags)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *FetchBlocksRawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovNode(uint64(m.Shard))
+	}
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FetchBlocksRawRequestElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Starts) > 0 {
+		l = 0
+		for _, e := range m.Starts {
+			l += sovNode(uint64(e))
+		}
+		n += 1 + sovNode(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *FetchBlocksRawResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Blocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovNode(uint64(m.Start))
+	}
+	if m.Segments != nil {
+		l = m.Segments.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Checksum != nil {
+		l = m.Checksum.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *FetchBlocksMetadataRawV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovNode(uint64(m.Shard))
+	}
+	if m.RangeStart != 0 {
+		n += 1 + sovNode(uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		n += 1 + sovNode(uint64(m.RangeEnd))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovNode(uint64(m.Limit))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.IncludeSizes {
+		n += 2
+	}
+	if m.IncludeChecksums {
+		n += 2
+	}
+	if m.IncludeLastRead {
+		n += 2
+	}
+	return n
+}
+
+func (m *FetchBlocksMetadataRawV2Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockMetadataV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovNode(uint64(m.Start))
+	}
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Size_ != nil {
+		l = m.Size_.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Checksum != nil {
+		l = m.Checksum.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.LastRead != nil {
+		l = m.LastRead.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.LastReadTimeType != 0 {
+		n += 1 + sovNode(uint64(m.LastReadTimeType))
+	}
+	l = len(m.EncodedTags)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *TermQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *RegexpQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Regexp)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *NegationQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *ConjunctionQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DisjunctionQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FieldQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *Query) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Term != nil {
+		l = m.Term.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Regexp != nil {
+		l = m.Regexp.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Negation != nil {
+		l = m.Negation.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Conjunction != nil {
+		l = m.Conjunction.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Disjunction != nil {
+		l = m.Disjunction.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.All != nil {
+		l = m.All.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Field != nil {
+		l = m.Field.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregateQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.RangeStart != 0 {
+		n += 1 + sovNode(uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		n += 1 + sovNode(uint64(m.RangeEnd))
+	}
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovNode(uint64(m.Limit))
+	}
+	if len(m.TagNameFilter) > 0 {
+		for _, s := range m.TagNameFilter {
+			l = len(s)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.AggregateQueryType != 0 {
+		n += 1 + sovNode(uint64(m.AggregateQueryType))
+	}
+	if m.RangeType != 0 {
+		n += 1 + sovNode(uint64(m.RangeType))
+	}
+	return n
+}
+
+func (m *AggregateQueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.Exhaustive {
+		n += 2
+	}
+	return n
+}
+
+func (m *AggregateQueryResultTagNameElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TagName)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.TagValues) > 0 {
+		for _, e := range m.TagValues {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AggregateQueryResultTagValueElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TagValue)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregateQueryRawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.RangeStart != 0 {
+		n += 1 + sovNode(uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		n += 1 + sovNode(uint64(m.RangeEnd))
+	}
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovNode(uint64(m.Limit))
+	}
+	if len(m.TagNameFilter) > 0 {
+		for _, b := range m.TagNameFilter {
+			l = len(b)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.AggregateQueryType != 0 {
+		n += 1 + sovNode(uint64(m.AggregateQueryType))
+	}
+	if m.RangeType != 0 {
+		n += 1 + sovNode(uint64(m.RangeType))
+	}
+	return n
+}
+
+func (m *AggregateQueryRawResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.Exhaustive {
+		n += 2
+	}
+	return n
+}
+
+func (m *AggregateQueryRawResultTagNameElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TagName)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.TagValues) > 0 {
+		for _, e := range m.TagValues {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AggregateQueryRawResultTagValueElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TagValue)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *TruncateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *TruncateResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumSeries != 0 {
+		n += 1 + sovNode(uint64(m.NumSeries))
+	}
+	return n
+}
+
+func (m *DeleteSeriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.RangeStart != 0 {
+		n += 1 + sovNode(uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		n += 1 + sovNode(uint64(m.RangeEnd))
+	}
+	return n
+}
+
+func (m *DeleteSeriesResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumSeries != 0 {
+		n += 1 + sovNode(uint64(m.NumSeries))
+	}
+	if len(m.Shards) > 0 {
+		for _, e := range m.Shards {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteSeriesShardResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shard != 0 {
+		n += 1 + sovNode(uint64(m.Shard))
+	}
+	if m.NumSeries != 0 {
+		n += 1 + sovNode(uint64(m.NumSeries))
+	}
+	return n
+}
+
+func (m *IndexCardinalityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.RangeStart != 0 {
+		n += 1 + sovNode(uint64(m.RangeStart))
+	}
+	if m.RangeEnd != 0 {
+		n += 1 + sovNode(uint64(m.RangeEnd))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovNode(uint64(m.Limit))
+	}
+	l = len(m.MetricNameTag)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexCardinalityResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexBlockCardinality) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockStart != 0 {
+		n += 1 + sovNode(uint64(m.BlockStart))
+	}
+	if m.NumSeries != 0 {
+		n += 1 + sovNode(uint64(m.NumSeries))
+	}
+	if len(m.SeriesCountByMetricName) > 0 {
+		for _, e := range m.SeriesCountByMetricName {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if len(m.SeriesCountByLabelName) > 0 {
+		for _, e := range m.SeriesCountByLabelName {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if len(m.SeriesCountByLabelValuePair) > 0 {
+		for _, e := range m.SeriesCountByLabelValuePair {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if len(m.LabelValueCountByLabelName) > 0 {
+		for _, e := range m.LabelValueCountByLabelName {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if len(m.LabelValuesByLabelName) > 0 {
+		for _, e := range m.LabelValuesByLabelName {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexCardinalityEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovNode(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *IndexCardinalityLabelValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, b := range m.Values {
+			l = len(b)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NodeHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NodeHealthResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Bootstrapped {
+		n += 2
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovNode(uint64(len(k))) + 1 + len(v) + sovNode(uint64(len(v)))
+			n += mapEntrySize + 1 + sovNode(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Compressions) > 0 {
+		l = 0
+		for _, e := range m.Compressions {
+			l += sovNode(uint64(e))
+		}
+		n += 1 + sovNode(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *NodeBootstrappedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *NodeBootstrappedResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovNode(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNode(x uint64) (n int) {
+	return sovNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Error) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ErrorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteBatchRawErrors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteBatchRawErrors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteBatchRawErrors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &WriteBatchRawError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteBatchRawError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteBatchRawError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteBatchRawError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Datapoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Datapoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Datapoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = append(m.Annotation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Annotation == nil {
+				m.Annotation = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampTimeType", wireType)
+			}
+			m.TimestampTimeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampTimeType |= TimeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datapoint == nil {
+				m.Datapoint = &Datapoint{}
+			}
+			if err := m.Datapoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteTaggedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteTaggedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteTaggedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, &Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datapoint == nil {
+				m.Datapoint = &Datapoint{}
+			}
+			if err := m.Datapoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteTaggedResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteTaggedResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteTaggedResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteBatchRawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteBatchRawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteBatchRawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &WriteBatchRawRequestElement{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteBatchRawRequestElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteBatchRawRequestElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteBatchRawRequestElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datapoint == nil {
+				m.Datapoint = &Datapoint{}
+			}
+			if err := m.Datapoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteBatchRawV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteBatchRawV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteBatchRawV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpaces = append(m.NameSpaces, make([]byte, postIndex-iNdEx))
+			copy(m.NameSpaces[len(m.NameSpaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &WriteBatchRawV2RequestElement{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteBatchRawV2RequestElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteBatchRawV2RequestElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteBatchRawV2RequestElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datapoint == nil {
+				m.Datapoint = &Datapoint{}
+			}
+			if err := m.Datapoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			m.NameSpace = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NameSpace |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteTaggedBatchRawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteTaggedBatchRawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteTaggedBatchRawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &WriteTaggedBatchRawRequestElement{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteTaggedBatchRawRequestElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteTaggedBatchRawRequestElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteTaggedBatchRawRequestElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedTags", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedTags = append(m.EncodedTags[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedTags == nil {
+				m.EncodedTags = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datapoint == nil {
+				m.Datapoint = &Datapoint{}
+			}
+			if err := m.Datapoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteTaggedBatchRawV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteTaggedBatchRawV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteTaggedBatchRawV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpaces = append(m.NameSpaces, make([]byte, postIndex-iNdEx))
+			copy(m.NameSpaces[len(m.NameSpaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &WriteTaggedBatchRawV2RequestElement{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteTaggedBatchRawV2RequestElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteTaggedBatchRawV2RequestElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteTaggedBatchRawV2RequestElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedTags", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedTags = append(m.EncodedTags[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedTags == nil {
+				m.EncodedTags = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datapoint == nil {
+				m.Datapoint = &Datapoint{}
+			}
+			if err := m.Datapoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			m.NameSpace = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NameSpace |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteBatchRawResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteBatchRawResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteBatchRawResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBatchRawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBatchRawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBatchRawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			}
+			m.RangeStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			m.RangeEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, make([]byte, postIndex-iNdEx))
+			copy(m.Ids[len(m.Ids)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeTimeType", wireType)
+			}
+			m.RangeTimeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeTimeType |= TimeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBatchRawV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBatchRawV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBatchRawV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpaces = append(m.NameSpaces, make([]byte, postIndex-iNdEx))
+			copy(m.NameSpaces[len(m.NameSpaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &FetchBatchRawV2RequestElement{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBatchRawV2RequestElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBatchRawV2RequestElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBatchRawV2RequestElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			m.NameSpace = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NameSpace |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			}
+			m.RangeStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			m.RangeEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeTimeType", wireType)
+			}
+			m.RangeTimeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeTimeType |= TimeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBatchRawResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBatchRawResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBatchRawResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &FetchRawResult{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchRawResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchRawResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchRawResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Segments = append(m.Segments, &Segments{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Segments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Segments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Segments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Merged == nil {
+				m.Merged = &Segment{}
+			}
+			if err := m.Merged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unmerged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unmerged = append(m.Unmerged, &Segment{})
+			if err := m.Unmerged[len(m.Unmerged)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Segment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Segment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Segment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Head = append(m.Head[:0], dAtA[iNdEx:postIndex]...)
+			if m.Head == nil {
+				m.Head = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tail = append(m.Tail[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tail == nil {
+				m.Tail = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
+			}
+			m.BlockSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checksum == nil {
+				m.Checksum = &types.Int64Value{}
+			}
+			if err := m.Checksum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchTaggedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchTaggedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchTaggedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			}
+			m.RangeStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			m.RangeEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FetchData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FetchData = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeTimeType", wireType)
+			}
+			m.RangeTimeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeTimeType |= TimeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireExhaustive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireExhaustive = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocsLimit", wireType)
+			}
+			m.DocsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocsLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchTaggedResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchTaggedResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchTaggedResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &FetchTaggedIDResult{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exhaustive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exhaustive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchTaggedIDResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchTaggedIDResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchTaggedIDResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedTags", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedTags = append(m.EncodedTags[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedTags == nil {
+				m.EncodedTags = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Segments = append(m.Segments, &Segments{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBlocksRawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBlocksRawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBlocksRawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &FetchBlocksRawRequestElement{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBlocksRawRequestElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBlocksRawRequestElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBlocksRawRequestElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNode
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Starts = append(m.Starts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNode
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNode
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNode
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Starts) == 0 {
+					m.Starts = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNode
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Starts = append(m.Starts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Starts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBlocksRawResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBlocksRawResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBlocksRawResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &Blocks{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Blocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Blocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Blocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &Block{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Segments == nil {
+				m.Segments = &Segments{}
+			}
+			if err := m.Segments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checksum == nil {
+				m.Checksum = &types.Int64Value{}
+			}
+			if err := m.Checksum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBlocksMetadataRawV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBlocksMetadataRawV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBlocksMetadataRawV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			}
+			m.RangeStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			m.RangeEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = append(m.PageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.PageToken == nil {
+				m.PageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeSizes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeSizes = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeChecksums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeChecksums = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeLastRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeLastRead = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchBlocksMetadataRawV2Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchBlocksMetadataRawV2Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchBlocksMetadataRawV2Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, &BlockMetadataV2{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockMetadataV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockMetadataV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockMetadataV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Size_ == nil {
+				m.Size_ = &types.Int64Value{}
+			}
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checksum == nil {
+				m.Checksum = &types.Int64Value{}
+			}
+			if err := m.Checksum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRead == nil {
+				m.LastRead = &types.Int64Value{}
+			}
+			if err := m.LastRead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReadTimeType", wireType)
+			}
+			m.LastReadTimeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReadTimeType |= TimeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedTags", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedTags = append(m.EncodedTags[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncodedTags == nil {
+				m.EncodedTags = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TermQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TermQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TermQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Term = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegexpQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegexpQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegexpQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regexp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regexp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NegationQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NegationQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NegationQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &Query{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConjunctionQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConjunctionQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConjunctionQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, &Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisjunctionQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisjunctionQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisjunctionQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, &Query{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Term == nil {
+				m.Term = &TermQuery{}
+			}
+			if err := m.Term.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regexp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Regexp == nil {
+				m.Regexp = &RegexpQuery{}
+			}
+			if err := m.Regexp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Negation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Negation == nil {
+				m.Negation = &NegationQuery{}
+			}
+			if err := m.Negation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conjunction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Conjunction == nil {
+				m.Conjunction = &ConjunctionQuery{}
+			}
+			if err := m.Conjunction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disjunction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Disjunction == nil {
+				m.Disjunction = &DisjunctionQuery{}
+			}
+			if err := m.Disjunction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.All == nil {
+				m.All = &AllQuery{}
+			}
+			if err := m.All.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Field == nil {
+				m.Field = &FieldQuery{}
+			}
+			if err := m.Field.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &Query{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			}
+			m.RangeStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			m.RangeEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagNameFilter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagNameFilter = append(m.TagNameFilter, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateQueryType", wireType)
+			}
+			m.AggregateQueryType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregateQueryType |= AggregateQueryType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeType", wireType)
+			}
+			m.RangeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeType |= TimeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &AggregateQueryResultTagNameElement{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exhaustive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exhaustive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateQueryResultTagNameElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateQueryResultTagNameElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateQueryResultTagNameElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagValues = append(m.TagValues, &AggregateQueryResultTagValueElement{})
+			if err := m.TagValues[len(m.TagValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateQueryResultTagValueElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateQueryResultTagValueElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateQueryResultTagValueElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateQueryRawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateQueryRawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateQueryRawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			}
+			m.RangeStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			m.RangeEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagNameFilter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagNameFilter = append(m.TagNameFilter, make([]byte, postIndex-iNdEx))
+			copy(m.TagNameFilter[len(m.TagNameFilter)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateQueryType", wireType)
+			}
+			m.AggregateQueryType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregateQueryType |= AggregateQueryType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeType", wireType)
+			}
+			m.RangeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeType |= TimeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateQueryRawResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateQueryRawResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateQueryRawResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &AggregateQueryRawResultTagNameElement{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exhaustive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exhaustive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateQueryRawResultTagNameElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateQueryRawResultTagNameElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateQueryRawResultTagNameElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagName", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagName = append(m.TagName[:0], dAtA[iNdEx:postIndex]...)
+			if m.TagName == nil {
+				m.TagName = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagValues = append(m.TagValues, &AggregateQueryRawResultTagValueElement{})
+			if err := m.TagValues[len(m.TagValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateQueryRawResultTagValueElement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateQueryRawResultTagValueElement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateQueryRawResultTagValueElement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagValue = append(m.TagValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.TagValue == nil {
+				m.TagValue = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TruncateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TruncateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TruncateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TruncateResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TruncateResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TruncateResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSeries", wireType)
+			}
+			m.NumSeries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSeries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteSeriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteSeriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteSeriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			}
+			m.RangeStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			m.RangeEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteSeriesResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteSeriesResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteSeriesResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSeries", wireType)
+			}
+			m.NumSeries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSeries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shards = append(m.Shards, &DeleteSeriesShardResult{})
+			if err := m.Shards[len(m.Shards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteSeriesShardResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteSeriesShardResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteSeriesShardResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSeries", wireType)
+			}
+			m.NumSeries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSeries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexCardinalityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexCardinalityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexCardinalityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeStart", wireType)
+			}
+			m.RangeStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeEnd", wireType)
+			}
+			m.RangeEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricNameTag", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricNameTag = append(m.MetricNameTag[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetricNameTag == nil {
+				m.MetricNameTag = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexCardinalityResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexCardinalityResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexCardinalityResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &IndexBlockCardinality{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexBlockCardinality) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexBlockCardinality: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexBlockCardinality: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockStart", wireType)
+			}
+			m.BlockStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSeries", wireType)
+			}
+			m.NumSeries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSeries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesCountByMetricName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesCountByMetricName = append(m.SeriesCountByMetricName, &IndexCardinalityEntry{})
+			if err := m.SeriesCountByMetricName[len(m.SeriesCountByMetricName)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesCountByLabelName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesCountByLabelName = append(m.SeriesCountByLabelName, &IndexCardinalityEntry{})
+			if err := m.SeriesCountByLabelName[len(m.SeriesCountByLabelName)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesCountByLabelValuePair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesCountByLabelValuePair = append(m.SeriesCountByLabelValuePair, &IndexCardinalityEntry{})
+			if err := m.SeriesCountByLabelValuePair[len(m.SeriesCountByLabelValuePair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelValueCountByLabelName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelValueCountByLabelName = append(m.LabelValueCountByLabelName, &IndexCardinalityEntry{})
+			if err := m.LabelValueCountByLabelName[len(m.LabelValueCountByLabelName)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelValuesByLabelName", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelValuesByLabelName = append(m.LabelValuesByLabelName, &IndexCardinalityLabelValues{})
+			if err := m.LabelValuesByLabelName[len(m.LabelValuesByLabelName)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexCardinalityEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexCardinalityEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexCardinalityEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexCardinalityLabelValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexCardinalityLabelValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexCardinalityLabelValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, make([]byte, postIndex-iNdEx))
+			copy(m.Values[len(m.Values)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeHealthResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeHealthResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeHealthResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrapped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bootstrapped = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNode
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNode
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthNode
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthNode
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNode
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthNode
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthNode
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipNode(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthNode
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v CompressionType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNode
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CompressionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Compressions = append(m.Compressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNode
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNode
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNode
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Compressions) == 0 {
+					m.Compressions = make([]CompressionType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CompressionType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNode
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CompressionType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Compressions = append(m.Compressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compressions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeBootstrappedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeBootstrappedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeBootstrappedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeBootstrappedResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeBootstrappedResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeBootstrappedResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNode(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNode
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNode
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNode
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNode        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNode          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNode = fmt.Errorf("proto: unexpected end of group")
+)
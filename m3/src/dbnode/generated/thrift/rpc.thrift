@@ -32,6 +32,12 @@ enum ErrorType {
 	BAD_REQUEST
 }
 
+enum CompressionType {
+	NONE,
+	SNAPPY,
+	ZSTD
+}
+
 exception Error {
 	1: required ErrorType type = ErrorType.INTERNAL_ERROR
 	2: required string message
@@ -63,6 +69,8 @@ service Node {
 	void writeTaggedBatchRawV2(1: WriteTaggedBatchRawV2Request req) throws (1: WriteBatchRawErrors err)
 	void repair() throws (1: Error err)
 	TruncateResult truncate(1: TruncateRequest req) throws (1: Error err)
+	DeleteSeriesResult deleteSeries(1: DeleteSeriesRequest req) throws (1: Error err)
+	IndexCardinalityResult indexCardinality(1: IndexCardinalityRequest req) throws (1: Error err)
 
 	// Management endpoints
 	NodeHealthResult health() throws (1: Error err)
@@ -172,11 +180,16 @@ struct FetchTaggedRequest {
 	7: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
 	8: optional bool requireExhaustive = false
 	9: optional i64 docsLimit
+	10: optional CompressionType acceptCompression = CompressionType.NONE
 }
 
 struct FetchTaggedResult {
 	1: required list<FetchTaggedIDResult> elements
 	2: required bool exhaustive
+	// When compressed, elements is empty and compressed holds the compressed
+	// binary serialized result.
+	3: optional CompressionType compression = CompressionType.NONE
+	4: optional binary compressed
 }
 
 struct FetchTaggedIDResult {
@@ -276,6 +289,10 @@ struct WriteTaggedBatchRawRequest {
 struct WriteTaggedBatchRawV2Request {
 	1: required list<binary> nameSpaces
 	2: required list<WriteTaggedBatchRawV2RequestElement> elements
+	// When compressed, nameSpaces and elements are empty and compressed holds
+	// the compressed binary serialized request.
+	3: optional CompressionType compression = CompressionType.NONE
+	4: optional binary compressed
 }
 
 struct WriteTaggedBatchRawRequestElement {
@@ -304,11 +321,63 @@ struct TruncateResult {
 	1: required i64 numSeries
 }
 
+struct DeleteSeriesRequest {
+	1: required binary nameSpace
+	2: required binary query
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+}
+
+struct DeleteSeriesResult {
+	1: required i64 numSeries
+	2: optional list<DeleteSeriesShardResult> shards
+}
+
+struct DeleteSeriesShardResult {
+	1: required i32 shard
+	2: required i64 numSeries
+}
+
+struct IndexCardinalityRequest {
+	1: required binary nameSpace
+	2: required i64 rangeStart
+	3: required i64 rangeEnd
+	4: required i64 limit
+	5: required binary metricNameTag
+	6: optional binary query
+}
+
+struct IndexCardinalityResult {
+	1: required list<IndexBlockCardinality> blocks
+}
+
+struct IndexBlockCardinality {
+	1: required i64 blockStart
+	2: required i64 numSeries
+	3: required list<IndexCardinalityEntry> seriesCountByMetricName
+	4: required list<IndexCardinalityEntry> seriesCountByLabelName
+	5: required list<IndexCardinalityEntry> seriesCountByLabelValuePair
+	6: required list<IndexCardinalityEntry> labelValueCountByLabelName
+	7: optional list<IndexCardinalityLabelValues> labelValuesByLabelName
+}
+
+struct IndexCardinalityLabelValues {
+	1: required binary name
+	2: required list<binary> values
+}
+
+struct IndexCardinalityEntry {
+	1: required binary name
+	2: optional binary value
+	3: required i64 count
+}
+
 struct NodeHealthResult {
 	1: required bool ok
 	2: required string status
 	3: required bool bootstrapped
 	4: optional map<string,string> metadata
+	5: optional list<CompressionType> compressions
 }
 
 struct NodeBootstrappedResult {}
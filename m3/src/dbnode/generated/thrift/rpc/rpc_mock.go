@@ -285,6 +285,21 @@ func (mr *MockTChanNodeMockRecorder) DebugProfileStop(ctx, req interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugProfileStop", reflect.TypeOf((*MockTChanNode)(nil).DebugProfileStop), ctx, req)
 }
 
+// DeleteSeries mocks base method
+func (m *MockTChanNode) DeleteSeries(ctx thrift.Context, req *DeleteSeriesRequest) (*DeleteSeriesResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, req)
+	ret0, _ := ret[0].(*DeleteSeriesResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries
+func (mr *MockTChanNodeMockRecorder) DeleteSeries(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockTChanNode)(nil).DeleteSeries), ctx, req)
+}
+
 // Fetch mocks base method
 func (m *MockTChanNode) Fetch(ctx thrift.Context, req *FetchRequest) (*FetchResult_, error) {
 	m.ctrl.T.Helper()
@@ -450,6 +465,21 @@ func (mr *MockTChanNodeMockRecorder) Health(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockTChanNode)(nil).Health), ctx)
 }
 
+// IndexCardinality mocks base method
+func (m *MockTChanNode) IndexCardinality(ctx thrift.Context, req *IndexCardinalityRequest) (*IndexCardinalityResult_, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexCardinality", ctx, req)
+	ret0, _ := ret[0].(*IndexCardinalityResult_)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexCardinality indicates an expected call of IndexCardinality
+func (mr *MockTChanNodeMockRecorder) IndexCardinality(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexCardinality", reflect.TypeOf((*MockTChanNode)(nil).IndexCardinality), ctx, req)
+}
+
 // Query mocks base method
 func (m *MockTChanNode) Query(ctx thrift.Context, req *QueryRequest) (*QueryResult_, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,72 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"time"
+)
+
+var (
+	// defaultColdTierEnabled disables the cold tier by default.
+	defaultColdTierEnabled = false
+
+	// defaultColdTierOffloadAfter is the default age of blocks offloaded to
+	// the cold tier.
+	defaultColdTierOffloadAfter = 7 * 24 * time.Hour
+)
+
+type coldTierOpts struct {
+	enabled      bool
+	offloadAfter time.Duration
+}
+
+// NewColdTierOptions returns a new ColdTierOptions.
+func NewColdTierOptions() ColdTierOptions {
+	return &coldTierOpts{
+		enabled:      defaultColdTierEnabled,
+		offloadAfter: defaultColdTierOffloadAfter,
+	}
+}
+
+func (c *coldTierOpts) Equal(value ColdTierOptions) bool {
+	return c.Enabled() == value.Enabled() &&
+		c.OffloadAfter() == value.OffloadAfter()
+}
+
+func (c *coldTierOpts) SetEnabled(value bool) ColdTierOptions {
+	co := *c
+	co.enabled = value
+	return &co
+}
+
+func (c *coldTierOpts) Enabled() bool {
+	return c.enabled
+}
+
+func (c *coldTierOpts) SetOffloadAfter(value time.Duration) ColdTierOptions {
+	co := *c
+	co.offloadAfter = value
+	return &co
+}
+
+func (c *coldTierOpts) OffloadAfter() time.Duration {
+	return c.offloadAfter
+}
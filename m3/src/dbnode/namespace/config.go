@@ -57,6 +57,7 @@ type MetadataConfiguration struct {
 	ColdWritesEnabled *bool                   `yaml:"coldWritesEnabled"`
 	Retention         retention.Configuration `yaml:"retention" validate:"nonzero"`
 	Index             IndexConfiguration      `yaml:"index"`
+	ColdTier          ColdTierConfiguration   `yaml:"coldTier"`
 }
 
 // Metadata returns a Metadata corresponding to the receiver struct
@@ -65,7 +66,8 @@ func (mc *MetadataConfiguration) Metadata() (Metadata, error) {
 	ropts := mc.Retention.Options()
 	opts := NewOptions().
 		SetRetentionOptions(ropts).
-		SetIndexOptions(iopts)
+		SetIndexOptions(iopts).
+		SetColdTierOptions(mc.ColdTier.Options())
 	if v := mc.BootstrapEnabled; v != nil {
 		opts = opts.SetBootstrapEnabled(*v)
 	}
@@ -99,3 +101,19 @@ func (ic *IndexConfiguration) Options() IndexOptions {
 		SetEnabled(ic.Enabled).
 		SetBlockSize(ic.BlockSize)
 }
+
+// ColdTierConfiguration controls the offloading of cold blocks to object
+// storage.
+type ColdTierConfiguration struct {
+	Enabled      bool          `yaml:"enabled"`
+	OffloadAfter time.Duration `yaml:"offloadAfter"`
+}
+
+// Options returns the ColdTierOptions corresponding to the receiver struct.
+func (cc *ColdTierConfiguration) Options() ColdTierOptions {
+	opts := NewColdTierOptions().SetEnabled(cc.Enabled)
+	if cc.OffloadAfter > 0 {
+		opts = opts.SetOffloadAfter(cc.OffloadAfter)
+	}
+	return opts
+}
@@ -80,6 +80,21 @@ func ToIndexOptions(
 	return iopts, nil
 }
 
+// ToColdTierOptions converts nsproto.ColdTierOptions to ColdTierOptions
+func ToColdTierOptions(
+	co *nsproto.ColdTierOptions,
+) (ColdTierOptions, error) {
+	copts := NewColdTierOptions().SetEnabled(false)
+	if co == nil {
+		return copts, nil
+	}
+
+	copts = copts.SetEnabled(co.Enabled).
+		SetOffloadAfter(FromNanos(co.OffloadAfterNanos))
+
+	return copts, nil
+}
+
 // ToMetadata converts nsproto.Options to Metadata
 func ToMetadata(
 	id string,
@@ -99,6 +114,11 @@ func ToMetadata(
 		return nil, err
 	}
 
+	copts, err := ToColdTierOptions(opts.ColdTierOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	sr, err := LoadSchemaHistory(opts.GetSchemaOptions())
 	if err != nil {
 		return nil, err
@@ -114,6 +134,7 @@ func ToMetadata(
 		SetSchemaHistory(sr).
 		SetRetentionOptions(ropts).
 		SetIndexOptions(iopts).
+		SetColdTierOptions(copts).
 		SetColdWritesEnabled(opts.ColdWritesEnabled)
 
 	return NewMetadata(ident.StringID(id), mopts)
@@ -149,6 +170,7 @@ func FromProto(protoRegistry nsproto.Registry) (Map, error) {
 func OptionsToProto(opts Options) *nsproto.NamespaceOptions {
 	ropts := opts.RetentionOptions()
 	iopts := opts.IndexOptions()
+	copts := opts.ColdTierOptions()
 
 	return &nsproto.NamespaceOptions{
 		BootstrapEnabled:  opts.BootstrapEnabled(),
@@ -172,5 +194,9 @@ func OptionsToProto(opts Options) *nsproto.NamespaceOptions {
 			BlockSizeNanos: iopts.BlockSize().Nanoseconds(),
 		},
 		ColdWritesEnabled: opts.ColdWritesEnabled(),
+		ColdTierOptions: &nsproto.ColdTierOptions{
+			Enabled:           copts.Enabled(),
+			OffloadAfterNanos: copts.OffloadAfter().Nanoseconds(),
+		},
 	}
 }
@@ -271,6 +271,43 @@ func TestFromProtoSnapshotEnabled(t *testing.T) {
 	require.Equal(t, !namespace.NewOptions().SnapshotEnabled(), md.Options().SnapshotEnabled())
 }
 
+func TestColdTierOptionsProtoRoundTrip(t *testing.T) {
+	validRegistry := nsproto.Registry{
+		Namespaces: map[string]*nsproto.NamespaceOptions{
+			"testns1": &nsproto.NamespaceOptions{
+				RetentionOptions: &validRetentionOpts,
+				ColdTierOptions: &nsproto.ColdTierOptions{
+					Enabled:           true,
+					OffloadAfterNanos: toNanos(600), // 10h
+				},
+			},
+		},
+	}
+	nsMap, err := namespace.FromProto(validRegistry)
+	require.NoError(t, err)
+
+	md, err := nsMap.Get(ident.StringID("testns1"))
+	require.NoError(t, err)
+	copts := md.Options().ColdTierOptions()
+	require.True(t, copts.Enabled())
+	require.Equal(t, 10*time.Hour, copts.OffloadAfter())
+
+	reg := namespace.ToProto(nsMap)
+	require.Equal(t, validRegistry.Namespaces["testns1"].ColdTierOptions,
+		reg.Namespaces["testns1"].ColdTierOptions)
+}
+
+func TestColdTierOptionsOffloadAfterBeyondRetention(t *testing.T) {
+	_, err := namespace.ToMetadata("abc", &nsproto.NamespaceOptions{
+		RetentionOptions: &validRetentionOpts,
+		ColdTierOptions: &nsproto.ColdTierOptions{
+			Enabled:           true,
+			OffloadAfterNanos: toNanos(1200), // 20h
+		},
+	})
+	require.Error(t, err)
+}
+
 func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOptions, observed namespace.Metadata) {
 	require.Equal(t, name, observed.ID().String())
 	opts := observed.Options()
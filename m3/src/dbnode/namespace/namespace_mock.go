@@ -340,6 +340,34 @@ func (mr *MockOptionsMockRecorder) IndexOptions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexOptions", reflect.TypeOf((*MockOptions)(nil).IndexOptions))
 }
 
+// SetColdTierOptions mocks base method
+func (m *MockOptions) SetColdTierOptions(value ColdTierOptions) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetColdTierOptions", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetColdTierOptions indicates an expected call of SetColdTierOptions
+func (mr *MockOptionsMockRecorder) SetColdTierOptions(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetColdTierOptions", reflect.TypeOf((*MockOptions)(nil).SetColdTierOptions), value)
+}
+
+// ColdTierOptions mocks base method
+func (m *MockOptions) ColdTierOptions() ColdTierOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ColdTierOptions")
+	ret0, _ := ret[0].(ColdTierOptions)
+	return ret0
+}
+
+// ColdTierOptions indicates an expected call of ColdTierOptions
+func (mr *MockOptionsMockRecorder) ColdTierOptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdTierOptions", reflect.TypeOf((*MockOptions)(nil).ColdTierOptions))
+}
+
 // SetSchemaHistory mocks base method
 func (m *MockOptions) SetSchemaHistory(value SchemaHistory) Options {
 	m.ctrl.T.Helper()
@@ -461,6 +489,99 @@ func (mr *MockIndexOptionsMockRecorder) BlockSize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSize", reflect.TypeOf((*MockIndexOptions)(nil).BlockSize))
 }
 
+// MockColdTierOptions is a mock of ColdTierOptions interface
+type MockColdTierOptions struct {
+	ctrl     *gomock.Controller
+	recorder *MockColdTierOptionsMockRecorder
+}
+
+// MockColdTierOptionsMockRecorder is the mock recorder for MockColdTierOptions
+type MockColdTierOptionsMockRecorder struct {
+	mock *MockColdTierOptions
+}
+
+// NewMockColdTierOptions creates a new mock instance
+func NewMockColdTierOptions(ctrl *gomock.Controller) *MockColdTierOptions {
+	mock := &MockColdTierOptions{ctrl: ctrl}
+	mock.recorder = &MockColdTierOptionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockColdTierOptions) EXPECT() *MockColdTierOptionsMockRecorder {
+	return m.recorder
+}
+
+// Equal mocks base method
+func (m *MockColdTierOptions) Equal(value ColdTierOptions) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", value)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Equal indicates an expected call of Equal
+func (mr *MockColdTierOptionsMockRecorder) Equal(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockColdTierOptions)(nil).Equal), value)
+}
+
+// SetEnabled mocks base method
+func (m *MockColdTierOptions) SetEnabled(value bool) ColdTierOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnabled", value)
+	ret0, _ := ret[0].(ColdTierOptions)
+	return ret0
+}
+
+// SetEnabled indicates an expected call of SetEnabled
+func (mr *MockColdTierOptionsMockRecorder) SetEnabled(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnabled", reflect.TypeOf((*MockColdTierOptions)(nil).SetEnabled), value)
+}
+
+// Enabled mocks base method
+func (m *MockColdTierOptions) Enabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Enabled indicates an expected call of Enabled
+func (mr *MockColdTierOptionsMockRecorder) Enabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enabled", reflect.TypeOf((*MockColdTierOptions)(nil).Enabled))
+}
+
+// SetOffloadAfter mocks base method
+func (m *MockColdTierOptions) SetOffloadAfter(value time.Duration) ColdTierOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOffloadAfter", value)
+	ret0, _ := ret[0].(ColdTierOptions)
+	return ret0
+}
+
+// SetOffloadAfter indicates an expected call of SetOffloadAfter
+func (mr *MockColdTierOptionsMockRecorder) SetOffloadAfter(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOffloadAfter", reflect.TypeOf((*MockColdTierOptions)(nil).SetOffloadAfter), value)
+}
+
+// OffloadAfter mocks base method
+func (m *MockColdTierOptions) OffloadAfter() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OffloadAfter")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// OffloadAfter indicates an expected call of OffloadAfter
+func (mr *MockColdTierOptionsMockRecorder) OffloadAfter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffloadAfter", reflect.TypeOf((*MockColdTierOptions)(nil).OffloadAfter))
+}
+
 // MockSchemaDescr is a mock of SchemaDescr interface
 type MockSchemaDescr struct {
 	ctrl     *gomock.Controller
@@ -53,6 +53,8 @@ var (
 	errIndexBlockSizePositive                       = errors.New("index block size must positive")
 	errIndexBlockSizeTooLarge                       = errors.New("index block size needs to be <= namespace retention period")
 	errIndexBlockSizeMustBeAMultipleOfDataBlockSize = errors.New("index block size must be a multiple of data block size")
+	errColdTierOffloadAfterPositive                 = errors.New("cold tier offload after must be positive")
+	errColdTierOffloadAfterTooLarge                 = errors.New("cold tier offload after needs to be < namespace retention period")
 )
 
 type options struct {
@@ -65,6 +67,7 @@ type options struct {
 	coldWritesEnabled bool
 	retentionOpts     retention.Options
 	indexOpts         IndexOptions
+	coldTierOpts      ColdTierOptions
 	schemaHis         SchemaHistory
 }
 
@@ -85,6 +88,7 @@ func NewOptions() Options {
 		coldWritesEnabled: defaultColdWritesEnabled,
 		retentionOpts:     retention.NewOptions(),
 		indexOpts:         NewIndexOptions(),
+		coldTierOpts:      NewColdTierOptions(),
 		schemaHis:         NewSchemaHistory(),
 	}
 }
@@ -93,6 +97,9 @@ func (o *options) Validate() error {
 	if err := o.retentionOpts.Validate(); err != nil {
 		return err
 	}
+	if err := o.validateColdTier(); err != nil {
+		return err
+	}
 	if !o.indexOpts.Enabled() {
 		return nil
 	}
@@ -114,6 +121,20 @@ func (o *options) Validate() error {
 	return nil
 }
 
+func (o *options) validateColdTier() error {
+	if !o.coldTierOpts.Enabled() {
+		return nil
+	}
+	offloadAfter := o.coldTierOpts.OffloadAfter()
+	if offloadAfter <= 0 {
+		return errColdTierOffloadAfterPositive
+	}
+	if offloadAfter >= o.retentionOpts.RetentionPeriod() {
+		return errColdTierOffloadAfterTooLarge
+	}
+	return nil
+}
+
 func (o *options) Equal(value Options) bool {
 	return o.bootstrapEnabled == value.BootstrapEnabled() &&
 		o.flushEnabled == value.FlushEnabled() &&
@@ -124,6 +145,7 @@ func (o *options) Equal(value Options) bool {
 		o.coldWritesEnabled == value.ColdWritesEnabled() &&
 		o.retentionOpts.Equal(value.RetentionOptions()) &&
 		o.indexOpts.Equal(value.IndexOptions()) &&
+		o.coldTierOpts.Equal(value.ColdTierOptions()) &&
 		o.schemaHis.Equal(value.SchemaHistory())
 }
 
@@ -217,6 +239,16 @@ func (o *options) IndexOptions() IndexOptions {
 	return o.indexOpts
 }
 
+func (o *options) SetColdTierOptions(value ColdTierOptions) Options {
+	opts := *o
+	opts.coldTierOpts = value
+	return &opts
+}
+
+func (o *options) ColdTierOptions() ColdTierOptions {
+	return o.coldTierOpts
+}
+
 func (o *options) SetSchemaHistory(value SchemaHistory) Options {
 	opts := *o
 	opts.schemaHis = value
@@ -92,6 +92,12 @@ type Options interface {
 	// IndexOptions returns the IndexOptions.
 	IndexOptions() IndexOptions
 
+	// SetColdTierOptions sets the ColdTierOptions.
+	SetColdTierOptions(value ColdTierOptions) Options
+
+	// ColdTierOptions returns the ColdTierOptions.
+	ColdTierOptions() ColdTierOptions
+
 	// SetSchemaHistory sets the schema registry for this namespace.
 	SetSchemaHistory(value SchemaHistory) Options
 
@@ -117,6 +123,25 @@ type IndexOptions interface {
 	BlockSize() time.Duration
 }
 
+// ColdTierOptions controls the offloading of cold fileset blocks of a
+// namespace to object storage.
+type ColdTierOptions interface {
+	// Equal returns true if the provide value is equal to this one.
+	Equal(value ColdTierOptions) bool
+
+	// SetEnabled sets whether the cold tier is enabled.
+	SetEnabled(value bool) ColdTierOptions
+
+	// Enabled returns whether the cold tier is enabled.
+	Enabled() bool
+
+	// SetOffloadAfter sets the age after which blocks are offloaded.
+	SetOffloadAfter(value time.Duration) ColdTierOptions
+
+	// OffloadAfter returns the age after which blocks are offloaded.
+	OffloadAfter() time.Duration
+}
+
 // SchemaDescr describes the schema for a complex type value.
 type SchemaDescr interface {
 	// DeployId returns the deploy id of the schema.
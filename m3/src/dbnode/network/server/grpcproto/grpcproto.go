@@ -0,0 +1,156 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package grpcproto serves the node service over gRPC with the protobuf
+// service of the nodepb package, whose messages mirror the Thrift structs of
+// the node service served over TChannel.
+package grpcproto
+
+import (
+	"io"
+	"sync"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+)
+
+const (
+	// DefaultMaxMessageSize is the default max size of messages sent and
+	// received, which is larger than the gRPC default since fetch and write
+	// batches can hold large amounts of data while still bounding the memory
+	// a single message can use.
+	DefaultMaxMessageSize = 64 << 20
+
+	// SnappyCompressorName is the name of the gRPC compressor compressing
+	// messages with snappy.
+	SnappyCompressorName = "snappy"
+
+	// ZstdCompressorName is the name of the gRPC compressor compressing
+	// messages with zstd.
+	ZstdCompressorName = "zstd"
+)
+
+func init() {
+	encoding.RegisterCompressor(snappyCompressor{})
+	encoding.RegisterCompressor(&zstdCompressor{})
+}
+
+// CompressorName returns the name of the gRPC compressor to compress
+// messages with for a compression type, false is returned if messages are
+// not compressed.
+func CompressorName(compression rpc.CompressionType) (string, bool) {
+	switch compression {
+	case rpc.CompressionType_SNAPPY:
+		return SnappyCompressorName, true
+	case rpc.CompressionType_ZSTD:
+		return ZstdCompressorName, true
+	default:
+		return "", false
+	}
+}
+
+type snappyCompressor struct{}
+
+func (snappyCompressor) Name() string {
+	return SnappyCompressorName
+}
+
+func (snappyCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return snappy.NewBufferedWriter(w), nil
+}
+
+func (snappyCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return snappy.NewReader(r), nil
+}
+
+// zstdCompressor pools its encoders and decoders since they are expensive to
+// create, a decoder is returned to the pool once its message is fully read.
+type zstdCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+func (c *zstdCompressor) Name() string {
+	return ZstdCompressorName
+}
+
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	enc, ok := c.encoders.Get().(*zstd.Encoder)
+	if !ok {
+		var err error
+		enc, err = zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		enc.Reset(w)
+	}
+	return &zstdWriter{enc: enc, pool: &c.encoders}, nil
+}
+
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	dec, ok := c.decoders.Get().(*zstd.Decoder)
+	if !ok {
+		var err error
+		dec, err = zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	} else if err := dec.Reset(r); err != nil {
+		c.decoders.Put(dec)
+		return nil, err
+	}
+	return &zstdReader{dec: dec, pool: &c.decoders}, nil
+}
+
+type zstdWriter struct {
+	enc  *zstd.Encoder
+	pool *sync.Pool
+}
+
+func (w *zstdWriter) Write(p []byte) (int, error) {
+	return w.enc.Write(p)
+}
+
+func (w *zstdWriter) Close() error {
+	err := w.enc.Close()
+	w.pool.Put(w.enc)
+	return err
+}
+
+type zstdReader struct {
+	dec  *zstd.Decoder
+	pool *sync.Pool
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.dec == nil {
+		return 0, io.EOF
+	}
+	n, err := r.dec.Read(p)
+	if err == io.EOF {
+		r.pool.Put(r.dec)
+		r.dec = nil
+	}
+	return n, err
+}
@@ -0,0 +1,369 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+
+	tchannelthrift "github.com/uber/tchannel-go/thrift"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var errCompressedRequest = errors.New(
+	"compressed requests are not supported over gRPC, use a gRPC compressor")
+
+type client struct {
+	client nodepb.NodeClient
+}
+
+// NewClient returns a node client that calls the node service over a gRPC
+// connection, methods not served over gRPC return an error.
+func NewClient(conn *grpc.ClientConn) rpc.TChanNode {
+	return &client{client: nodepb.NewNodeClient(conn)}
+}
+
+func errUnsupported(name string) error {
+	return fmt.Errorf("node method %s is not supported over gRPC", name)
+}
+
+func (c *client) Aggregate(
+	ctx tchannelthrift.Context,
+	req *rpc.AggregateQueryRequest,
+) (*rpc.AggregateQueryResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.Aggregate(ctx, toProtoAggregateQueryRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoAggregateQueryResult(result), nil
+}
+
+func (c *client) AggregateRaw(
+	ctx tchannelthrift.Context,
+	req *rpc.AggregateQueryRawRequest,
+) (*rpc.AggregateQueryRawResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.AggregateRaw(ctx, toProtoAggregateQueryRawRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoAggregateQueryRawResult(result), nil
+}
+
+func (c *client) Bootstrapped(ctx tchannelthrift.Context) (*rpc.NodeBootstrappedResult_, error) {
+	var trailer metadata.MD
+	_, err := c.client.Bootstrapped(ctx, &nodepb.NodeBootstrappedRequest{},
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return &rpc.NodeBootstrappedResult_{}, nil
+}
+
+func (c *client) BootstrappedInPlacementOrNoPlacement(
+	ctx tchannelthrift.Context,
+) (*rpc.NodeBootstrappedInPlacementOrNoPlacementResult_, error) {
+	return nil, errUnsupported("BootstrappedInPlacementOrNoPlacement")
+}
+
+func (c *client) DebugIndexMemorySegments(
+	ctx tchannelthrift.Context,
+	req *rpc.DebugIndexMemorySegmentsRequest,
+) (*rpc.DebugIndexMemorySegmentsResult_, error) {
+	return nil, errUnsupported("DebugIndexMemorySegments")
+}
+
+func (c *client) DebugProfileStart(
+	ctx tchannelthrift.Context,
+	req *rpc.DebugProfileStartRequest,
+) (*rpc.DebugProfileStartResult_, error) {
+	return nil, errUnsupported("DebugProfileStart")
+}
+
+func (c *client) DebugProfileStop(
+	ctx tchannelthrift.Context,
+	req *rpc.DebugProfileStopRequest,
+) (*rpc.DebugProfileStopResult_, error) {
+	return nil, errUnsupported("DebugProfileStop")
+}
+
+func (c *client) DeleteSeries(
+	ctx tchannelthrift.Context,
+	req *rpc.DeleteSeriesRequest,
+) (*rpc.DeleteSeriesResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.DeleteSeries(ctx, toProtoDeleteSeriesRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoDeleteSeriesResult(result), nil
+}
+
+func (c *client) Fetch(
+	ctx tchannelthrift.Context,
+	req *rpc.FetchRequest,
+) (*rpc.FetchResult_, error) {
+	return nil, errUnsupported("Fetch")
+}
+
+func (c *client) FetchBatchRaw(
+	ctx tchannelthrift.Context,
+	req *rpc.FetchBatchRawRequest,
+) (*rpc.FetchBatchRawResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.FetchBatchRaw(ctx, toProtoFetchBatchRawRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoFetchBatchRawResult(result), nil
+}
+
+func (c *client) FetchBatchRawV2(
+	ctx tchannelthrift.Context,
+	req *rpc.FetchBatchRawV2Request,
+) (*rpc.FetchBatchRawResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.FetchBatchRawV2(ctx, toProtoFetchBatchRawV2Request(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoFetchBatchRawResult(result), nil
+}
+
+func (c *client) FetchBlocksMetadataRawV2(
+	ctx tchannelthrift.Context,
+	req *rpc.FetchBlocksMetadataRawV2Request,
+) (*rpc.FetchBlocksMetadataRawV2Result_, error) {
+	var trailer metadata.MD
+	result, err := c.client.FetchBlocksMetadataRawV2(ctx,
+		toProtoFetchBlocksMetadataRawV2Request(req), grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoFetchBlocksMetadataRawV2Result(result), nil
+}
+
+func (c *client) FetchBlocksRaw(
+	ctx tchannelthrift.Context,
+	req *rpc.FetchBlocksRawRequest,
+) (*rpc.FetchBlocksRawResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.FetchBlocksRaw(ctx, toProtoFetchBlocksRawRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoFetchBlocksRawResult(result), nil
+}
+
+func (c *client) FetchTagged(
+	ctx tchannelthrift.Context,
+	req *rpc.FetchTaggedRequest,
+) (*rpc.FetchTaggedResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.FetchTagged(ctx, toProtoFetchTaggedRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoFetchTaggedResult(result), nil
+}
+
+func (c *client) GetPersistRateLimit(
+	ctx tchannelthrift.Context,
+) (*rpc.NodePersistRateLimitResult_, error) {
+	return nil, errUnsupported("GetPersistRateLimit")
+}
+
+func (c *client) GetWriteNewSeriesAsync(
+	ctx tchannelthrift.Context,
+) (*rpc.NodeWriteNewSeriesAsyncResult_, error) {
+	return nil, errUnsupported("GetWriteNewSeriesAsync")
+}
+
+func (c *client) GetWriteNewSeriesBackoffDuration(
+	ctx tchannelthrift.Context,
+) (*rpc.NodeWriteNewSeriesBackoffDurationResult_, error) {
+	return nil, errUnsupported("GetWriteNewSeriesBackoffDuration")
+}
+
+func (c *client) GetWriteNewSeriesLimitPerShardPerSecond(
+	ctx tchannelthrift.Context,
+) (*rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_, error) {
+	return nil, errUnsupported("GetWriteNewSeriesLimitPerShardPerSecond")
+}
+
+func (c *client) Health(ctx tchannelthrift.Context) (*rpc.NodeHealthResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.Health(ctx, &nodepb.NodeHealthRequest{},
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoNodeHealthResult(result), nil
+}
+
+func (c *client) IndexCardinality(
+	ctx tchannelthrift.Context,
+	req *rpc.IndexCardinalityRequest,
+) (*rpc.IndexCardinalityResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.IndexCardinality(ctx, toProtoIndexCardinalityRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return fromProtoIndexCardinalityResult(result), nil
+}
+
+func (c *client) Query(
+	ctx tchannelthrift.Context,
+	req *rpc.QueryRequest,
+) (*rpc.QueryResult_, error) {
+	return nil, errUnsupported("Query")
+}
+
+func (c *client) Repair(ctx tchannelthrift.Context) error {
+	return errUnsupported("Repair")
+}
+
+func (c *client) SetPersistRateLimit(
+	ctx tchannelthrift.Context,
+	req *rpc.NodeSetPersistRateLimitRequest,
+) (*rpc.NodePersistRateLimitResult_, error) {
+	return nil, errUnsupported("SetPersistRateLimit")
+}
+
+func (c *client) SetWriteNewSeriesAsync(
+	ctx tchannelthrift.Context,
+	req *rpc.NodeSetWriteNewSeriesAsyncRequest,
+) (*rpc.NodeWriteNewSeriesAsyncResult_, error) {
+	return nil, errUnsupported("SetWriteNewSeriesAsync")
+}
+
+func (c *client) SetWriteNewSeriesBackoffDuration(
+	ctx tchannelthrift.Context,
+	req *rpc.NodeSetWriteNewSeriesBackoffDurationRequest,
+) (*rpc.NodeWriteNewSeriesBackoffDurationResult_, error) {
+	return nil, errUnsupported("SetWriteNewSeriesBackoffDuration")
+}
+
+func (c *client) SetWriteNewSeriesLimitPerShardPerSecond(
+	ctx tchannelthrift.Context,
+	req *rpc.NodeSetWriteNewSeriesLimitPerShardPerSecondRequest,
+) (*rpc.NodeWriteNewSeriesLimitPerShardPerSecondResult_, error) {
+	return nil, errUnsupported("SetWriteNewSeriesLimitPerShardPerSecond")
+}
+
+func (c *client) Truncate(
+	ctx tchannelthrift.Context,
+	req *rpc.TruncateRequest,
+) (*rpc.TruncateResult_, error) {
+	var trailer metadata.MD
+	result, err := c.client.Truncate(ctx,
+		&nodepb.TruncateRequest{NameSpace: req.NameSpace}, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, errorFromStatus(err, trailer)
+	}
+	return &rpc.TruncateResult_{NumSeries: result.NumSeries}, nil
+}
+
+func (c *client) Write(ctx tchannelthrift.Context, req *rpc.WriteRequest) error {
+	var trailer metadata.MD
+	_, err := c.client.Write(ctx, toProtoWriteRequest(req), grpc.Trailer(&trailer))
+	if err != nil {
+		return errorFromStatus(err, trailer)
+	}
+	return nil
+}
+
+func (c *client) WriteBatchRaw(ctx tchannelthrift.Context, req *rpc.WriteBatchRawRequest) error {
+	var trailer metadata.MD
+	_, err := c.client.WriteBatchRaw(ctx, toProtoWriteBatchRawRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return errorFromStatus(err, trailer)
+	}
+	return nil
+}
+
+func (c *client) WriteBatchRawV2(
+	ctx tchannelthrift.Context,
+	req *rpc.WriteBatchRawV2Request,
+) error {
+	var trailer metadata.MD
+	_, err := c.client.WriteBatchRawV2(ctx, toProtoWriteBatchRawV2Request(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return errorFromStatus(err, trailer)
+	}
+	return nil
+}
+
+func (c *client) WriteTagged(ctx tchannelthrift.Context, req *rpc.WriteTaggedRequest) error {
+	var trailer metadata.MD
+	_, err := c.client.WriteTagged(ctx, toProtoWriteTaggedRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return errorFromStatus(err, trailer)
+	}
+	return nil
+}
+
+func (c *client) WriteTaggedBatchRaw(
+	ctx tchannelthrift.Context,
+	req *rpc.WriteTaggedBatchRawRequest,
+) error {
+	var trailer metadata.MD
+	_, err := c.client.WriteTaggedBatchRaw(ctx, toProtoWriteTaggedBatchRawRequest(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return errorFromStatus(err, trailer)
+	}
+	return nil
+}
+
+func (c *client) WriteTaggedBatchRawV2(
+	ctx tchannelthrift.Context,
+	req *rpc.WriteTaggedBatchRawV2Request,
+) error {
+	if req.Compression != rpc.CompressionType_NONE {
+		// NB: payloads are compressed by gRPC rather than in the messages.
+		return errCompressedRequest
+	}
+	var trailer metadata.MD
+	_, err := c.client.WriteTaggedBatchRawV2(ctx, toProtoWriteTaggedBatchRawV2Request(req),
+		grpc.Trailer(&trailer))
+	if err != nil {
+		return errorFromStatus(err, trailer)
+	}
+	return nil
+}
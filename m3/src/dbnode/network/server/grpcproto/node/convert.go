@@ -0,0 +1,1103 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// The conversions below share the byte slices of the messages they convert
+// rather than copying them.
+
+func toProtoInt64Value(v *int64) *types.Int64Value {
+	if v == nil {
+		return nil
+	}
+	return &types.Int64Value{Value: *v}
+}
+
+func fromProtoInt64Value(v *types.Int64Value) *int64 {
+	if v == nil {
+		return nil
+	}
+	value := v.Value
+	return &value
+}
+
+// optionalInt64 returns nil for the zero value since proto3 scalars do not
+// distinguish unset fields from zero values.
+func optionalInt64(v int64) *int64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+func toProtoError(err *rpc.Error) *nodepb.Error {
+	if err == nil {
+		return nil
+	}
+	return &nodepb.Error{
+		Type:    nodepb.ErrorType(err.Type),
+		Message: err.Message,
+	}
+}
+
+func fromProtoError(err *nodepb.Error) *rpc.Error {
+	if err == nil {
+		return nil
+	}
+	return &rpc.Error{
+		Type:    rpc.ErrorType(err.Type),
+		Message: err.Message,
+	}
+}
+
+func toProtoWriteBatchRawErrors(errs *rpc.WriteBatchRawErrors) *nodepb.WriteBatchRawErrors {
+	result := &nodepb.WriteBatchRawErrors{
+		Errors: make([]*nodepb.WriteBatchRawError, 0, len(errs.Errors)),
+	}
+	for _, err := range errs.Errors {
+		result.Errors = append(result.Errors, &nodepb.WriteBatchRawError{
+			Index: err.Index,
+			Err:   toProtoError(err.Err),
+		})
+	}
+	return result
+}
+
+func fromProtoWriteBatchRawErrors(errs *nodepb.WriteBatchRawErrors) *rpc.WriteBatchRawErrors {
+	result := &rpc.WriteBatchRawErrors{
+		Errors: make([]*rpc.WriteBatchRawError, 0, len(errs.Errors)),
+	}
+	for _, err := range errs.Errors {
+		result.Errors = append(result.Errors, &rpc.WriteBatchRawError{
+			Index: err.Index,
+			Err:   fromProtoError(err.Err),
+		})
+	}
+	return result
+}
+
+func toProtoDatapoint(dp *rpc.Datapoint) *nodepb.Datapoint {
+	if dp == nil {
+		return nil
+	}
+	return &nodepb.Datapoint{
+		Timestamp:         dp.Timestamp,
+		Value:             dp.Value,
+		Annotation:        dp.Annotation,
+		TimestampTimeType: nodepb.TimeType(dp.TimestampTimeType),
+	}
+}
+
+func fromProtoDatapoint(dp *nodepb.Datapoint) *rpc.Datapoint {
+	if dp == nil {
+		return nil
+	}
+	return &rpc.Datapoint{
+		Timestamp:         dp.Timestamp,
+		Value:             dp.Value,
+		Annotation:        dp.Annotation,
+		TimestampTimeType: rpc.TimeType(dp.TimestampTimeType),
+	}
+}
+
+func toProtoWriteRequest(req *rpc.WriteRequest) *nodepb.WriteRequest {
+	return &nodepb.WriteRequest{
+		NameSpace: req.NameSpace,
+		Id:        req.ID,
+		Datapoint: toProtoDatapoint(req.Datapoint),
+	}
+}
+
+func fromProtoWriteRequest(req *nodepb.WriteRequest) *rpc.WriteRequest {
+	return &rpc.WriteRequest{
+		NameSpace: req.NameSpace,
+		ID:        req.Id,
+		Datapoint: fromProtoDatapoint(req.Datapoint),
+	}
+}
+
+func toProtoWriteTaggedRequest(req *rpc.WriteTaggedRequest) *nodepb.WriteTaggedRequest {
+	tags := make([]*nodepb.Tag, 0, len(req.Tags))
+	for _, tag := range req.Tags {
+		tags = append(tags, &nodepb.Tag{Name: tag.Name, Value: tag.Value})
+	}
+	return &nodepb.WriteTaggedRequest{
+		NameSpace: req.NameSpace,
+		Id:        req.ID,
+		Tags:      tags,
+		Datapoint: toProtoDatapoint(req.Datapoint),
+	}
+}
+
+func fromProtoWriteTaggedRequest(req *nodepb.WriteTaggedRequest) *rpc.WriteTaggedRequest {
+	tags := make([]*rpc.Tag, 0, len(req.Tags))
+	for _, tag := range req.Tags {
+		tags = append(tags, &rpc.Tag{Name: tag.Name, Value: tag.Value})
+	}
+	return &rpc.WriteTaggedRequest{
+		NameSpace: req.NameSpace,
+		ID:        req.Id,
+		Tags:      tags,
+		Datapoint: fromProtoDatapoint(req.Datapoint),
+	}
+}
+
+func toProtoWriteBatchRawRequest(req *rpc.WriteBatchRawRequest) *nodepb.WriteBatchRawRequest {
+	elems := make([]*nodepb.WriteBatchRawRequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &nodepb.WriteBatchRawRequestElement{
+			Id:        elem.ID,
+			Datapoint: toProtoDatapoint(elem.Datapoint),
+		})
+	}
+	return &nodepb.WriteBatchRawRequest{
+		NameSpace: req.NameSpace,
+		Elements:  elems,
+	}
+}
+
+func fromProtoWriteBatchRawRequest(req *nodepb.WriteBatchRawRequest) *rpc.WriteBatchRawRequest {
+	elems := make([]*rpc.WriteBatchRawRequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &rpc.WriteBatchRawRequestElement{
+			ID:        elem.Id,
+			Datapoint: fromProtoDatapoint(elem.Datapoint),
+		})
+	}
+	return &rpc.WriteBatchRawRequest{
+		NameSpace: req.NameSpace,
+		Elements:  elems,
+	}
+}
+
+func toProtoWriteBatchRawV2Request(req *rpc.WriteBatchRawV2Request) *nodepb.WriteBatchRawV2Request {
+	elems := make([]*nodepb.WriteBatchRawV2RequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &nodepb.WriteBatchRawV2RequestElement{
+			Id:        elem.ID,
+			Datapoint: toProtoDatapoint(elem.Datapoint),
+			NameSpace: elem.NameSpace,
+		})
+	}
+	return &nodepb.WriteBatchRawV2Request{
+		NameSpaces: req.NameSpaces,
+		Elements:   elems,
+	}
+}
+
+func fromProtoWriteBatchRawV2Request(req *nodepb.WriteBatchRawV2Request) *rpc.WriteBatchRawV2Request {
+	elems := make([]*rpc.WriteBatchRawV2RequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &rpc.WriteBatchRawV2RequestElement{
+			ID:        elem.Id,
+			Datapoint: fromProtoDatapoint(elem.Datapoint),
+			NameSpace: elem.NameSpace,
+		})
+	}
+	return &rpc.WriteBatchRawV2Request{
+		NameSpaces: req.NameSpaces,
+		Elements:   elems,
+	}
+}
+
+func toProtoWriteTaggedBatchRawRequest(
+	req *rpc.WriteTaggedBatchRawRequest,
+) *nodepb.WriteTaggedBatchRawRequest {
+	elems := make([]*nodepb.WriteTaggedBatchRawRequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &nodepb.WriteTaggedBatchRawRequestElement{
+			Id:          elem.ID,
+			EncodedTags: elem.EncodedTags,
+			Datapoint:   toProtoDatapoint(elem.Datapoint),
+		})
+	}
+	return &nodepb.WriteTaggedBatchRawRequest{
+		NameSpace: req.NameSpace,
+		Elements:  elems,
+	}
+}
+
+func fromProtoWriteTaggedBatchRawRequest(
+	req *nodepb.WriteTaggedBatchRawRequest,
+) *rpc.WriteTaggedBatchRawRequest {
+	elems := make([]*rpc.WriteTaggedBatchRawRequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &rpc.WriteTaggedBatchRawRequestElement{
+			ID:          elem.Id,
+			EncodedTags: elem.EncodedTags,
+			Datapoint:   fromProtoDatapoint(elem.Datapoint),
+		})
+	}
+	return &rpc.WriteTaggedBatchRawRequest{
+		NameSpace: req.NameSpace,
+		Elements:  elems,
+	}
+}
+
+func toProtoWriteTaggedBatchRawV2Request(
+	req *rpc.WriteTaggedBatchRawV2Request,
+) *nodepb.WriteTaggedBatchRawV2Request {
+	elems := make([]*nodepb.WriteTaggedBatchRawV2RequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &nodepb.WriteTaggedBatchRawV2RequestElement{
+			Id:          elem.ID,
+			EncodedTags: elem.EncodedTags,
+			Datapoint:   toProtoDatapoint(elem.Datapoint),
+			NameSpace:   elem.NameSpace,
+		})
+	}
+	return &nodepb.WriteTaggedBatchRawV2Request{
+		NameSpaces: req.NameSpaces,
+		Elements:   elems,
+	}
+}
+
+func fromProtoWriteTaggedBatchRawV2Request(
+	req *nodepb.WriteTaggedBatchRawV2Request,
+) *rpc.WriteTaggedBatchRawV2Request {
+	elems := make([]*rpc.WriteTaggedBatchRawV2RequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &rpc.WriteTaggedBatchRawV2RequestElement{
+			ID:          elem.Id,
+			EncodedTags: elem.EncodedTags,
+			Datapoint:   fromProtoDatapoint(elem.Datapoint),
+			NameSpace:   elem.NameSpace,
+		})
+	}
+	return &rpc.WriteTaggedBatchRawV2Request{
+		NameSpaces: req.NameSpaces,
+		Elements:   elems,
+	}
+}
+
+func toProtoFetchBatchRawRequest(req *rpc.FetchBatchRawRequest) *nodepb.FetchBatchRawRequest {
+	return &nodepb.FetchBatchRawRequest{
+		RangeStart:    req.RangeStart,
+		RangeEnd:      req.RangeEnd,
+		NameSpace:     req.NameSpace,
+		Ids:           req.Ids,
+		RangeTimeType: nodepb.TimeType(req.RangeTimeType),
+	}
+}
+
+func fromProtoFetchBatchRawRequest(req *nodepb.FetchBatchRawRequest) *rpc.FetchBatchRawRequest {
+	return &rpc.FetchBatchRawRequest{
+		RangeStart:    req.RangeStart,
+		RangeEnd:      req.RangeEnd,
+		NameSpace:     req.NameSpace,
+		Ids:           req.Ids,
+		RangeTimeType: rpc.TimeType(req.RangeTimeType),
+	}
+}
+
+func toProtoFetchBatchRawV2Request(req *rpc.FetchBatchRawV2Request) *nodepb.FetchBatchRawV2Request {
+	elems := make([]*nodepb.FetchBatchRawV2RequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &nodepb.FetchBatchRawV2RequestElement{
+			NameSpace:     elem.NameSpace,
+			RangeStart:    elem.RangeStart,
+			RangeEnd:      elem.RangeEnd,
+			Id:            elem.ID,
+			RangeTimeType: nodepb.TimeType(elem.RangeTimeType),
+		})
+	}
+	return &nodepb.FetchBatchRawV2Request{
+		NameSpaces: req.NameSpaces,
+		Elements:   elems,
+	}
+}
+
+func fromProtoFetchBatchRawV2Request(req *nodepb.FetchBatchRawV2Request) *rpc.FetchBatchRawV2Request {
+	elems := make([]*rpc.FetchBatchRawV2RequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &rpc.FetchBatchRawV2RequestElement{
+			NameSpace:     elem.NameSpace,
+			RangeStart:    elem.RangeStart,
+			RangeEnd:      elem.RangeEnd,
+			ID:            elem.Id,
+			RangeTimeType: rpc.TimeType(elem.RangeTimeType),
+		})
+	}
+	return &rpc.FetchBatchRawV2Request{
+		NameSpaces: req.NameSpaces,
+		Elements:   elems,
+	}
+}
+
+func toProtoSegment(seg *rpc.Segment) *nodepb.Segment {
+	if seg == nil {
+		return nil
+	}
+	return &nodepb.Segment{
+		Head:      seg.Head,
+		Tail:      seg.Tail,
+		StartTime: seg.GetStartTime(),
+		BlockSize: seg.GetBlockSize(),
+		Checksum:  toProtoInt64Value(seg.Checksum),
+	}
+}
+
+func fromProtoSegment(seg *nodepb.Segment) *rpc.Segment {
+	if seg == nil {
+		return nil
+	}
+	return &rpc.Segment{
+		Head:      seg.Head,
+		Tail:      seg.Tail,
+		StartTime: optionalInt64(seg.StartTime),
+		BlockSize: optionalInt64(seg.BlockSize),
+		Checksum:  fromProtoInt64Value(seg.Checksum),
+	}
+}
+
+func toProtoSegments(segs *rpc.Segments) *nodepb.Segments {
+	if segs == nil {
+		return nil
+	}
+	result := &nodepb.Segments{Merged: toProtoSegment(segs.Merged)}
+	if len(segs.Unmerged) > 0 {
+		result.Unmerged = make([]*nodepb.Segment, 0, len(segs.Unmerged))
+		for _, seg := range segs.Unmerged {
+			result.Unmerged = append(result.Unmerged, toProtoSegment(seg))
+		}
+	}
+	return result
+}
+
+func fromProtoSegments(segs *nodepb.Segments) *rpc.Segments {
+	if segs == nil {
+		return nil
+	}
+	if segs.Merged != nil {
+		return &rpc.Segments{Merged: fromProtoSegment(segs.Merged)}
+	}
+	// NB: segments are unmerged when not merged, even if there are none.
+	result := &rpc.Segments{
+		Unmerged: make([]*rpc.Segment, 0, len(segs.Unmerged)),
+	}
+	for _, seg := range segs.Unmerged {
+		result.Unmerged = append(result.Unmerged, fromProtoSegment(seg))
+	}
+	return result
+}
+
+func toProtoSegmentsList(segs []*rpc.Segments) []*nodepb.Segments {
+	if segs == nil {
+		return nil
+	}
+	result := make([]*nodepb.Segments, 0, len(segs))
+	for _, s := range segs {
+		result = append(result, toProtoSegments(s))
+	}
+	return result
+}
+
+func fromProtoSegmentsList(segs []*nodepb.Segments) []*rpc.Segments {
+	if segs == nil {
+		return nil
+	}
+	result := make([]*rpc.Segments, 0, len(segs))
+	for _, s := range segs {
+		result = append(result, fromProtoSegments(s))
+	}
+	return result
+}
+
+func toProtoFetchBatchRawResult(result *rpc.FetchBatchRawResult_) *nodepb.FetchBatchRawResult {
+	elems := make([]*nodepb.FetchRawResult, 0, len(result.Elements))
+	for _, elem := range result.Elements {
+		elems = append(elems, &nodepb.FetchRawResult{
+			Segments: toProtoSegmentsList(elem.Segments),
+			Err:      toProtoError(elem.Err),
+		})
+	}
+	return &nodepb.FetchBatchRawResult{Elements: elems}
+}
+
+func fromProtoFetchBatchRawResult(result *nodepb.FetchBatchRawResult) *rpc.FetchBatchRawResult_ {
+	elems := make([]*rpc.FetchRawResult_, 0, len(result.Elements))
+	for _, elem := range result.Elements {
+		segs := fromProtoSegmentsList(elem.Segments)
+		if segs == nil {
+			// NB: segments are a required field of the result.
+			segs = []*rpc.Segments{}
+		}
+		elems = append(elems, &rpc.FetchRawResult_{
+			Segments: segs,
+			Err:      fromProtoError(elem.Err),
+		})
+	}
+	return &rpc.FetchBatchRawResult_{Elements: elems}
+}
+
+func toProtoFetchTaggedRequest(req *rpc.FetchTaggedRequest) *nodepb.FetchTaggedRequest {
+	return &nodepb.FetchTaggedRequest{
+		NameSpace:         req.NameSpace,
+		Query:             req.Query,
+		RangeStart:        req.RangeStart,
+		RangeEnd:          req.RangeEnd,
+		FetchData:         req.FetchData,
+		Limit:             req.GetLimit(),
+		RangeTimeType:     nodepb.TimeType(req.RangeTimeType),
+		RequireExhaustive: req.RequireExhaustive,
+		DocsLimit:         req.GetDocsLimit(),
+	}
+}
+
+func fromProtoFetchTaggedRequest(req *nodepb.FetchTaggedRequest) *rpc.FetchTaggedRequest {
+	return &rpc.FetchTaggedRequest{
+		NameSpace:         req.NameSpace,
+		Query:             req.Query,
+		RangeStart:        req.RangeStart,
+		RangeEnd:          req.RangeEnd,
+		FetchData:         req.FetchData,
+		Limit:             optionalInt64(req.Limit),
+		RangeTimeType:     rpc.TimeType(req.RangeTimeType),
+		RequireExhaustive: req.RequireExhaustive,
+		DocsLimit:         optionalInt64(req.DocsLimit),
+	}
+}
+
+func toProtoFetchTaggedResult(result *rpc.FetchTaggedResult_) *nodepb.FetchTaggedResult {
+	elems := make([]*nodepb.FetchTaggedIDResult, 0, len(result.Elements))
+	for _, elem := range result.Elements {
+		elems = append(elems, &nodepb.FetchTaggedIDResult{
+			Id:          elem.ID,
+			NameSpace:   elem.NameSpace,
+			EncodedTags: elem.EncodedTags,
+			Segments:    toProtoSegmentsList(elem.Segments),
+			Err:         toProtoError(elem.Err),
+		})
+	}
+	return &nodepb.FetchTaggedResult{
+		Elements:   elems,
+		Exhaustive: result.Exhaustive,
+	}
+}
+
+func fromProtoFetchTaggedResult(result *nodepb.FetchTaggedResult) *rpc.FetchTaggedResult_ {
+	elems := make([]*rpc.FetchTaggedIDResult_, 0, len(result.Elements))
+	for _, elem := range result.Elements {
+		elems = append(elems, &rpc.FetchTaggedIDResult_{
+			ID:          elem.Id,
+			NameSpace:   elem.NameSpace,
+			EncodedTags: elem.EncodedTags,
+			Segments:    fromProtoSegmentsList(elem.Segments),
+			Err:         fromProtoError(elem.Err),
+		})
+	}
+	return &rpc.FetchTaggedResult_{
+		Elements:   elems,
+		Exhaustive: result.Exhaustive,
+	}
+}
+
+func toProtoFetchBlocksRawRequest(req *rpc.FetchBlocksRawRequest) *nodepb.FetchBlocksRawRequest {
+	elems := make([]*nodepb.FetchBlocksRawRequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &nodepb.FetchBlocksRawRequestElement{
+			Id:     elem.ID,
+			Starts: elem.Starts,
+		})
+	}
+	return &nodepb.FetchBlocksRawRequest{
+		NameSpace: req.NameSpace,
+		Shard:     req.Shard,
+		Elements:  elems,
+	}
+}
+
+func fromProtoFetchBlocksRawRequest(req *nodepb.FetchBlocksRawRequest) *rpc.FetchBlocksRawRequest {
+	elems := make([]*rpc.FetchBlocksRawRequestElement, 0, len(req.Elements))
+	for _, elem := range req.Elements {
+		elems = append(elems, &rpc.FetchBlocksRawRequestElement{
+			ID:     elem.Id,
+			Starts: elem.Starts,
+		})
+	}
+	return &rpc.FetchBlocksRawRequest{
+		NameSpace: req.NameSpace,
+		Shard:     req.Shard,
+		Elements:  elems,
+	}
+}
+
+func toProtoFetchBlocksRawResult(result *rpc.FetchBlocksRawResult_) *nodepb.FetchBlocksRawResult {
+	elems := make([]*nodepb.Blocks, 0, len(result.Elements))
+	for _, elem := range result.Elements {
+		blocks := make([]*nodepb.Block, 0, len(elem.Blocks))
+		for _, block := range elem.Blocks {
+			blocks = append(blocks, &nodepb.Block{
+				Start:    block.Start,
+				Segments: toProtoSegments(block.Segments),
+				Err:      toProtoError(block.Err),
+				Checksum: toProtoInt64Value(block.Checksum),
+			})
+		}
+		elems = append(elems, &nodepb.Blocks{
+			Id:     elem.ID,
+			Blocks: blocks,
+		})
+	}
+	return &nodepb.FetchBlocksRawResult{Elements: elems}
+}
+
+func fromProtoFetchBlocksRawResult(result *nodepb.FetchBlocksRawResult) *rpc.FetchBlocksRawResult_ {
+	elems := make([]*rpc.Blocks, 0, len(result.Elements))
+	for _, elem := range result.Elements {
+		blocks := make([]*rpc.Block, 0, len(elem.Blocks))
+		for _, block := range elem.Blocks {
+			blocks = append(blocks, &rpc.Block{
+				Start:    block.Start,
+				Segments: fromProtoSegments(block.Segments),
+				Err:      fromProtoError(block.Err),
+				Checksum: fromProtoInt64Value(block.Checksum),
+			})
+		}
+		elems = append(elems, &rpc.Blocks{
+			ID:     elem.Id,
+			Blocks: blocks,
+		})
+	}
+	return &rpc.FetchBlocksRawResult_{Elements: elems}
+}
+
+func toProtoFetchBlocksMetadataRawV2Request(
+	req *rpc.FetchBlocksMetadataRawV2Request,
+) *nodepb.FetchBlocksMetadataRawV2Request {
+	return &nodepb.FetchBlocksMetadataRawV2Request{
+		NameSpace:        req.NameSpace,
+		Shard:            req.Shard,
+		RangeStart:       req.RangeStart,
+		RangeEnd:         req.RangeEnd,
+		Limit:            req.Limit,
+		PageToken:        req.PageToken,
+		IncludeSizes:     req.GetIncludeSizes(),
+		IncludeChecksums: req.GetIncludeChecksums(),
+		IncludeLastRead:  req.GetIncludeLastRead(),
+	}
+}
+
+func fromProtoFetchBlocksMetadataRawV2Request(
+	req *nodepb.FetchBlocksMetadataRawV2Request,
+) *rpc.FetchBlocksMetadataRawV2Request {
+	return &rpc.FetchBlocksMetadataRawV2Request{
+		NameSpace:        req.NameSpace,
+		Shard:            req.Shard,
+		RangeStart:       req.RangeStart,
+		RangeEnd:         req.RangeEnd,
+		Limit:            req.Limit,
+		PageToken:        req.PageToken,
+		IncludeSizes:     &req.IncludeSizes,
+		IncludeChecksums: &req.IncludeChecksums,
+		IncludeLastRead:  &req.IncludeLastRead,
+	}
+}
+
+func toProtoFetchBlocksMetadataRawV2Result(
+	result *rpc.FetchBlocksMetadataRawV2Result_,
+) *nodepb.FetchBlocksMetadataRawV2Result {
+	elems := make([]*nodepb.BlockMetadataV2, 0, len(result.Elements))
+	for _, elem := range result.Elements {
+		elems = append(elems, &nodepb.BlockMetadataV2{
+			Id:               elem.ID,
+			Start:            elem.Start,
+			Err:              toProtoError(elem.Err),
+			Size_:            toProtoInt64Value(elem.Size),
+			Checksum:         toProtoInt64Value(elem.Checksum),
+			LastRead:         toProtoInt64Value(elem.LastRead),
+			LastReadTimeType: nodepb.TimeType(elem.LastReadTimeType),
+			EncodedTags:      elem.EncodedTags,
+		})
+	}
+	return &nodepb.FetchBlocksMetadataRawV2Result{
+		Elements:      elems,
+		NextPageToken: result.NextPageToken,
+	}
+}
+
+func fromProtoFetchBlocksMetadataRawV2Result(
+	result *nodepb.FetchBlocksMetadataRawV2Result,
+) *rpc.FetchBlocksMetadataRawV2Result_ {
+	elems := make([]*rpc.BlockMetadataV2, 0, len(result.Elements))
+	for _, elem := range result.Elements {
+		elems = append(elems, &rpc.BlockMetadataV2{
+			ID:               elem.Id,
+			Start:            elem.Start,
+			Err:              fromProtoError(elem.Err),
+			Size:             fromProtoInt64Value(elem.Size_),
+			Checksum:         fromProtoInt64Value(elem.Checksum),
+			LastRead:         fromProtoInt64Value(elem.LastRead),
+			LastReadTimeType: rpc.TimeType(elem.LastReadTimeType),
+			EncodedTags:      elem.EncodedTags,
+		})
+	}
+	return &rpc.FetchBlocksMetadataRawV2Result_{
+		Elements:      elems,
+		NextPageToken: result.NextPageToken,
+	}
+}
+
+func toProtoQuery(q *rpc.Query) *nodepb.Query {
+	if q == nil {
+		return nil
+	}
+	result := &nodepb.Query{}
+	if q.Term != nil {
+		result.Term = &nodepb.TermQuery{Field: q.Term.Field, Term: q.Term.Term}
+	}
+	if q.Regexp != nil {
+		result.Regexp = &nodepb.RegexpQuery{Field: q.Regexp.Field, Regexp: q.Regexp.Regexp}
+	}
+	if q.Negation != nil {
+		result.Negation = &nodepb.NegationQuery{Query: toProtoQuery(q.Negation.Query)}
+	}
+	if q.Conjunction != nil {
+		result.Conjunction = &nodepb.ConjunctionQuery{
+			Queries: toProtoQueries(q.Conjunction.Queries),
+		}
+	}
+	if q.Disjunction != nil {
+		result.Disjunction = &nodepb.DisjunctionQuery{
+			Queries: toProtoQueries(q.Disjunction.Queries),
+		}
+	}
+	if q.All != nil {
+		result.All = &nodepb.AllQuery{}
+	}
+	if q.Field != nil {
+		result.Field = &nodepb.FieldQuery{Field: q.Field.Field}
+	}
+	return result
+}
+
+func toProtoQueries(qs []*rpc.Query) []*nodepb.Query {
+	result := make([]*nodepb.Query, 0, len(qs))
+	for _, q := range qs {
+		result = append(result, toProtoQuery(q))
+	}
+	return result
+}
+
+func fromProtoQuery(q *nodepb.Query) *rpc.Query {
+	if q == nil {
+		return nil
+	}
+	result := &rpc.Query{}
+	if q.Term != nil {
+		result.Term = &rpc.TermQuery{Field: q.Term.Field, Term: q.Term.Term}
+	}
+	if q.Regexp != nil {
+		result.Regexp = &rpc.RegexpQuery{Field: q.Regexp.Field, Regexp: q.Regexp.Regexp}
+	}
+	if q.Negation != nil {
+		result.Negation = &rpc.NegationQuery{Query: fromProtoQuery(q.Negation.Query)}
+	}
+	if q.Conjunction != nil {
+		result.Conjunction = &rpc.ConjunctionQuery{
+			Queries: fromProtoQueries(q.Conjunction.Queries),
+		}
+	}
+	if q.Disjunction != nil {
+		result.Disjunction = &rpc.DisjunctionQuery{
+			Queries: fromProtoQueries(q.Disjunction.Queries),
+		}
+	}
+	if q.All != nil {
+		result.All = &rpc.AllQuery{}
+	}
+	if q.Field != nil {
+		result.Field = &rpc.FieldQuery{Field: q.Field.Field}
+	}
+	return result
+}
+
+func fromProtoQueries(qs []*nodepb.Query) []*rpc.Query {
+	result := make([]*rpc.Query, 0, len(qs))
+	for _, q := range qs {
+		result = append(result, fromProtoQuery(q))
+	}
+	return result
+}
+
+func toProtoAggregateQueryRequest(req *rpc.AggregateQueryRequest) *nodepb.AggregateQueryRequest {
+	return &nodepb.AggregateQueryRequest{
+		Query:              toProtoQuery(req.Query),
+		RangeStart:         req.RangeStart,
+		RangeEnd:           req.RangeEnd,
+		NameSpace:          req.NameSpace,
+		Limit:              req.GetLimit(),
+		TagNameFilter:      req.TagNameFilter,
+		AggregateQueryType: nodepb.AggregateQueryType(req.AggregateQueryType),
+		RangeType:          nodepb.TimeType(req.RangeType),
+	}
+}
+
+func fromProtoAggregateQueryRequest(req *nodepb.AggregateQueryRequest) *rpc.AggregateQueryRequest {
+	return &rpc.AggregateQueryRequest{
+		Query:              fromProtoQuery(req.Query),
+		RangeStart:         req.RangeStart,
+		RangeEnd:           req.RangeEnd,
+		NameSpace:          req.NameSpace,
+		Limit:              optionalInt64(req.Limit),
+		TagNameFilter:      req.TagNameFilter,
+		AggregateQueryType: rpc.AggregateQueryType(req.AggregateQueryType),
+		RangeType:          rpc.TimeType(req.RangeType),
+	}
+}
+
+func toProtoAggregateQueryResult(result *rpc.AggregateQueryResult_) *nodepb.AggregateQueryResult {
+	results := make([]*nodepb.AggregateQueryResultTagNameElement, 0, len(result.Results))
+	for _, elem := range result.Results {
+		var values []*nodepb.AggregateQueryResultTagValueElement
+		if elem.TagValues != nil {
+			values = make([]*nodepb.AggregateQueryResultTagValueElement, 0, len(elem.TagValues))
+			for _, value := range elem.TagValues {
+				values = append(values, &nodepb.AggregateQueryResultTagValueElement{
+					TagValue: value.TagValue,
+				})
+			}
+		}
+		results = append(results, &nodepb.AggregateQueryResultTagNameElement{
+			TagName:   elem.TagName,
+			TagValues: values,
+		})
+	}
+	return &nodepb.AggregateQueryResult{
+		Results:    results,
+		Exhaustive: result.Exhaustive,
+	}
+}
+
+func fromProtoAggregateQueryResult(result *nodepb.AggregateQueryResult) *rpc.AggregateQueryResult_ {
+	results := make([]*rpc.AggregateQueryResultTagNameElement, 0, len(result.Results))
+	for _, elem := range result.Results {
+		var values []*rpc.AggregateQueryResultTagValueElement
+		if elem.TagValues != nil {
+			values = make([]*rpc.AggregateQueryResultTagValueElement, 0, len(elem.TagValues))
+			for _, value := range elem.TagValues {
+				values = append(values, &rpc.AggregateQueryResultTagValueElement{
+					TagValue: value.TagValue,
+				})
+			}
+		}
+		results = append(results, &rpc.AggregateQueryResultTagNameElement{
+			TagName:   elem.TagName,
+			TagValues: values,
+		})
+	}
+	return &rpc.AggregateQueryResult_{
+		Results:    results,
+		Exhaustive: result.Exhaustive,
+	}
+}
+
+func toProtoAggregateQueryRawRequest(
+	req *rpc.AggregateQueryRawRequest,
+) *nodepb.AggregateQueryRawRequest {
+	return &nodepb.AggregateQueryRawRequest{
+		Query:              req.Query,
+		RangeStart:         req.RangeStart,
+		RangeEnd:           req.RangeEnd,
+		NameSpace:          req.NameSpace,
+		Limit:              req.GetLimit(),
+		TagNameFilter:      req.TagNameFilter,
+		AggregateQueryType: nodepb.AggregateQueryType(req.AggregateQueryType),
+		RangeType:          nodepb.TimeType(req.RangeType),
+	}
+}
+
+func fromProtoAggregateQueryRawRequest(
+	req *nodepb.AggregateQueryRawRequest,
+) *rpc.AggregateQueryRawRequest {
+	return &rpc.AggregateQueryRawRequest{
+		Query:              req.Query,
+		RangeStart:         req.RangeStart,
+		RangeEnd:           req.RangeEnd,
+		NameSpace:          req.NameSpace,
+		Limit:              optionalInt64(req.Limit),
+		TagNameFilter:      req.TagNameFilter,
+		AggregateQueryType: rpc.AggregateQueryType(req.AggregateQueryType),
+		RangeType:          rpc.TimeType(req.RangeType),
+	}
+}
+
+func toProtoAggregateQueryRawResult(
+	result *rpc.AggregateQueryRawResult_,
+) *nodepb.AggregateQueryRawResult {
+	results := make([]*nodepb.AggregateQueryRawResultTagNameElement, 0, len(result.Results))
+	for _, elem := range result.Results {
+		var values []*nodepb.AggregateQueryRawResultTagValueElement
+		if elem.TagValues != nil {
+			values = make([]*nodepb.AggregateQueryRawResultTagValueElement, 0, len(elem.TagValues))
+			for _, value := range elem.TagValues {
+				values = append(values, &nodepb.AggregateQueryRawResultTagValueElement{
+					TagValue: value.TagValue,
+				})
+			}
+		}
+		results = append(results, &nodepb.AggregateQueryRawResultTagNameElement{
+			TagName:   elem.TagName,
+			TagValues: values,
+		})
+	}
+	return &nodepb.AggregateQueryRawResult{
+		Results:    results,
+		Exhaustive: result.Exhaustive,
+	}
+}
+
+func fromProtoAggregateQueryRawResult(
+	result *nodepb.AggregateQueryRawResult,
+) *rpc.AggregateQueryRawResult_ {
+	results := make([]*rpc.AggregateQueryRawResultTagNameElement, 0, len(result.Results))
+	for _, elem := range result.Results {
+		var values []*rpc.AggregateQueryRawResultTagValueElement
+		if elem.TagValues != nil {
+			values = make([]*rpc.AggregateQueryRawResultTagValueElement, 0, len(elem.TagValues))
+			for _, value := range elem.TagValues {
+				values = append(values, &rpc.AggregateQueryRawResultTagValueElement{
+					TagValue: value.TagValue,
+				})
+			}
+		}
+		results = append(results, &rpc.AggregateQueryRawResultTagNameElement{
+			TagName:   elem.TagName,
+			TagValues: values,
+		})
+	}
+	return &rpc.AggregateQueryRawResult_{
+		Results:    results,
+		Exhaustive: result.Exhaustive,
+	}
+}
+
+func toProtoDeleteSeriesRequest(req *rpc.DeleteSeriesRequest) *nodepb.DeleteSeriesRequest {
+	return &nodepb.DeleteSeriesRequest{
+		NameSpace:  req.NameSpace,
+		Query:      req.Query,
+		RangeStart: req.RangeStart,
+		RangeEnd:   req.RangeEnd,
+	}
+}
+
+func fromProtoDeleteSeriesRequest(req *nodepb.DeleteSeriesRequest) *rpc.DeleteSeriesRequest {
+	return &rpc.DeleteSeriesRequest{
+		NameSpace:  req.NameSpace,
+		Query:      req.Query,
+		RangeStart: req.RangeStart,
+		RangeEnd:   req.RangeEnd,
+	}
+}
+
+func toProtoDeleteSeriesResult(result *rpc.DeleteSeriesResult_) *nodepb.DeleteSeriesResult {
+	shards := make([]*nodepb.DeleteSeriesShardResult, 0, len(result.Shards))
+	for _, shard := range result.Shards {
+		shards = append(shards, &nodepb.DeleteSeriesShardResult{
+			Shard:     uint32(shard.Shard),
+			NumSeries: shard.NumSeries,
+		})
+	}
+	return &nodepb.DeleteSeriesResult{
+		NumSeries: result.NumSeries,
+		Shards:    shards,
+	}
+}
+
+func fromProtoDeleteSeriesResult(result *nodepb.DeleteSeriesResult) *rpc.DeleteSeriesResult_ {
+	shards := make([]*rpc.DeleteSeriesShardResult, 0, len(result.Shards))
+	for _, shard := range result.Shards {
+		shards = append(shards, &rpc.DeleteSeriesShardResult{
+			Shard:     int32(shard.Shard),
+			NumSeries: shard.NumSeries,
+		})
+	}
+	return &rpc.DeleteSeriesResult_{
+		NumSeries: result.NumSeries,
+		Shards:    shards,
+	}
+}
+
+func toProtoIndexCardinalityRequest(
+	req *rpc.IndexCardinalityRequest,
+) *nodepb.IndexCardinalityRequest {
+	return &nodepb.IndexCardinalityRequest{
+		NameSpace:     req.NameSpace,
+		RangeStart:    req.RangeStart,
+		RangeEnd:      req.RangeEnd,
+		Limit:         req.Limit,
+		MetricNameTag: req.MetricNameTag,
+		Query:         req.Query,
+	}
+}
+
+func fromProtoIndexCardinalityRequest(
+	req *nodepb.IndexCardinalityRequest,
+) *rpc.IndexCardinalityRequest {
+	return &rpc.IndexCardinalityRequest{
+		NameSpace:     req.NameSpace,
+		RangeStart:    req.RangeStart,
+		RangeEnd:      req.RangeEnd,
+		Limit:         req.Limit,
+		MetricNameTag: req.MetricNameTag,
+		Query:         req.Query,
+	}
+}
+
+func toProtoIndexCardinalityEntries(
+	entries []*rpc.IndexCardinalityEntry,
+) []*nodepb.IndexCardinalityEntry {
+	result := make([]*nodepb.IndexCardinalityEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, &nodepb.IndexCardinalityEntry{
+			Name:  entry.Name,
+			Value: entry.Value,
+			Count: entry.Count,
+		})
+	}
+	return result
+}
+
+func fromProtoIndexCardinalityEntries(
+	entries []*nodepb.IndexCardinalityEntry,
+) []*rpc.IndexCardinalityEntry {
+	result := make([]*rpc.IndexCardinalityEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, &rpc.IndexCardinalityEntry{
+			Name:  entry.Name,
+			Value: entry.Value,
+			Count: entry.Count,
+		})
+	}
+	return result
+}
+
+func toProtoIndexCardinalityResult(
+	result *rpc.IndexCardinalityResult_,
+) *nodepb.IndexCardinalityResult {
+	blocks := make([]*nodepb.IndexBlockCardinality, 0, len(result.Blocks))
+	for _, block := range result.Blocks {
+		blocks = append(blocks, &nodepb.IndexBlockCardinality{
+			BlockStart: block.BlockStart,
+			NumSeries:  block.NumSeries,
+			SeriesCountByMetricName: toProtoIndexCardinalityEntries(
+				block.SeriesCountByMetricName),
+			SeriesCountByLabelName: toProtoIndexCardinalityEntries(
+				block.SeriesCountByLabelName),
+			SeriesCountByLabelValuePair: toProtoIndexCardinalityEntries(
+				block.SeriesCountByLabelValuePair),
+			LabelValueCountByLabelName: toProtoIndexCardinalityEntries(
+				block.LabelValueCountByLabelName),
+			LabelValuesByLabelName: toProtoIndexCardinalityLabelValues(
+				block.LabelValuesByLabelName),
+		})
+	}
+	return &nodepb.IndexCardinalityResult{Blocks: blocks}
+}
+
+func fromProtoIndexCardinalityResult(
+	result *nodepb.IndexCardinalityResult,
+) *rpc.IndexCardinalityResult_ {
+	blocks := make([]*rpc.IndexBlockCardinality, 0, len(result.Blocks))
+	for _, block := range result.Blocks {
+		blocks = append(blocks, &rpc.IndexBlockCardinality{
+			BlockStart: block.BlockStart,
+			NumSeries:  block.NumSeries,
+			SeriesCountByMetricName: fromProtoIndexCardinalityEntries(
+				block.SeriesCountByMetricName),
+			SeriesCountByLabelName: fromProtoIndexCardinalityEntries(
+				block.SeriesCountByLabelName),
+			SeriesCountByLabelValuePair: fromProtoIndexCardinalityEntries(
+				block.SeriesCountByLabelValuePair),
+			LabelValueCountByLabelName: fromProtoIndexCardinalityEntries(
+				block.LabelValueCountByLabelName),
+			LabelValuesByLabelName: fromProtoIndexCardinalityLabelValues(
+				block.LabelValuesByLabelName),
+		})
+	}
+	return &rpc.IndexCardinalityResult_{Blocks: blocks}
+}
+
+func toProtoIndexCardinalityLabelValues(
+	labelValues []*rpc.IndexCardinalityLabelValues,
+) []*nodepb.IndexCardinalityLabelValues {
+	result := make([]*nodepb.IndexCardinalityLabelValues, 0, len(labelValues))
+	for _, lv := range labelValues {
+		result = append(result, &nodepb.IndexCardinalityLabelValues{
+			Name:   lv.Name,
+			Values: lv.Values,
+		})
+	}
+	return result
+}
+
+func fromProtoIndexCardinalityLabelValues(
+	labelValues []*nodepb.IndexCardinalityLabelValues,
+) []*rpc.IndexCardinalityLabelValues {
+	result := make([]*rpc.IndexCardinalityLabelValues, 0, len(labelValues))
+	for _, lv := range labelValues {
+		result = append(result, &rpc.IndexCardinalityLabelValues{
+			Name:   lv.Name,
+			Values: lv.Values,
+		})
+	}
+	return result
+}
+
+func toProtoNodeHealthResult(result *rpc.NodeHealthResult_) *nodepb.NodeHealthResult {
+	var compressions []nodepb.CompressionType
+	if result.Compressions != nil {
+		compressions = make([]nodepb.CompressionType, 0, len(result.Compressions))
+		for _, c := range result.Compressions {
+			compressions = append(compressions, nodepb.CompressionType(c))
+		}
+	}
+	return &nodepb.NodeHealthResult{
+		Ok:           result.Ok,
+		Status:       result.Status,
+		Bootstrapped: result.Bootstrapped,
+		Metadata:     result.Metadata,
+		Compressions: compressions,
+	}
+}
+
+func fromProtoNodeHealthResult(result *nodepb.NodeHealthResult) *rpc.NodeHealthResult_ {
+	var compressions []rpc.CompressionType
+	if result.Compressions != nil {
+		compressions = make([]rpc.CompressionType, 0, len(result.Compressions))
+		for _, c := range result.Compressions {
+			compressions = append(compressions, rpc.CompressionType(c))
+		}
+	}
+	return &rpc.NodeHealthResult_{
+		Ok:           result.Ok,
+		Status:       result.Status,
+		Bootstrapped: result.Bootstrapped,
+		Metadata:     result.Metadata,
+		Compressions: compressions,
+	}
+}
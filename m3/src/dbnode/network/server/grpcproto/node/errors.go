@@ -0,0 +1,91 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"context"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// errorTrailerKey is the trailer key holding a marshaled nodepb.Error.
+	errorTrailerKey = "m3-error-bin"
+	// writeBatchRawErrorsTrailerKey is the trailer key holding a marshaled
+	// nodepb.WriteBatchRawErrors.
+	writeBatchRawErrorsTrailerKey = "m3-write-batch-raw-errors-bin"
+)
+
+// newStatusError returns the gRPC status error for an error returned by the
+// node service, node errors are set in the trailer of the call so that
+// clients can return them as is.
+func newStatusError(ctx context.Context, err error) error {
+	var (
+		code = codes.Internal
+		key  string
+		msg  proto.Message
+	)
+	switch v := err.(type) {
+	case *rpc.Error:
+		if v.Type == rpc.ErrorType_BAD_REQUEST {
+			code = codes.InvalidArgument
+		}
+		key, msg = errorTrailerKey, toProtoError(v)
+	case *rpc.WriteBatchRawErrors:
+		key, msg = writeBatchRawErrorsTrailerKey, toProtoWriteBatchRawErrors(v)
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+
+	b, marshalErr := proto.Marshal(msg)
+	if marshalErr == nil {
+		marshalErr = grpc.SetTrailer(ctx, metadata.Pairs(key, string(b)))
+	}
+	if marshalErr != nil {
+		return status.Error(codes.Internal, marshalErr.Error())
+	}
+	return status.Error(code, err.Error())
+}
+
+// errorFromStatus returns the node error held by the trailer of a call, or
+// the gRPC status error of the call if there is none.
+func errorFromStatus(err error, trailer metadata.MD) error {
+	if values := trailer.Get(errorTrailerKey); len(values) > 0 {
+		var result nodepb.Error
+		if proto.Unmarshal([]byte(values[0]), &result) == nil {
+			return fromProtoError(&result)
+		}
+	}
+	if values := trailer.Get(writeBatchRawErrorsTrailerKey); len(values) > 0 {
+		var result nodepb.WriteBatchRawErrors
+		if proto.Unmarshal([]byte(values[0]), &result) == nil {
+			return fromProtoWriteBatchRawErrors(&result)
+		}
+	}
+	return err
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"github.com/m3db/m3/src/dbnode/network/server/grpcproto"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"google.golang.org/grpc"
+)
+
+// Options are gRPC node server options.
+type Options interface {
+	// SetServerOptions sets the gRPC server options, such as transport
+	// credentials.
+	SetServerOptions(value []grpc.ServerOption) Options
+
+	// ServerOptions returns the gRPC server options.
+	ServerOptions() []grpc.ServerOption
+
+	// SetMaxMessageSize sets the max size of messages sent and received.
+	SetMaxMessageSize(value int) Options
+
+	// MaxMessageSize returns the max size of messages sent and received.
+	MaxMessageSize() int
+
+	// SetReflectionEnabled sets whether the gRPC server reflection service is
+	// registered, allowing tools such as grpcurl to list and call the methods.
+	SetReflectionEnabled(value bool) Options
+
+	// ReflectionEnabled returns whether the gRPC server reflection service is
+	// registered.
+	ReflectionEnabled() bool
+
+	// SetInstrumentOptions sets the instrumentation options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrumentation options.
+	InstrumentOptions() instrument.Options
+}
+
+type options struct {
+	serverOpts        []grpc.ServerOption
+	maxMessageSize    int
+	reflectionEnabled bool
+	instrumentOpts    instrument.Options
+}
+
+// NewOptions creates a new options.
+func NewOptions() Options {
+	return &options{
+		maxMessageSize: grpcproto.DefaultMaxMessageSize,
+		instrumentOpts: instrument.NewOptions(),
+	}
+}
+
+func (o *options) SetServerOptions(value []grpc.ServerOption) Options {
+	opts := *o
+	opts.serverOpts = value
+	return &opts
+}
+
+func (o *options) ServerOptions() []grpc.ServerOption {
+	return o.serverOpts
+}
+
+func (o *options) SetMaxMessageSize(value int) Options {
+	opts := *o
+	opts.maxMessageSize = value
+	return &opts
+}
+
+func (o *options) MaxMessageSize() int {
+	return o.maxMessageSize
+}
+
+func (o *options) SetReflectionEnabled(value bool) Options {
+	opts := *o
+	opts.reflectionEnabled = value
+	return &opts
+}
+
+func (o *options) ReflectionEnabled() bool {
+	return o.reflectionEnabled
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+
+	golangproto "github.com/golang/protobuf/proto"
+	// Registers the well known wrapper types the node service file depends
+	// on with the golang/protobuf registry.
+	_ "github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// nodeProtoFile is the name of the file defining the node service.
+const nodeProtoFile = "github.com/m3db/m3/src/dbnode/generated/proto/nodepb/node.proto"
+
+func init() {
+	// NB: gRPC server reflection resolves files from the golang/protobuf
+	// registry while gogo/protobuf messages register their files with the
+	// gogo/protobuf one, so register the node service file with both.
+	desc, _ := (&nodepb.NodeHealthRequest{}).Descriptor()
+	golangproto.RegisterFile(nodeProtoFile, desc)
+}
@@ -0,0 +1,428 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"context"
+	"net"
+
+	"github.com/m3db/m3/src/dbnode/generated/proto/nodepb"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	ns "github.com/m3db/m3/src/dbnode/network/server"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
+	xcontext "github.com/m3db/m3/src/x/context"
+
+	tchannelthriftgo "github.com/uber/tchannel-go/thrift"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+)
+
+var errNoCallContext = status.Error(codes.Internal,
+	"gRPC server not created with the node server options")
+
+type server struct {
+	service     rpc.TChanNode
+	address     string
+	contextPool xcontext.Pool
+	opts        Options
+}
+
+// NewServer creates a new node gRPC network service
+func NewServer(
+	service rpc.TChanNode,
+	address string,
+	contextPool xcontext.Pool,
+	opts Options,
+) ns.NetworkService {
+	if opts == nil {
+		opts = NewOptions()
+	}
+	return &server{
+		service:     service,
+		address:     address,
+		contextPool: contextPool,
+		opts:        opts,
+	}
+}
+
+func (s *server) ListenAndServe() (ns.Close, error) {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return nil, err
+	}
+
+	serverOpts := append([]grpc.ServerOption{
+		grpc.MaxRecvMsgSize(s.opts.MaxMessageSize()),
+		grpc.MaxSendMsgSize(s.opts.MaxMessageSize()),
+	}, s.opts.ServerOptions()...)
+	server := grpc.NewServer(append(serverOpts, ServerOptions()...)...)
+	RegisterNodeServer(server, s.service, s.contextPool)
+	if s.opts.ReflectionEnabled() {
+		reflection.Register(server)
+	}
+
+	logger := s.opts.InstrumentOptions().Logger()
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			logger.Error("node gRPC server stopped serving", zap.Error(err))
+		}
+	}()
+
+	return server.Stop, nil
+}
+
+// ServerOptions returns the options gRPC servers serving the node service
+// must be created with, which close the M3DB context of each call once its
+// response is sent.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{grpc.StatsHandler(contextHandler{})}
+}
+
+// RegisterNodeServer registers the node service with a gRPC server created
+// with the ServerOptions, creating an M3DB context per call.
+func RegisterNodeServer(
+	server *grpc.Server,
+	service rpc.TChanNode,
+	contextPool xcontext.Pool,
+) {
+	nodepb.RegisterNodeServer(server, &nodeServer{
+		service:     service,
+		contextPool: contextPool,
+	})
+}
+
+type callContextKey struct{}
+
+// callContext holds the M3DB context of a call.
+type callContext struct {
+	xCtx xcontext.Context
+}
+
+// contextHandler closes the M3DB context of each call once its response is
+// sent rather than when the handler returns, since results may reference
+// data released when the context closes and are only marshaled after the
+// handler returns.
+type contextHandler struct{}
+
+func (contextHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, callContextKey{}, &callContext{})
+}
+
+func (contextHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	if _, ok := s.(*stats.End); !ok {
+		return
+	}
+	if c, ok := ctx.Value(callContextKey{}).(*callContext); ok && c.xCtx != nil {
+		c.xCtx.Close()
+	}
+}
+
+func (contextHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (contextHandler) HandleConn(context.Context, stats.ConnStats) {}
+
+type nodeServer struct {
+	service     rpc.TChanNode
+	contextPool xcontext.Pool
+}
+
+func (s *nodeServer) context(ctx context.Context) (tchannelthriftgo.Context, error) {
+	c, ok := ctx.Value(callContextKey{}).(*callContext)
+	if !ok {
+		return nil, errNoCallContext
+	}
+	xCtx := s.contextPool.Get()
+	xCtx.SetGoContext(ctx)
+	c.xCtx = xCtx
+	return tchannelthrift.WithContext(ctx, xCtx, nil), nil
+}
+
+func (s *nodeServer) Write(
+	ctx context.Context,
+	req *nodepb.WriteRequest,
+) (*nodepb.WriteResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.service.Write(tctx, fromProtoWriteRequest(req)); err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return &nodepb.WriteResult{}, nil
+}
+
+func (s *nodeServer) WriteTagged(
+	ctx context.Context,
+	req *nodepb.WriteTaggedRequest,
+) (*nodepb.WriteTaggedResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.service.WriteTagged(tctx, fromProtoWriteTaggedRequest(req)); err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return &nodepb.WriteTaggedResult{}, nil
+}
+
+func (s *nodeServer) WriteBatchRaw(
+	ctx context.Context,
+	req *nodepb.WriteBatchRawRequest,
+) (*nodepb.WriteBatchRawResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.service.WriteBatchRaw(tctx, fromProtoWriteBatchRawRequest(req)); err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return &nodepb.WriteBatchRawResult{}, nil
+}
+
+func (s *nodeServer) WriteBatchRawV2(
+	ctx context.Context,
+	req *nodepb.WriteBatchRawV2Request,
+) (*nodepb.WriteBatchRawResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.service.WriteBatchRawV2(tctx, fromProtoWriteBatchRawV2Request(req)); err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return &nodepb.WriteBatchRawResult{}, nil
+}
+
+func (s *nodeServer) WriteTaggedBatchRaw(
+	ctx context.Context,
+	req *nodepb.WriteTaggedBatchRawRequest,
+) (*nodepb.WriteBatchRawResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.WriteTaggedBatchRaw(tctx, fromProtoWriteTaggedBatchRawRequest(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return &nodepb.WriteBatchRawResult{}, nil
+}
+
+func (s *nodeServer) WriteTaggedBatchRawV2(
+	ctx context.Context,
+	req *nodepb.WriteTaggedBatchRawV2Request,
+) (*nodepb.WriteBatchRawResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.WriteTaggedBatchRawV2(tctx, fromProtoWriteTaggedBatchRawV2Request(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return &nodepb.WriteBatchRawResult{}, nil
+}
+
+func (s *nodeServer) FetchBatchRaw(
+	ctx context.Context,
+	req *nodepb.FetchBatchRawRequest,
+) (*nodepb.FetchBatchRawResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.FetchBatchRaw(tctx, fromProtoFetchBatchRawRequest(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoFetchBatchRawResult(result), nil
+}
+
+func (s *nodeServer) FetchBatchRawV2(
+	ctx context.Context,
+	req *nodepb.FetchBatchRawV2Request,
+) (*nodepb.FetchBatchRawResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.FetchBatchRawV2(tctx, fromProtoFetchBatchRawV2Request(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoFetchBatchRawResult(result), nil
+}
+
+func (s *nodeServer) FetchTagged(
+	ctx context.Context,
+	req *nodepb.FetchTaggedRequest,
+) (*nodepb.FetchTaggedResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.FetchTagged(tctx, fromProtoFetchTaggedRequest(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoFetchTaggedResult(result), nil
+}
+
+func (s *nodeServer) FetchBlocksRaw(
+	ctx context.Context,
+	req *nodepb.FetchBlocksRawRequest,
+) (*nodepb.FetchBlocksRawResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.FetchBlocksRaw(tctx, fromProtoFetchBlocksRawRequest(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoFetchBlocksRawResult(result), nil
+}
+
+func (s *nodeServer) FetchBlocksMetadataRawV2(
+	ctx context.Context,
+	req *nodepb.FetchBlocksMetadataRawV2Request,
+) (*nodepb.FetchBlocksMetadataRawV2Result, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.FetchBlocksMetadataRawV2(tctx,
+		fromProtoFetchBlocksMetadataRawV2Request(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoFetchBlocksMetadataRawV2Result(result), nil
+}
+
+func (s *nodeServer) Aggregate(
+	ctx context.Context,
+	req *nodepb.AggregateQueryRequest,
+) (*nodepb.AggregateQueryResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.Aggregate(tctx, fromProtoAggregateQueryRequest(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoAggregateQueryResult(result), nil
+}
+
+func (s *nodeServer) AggregateRaw(
+	ctx context.Context,
+	req *nodepb.AggregateQueryRawRequest,
+) (*nodepb.AggregateQueryRawResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.AggregateRaw(tctx, fromProtoAggregateQueryRawRequest(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoAggregateQueryRawResult(result), nil
+}
+
+func (s *nodeServer) Truncate(
+	ctx context.Context,
+	req *nodepb.TruncateRequest,
+) (*nodepb.TruncateResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.Truncate(tctx, &rpc.TruncateRequest{NameSpace: req.NameSpace})
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return &nodepb.TruncateResult{NumSeries: result.NumSeries}, nil
+}
+
+func (s *nodeServer) DeleteSeries(
+	ctx context.Context,
+	req *nodepb.DeleteSeriesRequest,
+) (*nodepb.DeleteSeriesResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.DeleteSeries(tctx, fromProtoDeleteSeriesRequest(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoDeleteSeriesResult(result), nil
+}
+
+func (s *nodeServer) IndexCardinality(
+	ctx context.Context,
+	req *nodepb.IndexCardinalityRequest,
+) (*nodepb.IndexCardinalityResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.IndexCardinality(tctx, fromProtoIndexCardinalityRequest(req))
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoIndexCardinalityResult(result), nil
+}
+
+func (s *nodeServer) Health(
+	ctx context.Context,
+	_ *nodepb.NodeHealthRequest,
+) (*nodepb.NodeHealthResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.service.Health(tctx)
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return toProtoNodeHealthResult(result), nil
+}
+
+func (s *nodeServer) Bootstrapped(
+	ctx context.Context,
+	_ *nodepb.NodeBootstrappedRequest,
+) (*nodepb.NodeBootstrappedResult, error) {
+	tctx, err := s.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.service.Bootstrapped(tctx); err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+	return &nodepb.NodeBootstrappedResult{}, nil
+}
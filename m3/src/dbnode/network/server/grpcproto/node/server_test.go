@@ -0,0 +1,388 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package node
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/grpcproto"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
+	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
+	xcontext "github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/instrument"
+	xtls "github.com/m3db/m3/src/x/tls"
+	"github.com/m3db/m3/src/x/tls/tlstest"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go/thrift"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+func newTestClient(
+	t *testing.T,
+	service rpc.TChanNode,
+) (rpc.TChanNode, func()) {
+	client, _, closer := newTestClientWithOptions(t, service, nil, grpc.WithInsecure())
+	return client, closer
+}
+
+func newTestClientWithOptions(
+	t *testing.T,
+	service rpc.TChanNode,
+	serverOpts []grpc.ServerOption,
+	dialOpts ...grpc.DialOption,
+) (rpc.TChanNode, *grpc.ClientConn, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(append(ServerOptions(), serverOpts...)...)
+	RegisterNodeServer(server, service, xcontext.NewPool(xcontext.NewOptions()))
+	reflection.Register(server)
+	go server.Serve(listener)
+
+	conn, err := grpc.Dial(listener.Addr().String(), dialOpts...)
+	require.NoError(t, err)
+
+	return NewClient(conn), conn, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func TestServerHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := rpc.NewMockTChanNode(ctrl)
+	client, closer := newTestClient(t, service)
+	defer closer()
+
+	expected := &rpc.NodeHealthResult_{
+		Ok:           true,
+		Status:       "up",
+		Bootstrapped: true,
+	}
+	service.EXPECT().Health(gomock.Any()).Return(expected, nil)
+
+	ctx, cancel := tchannelthrift.NewContext(time.Minute)
+	defer cancel()
+
+	result, err := client.Health(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestServerHealthTLS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := tlstest.NewCA(t, 1)
+	serverFiles := tlstest.NewFiles(dir, "server")
+	serverFiles.WriteIssued(t, ca, 2)
+	clientFiles := tlstest.NewFiles(dir, "client")
+	clientFiles.WriteIssued(t, ca, 3)
+
+	clientAuth := xtls.RequireAndVerifyClientCert
+	serverConfig, err := xtls.Configuration{
+		CertFile:   serverFiles.CertFile,
+		KeyFile:    serverFiles.KeyFile,
+		CAFile:     serverFiles.CAFile,
+		ClientAuth: &clientAuth,
+	}.NewServerConfig(instrument.NewOptions())
+	require.NoError(t, err)
+	clientConfig, err := xtls.Configuration{
+		CertFile:   clientFiles.CertFile,
+		KeyFile:    clientFiles.KeyFile,
+		CAFile:     clientFiles.CAFile,
+		ServerName: "localhost",
+	}.NewClientConfig(instrument.NewOptions())
+	require.NoError(t, err)
+
+	service := rpc.NewMockTChanNode(ctrl)
+	client, _, closer := newTestClientWithOptions(t, service,
+		[]grpc.ServerOption{grpc.Creds(credentials.NewTLS(serverConfig))},
+		grpc.WithTransportCredentials(credentials.NewTLS(clientConfig)))
+	defer closer()
+
+	expected := &rpc.NodeHealthResult_{Ok: true, Status: "up"}
+	service.EXPECT().Health(gomock.Any()).Return(expected, nil)
+
+	ctx, cancel := tchannelthrift.NewContext(time.Minute)
+	defer cancel()
+
+	result, err := client.Health(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestServerHealthCompressed(t *testing.T) {
+	for _, compression := range []rpc.CompressionType{
+		rpc.CompressionType_SNAPPY,
+		rpc.CompressionType_ZSTD,
+	} {
+		t.Run(compression.String(), func(t *testing.T) {
+			testServerHealthCompressed(t, compression)
+		})
+	}
+}
+
+func testServerHealthCompressed(t *testing.T, compression rpc.CompressionType) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	name, ok := grpcproto.CompressorName(compression)
+	require.True(t, ok)
+
+	service := rpc.NewMockTChanNode(ctrl)
+	client, _, closer := newTestClientWithOptions(t, service, nil,
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(name)))
+	defer closer()
+
+	expected := &rpc.NodeHealthResult_{Ok: true, Status: "up"}
+	service.EXPECT().Health(gomock.Any()).Return(expected, nil)
+
+	ctx, cancel := tchannelthrift.NewContext(time.Minute)
+	defer cancel()
+
+	result, err := client.Health(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestServerFetchTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := rpc.NewMockTChanNode(ctrl)
+	client, closer := newTestClient(t, service)
+	defer closer()
+
+	req := &rpc.FetchTaggedRequest{
+		NameSpace:  []byte("ns"),
+		Query:      []byte("query"),
+		RangeStart: 1,
+		RangeEnd:   2,
+		FetchData:  true,
+	}
+	expected := &rpc.FetchTaggedResult_{
+		Elements: []*rpc.FetchTaggedIDResult_{
+			{
+				ID:          []byte("foo"),
+				NameSpace:   []byte("ns"),
+				EncodedTags: []byte("tags"),
+			},
+		},
+		Exhaustive: true,
+	}
+	service.EXPECT().
+		FetchTagged(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			ctx thrift.Context,
+			actual *rpc.FetchTaggedRequest,
+		) (*rpc.FetchTaggedResult_, error) {
+			assert.Equal(t, req, actual)
+			return expected, nil
+		})
+
+	ctx, cancel := tchannelthrift.NewContext(time.Minute)
+	defer cancel()
+
+	result, err := client.FetchTagged(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestServerWriteErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := rpc.NewMockTChanNode(ctrl)
+	client, closer := newTestClient(t, service)
+	defer closer()
+
+	batchErrs := rpc.NewWriteBatchRawErrors()
+	batchErrs.Errors = []*rpc.WriteBatchRawError{
+		tterrors.NewBadRequestWriteBatchRawError(1, assert.AnError),
+	}
+	service.EXPECT().WriteBatchRaw(gomock.Any(), gomock.Any()).Return(batchErrs)
+	service.EXPECT().
+		WriteTagged(gomock.Any(), gomock.Any()).
+		Return(tterrors.NewBadRequestError(assert.AnError))
+
+	ctx, cancel := tchannelthrift.NewContext(time.Minute)
+	defer cancel()
+
+	err := client.WriteBatchRaw(ctx, &rpc.WriteBatchRawRequest{})
+	require.Error(t, err)
+	assert.Equal(t, batchErrs, err)
+
+	err = client.WriteTagged(ctx, &rpc.WriteTaggedRequest{
+		NameSpace: "ns",
+		ID:        "foo",
+		Datapoint: &rpc.Datapoint{Timestamp: 1, Value: 1},
+	})
+	require.Error(t, err)
+	assert.True(t, tterrors.IsBadRequestError(err.(*rpc.Error)))
+}
+
+func TestServerDeleteSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := rpc.NewMockTChanNode(ctrl)
+	client, closer := newTestClient(t, service)
+	defer closer()
+
+	req := &rpc.DeleteSeriesRequest{
+		NameSpace:  []byte("ns"),
+		Query:      []byte("query"),
+		RangeStart: 1,
+		RangeEnd:   2,
+	}
+	expected := &rpc.DeleteSeriesResult_{
+		NumSeries: 3,
+		Shards: []*rpc.DeleteSeriesShardResult{
+			{Shard: 1, NumSeries: 1},
+			{Shard: 2, NumSeries: 2},
+		},
+	}
+	service.EXPECT().
+		DeleteSeries(gomock.Any(), req).
+		Return(expected, nil)
+
+	ctx, cancel := tchannelthrift.NewContext(time.Minute)
+	defer cancel()
+
+	result, err := client.DeleteSeries(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestServerIndexCardinality(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := rpc.NewMockTChanNode(ctrl)
+	client, closer := newTestClient(t, service)
+	defer closer()
+
+	req := &rpc.IndexCardinalityRequest{
+		NameSpace:     []byte("ns"),
+		RangeStart:    1,
+		RangeEnd:      2,
+		Limit:         10,
+		MetricNameTag: []byte("__name__"),
+		Query:         []byte("query"),
+	}
+	entries := []*rpc.IndexCardinalityEntry{
+		{Name: []byte("foo"), Value: []byte("bar"), Count: 2},
+	}
+	expected := &rpc.IndexCardinalityResult_{
+		Blocks: []*rpc.IndexBlockCardinality{
+			{
+				BlockStart:                  1,
+				NumSeries:                   2,
+				SeriesCountByMetricName:     entries,
+				SeriesCountByLabelName:      entries,
+				SeriesCountByLabelValuePair: entries,
+				LabelValueCountByLabelName:  entries,
+				LabelValuesByLabelName: []*rpc.IndexCardinalityLabelValues{
+					{Name: []byte("foo"), Values: [][]byte{[]byte("bar")}},
+				},
+			},
+		},
+	}
+	service.EXPECT().
+		IndexCardinality(gomock.Any(), req).
+		Return(expected, nil)
+
+	ctx, cancel := tchannelthrift.NewContext(time.Minute)
+	defer cancel()
+
+	result, err := client.IndexCardinality(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestServerReflection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, conn, closer := newTestClientWithOptions(t, rpc.NewMockTChanNode(ctrl),
+		nil, grpc.WithInsecure())
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	var services []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	assert.Contains(t, services, "node.Node")
+
+	require.NoError(t, stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: "node.Node",
+		},
+	}))
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Nil(t, resp.GetErrorResponse())
+	assert.NotEmpty(t, resp.GetFileDescriptorResponse().GetFileDescriptorProto())
+}
+
+func TestClientUnsupportedMethod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client, closer := newTestClient(t, rpc.NewMockTChanNode(ctrl))
+	defer closer()
+
+	ctx, cancel := tchannelthrift.NewContext(time.Minute)
+	defer cancel()
+
+	require.Error(t, client.Repair(ctx))
+}
@@ -42,11 +42,21 @@ func RegisterServer(channel *tchannel.Channel, service thrift.TChanServer, conte
 	server.SetContextFn(func(ctx xnetcontext.Context, method string, headers map[string]string) thrift.Context {
 		xCtx := contextPool.Get()
 		xCtx.SetGoContext(ctx)
-		ctxWithValue := xnetcontext.WithValue(ctx, contextKey, xCtx)
-		return thrift.WithHeaders(ctxWithValue, headers)
+		return WithContext(ctx, xCtx, headers)
 	})
 }
 
+// WithContext returns a thrift context with the given M3DB context embedded, used
+// by transports other than TChannel to serve requests with the node service
+func WithContext(
+	ctx xnetcontext.Context,
+	xCtx context.Context,
+	headers map[string]string,
+) thrift.Context {
+	ctxWithValue := xnetcontext.WithValue(ctx, contextKey, xCtx)
+	return thrift.WithHeaders(ctxWithValue, headers)
+}
+
 // NewContext returns a new thrift context and cancel func with embedded M3DB context
 func NewContext(timeout time.Duration) (thrift.Context, xnetcontext.CancelFunc) {
 	tctx, cancel := thrift.NewContext(timeout)
@@ -0,0 +1,195 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package convert
+
+import (
+	"fmt"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// maxDecompressedSize bounds the size of a decompressed payload so that a
+	// corrupt or malicious length prefix cannot exhaust memory.
+	maxDecompressedSize = 1 << 30
+)
+
+var (
+	supportedCompressions = []rpc.CompressionType{
+		rpc.CompressionType_SNAPPY,
+		rpc.CompressionType_ZSTD,
+	}
+
+	// zstdEncoder and zstdDecoder are safe for concurrent use when encoding
+	// and decoding whole payloads.
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxDecompressedSize))
+)
+
+// SupportedCompressions returns the compression types supported for
+// payloads, in order of preference.
+func SupportedCompressions() []rpc.CompressionType {
+	return supportedCompressions
+}
+
+// IsSupportedCompression returns whether a compression type is both
+// supported locally and contained in the given compression types.
+func IsSupportedCompression(
+	t rpc.CompressionType,
+	compressions []rpc.CompressionType,
+) bool {
+	if t == rpc.CompressionType_NONE {
+		return false
+	}
+	var supported bool
+	for _, s := range supportedCompressions {
+		if s == t {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return false
+	}
+	for _, c := range compressions {
+		if c == t {
+			return true
+		}
+	}
+	return false
+}
+
+// CompressWriteTaggedBatchRawV2Request returns a request holding the
+// compressed contents of the given request.
+func CompressWriteTaggedBatchRawV2Request(
+	req *rpc.WriteTaggedBatchRawV2Request,
+	t rpc.CompressionType,
+) (*rpc.WriteTaggedBatchRawV2Request, error) {
+	compressed, err := compressStruct(req, t)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.WriteTaggedBatchRawV2Request{
+		NameSpaces:  [][]byte{},
+		Elements:    []*rpc.WriteTaggedBatchRawV2RequestElement{},
+		Compression: t,
+		Compressed:  compressed,
+	}, nil
+}
+
+// DecompressWriteTaggedBatchRawV2Request returns the decompressed request
+// if the given request is compressed, otherwise the request itself.
+func DecompressWriteTaggedBatchRawV2Request(
+	req *rpc.WriteTaggedBatchRawV2Request,
+) (*rpc.WriteTaggedBatchRawV2Request, error) {
+	if req.Compression == rpc.CompressionType_NONE {
+		return req, nil
+	}
+	result := rpc.NewWriteTaggedBatchRawV2Request()
+	if err := decompressStruct(result, req.Compression, req.Compressed); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CompressFetchTaggedResult returns a result holding the compressed contents
+// of the given result.
+func CompressFetchTaggedResult(
+	result *rpc.FetchTaggedResult_,
+	t rpc.CompressionType,
+) (*rpc.FetchTaggedResult_, error) {
+	compressed, err := compressStruct(result, t)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.FetchTaggedResult_{
+		Elements:    []*rpc.FetchTaggedIDResult_{},
+		Exhaustive:  result.Exhaustive,
+		Compression: t,
+		Compressed:  compressed,
+	}, nil
+}
+
+// DecompressFetchTaggedResult returns the decompressed result if the given
+// result is compressed, otherwise the result itself.
+func DecompressFetchTaggedResult(
+	result *rpc.FetchTaggedResult_,
+) (*rpc.FetchTaggedResult_, error) {
+	if result.Compression == rpc.CompressionType_NONE {
+		return result, nil
+	}
+	decompressed := rpc.NewFetchTaggedResult_()
+	if err := decompressStruct(decompressed, result.Compression, result.Compressed); err != nil {
+		return nil, err
+	}
+	return decompressed, nil
+}
+
+func compressStruct(s thrift.TStruct, t rpc.CompressionType) ([]byte, error) {
+	if t != rpc.CompressionType_SNAPPY && t != rpc.CompressionType_ZSTD {
+		return nil, fmt.Errorf("unsupported compression type: %v", t)
+	}
+	serialized, err := thrift.NewTSerializer().Write(s)
+	if err != nil {
+		return nil, err
+	}
+	if t == rpc.CompressionType_ZSTD {
+		return zstdEncoder.EncodeAll(serialized, nil), nil
+	}
+	return snappy.Encode(nil, serialized), nil
+}
+
+func decompressStruct(s thrift.TStruct, t rpc.CompressionType, b []byte) error {
+	var (
+		serialized []byte
+		err        error
+	)
+	switch t {
+	case rpc.CompressionType_SNAPPY:
+		serialized, err = decompressSnappy(b)
+	case rpc.CompressionType_ZSTD:
+		// The decoder fails payloads decompressing to more than the
+		// maximum decompressed size.
+		serialized, err = zstdDecoder.DecodeAll(b, nil)
+	default:
+		return fmt.Errorf("unsupported compression type: %v", t)
+	}
+	if err != nil {
+		return err
+	}
+	return thrift.NewTDeserializer().Read(s, serialized)
+}
+
+func decompressSnappy(b []byte) ([]byte, error) {
+	size, err := snappy.DecodedLen(b)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxDecompressedSize {
+		return nil, fmt.Errorf("decompressed size %d is larger than maximum supported size %d",
+			size, maxDecompressedSize)
+	}
+	return snappy.Decode(nil, b)
+}
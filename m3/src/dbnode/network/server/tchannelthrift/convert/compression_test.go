@@ -0,0 +1,132 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package convert_test
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testCompressions = []rpc.CompressionType{
+	rpc.CompressionType_SNAPPY,
+	rpc.CompressionType_ZSTD,
+}
+
+func TestCompressFetchTaggedResultRoundTrip(t *testing.T) {
+	for _, compression := range testCompressions {
+		t.Run(compression.String(), func(t *testing.T) {
+			testCompressFetchTaggedResultRoundTrip(t, compression)
+		})
+	}
+}
+
+func testCompressFetchTaggedResultRoundTrip(t *testing.T, compression rpc.CompressionType) {
+	result := &rpc.FetchTaggedResult_{
+		Exhaustive: true,
+		Elements: []*rpc.FetchTaggedIDResult_{
+			{
+				ID:          []byte("foo"),
+				NameSpace:   []byte("metrics"),
+				EncodedTags: []byte("foo=bar"),
+				Segments: []*rpc.Segments{
+					{Merged: &rpc.Segment{Head: []byte("head"), Tail: []byte("tail")}},
+				},
+			},
+			{
+				ID:          []byte("bar"),
+				NameSpace:   []byte("metrics"),
+				EncodedTags: []byte("bar=baz"),
+				Err:         &rpc.Error{Type: rpc.ErrorType_BAD_REQUEST, Message: "bad"},
+			},
+		},
+	}
+
+	compressed, err := convert.CompressFetchTaggedResult(result, compression)
+	require.NoError(t, err)
+	require.Equal(t, compression, compressed.Compression)
+	require.Equal(t, 0, len(compressed.Elements))
+	require.True(t, compressed.Exhaustive)
+
+	decompressed, err := convert.DecompressFetchTaggedResult(compressed)
+	require.NoError(t, err)
+	require.Equal(t, result, decompressed)
+
+	// Results that are not compressed are returned as is.
+	same, err := convert.DecompressFetchTaggedResult(result)
+	require.NoError(t, err)
+	require.True(t, same == result)
+}
+
+func TestCompressWriteTaggedBatchRawV2RequestRoundTrip(t *testing.T) {
+	for _, compression := range testCompressions {
+		t.Run(compression.String(), func(t *testing.T) {
+			testCompressWriteTaggedBatchRawV2RequestRoundTrip(t, compression)
+		})
+	}
+}
+
+func testCompressWriteTaggedBatchRawV2RequestRoundTrip(t *testing.T, compression rpc.CompressionType) {
+	req := &rpc.WriteTaggedBatchRawV2Request{
+		NameSpaces: [][]byte{[]byte("metrics")},
+		Elements: []*rpc.WriteTaggedBatchRawV2RequestElement{
+			{
+				NameSpace:   0,
+				ID:          []byte("foo"),
+				EncodedTags: []byte("foo=bar"),
+				Datapoint: &rpc.Datapoint{
+					Timestamp:         1,
+					TimestampTimeType: rpc.TimeType_UNIX_SECONDS,
+					Value:             42,
+				},
+			},
+		},
+	}
+
+	compressed, err := convert.CompressWriteTaggedBatchRawV2Request(req, compression)
+	require.NoError(t, err)
+	require.Equal(t, compression, compressed.Compression)
+	require.Equal(t, 0, len(compressed.NameSpaces))
+	require.Equal(t, 0, len(compressed.Elements))
+
+	decompressed, err := convert.DecompressWriteTaggedBatchRawV2Request(compressed)
+	require.NoError(t, err)
+	require.Equal(t, req, decompressed)
+
+	compressed.Compressed = []byte("corrupt")
+	_, err = convert.DecompressWriteTaggedBatchRawV2Request(compressed)
+	require.Error(t, err)
+}
+
+func TestIsSupportedCompression(t *testing.T) {
+	snappy := []rpc.CompressionType{rpc.CompressionType_SNAPPY}
+	require.True(t, convert.IsSupportedCompression(rpc.CompressionType_SNAPPY, snappy))
+	require.False(t, convert.IsSupportedCompression(rpc.CompressionType_SNAPPY, nil))
+	require.False(t, convert.IsSupportedCompression(rpc.CompressionType_ZSTD, snappy))
+	require.True(t, convert.IsSupportedCompression(rpc.CompressionType_ZSTD,
+		[]rpc.CompressionType{rpc.CompressionType_SNAPPY, rpc.CompressionType_ZSTD}))
+	require.False(t, convert.IsSupportedCompression(rpc.CompressionType_NONE, snappy))
+	require.False(t, convert.IsSupportedCompression(rpc.CompressionType(100),
+		[]rpc.CompressionType{rpc.CompressionType(100)}))
+}
@@ -289,6 +289,225 @@ func ToRPCFetchTaggedRequest(
 	return request, nil
 }
 
+// FromRPCDeleteSeriesRequest converts the rpc request type for DeleteSeriesRequest into corresponding Go API types.
+func FromRPCDeleteSeriesRequest(
+	req *rpc.DeleteSeriesRequest,
+) (ident.ID, index.Query, time.Time, time.Time, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, fetchTaggedTimeType)
+	if rangeStartErr != nil {
+		return nil, index.Query{}, time.Time{}, time.Time{}, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, fetchTaggedTimeType)
+	if rangeEndErr != nil {
+		return nil, index.Query{}, time.Time{}, time.Time{}, rangeEndErr
+	}
+
+	q, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return nil, index.Query{}, time.Time{}, time.Time{}, err
+	}
+
+	ns := ident.StringID(string(req.NameSpace))
+	return ns, index.Query{Query: q}, start, end, nil
+}
+
+// ToRPCDeleteSeriesRequest converts the Go `client/` types into rpc request type for DeleteSeriesRequest.
+func ToRPCDeleteSeriesRequest(
+	ns ident.ID,
+	q index.Query,
+	start, end time.Time,
+) (rpc.DeleteSeriesRequest, error) {
+	rangeStart, tsErr := ToValue(start, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.DeleteSeriesRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(end, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.DeleteSeriesRequest{}, tsErr
+	}
+
+	query, queryErr := idx.Marshal(q.Query)
+	if queryErr != nil {
+		return rpc.DeleteSeriesRequest{}, queryErr
+	}
+
+	return rpc.DeleteSeriesRequest{
+		NameSpace:  ns.Bytes(),
+		Query:      query,
+		RangeStart: rangeStart,
+		RangeEnd:   rangeEnd,
+	}, nil
+}
+
+// FromRPCIndexCardinalityRequest converts the rpc request type for IndexCardinalityRequest into corresponding Go API types.
+func FromRPCIndexCardinalityRequest(
+	req *rpc.IndexCardinalityRequest,
+) (ident.ID, time.Time, time.Time, index.CardinalityOptions, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, fetchTaggedTimeType)
+	if rangeStartErr != nil {
+		return nil, time.Time{}, time.Time{}, index.CardinalityOptions{}, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, fetchTaggedTimeType)
+	if rangeEndErr != nil {
+		return nil, time.Time{}, time.Time{}, index.CardinalityOptions{}, rangeEndErr
+	}
+
+	opts := index.CardinalityOptions{
+		Limit:         int(req.Limit),
+		MetricNameTag: req.MetricNameTag,
+	}
+	if req.IsSetQuery() {
+		q, err := idx.Unmarshal(req.Query)
+		if err != nil {
+			return nil, time.Time{}, time.Time{}, index.CardinalityOptions{}, err
+		}
+		opts.Query = &index.Query{Query: q}
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, time.Time{}, time.Time{}, index.CardinalityOptions{}, err
+	}
+
+	ns := ident.StringID(string(req.NameSpace))
+	return ns, start, end, opts, nil
+}
+
+// ToRPCIndexCardinalityRequest converts the Go `client/` types into rpc request type for IndexCardinalityRequest.
+func ToRPCIndexCardinalityRequest(
+	ns ident.ID,
+	start, end time.Time,
+	opts index.CardinalityOptions,
+) (rpc.IndexCardinalityRequest, error) {
+	rangeStart, tsErr := ToValue(start, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.IndexCardinalityRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(end, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.IndexCardinalityRequest{}, tsErr
+	}
+
+	var query []byte
+	if opts.Query != nil {
+		var err error
+		query, err = idx.Marshal(opts.Query.Query)
+		if err != nil {
+			return rpc.IndexCardinalityRequest{}, err
+		}
+	}
+
+	return rpc.IndexCardinalityRequest{
+		NameSpace:     ns.Bytes(),
+		RangeStart:    rangeStart,
+		RangeEnd:      rangeEnd,
+		Limit:         int64(opts.Limit),
+		MetricNameTag: opts.MetricNameTag,
+		Query:         query,
+	}, nil
+}
+
+// ToRPCIndexCardinalityResult converts the index block cardinality into the rpc result type.
+func ToRPCIndexCardinalityResult(
+	blocks []index.BlockCardinality,
+) (*rpc.IndexCardinalityResult_, error) {
+	res := rpc.NewIndexCardinalityResult_()
+	res.Blocks = make([]*rpc.IndexBlockCardinality, 0, len(blocks))
+	for _, b := range blocks {
+		blockStart, err := ToValue(b.BlockStart, fetchTaggedTimeType)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Blocks = append(res.Blocks, &rpc.IndexBlockCardinality{
+			BlockStart:                  blockStart,
+			NumSeries:                   b.NumSeries,
+			SeriesCountByMetricName:     toRPCCardinalityEntries(b.SeriesCountByMetricName),
+			SeriesCountByLabelName:      toRPCCardinalityEntries(b.SeriesCountByLabelName),
+			SeriesCountByLabelValuePair: toRPCCardinalityEntries(b.SeriesCountByLabelValuePair),
+			LabelValueCountByLabelName:  toRPCCardinalityEntries(b.LabelValueCountByLabelName),
+			LabelValuesByLabelName:      toRPCCardinalityLabelValues(b.LabelValuesByLabelName),
+		})
+	}
+	return res, nil
+}
+
+func toRPCCardinalityEntries(entries []index.CardinalityEntry) []*rpc.IndexCardinalityEntry {
+	result := make([]*rpc.IndexCardinalityEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, &rpc.IndexCardinalityEntry{
+			Name:  e.Name,
+			Value: e.Value,
+			Count: e.Count,
+		})
+	}
+	return result
+}
+
+func toRPCCardinalityLabelValues(
+	labelValues []index.CardinalityLabelValues,
+) []*rpc.IndexCardinalityLabelValues {
+	result := make([]*rpc.IndexCardinalityLabelValues, 0, len(labelValues))
+	for _, lv := range labelValues {
+		result = append(result, &rpc.IndexCardinalityLabelValues{
+			Name:   lv.Name,
+			Values: lv.Values,
+		})
+	}
+	return result
+}
+
+// FromRPCIndexCardinalityResult converts the rpc result type for IndexCardinalityResult into the index block cardinality.
+func FromRPCIndexCardinalityResult(
+	res *rpc.IndexCardinalityResult_,
+) ([]index.BlockCardinality, error) {
+	blocks := make([]index.BlockCardinality, 0, len(res.Blocks))
+	for _, b := range res.Blocks {
+		blockStart, err := ToTime(b.BlockStart, fetchTaggedTimeType)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, index.BlockCardinality{
+			BlockStart:                  blockStart,
+			NumSeries:                   b.NumSeries,
+			SeriesCountByMetricName:     fromRPCCardinalityEntries(b.SeriesCountByMetricName),
+			SeriesCountByLabelName:      fromRPCCardinalityEntries(b.SeriesCountByLabelName),
+			SeriesCountByLabelValuePair: fromRPCCardinalityEntries(b.SeriesCountByLabelValuePair),
+			LabelValueCountByLabelName:  fromRPCCardinalityEntries(b.LabelValueCountByLabelName),
+			LabelValuesByLabelName:      fromRPCCardinalityLabelValues(b.LabelValuesByLabelName),
+		})
+	}
+	return blocks, nil
+}
+
+func fromRPCCardinalityLabelValues(
+	labelValues []*rpc.IndexCardinalityLabelValues,
+) []index.CardinalityLabelValues {
+	result := make([]index.CardinalityLabelValues, 0, len(labelValues))
+	for _, lv := range labelValues {
+		result = append(result, index.CardinalityLabelValues{
+			Name:   lv.Name,
+			Values: lv.Values,
+		})
+	}
+	return result
+}
+
+func fromRPCCardinalityEntries(entries []*rpc.IndexCardinalityEntry) []index.CardinalityEntry {
+	result := make([]index.CardinalityEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, index.CardinalityEntry{
+			Name:  e.Name,
+			Value: e.Value,
+			Count: e.Count,
+		})
+	}
+	return result
+}
+
 // FromRPCAggregateQueryRequest converts the rpc request type for AggregateRawQueryRequest into corresponding Go API types.
 func FromRPCAggregateQueryRequest(
 	req *rpc.AggregateQueryRequest,
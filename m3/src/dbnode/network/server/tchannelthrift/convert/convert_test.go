@@ -165,6 +165,108 @@ func TestConvertFetchTaggedRequest(t *testing.T) {
 	}
 }
 
+func TestConvertDeleteSeriesRequest(t *testing.T) {
+	ns := ident.StringID("abc")
+	start := time.Now().Add(-900 * time.Hour)
+	end := time.Now()
+
+	q, rpcQ := termQueryTestCase(t)
+	expectedReq := rpc.DeleteSeriesRequest{
+		NameSpace:  ns.Bytes(),
+		Query:      rpcQ,
+		RangeStart: mustToRpcTime(t, start),
+		RangeEnd:   mustToRpcTime(t, end),
+	}
+
+	observedReq, err := convert.ToRPCDeleteSeriesRequest(ns, index.Query{Query: q}, start, end)
+	require.NoError(t, err)
+	require.Equal(t, expectedReq, observedReq)
+
+	id, observedQuery, observedStart, observedEnd, err := convert.FromRPCDeleteSeriesRequest(&observedReq)
+	require.NoError(t, err)
+	require.Equal(t, ns.String(), id.String())
+	require.True(t, index.NewQueryMatcher(index.Query{Query: q}).Matches(observedQuery))
+	require.True(t, start.Equal(observedStart))
+	require.True(t, end.Equal(observedEnd))
+}
+
+func TestConvertIndexCardinalityRequest(t *testing.T) {
+	ns := ident.StringID("abc")
+	start := time.Now().Add(-900 * time.Hour)
+	end := time.Now()
+	opts := index.CardinalityOptions{
+		Limit:         5,
+		MetricNameTag: []byte("__name__"),
+	}
+
+	expectedReq := rpc.IndexCardinalityRequest{
+		NameSpace:     ns.Bytes(),
+		RangeStart:    mustToRpcTime(t, start),
+		RangeEnd:      mustToRpcTime(t, end),
+		Limit:         5,
+		MetricNameTag: []byte("__name__"),
+	}
+
+	observedReq, err := convert.ToRPCIndexCardinalityRequest(ns, start, end, opts)
+	require.NoError(t, err)
+	require.Equal(t, expectedReq, observedReq)
+
+	id, observedStart, observedEnd, observedOpts, err := convert.FromRPCIndexCardinalityRequest(&observedReq)
+	require.NoError(t, err)
+	require.Equal(t, ns.String(), id.String())
+	require.True(t, start.Equal(observedStart))
+	require.True(t, end.Equal(observedEnd))
+	require.Equal(t, opts, observedOpts)
+
+	opts.Query = &index.Query{Query: idx.NewTermQuery([]byte("tenant"), []byte("a"))}
+	observedReq, err = convert.ToRPCIndexCardinalityRequest(ns, start, end, opts)
+	require.NoError(t, err)
+	require.True(t, observedReq.IsSetQuery())
+	_, _, _, observedOpts, err = convert.FromRPCIndexCardinalityRequest(&observedReq)
+	require.NoError(t, err)
+	require.NotNil(t, observedOpts.Query)
+	require.True(t, opts.Query.Equal(observedOpts.Query.Query))
+
+	observedReq.Limit = 0
+	_, _, _, _, err = convert.FromRPCIndexCardinalityRequest(&observedReq)
+	require.Error(t, err)
+}
+
+func TestConvertIndexCardinalityResult(t *testing.T) {
+	blockStart := time.Unix(0, time.Now().Truncate(time.Hour).UnixNano())
+	blocks := []index.BlockCardinality{
+		{
+			BlockStart: blockStart,
+			NumSeries:  3,
+			SeriesCountByMetricName: []index.CardinalityEntry{
+				{Name: []byte("up"), Count: 2},
+			},
+			SeriesCountByLabelName: []index.CardinalityEntry{
+				{Name: []byte("job"), Count: 3},
+			},
+			SeriesCountByLabelValuePair: []index.CardinalityEntry{
+				{Name: []byte("job"), Value: []byte("api"), Count: 2},
+			},
+			LabelValueCountByLabelName: []index.CardinalityEntry{
+				{Name: []byte("job"), Count: 2},
+			},
+			LabelValuesByLabelName: []index.CardinalityLabelValues{
+				{Name: []byte("job"), Values: [][]byte{[]byte("api"), []byte("db")}},
+			},
+		},
+	}
+
+	res, err := convert.ToRPCIndexCardinalityResult(blocks)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Blocks))
+	require.Equal(t, mustToRpcTime(t, blockStart), res.Blocks[0].BlockStart)
+	require.False(t, res.Blocks[0].SeriesCountByMetricName[0].IsSetValue())
+
+	observed, err := convert.FromRPCIndexCardinalityResult(res)
+	require.NoError(t, err)
+	require.Equal(t, blocks, observed)
+}
+
 func TestConvertAggregateRawQueryRequest(t *testing.T) {
 	ns := ident.StringID("abc")
 	opts := index.AggregationOptions{
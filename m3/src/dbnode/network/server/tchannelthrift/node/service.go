@@ -111,6 +111,8 @@ type serviceMetrics struct {
 	fetchBlocksMetadata     instrument.MethodMetrics
 	repair                  instrument.MethodMetrics
 	truncate                instrument.MethodMetrics
+	deleteSeries            instrument.MethodMetrics
+	indexCardinality        instrument.MethodMetrics
 	fetchBatchRawRPCS       tally.Counter
 	fetchBatchRaw           instrument.BatchMethodMetrics
 	writeBatchRawRPCs       tally.Counter
@@ -131,6 +133,8 @@ func newServiceMetrics(scope tally.Scope, opts instrument.TimerOptions) serviceM
 		fetchBlocksMetadata:     instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", opts),
 		repair:                  instrument.NewMethodMetrics(scope, "repair", opts),
 		truncate:                instrument.NewMethodMetrics(scope, "truncate", opts),
+		deleteSeries:            instrument.NewMethodMetrics(scope, "deleteSeries", opts),
+		indexCardinality:        instrument.NewMethodMetrics(scope, "indexCardinality", opts),
 		fetchBatchRawRPCS:       scope.Counter("fetchBatchRaw-rpcs"),
 		fetchBatchRaw:           instrument.NewBatchMethodMetrics(scope, "fetchBatchRaw", opts),
 		writeBatchRawRPCs:       scope.Counter("writeBatchRaw-rpcs"),
@@ -304,6 +308,7 @@ func NewService(db storage.Database, opts tchannelthrift.Options) Service {
 				Ok:           true,
 				Status:       "up",
 				Bootstrapped: false,
+				Compressions: convert.SupportedCompressions(),
 			},
 			maxOutstandingWriteRPCs: opts.MaxOutstandingWriteRequests(),
 			maxOutstandingReadRPCs:  opts.MaxOutstandingReadRequests(),
@@ -688,6 +693,17 @@ func (s *service) fetchTagged(ctx context.Context, db storage.Database, req *rpc
 		s.fetchReadResults(ctx, response, nsID, encodedDataResults)
 	}
 
+	// Compress the response if the client accepts it, clients that are
+	// unaware of compression never set the accepted compression.
+	if convert.IsSupportedCompression(req.AcceptCompression, convert.SupportedCompressions()) {
+		compressed, err := convert.CompressFetchTaggedResult(response, req.AcceptCompression)
+		if err != nil {
+			s.metrics.fetchTagged.ReportError(s.nowFn().Sub(callStart))
+			return nil, convert.ToRPCError(err)
+		}
+		response = compressed
+	}
+
 	s.metrics.fetchTagged.ReportSuccess(s.nowFn().Sub(callStart))
 	return response, nil
 }
@@ -1662,6 +1678,11 @@ func (s *service) WriteTaggedBatchRawV2(tctx thrift.Context, req *rpc.WriteTagge
 	}
 	defer s.writeRPCCompleted()
 
+	req, err = convert.DecompressWriteTaggedBatchRawV2Request(req)
+	if err != nil {
+		return tterrors.NewBadRequestError(err)
+	}
+
 	callStart := s.nowFn()
 	ctx := tchannelthrift.Context(tctx)
 
@@ -1819,6 +1840,74 @@ func (s *service) Truncate(tctx thrift.Context, req *rpc.TruncateRequest) (r *rp
 	return res, nil
 }
 
+func (s *service) DeleteSeries(tctx thrift.Context, req *rpc.DeleteSeriesRequest) (*rpc.DeleteSeriesResult_, error) {
+	db, err := s.startRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	ns, query, start, end, err := convert.FromRPCDeleteSeriesRequest(req)
+	if err != nil {
+		s.metrics.deleteSeries.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	deleted, err := db.DeleteSeries(ctx, ns, query, start, end)
+	if err != nil {
+		s.metrics.deleteSeries.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	res := rpc.NewDeleteSeriesResult_()
+	res.Shards = make([]*rpc.DeleteSeriesShardResult, 0, len(deleted))
+	for shard, numSeries := range deleted {
+		res.NumSeries += numSeries
+		res.Shards = append(res.Shards, &rpc.DeleteSeriesShardResult{
+			Shard:     int32(shard),
+			NumSeries: numSeries,
+		})
+	}
+
+	s.metrics.deleteSeries.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return res, nil
+}
+
+func (s *service) IndexCardinality(tctx thrift.Context, req *rpc.IndexCardinalityRequest) (*rpc.IndexCardinalityResult_, error) {
+	db, err := s.startRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	ns, start, end, opts, err := convert.FromRPCIndexCardinalityRequest(req)
+	if err != nil {
+		s.metrics.indexCardinality.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	blocks, err := db.IndexCardinality(ctx, ns, start, end, opts)
+	if err != nil {
+		s.metrics.indexCardinality.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	res, err := convert.ToRPCIndexCardinalityResult(blocks)
+	if err != nil {
+		s.metrics.indexCardinality.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	s.metrics.indexCardinality.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return res, nil
+}
+
 func (s *service) GetPersistRateLimit(
 	ctx thrift.Context,
 ) (*rpc.NodePersistRateLimitResult_, error) {
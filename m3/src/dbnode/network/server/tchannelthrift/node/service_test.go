@@ -92,6 +92,10 @@ func TestServiceHealth(t *testing.T) {
 	assert.Equal(t, true, result.Ok)
 	assert.Equal(t, "up", result.Status)
 	assert.Equal(t, false, result.Bootstrapped)
+	assert.Equal(t, []rpc.CompressionType{
+		rpc.CompressionType_SNAPPY,
+		rpc.CompressionType_ZSTD,
+	}, result.Compressions)
 
 	// Assert bootstrapped true
 	mockDB.EXPECT().IsBootstrappedAndDurable().Return(true)
@@ -2662,6 +2666,84 @@ func TestServiceWriteTaggedBatchRawV2(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestServiceWriteTaggedBatchRawV2Compressed(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+
+	mockDecoder := serialize.NewMockTagDecoder(ctrl)
+	mockDecoder.EXPECT().Reset(gomock.Any()).AnyTimes()
+	mockDecoder.EXPECT().Err().Return(nil).AnyTimes()
+	mockDecoder.EXPECT().Close().AnyTimes()
+	mockDecoderPool := serialize.NewMockTagDecoderPool(ctrl)
+	mockDecoderPool.EXPECT().Get().Return(mockDecoder).AnyTimes()
+
+	opts := tchannelthrift.NewOptions().
+		SetTagDecoderPool(mockDecoderPool)
+
+	service := NewService(mockDB, opts).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	nsID := "metrics"
+
+	values := []struct {
+		id        string
+		tagEncode string
+		t         time.Time
+		v         float64
+	}{
+		{"foo", "a|b", time.Now().Truncate(time.Second), 12.34},
+		{"bar", "c|dd", time.Now().Truncate(time.Second), 42.42},
+	}
+
+	writeBatch := writes.NewWriteBatch(len(values), ident.StringID(nsID), nil)
+	mockDB.EXPECT().
+		BatchWriter(ident.NewIDMatcher(nsID), len(values)).
+		Return(writeBatch, nil)
+
+	mockDB.EXPECT().
+		WriteTaggedBatch(ctx, ident.NewIDMatcher(nsID), writeBatch, gomock.Any()).
+		Return(nil)
+
+	var elements []*rpc.WriteTaggedBatchRawV2RequestElement
+	for _, w := range values {
+		elem := &rpc.WriteTaggedBatchRawV2RequestElement{
+			NameSpace:   0,
+			ID:          []byte(w.id),
+			EncodedTags: []byte(w.tagEncode),
+			Datapoint: &rpc.Datapoint{
+				Timestamp:         w.t.Unix(),
+				TimestampTimeType: rpc.TimeType_UNIX_SECONDS,
+				Value:             w.v,
+			},
+		}
+		elements = append(elements, elem)
+	}
+
+	req, err := convert.CompressWriteTaggedBatchRawV2Request(&rpc.WriteTaggedBatchRawV2Request{
+		NameSpaces: [][]byte{[]byte(nsID)},
+		Elements:   elements,
+	}, rpc.CompressionType_SNAPPY)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(req.Elements))
+
+	mockDB.EXPECT().IsOverloaded().Return(false)
+	err = service.WriteTaggedBatchRawV2(tctx, req)
+	require.NoError(t, err)
+
+	batchWrites := writeBatch.Iter()
+	require.Equal(t, len(values), len(batchWrites))
+	for i, w := range values {
+		assert.Equal(t, w.id, batchWrites[i].Write.Series.ID.String())
+		assert.Equal(t, w.v, batchWrites[i].Write.Datapoint.Value)
+	}
+}
+
 func TestServiceWriteTaggedBatchRawV2MultiNS(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -2884,6 +2966,124 @@ func TestServiceTruncate(t *testing.T) {
 	assert.Equal(t, truncated, r.NumSeries)
 }
 
+func TestServiceDeleteSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	var (
+		nsID    = "metrics"
+		start   = time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+		end     = start.Add(time.Hour)
+		deleted = map[uint32]int64{1: 40, 2: 2}
+	)
+
+	req, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+	qry := index.Query{Query: req}
+
+	mockDB.EXPECT().DeleteSeries(
+		ctx,
+		ident.NewIDMatcher(nsID),
+		index.NewQueryMatcher(qry),
+		start,
+		end,
+	).Return(deleted, nil)
+
+	query, err := idx.Marshal(req)
+	require.NoError(t, err)
+
+	r, err := service.DeleteSeries(tctx, &rpc.DeleteSeriesRequest{
+		NameSpace:  []byte(nsID),
+		Query:      query,
+		RangeStart: start.UnixNano(),
+		RangeEnd:   end.UnixNano(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), r.NumSeries)
+	sort.Slice(r.Shards, func(i, j int) bool {
+		return r.Shards[i].Shard < r.Shards[j].Shard
+	})
+	assert.Equal(t, []*rpc.DeleteSeriesShardResult{
+		{Shard: 1, NumSeries: 40},
+		{Shard: 2, NumSeries: 2},
+	}, r.Shards)
+}
+
+func TestServiceIndexCardinality(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false).Times(2)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	var (
+		nsID  = "metrics"
+		start = time.Now().Add(-2 * time.Hour).Truncate(time.Hour)
+		end   = start.Add(2 * time.Hour)
+		opts  = index.CardinalityOptions{
+			Limit:         10,
+			MetricNameTag: []byte("__name__"),
+		}
+	)
+
+	mockDB.EXPECT().IndexCardinality(
+		ctx,
+		ident.NewIDMatcher(nsID),
+		start,
+		end,
+		opts,
+	).Return([]index.BlockCardinality{
+		{
+			BlockStart: start,
+			NumSeries:  2,
+			SeriesCountByMetricName: []index.CardinalityEntry{
+				{Name: []byte("up"), Count: 2},
+			},
+		},
+	}, nil)
+
+	r, err := service.IndexCardinality(tctx, &rpc.IndexCardinalityRequest{
+		NameSpace:     []byte(nsID),
+		RangeStart:    start.UnixNano(),
+		RangeEnd:      end.UnixNano(),
+		Limit:         10,
+		MetricNameTag: []byte("__name__"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(r.Blocks))
+	assert.Equal(t, start.UnixNano(), r.Blocks[0].BlockStart)
+	assert.Equal(t, int64(2), r.Blocks[0].NumSeries)
+	assert.Equal(t, []*rpc.IndexCardinalityEntry{
+		{Name: []byte("up"), Count: 2},
+	}, r.Blocks[0].SeriesCountByMetricName)
+	assert.Equal(t, 0, len(r.Blocks[0].SeriesCountByLabelName))
+
+	_, err = service.IndexCardinality(tctx, &rpc.IndexCardinalityRequest{
+		NameSpace:  []byte(nsID),
+		RangeStart: start.UnixNano(),
+		RangeEnd:   end.UnixNano(),
+	})
+	require.Error(t, err)
+	require.True(t, tterrors.IsBadRequestError(err.(*rpc.Error)))
+}
+
 func TestServiceSetPersistRateLimit(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
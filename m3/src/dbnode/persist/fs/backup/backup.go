@@ -0,0 +1,409 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/adler32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+const (
+	backupIDFormat = "20060102T150405.000Z"
+
+	manifestsPrefix = "backups"
+	fileSetsPrefix  = "filesets"
+	manifestSuffix  = ".json"
+)
+
+type backuper struct {
+	opts           Options
+	store          objectstore.Store
+	hostID         string
+	filePathPrefix string
+	logger         *zap.Logger
+}
+
+// NewBackuper returns a new Backuper of the file path prefix of the
+// filesystem options.
+func NewBackuper(opts Options) (Backuper, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return &backuper{
+		opts:           opts,
+		store:          opts.Store(),
+		hostID:         opts.HostID(),
+		filePathPrefix: opts.FilesystemOptions().FilePathPrefix(),
+		logger:         opts.InstrumentOptions().Logger(),
+	}, nil
+}
+
+// localFileSet is a fileset on disk along with the absolute paths of its
+// files.
+type localFileSet struct {
+	FileSet
+	paths []string
+}
+
+func (b *backuper) Backup() (BackupResult, error) {
+	now := b.opts.ClockOptions().NowFn()().UTC()
+	manifest := Manifest{
+		ID:        now.Format(backupIDFormat),
+		HostID:    b.hostID,
+		CreatedAt: now,
+	}
+
+	// Filesets whose volume was uploaded by the previous backup with the
+	// same digest are referenced rather than uploaded again.
+	uploaded := make(map[string]FileSet)
+	previous, ok, err := latestManifest(b.store, b.hostID)
+	if err != nil {
+		return BackupResult{}, err
+	}
+	if ok {
+		manifest.Previous = previous.ID
+		for _, fileSet := range previous.FileSets {
+			uploaded[fileSetKey(fileSet)] = fileSet
+		}
+	}
+
+	if registry := b.opts.NamespaceRegistry(); registry != nil {
+		if manifest.Namespaces, err = marshalJSONPB(registry); err != nil {
+			return BackupResult{}, err
+		}
+	}
+	if placement := b.opts.Placement(); placement != nil {
+		if manifest.Placement, err = marshalJSONPB(placement); err != nil {
+			return BackupResult{}, err
+		}
+	}
+
+	fileSets, err := b.localFileSets()
+	if err != nil {
+		return BackupResult{}, err
+	}
+
+	result := BackupResult{}
+	for _, fileSet := range fileSets {
+		if prev, ok := uploaded[fileSetKey(fileSet.FileSet)]; ok && prev.Digest == fileSet.Digest {
+			manifest.FileSets = append(manifest.FileSets, prev)
+			result.FileSetsSkipped++
+			continue
+		}
+
+		backedUp, ok, err := b.upload(fileSet)
+		if err != nil {
+			return BackupResult{}, err
+		}
+		if !ok {
+			continue
+		}
+		for _, file := range backedUp.Files {
+			result.BytesUploaded += file.Size
+		}
+		manifest.FileSets = append(manifest.FileSets, backedUp)
+		result.FileSetsUploaded++
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return BackupResult{}, err
+	}
+	if err := b.store.Put(manifestKey(b.hostID, manifest.ID),
+		bytes.NewReader(data), int64(len(data))); err != nil {
+		return BackupResult{}, fmt.Errorf("could not upload manifest: %v", err)
+	}
+
+	b.logger.Info("backup complete",
+		zap.String("id", manifest.ID),
+		zap.String("hostID", b.hostID),
+		zap.Int("fileSetsUploaded", result.FileSetsUploaded),
+		zap.Int("fileSetsSkipped", result.FileSetsSkipped),
+		zap.Int64("bytesUploaded", result.BytesUploaded))
+
+	result.Manifest = manifest
+	return result, nil
+}
+
+// upload uploads the files of a fileset, it returns false if the fileset
+// was removed from disk while being uploaded, e.g. by a cleanup.
+func (b *backuper) upload(fileSet localFileSet) (FileSet, bool, error) {
+	backedUp := fileSet.FileSet
+	for _, filePath := range fileSet.paths {
+		rel, err := filepath.Rel(b.filePathPrefix, filePath)
+		if err != nil {
+			return FileSet{}, false, err
+		}
+		rel = filepath.ToSlash(rel)
+
+		file := File{
+			Path: rel,
+			Key: path.Join(fileSetsPrefix, b.hostID, path.Dir(rel),
+				fmt.Sprintf("%08x", fileSet.Digest), path.Base(rel)),
+		}
+		file.Size, file.Checksum, err = b.uploadFile(filePath, file.Key)
+		if os.IsNotExist(err) {
+			b.logger.Warn("fileset removed while being backed up",
+				zap.String("path", filePath))
+			return FileSet{}, false, nil
+		}
+		if err != nil {
+			return FileSet{}, false, fmt.Errorf("could not upload %s: %v", filePath, err)
+		}
+
+		backedUp.Files = append(backedUp.Files, file)
+	}
+
+	return backedUp, true, nil
+}
+
+func (b *backuper) uploadFile(filePath, key string) (int64, uint32, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	checksum := adler32.New()
+	if err := b.store.Put(key, io.TeeReader(f, checksum), info.Size()); err != nil {
+		return 0, 0, err
+	}
+	return info.Size(), checksum.Sum32(), nil
+}
+
+// localFileSets returns the complete filesets on disk.
+func (b *backuper) localFileSets() ([]localFileSet, error) {
+	namespaces, err := b.namespaces()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []localFileSet
+	for _, namespace := range namespaces {
+		nsID := ident.StringID(namespace)
+
+		shards, err := b.shards(nsID)
+		if err != nil {
+			return nil, err
+		}
+		for _, shard := range shards {
+			files, err := fs.DataFiles(b.filePathPrefix, nsID, shard)
+			if err != nil {
+				return nil, err
+			}
+			if result, err = appendFileSets(result, DataFileSetType, files); err != nil {
+				return nil, err
+			}
+
+			files, err = fs.SnapshotFiles(b.filePathPrefix, nsID, shard)
+			if err != nil {
+				return nil, err
+			}
+			if result, err = appendFileSets(result, SnapshotFileSetType, files); err != nil {
+				return nil, err
+			}
+		}
+
+		files, err := fs.IndexFiles(b.filePathPrefix, nsID)
+		if err != nil {
+			return nil, err
+		}
+		if result, err = appendFileSets(result, IndexFileSetType, files); err != nil {
+			return nil, err
+		}
+
+		files, err = fs.IndexSnapshotFiles(b.filePathPrefix, nsID)
+		if err != nil {
+			return nil, err
+		}
+		if result, err = appendFileSets(result, IndexSnapshotFileSetType, files); err != nil {
+			return nil, err
+		}
+	}
+
+	metadatas, _, err := fs.SortedSnapshotMetadataFiles(b.opts.FilesystemOptions())
+	if err != nil {
+		return nil, err
+	}
+	for _, metadata := range metadatas {
+		digest, err := fs.ReadCheckpointFile(metadata.CheckpointFilePath)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, localFileSet{
+			FileSet: FileSet{
+				Type:        SnapshotMetadataFileSetType,
+				VolumeIndex: int(metadata.ID.Index),
+				Digest:      digest,
+			},
+			paths: metadata.AbsoluteFilePaths(),
+		})
+	}
+
+	return result, nil
+}
+
+func appendFileSets(
+	result []localFileSet,
+	fileSetType FileSetType,
+	files fs.FileSetFilesSlice,
+) ([]localFileSet, error) {
+	for _, file := range files {
+		if !file.HasCompleteCheckpointFile() {
+			continue
+		}
+		checkpointFilePath, ok := file.CheckpointFilePath()
+		if !ok {
+			continue
+		}
+		digest, err := fs.ReadCheckpointFile(checkpointFilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, localFileSet{
+			FileSet: FileSet{
+				Type:        fileSetType,
+				Namespace:   file.ID.Namespace.String(),
+				Shard:       file.ID.Shard,
+				BlockStart:  file.ID.BlockStart.UTC(),
+				VolumeIndex: file.ID.VolumeIndex,
+				Digest:      digest,
+			},
+			paths: file.AbsoluteFilePaths,
+		})
+	}
+	return result, nil
+}
+
+// namespaces returns the namespaces of the registry if set, otherwise the
+// namespaces that have a directory on disk.
+func (b *backuper) namespaces() ([]string, error) {
+	if registry := b.opts.NamespaceRegistry(); registry != nil {
+		namespaces := make([]string, 0, len(registry.Namespaces))
+		for namespace := range registry.Namespaces {
+			namespaces = append(namespaces, namespace)
+		}
+		sort.Strings(namespaces)
+		return namespaces, nil
+	}
+
+	return subDirectories(
+		fs.DataDirPath(b.filePathPrefix),
+		fs.SnapshotDirPath(b.filePathPrefix),
+		fs.IndexDataDirPath(b.filePathPrefix),
+	)
+}
+
+// shards returns the shards of a namespace that have a directory on disk.
+func (b *backuper) shards(namespace ident.ID) ([]uint32, error) {
+	dirs, err := subDirectories(
+		fs.NamespaceDataDirPath(b.filePathPrefix, namespace),
+		fs.NamespaceSnapshotsDirPath(b.filePathPrefix, namespace),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	shards := make([]uint32, 0, len(dirs))
+	for _, dir := range dirs {
+		shard, err := strconv.ParseUint(dir, 10, 32)
+		if err != nil {
+			continue
+		}
+		shards = append(shards, uint32(shard))
+	}
+	sort.Slice(shards, func(i, j int) bool { return shards[i] < shards[j] })
+	return shards, nil
+}
+
+// subDirectories returns the sorted union of the names of the directories
+// in the given directories, ignoring the ones that do not exist.
+func subDirectories(dirs ...string) ([]string, error) {
+	names := make(map[string]struct{})
+	for _, dir := range dirs {
+		infos, err := ioutil.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if info.IsDir() {
+				names[info.Name()] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// fileSetKey identifies a fileset volume across backups.
+func fileSetKey(fileSet FileSet) string {
+	return strings.Join([]string{
+		string(fileSet.Type),
+		fileSet.Namespace,
+		strconv.FormatUint(uint64(fileSet.Shard), 10),
+		strconv.FormatInt(fileSet.BlockStart.UnixNano(), 10),
+		strconv.Itoa(fileSet.VolumeIndex),
+	}, "/")
+}
+
+func manifestKey(hostID, backupID string) string {
+	return path.Join(manifestsPrefix, hostID, backupID+manifestSuffix)
+}
+
+func marshalJSONPB(msg proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,384 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/placementpb"
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHostID    = "host0"
+	testNamespace = "metrics"
+	testBlockSize = 2 * time.Hour
+)
+
+var testBlockStart = time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+func TestBackupRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		src    = filepath.Join(dir, "src")
+		store  = objectstore.NewFilesystemStore(filepath.Join(dir, "store"))
+		block0 = testBlockStart
+		block1 = testBlockStart.Add(testBlockSize)
+		block2 = testBlockStart.Add(2 * testBlockSize)
+	)
+	for _, shard := range []uint32{0, 1} {
+		writeTestDataFileSet(t, src, shard, block0, 0, persist.FileSetFlushType)
+		writeTestDataFileSet(t, src, shard, block1, 0, persist.FileSetFlushType)
+	}
+	writeTestIndexFileSet(t, src, block0)
+	writeTestIndexFileSet(t, src, block1)
+	writeTestDataFileSet(t, src, 0, block2, 0, persist.FileSetSnapshotType)
+	writeTestSnapshotMetadata(t, src, 0)
+
+	now := block2.Add(time.Hour)
+	opts := NewOptions().
+		SetStore(store).
+		SetHostID(testHostID).
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(src)).
+		SetNamespaceRegistry(&nsproto.Registry{
+			Namespaces: map[string]*nsproto.NamespaceOptions{
+				testNamespace: {BootstrapEnabled: true},
+			},
+		}).
+		SetPlacement(&placementpb.Placement{ReplicaFactor: 1, NumShards: 2}).
+		SetClockOptions(clock.NewOptions().SetNowFn(func() time.Time { return now }))
+	backuper, err := NewBackuper(opts)
+	require.NoError(t, err)
+
+	first, err := backuper.Backup()
+	require.NoError(t, err)
+	assert.Equal(t, 8, first.FileSetsUploaded)
+	assert.Equal(t, 0, first.FileSetsSkipped)
+	assert.Equal(t, "", first.Manifest.Previous)
+	assert.Contains(t, string(first.Manifest.Namespaces), testNamespace)
+	assert.Contains(t, string(first.Manifest.Placement), `"numShards":2`)
+
+	// Only the new volume is uploaded by the next backup.
+	writeTestDataFileSet(t, src, 0, block0, 1, persist.FileSetFlushType)
+	firstAt := now
+	now = now.Add(time.Hour)
+	second, err := backuper.Backup()
+	require.NoError(t, err)
+	assert.Equal(t, 1, second.FileSetsUploaded)
+	assert.Equal(t, 8, second.FileSetsSkipped)
+	assert.Equal(t, first.Manifest.ID, second.Manifest.Previous)
+	assert.Len(t, second.Manifest.FileSets, 9)
+
+	restorer, err := NewRestorer(opts)
+	require.NoError(t, err)
+	hosts, err := restorer.Hosts()
+	require.NoError(t, err)
+	assert.Equal(t, []string{testHostID}, hosts)
+	backups, err := restorer.Backups(testHostID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{first.Manifest.ID, second.Manifest.ID}, backups)
+
+	// Restore the second block as of the first backup.
+	dest := filepath.Join(dir, "dest")
+	result, err := restorer.Restore(RestoreOptions{
+		HostID:         testHostID,
+		At:             firstAt.Add(time.Minute),
+		FilePathPrefix: dest,
+		Start:          block1,
+		End:            block2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, first.Manifest.ID, result.Manifest.ID)
+	assert.Equal(t, 3, result.FileSetsRestored)
+	for _, shard := range []uint32{0, 1} {
+		assertRestoredVolumes(t, dest, shard, map[time.Time][]int{block1: {0}})
+		assertSameFiles(t, src, dest, fs.ShardDataDirPath(src, ident.StringID(testNamespace), shard))
+	}
+	indexFiles, err := fs.IndexFileSetsAt(dest, ident.StringID(testNamespace), block1)
+	require.NoError(t, err)
+	assert.Len(t, indexFiles, 1)
+
+	// Restore a shard from the latest backup, which includes the snapshot.
+	dest = filepath.Join(dir, "dest-latest")
+	restoreOpts := RestoreOptions{
+		HostID:         testHostID,
+		FilePathPrefix: dest,
+		Shards:         []uint32{0},
+	}
+	result, err = restorer.Restore(restoreOpts)
+	require.NoError(t, err)
+	assert.Equal(t, second.Manifest.ID, result.Manifest.ID)
+	assert.Equal(t, 7, result.FileSetsRestored)
+	assertRestoredVolumes(t, dest, 0, map[time.Time][]int{block0: {0, 1}, block1: {0}})
+	assertRestoredVolumes(t, dest, 1, map[time.Time][]int{})
+	exists, err := fs.SnapshotFileSetExistsAt(dest, ident.StringID(testNamespace), 0, block2)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	metadatas, _, err := fs.SortedSnapshotMetadataFiles(fs.NewOptions().SetFilePathPrefix(dest))
+	require.NoError(t, err)
+	assert.Len(t, metadatas, 1)
+
+	_, err = restorer.Restore(restoreOpts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+
+	restoreOpts.Overwrite = true
+	_, err = restorer.Restore(restoreOpts)
+	require.NoError(t, err)
+
+	_, err = restorer.Restore(RestoreOptions{
+		HostID:         testHostID,
+		At:             firstAt.Add(-time.Minute),
+		FilePathPrefix: filepath.Join(dir, "dest-none"),
+	})
+	require.Error(t, err)
+}
+
+func TestRestoreVerifiesChecksums(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "src")
+	writeTestDataFileSet(t, src, 0, testBlockStart, 0, persist.FileSetFlushType)
+
+	opts := NewOptions().
+		SetStore(objectstore.NewFilesystemStore(filepath.Join(dir, "store"))).
+		SetHostID(testHostID).
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(src))
+	backuper, err := NewBackuper(opts)
+	require.NoError(t, err)
+	backup, err := backuper.Backup()
+	require.NoError(t, err)
+	require.Len(t, backup.Manifest.FileSets, 1)
+
+	// Corrupt the backed up data file.
+	for _, file := range backup.Manifest.FileSets[0].Files {
+		if filepath.Base(file.Path) == "fileset-"+fmt.Sprint(testBlockStart.UnixNano())+"-0-data.db" {
+			obj := filepath.Join(dir, "store", filepath.FromSlash(file.Key))
+			require.NoError(t, ioutil.WriteFile(obj, make([]byte, file.Size), 0644))
+		}
+	}
+
+	restorer, err := NewRestorer(opts)
+	require.NoError(t, err)
+	dest := filepath.Join(dir, "dest")
+	_, err = restorer.Restore(RestoreOptions{HostID: testHostID, FilePathPrefix: dest})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+
+	// The fileset was not left complete.
+	files, err := fs.DataFiles(dest, ident.StringID(testNamespace), 0)
+	require.NoError(t, err)
+	for _, file := range files {
+		assert.False(t, file.HasCompleteCheckpointFile())
+	}
+}
+
+func writeTestDataFileSet(
+	t *testing.T,
+	filePathPrefix string,
+	shard uint32,
+	blockStart time.Time,
+	volume int,
+	fileSetType persist.FileSetType,
+) {
+	w, err := fs.NewWriter(fs.NewOptions().SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, err)
+	require.NoError(t, w.Open(fs.DataWriterOpenOptions{
+		FileSetType: fileSetType,
+		BlockSize:   testBlockSize,
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   ident.StringID(testNamespace),
+			Shard:       shard,
+			BlockStart:  blockStart,
+			VolumeIndex: volume,
+		},
+		Snapshot: fs.DataWriterSnapshotOptions{
+			SnapshotTime: blockStart,
+			SnapshotID:   uuid.NewRandom(),
+		},
+	}))
+	for i := 0; i < 10; i++ {
+		id := ident.StringID(fmt.Sprintf("series.%d.%d", shard, i))
+		data := checked.NewBytes([]byte(fmt.Sprintf("data.%d.%d", volume, i)), nil)
+		data.IncRef()
+		metadata := persist.NewMetadataFromIDAndTags(id, ident.Tags{},
+			persist.MetadataOptions{})
+		require.NoError(t, w.Write(metadata, data, 0))
+		data.DecRef()
+	}
+	require.NoError(t, w.Close())
+}
+
+func writeTestIndexFileSet(t *testing.T, filePathPrefix string, blockStart time.Time) {
+	w, err := fs.NewIndexWriter(fs.NewOptions().SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, err)
+	require.NoError(t, w.Open(fs.IndexWriterOpenOptions{
+		FileSetType: persist.FileSetFlushType,
+		BlockSize:   testBlockSize,
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  ident.StringID(testNamespace),
+			BlockStart: blockStart,
+		},
+		Shards: map[uint32]struct{}{0: {}, 1: {}},
+	}))
+	require.NoError(t, w.Close())
+}
+
+func writeTestSnapshotMetadata(t *testing.T, filePathPrefix string, index int64) {
+	w := fs.NewSnapshotMetadataWriter(fs.NewOptions().SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, w.Write(fs.SnapshotMetadataWriteArgs{
+		ID: fs.SnapshotMetadataIdentifier{
+			Index: index,
+			UUID:  uuid.NewRandom(),
+		},
+		CommitlogIdentifier: persist.CommitLogFile{
+			FilePath: fs.CommitLogFilePath(filePathPrefix, 0),
+			Index:    0,
+		},
+	}))
+}
+
+// assertRestoredVolumes asserts the complete data fileset volumes of a shard.
+func assertRestoredVolumes(
+	t *testing.T,
+	filePathPrefix string,
+	shard uint32,
+	expected map[time.Time][]int,
+) {
+	files, err := fs.DataFiles(filePathPrefix, ident.StringID(testNamespace), shard)
+	require.NoError(t, err)
+
+	actual := make(map[time.Time][]int)
+	for _, file := range files {
+		require.True(t, file.HasCompleteCheckpointFile())
+		blockStart := file.ID.BlockStart.UTC()
+		actual[blockStart] = append(actual[blockStart], file.ID.VolumeIndex)
+	}
+	assert.Equal(t, expected, actual)
+}
+
+// assertSameFiles asserts that the files restored to a directory have the
+// same contents as the backed up ones.
+func assertSameFiles(t *testing.T, src, dest, srcDir string) {
+	rel, err := filepath.Rel(src, srcDir)
+	require.NoError(t, err)
+	infos, err := ioutil.ReadDir(filepath.Join(dest, rel))
+	require.NoError(t, err)
+	require.NotEmpty(t, infos)
+
+	for _, info := range infos {
+		expected, err := ioutil.ReadFile(filepath.Join(srcDir, info.Name()))
+		require.NoError(t, err)
+		actual, err := ioutil.ReadFile(filepath.Join(dest, rel, info.Name()))
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual, info.Name())
+	}
+}
+
+func TestRestoreRejectsEscapingFilePaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "src")
+	writeTestDataFileSet(t, src, 0, testBlockStart, 0, persist.FileSetFlushType)
+
+	store := objectstore.NewFilesystemStore(filepath.Join(dir, "store"))
+	opts := NewOptions().
+		SetStore(store).
+		SetHostID(testHostID).
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(src))
+	backuper, err := NewBackuper(opts)
+	require.NoError(t, err)
+	backup, err := backuper.Backup()
+	require.NoError(t, err)
+	require.Len(t, backup.Manifest.FileSets, 1)
+
+	restorer, err := NewRestorer(opts)
+	require.NoError(t, err)
+	dest := filepath.Join(dir, "dest")
+	for _, escaping := range []string{
+		"../escaped",
+		"data/../../escaped",
+		"/tmp/escaped",
+		"..",
+	} {
+		manifest := backup.Manifest
+		manifest.FileSets = []FileSet{backup.Manifest.FileSets[0]}
+		files := append([]File(nil), manifest.FileSets[0].Files...)
+		files[len(files)-1].Path = escaping
+		manifest.FileSets[0].Files = files
+
+		data, err := json.Marshal(manifest)
+		require.NoError(t, err)
+		require.NoError(t, store.Put(manifestKey(testHostID, manifest.ID),
+			bytes.NewReader(data), int64(len(data))))
+
+		_, err = restorer.Restore(RestoreOptions{
+			HostID:         testHostID,
+			FilePathPrefix: dest,
+			Overwrite:      true,
+		})
+		require.Error(t, err, escaping)
+		assert.Contains(t, err.Error(), "escapes")
+
+		// Nothing was restored.
+		_, err = os.Stat(dest)
+		assert.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestRestoreFilePath(t *testing.T) {
+	path, err := restoreFilePath("/var/lib/m3db", "data/metrics/0/fileset-0-0-data.db")
+	require.NoError(t, err)
+	assert.Equal(t, "/var/lib/m3db/data/metrics/0/fileset-0-0-data.db", path)
+
+	path, err = restoreFilePath("/var/lib/m3db", "data/./metrics/../metrics/0/x.db")
+	require.NoError(t, err)
+	assert.Equal(t, "/var/lib/m3db/data/metrics/0/x.db", path)
+
+	for _, invalid := range []string{"", ".", "..", "../x", "a/../../x", "/x"} {
+		_, err := restoreFilePath("/var/lib/m3db", invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/placementpb"
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/objectstore"
+)
+
+var (
+	errStoreNotSet  = errors.New("backup store not set")
+	errHostIDNotSet = errors.New("backup host ID not set")
+)
+
+type options struct {
+	store          objectstore.Store
+	hostID         string
+	fsOpts         fs.Options
+	registry       *nsproto.Registry
+	placement      *placementpb.Placement
+	clockOpts      clock.Options
+	instrumentOpts instrument.Options
+}
+
+// NewOptions creates new backup options.
+func NewOptions() Options {
+	return &options{
+		fsOpts:         fs.NewOptions(),
+		clockOpts:      clock.NewOptions(),
+		instrumentOpts: instrument.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.store == nil {
+		return errStoreNotSet
+	}
+	if o.hostID == "" {
+		return errHostIDNotSet
+	}
+	return o.fsOpts.Validate()
+}
+
+func (o *options) SetStore(value objectstore.Store) Options {
+	opts := *o
+	opts.store = value
+	return &opts
+}
+
+func (o *options) Store() objectstore.Store {
+	return o.store
+}
+
+func (o *options) SetHostID(value string) Options {
+	opts := *o
+	opts.hostID = value
+	return &opts
+}
+
+func (o *options) HostID() string {
+	return o.hostID
+}
+
+func (o *options) SetFilesystemOptions(value fs.Options) Options {
+	opts := *o
+	opts.fsOpts = value
+	return &opts
+}
+
+func (o *options) FilesystemOptions() fs.Options {
+	return o.fsOpts
+}
+
+func (o *options) SetNamespaceRegistry(value *nsproto.Registry) Options {
+	opts := *o
+	opts.registry = value
+	return &opts
+}
+
+func (o *options) NamespaceRegistry() *nsproto.Registry {
+	return o.registry
+}
+
+func (o *options) SetPlacement(value *placementpb.Placement) Options {
+	opts := *o
+	opts.placement = value
+	return &opts
+}
+
+func (o *options) Placement() *placementpb.Placement {
+	return o.placement
+}
+
+func (o *options) SetClockOptions(value clock.Options) Options {
+	opts := *o
+	opts.clockOpts = value
+	return &opts
+}
+
+func (o *options) ClockOptions() clock.Options {
+	return o.clockOpts
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
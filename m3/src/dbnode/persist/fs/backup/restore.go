@@ -0,0 +1,354 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/adler32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/x/objectstore"
+
+	"go.uber.org/zap"
+)
+
+var (
+	errRestoreHostIDNotSet         = errors.New("restore host ID not set")
+	errRestoreFilePathPrefixNotSet = errors.New("restore file path prefix not set")
+)
+
+type restorer struct {
+	opts   Options
+	store  objectstore.Store
+	logger *zap.Logger
+}
+
+// NewRestorer returns a new Restorer, only the store, filesystem and
+// instrument options are used.
+func NewRestorer(opts Options) (Restorer, error) {
+	if opts.Store() == nil {
+		return nil, errStoreNotSet
+	}
+	return &restorer{
+		opts:   opts,
+		store:  opts.Store(),
+		logger: opts.InstrumentOptions().Logger(),
+	}, nil
+}
+
+func (r *restorer) Hosts() ([]string, error) {
+	keys, err := r.store.List(manifestsPrefix + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []string
+	for _, key := range keys {
+		parts := strings.Split(key, "/")
+		if len(parts) != 3 {
+			continue
+		}
+		if n := len(hosts); n == 0 || hosts[n-1] != parts[1] {
+			hosts = append(hosts, parts[1])
+		}
+	}
+	return hosts, nil
+}
+
+func (r *restorer) Backups(hostID string) ([]string, error) {
+	return backupIDs(r.store, hostID)
+}
+
+func (r *restorer) Manifest(hostID, backupID string) (Manifest, error) {
+	return readManifest(r.store, hostID, backupID)
+}
+
+func (r *restorer) Restore(opts RestoreOptions) (RestoreResult, error) {
+	if opts.HostID == "" {
+		return RestoreResult{}, errRestoreHostIDNotSet
+	}
+	if opts.FilePathPrefix == "" {
+		return RestoreResult{}, errRestoreFilePathPrefixNotSet
+	}
+
+	backupID := opts.BackupID
+	if backupID == "" {
+		var err error
+		if backupID, err = r.backupAt(opts.HostID, opts.At); err != nil {
+			return RestoreResult{}, err
+		}
+	}
+	manifest, err := readManifest(r.store, opts.HostID, backupID)
+	if err != nil {
+		return RestoreResult{}, err
+	}
+
+	// Fail before restoring anything rather than leave a partial restore.
+	fileSets := selectFileSets(manifest.FileSets, opts)
+	for _, fileSet := range fileSets {
+		for _, file := range fileSet.Files {
+			filePath, err := restoreFilePath(opts.FilePathPrefix, file.Path)
+			if err != nil {
+				return RestoreResult{}, err
+			}
+			if opts.Overwrite {
+				continue
+			}
+			if _, err := os.Stat(filePath); err == nil {
+				return RestoreResult{}, fmt.Errorf("file %s already exists", filePath)
+			}
+		}
+	}
+
+	result := RestoreResult{Manifest: manifest}
+	for _, fileSet := range fileSets {
+		n, err := r.restoreFileSet(opts.FilePathPrefix, fileSet)
+		if err != nil {
+			return RestoreResult{}, err
+		}
+		result.FileSetsRestored++
+		result.BytesRestored += n
+	}
+
+	r.logger.Info("restore complete",
+		zap.String("id", manifest.ID),
+		zap.String("hostID", opts.HostID),
+		zap.String("filePathPrefix", opts.FilePathPrefix),
+		zap.Int("fileSetsRestored", result.FileSetsRestored),
+		zap.Int64("bytesRestored", result.BytesRestored))
+
+	return result, nil
+}
+
+// backupAt returns the latest backup of a host taken at or before the
+// given time, or the latest backup if the time is zero.
+func (r *restorer) backupAt(hostID string, at time.Time) (string, error) {
+	ids, err := backupIDs(r.store, hostID)
+	if err != nil {
+		return "", err
+	}
+	for i := len(ids) - 1; i >= 0; i-- {
+		if at.IsZero() {
+			return ids[i], nil
+		}
+		createdAt, err := time.Parse(backupIDFormat, ids[i])
+		if err != nil {
+			continue
+		}
+		if !createdAt.After(at) {
+			return ids[i], nil
+		}
+	}
+	if at.IsZero() {
+		return "", fmt.Errorf("no backup of host %s", hostID)
+	}
+	return "", fmt.Errorf("no backup of host %s taken at or before %v", hostID, at)
+}
+
+// selectFileSets returns the filesets selected by the restore options,
+// along with the snapshot metadata if any snapshot is selected. Index
+// filesets hold every shard of the node so they are not filtered by shard.
+func selectFileSets(fileSets []FileSet, opts RestoreOptions) []FileSet {
+	var (
+		namespaces = make(map[string]struct{}, len(opts.Namespaces))
+		shards     = make(map[uint32]struct{}, len(opts.Shards))
+		selected   []FileSet
+		metadatas  []FileSet
+		snapshots  bool
+	)
+	for _, namespace := range opts.Namespaces {
+		namespaces[namespace] = struct{}{}
+	}
+	for _, shard := range opts.Shards {
+		shards[shard] = struct{}{}
+	}
+
+	for _, fileSet := range fileSets {
+		if fileSet.Type == SnapshotMetadataFileSetType {
+			metadatas = append(metadatas, fileSet)
+			continue
+		}
+		if _, ok := namespaces[fileSet.Namespace]; len(namespaces) > 0 && !ok {
+			continue
+		}
+		if fileSet.Type == DataFileSetType || fileSet.Type == SnapshotFileSetType {
+			if _, ok := shards[fileSet.Shard]; len(shards) > 0 && !ok {
+				continue
+			}
+		}
+		if !opts.Start.IsZero() && fileSet.BlockStart.Before(opts.Start) {
+			continue
+		}
+		if !opts.End.IsZero() && !fileSet.BlockStart.Before(opts.End) {
+			continue
+		}
+
+		if fileSet.Type == SnapshotFileSetType || fileSet.Type == IndexSnapshotFileSetType {
+			snapshots = true
+		}
+		selected = append(selected, fileSet)
+	}
+
+	if snapshots {
+		selected = append(selected, metadatas...)
+	}
+	return selected
+}
+
+// restoreFileSet restores the files of a fileset, its checkpoint file is
+// restored last so that the fileset is only complete once all of its files
+// are restored.
+func (r *restorer) restoreFileSet(filePathPrefix string, fileSet FileSet) (int64, error) {
+	files := make([]File, 0, len(fileSet.Files))
+	var checkpoints []File
+	for _, file := range fileSet.Files {
+		if strings.Contains(path.Base(file.Path), "checkpoint") {
+			checkpoints = append(checkpoints, file)
+			continue
+		}
+		files = append(files, file)
+	}
+	files = append(files, checkpoints...)
+
+	var restored int64
+	for _, file := range files {
+		if err := r.restoreFile(filePathPrefix, file); err != nil {
+			return 0, fmt.Errorf("could not restore %s: %v", file.Path, err)
+		}
+		restored += file.Size
+	}
+	return restored, nil
+}
+
+func (r *restorer) restoreFile(filePathPrefix string, file File) error {
+	filePath, err := restoreFilePath(filePathPrefix, file.Path)
+	if err != nil {
+		return err
+	}
+
+	var (
+		fsOpts = r.opts.FilesystemOptions()
+		dir    = filepath.Dir(filePath)
+	)
+	if err := os.MkdirAll(dir, fsOpts.NewDirectoryMode()); err != nil {
+		return err
+	}
+
+	obj, err := r.store.Get(file.Key)
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+
+	// Restore to a temporary file, hidden from the fileset globs, and only
+	// rename it once its contents are verified.
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(filePath))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	checksum := adler32.New()
+	n, err := io.Copy(io.MultiWriter(tmp, checksum), obj)
+	if err == nil && n != file.Size {
+		err = fmt.Errorf("size mismatch: expected %d, actual %d", file.Size, n)
+	}
+	if err == nil && checksum.Sum32() != file.Checksum {
+		err = fmt.Errorf("checksum mismatch: expected %08x, actual %08x",
+			file.Checksum, checksum.Sum32())
+	}
+	if err == nil {
+		err = tmp.Chmod(fsOpts.NewFileMode())
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filePath)
+}
+
+// restoreFilePath returns the path a file of a manifest is restored to, the
+// manifest is not trusted so paths escaping the file path prefix are rejected.
+func restoreFilePath(filePathPrefix, filePath string) (string, error) {
+	cleaned := path.Clean(filePath)
+	if filePath == "" || path.IsAbs(cleaned) || cleaned == "." ||
+		cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("file path %q in manifest escapes %s",
+			filePath, filePathPrefix)
+	}
+	return filepath.Join(filePathPrefix, filepath.FromSlash(cleaned)), nil
+}
+
+func backupIDs(store objectstore.Store, hostID string) ([]string, error) {
+	keys, err := store.List(path.Join(manifestsPrefix, hostID) + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if strings.HasSuffix(key, manifestSuffix) {
+			ids = append(ids, strings.TrimSuffix(path.Base(key), manifestSuffix))
+		}
+	}
+	return ids, nil
+}
+
+func readManifest(store objectstore.Store, hostID, backupID string) (Manifest, error) {
+	obj, err := store.Get(manifestKey(hostID, backupID))
+	if err == objectstore.ErrObjectNotFound {
+		return Manifest{}, fmt.Errorf("backup %s of host %s not found", backupID, hostID)
+	}
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer obj.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(obj).Decode(&manifest); err != nil {
+		return Manifest{}, fmt.Errorf("could not decode manifest of backup %s: %v", backupID, err)
+	}
+	return manifest, nil
+}
+
+// latestManifest returns the manifest of the latest backup of a host, if any.
+func latestManifest(store objectstore.Store, hostID string) (Manifest, bool, error) {
+	ids, err := backupIDs(store, hostID)
+	if err != nil || len(ids) == 0 {
+		return Manifest{}, false, err
+	}
+	manifest, err := readManifest(store, hostID, ids[len(ids)-1])
+	if err != nil {
+		return Manifest{}, false, err
+	}
+	return manifest, true, nil
+}
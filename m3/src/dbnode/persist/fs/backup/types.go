@@ -0,0 +1,197 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package backup backs up the filesets of a node to an object store and
+// restores them from it.
+package backup
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/placementpb"
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+	"github.com/m3db/m3/src/x/objectstore"
+)
+
+// FileSetType is the type of a backed up fileset.
+type FileSetType string
+
+const (
+	// DataFileSetType is a flushed data fileset.
+	DataFileSetType FileSetType = "data"
+	// IndexFileSetType is a flushed index fileset.
+	IndexFileSetType FileSetType = "index"
+	// SnapshotFileSetType is a data snapshot fileset.
+	SnapshotFileSetType FileSetType = "snapshot"
+	// IndexSnapshotFileSetType is an index snapshot fileset.
+	IndexSnapshotFileSetType FileSetType = "indexSnapshot"
+	// SnapshotMetadataFileSetType is a snapshot metadata file and its
+	// checkpoint file.
+	SnapshotMetadataFileSetType FileSetType = "snapshotMetadata"
+)
+
+// Manifest describes a backup of a node, it lists every fileset that makes
+// up the backup whether it was uploaded by the backup or by a previous one.
+type Manifest struct {
+	ID         string          `json:"id"`
+	HostID     string          `json:"hostID"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	Previous   string          `json:"previous,omitempty"`
+	Namespaces json.RawMessage `json:"namespaces,omitempty"`
+	Placement  json.RawMessage `json:"placement,omitempty"`
+	FileSets   []FileSet       `json:"fileSets"`
+}
+
+// FileSet is a backed up fileset, its digest is the one held by its
+// checkpoint file and identifies the contents of the fileset volume.
+type FileSet struct {
+	Type        FileSetType `json:"type"`
+	Namespace   string      `json:"namespace,omitempty"`
+	Shard       uint32      `json:"shard"`
+	BlockStart  time.Time   `json:"blockStart"`
+	VolumeIndex int         `json:"volumeIndex"`
+	Digest      uint32      `json:"digest"`
+	Files       []File      `json:"files"`
+}
+
+// File is a backed up file of a fileset, its path is relative to the file
+// path prefix of the node and its checksum is the adler32 of its contents.
+type File struct {
+	Path     string `json:"path"`
+	Key      string `json:"key"`
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"checksum"`
+}
+
+// BackupResult is the result of a backup.
+type BackupResult struct {
+	Manifest         Manifest
+	FileSetsUploaded int
+	FileSetsSkipped  int
+	BytesUploaded    int64
+}
+
+// RestoreOptions selects what to restore from a backup and where to.
+type RestoreOptions struct {
+	// HostID is the host whose backup is restored.
+	HostID string
+	// BackupID is the backup to restore, if empty the latest backup taken
+	// at or before At is restored.
+	BackupID string
+	// At selects the backup to restore when no BackupID is set, if zero the
+	// latest backup is restored.
+	At time.Time
+	// FilePathPrefix is the file path prefix the filesets are restored to.
+	FilePathPrefix string
+	// Namespaces restricts the restore to the given namespaces if not empty.
+	Namespaces []string
+	// Shards restricts the restore to the given shards if not empty.
+	Shards []uint32
+	// Start and End restrict the restore to the blocks starting in
+	// [Start, End), either is ignored if zero.
+	Start time.Time
+	End   time.Time
+	// Overwrite restores files that already exist rather than failing.
+	Overwrite bool
+}
+
+// RestoreResult is the result of a restore.
+type RestoreResult struct {
+	Manifest         Manifest
+	FileSetsRestored int
+	BytesRestored    int64
+}
+
+// Backuper backs up the filesets of a node.
+type Backuper interface {
+	// Backup uploads the filesets that were not uploaded by a previous
+	// backup and the manifest of the backup.
+	Backup() (BackupResult, error)
+}
+
+// Restorer restores backed up filesets.
+type Restorer interface {
+	// Hosts returns the hosts that have been backed up.
+	Hosts() ([]string, error)
+
+	// Backups returns the IDs of the backups of a host in the order they
+	// were taken.
+	Backups(hostID string) ([]string, error)
+
+	// Manifest returns the manifest of a backup of a host.
+	Manifest(hostID, backupID string) (Manifest, error)
+
+	// Restore restores the filesets of a backup selected by the options.
+	Restore(opts RestoreOptions) (RestoreResult, error)
+}
+
+// Options represents the options for backups and restores.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetStore sets the object store.
+	SetStore(value objectstore.Store) Options
+
+	// Store returns the object store.
+	Store() objectstore.Store
+
+	// SetHostID sets the ID of the host that is backed up.
+	SetHostID(value string) Options
+
+	// HostID returns the ID of the host that is backed up.
+	HostID() string
+
+	// SetFilesystemOptions sets the filesystem options, the file path prefix
+	// of which is backed up.
+	SetFilesystemOptions(value fs.Options) Options
+
+	// FilesystemOptions returns the filesystem options.
+	FilesystemOptions() fs.Options
+
+	// SetNamespaceRegistry sets the namespace registry recorded in backups,
+	// if set only its namespaces are backed up.
+	SetNamespaceRegistry(value *nsproto.Registry) Options
+
+	// NamespaceRegistry returns the namespace registry recorded in backups.
+	NamespaceRegistry() *nsproto.Registry
+
+	// SetPlacement sets the placement recorded in backups.
+	SetPlacement(value *placementpb.Placement) Options
+
+	// Placement returns the placement recorded in backups.
+	Placement() *placementpb.Placement
+
+	// SetClockOptions sets the clock options.
+	SetClockOptions(value clock.Options) Options
+
+	// ClockOptions returns the clock options.
+	ClockOptions() clock.Options
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+}
@@ -0,0 +1,545 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/clock"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	coldTierMarkerFilePrefix = "." + filesetFilePrefix
+	coldTierMarkerFileSuffix = "offloaded"
+)
+
+// coldTierOffloadedFileSuffixes are the suffixes of the files of a data
+// fileset that are offloaded, the remaining files are always kept locally.
+var coldTierOffloadedFileSuffixes = []string{
+	indexFileSuffix,
+	summariesFileSuffix,
+	dataFileSuffix,
+}
+
+type coldTierMetrics struct {
+	offloaded   tally.Counter
+	evicted     tally.Counter
+	deleted     tally.Counter
+	fetched     tally.Counter
+	fetchErrors tally.Counter
+	fetchBytes  tally.Counter
+}
+
+func newColdTierMetrics(scope tally.Scope) coldTierMetrics {
+	return coldTierMetrics{
+		offloaded:   scope.Counter("offloaded"),
+		evicted:     scope.Counter("evicted"),
+		deleted:     scope.Counter("deleted"),
+		fetched:     scope.Counter("fetched"),
+		fetchErrors: scope.Counter("fetch-errors"),
+		fetchBytes:  scope.Counter("fetch-bytes"),
+	}
+}
+
+type coldTierLock struct {
+	sync.Mutex
+	refs int
+}
+
+type coldTier struct {
+	sync.Mutex
+
+	opts           ColdTierOptions
+	store          objectstore.Store
+	filePathPrefix string
+	newFileMode    os.FileMode
+	nowFn          clock.NowFn
+	locks          map[string]*coldTierLock
+	logger         *zap.Logger
+	metrics        coldTierMetrics
+}
+
+// coldTierFile is an offloaded file of a data fileset.
+type coldTierFile struct {
+	name string
+	size int64
+}
+
+// NewColdTier returns a new cold tier offloading the data filesets found
+// under the file path prefix of the filesystem options.
+func NewColdTier(fsOpts Options, opts ColdTierOptions) (ColdTier, error) {
+	if err := fsOpts.Validate(); err != nil {
+		return nil, err
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	iOpts := fsOpts.InstrumentOptions()
+	return &coldTier{
+		opts:           opts,
+		store:          opts.Store(),
+		filePathPrefix: fsOpts.FilePathPrefix(),
+		newFileMode:    fsOpts.NewFileMode(),
+		nowFn:          fsOpts.ClockOptions().NowFn(),
+		locks:          make(map[string]*coldTierLock),
+		logger:         iOpts.Logger(),
+		metrics:        newColdTierMetrics(iOpts.MetricsScope().SubScope("cold-tier")),
+	}, nil
+}
+
+func (t *coldTier) Options() ColdTierOptions {
+	return t.opts
+}
+
+func (t *coldTier) OffloadShard(
+	namespace ident.ID,
+	shard uint32,
+	before time.Time,
+) (ColdTierOffloadResult, error) {
+	var result ColdTierOffloadResult
+	filesets, err := DataFiles(t.filePathPrefix, namespace, shard)
+	if err != nil {
+		return result, err
+	}
+
+	// Only the latest complete volume of each block is offloaded, the
+	// previous volumes are soon to be cleaned up.
+	latest := make(map[int64]FileSetFile, len(filesets))
+	for _, fileset := range filesets {
+		if !fileset.ID.BlockStart.Before(before) || !fileset.HasCompleteCheckpointFile() {
+			continue
+		}
+		blockStart := fileset.ID.BlockStart.UnixNano()
+		if curr, ok := latest[blockStart]; ok && curr.ID.VolumeIndex > fileset.ID.VolumeIndex {
+			continue
+		}
+		latest[blockStart] = fileset
+	}
+
+	shardDir := ShardDataDirPath(t.filePathPrefix, namespace, shard)
+	for _, fileset := range latest {
+		offloaded, evicted, err := t.offloadOrEvict(shardDir, fileset)
+		if err != nil {
+			return result, err
+		}
+		if offloaded {
+			result.Offloaded++
+		}
+		if evicted {
+			result.Evicted++
+		}
+	}
+
+	deleted, err := t.deleteOrphans(shardDir, namespace, shard)
+	if err != nil {
+		return result, err
+	}
+	result.Deleted = deleted
+	return result, nil
+}
+
+func (t *coldTier) offloadOrEvict(
+	shardDir string,
+	fileset FileSetFile,
+) (bool, bool, error) {
+	markerPath := coldTierMarkerFilePath(shardDir, fileset.ID.BlockStart, fileset.ID.VolumeIndex)
+	unlock := t.lock(markerPath)
+	defer unlock()
+
+	files, ok, err := readColdTierMarkerFile(markerPath)
+	if err != nil {
+		return false, false, err
+	}
+	if ok {
+		evicted, err := t.evict(shardDir, files)
+		return false, evicted, err
+	}
+
+	files = files[:0]
+	for _, filePath := range fileset.AbsoluteFilePaths {
+		if !isColdTierOffloadedFile(filePath) {
+			continue
+		}
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return false, false, err
+		}
+		if err := t.upload(filePath, info.Size()); err != nil {
+			return false, false, err
+		}
+		files = append(files, coldTierFile{
+			name: filepath.Base(filePath),
+			size: info.Size(),
+		})
+	}
+
+	// Only remove the local files once the marker has been written so that
+	// they are never missing without a record of where to fetch them from.
+	if err := t.writeMarkerFile(markerPath, files); err != nil {
+		return false, false, err
+	}
+	for _, f := range files {
+		if err := os.Remove(path.Join(shardDir, f.name)); err != nil && !os.IsNotExist(err) {
+			return false, false, err
+		}
+	}
+
+	t.metrics.offloaded.Inc(1)
+	return true, false, nil
+}
+
+func (t *coldTier) evict(shardDir string, files []coldTierFile) (bool, error) {
+	var (
+		evicted  bool
+		cacheTTL = t.opts.CacheTTL()
+		now      = t.nowFn()
+	)
+	for _, f := range files {
+		filePath := path.Join(shardDir, f.name)
+		info, err := os.Stat(filePath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return evicted, err
+		}
+		if now.Sub(info.ModTime()) < cacheTTL {
+			continue
+		}
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return evicted, err
+		}
+		evicted = true
+	}
+	if evicted {
+		t.metrics.evicted.Inc(1)
+	}
+	return evicted, nil
+}
+
+// deleteOrphans deletes the objects of the offloaded filesets whose
+// checkpoint file has been removed locally, i.e. that were cleaned up.
+func (t *coldTier) deleteOrphans(
+	shardDir string,
+	namespace ident.ID,
+	shard uint32,
+) (int, error) {
+	markerPaths, err := filepath.Glob(path.Join(shardDir,
+		coldTierMarkerFilePrefix+separator+"*"+separator+coldTierMarkerFileSuffix))
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, markerPath := range markerPaths {
+		blockStart, volume, err := parseColdTierMarkerFilePath(markerPath)
+		if err != nil {
+			t.logger.Warn("skipping unexpected cold tier marker file",
+				zap.String("path", markerPath), zap.Error(err))
+			continue
+		}
+		exists, err := DataFileSetExists(t.filePathPrefix, namespace, shard, blockStart, volume)
+		if err != nil {
+			return deleted, err
+		}
+		if exists {
+			continue
+		}
+		if err := t.deleteOffloaded(shardDir, markerPath); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (t *coldTier) deleteOffloaded(shardDir, markerPath string) error {
+	unlock := t.lock(markerPath)
+	defer unlock()
+
+	files, ok, err := readColdTierMarkerFile(markerPath)
+	if err != nil || !ok {
+		return err
+	}
+	for _, f := range files {
+		filePath := path.Join(shardDir, f.name)
+		key, err := t.key(filePath)
+		if err != nil {
+			return err
+		}
+		if err := t.store.Delete(key); err != nil {
+			return err
+		}
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Remove(markerPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	t.metrics.deleted.Inc(1)
+	return nil
+}
+
+func (t *coldTier) Fetch(id FileSetFileIdentifier) error {
+	shardDir := ShardDataDirPath(t.filePathPrefix, id.Namespace, id.Shard)
+	markerPath := coldTierMarkerFilePath(shardDir, id.BlockStart, id.VolumeIndex)
+	unlock := t.lock(markerPath)
+	defer unlock()
+
+	files, ok, err := readColdTierMarkerFile(markerPath)
+	if err != nil || !ok {
+		return err
+	}
+
+	now := t.nowFn()
+	for _, f := range files {
+		filePath := path.Join(shardDir, f.name)
+		// Touch the files already present so that they are not evicted
+		// while accessed.
+		err := os.Chtimes(filePath, now, now)
+		if err == nil {
+			continue
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+		if err := t.download(filePath, f.size); err != nil {
+			t.metrics.fetchErrors.Inc(1)
+			return err
+		}
+		if err := os.Chtimes(filePath, now, now); err != nil {
+			return err
+		}
+		t.metrics.fetched.Inc(1)
+		t.metrics.fetchBytes.Inc(f.size)
+	}
+	return nil
+}
+
+func (t *coldTier) Offloaded(id FileSetFileIdentifier) (bool, error) {
+	shardDir := ShardDataDirPath(t.filePathPrefix, id.Namespace, id.Shard)
+	markerPath := coldTierMarkerFilePath(shardDir, id.BlockStart, id.VolumeIndex)
+	_, err := os.Stat(markerPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (t *coldTier) upload(filePath string, size int64) error {
+	key, err := t.key(filePath)
+	if err != nil {
+		return err
+	}
+	fd, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+	return t.store.Put(key, fd, size)
+}
+
+func (t *coldTier) download(filePath string, size int64) error {
+	key, err := t.key(filePath)
+	if err != nil {
+		return err
+	}
+	r, err := t.store.Get(key)
+	if err != nil {
+		return fmt.Errorf("unable to fetch %s from cold tier: %v", key, err)
+	}
+	defer r.Close()
+
+	// Download to a hidden temporary file that is only renamed once complete
+	// so that readers never observe a partially fetched file.
+	dir, name := filepath.Split(filePath)
+	tmp, err := ioutil.TempFile(dir, "."+name+separator)
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	n, err := io.Copy(tmp, r)
+	if err == nil && n != size {
+		err = fmt.Errorf("fetched %d bytes of %s from cold tier, expected %d", n, key, size)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpPath, t.newFileMode)
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, filePath)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+func (t *coldTier) writeMarkerFile(markerPath string, files []coldTierFile) error {
+	var buf strings.Builder
+	for _, f := range files {
+		fmt.Fprintf(&buf, "%s %d\n", f.name, f.size)
+	}
+
+	tmpPath := markerPath + separator + "tmp"
+	fd, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, t.newFileMode)
+	if err != nil {
+		return err
+	}
+	_, err = fd.WriteString(buf.String())
+	if err == nil {
+		err = fd.Sync()
+	}
+	if closeErr := fd.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, markerPath)
+}
+
+// key returns the object key of a file, its path relative to the file path
+// prefix under the key prefix.
+func (t *coldTier) key(filePath string) (string, error) {
+	rel, err := filepath.Rel(t.filePathPrefix, filePath)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(t.opts.KeyPrefix(), filepath.ToSlash(rel)), nil
+}
+
+// lock locks the offloaded files of a fileset, returning the function that
+// unlocks them.
+func (t *coldTier) lock(markerPath string) func() {
+	t.Lock()
+	l, ok := t.locks[markerPath]
+	if !ok {
+		l = &coldTierLock{}
+		t.locks[markerPath] = l
+	}
+	l.refs++
+	t.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		t.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(t.locks, markerPath)
+		}
+		t.Unlock()
+	}
+}
+
+// ColdTierOffloadBefore returns the time before which the blocks of a
+// namespace with the cold tier enabled are offloaded, i.e. blocks are
+// offloaded once they ended longer than the offload after duration ago.
+func ColdTierOffloadBefore(nsOpts namespace.Options, now time.Time) time.Time {
+	blockSize := nsOpts.RetentionOptions().BlockSize()
+	return now.Add(-nsOpts.ColdTierOptions().OffloadAfter()).Add(-blockSize)
+}
+
+func isColdTierOffloadedFile(filePath string) bool {
+	for _, suffix := range coldTierOffloadedFileSuffixes {
+		if strings.HasSuffix(filePath, separator+suffix+fileSuffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// coldTierMarkerFilePath returns the path of the hidden marker file that
+// records the offloaded files of a data fileset, it does not match any
+// fileset file pattern so that it is ignored by everything but the cold tier.
+func coldTierMarkerFilePath(shardDir string, blockStart time.Time, volume int) string {
+	return path.Join(shardDir, fmt.Sprintf("%s%s%d%s%d%s%s",
+		coldTierMarkerFilePrefix, separator, blockStart.UnixNano(),
+		separator, volume, separator, coldTierMarkerFileSuffix))
+}
+
+func parseColdTierMarkerFilePath(markerPath string) (time.Time, int, error) {
+	components := strings.Split(filepath.Base(markerPath), separator)
+	if len(components) != 4 {
+		return timeZero, 0, fmt.Errorf(errUnexpectedFilenamePattern, markerPath)
+	}
+	nanos, err := strconv.ParseInt(components[1], 10, 64)
+	if err != nil {
+		return timeZero, 0, err
+	}
+	volume, err := strconv.Atoi(components[2])
+	if err != nil {
+		return timeZero, 0, err
+	}
+	return time.Unix(0, nanos), volume, nil
+}
+
+func readColdTierMarkerFile(markerPath string) ([]coldTierFile, bool, error) {
+	fd, err := os.Open(markerPath)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	defer fd.Close()
+
+	var (
+		files   []coldTierFile
+		scanner = bufio.NewScanner(fd)
+	)
+	for scanner.Scan() {
+		var f coldTierFile
+		if _, err := fmt.Sscanf(scanner.Text(), "%s %d", &f.name, &f.size); err != nil {
+			return nil, false, fmt.Errorf("invalid cold tier marker file %s: %v", markerPath, err)
+		}
+		files = append(files, f)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, false, err
+	}
+	return files, true, nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m3db/m3/src/x/objectstore"
+)
+
+const (
+	// defaultColdTierCacheTTL is the default duration offloaded files fetched
+	// back are kept locally after they were last accessed.
+	defaultColdTierCacheTTL = time.Hour
+)
+
+var (
+	errColdTierStoreNotSet         = errors.New("cold tier object store is not set")
+	errColdTierCacheTTLNotPositive = errors.New("cold tier cache TTL must be positive")
+)
+
+type coldTierOptions struct {
+	store     objectstore.Store
+	keyPrefix string
+	cacheTTL  time.Duration
+}
+
+// NewColdTierOptions creates a new set of cold tier options.
+func NewColdTierOptions() ColdTierOptions {
+	return &coldTierOptions{
+		cacheTTL: defaultColdTierCacheTTL,
+	}
+}
+
+func (o *coldTierOptions) Validate() error {
+	if o.store == nil {
+		return errColdTierStoreNotSet
+	}
+	if o.cacheTTL <= 0 {
+		return errColdTierCacheTTLNotPositive
+	}
+	return nil
+}
+
+func (o *coldTierOptions) SetStore(value objectstore.Store) ColdTierOptions {
+	opts := *o
+	opts.store = value
+	return &opts
+}
+
+func (o *coldTierOptions) Store() objectstore.Store {
+	return o.store
+}
+
+func (o *coldTierOptions) SetKeyPrefix(value string) ColdTierOptions {
+	opts := *o
+	opts.keyPrefix = value
+	return &opts
+}
+
+func (o *coldTierOptions) KeyPrefix() string {
+	return o.keyPrefix
+}
+
+func (o *coldTierOptions) SetCacheTTL(value time.Duration) ColdTierOptions {
+	opts := *o
+	opts.cacheTTL = value
+	return &opts
+}
+
+func (o *coldTierOptions) CacheTTL() time.Duration {
+	return o.cacheTTL
+}
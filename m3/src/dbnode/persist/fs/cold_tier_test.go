@@ -0,0 +1,157 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/x/objectstore"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestColdTierOffloadFetchAndEvict(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+	filePathPrefix := filepath.Join(dir, "data")
+	store := objectstore.NewFilesystemStore(filepath.Join(dir, "store"))
+
+	entries := []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+		{"bar", map[string]string{"baz": "qux"}, []byte{4, 5, 6}},
+	}
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+
+	now := testWriterStart
+	opts := testDefaultOpts.
+		SetFilePathPrefix(filePathPrefix).
+		SetClockOptions(testDefaultOpts.ClockOptions().SetNowFn(func() time.Time {
+			return now
+		}))
+	coldTier, err := NewColdTier(opts, NewColdTierOptions().
+		SetStore(store).
+		SetKeyPrefix("host0").
+		SetCacheTTL(time.Hour))
+	require.NoError(t, err)
+
+	id := FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		Shard:      0,
+		BlockStart: testWriterStart,
+	}
+	shardDir := ShardDataDirPath(filePathPrefix, testNs1ID, 0)
+	offloadedPaths := []string{
+		filesetPathFromTimeAndIndex(shardDir, testWriterStart, 0, dataFileSuffix),
+		filesetPathFromTimeAndIndex(shardDir, testWriterStart, 0, indexFileSuffix),
+		filesetPathFromTimeAndIndex(shardDir, testWriterStart, 0, summariesFileSuffix),
+	}
+	requireExist := func(exist bool) {
+		for _, filePath := range offloadedPaths {
+			_, err := os.Stat(filePath)
+			require.Equal(t, exist, err == nil, filePath)
+		}
+	}
+
+	// Blocks that start at or after the given time are not offloaded.
+	result, err := coldTier.OffloadShard(testNs1ID, 0, testWriterStart)
+	require.NoError(t, err)
+	require.Equal(t, ColdTierOffloadResult{}, result)
+
+	result, err = coldTier.OffloadShard(testNs1ID, 0, testWriterStart.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, ColdTierOffloadResult{Offloaded: 1}, result)
+	requireExist(false)
+
+	offloaded, err := coldTier.Offloaded(id)
+	require.NoError(t, err)
+	require.True(t, offloaded)
+
+	keys, err := store.List("host0/")
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+
+	// The fileset remains complete and discoverable.
+	exists, err := DataFileSetExists(filePathPrefix, testNs1ID, 0, testWriterStart, 0)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// Reading the fileset fetches its files back.
+	r, err := NewReader(testBytesPool, opts.
+		SetInfoReaderBufferSize(testReaderBufferSize).
+		SetDataReaderBufferSize(testReaderBufferSize).
+		SetColdTier(coldTier))
+	require.NoError(t, err)
+	readTestData(t, r, 0, testWriterStart, entries)
+	requireExist(true)
+
+	// Offloading again neither re-uploads nor evicts recently fetched files.
+	result, err = coldTier.OffloadShard(testNs1ID, 0, testWriterStart.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, ColdTierOffloadResult{}, result)
+	requireExist(true)
+
+	now = now.Add(2 * time.Hour)
+	result, err = coldTier.OffloadShard(testNs1ID, 0, testWriterStart.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, ColdTierOffloadResult{Evicted: 1}, result)
+	requireExist(false)
+
+	// Once the fileset is cleaned up its objects are deleted.
+	filesets, err := DataFiles(filePathPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.NoError(t, DeleteFiles(filesets.Filepaths()))
+	result, err = coldTier.OffloadShard(testNs1ID, 0, testWriterStart.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, ColdTierOffloadResult{Deleted: 1}, result)
+
+	keys, err = store.List("host0/")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	offloaded, err = coldTier.Offloaded(id)
+	require.NoError(t, err)
+	require.False(t, offloaded)
+}
+
+func TestColdTierFetchNotOffloaded(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	coldTier, err := NewColdTier(testDefaultOpts.SetFilePathPrefix(dir),
+		NewColdTierOptions().SetStore(objectstore.NewFilesystemStore(dir)))
+	require.NoError(t, err)
+	require.NoError(t, coldTier.Fetch(FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		BlockStart: testWriterStart,
+	}))
+}
+
+func TestColdTierOptionsValidate(t *testing.T) {
+	require.Error(t, NewColdTierOptions().Validate())
+	opts := NewColdTierOptions().SetStore(objectstore.NewFilesystemStore(""))
+	require.NoError(t, opts.Validate())
+	require.Error(t, opts.SetCacheTTL(0).Validate())
+}
@@ -58,6 +58,7 @@ const (
 	indexDirName      = "index"
 	snapshotDirName   = "snapshots"
 	commitLogsDirName = "commitlogs"
+	tombstonesDirName = "tombstones"
 
 	// The maximum number of delimeters ('-' or '.') that is expected in a
 	// (base) filename.
@@ -143,6 +144,11 @@ func (f *FileSetFile) InfoFilePath() (string, bool) {
 	return f.filepath(infoFileSuffix)
 }
 
+// CheckpointFilePath returns the checkpoint file path of a filesetfile (if found).
+func (f *FileSetFile) CheckpointFilePath() (string, bool) {
+	return f.filepath(checkpointFileSuffix)
+}
+
 // SnapshotFilepath returns the info file path of a filesetfile (if found).
 func (f *FileSetFile) SnapshotFilepath() (string, bool) {
 	return f.filepath(snapshotDirName)
@@ -647,6 +653,12 @@ func readSnapshotInfoFile(filePathPrefix string, id FileSetFileIdentifier, reade
 		infoFilePath, readerBufferSize, expectedInfoDigest)
 }
 
+// ReadCheckpointFile returns the digest held by a complete checkpoint file,
+// which is the digest of the digests file of its fileset.
+func ReadCheckpointFile(filePath string) (uint32, error) {
+	return readCheckpointFile(filePath, digest.NewBuffer())
+}
+
 func readCheckpointFile(filePath string, digestBuf digest.Buffer) (uint32, error) {
 	exists, err := CompleteCheckpointFileExists(filePath)
 	if err != nil {
@@ -997,6 +1009,18 @@ func SnapshotFiles(filePathPrefix string, namespace ident.ID, shard uint32) (Fil
 	})
 }
 
+// IndexFiles returns a slice of all the names for all the index fileset files
+// for a given namespace.
+func IndexFiles(filePathPrefix string, namespace ident.ID) (FileSetFilesSlice, error) {
+	return filesetFiles(filesetFilesSelector{
+		fileSetType:    persist.FileSetFlushType,
+		contentType:    persist.FileSetIndexContentType,
+		filePathPrefix: filePathPrefix,
+		namespace:      namespace,
+		pattern:        filesetFilePattern,
+	})
+}
+
 // IndexSnapshotFiles returns a slice of all the names for all the index fileset files
 // for a given namespace.
 func IndexSnapshotFiles(filePathPrefix string, namespace ident.ID) (FileSetFilesSlice, error) {
@@ -1402,6 +1426,11 @@ func ShardSnapshotsDirPath(prefix string, namespace ident.ID, shard uint32) stri
 	return path.Join(namespacePath, strconv.Itoa(int(shard)))
 }
 
+// NamespaceTombstonesDirPath returns the path to the tombstones directory for a given namespace.
+func NamespaceTombstonesDirPath(prefix string, namespace ident.ID) string {
+	return path.Join(prefix, tombstonesDirName, namespace.String())
+}
+
 // CommitLogsDirPath returns the path to commit logs.
 func CommitLogsDirPath(prefix string) string {
 	return path.Join(prefix, commitLogsDirName)
@@ -197,6 +197,7 @@ func (m *merger) Merge(
 		if hasInMemoryData {
 			segmentReaders = appendBlockReadersToSegmentReaders(segmentReaders, mergeWithData)
 		}
+		tombstones := mergeWith.Tombstones(id, blockStart)
 
 		// Inform the writer to finalize the ID and tag iterator once
 		// the volume is written.
@@ -209,7 +210,7 @@ func (m *merger) Merge(
 		// In the special (but common) case that we're just copying the series data from the old file
 		// into the new one without merging or adding any additional data we can avoid recalculating
 		// the checksum.
-		if len(segmentReaders) == 1 && hasInMemoryData == false {
+		if len(segmentReaders) == 1 && hasInMemoryData == false && tombstones == nil {
 			segment, err := segmentReaders[0].Segment()
 			if err != nil {
 				return closer, err
@@ -219,7 +220,7 @@ func (m *merger) Merge(
 				return closer, err
 			}
 		} else {
-			if err := persistSegmentReaders(metadata, segmentReaders, iterResources, prepared.Persist); err != nil {
+			if err := persistSegmentReaders(metadata, segmentReaders, tombstones, iterResources, prepared.Persist); err != nil {
 				return closer, err
 			}
 		}
@@ -239,7 +240,8 @@ func (m *merger) Merge(
 			segmentReaders = appendBlockReadersToSegmentReaders(segmentReaders, mergeWithData.Blocks)
 
 			metadata := persist.NewMetadata(seriesMetadata)
-			err := persistSegmentReaders(metadata, segmentReaders, iterResources, prepared.Persist)
+			tombstones := mergeWith.Tombstones(ident.BytesID(seriesMetadata.ID), blockStart)
+			err := persistSegmentReaders(metadata, segmentReaders, tombstones, iterResources, prepared.Persist)
 
 			if err == nil {
 				err = onFlush.OnFlushNewSeries(persist.OnFlushNewSeriesEvent{
@@ -286,6 +288,7 @@ func segmentReaderFromData(
 func persistSegmentReaders(
 	metadata persist.Metadata,
 	segReaders []xio.SegmentReader,
+	tombstones xtime.Ranges,
 	ir iterResources,
 	persistFn persist.DataFn,
 ) error {
@@ -293,16 +296,17 @@ func persistSegmentReaders(
 		return nil
 	}
 
-	if len(segReaders) == 1 {
+	if len(segReaders) == 1 && tombstones == nil {
 		return persistSegmentReader(metadata, segReaders[0], persistFn)
 	}
 
-	return persistIter(metadata, segReaders, ir, persistFn)
+	return persistIter(metadata, segReaders, tombstones, ir, persistFn)
 }
 
 func persistIter(
 	metadata persist.Metadata,
 	segReaders []xio.SegmentReader,
+	tombstones xtime.Ranges,
 	ir iterResources,
 	persistFn persist.DataFn,
 ) error {
@@ -311,7 +315,15 @@ func persistIter(
 	encoder := ir.encoderPool.Get()
 	encoder.Reset(ir.blockStart, ir.blockAllocSize, ir.schema)
 	for it.Next() {
-		if err := encoder.Encode(it.Current()); err != nil {
+		dp, unit, annotation := it.Current()
+		if tombstones != nil && tombstones.Overlaps(xtime.Range{
+			Start: dp.Timestamp,
+			End:   dp.Timestamp.Add(time.Nanosecond),
+		}) {
+			// Drop datapoints that have been deleted.
+			continue
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
 			encoder.Close()
 			return err
 		}
@@ -321,6 +333,12 @@ func persistIter(
 		return err
 	}
 
+	if encoder.NumEncoded() == 0 {
+		// Don't write out series with all datapoints deleted.
+		encoder.Close()
+		return nil
+	}
+
 	segment := encoder.Discard()
 	return persistSegment(metadata, segment, persistFn)
 }
@@ -427,11 +427,77 @@ func TestMergeWithNoData(t *testing.T) {
 	testMergeWith(t, diskData, mergeTargetData, expected)
 }
 
+func TestMergeWithTombstones(t *testing.T) {
+	// This test scenario is when series have tombstoned datapoints both on
+	// disk and in the merge target.
+	diskData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	diskData.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(0 * time.Second), Value: 0},
+		{Timestamp: startTime.Add(1 * time.Second), Value: 1},
+		{Timestamp: startTime.Add(2 * time.Second), Value: 2},
+	}))
+	diskData.Set(id1, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(2 * time.Second), Value: 3},
+		{Timestamp: startTime.Add(3 * time.Second), Value: 4},
+	}))
+	diskData.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 5},
+	}))
+
+	mergeTargetData := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	mergeTargetData.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(3 * time.Second), Value: 6},
+	}))
+	mergeTargetData.Set(id3, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(2 * time.Second), Value: 7},
+		{Timestamp: startTime.Add(4 * time.Second), Value: 8},
+	}))
+
+	tombstones := map[string]xtime.Ranges{
+		id0.String(): xtime.NewRanges(xtime.Range{
+			Start: startTime.Add(1 * time.Second),
+			End:   startTime.Add(3 * time.Second),
+		}),
+		id1.String(): xtime.NewRanges(xtime.Range{
+			Start: startTime,
+			End:   startTime.Add(blockSize),
+		}),
+		id3.String(): xtime.NewRanges(xtime.Range{
+			Start: startTime.Add(4 * time.Second),
+			End:   startTime.Add(5 * time.Second),
+		}),
+	}
+
+	expected := newCheckedBytesByIDMap(newCheckedBytesByIDMapOptions{})
+	expected.Set(id0, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(0 * time.Second), Value: 0},
+		{Timestamp: startTime.Add(3 * time.Second), Value: 6},
+	}))
+	expected.Set(id2, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(1 * time.Second), Value: 5},
+	}))
+	expected.Set(id3, datapointsToCheckedBytes(t, []ts.Datapoint{
+		{Timestamp: startTime.Add(2 * time.Second), Value: 7},
+	}))
+
+	testMergeWithTombstones(t, diskData, mergeTargetData, tombstones, expected)
+}
+
 func testMergeWith(
 	t *testing.T,
 	diskData *checkedBytesMap,
 	mergeTargetData *checkedBytesMap,
 	expectedData *checkedBytesMap,
+) {
+	testMergeWithTombstones(t, diskData, mergeTargetData, nil, expectedData)
+}
+
+func testMergeWithTombstones(
+	t *testing.T,
+	diskData *checkedBytesMap,
+	mergeTargetData *checkedBytesMap,
+	tombstones map[string]xtime.Ranges,
+	expectedData *checkedBytesMap,
 ) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -470,7 +536,7 @@ func testMergeWith(
 		Shard:      uint32(8),
 		BlockStart: startTime,
 	}
-	mergeWith := mockMergeWithFromData(t, ctrl, diskData, mergeTargetData)
+	mergeWith := mockMergeWithFromData(t, ctrl, diskData, mergeTargetData, tombstones)
 	close, err := merger.Merge(fsID, mergeWith, 1, preparer, nsCtx, &persist.NoOpColdFlushNamespace{})
 	require.NoError(t, err)
 	require.False(t, deferClosed)
@@ -556,8 +622,14 @@ func mockMergeWithFromData(
 	ctrl *gomock.Controller,
 	diskData *checkedBytesMap,
 	mergeTargetData *checkedBytesMap,
+	tombstones map[string]xtime.Ranges,
 ) *MockMergeWith {
 	mergeWith := NewMockMergeWith(ctrl)
+	mergeWith.EXPECT().Tombstones(gomock.Any(), xtime.ToUnixNano(startTime)).
+		DoAndReturn(func(id ident.ID, _ xtime.UnixNano) xtime.Ranges {
+			return tombstones[id.String()]
+		}).
+		AnyTimes()
 
 	// Get the series IDs in the merge target that does not exist in disk data.
 	// This logic is not tested here because it should be part of tests of the
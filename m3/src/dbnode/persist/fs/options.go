@@ -100,6 +100,7 @@ type options struct {
 	forceBloomFilterMmapMemory           bool
 	mmapEnableHugePages                  bool
 	mmapReporter                         mmap.Reporter
+	coldTier                             ColdTier
 }
 
 // NewOptions creates a new set of fs options
@@ -366,3 +367,13 @@ func (o *options) SetMmapReporter(mmapReporter mmap.Reporter) Options {
 func (o *options) MmapReporter() mmap.Reporter {
 	return o.mmapReporter
 }
+
+func (o *options) SetColdTier(value ColdTier) Options {
+	opts := *o
+	opts.coldTier = value
+	return &opts
+}
+
+func (o *options) ColdTier() ColdTier {
+	return o.coldTier
+}
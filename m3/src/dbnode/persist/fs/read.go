@@ -161,6 +161,13 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 		indexFilepath = filesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix)
 		dataFilepath = filesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix)
 	case persist.FileSetFlushType:
+		if coldTier := r.opts.ColdTier(); coldTier != nil {
+			// Fetch the files of the fileset if offloaded to the cold tier.
+			if err := coldTier.Fetch(opts.Identifier); err != nil {
+				return err
+			}
+		}
+
 		shardDir = ShardDataDirPath(r.filePathPrefix, namespace, shard)
 
 		isLegacy := false
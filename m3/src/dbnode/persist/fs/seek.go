@@ -30,6 +30,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist"
 	xmsgpack "github.com/m3db/m3/src/dbnode/persist/fs/msgpack"
 	"github.com/m3db/m3/src/dbnode/persist/schema"
 	"github.com/m3db/m3/src/x/checked"
@@ -153,6 +154,19 @@ func (s *seeker) Open(
 		return errClonesShouldNotBeOpened
 	}
 
+	if coldTier := s.opts.opts.ColdTier(); coldTier != nil {
+		// Fetch the files of the fileset if offloaded to the cold tier.
+		if err := coldTier.Fetch(FileSetFileIdentifier{
+			FileSetContentType: persist.FileSetDataContentType,
+			Namespace:          namespace,
+			Shard:              shard,
+			BlockStart:         blockStart,
+			VolumeIndex:        volumeIndex,
+		}); err != nil {
+			return err
+		}
+	}
+
 	shardDir := ShardDataDirPath(s.opts.filePathPrefix, namespace, shard)
 	var (
 		infoFd, digestFd, bloomFilterFd, summariesFd *os.File
@@ -168,6 +168,9 @@ type seekersByTime struct {
 type rotatableSeekers struct {
 	active   seekersAndBloom
 	inactive seekersAndBloom
+	// accessedAt is when the seekers were last opened or borrowed, used to
+	// close the seekers of blocks offloaded to the cold tier once idle.
+	accessedAt time.Time
 }
 
 type seekerManagerPendingClose struct {
@@ -340,6 +343,10 @@ func (m *seekerManager) Borrow(shard uint32, start time.Time) (ConcurrentDataFil
 	if err != nil {
 		return nil, err
 	}
+	if rotatable, ok := byTime.seekers[startNano]; ok {
+		rotatable.accessedAt = m.opts.ClockOptions().NowFn()()
+		byTime.seekers[startNano] = rotatable
+	}
 
 	seekers := seekersAndBloom.seekers
 	availableSeekerIdx := -1
@@ -665,6 +672,7 @@ func (m *seekerManager) getOrOpenSeekersWithLock(start xtime.UnixNano, byTime *s
 	wg := &sync.WaitGroup{}
 	seekers.active.wg = wg
 	seekers.active.wg.Add(1)
+	seekers.accessedAt = m.opts.ClockOptions().NowFn()()
 	byTime.seekers[start] = seekers
 	byTime.Unlock()
 
@@ -756,7 +764,12 @@ func (m *seekerManager) openAnyUnopenSeekers(byTime *seekersByTime) error {
 	blockSize := m.namespaceMetadata.Options().RetentionOptions().BlockSize()
 	multiErr := xerrors.NewMultiError()
 
+	// Blocks offloaded to the cold tier are only opened on demand.
+	coldTierBefore, coldTierEnabled := m.coldTierOffloadBefore()
 	for t := start; !t.After(end); t = t.Add(blockSize) {
+		if coldTierEnabled && t.Before(coldTierBefore) {
+			continue
+		}
 		byTime.Lock()
 		_, err := m.getOrOpenSeekersWithLock(xtime.ToUnixNano(t), byTime)
 		byTime.Unlock()
@@ -912,6 +925,17 @@ func (m *seekerManager) earliestSeekableBlockStart() time.Time {
 	return earliestSeekableBlockStart
 }
 
+// coldTierOffloadBefore returns the time before which blocks are offloaded to
+// the cold tier and whether the cold tier is enabled for the namespace.
+func (m *seekerManager) coldTierOffloadBefore() (time.Time, bool) {
+	nsOpts := m.namespaceMetadata.Options()
+	if m.opts.ColdTier() == nil || !nsOpts.ColdTierOptions().Enabled() {
+		return time.Time{}, false
+	}
+	now := m.opts.ClockOptions().NowFn()()
+	return ColdTierOffloadBefore(nsOpts, now), true
+}
+
 func (m *seekerManager) latestSeekableBlockStart() time.Time {
 	nowFn := m.opts.ClockOptions().NowFn()
 	now := nowFn()
@@ -945,6 +969,12 @@ func (m *seekerManager) openCloseLoop() {
 	for {
 		earliestSeekableBlockStart :=
 			m.earliestSeekableBlockStart()
+		coldTierBefore, coldTierEnabled := m.coldTierOffloadBefore()
+		var coldTierIdleBefore time.Time
+		if coldTierEnabled {
+			now := m.opts.ClockOptions().NowFn()()
+			coldTierIdleBefore = now.Add(-m.opts.ColdTier().Options().CacheTTL())
+		}
 
 		m.RLock()
 		if m.status != seekerManagerOpen {
@@ -971,9 +1001,15 @@ func (m *seekerManager) openCloseLoop() {
 		m.RLock()
 		for shard, byTime := range m.seekersByShardIdx {
 			byTime.RLock()
-			for blockStartNano := range byTime.seekers {
+			for blockStartNano, seekers := range byTime.seekers {
 				blockStart := blockStartNano.ToTime()
-				if blockStart.Before(earliestSeekableBlockStart) ||
+				// Close idle seekers of blocks offloaded to the cold tier so
+				// that their fetched files can be evicted.
+				coldTierIdle := coldTierEnabled &&
+					blockStart.Before(coldTierBefore) &&
+					seekers.active.wg == nil &&
+					seekers.accessedAt.Before(coldTierIdleBefore)
+				if blockStart.Before(earliestSeekableBlockStart) || coldTierIdle ||
 					// Close seekers for shards that are no longer available. This
 					// ensure that seekers are eventually consistent w/ shard state.
 					!m.shardExistsWithLock(uint32(shard)) {
@@ -21,14 +21,17 @@
 package fs
 
 import (
+	"os"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/objectstore"
 	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/fortytw2/leaktest"
@@ -263,6 +266,64 @@ func TestSeekerManagerBorrowOpenSeekersLazy(t *testing.T) {
 	require.NoError(t, m.Close())
 }
 
+func TestSeekerManagerOpenAnyUnopenSeekersSkipsColdTierBlocks(t *testing.T) {
+	defer leaktest.CheckTimeout(t, 1*time.Minute)()
+
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	opts := testDefaultOpts.
+		SetFilePathPrefix(dir).
+		SetClockOptions(testDefaultOpts.ClockOptions().SetNowFn(func() time.Time {
+			return now
+		}))
+	coldTier, err := NewColdTier(opts, NewColdTierOptions().
+		SetStore(objectstore.NewFilesystemStore(dir)))
+	require.NoError(t, err)
+	opts = opts.SetColdTier(coldTier)
+
+	nsOpts := testNs1Metadata(t).Options().
+		SetColdTierOptions(namespace.NewColdTierOptions().
+			SetEnabled(true).
+			SetOffloadAfter(12 * time.Hour))
+	metadata, err := namespace.NewMetadata(testNs1ID, nsOpts)
+	require.NoError(t, err)
+
+	var (
+		openedLock sync.Mutex
+		opened     []time.Time
+	)
+	m := NewSeekerManager(nil, opts, defaultTestBlockRetrieverOptions).(*seekerManager)
+	m.newOpenSeekerFn = func(
+		shard uint32,
+		blockStart time.Time,
+		volume int,
+	) (DataFileSetSeeker, error) {
+		openedLock.Lock()
+		opened = append(opened, blockStart)
+		openedLock.Unlock()
+		return nil, errSeekerManagerFileSetNotFound
+	}
+
+	shardSet, err := sharding.NewShardSet(
+		sharding.NewShards([]uint32{0}, shard.Available),
+		sharding.DefaultHashFn(1),
+	)
+	require.NoError(t, err)
+	require.NoError(t, m.Open(metadata, shardSet))
+	require.NoError(t, m.CacheShardIndices([]uint32{0}))
+	require.NoError(t, m.Close())
+
+	openedLock.Lock()
+	defer openedLock.Unlock()
+	require.NotEmpty(t, opened)
+	offloadBefore := ColdTierOffloadBefore(nsOpts, now)
+	for _, blockStart := range opened {
+		require.False(t, blockStart.Before(offloadBefore))
+	}
+}
+
 // TestSeekerManagerOpenCloseLoop tests the openCloseLoop of the SeekerManager
 // by making sure that it makes the right decisions with regards to cleaning
 // up resources based on their state.
@@ -43,6 +43,7 @@ import (
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/mmap"
+	"github.com/m3db/m3/src/x/objectstore"
 	"github.com/m3db/m3/src/x/pool"
 	"github.com/m3db/m3/src/x/serialize"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -488,6 +489,77 @@ type Options interface {
 
 	// MmapReporter returns the mmap reporter.
 	MmapReporter() mmap.Reporter
+
+	// SetColdTier sets the cold tier that data filesets of namespaces with
+	// the cold tier enabled are offloaded to, nil if there is none.
+	SetColdTier(value ColdTier) Options
+
+	// ColdTier returns the cold tier that data filesets of namespaces with
+	// the cold tier enabled are offloaded to, nil if there is none.
+	ColdTier() ColdTier
+}
+
+// ColdTier offloads the data, index and summaries files of cold data filesets
+// to an object store and fetches them back on demand. The info, digest,
+// bloom filter and checkpoint files of offloaded filesets are kept locally so
+// that they remain complete and discoverable.
+type ColdTier interface {
+	// OffloadShard offloads the latest complete data filesets of the shard
+	// with block starts before the given time, evicts the offloaded files
+	// fetched back that were not accessed within the cache TTL and deletes
+	// the objects of offloaded filesets that no longer exist locally.
+	OffloadShard(
+		namespace ident.ID,
+		shard uint32,
+		before time.Time,
+	) (ColdTierOffloadResult, error)
+
+	// Fetch fetches the offloaded files of a data fileset back from the
+	// object store unless they are already present locally. It is a no-op
+	// for filesets that have not been offloaded.
+	Fetch(id FileSetFileIdentifier) error
+
+	// Offloaded returns whether a data fileset has been offloaded.
+	Offloaded(id FileSetFileIdentifier) (bool, error)
+
+	// Options returns the cold tier options.
+	Options() ColdTierOptions
+}
+
+// ColdTierOffloadResult is the result of offloading a shard to a cold tier.
+type ColdTierOffloadResult struct {
+	// Offloaded is the number of filesets offloaded.
+	Offloaded int
+	// Evicted is the number of filesets whose fetched files were evicted.
+	Evicted int
+	// Deleted is the number of filesets whose objects were deleted.
+	Deleted int
+}
+
+// ColdTierOptions represents the options for a cold tier.
+type ColdTierOptions interface {
+	// Validate will validate the options and return an error if not valid.
+	Validate() error
+
+	// SetStore sets the object store files are offloaded to.
+	SetStore(value objectstore.Store) ColdTierOptions
+
+	// Store returns the object store files are offloaded to.
+	Store() objectstore.Store
+
+	// SetKeyPrefix sets the prefix of the keys of offloaded files.
+	SetKeyPrefix(value string) ColdTierOptions
+
+	// KeyPrefix returns the prefix of the keys of offloaded files.
+	KeyPrefix() string
+
+	// SetCacheTTL sets how long offloaded files fetched back are kept
+	// locally after they were last accessed.
+	SetCacheTTL(value time.Duration) ColdTierOptions
+
+	// CacheTTL returns how long offloaded files fetched back are kept
+	// locally after they were last accessed.
+	CacheTTL() time.Duration
 }
 
 // BlockRetrieverOptions represents the options for block retrieval
@@ -549,6 +621,13 @@ type MergeWith interface {
 		fn ForEachRemainingFn,
 		nsCtx namespace.Context,
 	) error
+
+	// Tombstones returns the time ranges of the series that have been
+	// deleted and must be dropped from the merged block, or nil if none.
+	Tombstones(
+		seriesID ident.ID,
+		blockStart xtime.UnixNano,
+	) xtime.Ranges
 }
 
 // Merger is in charge of merging filesets with some target MergeWith interface.
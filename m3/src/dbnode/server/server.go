@@ -49,6 +49,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/environment"
 	"github.com/m3db/m3/src/dbnode/kvconfig"
 	"github.com/m3db/m3/src/dbnode/namespace"
+	grpcnode "github.com/m3db/m3/src/dbnode/network/server/grpcproto/node"
 	hjcluster "github.com/m3db/m3/src/dbnode/network/server/httpjson/cluster"
 	hjnode "github.com/m3db/m3/src/dbnode/network/server/httpjson/node"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
@@ -96,6 +97,8 @@ import (
 	"github.com/uber/tchannel-go"
 	"go.etcd.io/etcd/embed"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -504,6 +507,24 @@ func Run(runOpts RunOptions) {
 		SetIndexBloomFilterFalsePositivePercent(cfg.Filesystem.BloomFilterFalsePositivePercentOrDefault()).
 		SetMmapReporter(mmapReporter)
 
+	if coldTierCfg := cfg.Filesystem.ColdTier; coldTierCfg != nil {
+		store, err := coldTierCfg.Store.NewStore()
+		if err != nil {
+			logger.Fatal("could not create cold tier object store", zap.Error(err))
+		}
+		coldTierOpts := fs.NewColdTierOptions().
+			SetStore(store).
+			SetKeyPrefix(coldTierCfg.KeyPrefixOrDefault(hostID))
+		if coldTierCfg.CacheTTL != nil {
+			coldTierOpts = coldTierOpts.SetCacheTTL(*coldTierCfg.CacheTTL)
+		}
+		coldTier, err := fs.NewColdTier(fsopts, coldTierOpts)
+		if err != nil {
+			logger.Fatal("could not create cold tier", zap.Error(err))
+		}
+		fsopts = fsopts.SetColdTier(coldTier)
+	}
+
 	var commitLogQueueSize int
 	specified := cfg.CommitLog.Queue.Size
 	switch cfg.CommitLog.Queue.CalculationType {
@@ -671,6 +692,31 @@ func Run(runOpts RunOptions) {
 	defer httpjsonNodeClose()
 	logger.Info("node httpjson: listening", zap.String("address", cfg.HTTPNodeListenAddress))
 
+	if cfg.GRPCNodeListenAddress != "" {
+		grpcNodeOpts := grpcnode.NewOptions().
+			SetReflectionEnabled(cfg.GRPCNodeReflectionEnabled).
+			SetInstrumentOptions(opts.InstrumentOptions())
+		if cfg.GRPCNodeMaxMessageSize > 0 {
+			grpcNodeOpts = grpcNodeOpts.SetMaxMessageSize(cfg.GRPCNodeMaxMessageSize)
+		}
+		if cfg.GRPCNodeTLS != nil {
+			tlsConfig, err := cfg.GRPCNodeTLS.NewServerConfig(opts.InstrumentOptions())
+			if err != nil {
+				logger.Fatal("could not create gRPC TLS config", zap.Error(err))
+			}
+			grpcNodeOpts = grpcNodeOpts.SetServerOptions(append(grpcNodeOpts.ServerOptions(),
+				grpc.Creds(credentials.NewTLS(tlsConfig))))
+		}
+		grpcNodeClose, err := grpcnode.NewServer(service,
+			cfg.GRPCNodeListenAddress, contextPool, grpcNodeOpts).ListenAndServe()
+		if err != nil {
+			logger.Fatal("could not open gRPC interface",
+				zap.String("address", cfg.GRPCNodeListenAddress), zap.Error(err))
+		}
+		defer grpcNodeClose()
+		logger.Info("node gRPC: listening", zap.String("address", cfg.GRPCNodeListenAddress))
+	}
+
 	if cfg.DebugListenAddress != "" {
 		var debugWriter xdebug.ZipWriter
 		handlerOpts, err := placement.NewHandlerOptions(syncCfg.ClusterClient,
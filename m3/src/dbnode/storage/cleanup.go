@@ -152,6 +152,11 @@ func (m *cleanupManager) Cleanup(t time.Time, isBootstrapped bool) error {
 			"encountered errors when cleaning up data files for %v: %v", t, err))
 	}
 
+	if err := m.offloadColdDataFiles(t, namespaces); err != nil {
+		multiErr = multiErr.Add(fmt.Errorf(
+			"encountered errors when offloading data files to the cold tier for %v: %v", t, err))
+	}
+
 	if err := m.cleanupExpiredIndexFiles(t, namespaces); err != nil {
 		multiErr = multiErr.Add(fmt.Errorf(
 			"encountered errors when cleaning up index files for %v: %v", t, err))
@@ -251,6 +256,40 @@ func (m *cleanupManager) cleanupDataFiles(t time.Time, namespaces []databaseName
 	return multiErr.FinalError()
 }
 
+// offloadColdDataFiles offloads the data filesets of blocks older than the cold
+// tier offload after duration of namespaces with the cold tier enabled. It runs
+// after the data files are cleaned up so that the objects of the filesets just
+// cleaned up are deleted from the cold tier.
+func (m *cleanupManager) offloadColdDataFiles(t time.Time, namespaces []databaseNamespace) error {
+	coldTier := m.opts.CommitLogOptions().FilesystemOptions().ColdTier()
+	if coldTier == nil {
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, n := range namespaces {
+		if !n.Options().ColdTierOptions().Enabled() {
+			continue
+		}
+		before := fs.ColdTierOffloadBefore(n.Options(), t)
+		for _, shard := range n.OwnedShards() {
+			result, err := coldTier.OffloadShard(n.ID(), shard.ID(), before)
+			if err != nil {
+				multiErr = multiErr.Add(err)
+				continue
+			}
+			if result.Offloaded > 0 || result.Deleted > 0 {
+				m.logger.Info("offloaded data files to the cold tier",
+					zap.Stringer("namespace", n.ID()),
+					zap.Uint32("shard", shard.ID()),
+					zap.Int("offloaded", result.Offloaded),
+					zap.Int("deleted", result.Deleted))
+			}
+		}
+	}
+	return multiErr.FinalError()
+}
+
 func (m *cleanupManager) cleanupExpiredIndexFiles(t time.Time, namespaces []databaseNamespace) error {
 	multiErr := xerrors.NewMultiError()
 	for _, n := range namespaces {
@@ -125,6 +125,8 @@ type databaseMetrics struct {
 	unknownNamespaceFetchBlocks         tally.Counter
 	unknownNamespaceFetchBlocksMetadata tally.Counter
 	unknownNamespaceQueryIDs            tally.Counter
+	unknownNamespaceDeleteSeries        tally.Counter
+	unknownNamespaceIndexCardinality    tally.Counter
 	errQueryIDsIndexDisabled            tally.Counter
 	errWriteTaggedIndexDisabled         tally.Counter
 }
@@ -142,6 +144,8 @@ func newDatabaseMetrics(scope tally.Scope) databaseMetrics {
 		unknownNamespaceFetchBlocks:         unknownNamespaceScope.Counter("fetch-blocks"),
 		unknownNamespaceFetchBlocksMetadata: unknownNamespaceScope.Counter("fetch-blocks-metadata"),
 		unknownNamespaceQueryIDs:            unknownNamespaceScope.Counter("query-ids"),
+		unknownNamespaceDeleteSeries:        unknownNamespaceScope.Counter("delete-series"),
+		unknownNamespaceIndexCardinality:    unknownNamespaceScope.Counter("index-cardinality"),
 		errQueryIDsIndexDisabled:            indexDisabledScope.Counter("err-query-ids"),
 		errWriteTaggedIndexDisabled:         indexDisabledScope.Counter("err-write-tagged"),
 	}
@@ -999,6 +1003,34 @@ func (d *db) Truncate(namespace ident.ID) (int64, error) {
 	return n.Truncate()
 }
 
+func (d *db) DeleteSeries(
+	ctx context.Context,
+	namespace ident.ID,
+	query index.Query,
+	start, end time.Time,
+) (map[uint32]int64, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		d.metrics.unknownNamespaceDeleteSeries.Inc(1)
+		return nil, err
+	}
+	return n.DeleteSeries(ctx, query, start, end)
+}
+
+func (d *db) IndexCardinality(
+	ctx context.Context,
+	namespace ident.ID,
+	start, end time.Time,
+	opts index.CardinalityOptions,
+) ([]index.BlockCardinality, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		d.metrics.unknownNamespaceIndexCardinality.Inc(1)
+		return nil, err
+	}
+	return n.IndexCardinality(ctx, start, end, opts)
+}
+
 func (d *db) IsOverloaded() bool {
 	queueSize := float64(d.commitLog.QueueLength())
 	queueCapacity := float64(d.opts.CommitLogOptions().BacklogQueueSize())
@@ -113,6 +113,23 @@ func (m *fsMergeWithMem) fetchBlocks(
 	return block.FetchBlockResult{}, false, nil
 }
 
+func (m *fsMergeWithMem) Tombstones(
+	seriesID ident.ID,
+	blockStart xtime.UnixNano,
+) xtime.Ranges {
+	// Series with tombstones in a block are always marked dirty for that
+	// block, so only dirty series need to be looked up.
+	_, exists := m.dirtySeries.Get(idAndBlockStart{
+		blockStart: blockStart,
+		id:         seriesID.Bytes(),
+	})
+	if !exists {
+		return nil
+	}
+
+	return m.shard.SeriesTombstones(seriesID)
+}
+
 // The data passed to ForEachRemaining (through the fs.ForEachRemainingFn) is
 // basically a copy that will be finalized when the context is closed, but the
 // ID and tags are expected to live for as long as the caller of the MergeWith
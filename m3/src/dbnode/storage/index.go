@@ -1179,6 +1179,29 @@ func (i *nsIndex) shardsFilterID() func(id ident.ID) bool {
 	return v
 }
 
+func composeFilterID(filters ...func(ident.ID) bool) func(ident.ID) bool {
+	var nonNil []func(ident.ID) bool
+	for _, filter := range filters {
+		if filter != nil {
+			nonNil = append(nonNil, filter)
+		}
+	}
+	switch len(nonNil) {
+	case 0:
+		return nil
+	case 1:
+		return nonNil[0]
+	}
+	return func(id ident.ID) bool {
+		for _, filter := range nonNil {
+			if !filter(id) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
 func (i *nsIndex) Query(
 	ctx context.Context,
 	query index.Query,
@@ -1201,7 +1224,7 @@ func (i *nsIndex) Query(
 	results := i.resultsPool.Get()
 	results.Reset(i.nsMetadata.ID(), index.QueryResultsOptions{
 		SizeLimit: opts.SeriesLimit,
-		FilterID:  i.shardsFilterID(),
+		FilterID:  composeFilterID(i.shardsFilterID(), opts.FilterID),
 	})
 	ctx.RegisterFinalizer(results)
 	exhaustive, err := i.query(ctx, query, results, opts, i.execBlockQueryFn, logFields)
@@ -1326,6 +1349,56 @@ func (i *nsIndex) query(
 	return exhaustive, nil
 }
 
+func (i *nsIndex) Cardinality(
+	ctx context.Context,
+	start, end time.Time,
+	opts index.CardinalityOptions,
+) ([]index.BlockCardinality, error) {
+	ctx, sp := ctx.StartTraceSpan(tracepoint.NSIdxCardinality)
+	sp.LogFields(
+		opentracinglog.String("namespace", i.nsMetadata.ID().String()),
+		opentracinglog.Int("limit", opts.Limit),
+		xopentracing.Time("start", start),
+		xopentracing.Time("end", end),
+	)
+	defer sp.Finish()
+
+	i.state.RLock()
+	if !i.isOpenWithRLock() {
+		i.state.RUnlock()
+		return nil, errDbIndexUnableToQueryClosed
+	}
+
+	// Track this as an inflight query that needs to finish
+	// when the index is closed.
+	i.queriesWg.Add(1)
+	defer i.queriesWg.Done()
+
+	blocks, err := i.blocksForQueryWithRLock(xtime.NewRanges(xtime.Range{
+		Start: start,
+		End:   end,
+	}))
+
+	// Can now release the lock and compute the cardinality without holding the lock.
+	i.state.RUnlock()
+
+	if err != nil {
+		sp.LogFields(opentracinglog.Error(err))
+		return nil, err
+	}
+
+	results := make([]index.BlockCardinality, 0, len(blocks))
+	for _, block := range blocks {
+		result, err := block.Cardinality(opts)
+		if err != nil {
+			sp.LogFields(opentracinglog.Error(err))
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
 func (i *nsIndex) queryWithSpan(
 	ctx context.Context,
 	query index.Query,
@@ -36,6 +36,7 @@ import (
 	"github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
 	"github.com/m3db/m3/src/m3ninx/persist"
+	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/m3ninx/search"
 	"github.com/m3db/m3/src/m3ninx/search/executor"
 	"github.com/m3db/m3/src/x/context"
@@ -699,6 +700,166 @@ func (b *block) aggregateWithSpan(
 	return exhaustive, nil
 }
 
+func (b *block) Cardinality(opts CardinalityOptions) (BlockCardinality, error) {
+	if err := opts.Validate(); err != nil {
+		return BlockCardinality{}, err
+	}
+
+	b.RLock()
+	defer b.RUnlock()
+
+	if b.state == blockStateClosed {
+		return BlockCardinality{}, ErrUnableToQueryBlockClosed
+	}
+
+	iterateOpts := fieldsAndTermsIteratorOpts{
+		iterateTerms: true,
+		allowFn: func(field []byte) bool {
+			// skip the ID field, every series has a distinct ID.
+			return !bytes.Equal(field, doc.IDReservedFieldName)
+		},
+	}
+
+	var searcher search.Searcher
+	if opts.Query != nil {
+		s, err := opts.Query.SearchQuery().Searcher()
+		if err != nil {
+			return BlockCardinality{}, err
+		}
+		searcher = s
+	}
+
+	iter, err := b.newFieldsAndTermsIteratorFn(nil, iterateOpts)
+	if err != nil {
+		return BlockCardinality{}, err
+	}
+
+	iterClosed := false // tracking whether we need to free the iterator at the end.
+	defer func() {
+		if !iterClosed {
+			iter.Close()
+		}
+	}()
+
+	var (
+		result          = BlockCardinality{BlockStart: b.blockStart}
+		metricNames     = make(cardinalityCounts)
+		labelNames      = make(cardinalityCounts)
+		labelValuePairs = make(cardinalityCounts)
+		labelValues     = make(cardinalityLabelValues)
+	)
+	for _, s := range b.segmentsWithRLock() {
+		var matched postings.List
+		if searcher != nil {
+			matched, err = searchSegment(searcher, s)
+			if err != nil {
+				return BlockCardinality{}, err
+			}
+			result.NumSeries += int64(matched.Len())
+		} else {
+			result.NumSeries += s.Size()
+		}
+
+		err = iter.Reset(s, iterateOpts)
+		if err != nil {
+			return BlockCardinality{}, err
+		}
+		iterClosed = false // only once the iterator has been successfully Reset().
+
+		// NB: the number of label names is small enough to be counted exactly
+		// and the label values are tracked to count the distinct values across
+		// segments, however the metric names and label value pairs are only
+		// tracked if they are in the top entries of the segment to bound
+		// memory usage.
+		var (
+			segMetricNames     = newCardinalityTopK(opts.Limit)
+			segLabelValuePairs = newCardinalityTopK(opts.Limit)
+			field              []byte
+			fieldSeries        int64
+		)
+		addField := func() {
+			if fieldSeries == 0 {
+				return
+			}
+			labelNames[cardinalityEntryKey{name: string(field)}] += fieldSeries
+		}
+		for iter.Next() {
+			currField, term := iter.Current()
+			if !bytes.Equal(currField, field) {
+				// fields are iterated in order, so all the terms of a field
+				// are iterated before moving on to the next field.
+				addField()
+				field = append(field[:0], currField...)
+				fieldSeries = 0
+			}
+
+			numSeries, err := cardinalityPostingsLen(iter.Postings(), matched)
+			if err != nil {
+				return BlockCardinality{}, err
+			}
+			if numSeries == 0 {
+				continue
+			}
+			fieldSeries += numSeries
+			labelValues.add(currField, term)
+			segLabelValuePairs.add(currField, term, numSeries)
+			if bytes.Equal(currField, opts.MetricNameTag) {
+				segMetricNames.add(term, nil, numSeries)
+			}
+		}
+		addField()
+
+		if err := iter.Err(); err != nil {
+			return BlockCardinality{}, err
+		}
+
+		iterClosed = true
+		if err := iter.Close(); err != nil {
+			return BlockCardinality{}, err
+		}
+
+		metricNames.add(segMetricNames.entries)
+		labelValuePairs.add(segLabelValuePairs.entries)
+	}
+
+	result.SeriesCountByMetricName = metricNames.top(opts.Limit)
+	result.SeriesCountByLabelName = labelNames.top(opts.Limit)
+	result.SeriesCountByLabelValuePair = labelValuePairs.top(opts.Limit)
+	labelValueCounts := make(cardinalityCounts)
+	labelValueCounts.add(labelValues.counts())
+	result.LabelValueCountByLabelName = labelValueCounts.top(opts.Limit)
+	result.LabelValuesByLabelName = labelValues.sorted()
+	return result, nil
+}
+
+// searchSegment returns the postings of the series of the segment matched by
+// the searcher. NB: the postings remain valid once the reader is closed since
+// the segments of a block are not closed while the block lock is held.
+func searchSegment(searcher search.Searcher, s segment.Segment) (postings.List, error) {
+	reader, err := s.Reader()
+	if err != nil {
+		return nil, err
+	}
+	pl, err := searcher.Search(reader)
+	if closeErr := reader.Close(); err == nil {
+		err = closeErr
+	}
+	return pl, err
+}
+
+// cardinalityPostingsLen returns the number of series of the postings that
+// are matched, all of them are if matched is nil.
+func cardinalityPostingsLen(pl postings.List, matched postings.List) (int64, error) {
+	if matched == nil {
+		return int64(pl.Len()), nil
+	}
+	intersection := pl.Clone()
+	if err := intersection.Intersect(matched); err != nil {
+		return 0, err
+	}
+	return int64(intersection.Len()), nil
+}
+
 func (b *block) appendFieldAndTermToBatch(
 	batch []AggregateResultsEntry,
 	field, term []byte,
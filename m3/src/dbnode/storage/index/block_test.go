@@ -2045,6 +2045,148 @@ func testSegment(t *testing.T, docs ...doc.Document) segment.Segment {
 	return seg
 }
 
+func TestBlockE2EInsertCardinality(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blockSize := time.Hour
+
+	testMD := newTestNSMetadata(t)
+	now := time.Now()
+	blockStart := now.Truncate(blockSize)
+
+	blk, err := NewBlock(blockStart, testMD, BlockOptions{}, testOpts)
+	require.NoError(t, err)
+
+	docs := []doc.Document{
+		{
+			ID: []byte("a"),
+			Fields: []doc.Field{
+				{Name: []byte("__name__"), Value: []byte("up")},
+				{Name: []byte("job"), Value: []byte("api")},
+			},
+		},
+		{
+			ID: []byte("b"),
+			Fields: []doc.Field{
+				{Name: []byte("__name__"), Value: []byte("up")},
+				{Name: []byte("job"), Value: []byte("db")},
+			},
+		},
+		{
+			ID: []byte("c"),
+			Fields: []doc.Field{
+				{Name: []byte("__name__"), Value: []byte("requests")},
+				{Name: []byte("job"), Value: []byte("api")},
+				{Name: []byte("path"), Value: []byte("/x")},
+			},
+		},
+	}
+
+	batch := NewWriteBatch(WriteBatchOptions{
+		IndexBlockSize: blockSize,
+	})
+	for _, d := range docs {
+		h := NewMockOnIndexSeries(ctrl)
+		h.EXPECT().OnIndexFinalize(xtime.ToUnixNano(blockStart))
+		h.EXPECT().OnIndexSuccess(xtime.ToUnixNano(blockStart))
+		batch.Append(WriteBatchEntry{
+			Timestamp:     blockStart.Add(time.Minute),
+			OnIndexSeries: h,
+		}, d)
+	}
+
+	res, err := blk.WriteBatch(batch)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.NumSuccess)
+
+	_, err = blk.Cardinality(CardinalityOptions{})
+	require.Error(t, err)
+
+	result, err := blk.Cardinality(CardinalityOptions{
+		Limit:         2,
+		MetricNameTag: DefaultCardinalityMetricNameTag,
+	})
+	require.NoError(t, err)
+
+	entry := func(name, value string, count int64) CardinalityEntry {
+		e := CardinalityEntry{Name: []byte(name), Count: count}
+		if value != "" {
+			e.Value = []byte(value)
+		}
+		return e
+	}
+	labelValues := func(name string, values ...string) CardinalityLabelValues {
+		lv := CardinalityLabelValues{Name: []byte(name)}
+		for _, v := range values {
+			lv.Values = append(lv.Values, []byte(v))
+		}
+		return lv
+	}
+	require.Equal(t, BlockCardinality{
+		BlockStart: blockStart,
+		NumSeries:  3,
+		SeriesCountByMetricName: []CardinalityEntry{
+			entry("up", "", 2),
+			entry("requests", "", 1),
+		},
+		SeriesCountByLabelName: []CardinalityEntry{
+			entry("__name__", "", 3),
+			entry("job", "", 3),
+		},
+		SeriesCountByLabelValuePair: []CardinalityEntry{
+			entry("__name__", "up", 2),
+			entry("job", "api", 2),
+		},
+		LabelValueCountByLabelName: []CardinalityEntry{
+			entry("__name__", "", 2),
+			entry("job", "", 2),
+		},
+		LabelValuesByLabelName: []CardinalityLabelValues{
+			labelValues("__name__", "requests", "up"),
+			labelValues("job", "api", "db"),
+			labelValues("path", "/x"),
+		},
+	}, result)
+
+	// Restricting to the series of a query only counts the matched series.
+	result, err = blk.Cardinality(CardinalityOptions{
+		Limit:         2,
+		MetricNameTag: DefaultCardinalityMetricNameTag,
+		Query:         &Query{Query: idx.NewTermQuery([]byte("job"), []byte("api"))},
+	})
+	require.NoError(t, err)
+	require.Equal(t, BlockCardinality{
+		BlockStart: blockStart,
+		NumSeries:  2,
+		SeriesCountByMetricName: []CardinalityEntry{
+			entry("requests", "", 1),
+			entry("up", "", 1),
+		},
+		SeriesCountByLabelName: []CardinalityEntry{
+			entry("__name__", "", 2),
+			entry("job", "", 2),
+		},
+		SeriesCountByLabelValuePair: []CardinalityEntry{
+			entry("job", "api", 2),
+			entry("__name__", "requests", 1),
+		},
+		LabelValueCountByLabelName: []CardinalityEntry{
+			entry("__name__", "", 2),
+			entry("job", "", 1),
+		},
+		LabelValuesByLabelName: []CardinalityLabelValues{
+			labelValues("__name__", "requests", "up"),
+			labelValues("job", "api"),
+			labelValues("path", "/x"),
+		},
+	}, result)
+
+	require.NoError(t, blk.Close())
+	_, err = blk.Cardinality(CardinalityOptions{Limit: 2})
+	require.Equal(t, ErrUnableToQueryBlockClosed, err)
+}
+
 func testDoc1() doc.Document {
 	return doc.Document{
 		ID: []byte("foo"),
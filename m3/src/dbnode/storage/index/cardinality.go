@@ -0,0 +1,330 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"bytes"
+	"container/heap"
+	"errors"
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultCardinalityLimit is the default number of entries returned for
+	// each of the cardinality statistics.
+	DefaultCardinalityLimit = 10
+)
+
+var (
+	// DefaultCardinalityMetricNameTag is the default tag holding the metric name.
+	DefaultCardinalityMetricNameTag = []byte("__name__")
+
+	errCardinalityLimitInvalid = errors.New("cardinality limit must be positive")
+)
+
+// CardinalityOptions configures the computation of index block cardinality.
+type CardinalityOptions struct {
+	// Limit is the number of entries returned for each of the statistics.
+	Limit int
+	// MetricNameTag is the tag holding the metric name.
+	MetricNameTag []byte
+	// Query restricts the statistics to the series matching it if set.
+	Query *Query
+}
+
+// Validate validates the cardinality options.
+func (o CardinalityOptions) Validate() error {
+	if o.Limit <= 0 {
+		return errCardinalityLimitInvalid
+	}
+	return nil
+}
+
+// CardinalityEntry is a single entry of the cardinality statistics.
+type CardinalityEntry struct {
+	// Name is the label name, or the metric name for series counts by metric name.
+	Name []byte
+	// Value is the label value, only set for series counts by label value pair.
+	Value []byte
+	// Count is the number of series, or distinct values, of the entry.
+	Count int64
+}
+
+// CardinalityLabelValues is the distinct values of a label name.
+type CardinalityLabelValues struct {
+	Name   []byte
+	Values [][]byte
+}
+
+// BlockCardinality is the cardinality of an index block.
+// NB: the series counts are summed across the segments of the block, series
+// present in several segments are counted more than once and entries are
+// only considered if they are in the top entries of at least one segment,
+// so the series counts are an approximation for blocks with several segments.
+// The label value counts are exact since the values of the segments are merged.
+type BlockCardinality struct {
+	BlockStart                  time.Time
+	NumSeries                   int64
+	SeriesCountByMetricName     []CardinalityEntry
+	SeriesCountByLabelName      []CardinalityEntry
+	SeriesCountByLabelValuePair []CardinalityEntry
+	LabelValueCountByLabelName  []CardinalityEntry
+	// LabelValuesByLabelName is the distinct values of every label name, the
+	// counts of distinct values cannot be summed when merging blocks computed
+	// by different hosts since the same value can be indexed by several shards.
+	LabelValuesByLabelName []CardinalityLabelValues
+}
+
+// MergeBlockCardinality merges the cardinality of the same index blocks, e.g.
+// computed by different hosts, summing the series counts of each block and
+// merging the label values to count the distinct values of each label name,
+// keeping the top entries. The merged blocks are returned newest first and
+// without their label values.
+func MergeBlockCardinality(
+	blocks []BlockCardinality,
+	limit int,
+) []BlockCardinality {
+	type mergedBlock struct {
+		numSeries                   int64
+		seriesCountByMetricName     cardinalityCounts
+		seriesCountByLabelName      cardinalityCounts
+		seriesCountByLabelValuePair cardinalityCounts
+		labelValueCountByLabelName  cardinalityCounts
+		labelValues                 cardinalityLabelValues
+	}
+
+	var (
+		blockStarts []time.Time
+		byStart     = make(map[int64]*mergedBlock)
+	)
+	for _, b := range blocks {
+		key := b.BlockStart.UnixNano()
+		merged, ok := byStart[key]
+		if !ok {
+			merged = &mergedBlock{
+				seriesCountByMetricName:     make(cardinalityCounts),
+				seriesCountByLabelName:      make(cardinalityCounts),
+				seriesCountByLabelValuePair: make(cardinalityCounts),
+				labelValueCountByLabelName:  make(cardinalityCounts),
+				labelValues:                 make(cardinalityLabelValues),
+			}
+			byStart[key] = merged
+			blockStarts = append(blockStarts, b.BlockStart)
+		}
+
+		merged.numSeries += b.NumSeries
+		merged.seriesCountByMetricName.add(b.SeriesCountByMetricName)
+		merged.seriesCountByLabelName.add(b.SeriesCountByLabelName)
+		merged.seriesCountByLabelValuePair.add(b.SeriesCountByLabelValuePair)
+		if len(b.LabelValuesByLabelName) == 0 {
+			// NB: hosts not returning the label values only report the top
+			// label value counts, the largest count is the closest to the
+			// distinct count since values shared across hosts are unknown.
+			merged.labelValueCountByLabelName.max(b.LabelValueCountByLabelName)
+		}
+		for _, lv := range b.LabelValuesByLabelName {
+			merged.labelValues.add(lv.Name, lv.Values...)
+		}
+	}
+
+	sort.Slice(blockStarts, func(i, j int) bool {
+		return blockStarts[i].After(blockStarts[j])
+	})
+
+	result := make([]BlockCardinality, 0, len(blockStarts))
+	for _, blockStart := range blockStarts {
+		merged := byStart[blockStart.UnixNano()]
+		merged.labelValueCountByLabelName.max(merged.labelValues.counts())
+		result = append(result, BlockCardinality{
+			BlockStart:                  blockStart,
+			NumSeries:                   merged.numSeries,
+			SeriesCountByMetricName:     merged.seriesCountByMetricName.top(limit),
+			SeriesCountByLabelName:      merged.seriesCountByLabelName.top(limit),
+			SeriesCountByLabelValuePair: merged.seriesCountByLabelValuePair.top(limit),
+			LabelValueCountByLabelName:  merged.labelValueCountByLabelName.top(limit),
+		})
+	}
+	return result
+}
+
+// cardinalityEntryKey is the key of an entry when summing across segments.
+type cardinalityEntryKey struct {
+	name  string
+	value string
+}
+
+// cardinalityCounts sums cardinality entries across segments.
+type cardinalityCounts map[cardinalityEntryKey]int64
+
+func (c cardinalityCounts) add(entries []CardinalityEntry) {
+	for _, e := range entries {
+		c[cardinalityEntryKey{name: string(e.Name), value: string(e.Value)}] += e.Count
+	}
+}
+
+// max keeps the largest count of each entry rather than summing them.
+func (c cardinalityCounts) max(entries []CardinalityEntry) {
+	for _, e := range entries {
+		key := cardinalityEntryKey{name: string(e.Name), value: string(e.Value)}
+		if count, ok := c[key]; !ok || e.Count > count {
+			c[key] = e.Count
+		}
+	}
+}
+
+func (c cardinalityCounts) top(limit int) []CardinalityEntry {
+	topK := newCardinalityTopK(limit)
+	for k, count := range c {
+		topK.add([]byte(k.name), []byte(k.value), count)
+	}
+	return topK.sorted()
+}
+
+// cardinalityLabelValues tracks the distinct values of each label name.
+type cardinalityLabelValues map[string]map[string]struct{}
+
+// add adds the values of a label name, copying them since iterator bytes are
+// only valid until the iterator moves on.
+func (l cardinalityLabelValues) add(name []byte, values ...[]byte) {
+	distinct, ok := l[string(name)]
+	if !ok {
+		distinct = make(map[string]struct{}, len(values))
+		l[string(name)] = distinct
+	}
+	for _, v := range values {
+		distinct[string(v)] = struct{}{}
+	}
+}
+
+// counts returns the number of distinct values of each label name.
+func (l cardinalityLabelValues) counts() []CardinalityEntry {
+	result := make([]CardinalityEntry, 0, len(l))
+	for name, values := range l {
+		result = append(result, CardinalityEntry{
+			Name:  []byte(name),
+			Count: int64(len(values)),
+		})
+	}
+	return result
+}
+
+// sorted returns the distinct values of each label name sorted by name and value.
+func (l cardinalityLabelValues) sorted() []CardinalityLabelValues {
+	result := make([]CardinalityLabelValues, 0, len(l))
+	for name, distinct := range l {
+		values := make([][]byte, 0, len(distinct))
+		for v := range distinct {
+			values = append(values, []byte(v))
+		}
+		sort.Slice(values, func(i, j int) bool {
+			return bytes.Compare(values[i], values[j]) < 0
+		})
+		result = append(result, CardinalityLabelValues{
+			Name:   []byte(name),
+			Values: values,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i].Name, result[j].Name) < 0
+	})
+	return result
+}
+
+// cardinalityTopK keeps the entries with the highest counts.
+type cardinalityTopK struct {
+	limit   int
+	entries cardinalityEntriesMinHeap
+}
+
+func newCardinalityTopK(limit int) *cardinalityTopK {
+	return &cardinalityTopK{
+		limit:   limit,
+		entries: make(cardinalityEntriesMinHeap, 0, limit),
+	}
+}
+
+// add adds an entry, copying the name and value if the entry is kept since
+// iterator bytes are only valid until the iterator moves on.
+func (t *cardinalityTopK) add(name, value []byte, count int64) {
+	if len(t.entries) < t.limit {
+		heap.Push(&t.entries, newCardinalityEntry(name, value, count))
+		return
+	}
+
+	candidate := CardinalityEntry{Name: name, Value: value, Count: count}
+	if !cardinalityEntryLess(t.entries[0], candidate) {
+		return
+	}
+	t.entries[0] = newCardinalityEntry(name, value, count)
+	heap.Fix(&t.entries, 0)
+}
+
+// sorted returns the entries by descending count.
+func (t *cardinalityTopK) sorted() []CardinalityEntry {
+	result := make([]CardinalityEntry, len(t.entries))
+	copy(result, t.entries)
+	sort.Slice(result, func(i, j int) bool {
+		return cardinalityEntryLess(result[j], result[i])
+	})
+	return result
+}
+
+func newCardinalityEntry(name, value []byte, count int64) CardinalityEntry {
+	entry := CardinalityEntry{
+		Name:  append([]byte(nil), name...),
+		Count: count,
+	}
+	if len(value) > 0 {
+		entry.Value = append([]byte(nil), value...)
+	}
+	return entry
+}
+
+// cardinalityEntryLess orders entries by count, then by descending name and
+// value so that ties are resolved in favour of lexicographically smaller entries.
+func cardinalityEntryLess(a, b CardinalityEntry) bool {
+	if a.Count != b.Count {
+		return a.Count < b.Count
+	}
+	if cmp := bytes.Compare(a.Name, b.Name); cmp != 0 {
+		return cmp > 0
+	}
+	return bytes.Compare(a.Value, b.Value) > 0
+}
+
+type cardinalityEntriesMinHeap []CardinalityEntry
+
+func (h cardinalityEntriesMinHeap) Len() int           { return len(h) }
+func (h cardinalityEntriesMinHeap) Less(i, j int) bool { return cardinalityEntryLess(h[i], h[j]) }
+func (h cardinalityEntriesMinHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *cardinalityEntriesMinHeap) Push(x interface{}) {
+	*h = append(*h, x.(CardinalityEntry))
+}
+
+func (h *cardinalityEntriesMinHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCardinalityTopK(t *testing.T) {
+	topK := newCardinalityTopK(3)
+	name := []byte("name")
+	for i := 0; i < 10; i++ {
+		// NB: reuse the same buffer to ensure the entries kept are copied.
+		copy(name, fmt.Sprintf("n%03d", i))
+		topK.add(name, nil, int64(i%5))
+	}
+
+	require.Equal(t, []CardinalityEntry{
+		{Name: []byte("n004"), Count: 4},
+		{Name: []byte("n009"), Count: 4},
+		{Name: []byte("n003"), Count: 3},
+	}, topK.sorted())
+}
+
+func TestMergeBlockCardinality(t *testing.T) {
+	var (
+		first  = time.Unix(0, 0).Add(2 * time.Hour)
+		second = first.Add(2 * time.Hour)
+	)
+	blocks := []BlockCardinality{
+		{
+			BlockStart: first,
+			NumSeries:  2,
+			SeriesCountByMetricName: []CardinalityEntry{
+				{Name: []byte("up"), Count: 2},
+			},
+			SeriesCountByLabelValuePair: []CardinalityEntry{
+				{Name: []byte("job"), Value: []byte("api"), Count: 1},
+				{Name: []byte("job"), Value: []byte("db"), Count: 1},
+			},
+		},
+		{
+			BlockStart: second,
+			NumSeries:  1,
+			SeriesCountByMetricName: []CardinalityEntry{
+				{Name: []byte("requests"), Count: 1},
+			},
+		},
+		{
+			BlockStart: first,
+			NumSeries:  3,
+			SeriesCountByMetricName: []CardinalityEntry{
+				{Name: []byte("requests"), Count: 2},
+				{Name: []byte("up"), Count: 1},
+			},
+			SeriesCountByLabelValuePair: []CardinalityEntry{
+				{Name: []byte("job"), Value: []byte("db"), Count: 3},
+			},
+		},
+	}
+
+	require.Equal(t, []BlockCardinality{
+		{
+			BlockStart: second,
+			NumSeries:  1,
+			SeriesCountByMetricName: []CardinalityEntry{
+				{Name: []byte("requests"), Count: 1},
+			},
+			SeriesCountByLabelName:      []CardinalityEntry{},
+			SeriesCountByLabelValuePair: []CardinalityEntry{},
+			LabelValueCountByLabelName:  []CardinalityEntry{},
+		},
+		{
+			BlockStart: first,
+			NumSeries:  5,
+			SeriesCountByMetricName: []CardinalityEntry{
+				{Name: []byte("up"), Count: 3},
+				{Name: []byte("requests"), Count: 2},
+			},
+			SeriesCountByLabelName: []CardinalityEntry{},
+			SeriesCountByLabelValuePair: []CardinalityEntry{
+				{Name: []byte("job"), Value: []byte("db"), Count: 4},
+				{Name: []byte("job"), Value: []byte("api"), Count: 1},
+			},
+			LabelValueCountByLabelName: []CardinalityEntry{},
+		},
+	}, MergeBlockCardinality(blocks, 2))
+}
+
+func TestMergeBlockCardinalityLabelValues(t *testing.T) {
+	blockStart := time.Unix(0, 0).Add(2 * time.Hour)
+	labelValues := func(name string, values ...string) CardinalityLabelValues {
+		lv := CardinalityLabelValues{Name: []byte(name)}
+		for _, v := range values {
+			lv.Values = append(lv.Values, []byte(v))
+		}
+		return lv
+	}
+	blocks := []BlockCardinality{
+		{
+			BlockStart: blockStart,
+			LabelValuesByLabelName: []CardinalityLabelValues{
+				labelValues("job", "api", "db"),
+				labelValues("path", "/x"),
+			},
+		},
+		{
+			BlockStart: blockStart,
+			LabelValuesByLabelName: []CardinalityLabelValues{
+				labelValues("job", "api", "web"),
+				labelValues("path", "/x"),
+			},
+		},
+		{
+			// Blocks computed by hosts not returning the label values only
+			// contribute their count if it is larger than the merged one.
+			BlockStart: blockStart,
+			LabelValueCountByLabelName: []CardinalityEntry{
+				{Name: []byte("job"), Count: 2},
+				{Name: []byte("path"), Count: 4},
+			},
+		},
+	}
+
+	merged := MergeBlockCardinality(blocks, 10)
+	require.Equal(t, 1, len(merged))
+	require.Equal(t, []CardinalityEntry{
+		{Name: []byte("path"), Count: 4},
+		{Name: []byte("job"), Count: 3},
+	}, merged[0].LabelValueCountByLabelName)
+	require.Nil(t, merged[0].LabelValuesByLabelName)
+}
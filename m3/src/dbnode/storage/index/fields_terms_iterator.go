@@ -269,6 +269,10 @@ func (fti *fieldsAndTermsIter) Current() (field, term []byte) {
 	return fti.current.field, fti.current.term
 }
 
+func (fti *fieldsAndTermsIter) Postings() postings.List {
+	return fti.current.postings
+}
+
 func (fti *fieldsAndTermsIter) Err() error {
 	return fti.err
 }
@@ -37,6 +37,7 @@ import (
 	"github.com/m3db/m3/src/m3ninx/index/segment/builder"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
 	"github.com/m3db/m3/src/m3ninx/index/segment/mem"
+	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
@@ -761,6 +762,21 @@ func (mr *MockBlockMockRecorder) Aggregate(ctx, cancellable, opts, results, logF
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockBlock)(nil).Aggregate), ctx, cancellable, opts, results, logFields)
 }
 
+// Cardinality mocks base method
+func (m *MockBlock) Cardinality(opts CardinalityOptions) (BlockCardinality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", opts)
+	ret0, _ := ret[0].(BlockCardinality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockBlockMockRecorder) Cardinality(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockBlock)(nil).Cardinality), opts)
+}
+
 // AddResults mocks base method
 func (m *MockBlock) AddResults(resultsByVolumeType result.IndexBlockByVolumeType) error {
 	m.ctrl.T.Helper()
@@ -1016,6 +1032,20 @@ func (mr *MockfieldsAndTermsIteratorMockRecorder) Current() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockfieldsAndTermsIterator)(nil).Current))
 }
 
+// Postings mocks base method
+func (m *MockfieldsAndTermsIterator) Postings() postings.List {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Postings")
+	ret0, _ := ret[0].(postings.List)
+	return ret0
+}
+
+// Postings indicates an expected call of Postings
+func (mr *MockfieldsAndTermsIteratorMockRecorder) Postings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Postings", reflect.TypeOf((*MockfieldsAndTermsIterator)(nil).Postings))
+}
+
 // Err mocks base method
 func (m *MockfieldsAndTermsIterator) Err() error {
 	m.ctrl.T.Helper()
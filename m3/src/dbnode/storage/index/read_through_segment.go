@@ -249,6 +249,15 @@ func (s *readThroughSegmentReader) MatchField(field []byte) (postings.List, erro
 	return pl, err
 }
 
+// MatchRange is a pass through call, range queries are not cached since
+// their bounds are typically far more varied than terms or regexps.
+func (s *readThroughSegmentReader) MatchRange(
+	field []byte,
+	r index.TermRange,
+) (postings.List, error) {
+	return s.reader.MatchRange(field, r)
+}
+
 // MatchAll is a pass through call, since there's no postings list to cache.
 // NB(r): The postings list returned by match all is just an iterator
 // from zero to the maximum document number indexed by the segment and as such
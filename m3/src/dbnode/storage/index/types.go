@@ -36,6 +36,7 @@ import (
 	"github.com/m3db/m3/src/m3ninx/index/segment/builder"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
 	"github.com/m3db/m3/src/m3ninx/index/segment/mem"
+	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/x/context"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
@@ -86,6 +87,10 @@ type QueryOptions struct {
 	DocsLimit         int
 	RequireExhaustive bool
 	IterationOptions  IterationOptions
+
+	// FilterID, if provided, is applied in addition to the filter on the
+	// shards owned by the node to exclude unwanted IDs from the results.
+	FilterID func(id ident.ID) bool
 }
 
 // IterationOptions enables users to specify iteration preferences.
@@ -349,6 +354,10 @@ type Block interface {
 		logFields []opentracinglog.Field,
 	) (exhaustive bool, err error)
 
+	// Cardinality computes the top metric names, label names and label value
+	// pairs by series count, and the top label names by distinct value count.
+	Cardinality(opts CardinalityOptions) (BlockCardinality, error)
+
 	// AddResults adds bootstrap results to the block.
 	AddResults(resultsByVolumeType result.IndexBlockByVolumeType) error
 
@@ -803,6 +812,11 @@ type fieldsAndTermsIterator interface {
 	// NB: the element returned is only valid until the subsequent call to Next().
 	Current() (field, term []byte)
 
+	// Postings returns the postings list of the current term when iterating
+	// terms, it is not restricted by the query the iterator is restricted by.
+	// NB: the postings list returned is only valid until the subsequent call to Next().
+	Postings() postings.List
+
 	// Err returns any errors encountered during iteration.
 	Err() error
 
@@ -1056,6 +1056,94 @@ func TestNamespaceIndexBlockAggregateQuery(t *testing.T) {
 	}
 }
 
+func TestNamespaceIndexBlockCardinality(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	retention := 2 * time.Hour
+	blockSize := time.Hour
+	now := time.Now().Truncate(blockSize).Add(10 * time.Minute)
+	t0 := now.Truncate(blockSize)
+	t0Nanos := xtime.ToUnixNano(t0)
+	t1 := t0.Add(1 * blockSize)
+	t1Nanos := xtime.ToUnixNano(t1)
+	t2 := t1.Add(1 * blockSize)
+	var nowLock sync.Mutex
+	nowFn := func() time.Time {
+		nowLock.Lock()
+		defer nowLock.Unlock()
+		return now
+	}
+	opts := DefaultTestOptions()
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(nowFn))
+
+	b0 := index.NewMockBlock(ctrl)
+	b0.EXPECT().Stats(gomock.Any()).Return(nil).AnyTimes()
+	b0.EXPECT().Close().Return(nil)
+	b0.EXPECT().StartTime().Return(t0).AnyTimes()
+	b0.EXPECT().EndTime().Return(t0.Add(blockSize)).AnyTimes()
+	b1 := index.NewMockBlock(ctrl)
+	b1.EXPECT().Stats(gomock.Any()).Return(nil).AnyTimes()
+	b1.EXPECT().Close().Return(nil)
+	b1.EXPECT().StartTime().Return(t1).AnyTimes()
+	b1.EXPECT().EndTime().Return(t1.Add(blockSize)).AnyTimes()
+	newBlockFn := func(
+		ts time.Time,
+		md namespace.Metadata,
+		_ index.BlockOptions,
+		io index.Options,
+	) (index.Block, error) {
+		if ts.Equal(t0) {
+			return b0, nil
+		}
+		if ts.Equal(t1) {
+			return b1, nil
+		}
+		panic("should never get here")
+	}
+	md := testNamespaceMetadata(blockSize, retention)
+	idx, err := newNamespaceIndexWithNewBlockFn(md, testShardSet, newBlockFn, opts)
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, idx.Close())
+	}()
+
+	t0Results := result.NewIndexBlockByVolumeType(t0)
+	t1Results := result.NewIndexBlockByVolumeType(t1)
+	bootstrapResults := result.IndexResults{
+		t0Nanos: t0Results,
+		t1Nanos: t1Results,
+	}
+	b0.EXPECT().AddResults(bootstrapResults[t0Nanos]).Return(nil)
+	b1.EXPECT().AddResults(bootstrapResults[t1Nanos]).Return(nil)
+	require.NoError(t, idx.Bootstrap(bootstrapResults))
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	cOpts := index.CardinalityOptions{Limit: 10}
+	b0Cardinality := index.BlockCardinality{BlockStart: t0, NumSeries: 1}
+	b1Cardinality := index.BlockCardinality{BlockStart: t1, NumSeries: 2}
+
+	// only computes the blocks overlapping the range.
+	b0.EXPECT().Cardinality(cOpts).Return(b0Cardinality, nil)
+	results, err := idx.Cardinality(ctx, t0, t0.Add(time.Minute), cOpts)
+	require.NoError(t, err)
+	require.Equal(t, []index.BlockCardinality{b0Cardinality}, results)
+
+	// returns the newest block first.
+	b0.EXPECT().Cardinality(cOpts).Return(b0Cardinality, nil)
+	b1.EXPECT().Cardinality(cOpts).Return(b1Cardinality, nil)
+	results, err = idx.Cardinality(ctx, t0, t2, cOpts)
+	require.NoError(t, err)
+	require.Equal(t, []index.BlockCardinality{b1Cardinality, b0Cardinality}, results)
+
+	b1.EXPECT().Cardinality(cOpts).Return(index.BlockCardinality{}, index.ErrUnableToQueryBlockClosed)
+	_, err = idx.Cardinality(ctx, t0, t2, cOpts)
+	require.Error(t, err)
+}
+
 func TestNamespaceIndexBlockAggregateQueryReleasingContext(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
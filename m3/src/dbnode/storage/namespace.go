@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
@@ -129,6 +130,7 @@ type dbNamespace struct {
 	increasingIndex increasingIndex
 	commitLogWriter commitLogWriter
 	reverseIndex    NamespaceIndex
+	tombstones      *namespaceTombstones
 
 	tickWorkers            xsync.WorkerPool
 	tickWorkersConcurrency int
@@ -163,6 +165,8 @@ type databaseNamespaceMetrics struct {
 	fetchBlocksMetadata instrument.MethodMetrics
 	queryIDs            instrument.MethodMetrics
 	aggregateQuery      instrument.MethodMetrics
+	deleteSeries        instrument.MethodMetrics
+	indexCardinality    instrument.MethodMetrics
 	unfulfilled         tally.Counter
 	bootstrapStart      tally.Counter
 	bootstrapEnd        tally.Counter
@@ -245,6 +249,8 @@ func newDatabaseNamespaceMetrics(
 		fetchBlocksMetadata: instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", opts),
 		queryIDs:            instrument.NewMethodMetrics(scope, "queryIDs", opts),
 		aggregateQuery:      instrument.NewMethodMetrics(scope, "aggregateQuery", opts),
+		deleteSeries:        instrument.NewMethodMetrics(scope, "deleteSeries", opts),
+		indexCardinality:    instrument.NewMethodMetrics(scope, "indexCardinality", opts),
 		unfulfilled:         scope.Counter("bootstrap.unfulfilled"),
 		bootstrapStart:      scope.Counter("bootstrap.start"),
 		bootstrapEnd:        scope.Counter("bootstrap.end"),
@@ -336,6 +342,14 @@ func newDatabaseNamespace(
 		}
 	}
 
+	tombstones, err := newNamespaceTombstones(
+		opts.CommitLogOptions().FilesystemOptions(), id, logger)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to create namespace %v, could not load tombstones: %v",
+			metadata.ID().String(), err)
+	}
+
 	n := &dbNamespace{
 		id:                     id,
 		shutdownCh:             make(chan struct{}),
@@ -352,6 +366,7 @@ func newDatabaseNamespace(
 		increasingIndex:        increasingIndex,
 		commitLogWriter:        commitLogWriter,
 		reverseIndex:           index,
+		tombstones:             tombstones,
 		tickWorkers:            tickWorkers,
 		tickWorkersConcurrency: tickWorkersConcurrency,
 		metrics:                newDatabaseNamespaceMetrics(scope, iops.TimerOptions()),
@@ -622,6 +637,13 @@ func (n *dbNamespace) Tick(c context.Cancellable, startTime time.Time) error {
 		}
 	}
 
+	// Drop the deletes that are out of retention, nothing remains to be
+	// deleted by them once the blocks they cover have expired.
+	expireCutoff := retention.FlushTimeStart(n.nopts.RetentionOptions(), startTime)
+	if err := n.tombstones.Expire(expireCutoff); err != nil {
+		n.log.Warn("unable to expire namespace tombstones", zap.Error(err))
+	}
+
 	// NB: we early terminate here to ensure we are not reporting metrics
 	// based on in-accurate/partial tick results.
 	if err := multiErr.FinalError(); err != nil || c.IsCancelled() {
@@ -775,6 +797,16 @@ func (n *dbNamespace) QueryIDs(
 			xerrors.NewRetryableError(err)
 	}
 
+	// NB: Deleted series remain in the index segments until they are
+	// rotated out by retention, so exclude the series that have no data
+	// left within the retained part of the query range.
+	filterStart := opts.StartInclusive
+	if retentionStart := retention.FlushTimeStart(n.nopts.RetentionOptions(), callStart); filterStart.Before(retentionStart) {
+		filterStart = retentionStart
+	}
+	opts.FilterID = composeFilterID(opts.FilterID,
+		n.tombstones.FilterID(filterStart, opts.EndExclusive))
+
 	res, err := n.reverseIndex.Query(ctx, query, opts)
 	if err != nil {
 		sp.LogFields(opentracinglog.Error(err))
@@ -806,6 +838,28 @@ func (n *dbNamespace) AggregateQuery(
 	return res, err
 }
 
+func (n *dbNamespace) IndexCardinality(
+	ctx context.Context,
+	start, end time.Time,
+	opts index.CardinalityOptions,
+) ([]index.BlockCardinality, error) {
+	callStart := n.nowFn()
+	if n.reverseIndex == nil { // only happens if indexing is enabled.
+		n.metrics.indexCardinality.ReportError(n.nowFn().Sub(callStart))
+		return nil, errNamespaceIndexingDisabled
+	}
+
+	if n.reverseIndex.BootstrapsDone() < 1 {
+		// Similar to reading shard data, return not bootstrapped
+		n.metrics.indexCardinality.ReportError(n.nowFn().Sub(callStart))
+		return nil, xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
+	}
+
+	res, err := n.reverseIndex.Cardinality(ctx, start, end, opts)
+	n.metrics.indexCardinality.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	return res, err
+}
+
 func (n *dbNamespace) PrepareBootstrap(ctx context.Context) ([]databaseShard, error) {
 	ctx, span, sampled := ctx.StartSampledTraceSpan(tracepoint.NSPrepareBootstrap)
 	defer span.Finish()
@@ -995,6 +1049,12 @@ func (n *dbNamespace) Bootstrap(
 	}
 	wg.Wait()
 
+	// Re-apply the persisted deletes since the bootstrapped data may include
+	// datapoints that were deleted but not yet merged out of the filesets.
+	if err := n.applyTombstones(bootstrappedShards); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
 	if n.reverseIndex != nil {
 		indexResults := bootstrapResult.IndexResult.IndexResults()
 		n.log.Info("bootstrap index with bootstrapped index segments",
@@ -1041,6 +1101,33 @@ func (n *dbNamespace) Bootstrap(
 	return err
 }
 
+func (n *dbNamespace) applyTombstones(shards []uint32) error {
+	bootstrapped := make(map[uint32]struct{}, len(shards))
+	for _, shard := range shards {
+		bootstrapped[shard] = struct{}{}
+	}
+
+	var multiErr xerrors.MultiError
+	for _, entry := range n.tombstones.Entries() {
+		id := entry.seriesID()
+		n.RLock()
+		shardID := n.shardSet.Lookup(id)
+		n.RUnlock()
+		if _, ok := bootstrapped[shardID]; !ok {
+			continue
+		}
+
+		shard, _, err := n.shardFor(id)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		r := entry.timeRange()
+		multiErr = multiErr.Add(shard.DeleteSeries(id, entry.tagsIter(), r.Start, r.End))
+	}
+	return multiErr.FinalError()
+}
+
 func (n *dbNamespace) WarmFlush(
 	blockStart time.Time,
 	flushPersist persist.FlushPreparer,
@@ -1378,6 +1465,69 @@ func (n *dbNamespace) Truncate() (int64, error) {
 	return totalNumSeries, nil
 }
 
+func (n *dbNamespace) DeleteSeries(
+	ctx context.Context,
+	query index.Query,
+	start, end time.Time,
+) (map[uint32]int64, error) {
+	callStart := n.nowFn()
+	res, err := n.QueryIDs(ctx, query, index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+	})
+	if err != nil {
+		n.metrics.deleteSeries.ReportError(n.nowFn().Sub(callStart))
+		return nil, err
+	}
+
+	// Persist the deletes before applying them so that they are not lost
+	// if the node restarts before the deleted datapoints are merged out.
+	results := res.Results.Map().Iter()
+	tombstones := make([]tombstone, 0, len(results))
+	for _, entry := range results {
+		tags := entry.Value().Duplicate()
+		t, err := newTombstone(entry.Key(), tags, start, end)
+		tags.Close()
+		if err != nil {
+			n.metrics.deleteSeries.ReportError(n.nowFn().Sub(callStart))
+			return nil, err
+		}
+		tombstones = append(tombstones, t)
+	}
+	if err := n.tombstones.Append(tombstones); err != nil {
+		n.metrics.deleteSeries.ReportError(n.nowFn().Sub(callStart))
+		return nil, err
+	}
+
+	var (
+		numSeries = make(map[uint32]int64)
+		multiErr  xerrors.MultiError
+	)
+	for _, entry := range results {
+		id := entry.Key()
+		shard, _, err := n.shardFor(id)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		// NB: Duplicate the tags since the shard consumes the iterator
+		// when it needs to insert the series.
+		tags := entry.Value().Duplicate()
+		err = shard.DeleteSeries(id, tags, start, end)
+		tags.Close()
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		numSeries[shard.ID()]++
+	}
+
+	err = multiErr.FinalError()
+	n.metrics.deleteSeries.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	return numSeries, err
+}
+
 func (n *dbNamespace) Repair(
 	repairer databaseShardRepairer,
 	tr xtime.Range,
@@ -1550,6 +1700,9 @@ func (n *dbNamespace) Close() error {
 	n.namespaceReaderMgr.close()
 	n.closeShards(shards, true)
 	close(n.shutdownCh)
+	if err := n.tombstones.Close(); err != nil {
+		n.log.Warn("unable to close namespace tombstones", zap.Error(err))
+	}
 	if n.reverseIndex != nil {
 		return n.reverseIndex.Close()
 	}
@@ -0,0 +1,410 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	tombstonesFileName = "tombstones.log"
+
+	// tombstoneRecordHeaderLen is the length of the record header, which
+	// is the payload length followed by the payload checksum.
+	tombstoneRecordHeaderLen = 8
+)
+
+var errNamespaceTombstonesClosed = errors.New("namespace tombstones closed")
+
+// tombstone is a persisted series delete.
+type tombstone struct {
+	ID    string         `json:"id"`
+	Tags  []tombstoneTag `json:"tags,omitempty"`
+	Start int64          `json:"start"`
+	End   int64          `json:"end"`
+}
+
+type tombstoneTag struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func newTombstone(
+	id ident.ID,
+	tags ident.TagIterator,
+	start, end time.Time,
+) (tombstone, error) {
+	t := tombstone{
+		ID:    id.String(),
+		Start: start.UnixNano(),
+		End:   end.UnixNano(),
+	}
+	for tags.Next() {
+		tag := tags.Current()
+		t.Tags = append(t.Tags, tombstoneTag{
+			Name:  tag.Name.String(),
+			Value: tag.Value.String(),
+		})
+	}
+	return t, tags.Err()
+}
+
+func (t tombstone) seriesID() ident.ID {
+	return ident.StringID(t.ID)
+}
+
+func (t tombstone) tagsIter() ident.TagIterator {
+	tags := make([]ident.Tag, 0, len(t.Tags))
+	for _, tag := range t.Tags {
+		tags = append(tags, ident.StringTag(tag.Name, tag.Value))
+	}
+	return ident.NewTagsIterator(ident.NewTags(tags...))
+}
+
+func (t tombstone) timeRange() xtime.Range {
+	return xtime.Range{Start: time.Unix(0, t.Start), End: time.Unix(0, t.End)}
+}
+
+// namespaceTombstones is an append only log of the series deletes of a
+// namespace. Deletes are retained until they fall out of retention so they
+// can be re-applied on bootstrap to datapoints replayed from the commit log
+// or read back from filesets that have not been merged yet.
+type namespaceTombstones struct {
+	sync.RWMutex
+
+	dirPath          string
+	filePath         string
+	newFileMode      os.FileMode
+	newDirectoryMode os.FileMode
+	log              *zap.Logger
+
+	closed  bool
+	fd      *os.File
+	entries []tombstone
+	byID    map[string]xtime.Ranges
+}
+
+func newNamespaceTombstones(
+	opts fs.Options,
+	namespace ident.ID,
+	log *zap.Logger,
+) (*namespaceTombstones, error) {
+	dirPath := fs.NamespaceTombstonesDirPath(opts.FilePathPrefix(), namespace)
+	t := &namespaceTombstones{
+		dirPath:          dirPath,
+		filePath:         path.Join(dirPath, tombstonesFileName),
+		newFileMode:      opts.NewFileMode(),
+		newDirectoryMode: opts.NewDirectoryMode(),
+		log:              log,
+		byID:             make(map[string]xtime.Ranges),
+	}
+	if err := t.load(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *namespaceTombstones) load() error {
+	data, err := ioutil.ReadFile(t.filePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var offset int
+	for offset < len(data) {
+		entry, n, ok := decodeTombstoneRecord(data[offset:])
+		if !ok {
+			break
+		}
+		t.addWithLock(entry)
+		offset += n
+	}
+	if offset == len(data) {
+		return nil
+	}
+
+	// NB: A torn or corrupt record can only be the result of a crash during
+	// an append whose caller never received an acknowledgement, truncate it
+	// so that subsequent appends are readable.
+	t.log.Warn("truncating corrupt tombstones log tail",
+		zap.String("path", t.filePath),
+		zap.Int("validBytes", offset),
+		zap.Int("totalBytes", len(data)))
+	return os.Truncate(t.filePath, int64(offset))
+}
+
+// Append durably records the deletes before they are applied.
+func (t *namespaceTombstones) Append(entries []tombstone) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		if err := encodeTombstoneRecord(&buf, entry); err != nil {
+			return err
+		}
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.closed {
+		return errNamespaceTombstonesClosed
+	}
+	if t.fd == nil {
+		if err := t.openWithLock(); err != nil {
+			return err
+		}
+	}
+	if _, err := t.fd.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	if err := t.fd.Sync(); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		t.addWithLock(entry)
+	}
+	return nil
+}
+
+func (t *namespaceTombstones) openWithLock() error {
+	if err := os.MkdirAll(t.dirPath, t.newDirectoryMode); err != nil {
+		return err
+	}
+	fd, err := os.OpenFile(t.filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, t.newFileMode)
+	if err != nil {
+		return err
+	}
+	if err := syncDir(t.dirPath); err != nil {
+		fd.Close()
+		return err
+	}
+	t.fd = fd
+	return nil
+}
+
+func (t *namespaceTombstones) addWithLock(entry tombstone) {
+	t.entries = append(t.entries, entry)
+	ranges, ok := t.byID[entry.ID]
+	if !ok {
+		ranges = xtime.NewRanges()
+		t.byID[entry.ID] = ranges
+	}
+	ranges.AddRange(entry.timeRange())
+}
+
+// Entries returns a copy of the retained deletes.
+func (t *namespaceTombstones) Entries() []tombstone {
+	t.RLock()
+	entries := append([]tombstone(nil), t.entries...)
+	t.RUnlock()
+	return entries
+}
+
+// FilterID returns a filter that excludes series whose entire data within
+// the time range has been deleted, or nil if there are no deletes.
+func (t *namespaceTombstones) FilterID(start, end time.Time) func(ident.ID) bool {
+	t.RLock()
+	empty := len(t.byID) == 0
+	t.RUnlock()
+	if empty || !start.Before(end) {
+		return nil
+	}
+
+	queryRange := xtime.Range{Start: start, End: end}
+	return func(id ident.ID) bool {
+		t.RLock()
+		ranges, ok := t.byID[string(id.Bytes())]
+		if !ok {
+			t.RUnlock()
+			return true
+		}
+		remaining := xtime.NewRanges(queryRange)
+		remaining.RemoveRanges(ranges)
+		t.RUnlock()
+		return !remaining.IsEmpty()
+	}
+}
+
+// Expire drops the deletes that ended before the cutoff and compacts the
+// log if any were dropped.
+func (t *namespaceTombstones) Expire(cutoff time.Time) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.closed {
+		return errNamespaceTombstonesClosed
+	}
+
+	cutoffNanos := cutoff.UnixNano()
+	retained := make([]tombstone, 0, len(t.entries))
+	for _, entry := range t.entries {
+		if entry.End > cutoffNanos {
+			retained = append(retained, entry)
+		}
+	}
+	if len(retained) == len(t.entries) {
+		return nil
+	}
+
+	if err := t.rewriteWithLock(retained); err != nil {
+		return err
+	}
+
+	t.entries = nil
+	t.byID = make(map[string]xtime.Ranges, len(retained))
+	for _, entry := range retained {
+		t.addWithLock(entry)
+	}
+	return nil
+}
+
+func (t *namespaceTombstones) rewriteWithLock(entries []tombstone) error {
+	if t.fd != nil {
+		if err := t.fd.Close(); err != nil {
+			return err
+		}
+		t.fd = nil
+	}
+
+	if len(entries) == 0 {
+		if err := os.Remove(t.filePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return syncDir(t.dirPath)
+	}
+
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		if err := encodeTombstoneRecord(&buf, entry); err != nil {
+			return err
+		}
+	}
+
+	// Write out the compacted log to a temporary file that is only renamed
+	// once synced so that a crash never leaves a partially written log.
+	tmpPath := t.filePath + ".tmp"
+	fd, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, t.newFileMode)
+	if err != nil {
+		return err
+	}
+	_, err = fd.Write(buf.Bytes())
+	if err == nil {
+		err = fd.Sync()
+	}
+	if closeErr := fd.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, t.filePath)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return syncDir(t.dirPath)
+}
+
+// Close closes the log.
+func (t *namespaceTombstones) Close() error {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.closed {
+		return errNamespaceTombstonesClosed
+	}
+	t.closed = true
+	if t.fd == nil {
+		return nil
+	}
+	err := t.fd.Close()
+	t.fd = nil
+	return err
+}
+
+func encodeTombstoneRecord(buf *bytes.Buffer, entry tombstone) error {
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	var header [tombstoneRecordHeaderLen]byte
+	binary.LittleEndian.PutUint32(header[:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(header[4:], digest.Checksum(payload))
+	buf.Write(header[:])
+	buf.Write(payload)
+	return nil
+}
+
+func decodeTombstoneRecord(data []byte) (tombstone, int, bool) {
+	if len(data) < tombstoneRecordHeaderLen {
+		return tombstone{}, 0, false
+	}
+	var (
+		size     = int(binary.LittleEndian.Uint32(data[:4]))
+		checksum = binary.LittleEndian.Uint32(data[4:tombstoneRecordHeaderLen])
+		n        = tombstoneRecordHeaderLen + size
+	)
+	if size < 0 || len(data) < n {
+		return tombstone{}, 0, false
+	}
+	payload := data[tombstoneRecordHeaderLen:n]
+	if digest.Checksum(payload) != checksum {
+		return tombstone{}, 0, false
+	}
+	var entry tombstone
+	if err := json.Unmarshal(payload, &entry); err != nil {
+		return tombstone{}, 0, false
+	}
+	return entry, n, true
+}
+
+func syncDir(dirPath string) error {
+	dir, err := os.Open(dirPath)
+	if err != nil {
+		return err
+	}
+	err = dir.Sync()
+	if closeErr := dir.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/runtime"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	xidx "github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/x/context"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestTombstones(t *testing.T, dir string) *namespaceTombstones {
+	opts := fs.NewOptions().SetFilePathPrefix(dir)
+	tombstones, err := newNamespaceTombstones(opts, defaultTestNs1ID, zap.NewNop())
+	require.NoError(t, err)
+	return tombstones
+}
+
+func newTestTombstone(t *testing.T, id string, start, end time.Time) tombstone {
+	tags := ident.NewTagsIterator(ident.NewTags(ident.StringTag("foo", id)))
+	entry, err := newTombstone(ident.StringID(id), tags, start, end)
+	require.NoError(t, err)
+	return entry
+}
+
+func TestNamespaceTombstonesPersisted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tombstones")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		start = time.Now().Truncate(time.Hour)
+		end   = start.Add(time.Hour)
+		a     = newTestTombstone(t, "a", start, end)
+		b     = newTestTombstone(t, "b", start, end)
+	)
+	tombstones := newTestTombstones(t, dir)
+	require.NoError(t, tombstones.Append([]tombstone{a}))
+	require.NoError(t, tombstones.Append([]tombstone{b}))
+	require.NoError(t, tombstones.Close())
+
+	tombstones = newTestTombstones(t, dir)
+	defer tombstones.Close()
+	require.Equal(t, []tombstone{a, b}, tombstones.Entries())
+
+	tags := b.tagsIter()
+	require.True(t, tags.Next())
+	require.Equal(t, "foo", tags.Current().Name.String())
+	require.Equal(t, "b", tags.Current().Value.String())
+	require.False(t, tags.Next())
+}
+
+func TestNamespaceTombstonesTruncatesTornRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tombstones")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		start = time.Now().Truncate(time.Hour)
+		end   = start.Add(time.Hour)
+		a     = newTestTombstone(t, "a", start, end)
+		b     = newTestTombstone(t, "b", start, end)
+		c     = newTestTombstone(t, "c", start, end)
+	)
+	tombstones := newTestTombstones(t, dir)
+	require.NoError(t, tombstones.Append([]tombstone{a, b}))
+	require.NoError(t, tombstones.Close())
+
+	// Simulate a crash part way through appending the last record.
+	filePath := path.Join(fs.NamespaceTombstonesDirPath(dir, defaultTestNs1ID), tombstonesFileName)
+	info, err := os.Stat(filePath)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(filePath, info.Size()-3))
+
+	tombstones = newTestTombstones(t, dir)
+	require.Equal(t, []tombstone{a}, tombstones.Entries())
+	require.NoError(t, tombstones.Append([]tombstone{c}))
+	require.NoError(t, tombstones.Close())
+
+	tombstones = newTestTombstones(t, dir)
+	defer tombstones.Close()
+	require.Equal(t, []tombstone{a, c}, tombstones.Entries())
+}
+
+func TestNamespaceTombstonesExpire(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tombstones")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		start = time.Now().Truncate(time.Hour)
+		a     = newTestTombstone(t, "a", start, start.Add(time.Hour))
+		b     = newTestTombstone(t, "b", start, start.Add(3*time.Hour))
+	)
+	tombstones := newTestTombstones(t, dir)
+	require.NoError(t, tombstones.Append([]tombstone{a, b}))
+
+	require.NoError(t, tombstones.Expire(start.Add(2*time.Hour)))
+	require.Equal(t, []tombstone{b}, tombstones.Entries())
+	require.True(t, tombstones.FilterID(start, start.Add(time.Hour))(ident.StringID("a")))
+
+	// Appends after compaction must land in the compacted log.
+	c := newTestTombstone(t, "c", start, start.Add(3*time.Hour))
+	require.NoError(t, tombstones.Append([]tombstone{c}))
+	require.NoError(t, tombstones.Close())
+
+	tombstones = newTestTombstones(t, dir)
+	require.Equal(t, []tombstone{b, c}, tombstones.Entries())
+	require.NoError(t, tombstones.Expire(start.Add(4*time.Hour)))
+	require.Empty(t, tombstones.Entries())
+	require.NoError(t, tombstones.Close())
+
+	tombstones = newTestTombstones(t, dir)
+	defer tombstones.Close()
+	require.Empty(t, tombstones.Entries())
+}
+
+func TestNamespaceTombstonesFilterID(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tombstones")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tombstones := newTestTombstones(t, dir)
+	defer tombstones.Close()
+
+	start := time.Now().Truncate(time.Hour)
+	require.Nil(t, tombstones.FilterID(start, start.Add(time.Hour)))
+
+	require.NoError(t, tombstones.Append([]tombstone{
+		newTestTombstone(t, "a", start, start.Add(time.Hour)),
+		newTestTombstone(t, "a", start.Add(time.Hour), start.Add(2*time.Hour)),
+	}))
+
+	filter := tombstones.FilterID(start, start.Add(2*time.Hour))
+	require.False(t, filter(ident.StringID("a")))
+	require.True(t, filter(ident.StringID("b")))
+
+	filter = tombstones.FilterID(start, start.Add(3*time.Hour))
+	require.True(t, filter(ident.StringID("a")))
+}
+
+func TestNamespaceQueryIDsFiltersDeletedSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "tombstones")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dopts := DefaultTestOptions().SetRuntimeOptionsManager(runtime.NewOptionsManager())
+	dopts = dopts.SetCommitLogOptions(dopts.CommitLogOptions().SetFilesystemOptions(
+		dopts.CommitLogOptions().FilesystemOptions().SetFilePathPrefix(dir)))
+	ns, closer := newTestNamespaceWithOpts(t, dopts)
+	defer closer()
+
+	if ns.reverseIndex != nil {
+		require.NoError(t, ns.reverseIndex.Close())
+	}
+	idx := NewMockNamespaceIndex(ctrl)
+	ns.reverseIndex = idx
+
+	now := time.Now()
+	require.NoError(t, ns.tombstones.Append([]tombstone{
+		newTestTombstone(t, "deleted", now.Add(-time.Hour), now),
+	}))
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	query := index.Query{
+		Query: xidx.NewTermQuery([]byte("foo"), []byte("bar")),
+	}
+	opts := index.QueryOptions{
+		StartInclusive: now.Add(-time.Hour),
+		EndExclusive:   now,
+	}
+
+	idx.EXPECT().BootstrapsDone().Return(uint(1))
+	idx.EXPECT().Query(gomock.Any(), query, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ index.Query, opts index.QueryOptions) (index.QueryResult, error) {
+			require.NotNil(t, opts.FilterID)
+			require.False(t, opts.FilterID(ident.StringID("deleted")))
+			require.True(t, opts.FilterID(ident.StringID("other")))
+			return index.QueryResult{}, nil
+		})
+	_, err = ns.QueryIDs(ctx, query, opts)
+	require.NoError(t, err)
+
+	idx.EXPECT().Close().Return(nil)
+	require.NoError(t, ns.Close())
+}
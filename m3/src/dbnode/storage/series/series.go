@@ -78,6 +78,11 @@ type dbSeries struct {
 	onRetrieveBlock             block.OnRetrieveBlock
 	blockOnEvictedFromWiredList block.OnEvictedFromWiredList
 	pool                        DatabaseSeriesPool
+
+	// NB: Tombstones are persisted by the namespace and re-applied to the
+	// series on bootstrap until they fall out of retention.
+	tombstones       xtime.Ranges
+	tombstonedBlocks map[xtime.UnixNano]struct{}
 }
 
 // NewDatabaseSeries creates a new database series.
@@ -146,10 +151,14 @@ func (s *dbSeries) Tick(blockStates ShardBlockStateSnapshot, nsCtx namespace.Con
 	r.TickStatus = update.TickStatus
 	r.MadeExpiredBlocks, r.MadeUnwiredBlocks =
 		update.madeExpiredBlocks, update.madeUnwiredBlocks
+	s.expireTombstonesWithLock()
+	pendingTombstones := len(s.tombstonedBlocks)
 
 	s.Unlock()
 
-	if update.ActiveBlocks == 0 {
+	// Series with pending tombstones cannot be expired since the tombstones
+	// would be lost before the deleted data is removed from disk.
+	if update.ActiveBlocks == 0 && pendingTombstones == 0 {
 		return r, ErrSeriesAllDatapointsExpired
 	}
 	return r, nil
@@ -272,8 +281,9 @@ func (s *dbSeries) IsEmpty() bool {
 	s.RLock()
 	blocksLen := s.cachedBlocks.Len()
 	bufferEmpty := s.buffer.IsEmpty()
+	pendingTombstones := len(s.tombstonedBlocks)
 	s.RUnlock()
-	if blocksLen == 0 && bufferEmpty {
+	if blocksLen == 0 && bufferEmpty && pendingTombstones == 0 {
 		return true
 	}
 	return false
@@ -324,8 +334,22 @@ func (s *dbSeries) ReadEncoded(
 	s.RLock()
 	reader := NewReaderUsingRetriever(s.id, s.blockRetriever, s.onRetrieveBlock, s, s.opts)
 	r, err := reader.readersWithBlocksMapAndBuffer(ctx, start, end, s.cachedBlocks, s.buffer, nsCtx)
+	tombstones := s.cloneTombstonesWithRLock()
 	s.RUnlock()
-	return r, err
+	if err != nil || tombstones == nil {
+		return r, err
+	}
+
+	// NB: Filter outside of the lock since decoding blocks that are
+	// being retrieved from disk waits on OnRetrieveBlock which requires
+	// the write lock.
+	for i := range r {
+		r[i], err = filterTombstonedBlockReaders(ctx, r[i], tombstones, s.opts, nsCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
 }
 
 func (s *dbSeries) FetchBlocksForColdFlush(
@@ -338,6 +362,11 @@ func (s *dbSeries) FetchBlocksForColdFlush(
 	// to be modified.
 	s.Lock()
 	result, err := s.buffer.FetchBlocksForColdFlush(ctx, start, version, nsCtx)
+	if err == nil {
+		// The cold flush merge drops the tombstoned datapoints of this block
+		// from disk, so the block no longer needs to be cold flushed for them.
+		delete(s.tombstonedBlocks, xtime.ToUnixNano(start))
+	}
 	s.Unlock()
 
 	return result, err
@@ -355,8 +384,19 @@ func (s *dbSeries) FetchBlocks(
 		retriever:  s.blockRetriever,
 		onRetrieve: s.onRetrieveBlock,
 	}.fetchBlocksWithBlocksMapAndBuffer(ctx, starts, s.cachedBlocks, s.buffer, nsCtx)
+	tombstones := s.cloneTombstonesWithRLock()
 	s.RUnlock()
-	return r, err
+	if err != nil || tombstones == nil {
+		return r, err
+	}
+
+	for i := range r {
+		r[i].Blocks, err = filterTombstonedBlockReaders(ctx, r[i].Blocks, tombstones, s.opts, nsCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
 }
 
 func (s *dbSeries) FetchBlocksMetadata(
@@ -534,6 +574,13 @@ func (s *dbSeries) WarmFlush(
 	// Need a write lock because the buffer WarmFlush method mutates
 	// state (by performing a pro-active merge).
 	s.Lock()
+	if s.tombstones != nil && s.tombstones.Overlaps(xtime.Range{
+		Start: blockStart,
+		End:   blockStart.Add(s.opts.RetentionOptions().BlockSize()),
+	}) {
+		persistFn = tombstoneFilteringPersistFn(ctx, blockStart,
+			s.tombstones, s.opts, nsCtx, persistFn)
+	}
 	outcome, err := s.buffer.WarmFlush(ctx, blockStart,
 		persist.NewMetadata(s.metadata), persistFn, nsCtx)
 	s.Unlock()
@@ -559,7 +606,83 @@ func (s *dbSeries) ColdFlushBlockStarts(blockStates BootstrappedBlockStateSnapsh
 	s.RLock()
 	defer s.RUnlock()
 
-	return s.buffer.ColdFlushBlockStarts(blockStates.Snapshot)
+	blockStarts := s.buffer.ColdFlushBlockStarts(blockStates.Snapshot)
+	for blockStart := range s.tombstonedBlocks {
+		if !blockStarts.Contains(blockStart) {
+			blockStarts.Add(blockStart)
+		}
+	}
+	return blockStarts
+}
+
+func (s *dbSeries) Delete(start, end time.Time) {
+	var (
+		now       = s.now()
+		ropts     = s.opts.RetentionOptions()
+		blockSize = ropts.BlockSize()
+		earliest  = now.Add(-ropts.RetentionPeriod()).Truncate(blockSize)
+		latest    = now.Add(ropts.BufferFuture()).Truncate(blockSize).Add(blockSize)
+	)
+	// Only datapoints within retention can exist, clamping the range also
+	// bounds the number of blocks that need to be cold flushed.
+	if start.Before(earliest) {
+		start = earliest
+	}
+	if end.After(latest) {
+		end = latest
+	}
+	if !start.Before(end) {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.tombstones == nil {
+		s.tombstones = xtime.NewRanges()
+		s.tombstonedBlocks = make(map[xtime.UnixNano]struct{})
+	}
+	s.tombstones.AddRange(xtime.Range{Start: start, End: end})
+	for t := start.Truncate(blockSize); t.Before(end); t = t.Add(blockSize) {
+		s.tombstonedBlocks[xtime.ToUnixNano(t)] = struct{}{}
+	}
+}
+
+func (s *dbSeries) Tombstones() xtime.Ranges {
+	s.RLock()
+	tombstones := s.cloneTombstonesWithRLock()
+	s.RUnlock()
+	return tombstones
+}
+
+func (s *dbSeries) cloneTombstonesWithRLock() xtime.Ranges {
+	if s.tombstones == nil || s.tombstones.IsEmpty() {
+		return nil
+	}
+	return s.tombstones.Clone()
+}
+
+// expireTombstonesWithLock drops tombstones that are out of retention and
+// therefore can no longer mask any datapoints.
+func (s *dbSeries) expireTombstonesWithLock() {
+	if s.tombstones == nil {
+		return
+	}
+
+	var (
+		ropts        = s.opts.RetentionOptions()
+		expireCutoff = s.now().Add(-ropts.RetentionPeriod()).Truncate(ropts.BlockSize())
+	)
+	s.tombstones.RemoveRange(xtime.Range{Start: timeZero, End: expireCutoff})
+	for blockStart := range s.tombstonedBlocks {
+		if blockStart.ToTime().Before(expireCutoff) {
+			delete(s.tombstonedBlocks, blockStart)
+		}
+	}
+	if s.tombstones.IsEmpty() && len(s.tombstonedBlocks) == 0 {
+		s.tombstones = nil
+		s.tombstonedBlocks = nil
+	}
 }
 
 func (s *dbSeries) Close() {
@@ -570,6 +693,8 @@ func (s *dbSeries) Close() {
 	s.id = nil
 	s.metadata = doc.Document{}
 	s.uniqueIndex = 0
+	s.tombstones = nil
+	s.tombstonedBlocks = nil
 
 	switch s.opts.CachePolicy() {
 	case CacheLRU:
@@ -614,6 +739,8 @@ func (s *dbSeries) Reset(opts DatabaseSeriesOptions) {
 	s.id = opts.ID
 	s.metadata = opts.Metadata
 	s.uniqueIndex = opts.UniqueIndex
+	s.tombstones = nil
+	s.tombstonedBlocks = nil
 	s.cachedBlocks.Reset()
 	s.buffer.Reset(databaseBufferResetOptions{
 		BlockRetriever: opts.BlockRetriever,
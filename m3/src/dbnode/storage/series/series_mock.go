@@ -90,6 +90,18 @@ func (mr *MockDatabaseSeriesMockRecorder) ColdFlushBlockStarts(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlushBlockStarts", reflect.TypeOf((*MockDatabaseSeries)(nil).ColdFlushBlockStarts), arg0)
 }
 
+// Delete mocks base method
+func (m *MockDatabaseSeries) Delete(arg0, arg1 time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", arg0, arg1)
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockDatabaseSeriesMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDatabaseSeries)(nil).Delete), arg0, arg1)
+}
+
 // FetchBlocks mocks base method
 func (m *MockDatabaseSeries) FetchBlocks(arg0 context.Context, arg1 []time.Time, arg2 namespace.Context) ([]block.FetchBlockResult, error) {
 	m.ctrl.T.Helper()
@@ -285,6 +297,20 @@ func (mr *MockDatabaseSeriesMockRecorder) Tick(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tick", reflect.TypeOf((*MockDatabaseSeries)(nil).Tick), arg0, arg1)
 }
 
+// Tombstones mocks base method
+func (m *MockDatabaseSeries) Tombstones() time0.Ranges {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tombstones")
+	ret0, _ := ret[0].(time0.Ranges)
+	return ret0
+}
+
+// Tombstones indicates an expected call of Tombstones
+func (mr *MockDatabaseSeriesMockRecorder) Tombstones() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tombstones", reflect.TypeOf((*MockDatabaseSeries)(nil).Tombstones))
+}
+
 // UniqueIndex mocks base method
 func (m *MockDatabaseSeries) UniqueIndex() uint64 {
 	m.ctrl.T.Helper()
@@ -1327,3 +1327,141 @@ func TestSeriesCloseCacheLRUPolicy(t *testing.T) {
 	series.cachedBlocks = blocks
 	series.Close()
 }
+
+func TestSeriesDeleteMasksReads(t *testing.T) {
+	opts := newSeriesTestOptions()
+	curr := time.Now().Truncate(opts.RetentionOptions().BlockSize())
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blockRetriever := NewMockQueryableBlockRetriever(ctrl)
+	blockRetriever.EXPECT().
+		IsBlockRetrievable(gomock.Any()).
+		Return(false, nil).
+		AnyTimes()
+
+	series := NewDatabaseSeries(DatabaseSeriesOptions{
+		ID:             ident.StringID("foo"),
+		BlockRetriever: blockRetriever,
+		Options:        opts,
+	}).(*dbSeries)
+
+	data := []DecodedTestValue{
+		{curr.Add(mins(1)), 2, xtime.Second, nil},
+		{curr.Add(mins(3)), 3, xtime.Second, nil},
+		{curr.Add(mins(3.5)), 4, xtime.Second, nil},
+		{curr.Add(mins(5)), 5, xtime.Second, nil},
+		{curr.Add(mins(7)), 6, xtime.Second, nil},
+	}
+
+	for _, v := range data {
+		curr = v.Timestamp
+		verifyWriteToSeries(t, series, v)
+	}
+
+	series.Delete(start.Add(mins(3)), start.Add(mins(6)))
+	require.Equal(t, 1, series.Tombstones().Len())
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+	nsCtx := namespace.Context{}
+
+	results, err := series.ReadEncoded(ctx, start, start.Add(mins(10)), nsCtx)
+	require.NoError(t, err)
+
+	expected := []DecodedTestValue{data[0], data[4]}
+	requireReaderValuesEqual(t, expected, results, opts, nsCtx)
+}
+
+func TestSeriesDeletePendingColdFlush(t *testing.T) {
+	opts := newSeriesTestOptions()
+	blockSize := opts.RetentionOptions().BlockSize()
+	curr := time.Now().Truncate(blockSize)
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+
+	series := NewDatabaseSeries(DatabaseSeriesOptions{
+		ID:      ident.StringID("foo"),
+		Options: opts,
+	}).(*dbSeries)
+	require.True(t, series.IsEmpty())
+
+	// Ranges outside of retention are ignored.
+	series.Delete(timeZero, curr.Add(-2*time.Hour))
+	require.Nil(t, series.Tombstones())
+
+	blockStart := curr.Add(-2 * blockSize)
+	series.Delete(blockStart, blockStart.Add(blockSize))
+	require.False(t, series.IsEmpty())
+
+	blockStarts := series.ColdFlushBlockStarts(BootstrappedBlockStateSnapshot{})
+	require.Equal(t, 1, blockStarts.Len())
+	require.True(t, blockStarts.Contains(xtime.ToUnixNano(blockStart)))
+
+	// Series with pending tombstones must not be expired.
+	blockStates := NewShardBlockStateSnapshot(true, BootstrappedBlockStateSnapshot{})
+	_, err := series.Tick(blockStates, namespace.Context{})
+	require.NoError(t, err)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+	_, err = series.FetchBlocksForColdFlush(ctx, blockStart, 1, namespace.Context{})
+	require.NoError(t, err)
+
+	blockStarts = series.ColdFlushBlockStarts(BootstrappedBlockStateSnapshot{})
+	require.Equal(t, 0, blockStarts.Len())
+	require.True(t, series.IsEmpty())
+	require.Equal(t, 1, series.Tombstones().Len())
+
+	_, err = series.Tick(blockStates, namespace.Context{})
+	require.Equal(t, ErrSeriesAllDatapointsExpired, err)
+}
+
+func TestSeriesDeleteFiltersWarmFlush(t *testing.T) {
+	opts := newSeriesTestOptions()
+	blockSize := opts.RetentionOptions().BlockSize()
+	curr := time.Now().Truncate(blockSize)
+	start := curr
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+
+	series := NewDatabaseSeries(DatabaseSeriesOptions{
+		ID:      ident.StringID("foo"),
+		Options: opts,
+	}).(*dbSeries)
+
+	data := []DecodedTestValue{
+		{curr, 1, xtime.Second, nil},
+		{curr.Add(mins(0.5)), 2, xtime.Second, nil},
+		{curr.Add(mins(1)), 3, xtime.Second, nil},
+	}
+	for _, v := range data {
+		curr = v.Timestamp
+		verifyWriteToSeries(t, series, v)
+	}
+
+	series.Delete(start.Add(mins(0.5)), start.Add(mins(1)))
+
+	var persisted []ts.Segment
+	persistFn := func(_ persist.Metadata, segment ts.Segment, _ uint32) error {
+		persisted = append(persisted, segment.Clone(nil))
+		return nil
+	}
+	ctx := context.NewContext()
+	defer ctx.Close()
+	outcome, err := series.WarmFlush(ctx, start, persistFn, namespace.Context{})
+	require.NoError(t, err)
+	require.Equal(t, FlushOutcomeFlushedToDisk, outcome)
+	require.Len(t, persisted, 1)
+
+	expected := []DecodedTestValue{data[0], data[2]}
+	streams := []xio.SegmentReader{xio.NewSegmentReader(persisted[0])}
+	requireSegmentValuesEqual(t, expected, streams, opts, namespace.Context{})
+}
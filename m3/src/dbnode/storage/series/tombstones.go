@@ -0,0 +1,157 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package series
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/context"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// isTombstoned returns whether the timestamp falls within any of the
+// tombstoned ranges.
+func isTombstoned(tombstones xtime.Ranges, t time.Time) bool {
+	if tombstones == nil {
+		return false
+	}
+	return tombstones.Overlaps(xtime.Range{Start: t, End: t.Add(time.Nanosecond)})
+}
+
+// filterTombstonedBlockReaders re-encodes the readers of a single block
+// without the datapoints that fall within the tombstoned ranges, readers
+// for blocks that do not overlap any tombstone are returned unmodified.
+func filterTombstonedBlockReaders(
+	ctx context.Context,
+	readers []xio.BlockReader,
+	tombstones xtime.Ranges,
+	opts Options,
+	nsCtx namespace.Context,
+) ([]xio.BlockReader, error) {
+	if len(readers) == 0 || tombstones == nil {
+		return readers, nil
+	}
+
+	var (
+		blockStart = readers[0].Start
+		blockSize  = opts.RetentionOptions().BlockSize()
+	)
+	if !tombstones.Overlaps(xtime.Range{Start: blockStart, End: blockStart.Add(blockSize)}) {
+		return readers, nil
+	}
+
+	streams := make([]xio.SegmentReader, 0, len(readers))
+	for _, reader := range readers {
+		streams = append(streams, reader.SegmentReader)
+	}
+
+	stream, ok, err := filterTombstonedStreams(ctx, blockStart, streams, tombstones, opts, nsCtx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	return []xio.BlockReader{{
+		SegmentReader: stream,
+		Start:         blockStart,
+		BlockSize:     blockSize,
+	}}, nil
+}
+
+// filterTombstonedStreams merges the streams of a block into a single stream
+// that excludes any tombstoned datapoints, returning false if no datapoints
+// remain.
+func filterTombstonedStreams(
+	ctx context.Context,
+	blockStart time.Time,
+	streams []xio.SegmentReader,
+	tombstones xtime.Ranges,
+	opts Options,
+	nsCtx namespace.Context,
+) (xio.SegmentReader, bool, error) {
+	bopts := opts.DatabaseBlockOptions()
+	encoder := opts.EncoderPool().Get()
+	encoder.Reset(blockStart, bopts.DatabaseBlockAllocSize(), nsCtx.Schema)
+	defer encoder.Close()
+
+	iter := opts.MultiReaderIteratorPool().Get()
+	defer iter.Close()
+
+	iter.Reset(streams, blockStart, opts.RetentionOptions().BlockSize(), nsCtx.Schema)
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if isTombstoned(tombstones, dp.Timestamp) {
+			continue
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, false, err
+	}
+
+	stream, ok := encoder.Stream(ctx)
+	if !ok {
+		return nil, false, nil
+	}
+	ctx.RegisterFinalizer(stream)
+	return stream, true, nil
+}
+
+// tombstoneFilteringPersistFn wraps a persist function so that tombstoned
+// datapoints are dropped before the segment is persisted, series left with
+// no datapoints are not persisted at all.
+func tombstoneFilteringPersistFn(
+	ctx context.Context,
+	blockStart time.Time,
+	tombstones xtime.Ranges,
+	opts Options,
+	nsCtx namespace.Context,
+	persistFn persist.DataFn,
+) persist.DataFn {
+	return func(metadata persist.Metadata, segment ts.Segment, checksum uint32) error {
+		reader := xio.NewSegmentReader(segment)
+		stream, ok, err := filterTombstonedStreams(ctx, blockStart,
+			[]xio.SegmentReader{reader}, tombstones, opts, nsCtx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+
+		filtered, err := stream.Segment()
+		if err != nil {
+			return err
+		}
+		if filtered.Len() == 0 {
+			return nil
+		}
+		return persistFn(metadata, filtered, filtered.CalculateChecksum())
+	}
+}
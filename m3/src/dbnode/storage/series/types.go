@@ -141,6 +141,15 @@ type DatabaseSeries interface {
 	// ColdFlushBlockStarts returns the block starts that need cold flushes.
 	ColdFlushBlockStarts(blockStates BootstrappedBlockStateSnapshot) OptimizedTimes
 
+	// Delete tombstones all datapoints of the series within [start, end),
+	// masking them from reads until they are dropped by the next flush
+	// or cold flush merge of the affected blocks.
+	Delete(start, end time.Time)
+
+	// Tombstones returns the time ranges of the series that have been
+	// deleted but may still exist on disk, or nil if there are none.
+	Tombstones() xtime.Ranges
+
 	// Close will close the series and if pooled returned to the pool.
 	Close()
 
@@ -1614,6 +1614,36 @@ func (s *dbShard) FetchBlocksForColdFlush(
 	return entry.Series.FetchBlocksForColdFlush(ctx, start, version, nsCtx)
 }
 
+func (s *dbShard) DeleteSeries(
+	id ident.ID,
+	tags ident.TagIterator,
+	start, end time.Time,
+) error {
+	// NB: The series may only exist on disk, in which case it needs to be
+	// inserted so that it holds the tombstones until the deleted data has
+	// been merged out of the filesets.
+	entry, err := s.writableSeries(id, tags)
+	if err != nil {
+		return err
+	}
+
+	entry.Series.Delete(start, end)
+	// Release the reference we got on entry from `writableSeries`.
+	entry.DecrementReaderWriterCount()
+	return nil
+}
+
+func (s *dbShard) SeriesTombstones(id ident.ID) xtime.Ranges {
+	s.RLock()
+	entry, _, err := s.lookupEntryWithLock(id)
+	s.RUnlock()
+	if entry == nil || err != nil {
+		return nil
+	}
+
+	return entry.Series.Tombstones()
+}
+
 func (s *dbShard) fetchActiveBlocksMetadata(
 	ctx context.Context,
 	start, end time.Time,
@@ -771,6 +771,13 @@ func (m *noopMergeWith) ForEachRemaining(
 	return nil
 }
 
+func (m *noopMergeWith) Tombstones(
+	seriesID ident.ID,
+	blockStart xtime.UnixNano,
+) xtime.Ranges {
+	return nil
+}
+
 func TestShardSnapshotShardNotBootstrapped(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
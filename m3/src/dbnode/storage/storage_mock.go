@@ -458,6 +458,36 @@ func (mr *MockDatabaseMockRecorder) Truncate(namespace interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockDatabase)(nil).Truncate), namespace)
 }
 
+// IndexCardinality mocks base method
+func (m *MockDatabase) IndexCardinality(ctx context.Context, namespace ident.ID, start, end time.Time, opts index.CardinalityOptions) ([]index.BlockCardinality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexCardinality", ctx, namespace, start, end, opts)
+	ret0, _ := ret[0].([]index.BlockCardinality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexCardinality indicates an expected call of IndexCardinality
+func (mr *MockDatabaseMockRecorder) IndexCardinality(ctx, namespace, start, end, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexCardinality", reflect.TypeOf((*MockDatabase)(nil).IndexCardinality), ctx, namespace, start, end, opts)
+}
+
+// DeleteSeries mocks base method
+func (m *MockDatabase) DeleteSeries(ctx context.Context, namespace ident.ID, query index.Query, start, end time.Time) (map[uint32]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(map[uint32]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries
+func (mr *MockDatabaseMockRecorder) DeleteSeries(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockDatabase)(nil).DeleteSeries), ctx, namespace, query, start, end)
+}
+
 // BootstrapState mocks base method
 func (m *MockDatabase) BootstrapState() DatabaseBootstrapState {
 	m.ctrl.T.Helper()
@@ -853,6 +883,36 @@ func (mr *MockdatabaseMockRecorder) Truncate(namespace interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*Mockdatabase)(nil).Truncate), namespace)
 }
 
+// IndexCardinality mocks base method
+func (m *Mockdatabase) IndexCardinality(ctx context.Context, namespace ident.ID, start, end time.Time, opts index.CardinalityOptions) ([]index.BlockCardinality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexCardinality", ctx, namespace, start, end, opts)
+	ret0, _ := ret[0].([]index.BlockCardinality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexCardinality indicates an expected call of IndexCardinality
+func (mr *MockdatabaseMockRecorder) IndexCardinality(ctx, namespace, start, end, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexCardinality", reflect.TypeOf((*Mockdatabase)(nil).IndexCardinality), ctx, namespace, start, end, opts)
+}
+
+// DeleteSeries mocks base method
+func (m *Mockdatabase) DeleteSeries(ctx context.Context, namespace ident.ID, query index.Query, start, end time.Time) (map[uint32]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(map[uint32]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries
+func (mr *MockdatabaseMockRecorder) DeleteSeries(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*Mockdatabase)(nil).DeleteSeries), ctx, namespace, query, start, end)
+}
+
 // BootstrapState mocks base method
 func (m *Mockdatabase) BootstrapState() DatabaseBootstrapState {
 	m.ctrl.T.Helper()
@@ -1458,6 +1518,36 @@ func (mr *MockdatabaseNamespaceMockRecorder) Truncate() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Truncate", reflect.TypeOf((*MockdatabaseNamespace)(nil).Truncate))
 }
 
+// IndexCardinality mocks base method
+func (m *MockdatabaseNamespace) IndexCardinality(ctx context.Context, start, end time.Time, opts index.CardinalityOptions) ([]index.BlockCardinality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexCardinality", ctx, start, end, opts)
+	ret0, _ := ret[0].([]index.BlockCardinality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexCardinality indicates an expected call of IndexCardinality
+func (mr *MockdatabaseNamespaceMockRecorder) IndexCardinality(ctx, start, end, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexCardinality", reflect.TypeOf((*MockdatabaseNamespace)(nil).IndexCardinality), ctx, start, end, opts)
+}
+
+// DeleteSeries mocks base method
+func (m *MockdatabaseNamespace) DeleteSeries(ctx context.Context, query index.Query, start, end time.Time) (map[uint32]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, query, start, end)
+	ret0, _ := ret[0].(map[uint32]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries
+func (mr *MockdatabaseNamespaceMockRecorder) DeleteSeries(ctx, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockdatabaseNamespace)(nil).DeleteSeries), ctx, query, start, end)
+}
+
 // Repair mocks base method
 func (m *MockdatabaseNamespace) Repair(repairer databaseShardRepairer, tr time0.Range) error {
 	m.ctrl.T.Helper()
@@ -1805,6 +1895,34 @@ func (mr *MockdatabaseShardMockRecorder) FetchBlocksForColdFlush(ctx, seriesID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksForColdFlush", reflect.TypeOf((*MockdatabaseShard)(nil).FetchBlocksForColdFlush), ctx, seriesID, start, version, nsCtx)
 }
 
+// DeleteSeries mocks base method
+func (m *MockdatabaseShard) DeleteSeries(id ident.ID, tags ident.TagIterator, start, end time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", id, tags, start, end)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries
+func (mr *MockdatabaseShardMockRecorder) DeleteSeries(id, tags, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockdatabaseShard)(nil).DeleteSeries), id, tags, start, end)
+}
+
+// SeriesTombstones mocks base method
+func (m *MockdatabaseShard) SeriesTombstones(id ident.ID) time0.Ranges {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeriesTombstones", id)
+	ret0, _ := ret[0].(time0.Ranges)
+	return ret0
+}
+
+// SeriesTombstones indicates an expected call of SeriesTombstones
+func (mr *MockdatabaseShardMockRecorder) SeriesTombstones(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeriesTombstones", reflect.TypeOf((*MockdatabaseShard)(nil).SeriesTombstones), id)
+}
+
 // FetchBlocksMetadataV2 mocks base method
 func (m *MockdatabaseShard) FetchBlocksMetadataV2(ctx context.Context, start, end time.Time, limit int64, pageToken PageToken, opts block.FetchBlocksMetadataOptions) (block.FetchBlocksMetadataResults, PageToken, error) {
 	m.ctrl.T.Helper()
@@ -2150,6 +2268,21 @@ func (mr *MockNamespaceIndexMockRecorder) AggregateQuery(ctx, query, opts interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateQuery", reflect.TypeOf((*MockNamespaceIndex)(nil).AggregateQuery), ctx, query, opts)
 }
 
+// Cardinality mocks base method
+func (m *MockNamespaceIndex) Cardinality(ctx context.Context, start, end time.Time, opts index.CardinalityOptions) ([]index.BlockCardinality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cardinality", ctx, start, end, opts)
+	ret0, _ := ret[0].([]index.BlockCardinality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cardinality indicates an expected call of Cardinality
+func (mr *MockNamespaceIndexMockRecorder) Cardinality(ctx, start, end, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cardinality", reflect.TypeOf((*MockNamespaceIndex)(nil).Cardinality), ctx, start, end, opts)
+}
+
 // Bootstrap mocks base method
 func (m *MockNamespaceIndex) Bootstrap(bootstrapResults result.IndexResults) error {
 	m.ctrl.T.Helper()
@@ -212,6 +212,25 @@ type Database interface {
 	// Truncate truncates data for the given namespace.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteSeries tombstones the datapoints within [start, end) of the
+	// series matching the query, returning the number of series affected
+	// by shard.
+	DeleteSeries(
+		ctx context.Context,
+		namespace ident.ID,
+		query index.Query,
+		start, end time.Time,
+	) (map[uint32]int64, error)
+
+	// IndexCardinality returns the cardinality of the namespace index blocks
+	// overlapping [start, end), newest block first.
+	IndexCardinality(
+		ctx context.Context,
+		namespace ident.ID,
+		start, end time.Time,
+		opts index.CardinalityOptions,
+	) ([]index.BlockCardinality, error)
+
 	// BootstrapState captures and returns a snapshot of the databases'
 	// bootstrap state.
 	BootstrapState() DatabaseBootstrapState
@@ -383,6 +402,23 @@ type databaseNamespace interface {
 	// Truncate truncates the in-memory data for this namespace.
 	Truncate() (int64, error)
 
+	// DeleteSeries tombstones the datapoints within [start, end) of the
+	// series matching the query, returning the number of series affected
+	// by shard.
+	DeleteSeries(
+		ctx context.Context,
+		query index.Query,
+		start, end time.Time,
+	) (map[uint32]int64, error)
+
+	// IndexCardinality returns the cardinality of the index blocks
+	// overlapping [start, end), newest block first.
+	IndexCardinality(
+		ctx context.Context,
+		start, end time.Time,
+		opts index.CardinalityOptions,
+	) ([]index.BlockCardinality, error)
+
 	// Repair repairs the namespace data for a given time range
 	Repair(repairer databaseShardRepairer, tr xtime.Range) error
 
@@ -500,6 +536,18 @@ type databaseShard interface {
 		nsCtx namespace.Context,
 	) (block.FetchBlockResult, error)
 
+	// DeleteSeries tombstones the datapoints within [start, end) of a series,
+	// loading the series into memory if required to hold the tombstones.
+	DeleteSeries(
+		id ident.ID,
+		tags ident.TagIterator,
+		start, end time.Time,
+	) error
+
+	// SeriesTombstones returns the tombstoned time ranges of a series, or nil
+	// if the series has none.
+	SeriesTombstones(id ident.ID) xtime.Ranges
+
 	// FetchBlocksMetadataV2 retrieves blocks metadata.
 	FetchBlocksMetadataV2(
 		ctx context.Context,
@@ -631,6 +679,14 @@ type NamespaceIndex interface {
 		opts index.AggregationOptions,
 	) (index.AggregateQueryResult, error)
 
+	// Cardinality returns the cardinality of the index blocks overlapping
+	// [start, end), newest block first.
+	Cardinality(
+		ctx context.Context,
+		start, end time.Time,
+		opts index.CardinalityOptions,
+	) ([]index.BlockCardinality, error)
+
 	// Bootstrap bootstraps the index the provided segments.
 	Bootstrap(
 		bootstrapResults result.IndexResults,
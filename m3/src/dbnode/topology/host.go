@@ -24,6 +24,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net"
+	"strconv"
 
 	"github.com/m3db/m3/src/cluster/services"
 	"github.com/m3db/m3/src/cluster/shard"
@@ -39,8 +41,9 @@ func Majority(replicas int) int {
 }
 
 type host struct {
-	id      string
-	address string
+	id          string
+	address     string
+	grpcAddress string
 }
 
 func (h *host) ID() string {
@@ -51,6 +54,10 @@ func (h *host) Address() string {
 	return h.address
 }
 
+func (h *host) GRPCAddress() string {
+	return h.grpcAddress
+}
+
 func (h *host) String() string {
 	return fmt.Sprintf("Host<ID=%s, Address=%s>", h.id, h.address)
 }
@@ -60,6 +67,12 @@ func NewHost(id, address string) Host {
 	return &host{id: id, address: address}
 }
 
+// NewHostWithGRPCAddress creates a new host that serves the node service
+// over gRPC on the given address
+func NewHostWithGRPCAddress(id, address, grpcAddress string) Host {
+	return &host{id: id, address: address, grpcAddress: grpcAddress}
+}
+
 type hostShardSet struct {
 	host     Host
 	shardSet sharding.ShardSet
@@ -86,7 +99,23 @@ func NewHostShardSetFromServiceInstance(
 	if err != nil {
 		return nil, err
 	}
-	return NewHostShardSet(NewHost(si.InstanceID(), si.Endpoint()), shardSet), nil
+	host := NewHostWithGRPCAddress(si.InstanceID(), si.Endpoint(),
+		grpcAddress(si.Endpoint(), si.InstanceMetadata().GRPCPort))
+	return NewHostShardSet(host, shardSet), nil
+}
+
+// grpcAddress returns the address the node service is served on over gRPC
+// given the endpoint of the instance and the gRPC port in its placement
+// metadata, empty if the instance has no gRPC port.
+func grpcAddress(endpoint string, port uint32) string {
+	if port == 0 {
+		return ""
+	}
+	hostname, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return ""
+	}
+	return net.JoinHostPort(hostname, strconv.Itoa(int(port)))
 }
 
 func (h *hostShardSet) Host() Host {
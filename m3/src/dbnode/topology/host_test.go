@@ -23,6 +23,7 @@ package topology
 import (
 	"testing"
 
+	"github.com/m3db/m3/src/cluster/placement"
 	"github.com/m3db/m3/src/cluster/services"
 	"github.com/m3db/m3/src/cluster/shard"
 	"github.com/m3db/m3/src/dbnode/sharding"
@@ -45,6 +46,7 @@ func TestNewHostShardSetFromServiceInstance(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "h1:9000", host.Host().Address())
 	assert.Equal(t, "h1", host.Host().ID())
+	assert.Equal(t, "", host.Host().GRPCAddress())
 	assert.Equal(t, 3, len(host.ShardSet().AllIDs()))
 	assert.Equal(t, uint32(1), host.ShardSet().Min())
 	assert.Equal(t, uint32(3), host.ShardSet().Max())
@@ -52,3 +54,15 @@ func TestNewHostShardSetFromServiceInstance(t *testing.T) {
 	id := ident.StringID("id")
 	assert.Equal(t, host.ShardSet().Lookup(id), hash(id))
 }
+
+func TestNewHostShardSetFromServiceInstanceWithGRPCPort(t *testing.T) {
+	i1 := services.NewServiceInstance().
+		SetInstanceID("h1").
+		SetEndpoint("h1:9000").
+		SetShards(shard.NewShards([]shard.Shard{shard.NewShard(1)})).
+		SetInstanceMetadata(placement.InstanceMetadata{GRPCPort: 9010})
+	host, err := NewHostShardSetFromServiceInstance(i1, sharding.DefaultHashFn(1))
+	assert.NoError(t, err)
+	assert.Equal(t, "h1:9000", host.Host().Address())
+	assert.Equal(t, "h1:9010", host.Host().GRPCAddress())
+}
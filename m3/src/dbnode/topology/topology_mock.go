@@ -87,6 +87,20 @@ func (mr *MockHostMockRecorder) Address() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Address", reflect.TypeOf((*MockHost)(nil).Address))
 }
 
+// GRPCAddress mocks base method
+func (m *MockHost) GRPCAddress() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCAddress")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GRPCAddress indicates an expected call of GRPCAddress
+func (mr *MockHostMockRecorder) GRPCAddress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCAddress", reflect.TypeOf((*MockHost)(nil).GRPCAddress))
+}
+
 // String mocks base method
 func (m *MockHost) String() string {
 	m.ctrl.T.Helper()
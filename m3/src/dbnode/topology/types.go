@@ -37,6 +37,10 @@ type Host interface {
 	// Address returns the address of the host
 	Address() string
 
+	// GRPCAddress returns the address the host serves the node service on
+	// over gRPC, empty if not known
+	GRPCAddress() string
+
 	// String returns a string representation of the host
 	String() string
 }
@@ -145,8 +149,9 @@ type StaticConfiguration struct {
 
 // HostShardConfig stores host information for fanout
 type HostShardConfig struct {
-	HostID        string `yaml:"hostID"`
-	ListenAddress string `yaml:"listenAddress"`
+	HostID            string `yaml:"hostID"`
+	ListenAddress     string `yaml:"listenAddress"`
+	GRPCListenAddress string `yaml:"grpcListenAddress"`
 }
 
 // StaticOptions is a set of options for static topology
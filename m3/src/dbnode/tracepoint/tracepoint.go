@@ -85,6 +85,9 @@ const (
 	// NSIdxAggregateQuery is the operation name for the nsIndex AggregateQuery path.
 	NSIdxAggregateQuery = "storage.nsIndex.AggregateQuery"
 
+	// NSIdxCardinality is the operation name for the nsIndex Cardinality path.
+	NSIdxCardinality = "storage.nsIndex.Cardinality"
+
 	// NSIdxQueryHelper is the operation name for the nsIndex query path.
 	NSIdxQueryHelper = "storage.nsIndex.query"
 
@@ -36,6 +36,9 @@
 		DisjunctionQuery
 		AllQuery
 		Query
+		PrefixQuery
+		RangeQuery
+		NumericRangeQuery
 */
 package querypb
 
@@ -43,6 +46,8 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 
+import encoding_binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -185,6 +190,9 @@ type Query struct {
 	//	*Query_Disjunction
 	//	*Query_All
 	//	*Query_Field
+	//	*Query_Prefix
+	//	*Query_Range
+	//	*Query_NumericRange
 	Query isQuery_Query `protobuf_oneof:"query"`
 }
 
@@ -220,14 +228,26 @@ type Query_All struct {
 type Query_Field struct {
 	Field *FieldQuery `protobuf:"bytes,7,opt,name=field,oneof"`
 }
+type Query_Prefix struct {
+	Prefix *PrefixQuery `protobuf:"bytes,8,opt,name=prefix,oneof"`
+}
+type Query_Range struct {
+	Range *RangeQuery `protobuf:"bytes,9,opt,name=range,oneof"`
+}
+type Query_NumericRange struct {
+	NumericRange *NumericRangeQuery `protobuf:"bytes,10,opt,name=numeric_range,json=numericRange,oneof"`
+}
 
-func (*Query_Term) isQuery_Query()        {}
-func (*Query_Regexp) isQuery_Query()      {}
-func (*Query_Negation) isQuery_Query()    {}
-func (*Query_Conjunction) isQuery_Query() {}
-func (*Query_Disjunction) isQuery_Query() {}
-func (*Query_All) isQuery_Query()         {}
-func (*Query_Field) isQuery_Query()       {}
+func (*Query_Term) isQuery_Query()         {}
+func (*Query_Regexp) isQuery_Query()       {}
+func (*Query_Negation) isQuery_Query()     {}
+func (*Query_Conjunction) isQuery_Query()  {}
+func (*Query_Disjunction) isQuery_Query()  {}
+func (*Query_All) isQuery_Query()          {}
+func (*Query_Field) isQuery_Query()        {}
+func (*Query_Prefix) isQuery_Query()       {}
+func (*Query_Range) isQuery_Query()        {}
+func (*Query_NumericRange) isQuery_Query() {}
 
 func (m *Query) GetQuery() isQuery_Query {
 	if m != nil {
@@ -285,6 +305,27 @@ func (m *Query) GetField() *FieldQuery {
 	return nil
 }
 
+func (m *Query) GetPrefix() *PrefixQuery {
+	if x, ok := m.GetQuery().(*Query_Prefix); ok {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (m *Query) GetRange() *RangeQuery {
+	if x, ok := m.GetQuery().(*Query_Range); ok {
+		return x.Range
+	}
+	return nil
+}
+
+func (m *Query) GetNumericRange() *NumericRangeQuery {
+	if x, ok := m.GetQuery().(*Query_NumericRange); ok {
+		return x.NumericRange
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Query) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Query_OneofMarshaler, _Query_OneofUnmarshaler, _Query_OneofSizer, []interface{}{
@@ -295,6 +336,9 @@ func (*Query) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error,
 		(*Query_Disjunction)(nil),
 		(*Query_All)(nil),
 		(*Query_Field)(nil),
+		(*Query_Prefix)(nil),
+		(*Query_Range)(nil),
+		(*Query_NumericRange)(nil),
 	}
 }
 
@@ -337,6 +381,21 @@ func _Query_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Field); err != nil {
 			return err
 		}
+	case *Query_Prefix:
+		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Prefix); err != nil {
+			return err
+		}
+	case *Query_Range:
+		_ = b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Range); err != nil {
+			return err
+		}
+	case *Query_NumericRange:
+		_ = b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.NumericRange); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Query.Query has unexpected type %T", x)
@@ -403,6 +462,30 @@ func _Query_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		err := b.DecodeMessage(msg)
 		m.Query = &Query_Field{msg}
 		return true, err
+	case 8: // query.prefix
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PrefixQuery)
+		err := b.DecodeMessage(msg)
+		m.Query = &Query_Prefix{msg}
+		return true, err
+	case 9: // query.range
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RangeQuery)
+		err := b.DecodeMessage(msg)
+		m.Query = &Query_Range{msg}
+		return true, err
+	case 10: // query.numeric_range
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(NumericRangeQuery)
+		err := b.DecodeMessage(msg)
+		m.Query = &Query_NumericRange{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -447,6 +530,21 @@ func _Query_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(7<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Query_Prefix:
+		s := proto.Size(x.Prefix)
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Query_Range:
+		s := proto.Size(x.Range)
+		n += proto.SizeVarint(9<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Query_NumericRange:
+		s := proto.Size(x.NumericRange)
+		n += proto.SizeVarint(10<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -454,6 +552,142 @@ func _Query_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type PrefixQuery struct {
+	Field  []byte `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Prefix []byte `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (m *PrefixQuery) Reset()                    { *m = PrefixQuery{} }
+func (m *PrefixQuery) String() string            { return proto.CompactTextString(m) }
+func (*PrefixQuery) ProtoMessage()               {}
+func (*PrefixQuery) Descriptor() ([]byte, []int) { return fileDescriptorQuery, []int{8} }
+
+func (m *PrefixQuery) GetField() []byte {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
+func (m *PrefixQuery) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+type RangeQuery struct {
+	Field        []byte `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Min          []byte `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max          []byte `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	MinInclusive bool   `protobuf:"varint,4,opt,name=min_inclusive,json=minInclusive,proto3" json:"min_inclusive,omitempty"`
+	MaxInclusive bool   `protobuf:"varint,5,opt,name=max_inclusive,json=maxInclusive,proto3" json:"max_inclusive,omitempty"`
+	MinUnbounded bool   `protobuf:"varint,6,opt,name=min_unbounded,json=minUnbounded,proto3" json:"min_unbounded,omitempty"`
+	MaxUnbounded bool   `protobuf:"varint,7,opt,name=max_unbounded,json=maxUnbounded,proto3" json:"max_unbounded,omitempty"`
+}
+
+func (m *RangeQuery) Reset()                    { *m = RangeQuery{} }
+func (m *RangeQuery) String() string            { return proto.CompactTextString(m) }
+func (*RangeQuery) ProtoMessage()               {}
+func (*RangeQuery) Descriptor() ([]byte, []int) { return fileDescriptorQuery, []int{9} }
+
+func (m *RangeQuery) GetField() []byte {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
+func (m *RangeQuery) GetMin() []byte {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *RangeQuery) GetMax() []byte {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
+func (m *RangeQuery) GetMinInclusive() bool {
+	if m != nil {
+		return m.MinInclusive
+	}
+	return false
+}
+
+func (m *RangeQuery) GetMaxInclusive() bool {
+	if m != nil {
+		return m.MaxInclusive
+	}
+	return false
+}
+
+func (m *RangeQuery) GetMinUnbounded() bool {
+	if m != nil {
+		return m.MinUnbounded
+	}
+	return false
+}
+
+func (m *RangeQuery) GetMaxUnbounded() bool {
+	if m != nil {
+		return m.MaxUnbounded
+	}
+	return false
+}
+
+type NumericRangeQuery struct {
+	Field        []byte  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Min          float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max          float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	MinInclusive bool    `protobuf:"varint,4,opt,name=min_inclusive,json=minInclusive,proto3" json:"min_inclusive,omitempty"`
+	MaxInclusive bool    `protobuf:"varint,5,opt,name=max_inclusive,json=maxInclusive,proto3" json:"max_inclusive,omitempty"`
+}
+
+func (m *NumericRangeQuery) Reset()                    { *m = NumericRangeQuery{} }
+func (m *NumericRangeQuery) String() string            { return proto.CompactTextString(m) }
+func (*NumericRangeQuery) ProtoMessage()               {}
+func (*NumericRangeQuery) Descriptor() ([]byte, []int) { return fileDescriptorQuery, []int{10} }
+
+func (m *NumericRangeQuery) GetField() []byte {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
+func (m *NumericRangeQuery) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *NumericRangeQuery) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *NumericRangeQuery) GetMinInclusive() bool {
+	if m != nil {
+		return m.MinInclusive
+	}
+	return false
+}
+
+func (m *NumericRangeQuery) GetMaxInclusive() bool {
+	if m != nil {
+		return m.MaxInclusive
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*FieldQuery)(nil), "query.FieldQuery")
 	proto.RegisterType((*TermQuery)(nil), "query.TermQuery")
@@ -463,6 +697,9 @@ func init() {
 	proto.RegisterType((*DisjunctionQuery)(nil), "query.DisjunctionQuery")
 	proto.RegisterType((*AllQuery)(nil), "query.AllQuery")
 	proto.RegisterType((*Query)(nil), "query.Query")
+	proto.RegisterType((*PrefixQuery)(nil), "query.PrefixQuery")
+	proto.RegisterType((*RangeQuery)(nil), "query.RangeQuery")
+	proto.RegisterType((*NumericRangeQuery)(nil), "query.NumericRangeQuery")
 }
 func (m *FieldQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -777,6 +1014,210 @@ func (m *Query_Field) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *Query_Prefix) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Prefix != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.Prefix.Size()))
+		n10, err := m.Prefix.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+func (m *Query_Range) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Range != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.Range.Size()))
+		n11, err := m.Range.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+func (m *Query_NumericRange) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.NumericRange != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumericRange.Size()))
+		n12, err := m.NumericRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+func (m *PrefixQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefixQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Field) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	return i, nil
+}
+
+func (m *RangeQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Field) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if len(m.Min) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Min)))
+		i += copy(dAtA[i:], m.Min)
+	}
+	if len(m.Max) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Max)))
+		i += copy(dAtA[i:], m.Max)
+	}
+	if m.MinInclusive {
+		dAtA[i] = 0x20
+		i++
+		if m.MinInclusive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxInclusive {
+		dAtA[i] = 0x28
+		i++
+		if m.MaxInclusive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MinUnbounded {
+		dAtA[i] = 0x30
+		i++
+		if m.MinUnbounded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxUnbounded {
+		dAtA[i] = 0x38
+		i++
+		if m.MaxUnbounded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *NumericRangeQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumericRangeQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Field) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if m.Min != 0 {
+		dAtA[i] = 0x11
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+		i += 8
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x19
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+		i += 8
+	}
+	if m.MinInclusive {
+		dAtA[i] = 0x20
+		i++
+		if m.MinInclusive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MaxInclusive {
+		dAtA[i] = 0x28
+		i++
+		if m.MaxInclusive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -936,14 +1377,106 @@ func (m *Query_Field) Size() (n int) {
 	}
 	return n
 }
-
-func sovQuery(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *Query_Prefix) Size() (n int) {
+	var l int
+	_ = l
+	if m.Prefix != nil {
+		l = m.Prefix.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *Query_Range) Size() (n int) {
+	var l int
+	_ = l
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *Query_NumericRange) Size() (n int) {
+	var l int
+	_ = l
+	if m.NumericRange != nil {
+		l = m.NumericRange.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *PrefixQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RangeQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinInclusive {
+		n += 2
+	}
+	if m.MaxInclusive {
+		n += 2
+	}
+	if m.MinUnbounded {
+		n += 2
+	}
+	if m.MaxUnbounded {
+		n += 2
+	}
+	return n
+}
+
+func (m *NumericRangeQuery) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Min != 0 {
+		n += 9
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	if m.MinInclusive {
+		n += 2
+	}
+	if m.MaxInclusive {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
 	}
 	return n
 }
@@ -1803,6 +2336,580 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = &Query_Field{v}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PrefixQuery{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Query = &Query_Prefix{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RangeQuery{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Query = &Query_Range{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumericRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NumericRangeQuery{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Query = &Query_NumericRange{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrefixQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefixQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefixQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = append(m.Field[:0], dAtA[iNdEx:postIndex]...)
+			if m.Field == nil {
+				m.Field = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = append(m.Field[:0], dAtA[iNdEx:postIndex]...)
+			if m.Field == nil {
+				m.Field = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Min = append(m.Min[:0], dAtA[iNdEx:postIndex]...)
+			if m.Min == nil {
+				m.Min = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = append(m.Max[:0], dAtA[iNdEx:postIndex]...)
+			if m.Max == nil {
+				m.Max = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInclusive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinInclusive = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInclusive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxInclusive = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnbounded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinUnbounded = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnbounded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxUnbounded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumericRangeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumericRangeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumericRangeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = append(m.Field[:0], dAtA[iNdEx:postIndex]...)
+			if m.Field == nil {
+				m.Field = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Min = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInclusive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinInclusive = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInclusive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxInclusive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1934,30 +3041,40 @@ func init() {
 }
 
 var fileDescriptorQuery = []byte{
-	// 389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x4a, 0xf3, 0x40,
-	0x14, 0x86, 0x93, 0xb6, 0x69, 0xfa, 0x9d, 0xf4, 0xc3, 0x3a, 0x14, 0x8d, 0x9b, 0x50, 0x22, 0x88,
-	0x82, 0x34, 0xd0, 0xe0, 0xc6, 0xae, 0x5a, 0x45, 0xb2, 0x12, 0x0c, 0xae, 0xdc, 0x35, 0xc9, 0x18,
-	0x23, 0xc9, 0xa4, 0x4e, 0x53, 0xa8, 0x77, 0xe1, 0x65, 0xb9, 0xf4, 0x12, 0xa4, 0x7a, 0x21, 0x32,
-	0x3f, 0x69, 0xda, 0x0a, 0x5d, 0xb8, 0x4a, 0xce, 0x9c, 0xf7, 0x81, 0x99, 0x87, 0x17, 0x46, 0x71,
-	0x52, 0x3c, 0xcd, 0x83, 0x7e, 0x98, 0x67, 0x4e, 0xe6, 0x46, 0x81, 0x93, 0xb9, 0xce, 0x8c, 0x86,
-	0x4e, 0xe6, 0x92, 0x84, 0x2c, 0x9c, 0x18, 0x13, 0x4c, 0x27, 0x05, 0x8e, 0x9c, 0x29, 0xcd, 0x8b,
-	0xdc, 0x79, 0x99, 0x63, 0xfa, 0x3a, 0x0d, 0xc4, 0xb7, 0xcf, 0xcf, 0x90, 0xc6, 0x07, 0xdb, 0x06,
-	0xb8, 0x49, 0x70, 0x1a, 0xdd, 0xb1, 0x09, 0x75, 0x41, 0x7b, 0x64, 0x93, 0xa9, 0xf6, 0xd4, 0xd3,
-	0xb6, 0x2f, 0x06, 0xfb, 0x02, 0xfe, 0xdd, 0x63, 0x9a, 0xed, 0x88, 0x20, 0x04, 0x8d, 0x02, 0xd3,
-	0xcc, 0xac, 0xf1, 0x43, 0xfe, 0x6f, 0x0f, 0xc1, 0xf0, 0x71, 0x8c, 0x17, 0xd3, 0x5d, 0xe0, 0x01,
-	0x34, 0x29, 0x0f, 0x49, 0x54, 0x4e, 0xb6, 0x0b, 0xff, 0x6f, 0x71, 0x3c, 0x29, 0x92, 0x9c, 0x08,
-	0xdc, 0x06, 0x71, 0x63, 0x8e, 0x1b, 0x83, 0x76, 0x5f, 0x3c, 0x86, 0x2f, 0x7d, 0xf9, 0x98, 0x4b,
-	0xe8, 0x5c, 0xe5, 0xe4, 0x79, 0x4e, 0xc2, 0x8a, 0x3b, 0x01, 0x9d, 0x2d, 0x13, 0x3c, 0x33, 0xd5,
-	0x5e, 0xfd, 0x17, 0x59, 0x2e, 0x19, 0x7b, 0x9d, 0xcc, 0xfe, 0xc6, 0x02, 0xb4, 0x46, 0x69, 0xca,
-	0x0f, 0xed, 0xef, 0x1a, 0x68, 0x25, 0x2d, 0x9c, 0x88, 0x0b, 0x77, 0x24, 0xba, 0x32, 0xe9, 0x29,
-	0xc2, 0x13, 0x3a, 0xdf, 0x50, 0x60, 0x0c, 0x90, 0x4c, 0xae, 0xc9, 0xf3, 0x94, 0x52, 0x0c, 0x1a,
-	0x40, 0x8b, 0x48, 0x31, 0x66, 0x9d, 0xe7, 0xbb, 0x32, 0xbf, 0xe1, 0xcb, 0x53, 0xfc, 0x55, 0x0e,
-	0x0d, 0xc1, 0x08, 0x2b, 0x2f, 0x66, 0x83, 0x63, 0x87, 0x12, 0xdb, 0x36, 0xe6, 0x29, 0xfe, 0x7a,
-	0x9a, 0xc1, 0x51, 0x25, 0xc6, 0xd4, 0x36, 0xe0, 0x6d, 0x65, 0x0c, 0x5e, 0x4b, 0xa3, 0x63, 0xa8,
-	0x4f, 0xd2, 0xd4, 0x6c, 0x72, 0x68, 0x4f, 0x42, 0xa5, 0x2b, 0x4f, 0xf1, 0xd9, 0x16, 0x9d, 0x95,
-	0xcd, 0xd0, 0x79, 0x6c, 0x5f, 0xc6, 0xaa, 0x5e, 0x7a, 0x8a, 0xac, 0xcb, 0x58, 0x97, 0x2d, 0x18,
-	0x1f, 0xbd, 0x2f, 0x2d, 0xf5, 0x63, 0x69, 0xa9, 0x9f, 0x4b, 0x4b, 0x7d, 0xfb, 0xb2, 0x94, 0x07,
-	0x5d, 0xb6, 0x3c, 0x68, 0xf2, 0x82, 0xbb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x4a, 0xb7,
-	0x71, 0x25, 0x03, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x13, 0xb3, 0x7d, 0xd9, 0xa7, 0x5d, 0xec, 0x0e, 0x8b, 0x8e, 0x97, 0xb2, 0x64, 0x41,
+	0x14, 0x96, 0x06, 0x5a, 0xbc, 0xb8, 0x07, 0xd9, 0x55, 0x24, 0x5e, 0x44, 0x83, 0x5e, 0xbc, 0x94,
+	0x34, 0x99, 0xad, 0x23, 0x99, 0x49, 0x9d, 0x36, 0x12, 0xbf, 0x85, 0x37, 0xbf, 0x92, 0x47, 0xcf,
+	0x9e, 0xa4, 0x7e, 0x0f, 0x91, 0x79, 0xcb, 0xcb, 0x96, 0x5d, 0x41, 0x3c, 0x35, 0xcf, 0x7f, 0xfe,
+	0xbf, 0xa7, 0xf3, 0xfc, 0xf3, 0x10, 0x38, 0x5f, 0xd2, 0xcd, 0xfb, 0x62, 0x31, 0x49, 0x72, 0x16,
+	0xb0, 0x59, 0xba, 0x08, 0xd8, 0x2c, 0x58, 0x8b, 0x24, 0x60, 0x33, 0x4e, 0x79, 0x19, 0x2c, 0x09,
+	0x27, 0x22, 0xde, 0x90, 0x34, 0x58, 0x89, 0x7c, 0x93, 0x07, 0x1f, 0x0b, 0x22, 0x3e, 0xaf, 0x16,
+	0xfa, 0x77, 0xa2, 0x34, 0xd4, 0x51, 0x85, 0xef, 0x03, 0x3c, 0xa7, 0x24, 0x4b, 0x5f, 0xcb, 0x0a,
+	0x1d, 0x41, 0xe7, 0x52, 0x56, 0xd8, 0x3d, 0x76, 0x1f, 0x0c, 0x23, 0x5d, 0xf8, 0x8f, 0x60, 0xff,
+	0x0d, 0x11, 0xec, 0x06, 0x0b, 0x42, 0xb0, 0xb7, 0x21, 0x82, 0xe1, 0x5b, 0x4a, 0x54, 0xcf, 0xfe,
+	0x19, 0x0c, 0x22, 0xb2, 0x24, 0xe5, 0xea, 0x26, 0xf0, 0x0e, 0x74, 0x85, 0x32, 0x19, 0xd4, 0x54,
+	0xfe, 0x0c, 0x0e, 0x5e, 0x92, 0x65, 0xbc, 0xa1, 0x39, 0xd7, 0xb8, 0x0f, 0xfa, 0xc6, 0x0a, 0x1f,
+	0x4c, 0x87, 0x13, 0x3d, 0x8c, 0x3a, 0x8c, 0xcc, 0x30, 0x8f, 0x61, 0xf4, 0x34, 0xe7, 0x1f, 0x0a,
+	0x9e, 0xd4, 0xdc, 0x7d, 0xe8, 0xc9, 0x43, 0x4a, 0xd6, 0xd8, 0x3d, 0xf6, 0x76, 0x48, 0x7b, 0x28,
+	0xd9, 0x67, 0x74, 0xfd, 0x6f, 0x2c, 0x40, 0xff, 0x3c, 0xcb, 0x94, 0xe8, 0xff, 0xf6, 0xa0, 0x63,
+	0x69, 0x9d, 0x89, 0xbe, 0xf0, 0xc8, 0xa0, 0x55, 0x92, 0xa1, 0xa3, 0x73, 0x42, 0xa7, 0xad, 0x08,
+	0x06, 0x53, 0x64, 0x9c, 0x8d, 0xf0, 0x42, 0xc7, 0x06, 0x83, 0xa6, 0xd0, 0xe7, 0x26, 0x18, 0xec,
+	0x29, 0xff, 0x91, 0xf1, 0xb7, 0xf2, 0x0a, 0x9d, 0xa8, 0xf2, 0xa1, 0x33, 0x18, 0x24, 0x75, 0x2e,
+	0x78, 0x4f, 0x61, 0x77, 0x0d, 0x76, 0x35, 0xb1, 0xd0, 0x89, 0x9a, 0x6e, 0x09, 0xa7, 0x75, 0x30,
+	0xb8, 0xd3, 0x82, 0xaf, 0x46, 0x26, 0xe1, 0x86, 0x1b, 0x9d, 0x80, 0x17, 0x67, 0x19, 0xee, 0x2a,
+	0xe8, 0xb6, 0x81, 0x6c, 0x56, 0xa1, 0x13, 0xc9, 0x53, 0xf4, 0xd0, 0x6e, 0x46, 0x4f, 0xd9, 0x0e,
+	0x8d, 0xad, 0xde, 0xcb, 0xd0, 0xb1, 0xeb, 0x72, 0x0a, 0xdd, 0x95, 0x20, 0x97, 0xb4, 0xc4, 0xfd,
+	0x56, 0x56, 0xaf, 0x94, 0x58, 0x65, 0xa5, 0x3d, 0xb2, 0xb1, 0x88, 0xf9, 0x92, 0xe0, 0xfd, 0x56,
+	0xe3, 0x48, 0x6a, 0x55, 0x63, 0xe5, 0x40, 0x4f, 0xe0, 0x80, 0x17, 0x8c, 0x08, 0x9a, 0xcc, 0x35,
+	0x02, 0x0a, 0xc1, 0x36, 0x5b, 0x7d, 0xd6, 0x22, 0x87, 0xbc, 0x21, 0x5e, 0xf4, 0xcc, 0x7e, 0xca,
+	0xb5, 0x6f, 0xdc, 0xe6, 0xfa, 0xb5, 0x37, 0x73, 0x98, 0xb5, 0xd7, 0x95, 0xff, 0xc3, 0x05, 0xa8,
+	0xff, 0xe4, 0x1a, 0x78, 0x04, 0x1e, 0xa3, 0xdc, 0x90, 0xf2, 0x51, 0x29, 0x71, 0x89, 0x3d, 0xa3,
+	0xc4, 0x25, 0x3a, 0x81, 0x03, 0x46, 0xf9, 0x9c, 0xf2, 0x24, 0x2b, 0xd6, 0xf4, 0x13, 0x51, 0x2f,
+	0xbd, 0x1f, 0x0d, 0x19, 0xe5, 0x2f, 0xac, 0xa6, 0x4c, 0x71, 0xd9, 0x30, 0x75, 0x8c, 0x29, 0x2e,
+	0xdb, 0x26, 0xca, 0xe7, 0x05, 0x5f, 0xe4, 0x05, 0x4f, 0x49, 0x8a, 0xbb, 0xc6, 0x44, 0xf9, 0x5b,
+	0xab, 0xd9, 0x4e, 0xb5, 0xa9, 0x57, 0x75, 0xaa, 0x4c, 0xfe, 0x57, 0x17, 0x0e, 0x77, 0x82, 0xfc,
+	0xfb, 0x8c, 0xee, 0xce, 0x8c, 0xee, 0x7f, 0x9e, 0xf1, 0xe2, 0xde, 0xb7, 0xed, 0xd8, 0xfd, 0xbe,
+	0x1d, 0xbb, 0x3f, 0xb7, 0x63, 0xf7, 0xcb, 0xaf, 0xb1, 0xf3, 0xae, 0x67, 0xbe, 0x99, 0x8b, 0xae,
+	0xfa, 0x5c, 0xce, 0xfe, 0x0c, 0x00, 0x33, 0x5b, 0xca, 0xa9, 0x73, 0x05, 0x00, 0x00,
 }
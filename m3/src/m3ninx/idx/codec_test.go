@@ -43,6 +43,18 @@ func TestMarshal(t *testing.T) {
 			name:  "regexp query",
 			query: MustCreateRegexpQuery([]byte("fruit"), []byte(".*ple")),
 		},
+		{
+			name:  "prefix query",
+			query: NewPrefixQuery([]byte("fruit"), []byte("app")),
+		},
+		{
+			name:  "range query",
+			query: NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, true),
+		},
+		{
+			name:  "numeric range query",
+			query: mustNewNumericRangeQuery([]byte("shard"), 10, 20, false, true),
+		},
 		{
 			name:  "negation query",
 			query: NewNegationQuery(NewTermQuery([]byte("fruit"), []byte("apple"))),
@@ -85,3 +97,15 @@ func TestMarshal(t *testing.T) {
 		})
 	}
 }
+
+func mustNewNumericRangeQuery(
+	field []byte,
+	min, max float64,
+	minInclusive, maxInclusive bool,
+) Query {
+	q, err := NewNumericRangeQuery(field, min, max, minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
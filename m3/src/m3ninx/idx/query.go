@@ -94,6 +94,40 @@ func MustCreateRegexpQuery(field, regexp []byte) Query {
 	}
 }
 
+// NewPrefixQuery returns a new query for finding documents which have a term for the
+// given field starting with the given prefix.
+func NewPrefixQuery(field, prefix []byte) Query {
+	return Query{
+		query: query.NewPrefixQuery(field, prefix),
+	}
+}
+
+// NewRangeQuery returns a new query for finding documents which have a term for the
+// given field within a lexicographic range. A nil min or max leaves the range
+// unbounded on that side, whereas an empty non-nil bound is the empty term.
+func NewRangeQuery(field, min, max []byte, minInclusive, maxInclusive bool) Query {
+	return Query{
+		query: query.NewRangeQuery(field, min, max, minInclusive, maxInclusive),
+	}
+}
+
+// NewNumericRangeQuery returns a new query for finding documents which have a term for
+// the given field that parses as a number within a numeric range. An infinite min or
+// max leaves the range unbounded on that side.
+func NewNumericRangeQuery(
+	field []byte,
+	min, max float64,
+	minInclusive, maxInclusive bool,
+) (Query, error) {
+	q, err := query.NewNumericRangeQuery(field, min, max, minInclusive, maxInclusive)
+	if err != nil {
+		return Query{}, err
+	}
+	return Query{
+		query: q,
+	}, nil
+}
+
 // NewNegationQuery returns a new query for finding documents which don't match a given query.
 func NewNegationQuery(q Query) Query {
 	return Query{
@@ -577,6 +577,86 @@ func (r *fsSegment) matchRegexpNotClosedMaybeFinalizedWithRLock(
 	return pl, nil
 }
 
+func (r *fsSegment) MatchRange(
+	field []byte,
+	termRange index.TermRange,
+) (postings.List, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return nil, errReaderClosed
+	}
+	return r.matchRangeNotClosedMaybeFinalizedWithRLock(field, termRange)
+}
+
+func (r *fsSegment) matchRangeNotClosedMaybeFinalizedWithRLock(
+	field []byte,
+	termRange index.TermRange,
+) (postings.List, error) {
+	// NB(r): Not closed, but could be finalized (i.e. closed segment reader)
+	// calling match field after this segment is finalized.
+	if r.finalized {
+		return nil, errReaderFinalized
+	}
+
+	termsFST, exists, err := r.retrieveTermsFSTWithRLock(field)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		// i.e. we don't know anything about the field, so can early return an empty postings list
+		return r.opts.PostingsListPool().Get(), nil
+	}
+
+	var (
+		startInclusive, endExclusive = termRange.Bounds()
+		fstCloser                    = x.NewSafeCloser(termsFST)
+		iter, iterErr                = termsFST.Iterator(startInclusive, endExclusive)
+		iterCloser                   = x.NewSafeCloser(iter)
+		pls                          []postings.List
+	)
+	defer func() {
+		iterCloser.Close()
+		fstCloser.Close()
+	}()
+
+	for {
+		if iterErr == vellum.ErrIteratorDone {
+			break
+		}
+
+		if iterErr != nil {
+			return nil, iterErr
+		}
+
+		term, postingsOffset := iter.Current()
+		if termRange.Contains(term) {
+			nextPl, err := r.retrievePostingsListWithRLock(postingsOffset)
+			if err != nil {
+				return nil, err
+			}
+			pls = append(pls, nextPl)
+		}
+		iterErr = iter.Next()
+	}
+
+	pl, err := roaring.Union(pls)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iterCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := fstCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
 func (r *fsSegment) MatchAll() (postings.MutableList, error) {
 	r.RLock()
 	defer r.RUnlock()
@@ -891,6 +971,21 @@ func (sr *fsSegmentReader) MatchRegexp(
 	return pl, err
 }
 
+func (sr *fsSegmentReader) MatchRange(
+	field []byte,
+	termRange index.TermRange,
+) (postings.List, error) {
+	if sr.closed {
+		return nil, errReaderClosed
+	}
+	// NB(r): We are allowed to call match field after Close called on
+	// the segment but not after it is finalized.
+	sr.fsSegment.RLock()
+	pl, err := sr.fsSegment.matchRangeNotClosedMaybeFinalizedWithRLock(field, termRange)
+	sr.fsSegment.RUnlock()
+	return pl, err
+}
+
 func (sr *fsSegmentReader) MatchAll() (postings.MutableList, error) {
 	if sr.closed {
 		return nil, errReaderClosed
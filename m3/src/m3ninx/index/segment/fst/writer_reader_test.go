@@ -394,6 +394,85 @@ func TestPostingsListRegexAll(t *testing.T) {
 	}
 }
 
+func TestPostingsListEqualForMatchRange(t *testing.T) {
+	for _, test := range testDocuments {
+		t.Run(test.name, func(t *testing.T) {
+			for _, tc := range newTestCases(t, test.docs) {
+				t.Run(tc.name, func(t *testing.T) {
+					expSeg, obsSeg := tc.expected, tc.observed
+					expReader, err := expSeg.Reader()
+					require.NoError(t, err)
+					obsReader, err := obsSeg.Reader()
+					require.NoError(t, err)
+
+					fieldsIter, err := expSeg.FieldsIterable().Fields()
+					require.NoError(t, err)
+					fields := toSlice(t, fieldsIter)
+					for _, f := range fields {
+						termsIter, err := expSeg.TermsIterable().Terms(f)
+						require.NoError(t, err)
+						terms := toTermPostings(t, termsIter)
+
+						// NB: only a handful of terms per field are used to build ranges to
+						// keep the test fast for the larger test documents set.
+						var ranges []index.TermRange
+						for term := range terms {
+							if len(ranges) >= 15 {
+								break
+							}
+							prefix := []byte(term)
+							if len(prefix) > 2 {
+								prefix = prefix[:2]
+							}
+							ranges = append(ranges,
+								index.NewPrefixTermRange(prefix),
+								index.NewTermRange(prefix, []byte(term), true, true),
+								index.NewTermRange(nil, []byte(term), false, false),
+							)
+						}
+						ranges = append(ranges, index.NewNumericTermRange(0, 100, true, false))
+
+						for _, r := range ranges {
+							expPl, err := expReader.MatchRange(f, r)
+							require.NoError(t, err)
+							obsPl, err := obsReader.MatchRange(f, r)
+							require.NoError(t, err)
+							require.True(t, expPl.Equal(obsPl),
+								fmt.Sprintf("%s:%v - [%v] != [%v]", string(f), r, pprintIter(expPl), pprintIter(obsPl)))
+						}
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestPostingsListPrefixEqualsRegexp(t *testing.T) {
+	memSeg, fstSeg := newTestSegments(t, fewTestDocuments)
+	for _, seg := range []sgmt.Segment{memSeg, fstSeg} {
+		reader, err := seg.Reader()
+		require.NoError(t, err)
+
+		prefixPl, err := reader.MatchRange([]byte("fruit"), index.NewPrefixTermRange([]byte("ban")))
+		require.NoError(t, err)
+		require.Equal(t, 1, prefixPl.Len())
+
+		re, err := index.CompileRegex([]byte("ban.*"))
+		require.NoError(t, err)
+		regexpPl, err := reader.MatchRegexp([]byte("fruit"), re)
+		require.NoError(t, err)
+		require.True(t, prefixPl.Equal(regexpPl))
+
+		emptyPl, err := reader.MatchRange([]byte("fruit"), index.NewPrefixTermRange([]byte("cherry")))
+		require.NoError(t, err)
+		require.True(t, emptyPl.IsEmpty())
+
+		missingFieldPl, err := reader.MatchRange([]byte("unknown"), index.NewPrefixTermRange(nil))
+		require.NoError(t, err)
+		require.True(t, missingFieldPl.IsEmpty())
+	}
+}
+
 func TestSegmentDocs(t *testing.T) {
 	for _, test := range testDocuments {
 		t.Run(test.name, func(t *testing.T) {
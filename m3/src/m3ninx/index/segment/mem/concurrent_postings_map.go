@@ -24,6 +24,7 @@ import (
 	"regexp"
 	"sync"
 
+	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/postings"
 )
 
@@ -97,6 +98,29 @@ func (m *concurrentPostingsMap) Get(key []byte) (postings.List, bool) {
 	return nil, false
 }
 
+// GetRange returns the union of the postings lists whose keys are within the
+// provided term range.
+func (m *concurrentPostingsMap) GetRange(r index.TermRange) (postings.List, bool) {
+	var pl postings.MutableList
+
+	m.RLock()
+	for _, mapEntry := range m.postingsMap.Iter() {
+		if r.Contains(mapEntry.Key()) {
+			if pl == nil {
+				pl = mapEntry.Value().Clone()
+			} else {
+				pl.Union(mapEntry.Value())
+			}
+		}
+	}
+	m.RUnlock()
+
+	if pl == nil {
+		return nil, false
+	}
+	return pl, true
+}
+
 // GetRegex returns the union of the postings lists whose keys match the
 // provided regexp.
 func (m *concurrentPostingsMap) GetRegex(re *regexp.Regexp) (postings.List, bool) {
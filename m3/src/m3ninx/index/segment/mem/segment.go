@@ -397,6 +397,16 @@ func (s *segment) matchRegexp(field []byte, compiled *re.Regexp) (postings.List,
 	return s.termsDict.MatchRegexp(field, compiled), nil
 }
 
+func (s *segment) matchRange(field []byte, r index.TermRange) (postings.List, error) {
+	s.state.RLock()
+	defer s.state.RUnlock()
+	if s.state.closed {
+		return nil, sgmt.ErrClosed
+	}
+
+	return s.termsDict.MatchRange(field, r), nil
+}
+
 func (s *segment) getDoc(id postings.ID) (doc.Document, error) {
 	s.state.RLock()
 	defer s.state.RUnlock()
@@ -0,0 +1,144 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"bytes"
+	"math"
+	"strconv"
+)
+
+// TermRange is a range of terms which can be used to query the various segment
+// implementations. Terms are either compared lexicographically, in which case
+// the range bounds the terms iterated over, or numerically by their parsed
+// floating point values, in which case every term of the field is inspected.
+type TermRange struct {
+	min          []byte
+	max          []byte
+	minInclusive bool
+	maxInclusive bool
+
+	numeric  bool
+	minValue float64
+	maxValue float64
+}
+
+// NewTermRange returns a new lexicographic term range, a nil min or max
+// leaves the range unbounded on that side.
+func NewTermRange(min, max []byte, minInclusive, maxInclusive bool) TermRange {
+	return TermRange{
+		min:          min,
+		max:          max,
+		minInclusive: minInclusive,
+		maxInclusive: maxInclusive,
+	}
+}
+
+// NewNumericTermRange returns a new numeric term range, an infinite min or max
+// leaves the range unbounded on that side. Terms which cannot be parsed as
+// numbers never match a numeric range.
+func NewNumericTermRange(min, max float64, minInclusive, maxInclusive bool) TermRange {
+	return TermRange{
+		minInclusive: minInclusive,
+		maxInclusive: maxInclusive,
+		numeric:      true,
+		minValue:     min,
+		maxValue:     max,
+	}
+}
+
+// NewPrefixTermRange returns a new lexicographic term range which matches
+// every term with the given prefix.
+func NewPrefixTermRange(prefix []byte) TermRange {
+	return TermRange{
+		min:          prefix,
+		max:          prefixSuccessor(prefix),
+		minInclusive: true,
+		maxInclusive: false,
+	}
+}
+
+// Bounds returns the lexicographic bounds of the terms that can match the range,
+// nil bounds are unbounded.
+func (r TermRange) Bounds() (startInclusive, endExclusive []byte) {
+	if r.numeric {
+		// NB: numeric ordering does not follow lexicographic ordering of the terms
+		// (e.g. "10" < "9"), so every term needs to be inspected.
+		return nil, nil
+	}
+	if r.max == nil || !r.maxInclusive {
+		return r.min, r.max
+	}
+	// The smallest term greater than max is max followed by a zero byte.
+	endExclusive = make([]byte, len(r.max)+1)
+	copy(endExclusive, r.max)
+	return r.min, endExclusive
+}
+
+// Contains returns whether the given term is within the range.
+func (r TermRange) Contains(term []byte) bool {
+	if r.numeric {
+		value, err := strconv.ParseFloat(string(term), 64)
+		if err != nil || math.IsNaN(value) {
+			return false
+		}
+		return r.containsValue(value)
+	}
+
+	if r.min != nil {
+		cmp := bytes.Compare(term, r.min)
+		if cmp < 0 || (cmp == 0 && !r.minInclusive) {
+			return false
+		}
+	}
+	if r.max != nil {
+		cmp := bytes.Compare(term, r.max)
+		if cmp > 0 || (cmp == 0 && !r.maxInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r TermRange) containsValue(value float64) bool {
+	if value < r.minValue || (value == r.minValue && !r.minInclusive) {
+		return false
+	}
+	if value > r.maxValue || (value == r.maxValue && !r.maxInclusive) {
+		return false
+	}
+	return true
+}
+
+// prefixSuccessor returns the smallest byte slice greater than every byte slice
+// with the given prefix, or nil if there is no such byte slice.
+func prefixSuccessor(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] == math.MaxUint8 {
+			continue
+		}
+		successor := make([]byte, i+1)
+		copy(successor, prefix[:i+1])
+		successor[i]++
+		return successor
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTermRangeContains(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        TermRange
+		matches  []string
+		excludes []string
+	}{
+		{
+			name:     "inclusive lexicographic range",
+			r:        NewTermRange([]byte("b"), []byte("d"), true, true),
+			matches:  []string{"b", "ba", "c", "d"},
+			excludes: []string{"a", "da", "e"},
+		},
+		{
+			name:     "exclusive lexicographic range",
+			r:        NewTermRange([]byte("b"), []byte("d"), false, false),
+			matches:  []string{"ba", "c"},
+			excludes: []string{"b", "d", "da"},
+		},
+		{
+			name:     "unbounded lexicographic range",
+			r:        NewTermRange(nil, []byte("c"), false, false),
+			matches:  []string{"", "a", "bzz"},
+			excludes: []string{"c", "d"},
+		},
+		{
+			name:     "prefix range",
+			r:        NewPrefixTermRange([]byte("web-")),
+			matches:  []string{"web-", "web-01", "web-\xff"},
+			excludes: []string{"web", "web.", "api-web-01"},
+		},
+		{
+			name:     "prefix range of max bytes",
+			r:        NewPrefixTermRange([]byte("a\xff\xff")),
+			matches:  []string{"a\xff\xff", "a\xff\xff\xff"},
+			excludes: []string{"a\xff", "b"},
+		},
+		{
+			name:     "numeric range",
+			r:        NewNumericTermRange(9, 100, false, true),
+			matches:  []string{"10", "9.5", "100", "1e2"},
+			excludes: []string{"9", "101", "5", "foo", "NaN"},
+		},
+		{
+			name:     "unbounded numeric range",
+			r:        NewNumericTermRange(math.Inf(-1), 0, true, false),
+			matches:  []string{"-1", "-1e10", "-Inf"},
+			excludes: []string{"0", "1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, term := range test.matches {
+				require.True(t, test.r.Contains([]byte(term)), term)
+			}
+			for _, term := range test.excludes {
+				require.False(t, test.r.Contains([]byte(term)), term)
+			}
+		})
+	}
+}
+
+func TestTermRangeBounds(t *testing.T) {
+	start, end := NewTermRange([]byte("b"), []byte("d"), true, false).Bounds()
+	require.Equal(t, []byte("b"), start)
+	require.Equal(t, []byte("d"), end)
+
+	start, end = NewTermRange([]byte("b"), []byte("d"), false, true).Bounds()
+	require.Equal(t, []byte("b"), start)
+	require.Equal(t, []byte("d\x00"), end)
+
+	start, end = NewPrefixTermRange([]byte("ab\xff")).Bounds()
+	require.Equal(t, []byte("ab\xff"), start)
+	require.Equal(t, []byte("ac"), end)
+
+	start, end = NewPrefixTermRange([]byte("\xff")).Bounds()
+	require.Equal(t, []byte("\xff"), start)
+	require.Nil(t, end)
+
+	start, end = NewNumericTermRange(1, 10, true, true).Bounds()
+	require.Nil(t, start)
+	require.Nil(t, end)
+}
@@ -63,6 +63,19 @@ func unmarshal(q *querypb.Query) (search.Query, error) {
 	case *querypb.Query_Regexp:
 		return NewRegexpQuery(q.Regexp.Field, q.Regexp.Regexp)
 
+	case *querypb.Query_Prefix:
+		return NewPrefixQuery(q.Prefix.Field, q.Prefix.Prefix), nil
+
+	case *querypb.Query_Range:
+		return NewRangeQuery(q.Range.Field,
+			rangeBoundFromProto(q.Range.Min, q.Range.MinUnbounded),
+			rangeBoundFromProto(q.Range.Max, q.Range.MaxUnbounded),
+			q.Range.MinInclusive, q.Range.MaxInclusive), nil
+
+	case *querypb.Query_NumericRange:
+		return NewNumericRangeQuery(q.NumericRange.Field, q.NumericRange.Min,
+			q.NumericRange.Max, q.NumericRange.MinInclusive, q.NumericRange.MaxInclusive)
+
 	case *querypb.Query_Negation:
 		inner, err := unmarshal(q.Negation.Query)
 		if err != nil {
@@ -95,3 +108,15 @@ func unmarshal(q *querypb.Query) (search.Query, error) {
 
 	return nil, fmt.Errorf("unknown query: %+v", q)
 }
+
+// rangeBoundFromProto returns the range bound, an unset bytes field decodes as
+// nil so bounded sides are always returned as non-nil.
+func rangeBoundFromProto(bound []byte, unbounded bool) []byte {
+	if unbounded {
+		return nil
+	}
+	if bound == nil {
+		return []byte{}
+	}
+	return bound
+}
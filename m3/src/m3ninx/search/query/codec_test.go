@@ -21,8 +21,10 @@
 package query
 
 import (
+	"math"
 	"testing"
 
+	"github.com/m3db/m3/src/m3ninx/index"
 	"github.com/m3db/m3/src/m3ninx/search"
 
 	"github.com/stretchr/testify/require"
@@ -49,6 +51,30 @@ func TestMarshal(t *testing.T) {
 			name:  "regexp query",
 			query: MustCreateRegexpQuery([]byte("fruit"), []byte(".*ple")),
 		},
+		{
+			name:  "prefix query",
+			query: NewPrefixQuery([]byte("fruit"), []byte("app")),
+		},
+		{
+			name:  "range query",
+			query: NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, false),
+		},
+		{
+			name:  "unbounded range query",
+			query: NewRangeQuery([]byte("fruit"), nil, []byte("banana"), false, true),
+		},
+		{
+			name:  "unbounded max range query",
+			query: NewRangeQuery([]byte("fruit"), []byte("apple"), nil, true, false),
+		},
+		{
+			name:  "empty bounds range query",
+			query: NewRangeQuery([]byte("fruit"), []byte{}, []byte{}, true, true),
+		},
+		{
+			name:  "numeric range query",
+			query: MustCreateNumericRangeQuery([]byte("shard"), 10, math.Inf(1), false, false),
+		},
 		{
 			name:  "negation query",
 			query: NewNegationQuery(NewTermQuery([]byte("fruit"), []byte("apple"))),
@@ -96,6 +122,27 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+func TestMarshalRangeQueryBounds(t *testing.T) {
+	// An empty max only matches the empty term and must not be decoded as
+	// an unbounded max.
+	q := NewRangeQuery([]byte("fruit"), nil, []byte{}, false, true)
+	data, err := Marshal(q)
+	require.NoError(t, err)
+
+	cpy, err := Unmarshal(data)
+	require.NoError(t, err)
+
+	rng, ok := cpy.(*RangeQuery)
+	require.True(t, ok)
+	require.Nil(t, rng.min)
+	require.NotNil(t, rng.max)
+	require.Empty(t, rng.max)
+
+	termRange := index.NewTermRange(rng.min, rng.max, rng.minInclusive, rng.maxInclusive)
+	require.True(t, termRange.Contains([]byte{}))
+	require.False(t, termRange.Contains([]byte("apple")))
+}
+
 func TestMarshalError(t *testing.T) {
 	tests := []struct {
 		name  string
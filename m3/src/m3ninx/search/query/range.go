@@ -0,0 +1,218 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/m3db/m3/src/m3ninx/generated/proto/querypb"
+	"github.com/m3db/m3/src/m3ninx/index"
+	"github.com/m3db/m3/src/m3ninx/search"
+	"github.com/m3db/m3/src/m3ninx/search/searcher"
+)
+
+var errNaNRangeBound = errors.New("numeric range bounds must not be NaN")
+
+// RangeQuery finds documents which have a term for the given field within a
+// lexicographic range.
+type RangeQuery struct {
+	field        []byte
+	min          []byte
+	max          []byte
+	minInclusive bool
+	maxInclusive bool
+}
+
+// NewRangeQuery constructs a new RangeQuery for the given field and bounds. A nil
+// min or max leaves the range unbounded on that side, whereas an empty non-nil
+// bound is the empty term.
+func NewRangeQuery(field, min, max []byte, minInclusive, maxInclusive bool) search.Query {
+	return &RangeQuery{
+		field:        field,
+		min:          min,
+		max:          max,
+		minInclusive: minInclusive,
+		maxInclusive: maxInclusive,
+	}
+}
+
+// Searcher returns a searcher over the provided readers.
+func (q *RangeQuery) Searcher() (search.Searcher, error) {
+	termRange := index.NewTermRange(q.min, q.max, q.minInclusive, q.maxInclusive)
+	return searcher.NewRangeSearcher(q.field, termRange), nil
+}
+
+// Equal reports whether q is equivalent to o.
+func (q *RangeQuery) Equal(o search.Query) bool {
+	o, ok := singular(o)
+	if !ok {
+		return false
+	}
+
+	inner, ok := o.(*RangeQuery)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(q.field, inner.field) &&
+		boundEqual(q.min, inner.min) &&
+		boundEqual(q.max, inner.max) &&
+		q.minInclusive == inner.minInclusive &&
+		q.maxInclusive == inner.maxInclusive
+}
+
+// ToProto returns the Protobuf query struct corresponding to the range query.
+func (q *RangeQuery) ToProto() *querypb.Query {
+	rng := querypb.RangeQuery{
+		Field:        q.field,
+		Min:          q.min,
+		Max:          q.max,
+		MinInclusive: q.minInclusive,
+		MaxInclusive: q.maxInclusive,
+		MinUnbounded: q.min == nil,
+		MaxUnbounded: q.max == nil,
+	}
+
+	return &querypb.Query{
+		Query: &querypb.Query_Range{Range: &rng},
+	}
+}
+
+func (q *RangeQuery) String() string {
+	return fmt.Sprintf("range(%s, %s%s, %s%s)", q.field,
+		lowerBracket(q.minInclusive), q.min, q.max, upperBracket(q.maxInclusive))
+}
+
+// NumericRangeQuery finds documents which have a term for the given field that
+// parses as a number within a numeric range.
+type NumericRangeQuery struct {
+	field        []byte
+	min          float64
+	max          float64
+	minInclusive bool
+	maxInclusive bool
+}
+
+// NewNumericRangeQuery constructs a new NumericRangeQuery for the given field and
+// bounds. An infinite min or max leaves the range unbounded on that side.
+func NewNumericRangeQuery(
+	field []byte,
+	min, max float64,
+	minInclusive, maxInclusive bool,
+) (search.Query, error) {
+	if math.IsNaN(min) || math.IsNaN(max) {
+		return nil, errNaNRangeBound
+	}
+	return &NumericRangeQuery{
+		field:        field,
+		min:          min,
+		max:          max,
+		minInclusive: minInclusive,
+		maxInclusive: maxInclusive,
+	}, nil
+}
+
+// MustCreateNumericRangeQuery is like NewNumericRangeQuery but panics if the query
+// cannot be created.
+func MustCreateNumericRangeQuery(
+	field []byte,
+	min, max float64,
+	minInclusive, maxInclusive bool,
+) search.Query {
+	q, err := NewNumericRangeQuery(field, min, max, minInclusive, maxInclusive)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// Searcher returns a searcher over the provided readers.
+func (q *NumericRangeQuery) Searcher() (search.Searcher, error) {
+	termRange := index.NewNumericTermRange(q.min, q.max, q.minInclusive, q.maxInclusive)
+	return searcher.NewRangeSearcher(q.field, termRange), nil
+}
+
+// Equal reports whether q is equivalent to o.
+func (q *NumericRangeQuery) Equal(o search.Query) bool {
+	o, ok := singular(o)
+	if !ok {
+		return false
+	}
+
+	inner, ok := o.(*NumericRangeQuery)
+	if !ok {
+		return false
+	}
+
+	return bytes.Equal(q.field, inner.field) &&
+		q.min == inner.min &&
+		q.max == inner.max &&
+		q.minInclusive == inner.minInclusive &&
+		q.maxInclusive == inner.maxInclusive
+}
+
+// ToProto returns the Protobuf query struct corresponding to the numeric range query.
+func (q *NumericRangeQuery) ToProto() *querypb.Query {
+	rng := querypb.NumericRangeQuery{
+		Field:        q.field,
+		Min:          q.min,
+		Max:          q.max,
+		MinInclusive: q.minInclusive,
+		MaxInclusive: q.maxInclusive,
+	}
+
+	return &querypb.Query{
+		Query: &querypb.Query_NumericRange{NumericRange: &rng},
+	}
+}
+
+func (q *NumericRangeQuery) String() string {
+	return fmt.Sprintf("numeric_range(%s, %s%s, %s%s)", q.field,
+		lowerBracket(q.minInclusive), strconv.FormatFloat(q.min, 'g', -1, 64),
+		strconv.FormatFloat(q.max, 'g', -1, 64), upperBracket(q.maxInclusive))
+}
+
+// boundEqual compares range bounds, where a nil bound is unbounded and
+// differs from an empty bound.
+func boundEqual(a, b []byte) bool {
+	if (a == nil) != (b == nil) {
+		return false
+	}
+	return bytes.Equal(a, b)
+}
+
+func lowerBracket(inclusive bool) string {
+	if inclusive {
+		return "["
+	}
+	return "("
+}
+
+func upperBracket(inclusive bool) string {
+	if inclusive {
+		return "]"
+	}
+	return ")"
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package query
+
+import (
+	"math"
+	"testing"
+
+	"github.com/m3db/m3/src/m3ninx/search"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeQuery(t *testing.T) {
+	q := NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, false)
+	_, err := q.Searcher()
+	require.NoError(t, err)
+	require.Equal(t, "range(fruit, [apple, banana))", q.String())
+}
+
+func TestNumericRangeQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		min, max  float64
+		expectErr bool
+	}{
+		{
+			name: "valid bounds should not return an error",
+			min:  10,
+			max:  math.Inf(1),
+		},
+		{
+			name:      "NaN min should return an error",
+			min:       math.NaN(),
+			max:       10,
+			expectErr: true,
+		},
+		{
+			name:      "NaN max should return an error",
+			min:       10,
+			max:       math.NaN(),
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := NewNumericRangeQuery([]byte("shard"), test.min, test.max, false, true)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = q.Searcher()
+			require.NoError(t, err)
+			require.Equal(t, "numeric_range(shard, (10, +Inf])", q.String())
+		})
+	}
+}
+
+func TestRangeQueryEqual(t *testing.T) {
+	tests := []struct {
+		name        string
+		left, right search.Query
+		expected    bool
+	}{
+		{
+			name:     "same field and bounds",
+			left:     NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, false),
+			right:    NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, false),
+			expected: true,
+		},
+		{
+			name: "singular disjunction query",
+			left: NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, false),
+			right: NewDisjunctionQuery([]search.Query{
+				NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, false),
+			}),
+			expected: true,
+		},
+		{
+			name:     "different bounds",
+			left:     NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, false),
+			right:    NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("cherry"), true, false),
+			expected: false,
+		},
+		{
+			name:     "different inclusivity",
+			left:     NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, false),
+			right:    NewRangeQuery([]byte("fruit"), []byte("apple"), []byte("banana"), true, true),
+			expected: false,
+		},
+		{
+			name:     "empty and unbounded max",
+			left:     NewRangeQuery([]byte("fruit"), []byte("apple"), []byte{}, true, true),
+			right:    NewRangeQuery([]byte("fruit"), []byte("apple"), nil, true, true),
+			expected: false,
+		},
+		{
+			name:     "same numeric bounds",
+			left:     MustCreateNumericRangeQuery([]byte("shard"), 10, 20, true, false),
+			right:    MustCreateNumericRangeQuery([]byte("shard"), 10, 20, true, false),
+			expected: true,
+		},
+		{
+			name:     "different numeric bounds",
+			left:     MustCreateNumericRangeQuery([]byte("shard"), 10, 20, true, false),
+			right:    MustCreateNumericRangeQuery([]byte("shard"), 10, 30, true, false),
+			expected: false,
+		},
+		{
+			name:     "lexicographic and numeric ranges",
+			left:     NewRangeQuery([]byte("shard"), []byte("10"), []byte("20"), true, false),
+			right:    MustCreateNumericRangeQuery([]byte("shard"), 10, 20, true, false),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.left.Equal(test.right))
+		})
+	}
+}
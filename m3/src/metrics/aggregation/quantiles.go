@@ -0,0 +1,196 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	quantilePrefix = "P"
+)
+
+// Quantiles is a list of arbitrary quantiles computed for timers in addition
+// to the quantiles represented by the aggregation types, e.g. P75 or P99.5.
+type Quantiles []float64
+
+// NewQuantiles creates a sorted and deduplicated list of quantiles.
+func NewQuantiles(values ...float64) (Quantiles, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	res := make(Quantiles, 0, len(values))
+	for _, q := range values {
+		if err := validateQuantile(q); err != nil {
+			return nil, err
+		}
+		res = append(res, q)
+	}
+	sort.Float64s(res)
+	deduped := res[:1]
+	for _, q := range res[1:] {
+		if q != deduped[len(deduped)-1] {
+			deduped = append(deduped, q)
+		}
+	}
+	return deduped, nil
+}
+
+// MustNewQuantiles creates a list of quantiles, it panics if an error
+// was encountered.
+func MustNewQuantiles(values ...float64) Quantiles {
+	res, err := NewQuantiles(values...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// ParseQuantile parses a quantile in the form of P75 or P99.5.
+func ParseQuantile(str string) (float64, error) {
+	if len(str) <= len(quantilePrefix) ||
+		!strings.EqualFold(str[:len(quantilePrefix)], quantilePrefix) {
+		return 0, fmt.Errorf("invalid quantile: %s", str)
+	}
+	// NB: the percentile is scaled through the exponent rather than divided
+	// to get the closest quantile to the decimal representation, e.g. 0.9999
+	// rather than 0.9998999999999999 for P99.99.
+	q, err := strconv.ParseFloat(str[len(quantilePrefix):]+"e-2", 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quantile: %s", str)
+	}
+	if err := validateQuantile(q); err != nil {
+		return 0, err
+	}
+	return q, nil
+}
+
+// ParseQuantiles parses a list of quantiles in the form of P75,P99.5.
+func ParseQuantiles(str string) (Quantiles, error) {
+	parts := strings.Split(str, typesSeparator)
+	values := make([]float64, len(parts))
+	for i := range parts {
+		q, err := ParseQuantile(parts[i])
+		if err != nil {
+			return nil, err
+		}
+		values[i] = q
+	}
+	return NewQuantiles(values...)
+}
+
+// IsEmpty returns whether the list of quantiles is empty.
+func (q Quantiles) IsEmpty() bool {
+	return len(q) == 0
+}
+
+// Equal returns whether two lists of quantiles are equal.
+func (q Quantiles) Equal(other Quantiles) bool {
+	if len(q) != len(other) {
+		return false
+	}
+	for i := range q {
+		if q[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone clones the list of quantiles.
+func (q Quantiles) Clone() Quantiles {
+	if len(q) == 0 {
+		return nil
+	}
+	cloned := make(Quantiles, len(q))
+	copy(cloned, q)
+	return cloned
+}
+
+// Validate validates the list of quantiles.
+func (q Quantiles) Validate() error {
+	for _, value := range q {
+		if err := validateQuantile(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns the string representation of the list of quantiles.
+func (q Quantiles) String() string {
+	if len(q) == 0 {
+		return ""
+	}
+	parts := make([]string, len(q))
+	for i, value := range q {
+		parts[i] = quantilePrefix + strconv.FormatFloat(value*100, 'f', -1, 64)
+	}
+	return strings.Join(parts, typesSeparator)
+}
+
+// UnmarshalJSON unmarshals JSON-encoded data into a list of quantiles,
+// quantiles can be specified either as numbers or in the form of P99.5.
+func (q *Quantiles) UnmarshalJSON(data []byte) error {
+	return q.UnmarshalYAML(func(v interface{}) error {
+		return json.Unmarshal(data, v)
+	})
+}
+
+// UnmarshalYAML unmarshals YAML-encoded data into a list of quantiles,
+// quantiles can be specified either as numbers or in the form of P99.5.
+func (q *Quantiles) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var values []float64
+	if err := unmarshal(&values); err == nil {
+		quantiles, err := NewQuantiles(values...)
+		if err != nil {
+			return err
+		}
+		*q = quantiles
+		return nil
+	}
+
+	var strs []string
+	if err := unmarshal(&strs); err != nil {
+		return err
+	}
+	if len(strs) == 0 {
+		*q = nil
+		return nil
+	}
+	quantiles, err := ParseQuantiles(strings.Join(strs, typesSeparator))
+	if err != nil {
+		return err
+	}
+	*q = quantiles
+	return nil
+}
+
+func validateQuantile(q float64) error {
+	if !(q > 0 && q < 1) {
+		return fmt.Errorf("invalid quantile %v: must be between 0 and 1", q)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestNewQuantiles(t *testing.T) {
+	quantiles, err := NewQuantiles(0.995, 0.75, 0.995)
+	require.NoError(t, err)
+	require.Equal(t, Quantiles{0.75, 0.995}, quantiles)
+
+	quantiles, err = NewQuantiles()
+	require.NoError(t, err)
+	require.True(t, quantiles.IsEmpty())
+
+	_, err = NewQuantiles(0.5, 1.5)
+	require.Error(t, err)
+}
+
+func TestParseQuantile(t *testing.T) {
+	inputs := []struct {
+		str      string
+		expected float64
+	}{
+		{str: "P75", expected: 0.75},
+		{str: "p99.5", expected: 0.995},
+		{str: "P99.99", expected: 0.9999},
+	}
+	for _, input := range inputs {
+		q, err := ParseQuantile(input.str)
+		require.NoError(t, err)
+		require.Equal(t, input.expected, q)
+	}
+
+	for _, str := range []string{"", "P", "75", "Pfoo", "P0", "P100", "P150"} {
+		_, err := ParseQuantile(str)
+		require.Error(t, err, str)
+	}
+}
+
+func TestParseQuantiles(t *testing.T) {
+	quantiles, err := ParseQuantiles("P99.5,P75")
+	require.NoError(t, err)
+	require.Equal(t, Quantiles{0.75, 0.995}, quantiles)
+	require.Equal(t, "P75,P99.5", quantiles.String())
+
+	_, err = ParseQuantiles("P99.5,Max")
+	require.Error(t, err)
+}
+
+func TestQuantilesEqualAndClone(t *testing.T) {
+	quantiles := MustNewQuantiles(0.75, 0.995)
+	cloned := quantiles.Clone()
+	require.True(t, quantiles.Equal(cloned))
+
+	cloned[0] = 0.8
+	require.False(t, quantiles.Equal(cloned))
+	require.False(t, quantiles.Equal(nil))
+	require.True(t, Quantiles(nil).Equal(Quantiles{}))
+	require.Nil(t, Quantiles(nil).Clone())
+}
+
+func TestQuantilesUnmarshalYAML(t *testing.T) {
+	inputs := []struct {
+		str      string
+		expected Quantiles
+	}{
+		{str: "[0.995, 0.75]", expected: Quantiles{0.75, 0.995}},
+		{str: "[P99.5, p75]", expected: Quantiles{0.75, 0.995}},
+		{str: "[]", expected: nil},
+	}
+	for _, input := range inputs {
+		var quantiles Quantiles
+		require.NoError(t, yaml.Unmarshal([]byte(input.str), &quantiles))
+		require.Equal(t, input.expected, quantiles)
+	}
+
+	var quantiles Quantiles
+	require.Error(t, yaml.Unmarshal([]byte("[Max]"), &quantiles))
+	require.Error(t, yaml.Unmarshal([]byte("[1.5]"), &quantiles))
+}
+
+func TestQuantilesUnmarshalJSON(t *testing.T) {
+	inputs := []struct {
+		str      string
+		expected Quantiles
+	}{
+		{str: "[0.995, 0.75]", expected: Quantiles{0.75, 0.995}},
+		{str: `["P99.5", "p75"]`, expected: Quantiles{0.75, 0.995}},
+		{str: "[]", expected: nil},
+	}
+	for _, input := range inputs {
+		var quantiles Quantiles
+		require.NoError(t, json.Unmarshal([]byte(input.str), &quantiles))
+		require.Equal(t, input.expected, quantiles)
+	}
+
+	var quantiles Quantiles
+	require.Error(t, json.Unmarshal([]byte(`["Max"]`), &quantiles))
+	require.Error(t, json.Unmarshal([]byte("[1.5]"), &quantiles))
+}
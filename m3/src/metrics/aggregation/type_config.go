@@ -38,6 +38,10 @@ type TypesConfiguration struct {
 	// Default aggregation types for gauge metrics.
 	DefaultGaugeAggregationTypes *Types `yaml:"defaultGaugeAggregationTypes"`
 
+	// Default quantiles for timer metrics in addition to the quantiles of the
+	// default timer aggregation types, e.g. P75 or P99.5.
+	DefaultTimerQuantiles *Quantiles `yaml:"defaultTimerQuantiles"`
+
 	// CounterTransformFnType configures the type string transformation function for counters.
 	CounterTransformFnType *transformFnType `yaml:"counterTransformFnType"`
 
@@ -66,6 +70,9 @@ func (c TypesConfiguration) NewOptions(instrumentOpts instrument.Options) (Types
 	if c.DefaultTimerAggregationTypes != nil {
 		opts = opts.SetDefaultTimerAggregationTypes(*c.DefaultTimerAggregationTypes)
 	}
+	if c.DefaultTimerQuantiles != nil {
+		opts = opts.SetDefaultTimerQuantiles(*c.DefaultTimerQuantiles)
+	}
 	if c.CounterTransformFnType != nil {
 		fn, err := c.CounterTransformFnType.TransformFn()
 		if err != nil {
@@ -36,6 +36,7 @@ defaultTimerAggregationTypes:
   - P50
   - P99
   - P9999
+defaultTimerQuantiles: [P75, P99.5]
 counterTransformFnType: empty
 timerTransformFnType: suffix
 gaugeTransformFnType: empty
@@ -48,12 +49,14 @@ gaugeTransformFnType: empty
 	require.Equal(t, defaultDefaultCounterAggregationTypes, opts.DefaultCounterAggregationTypes())
 	require.Equal(t, Types{Max}, opts.DefaultGaugeAggregationTypes())
 	require.Equal(t, Types{P50, P99, P9999}, opts.DefaultTimerAggregationTypes())
+	require.Equal(t, Quantiles{0.75, 0.995}, opts.DefaultTimerQuantiles())
 	require.Equal(t, []byte(nil), opts.TypeStringForCounter(Mean))
 	require.Equal(t, []byte(nil), opts.TypeStringForCounter(Sum))
 	require.Equal(t, []byte(".sum"), opts.TypeStringForTimer(Sum))
 	require.Equal(t, []byte(".mean"), opts.TypeStringForTimer(Mean))
 	require.Equal(t, []byte(".p50"), opts.TypeStringForTimer(P50))
 	require.Equal(t, []byte(".p999"), opts.TypeStringForTimer(P999))
+	require.Equal(t, []byte(".p995"), opts.TypeStringForTimerQuantile(0.995))
 	require.Equal(t, []byte(nil), opts.TypeStringForGauge(Last))
 }
 
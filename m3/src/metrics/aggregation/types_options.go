@@ -57,6 +57,14 @@ type TypesOptions interface {
 	// DefaultGaugeAggregationTypes returns the default aggregation types for gauges.
 	DefaultGaugeAggregationTypes() Types
 
+	// SetDefaultTimerQuantiles sets the default quantiles computed for timers in
+	// addition to the quantiles of the default timer aggregation types.
+	SetDefaultTimerQuantiles(value Quantiles) TypesOptions
+
+	// DefaultTimerQuantiles returns the default quantiles computed for timers in
+	// addition to the quantiles of the default timer aggregation types.
+	DefaultTimerQuantiles() Quantiles
+
 	// SetQuantileTypeStringFn sets the quantile type string function for timers.
 	SetQuantileTypeStringFn(value QuantileTypeStringFn) TypesOptions
 
@@ -104,6 +112,9 @@ type TypesOptions interface {
 	// TypeStringForGauge returns the type string for the aggregation type for gauges.
 	TypeStringForGauge(value Type) []byte
 
+	// TypeStringForTimerQuantile returns the type string for an arbitrary quantile for timers.
+	TypeStringForTimerQuantile(quantile float64) []byte
+
 	// TypeForCounter returns the aggregation type for given counter type string.
 	TypeForCounter(value []byte) Type
 
@@ -158,6 +169,7 @@ type options struct {
 	defaultCounterAggregationTypes Types
 	defaultTimerAggregationTypes   Types
 	defaultGaugeAggregationTypes   Types
+	defaultTimerQuantiles          Quantiles
 	quantileTypeStringFn           QuantileTypeStringFn
 	counterTypeStringTransformFn   TypeStringTransformFn
 	timerTypeStringTransformFn     TypeStringTransformFn
@@ -230,6 +242,16 @@ func (o *options) DefaultGaugeAggregationTypes() Types {
 	return o.defaultGaugeAggregationTypes
 }
 
+func (o *options) SetDefaultTimerQuantiles(value Quantiles) TypesOptions {
+	opts := *o
+	opts.defaultTimerQuantiles = value
+	return &opts
+}
+
+func (o *options) DefaultTimerQuantiles() Quantiles {
+	return o.defaultTimerQuantiles
+}
+
 func (o *options) SetQuantileTypeStringFn(value QuantileTypeStringFn) TypesOptions {
 	opts := *o
 	opts.quantileTypeStringFn = value
@@ -306,6 +328,10 @@ func (o *options) TypeStringForGauge(aggType Type) []byte {
 	return o.gaugeTypeStrings[aggType.ID()]
 }
 
+func (o *options) TypeStringForTimerQuantile(quantile float64) []byte {
+	return o.timerTypeStringTransformFn(o.quantileTypeStringFn(quantile))
+}
+
 func (o *options) TypeForCounter(value []byte) Type {
 	return typeFor(value, o.counterTypeStrings)
 }
@@ -64,6 +64,14 @@ func TestOptionsSetDefaultTimerAggregationTypes(t *testing.T) {
 	require.Equal(t, typeStrings(nil), o.(*options).counterTypeStrings)
 }
 
+func TestOptionsSetDefaultTimerQuantiles(t *testing.T) {
+	quantiles := MustNewQuantiles(0.75, 0.995)
+	o := NewTypesOptions().SetDefaultTimerQuantiles(quantiles)
+	require.Equal(t, quantiles, o.DefaultTimerQuantiles())
+	require.Equal(t, []byte("p75"), o.TypeStringForTimerQuantile(0.75))
+	require.Equal(t, []byte("p995"), o.TypeStringForTimerQuantile(0.995))
+}
+
 func TestOptionsSetDefaultGaugeAggregationTypes(t *testing.T) {
 	aggTypes := Types{Mean, SumSq}
 	o := NewTypesOptions().SetDefaultGaugeAggregationTypes(aggTypes)
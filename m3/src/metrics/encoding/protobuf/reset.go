@@ -148,6 +148,7 @@ func resetForwardedMetric(pb *metricpb.ForwardedMetric) {
 	pb.Id = pb.Id[:0]
 	pb.TimeNanos = 0
 	pb.Values = pb.Values[:0]
+	pb.Sketch = pb.Sketch[:0]
 }
 
 func resetTimedMetric(pb *metricpb.TimedMetric) {
@@ -176,6 +177,7 @@ func resetForwardMetadata(pb *metricpb.ForwardMetadata) {
 	pb.Pipeline.Ops = pb.Pipeline.Ops[:0]
 	pb.SourceId = 0
 	pb.NumForwardedTimes = 0
+	pb.Quantiles = pb.Quantiles[:0]
 }
 
 func resetTimedMetadata(pb *metricpb.TimedMetadata) {
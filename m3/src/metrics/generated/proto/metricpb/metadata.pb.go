@@ -31,6 +31,8 @@ import aggregationpb "github.com/m3db/m3/src/metrics/generated/proto/aggregation
 import policypb "github.com/m3db/m3/src/metrics/generated/proto/policypb"
 import pipelinepb "github.com/m3db/m3/src/metrics/generated/proto/pipelinepb"
 
+import binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -43,6 +45,7 @@ type PipelineMetadata struct {
 	StoragePolicies []policypb.StoragePolicy    `protobuf:"bytes,2,rep,name=storage_policies,json=storagePolicies" json:"storage_policies"`
 	Pipeline        pipelinepb.AppliedPipeline  `protobuf:"bytes,3,opt,name=pipeline" json:"pipeline"`
 	DropPolicy      policypb.DropPolicy         `protobuf:"varint,4,opt,name=drop_policy,json=dropPolicy,proto3,enum=policypb.DropPolicy" json:"drop_policy,omitempty"`
+	Quantiles       []float64                   `protobuf:"fixed64,5,rep,packed,name=quantiles" json:"quantiles,omitempty"`
 }
 
 func (m *PipelineMetadata) Reset()                    { *m = PipelineMetadata{} }
@@ -78,6 +81,13 @@ func (m *PipelineMetadata) GetDropPolicy() policypb.DropPolicy {
 	return policypb.DropPolicy_NONE
 }
 
+func (m *PipelineMetadata) GetQuantiles() []float64 {
+	if m != nil {
+		return m.Quantiles
+	}
+	return nil
+}
+
 type Metadata struct {
 	Pipelines []PipelineMetadata `protobuf:"bytes,1,rep,name=pipelines" json:"pipelines"`
 }
@@ -148,6 +158,7 @@ type ForwardMetadata struct {
 	Pipeline          pipelinepb.AppliedPipeline  `protobuf:"bytes,3,opt,name=pipeline" json:"pipeline"`
 	SourceId          uint32                      `protobuf:"varint,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 	NumForwardedTimes int32                       `protobuf:"varint,5,opt,name=num_forwarded_times,json=numForwardedTimes,proto3" json:"num_forwarded_times,omitempty"`
+	Quantiles         []float64                   `protobuf:"fixed64,6,rep,packed,name=quantiles" json:"quantiles,omitempty"`
 }
 
 func (m *ForwardMetadata) Reset()                    { *m = ForwardMetadata{} }
@@ -190,6 +201,13 @@ func (m *ForwardMetadata) GetNumForwardedTimes() int32 {
 	return 0
 }
 
+func (m *ForwardMetadata) GetQuantiles() []float64 {
+	if m != nil {
+		return m.Quantiles
+	}
+	return nil
+}
+
 type TimedMetadata struct {
 	AggregationId aggregationpb.AggregationID `protobuf:"bytes,1,opt,name=aggregation_id,json=aggregationId" json:"aggregation_id"`
 	StoragePolicy policypb.StoragePolicy      `protobuf:"bytes,2,opt,name=storage_policy,json=storagePolicy" json:"storage_policy"`
@@ -270,6 +288,16 @@ func (m *PipelineMetadata) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMetadata(dAtA, i, uint64(m.DropPolicy))
 	}
+	if len(m.Quantiles) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Quantiles)*8))
+		for _, num := range m.Quantiles {
+			f95 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f95))
+			i += 8
+		}
+	}
 	return i, nil
 }
 
@@ -423,6 +451,16 @@ func (m *ForwardMetadata) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMetadata(dAtA, i, uint64(m.NumForwardedTimes))
 	}
+	if len(m.Quantiles) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Quantiles)*8))
+		for _, num := range m.Quantiles {
+			f96 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f96))
+			i += 8
+		}
+	}
 	return i, nil
 }
 
@@ -485,6 +523,9 @@ func (m *PipelineMetadata) Size() (n int) {
 	if m.DropPolicy != 0 {
 		n += 1 + sovMetadata(uint64(m.DropPolicy))
 	}
+	if len(m.Quantiles) > 0 {
+		n += 1 + sovMetadata(uint64(len(m.Quantiles)*8)) + len(m.Quantiles)*8
+	}
 	return n
 }
 
@@ -541,6 +582,9 @@ func (m *ForwardMetadata) Size() (n int) {
 	if m.NumForwardedTimes != 0 {
 		n += 1 + sovMetadata(uint64(m.NumForwardedTimes))
 	}
+	if len(m.Quantiles) > 0 {
+		n += 1 + sovMetadata(uint64(len(m.Quantiles)*8)) + len(m.Quantiles)*8
+	}
 	return n
 }
 
@@ -706,6 +750,52 @@ func (m *PipelineMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Quantiles = append(m.Quantiles, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetadata
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Quantiles = append(m.Quantiles, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantiles", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -1165,6 +1255,52 @@ func (m *ForwardMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Quantiles = append(m.Quantiles, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetadata
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Quantiles = append(m.Quantiles, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantiles", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -180,6 +180,7 @@ type ForwardedMetric struct {
 	Id        []byte     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	TimeNanos int64      `protobuf:"varint,3,opt,name=time_nanos,json=timeNanos,proto3" json:"time_nanos,omitempty"`
 	Values    []float64  `protobuf:"fixed64,4,rep,packed,name=values" json:"values,omitempty"`
+	Sketch    []byte     `protobuf:"bytes,5,opt,name=sketch,proto3" json:"sketch,omitempty"`
 }
 
 func (m *ForwardedMetric) Reset()                    { *m = ForwardedMetric{} }
@@ -215,6 +216,13 @@ func (m *ForwardedMetric) GetValues() []float64 {
 	return nil
 }
 
+func (m *ForwardedMetric) GetSketch() []byte {
+	if m != nil {
+		return m.Sketch
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Counter)(nil), "metricpb.Counter")
 	proto.RegisterType((*BatchTimer)(nil), "metricpb.BatchTimer")
@@ -397,6 +405,12 @@ func (m *ForwardedMetric) MarshalTo(dAtA []byte) (int, error) {
 			i += 8
 		}
 	}
+	if len(m.Sketch) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMetric(dAtA, i, uint64(len(m.Sketch)))
+		i += copy(dAtA[i:], m.Sketch)
+	}
 	return i, nil
 }
 
@@ -483,6 +497,10 @@ func (m *ForwardedMetric) Size() (n int) {
 	if len(m.Values) > 0 {
 		n += 1 + sovMetric(uint64(len(m.Values)*8)) + len(m.Values)*8
 	}
+	l = len(m.Sketch)
+	if l > 0 {
+		n += 1 + l + sovMetric(uint64(l))
+	}
 	return n
 }
 
@@ -1092,6 +1110,37 @@ func (m *ForwardedMetric) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sketch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetric
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetric
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sketch = append(m.Sketch[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sketch == nil {
+				m.Sketch = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetric(dAtA[iNdEx:])
@@ -46,6 +46,8 @@ import _ "github.com/gogo/protobuf/gogoproto"
 import aggregationpb "github.com/m3db/m3/src/metrics/generated/proto/aggregationpb"
 import transformationpb "github.com/m3db/m3/src/metrics/generated/proto/transformationpb"
 
+import binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -148,6 +150,7 @@ type RollupOp struct {
 	NewName          string                          `protobuf:"bytes,1,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
 	Tags             []string                        `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
 	AggregationTypes []aggregationpb.AggregationType `protobuf:"varint,3,rep,packed,name=aggregation_types,json=aggregationTypes,enum=aggregationpb.AggregationType" json:"aggregation_types,omitempty"`
+	Quantiles        []float64                       `protobuf:"fixed64,4,rep,packed,name=quantiles" json:"quantiles,omitempty"`
 }
 
 func (m *RollupOp) Reset()                    { *m = RollupOp{} }
@@ -176,6 +179,13 @@ func (m *RollupOp) GetAggregationTypes() []aggregationpb.AggregationType {
 	return nil
 }
 
+func (m *RollupOp) GetQuantiles() []float64 {
+	if m != nil {
+		return m.Quantiles
+	}
+	return nil
+}
+
 type PipelineOp struct {
 	Type           PipelineOp_Type   `protobuf:"varint,1,opt,name=type,proto3,enum=pipelinepb.PipelineOp_Type" json:"type,omitempty"`
 	Aggregation    *AggregationOp    `protobuf:"bytes,2,opt,name=aggregation" json:"aggregation,omitempty"`
@@ -237,6 +247,7 @@ func (m *Pipeline) GetOps() []PipelineOp {
 type AppliedRollupOp struct {
 	Id            []byte                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	AggregationId aggregationpb.AggregationID `protobuf:"bytes,2,opt,name=aggregation_id,json=aggregationId" json:"aggregation_id"`
+	Quantiles     []float64                   `protobuf:"fixed64,3,rep,packed,name=quantiles" json:"quantiles,omitempty"`
 }
 
 func (m *AppliedRollupOp) Reset()                    { *m = AppliedRollupOp{} }
@@ -258,6 +269,13 @@ func (m *AppliedRollupOp) GetAggregationId() aggregationpb.AggregationID {
 	return aggregationpb.AggregationID{}
 }
 
+func (m *AppliedRollupOp) GetQuantiles() []float64 {
+	if m != nil {
+		return m.Quantiles
+	}
+	return nil
+}
+
 // AppliedPipelineOp is a pipeline operation that has
 // been applied against a metric.
 type AppliedPipelineOp struct {
@@ -421,6 +439,16 @@ func (m *RollupOp) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPipeline(dAtA, i, uint64(j1))
 		i += copy(dAtA[i:], dAtA2[:j1])
 	}
+	if len(m.Quantiles) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Quantiles)*8))
+		for _, num := range m.Quantiles {
+			f94 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f94))
+			i += 8
+		}
+	}
 	return i, nil
 }
 
@@ -536,6 +564,16 @@ func (m *AppliedRollupOp) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n6
+	if len(m.Quantiles) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Quantiles)*8))
+		for _, num := range m.Quantiles {
+			f93 := math.Float64bits(float64(num))
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f93))
+			i += 8
+		}
+	}
 	return i, nil
 }
 
@@ -659,6 +697,9 @@ func (m *RollupOp) Size() (n int) {
 		}
 		n += 1 + sovPipeline(uint64(l)) + l
 	}
+	if len(m.Quantiles) > 0 {
+		n += 1 + sovPipeline(uint64(len(m.Quantiles)*8)) + len(m.Quantiles)*8
+	}
 	return n
 }
 
@@ -704,6 +745,9 @@ func (m *AppliedRollupOp) Size() (n int) {
 	}
 	l = m.AggregationId.Size()
 	n += 1 + l + sovPipeline(uint64(l))
+	if len(m.Quantiles) > 0 {
+		n += 1 + sovPipeline(uint64(len(m.Quantiles)*8)) + len(m.Quantiles)*8
+	}
 	return n
 }
 
@@ -1036,6 +1080,52 @@ func (m *RollupOp) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field AggregationTypes", wireType)
 			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Quantiles = append(m.Quantiles, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Quantiles = append(m.Quantiles, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantiles", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -1396,6 +1486,52 @@ func (m *AppliedRollupOp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Quantiles = append(m.Quantiles, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Quantiles = append(m.Quantiles, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantiles", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -81,6 +81,9 @@ type PipelineMetadata struct {
 
 	// Drop policy.
 	DropPolicy policy.DropPolicy `json:"dropPolicy,omitempty"`
+
+	// List of arbitrary quantiles.
+	Quantiles aggregation.Quantiles `json:"quantiles,omitempty"`
 }
 
 // Equal returns true if two pipeline metadata are considered equal.
@@ -88,7 +91,8 @@ func (m PipelineMetadata) Equal(other PipelineMetadata) bool {
 	return m.AggregationID.Equal(other.AggregationID) &&
 		m.StoragePolicies.Equal(other.StoragePolicies) &&
 		m.Pipeline.Equal(other.Pipeline) &&
-		m.DropPolicy == other.DropPolicy
+		m.DropPolicy == other.DropPolicy &&
+		m.Quantiles.Equal(other.Quantiles)
 }
 
 // IsDefault returns whether this is the default standard pipeline metadata.
@@ -96,7 +100,8 @@ func (m PipelineMetadata) IsDefault() bool {
 	return m.AggregationID.IsDefault() &&
 		m.StoragePolicies.IsDefault() &&
 		m.Pipeline.IsEmpty() &&
-		m.DropPolicy.IsDefault()
+		m.DropPolicy.IsDefault() &&
+		m.Quantiles.IsEmpty()
 }
 
 // IsDropPolicyApplied returns whether this is the default standard pipeline
@@ -105,7 +110,8 @@ func (m PipelineMetadata) IsDropPolicyApplied() bool {
 	return m.AggregationID.IsDefault() &&
 		m.StoragePolicies.IsDefault() &&
 		m.Pipeline.IsEmpty() &&
-		!m.DropPolicy.IsDefault()
+		!m.DropPolicy.IsDefault() &&
+		m.Quantiles.IsEmpty()
 }
 
 // Clone clones the pipeline metadata.
@@ -114,6 +120,7 @@ func (m PipelineMetadata) Clone() PipelineMetadata {
 		AggregationID:   m.AggregationID,
 		StoragePolicies: m.StoragePolicies.Clone(),
 		Pipeline:        m.Pipeline.Clone(),
+		Quantiles:       m.Quantiles.Clone(),
 	}
 }
 
@@ -137,6 +144,7 @@ func (m PipelineMetadata) ToProto(pb *metricpb.PipelineMetadata) error {
 		}
 	}
 	pb.DropPolicy = policypb.DropPolicy(m.DropPolicy)
+	pb.Quantiles = m.Quantiles
 	return nil
 }
 
@@ -159,7 +167,12 @@ func (m *PipelineMetadata) FromProto(pb metricpb.PipelineMetadata) error {
 			return err
 		}
 	}
+	quantiles, err := aggregation.NewQuantiles(pb.Quantiles...)
+	if err != nil {
+		return err
+	}
 	m.DropPolicy = policy.DropPolicy(pb.DropPolicy)
+	m.Quantiles = quantiles
 	return nil
 }
 
@@ -321,6 +334,9 @@ type ForwardMetadata struct {
 
 	// Number of times this metric has been forwarded.
 	NumForwardedTimes int
+
+	// List of arbitrary quantiles.
+	Quantiles aggregation.Quantiles
 }
 
 // ToProto converts the forward metadata to a protobuf message in place.
@@ -336,6 +352,7 @@ func (m ForwardMetadata) ToProto(pb *metricpb.ForwardMetadata) error {
 	}
 	pb.SourceId = m.SourceID
 	pb.NumForwardedTimes = int32(m.NumForwardedTimes)
+	pb.Quantiles = m.Quantiles
 	return nil
 }
 
@@ -350,8 +367,13 @@ func (m *ForwardMetadata) FromProto(pb metricpb.ForwardMetadata) error {
 	if err := m.Pipeline.FromProto(pb.Pipeline); err != nil {
 		return err
 	}
+	quantiles, err := aggregation.NewQuantiles(pb.Quantiles...)
+	if err != nil {
+		return err
+	}
 	m.SourceID = pb.SourceId
 	m.NumForwardedTimes = int(pb.NumForwardedTimes)
+	m.Quantiles = quantiles
 	return nil
 }
 
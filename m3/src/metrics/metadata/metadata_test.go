@@ -77,6 +77,7 @@ var (
 		}),
 		SourceID:          897,
 		NumForwardedTimes: 2,
+		Quantiles:         aggregation.MustNewQuantiles(0.75, 0.995),
 	}
 	testSmallPipelineMetadata = PipelineMetadata{
 		AggregationID: aggregation.DefaultID,
@@ -113,6 +114,7 @@ var (
 				},
 			},
 		}),
+		Quantiles: aggregation.MustNewQuantiles(0.5, 0.999),
 	}
 	testBadForwardMetadata = ForwardMetadata{
 		StoragePolicy: policy.NewStoragePolicy(10*time.Second, xtime.Unit(101), 6*time.Hour),
@@ -291,6 +293,7 @@ var (
 		},
 		SourceId:          897,
 		NumForwardedTimes: 2,
+		Quantiles:         []float64{0.75, 0.995},
 	}
 	testBadForwardMetadataProto    = metricpb.ForwardMetadata{}
 	testSmallPipelineMetadataProto = metricpb.PipelineMetadata{
@@ -356,6 +359,7 @@ var (
 				},
 			},
 		},
+		Quantiles: []float64{0.5, 0.999},
 	}
 	testBadPipelineMetadataProto = metricpb.PipelineMetadata{
 		StoragePolicies: []policypb.StoragePolicy{
@@ -821,6 +825,10 @@ func TestPipelineMetadataClone(t *testing.T) {
 	cloned1.StoragePolicies[0] = policy.MustParseStoragePolicy("1h:1h")
 	require.False(t, cloned1.Equal(testLargePipelineMetadata))
 	require.True(t, cloned2.Equal(testLargePipelineMetadata))
+
+	// Assert that modifying the cloned quantiles does not mutate the original.
+	cloned2.Quantiles[0] = 0.25
+	require.False(t, cloned2.Equal(testLargePipelineMetadata))
 }
 
 func TestPipelineMetadataToProto(t *testing.T) {
@@ -147,6 +147,10 @@ type ForwardedMetric struct {
 	ID        id.RawID
 	TimeNanos int64
 	Values    []float64
+
+	// Sketch is the encoded quantile sketch carried alongside the values so
+	// that downstream aggregations can merge quantiles without losing accuracy.
+	Sketch []byte
 }
 
 // ToProto converts the forwarded metric to a protobuf message in place.
@@ -157,6 +161,7 @@ func (m ForwardedMetric) ToProto(pb *metricpb.ForwardedMetric) error {
 	pb.Id = m.ID
 	pb.TimeNanos = m.TimeNanos
 	pb.Values = m.Values
+	pb.Sketch = m.Sketch
 	return nil
 }
 
@@ -168,6 +173,7 @@ func (m *ForwardedMetric) FromProto(pb metricpb.ForwardedMetric) error {
 	m.ID = pb.Id
 	m.TimeNanos = pb.TimeNanos
 	m.Values = pb.Values
+	m.Sketch = pb.Sketch
 	return nil
 }
 
@@ -43,22 +43,33 @@ type RollupOp struct {
 	ID []byte
 	// Type of aggregations performed within each unique dimension combination.
 	AggregationID aggregation.ID
+	// Arbitrary quantiles computed within each unique dimension combination.
+	Quantiles aggregation.Quantiles
 }
 
 // Equal determines whether two rollup operations are equal.
 func (op RollupOp) Equal(other RollupOp) bool {
-	return op.AggregationID == other.AggregationID && bytes.Equal(op.ID, other.ID)
+	return op.AggregationID == other.AggregationID &&
+		op.Quantiles.Equal(other.Quantiles) &&
+		bytes.Equal(op.ID, other.ID)
 }
 
 // Clone clones the rollup operation.
 func (op RollupOp) Clone() RollupOp {
 	idClone := make([]byte, len(op.ID))
 	copy(idClone, op.ID)
-	return RollupOp{ID: idClone, AggregationID: op.AggregationID}
+	return RollupOp{
+		ID:            idClone,
+		AggregationID: op.AggregationID,
+		Quantiles:     op.Quantiles.Clone(),
+	}
 }
 
 func (op RollupOp) String() string {
-	return fmt.Sprintf("{id: %s, aggregation: %v}", op.ID, op.AggregationID)
+	if op.Quantiles.IsEmpty() {
+		return fmt.Sprintf("{id: %s, aggregation: %v}", op.ID, op.AggregationID)
+	}
+	return fmt.Sprintf("{id: %s, aggregation: %v, quantiles: %v}", op.ID, op.AggregationID, op.Quantiles)
 }
 
 // ToProto converts the applied rollup op to a protobuf message in place.
@@ -67,6 +78,7 @@ func (op RollupOp) ToProto(pb *pipelinepb.AppliedRollupOp) error {
 		return err
 	}
 	pb.Id = op.ID
+	pb.Quantiles = op.Quantiles
 	return nil
 }
 
@@ -78,7 +90,12 @@ func (op *RollupOp) FromProto(pb *pipelinepb.AppliedRollupOp) error {
 	if err := op.AggregationID.FromProto(pb.AggregationId); err != nil {
 		return err
 	}
+	quantiles, err := aggregation.NewQuantiles(pb.Quantiles...)
+	if err != nil {
+		return err
+	}
 	op.ID = pb.Id
+	op.Quantiles = quantiles
 	return nil
 }
 
@@ -174,6 +174,8 @@ type RollupOp struct {
 	Tags [][]byte
 	// Types of aggregation performed within each unique dimension combination.
 	AggregationID aggregation.ID
+	// Arbitrary quantiles computed within each unique dimension combination.
+	Quantiles aggregation.Quantiles
 }
 
 // NewRollupOpFromProto creates a new rollup op from proto.
@@ -187,6 +189,10 @@ func NewRollupOpFromProto(pb *pipelinepb.RollupOp) (RollupOp, error) {
 	if err != nil {
 		return rollup, err
 	}
+	quantiles, err := aggregation.NewQuantiles(pb.Quantiles...)
+	if err != nil {
+		return rollup, err
+	}
 	tags := make([]string, len(pb.Tags))
 	copy(tags, pb.Tags)
 	sort.Strings(tags)
@@ -194,6 +200,7 @@ func NewRollupOpFromProto(pb *pipelinepb.RollupOp) (RollupOp, error) {
 		NewName:       []byte(pb.NewName),
 		Tags:          xbytes.ArraysFromStringArray(tags),
 		AggregationID: aggregationID,
+		Quantiles:     quantiles,
 	}, nil
 }
 
@@ -224,6 +231,9 @@ func (op RollupOp) Equal(other RollupOp) bool {
 	if !op.AggregationID.Equal(other.AggregationID) {
 		return false
 	}
+	if !op.Quantiles.Equal(other.Quantiles) {
+		return false
+	}
 	return op.SameTransform(other)
 }
 
@@ -235,6 +245,7 @@ func (op RollupOp) Clone() RollupOp {
 		NewName:       newName,
 		Tags:          xbytes.ArrayCopy(op.Tags),
 		AggregationID: op.AggregationID,
+		Quantiles:     op.Quantiles.Clone(),
 	}
 }
 
@@ -252,6 +263,7 @@ func (op RollupOp) Proto() (*pipelinepb.RollupOp, error) {
 		NewName:          string(op.NewName),
 		Tags:             xbytes.ArraysToStringArray(op.Tags),
 		AggregationTypes: pbAggTypes,
+		Quantiles:        op.Quantiles,
 	}, nil
 }
 
@@ -268,6 +280,9 @@ func (op RollupOp) String() string {
 	}
 	b.WriteString("], ")
 	fmt.Fprintf(&b, "aggregation: %v", op.AggregationID)
+	if !op.Quantiles.IsEmpty() {
+		fmt.Fprintf(&b, ", quantiles: %v", op.Quantiles)
+	}
 	b.WriteString("}")
 	return b.String()
 }
@@ -303,9 +318,10 @@ func (op RollupOp) MarshalYAML() (interface{}, error) {
 }
 
 type rollupMarshaler struct {
-	NewName       string         `json:"newName" yaml:"newName"`
-	Tags          []string       `json:"tags" yaml:"tags"`
-	AggregationID aggregation.ID `json:"aggregation,omitempty" yaml:"aggregation"`
+	NewName       string                `json:"newName" yaml:"newName"`
+	Tags          []string              `json:"tags" yaml:"tags"`
+	AggregationID aggregation.ID        `json:"aggregation,omitempty" yaml:"aggregation"`
+	Quantiles     aggregation.Quantiles `json:"quantiles,omitempty" yaml:"quantiles,omitempty"`
 }
 
 func newRollupMarshaler(op RollupOp) rollupMarshaler {
@@ -313,6 +329,7 @@ func newRollupMarshaler(op RollupOp) rollupMarshaler {
 		NewName:       string(op.NewName),
 		Tags:          xbytes.ArraysToStringArray(op.Tags),
 		AggregationID: op.AggregationID,
+		Quantiles:     op.Quantiles,
 	}
 }
 
@@ -321,6 +338,7 @@ func (m rollupMarshaler) RollupOp() RollupOp {
 		NewName:       []byte(m.NewName),
 		Tags:          xbytes.ArraysFromStringArray(m.Tags),
 		AggregationID: m.AggregationID,
+		Quantiles:     m.Quantiles,
 	}
 }
 
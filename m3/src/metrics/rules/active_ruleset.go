@@ -323,6 +323,7 @@ func (as *activeRuleSet) toRollupResults(
 		}
 		var (
 			aggregationID aggregation.ID
+			quantiles     aggregation.Quantiles
 			rollupID      []byte
 			numSteps      = pipeline.Len()
 			firstOp       = pipeline.At(0)
@@ -355,6 +356,7 @@ func (as *activeRuleSet) toRollupResults(
 				continue
 			}
 			aggregationID = firstOp.Rollup.AggregationID
+			quantiles = firstOp.Rollup.Quantiles
 			toApply = pipeline.SubPipeline(1, numSteps)
 		default:
 			err = fmt.Errorf("target %v operation 0 has unknown type: %v", target, firstOp.Type)
@@ -372,6 +374,7 @@ func (as *activeRuleSet) toRollupResults(
 			AggregationID:   aggregationID,
 			StoragePolicies: target.StoragePolicies,
 			Pipeline:        applied,
+			Quantiles:       quantiles,
 		}
 		if rollupID == nil {
 			// The applied pipeline applies to the incoming ID.
@@ -468,8 +471,12 @@ func (as *activeRuleSet) applyIDToPipeline(
 				return applied.Pipeline{}, err
 			}
 			opUnion = applied.OpUnion{
-				Type:   mpipeline.RollupOpType,
-				Rollup: applied.RollupOp{ID: rollupID, AggregationID: rollupOp.AggregationID},
+				Type: mpipeline.RollupOpType,
+				Rollup: applied.RollupOp{
+					ID:            rollupID,
+					AggregationID: rollupOp.AggregationID,
+					Quantiles:     rollupOp.Quantiles,
+				},
 			}
 		default:
 			return applied.Pipeline{}, fmt.Errorf("unexpected pipeline op type: %v", pipelineOp.Type)
@@ -566,6 +573,7 @@ func (as *activeRuleSet) reverseMappingsForRollupID(
 				pipeline := metadata.PipelineMetadata{
 					AggregationID:   rollupOp.AggregationID,
 					StoragePolicies: target.StoragePolicies.Clone(),
+					Quantiles:       rollupOp.Quantiles.Clone(),
 				}
 				// Only further filter the pipelines with aggregation types if the given metric type
 				// supports multiple aggregation types. This is because if a metric type only supports
@@ -1340,6 +1340,97 @@ func TestActiveRuleSetForwardMatchWithRollupRules(t *testing.T) {
 	}
 }
 
+func TestActiveRuleSetForwardMatchWithRollupRuleQuantiles(t *testing.T) {
+	filter, err := filters.NewTagsFilter(
+		filters.TagFilterValueMap{
+			"rtagName1": filters.FilterValue{Pattern: "rtagValue1"},
+		},
+		filters.Conjunction,
+		testTagsFilterOptions(),
+	)
+	require.NoError(t, err)
+
+	quantiles := aggregation.MustNewQuantiles(0.75, 0.995)
+	rules := []*rollupRule{
+		&rollupRule{
+			uuid: "rollupRuleQuantiles",
+			snapshots: []*rollupRuleSnapshot{
+				&rollupRuleSnapshot{
+					name:         "rollupRuleQuantiles.snapshot1",
+					cutoverNanos: 10000,
+					filter:       filter,
+					targets: []rollupTarget{
+						{
+							Pipeline: pipeline.NewPipeline([]pipeline.OpUnion{
+								{
+									Type: pipeline.RollupOpType,
+									Rollup: pipeline.RollupOp{
+										NewName:       b("rName1"),
+										Tags:          bs("rtagName1"),
+										AggregationID: aggregation.MustCompressTypes(aggregation.Count),
+										Quantiles:     quantiles,
+									},
+								},
+							}),
+							StoragePolicies: policy.StoragePolicies{
+								policy.NewStoragePolicy(10*time.Second, xtime.Second, 24*time.Hour),
+							},
+						},
+						{
+							Pipeline: pipeline.NewPipeline([]pipeline.OpUnion{
+								{
+									Type: pipeline.AggregationOpType,
+									Aggregation: pipeline.AggregationOp{
+										Type: aggregation.Max,
+									},
+								},
+								{
+									Type: pipeline.RollupOpType,
+									Rollup: pipeline.RollupOp{
+										NewName:       b("rName2"),
+										Tags:          bs("rtagName1"),
+										AggregationID: aggregation.DefaultID,
+										Quantiles:     quantiles,
+									},
+								},
+							}),
+							StoragePolicies: policy.StoragePolicies{
+								policy.NewStoragePolicy(time.Minute, xtime.Minute, 48*time.Hour),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	as := newActiveRuleSet(
+		0,
+		nil,
+		rules,
+		testTagsFilterOptions(),
+		mockNewID,
+		nil,
+	)
+	res := as.ForwardMatch(b("rtagName1=rtagValue1,rtagName2=rtagValue2"), 20000, 20001)
+
+	existing := res.ForExistingIDAt(0)
+	require.Equal(t, 1, len(existing))
+	require.Equal(t, 2, len(existing[0].Pipelines))
+	forwarded := existing[0].Pipelines[1]
+	require.True(t, forwarded.Quantiles.IsEmpty())
+	require.Equal(t, 1, forwarded.Pipeline.Len())
+	require.Equal(t, b("rName2|rtagName1=rtagValue1"), forwarded.Pipeline.At(0).Rollup.ID)
+	require.Equal(t, quantiles, forwarded.Pipeline.At(0).Rollup.Quantiles)
+
+	require.Equal(t, 1, res.NumNewRollupIDs())
+	rollup := res.ForNewRollupIDsAt(0, 0)
+	require.Equal(t, b("rName1|rtagName1=rtagValue1"), rollup.ID)
+	require.Equal(t, 1, len(rollup.Metadatas))
+	require.Equal(t, 1, len(rollup.Metadatas[0].Pipelines))
+	require.Equal(t, quantiles, rollup.Metadatas[0].Pipelines[0].Quantiles)
+}
+
 func TestActiveRuleSetForwardMatchWithMappingRulesAndRollupRules(t *testing.T) {
 	inputs := []testMatchInput{
 		{
@@ -37,6 +37,7 @@ type Configuration struct {
 	AckBufferSize             *int                      `yaml:"ackBufferSize"`
 	ConnectionWriteBufferSize *int                      `yaml:"connectionWriteBufferSize"`
 	ConnectionReadBufferSize  *int                      `yaml:"connectionReadBufferSize"`
+	Compressions              *[]proto.CompressionType  `yaml:"compressions"`
 }
 
 // MessagePoolConfiguration is the message pool configuration
@@ -92,5 +93,8 @@ func (c *Configuration) NewOptions(iOpts instrument.Options) Options {
 	if c.ConnectionReadBufferSize != nil {
 		opts = opts.SetConnectionReadBufferSize(*c.ConnectionReadBufferSize)
 	}
+	if c.Compressions != nil {
+		opts = opts.SetCompressions(*c.Compressions)
+	}
 	return opts
 }
@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/m3db/m3/src/msg/protocol/proto"
 	"github.com/m3db/m3/src/x/instrument"
 
 	"github.com/stretchr/testify/require"
@@ -39,6 +40,9 @@ ackFlushInterval: 100ms
 ackBufferSize: 100
 connectionWriteBufferSize: 200
 connectionReadBufferSize: 300
+compressions:
+  - snappy
+  - zstd
 encoder:
   maxMessageSize: 100
   bytesPool:
@@ -61,6 +65,7 @@ decoder:
 	require.Equal(t, 100, opts.AckBufferSize())
 	require.Equal(t, 200, opts.ConnectionWriteBufferSize())
 	require.Equal(t, 300, opts.ConnectionReadBufferSize())
+	require.Equal(t, []proto.CompressionType{proto.SnappyCompression, proto.ZstdCompression}, opts.Compressions())
 	require.Equal(t, 100, opts.EncoderOptions().MaxMessageSize())
 	require.NotNil(t, opts.EncoderOptions().BytesPool())
 	require.Equal(t, 200, opts.DecoderOptions().MaxMessageSize())
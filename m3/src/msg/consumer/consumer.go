@@ -28,6 +28,7 @@ import (
 
 	"github.com/m3db/m3/src/msg/generated/proto/msgpb"
 	"github.com/m3db/m3/src/msg/protocol/proto"
+	xtcp "github.com/m3db/m3/src/x/tcp"
 
 	"github.com/uber-go/tally"
 )
@@ -49,6 +50,7 @@ func NewListener(addr string, opts Options) (Listener, error) {
 	if err != nil {
 		return nil, err
 	}
+	lis = xtcp.NewTLSListener(lis, opts.TLSConfig())
 	mPool := newMessagePool(opts.MessagePoolOptions())
 	mPool.Init()
 	return &listener{
@@ -108,7 +110,7 @@ func newConsumer(
 	opts Options,
 	m metrics,
 ) *consumer {
-	return &consumer{
+	c := &consumer{
 		opts:    opts,
 		mPool:   mPool,
 		encoder: proto.NewEncoder(opts.EncoderOptions()),
@@ -122,6 +124,35 @@ func newConsumer(
 		doneCh: make(chan struct{}),
 		m:      m,
 	}
+	c.writeCompressions()
+	return c
+}
+
+// writeCompressions advertises the accepted compression types to the writer
+// with an ack carrying no metadata, writers that do not support compression
+// treat it as an empty ack.
+func (c *consumer) writeCompressions() {
+	compressions := c.opts.Compressions()
+	if len(compressions) == 0 {
+		return
+	}
+	ack := msgpb.Ack{
+		Compressions: make([]msgpb.CompressionType, 0, len(compressions)),
+	}
+	for _, t := range compressions {
+		ack.Compressions = append(ack.Compressions, msgpb.CompressionType(t))
+	}
+	if err := c.encoder.Encode(&ack); err != nil {
+		c.m.ackEncodeError.Inc(1)
+		return
+	}
+	if _, err := c.w.Write(c.encoder.Bytes()); err != nil {
+		c.m.ackWriteError.Inc(1)
+		return
+	}
+	if err := c.w.Flush(); err != nil {
+		c.m.ackWriteError.Inc(1)
+	}
 }
 
 func (c *consumer) Init() {
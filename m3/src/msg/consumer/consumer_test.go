@@ -24,6 +24,7 @@ import (
 	"errors"
 	"io"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -387,6 +388,53 @@ func TestListenerMultipleConnection(t *testing.T) {
 	testProduceAndReceiveAck(t, testMsg2, l, opts)
 }
 
+func TestConsumerWritesCompressions(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	opts := testOptions().SetCompressions([]proto.CompressionType{proto.SnappyCompression})
+	l, err := NewListener("127.0.0.1:0", opts)
+	require.NoError(t, err)
+	defer l.Close()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+
+	c, err := l.Accept()
+	require.NoError(t, err)
+	defer c.Close()
+
+	var (
+		dec = proto.NewDecoder(conn, opts.DecoderOptions())
+		ack msgpb.Ack
+	)
+	require.NoError(t, dec.Decode(&ack))
+	require.Equal(t, 0, len(ack.Metadata))
+	require.Equal(t, []msgpb.CompressionType{msgpb.CompressionType_SNAPPY}, ack.Compressions)
+
+	comp, err := proto.NewCompressor(proto.SnappyCompression, nil)
+	require.NoError(t, err)
+	msg := msgpb.Message{
+		Metadata: msgpb.Metadata{Shard: 1, Id: 2},
+		Value:    []byte(strings.Repeat("foo", 100)),
+	}
+	enc := proto.NewEncoder(nil)
+	require.NoError(t, enc.Encode(&msg))
+	compressed, err := comp.Compress(enc.Bytes())
+	require.NoError(t, err)
+	_, err = conn.Write(compressed)
+	require.NoError(t, err)
+
+	m, err := c.Message()
+	require.NoError(t, err)
+	require.Equal(t, msg.Value, m.Bytes())
+
+	m.Ack()
+	ack = msgpb.Ack{}
+	require.NoError(t, dec.Decode(&ack))
+	require.Equal(t, []msgpb.Metadata{msg.Metadata}, ack.Metadata)
+	require.Equal(t, 0, len(ack.Compressions))
+}
+
 func testProduceAndReceiveAck(t *testing.T, testMsg msgpb.Message, l Listener, opts Options) {
 	conn, err := net.Dial("tcp", l.Addr().String())
 	require.NoError(t, err)
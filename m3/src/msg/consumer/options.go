@@ -21,6 +21,7 @@
 package consumer
 
 import (
+	"crypto/tls"
 	"time"
 
 	"github.com/m3db/m3/src/msg/protocol/proto"
@@ -42,6 +43,8 @@ type options struct {
 	ackBufferSize    int
 	writeBufferSize  int
 	readBufferSize   int
+	compressions     []proto.CompressionType
+	tlsConfig        *tls.Config
 	iOpts            instrument.Options
 }
 
@@ -129,6 +132,26 @@ func (opts *options) SetConnectionReadBufferSize(value int) Options {
 	return &o
 }
 
+func (opts *options) Compressions() []proto.CompressionType {
+	return opts.compressions
+}
+
+func (opts *options) SetCompressions(value []proto.CompressionType) Options {
+	o := *opts
+	o.compressions = value
+	return &o
+}
+
+func (opts *options) TLSConfig() *tls.Config {
+	return opts.tlsConfig
+}
+
+func (opts *options) SetTLSConfig(value *tls.Config) Options {
+	o := *opts
+	o.tlsConfig = value
+	return &o
+}
+
 func (opts *options) InstrumentOptions() instrument.Options {
 	return opts.iOpts
 }
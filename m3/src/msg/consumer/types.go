@@ -21,6 +21,7 @@
 package consumer
 
 import (
+	"crypto/tls"
 	"net"
 	"time"
 
@@ -106,6 +107,20 @@ type Options interface {
 	// SetConnectionWriteBufferSize sets the buffer size.
 	SetConnectionReadBufferSize(value int) Options
 
+	// Compressions returns the compression types accepted from writers, in
+	// order of preference, writers do not compress when it is empty.
+	Compressions() []proto.CompressionType
+
+	// SetCompressions sets the compression types accepted from writers, in
+	// order of preference.
+	SetCompressions(value []proto.CompressionType) Options
+
+	// TLSConfig returns the TLS config of listeners, nil disables TLS.
+	TLSConfig() *tls.Config
+
+	// SetTLSConfig sets the TLS config of listeners, nil disables TLS.
+	SetTLSConfig(value *tls.Config) Options
+
 	// InstrumentOptions returns the instrument options.
 	InstrumentOptions() instrument.Options
 
@@ -52,6 +52,30 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type CompressionType int32
+
+const (
+	CompressionType_NONE   CompressionType = 0
+	CompressionType_SNAPPY CompressionType = 1
+	CompressionType_ZSTD   CompressionType = 2
+)
+
+var CompressionType_name = map[int32]string{
+	0: "NONE",
+	1: "SNAPPY",
+	2: "ZSTD",
+}
+var CompressionType_value = map[string]int32{
+	"NONE":   0,
+	"SNAPPY": 1,
+	"ZSTD":   2,
+}
+
+func (x CompressionType) String() string {
+	return proto.EnumName(CompressionType_name, int32(x))
+}
+func (CompressionType) EnumDescriptor() ([]byte, []int) { return fileDescriptorMsg, []int{0} }
+
 type Metadata struct {
 	Shard uint64 `protobuf:"varint,1,opt,name=shard,proto3" json:"shard,omitempty"`
 	Id    uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -101,7 +125,8 @@ func (m *Message) GetValue() []byte {
 }
 
 type Ack struct {
-	Metadata []Metadata `protobuf:"bytes,1,rep,name=metadata" json:"metadata"`
+	Metadata     []Metadata        `protobuf:"bytes,1,rep,name=metadata" json:"metadata"`
+	Compressions []CompressionType `protobuf:"varint,2,rep,packed,name=compressions,enum=msgpb.CompressionType" json:"compressions,omitempty"`
 }
 
 func (m *Ack) Reset()                    { *m = Ack{} }
@@ -116,10 +141,18 @@ func (m *Ack) GetMetadata() []Metadata {
 	return nil
 }
 
+func (m *Ack) GetCompressions() []CompressionType {
+	if m != nil {
+		return m.Compressions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "msgpb.Metadata")
 	proto.RegisterType((*Message)(nil), "msgpb.Message")
 	proto.RegisterType((*Ack)(nil), "msgpb.Ack")
+	proto.RegisterEnum("msgpb.CompressionType", CompressionType_name, CompressionType_value)
 }
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -208,6 +241,23 @@ func (m *Ack) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Compressions) > 0 {
+		dAtA2 := make([]byte, len(m.Compressions)*10)
+		var j1 int
+		for _, num := range m.Compressions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMsg(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
+	}
 	return i, nil
 }
 
@@ -253,6 +303,13 @@ func (m *Ack) Size() (n int) {
 			n += 1 + l + sovMsg(uint64(l))
 		}
 	}
+	if len(m.Compressions) > 0 {
+		l = 0
+		for _, e := range m.Compressions {
+			l += sovMsg(uint64(e))
+		}
+		n += 1 + sovMsg(uint64(l)) + l
+	}
 	return n
 }
 
@@ -528,6 +585,68 @@ func (m *Ack) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v CompressionType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (CompressionType(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Compressions = append(m.Compressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsg
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v CompressionType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (CompressionType(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Compressions = append(m.Compressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compressions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsg(dAtA[iNdEx:])
@@ -659,20 +778,24 @@ func init() {
 }
 
 var fileDescriptorMsg = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0x4a, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x35, 0x4e, 0x49, 0xd2, 0xcf, 0x35, 0xd6, 0x2f,
-	0x2e, 0x4a, 0xd6, 0xcf, 0x2d, 0x4e, 0xd7, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0x4a, 0x2c, 0x49, 0x4d,
-	0xd1, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x07, 0x89, 0x15, 0x24, 0x81, 0x48, 0x3d, 0x30, 0x5f, 0x88,
-	0x15, 0x2c, 0x20, 0xa5, 0x8b, 0x64, 0x44, 0x7a, 0x7e, 0x7a, 0x3e, 0x44, 0x75, 0x52, 0x69, 0x1a,
-	0x98, 0x07, 0xd1, 0x0a, 0x62, 0x41, 0x74, 0x29, 0x19, 0x70, 0x71, 0xf8, 0xa6, 0x96, 0x24, 0xa6,
-	0x24, 0x96, 0x24, 0x0a, 0x89, 0x70, 0xb1, 0x16, 0x67, 0x24, 0x16, 0xa5, 0x48, 0x30, 0x2a, 0x30,
-	0x6a, 0xb0, 0x04, 0x41, 0x38, 0x42, 0x7c, 0x5c, 0x4c, 0x99, 0x29, 0x12, 0x4c, 0x60, 0x21, 0xa6,
-	0xcc, 0x14, 0xa5, 0x20, 0x2e, 0x76, 0xdf, 0xd4, 0xe2, 0xe2, 0xc4, 0xf4, 0x54, 0x21, 0x43, 0x2e,
-	0x8e, 0x5c, 0xa8, 0x66, 0xb0, 0x1e, 0x6e, 0x23, 0x7e, 0x3d, 0xb0, 0x2b, 0xf4, 0x60, 0x66, 0x3a,
-	0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x57, 0x06, 0xb2, 0xa3, 0x2c, 0x31, 0xa7, 0x34, 0x15,
-	0x6c, 0x20, 0x4f, 0x10, 0x84, 0xa3, 0x64, 0xc1, 0xc5, 0xec, 0x98, 0x9c, 0x8d, 0x66, 0x1e, 0x33,
-	0x11, 0xe6, 0x39, 0x09, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81, 0x3d, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x31, 0xd7, 0x86, 0x7e, 0x4c, 0x01, 0x00, 0x00,
+	// 300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xcf, 0x4e, 0xc2, 0x30,
+	0x1c, 0xc7, 0xe9, 0xf8, 0xe3, 0xf2, 0x93, 0xc0, 0xd2, 0x18, 0x43, 0x3c, 0x4c, 0xc2, 0x89, 0x98,
+	0xb8, 0x0a, 0xdc, 0xb8, 0x81, 0x7a, 0x04, 0xc9, 0xe0, 0xa2, 0xb7, 0x6e, 0xad, 0x65, 0xd1, 0xd2,
+	0x65, 0x2d, 0x26, 0xbe, 0x85, 0x8f, 0xc5, 0xd1, 0x27, 0x30, 0x06, 0x5f, 0xc4, 0xac, 0xc3, 0x88,
+	0x9c, 0xbc, 0x34, 0xfd, 0x7e, 0xfb, 0xfb, 0x7c, 0xda, 0x14, 0x86, 0x22, 0x31, 0xcb, 0x75, 0x14,
+	0xc4, 0x4a, 0x12, 0x39, 0x60, 0x11, 0x91, 0x03, 0xa2, 0xb3, 0x98, 0x48, 0x2d, 0x88, 0xe0, 0x2b,
+	0x9e, 0x51, 0xc3, 0x19, 0x49, 0x33, 0x65, 0x54, 0xde, 0xa5, 0x51, 0xbe, 0x06, 0x36, 0xe3, 0xaa,
+	0x2d, 0xce, 0x2e, 0xf7, 0x14, 0x42, 0x09, 0x55, 0x4c, 0x47, 0xeb, 0x47, 0x9b, 0x0a, 0x34, 0xdf,
+	0x15, 0x54, 0xe7, 0x0a, 0xdc, 0x09, 0x37, 0x94, 0x51, 0x43, 0xf1, 0x09, 0x54, 0xf5, 0x92, 0x66,
+	0xac, 0x85, 0xda, 0xa8, 0x5b, 0x09, 0x8b, 0x80, 0x1b, 0xe0, 0x24, 0xac, 0xe5, 0xd8, 0xca, 0x49,
+	0x58, 0x27, 0x84, 0xa3, 0x09, 0xd7, 0x9a, 0x0a, 0x8e, 0x7b, 0xe0, 0xca, 0x1d, 0x6c, 0x99, 0xe3,
+	0x7e, 0x33, 0xb0, 0xaf, 0x08, 0x7e, 0x9c, 0xe3, 0xca, 0xe6, 0xe3, 0xbc, 0x14, 0xba, 0x72, 0xef,
+	0x8e, 0x17, 0xfa, 0xbc, 0xe6, 0x56, 0x58, 0x0f, 0x8b, 0xd0, 0x31, 0x50, 0x1e, 0xc5, 0x4f, 0x07,
+	0xbe, 0xf2, 0x7f, 0x7c, 0x43, 0xa8, 0xc7, 0x4a, 0xa6, 0x19, 0xd7, 0x3a, 0x51, 0x2b, 0xdd, 0x72,
+	0xda, 0xe5, 0x6e, 0xa3, 0x7f, 0xba, 0xc3, 0xae, 0x7f, 0x8f, 0x16, 0xaf, 0x29, 0x0f, 0xff, 0xcc,
+	0x5e, 0xf4, 0xa0, 0x79, 0x30, 0x80, 0x5d, 0xa8, 0x4c, 0xef, 0xa6, 0xb7, 0x5e, 0x09, 0x03, 0xd4,
+	0xe6, 0xd3, 0xd1, 0x6c, 0x76, 0xef, 0xa1, 0xbc, 0x7d, 0x98, 0x2f, 0x6e, 0x3c, 0x67, 0xec, 0x6d,
+	0xb6, 0x3e, 0x7a, 0xdf, 0xfa, 0xe8, 0x73, 0xeb, 0xa3, 0xb7, 0x2f, 0xbf, 0x14, 0xd5, 0xec, 0x3f,
+	0x0e, 0xbe, 0x07, 0x00, 0xc8, 0x17, 0xec, 0x85, 0xbb, 0x01, 0x00, 0x00,
 }
@@ -33,6 +33,7 @@ import (
 
 	"github.com/uber-go/tally"
 	"go.uber.org/atomic"
+	"go.uber.org/zap"
 )
 
 var (
@@ -56,6 +57,14 @@ type bufferMetrics struct {
 	messageBuffered   tally.Gauge
 	byteBuffered      tally.Gauge
 	bufferScanBatch   tally.Timer
+	spillMessage      tally.Counter
+	spillByte         tally.Counter
+	spillReplayed     tally.Counter
+	spillDropped      tally.Counter
+	spillFull         tally.Counter
+	spillErrors       tally.Counter
+	spillCorrupt      tally.Counter
+	spillByteBuffered tally.Gauge
 }
 
 type counterPerNumRefBuckets struct {
@@ -117,6 +126,14 @@ func newBufferMetrics(
 		messageBuffered:   scope.Gauge("message-buffered"),
 		byteBuffered:      scope.Gauge("byte-buffered"),
 		bufferScanBatch:   instrument.NewTimer(scope, "buffer-scan-batch", opts),
+		spillMessage:      scope.Counter("spill-message"),
+		spillByte:         scope.Counter("spill-byte"),
+		spillReplayed:     scope.Counter("spill-message-replayed"),
+		spillFull:         scope.Counter("spill-full"),
+		spillErrors:       scope.Counter("spill-errors"),
+		spillDropped:      scope.Counter("spill-message-dropped"),
+		spillCorrupt:      scope.Counter("spill-corrupt"),
+		spillByteBuffered: scope.Gauge("spill-byte-buffered"),
 	}
 }
 
@@ -133,6 +150,15 @@ type buffer struct {
 	onFinalizeFn     producer.OnFinalizeFn
 	retrier          retry.Retrier
 	m                bufferMetrics
+	logger           *zap.Logger
+
+	// spillLock serializes spilling and replaying messages so that spilled
+	// messages are replayed in order.
+	spillLock   sync.Mutex
+	spill       *spillLog
+	writeFn     producer.WriteFn
+	spillDoneCh chan struct{}
+	spillWg     sync.WaitGroup
 
 	size         *atomic.Uint64
 	isClosed     bool
@@ -163,12 +189,21 @@ func NewBuffer(opts Options) (producer.Buffer, error) {
 			opts.InstrumentOptions().MetricsScope(),
 			opts.InstrumentOptions().TimerOptions(),
 		),
+		logger:       opts.InstrumentOptions().Logger(),
 		size:         atomic.NewUint64(0),
 		isClosed:     false,
 		dropOldestCh: make(chan struct{}, 1),
 		doneCh:       make(chan struct{}),
+		spillDoneCh:  make(chan struct{}),
 	}
 	b.onFinalizeFn = b.subSize
+	if spillOpts := opts.SpillOptions(); spillOpts != nil {
+		spill, err := openSpillLog(spillOpts, b.m.spillCorrupt, b.m.spillDropped, b.logger)
+		if err != nil {
+			return nil, err
+		}
+		b.spill = spill
+	}
 	return b, nil
 }
 
@@ -185,6 +220,10 @@ func (b *buffer) Add(m producer.Message) (*producer.RefCountedMessage, error) {
 	}
 	messageSize := uint64(s)
 	newBufferSize := b.size.Add(messageSize)
+	if b.spill != nil && b.spillIfNeeded(m, newBufferSize) {
+		b.RUnlock()
+		return nil, nil
+	}
 	if newBufferSize > b.maxBufferSize {
 		if err := b.produceOnFull(newBufferSize, messageSize); err != nil {
 			b.RUnlock()
@@ -199,6 +238,36 @@ func (b *buffer) Add(m producer.Message) (*producer.RefCountedMessage, error) {
 	return rm, nil
 }
 
+// spillIfNeeded appends the message to the spill log if the buffer is full or
+// if messages are already spilled, so that messages are written in order.
+// The on full strategy applies if the message could not be spilled.
+func (b *buffer) spillIfNeeded(m producer.Message, newBufferSize uint64) bool {
+	b.spillLock.Lock()
+	defer b.spillLock.Unlock()
+
+	if newBufferSize <= b.maxBufferSize && b.spill.empty() {
+		return false
+	}
+	if err := b.spill.append(m.Shard(), m.Bytes()); err != nil {
+		if err == errSpillFull {
+			b.m.spillFull.Inc(1)
+		} else {
+			b.m.spillErrors.Inc(1)
+			b.logger.Error("could not spill message", zap.Error(err))
+		}
+		return false
+	}
+
+	messageSize := m.Size()
+	b.size.Sub(uint64(messageSize))
+	b.m.spillMessage.Inc(1)
+	b.m.spillByte.Inc(int64(messageSize))
+	// The message is persisted in the spill log, which delivers a copy of it
+	// once replayed, so the producer is done with it.
+	m.Finalize(producer.Spilled)
+	return true
+}
+
 func (b *buffer) produceOnFull(newBufferSize uint64, messageSize uint64) error {
 	switch b.opts.OnFullStrategy() {
 	case ReturnError:
@@ -224,13 +293,22 @@ func (b *buffer) produceOnFull(newBufferSize uint64, messageSize uint64) error {
 	return nil
 }
 
-func (b *buffer) Init() {
+func (b *buffer) Init(fn producer.WriteFn) {
 	b.wg.Add(1)
 	go func() {
 		b.cleanupUntilClose()
 		b.wg.Done()
 	}()
 
+	if b.spill != nil {
+		b.writeFn = fn
+		b.spillWg.Add(1)
+		go func() {
+			b.replayUntilClose()
+			b.spillWg.Done()
+		}()
+	}
+
 	if b.opts.OnFullStrategy() != DropOldest {
 		return
 	}
@@ -404,6 +482,73 @@ func (b *buffer) dropOldestBatchUntilTargetWithListLock(
 	return false
 }
 
+func (b *buffer) replayUntilClose() {
+	ticker := time.NewTicker(b.opts.SpillOptions().ReplayInterval())
+	defer ticker.Stop()
+
+	// NB: spilled messages are synced as they are appended without a sync
+	// interval, the log is synced when closed either way.
+	var syncCh <-chan time.Time
+	if interval := b.opts.SpillOptions().SyncInterval(); interval > 0 {
+		syncTicker := time.NewTicker(interval)
+		defer syncTicker.Stop()
+		syncCh = syncTicker.C
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			b.replay()
+		case <-syncCh:
+			if err := b.spill.sync(); err != nil {
+				b.m.spillErrors.Inc(1)
+				b.logger.Error("could not sync spill log", zap.Error(err))
+			}
+		case <-b.spillDoneCh:
+			return
+		}
+	}
+}
+
+// replay writes the spilled messages in order as long as the buffer has room
+// for them, i.e. as long as the buffered messages are being consumed.
+func (b *buffer) replay() {
+	b.spillLock.Lock()
+	defer b.spillLock.Unlock()
+
+	for b.size.Load() < b.maxBufferSize {
+		select {
+		case <-b.spillDoneCh:
+			return
+		default:
+		}
+
+		m, ok, err := b.spill.next()
+		if err != nil {
+			b.m.spillErrors.Inc(1)
+			b.logger.Error("could not replay spilled message", zap.Error(err))
+			break
+		}
+		if !ok {
+			break
+		}
+
+		rm := producer.NewRefCountedMessage(m, b.onFinalizeFn)
+		b.size.Add(rm.Size())
+		b.listLock.Lock()
+		b.bufferList.PushBack(rm)
+		b.listLock.Unlock()
+		if err := b.writeFn(rm); err != nil {
+			// NB: the writer drops messages it fails to write in the same
+			// way as messages that were never spilled.
+			b.m.spillErrors.Inc(1)
+			b.logger.Error("could not write replayed message", zap.Error(err))
+		}
+		b.m.spillReplayed.Inc(1)
+	}
+	b.m.spillByteBuffered.Update(float64(b.spill.bytes()))
+}
+
 func (b *buffer) Close(ct producer.CloseType) {
 	// Stop taking writes right away.
 	b.Lock()
@@ -416,7 +561,16 @@ func (b *buffer) Close(ct producer.CloseType) {
 		b.forceDrop = true
 	}
 	b.Unlock()
+	if b.spill != nil {
+		// Stop replaying, the messages left in the spill log are replayed
+		// on restart.
+		close(b.spillDoneCh)
+		b.spillWg.Wait()
+	}
 	b.waitUntilAllDataConsumed()
+	if b.spill != nil {
+		b.spill.close()
+	}
 	close(b.doneCh)
 	close(b.dropOldestCh)
 	b.wg.Wait()
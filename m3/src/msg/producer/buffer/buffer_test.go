@@ -273,7 +273,7 @@ func TestBufferCleanupBackground(t *testing.T) {
 	require.Equal(t, rm.Size(), uint64(mm.Size()))
 	require.Equal(t, rm.Size(), b.size.Load())
 
-	b.Init()
+	b.Init(nil)
 	mm.EXPECT().Finalize(producer.Consumed)
 	rm.IncRef()
 	rm.DecRef()
@@ -327,7 +327,7 @@ func TestBufferCloseDropEverything(t *testing.T) {
 	require.Equal(t, rm.Size(), uint64(mm.Size()))
 	require.Equal(t, rm.Size(), b.size.Load())
 
-	b.Init()
+	b.Init(nil)
 	mm.EXPECT().Finalize(producer.Dropped)
 	b.Close(producer.DropEverything)
 	for {
@@ -383,7 +383,7 @@ func TestBufferDropOldestAsyncOnFull(t *testing.T) {
 	mm.EXPECT().Finalize(producer.Dropped).Do(func(interface{}) {
 		wg.Done()
 	}).Times(2)
-	b.Init()
+	b.Init(nil)
 	wg.Wait()
 	require.True(t, rd1.IsDroppedOrConsumed())
 	require.True(t, rd2.IsDroppedOrConsumed())
@@ -37,6 +37,11 @@ const (
 	defaultCleanupInitialBackoff = 10 * time.Second
 	defaultAllowedSpilloverRatio = 0.2
 	defaultCleanupMaxBackoff     = time.Minute
+
+	defaultSpillSegmentSize    = 64 * 1024 * 1024   // 64MB.
+	defaultSpillMaxSize        = 1024 * 1024 * 1024 // 1GB.
+	defaultSpillReplayInterval = 100 * time.Millisecond
+	defaultSpillSyncInterval   = 100 * time.Millisecond
 )
 
 var (
@@ -44,6 +49,11 @@ var (
 	errInvalidMaxMessageSize  = errors.New("invalid max message size")
 	errNegativeMaxBufferSize  = errors.New("negative max buffer size")
 	errNegativeMaxMessageSize = errors.New("negative max message size")
+	errNoSpillDirectory       = errors.New("no spill directory")
+	errInvalidSpillSegment    = errors.New("invalid spill segment size")
+	errInvalidSpillMaxSize    = errors.New("spill max size smaller than segment size")
+	errInvalidReplayInterval  = errors.New("invalid spill replay interval")
+	errInvalidSyncInterval    = errors.New("invalid spill sync interval")
 )
 
 type bufferOptions struct {
@@ -55,6 +65,7 @@ type bufferOptions struct {
 	scanBatchSize         int
 	allowedSpilloverRatio float64
 	rOpts                 retry.Options
+	spillOpts             SpillOptions
 	iOpts                 instrument.Options
 }
 
@@ -156,6 +167,16 @@ func (opts *bufferOptions) SetCleanupRetryOptions(value retry.Options) Options {
 	return &o
 }
 
+func (opts *bufferOptions) SpillOptions() SpillOptions {
+	return opts.spillOpts
+}
+
+func (opts *bufferOptions) SetSpillOptions(value SpillOptions) Options {
+	o := *opts
+	o.spillOpts = value
+	return &o
+}
+
 func (opts *bufferOptions) InstrumentOptions() instrument.Options {
 	return opts.iOpts
 }
@@ -180,5 +201,95 @@ func (opts *bufferOptions) Validate() error {
 		// Max message size can only be as large as max buffer size.
 		return errInvalidMaxMessageSize
 	}
+	if opts.SpillOptions() != nil {
+		return opts.SpillOptions().Validate()
+	}
+	return nil
+}
+
+type spillOptions struct {
+	directory      string
+	segmentSize    int
+	maxSize        int
+	replayInterval time.Duration
+	syncInterval   time.Duration
+}
+
+// NewSpillOptions creates SpillOptions.
+func NewSpillOptions() SpillOptions {
+	return &spillOptions{
+		segmentSize:    defaultSpillSegmentSize,
+		maxSize:        defaultSpillMaxSize,
+		replayInterval: defaultSpillReplayInterval,
+		syncInterval:   defaultSpillSyncInterval,
+	}
+}
+
+func (opts *spillOptions) Directory() string {
+	return opts.directory
+}
+
+func (opts *spillOptions) SetDirectory(value string) SpillOptions {
+	o := *opts
+	o.directory = value
+	return &o
+}
+
+func (opts *spillOptions) SegmentSize() int {
+	return opts.segmentSize
+}
+
+func (opts *spillOptions) SetSegmentSize(value int) SpillOptions {
+	o := *opts
+	o.segmentSize = value
+	return &o
+}
+
+func (opts *spillOptions) MaxSize() int {
+	return opts.maxSize
+}
+
+func (opts *spillOptions) SetMaxSize(value int) SpillOptions {
+	o := *opts
+	o.maxSize = value
+	return &o
+}
+
+func (opts *spillOptions) ReplayInterval() time.Duration {
+	return opts.replayInterval
+}
+
+func (opts *spillOptions) SetReplayInterval(value time.Duration) SpillOptions {
+	o := *opts
+	o.replayInterval = value
+	return &o
+}
+
+func (opts *spillOptions) SyncInterval() time.Duration {
+	return opts.syncInterval
+}
+
+func (opts *spillOptions) SetSyncInterval(value time.Duration) SpillOptions {
+	o := *opts
+	o.syncInterval = value
+	return &o
+}
+
+func (opts *spillOptions) Validate() error {
+	if opts.Directory() == "" {
+		return errNoSpillDirectory
+	}
+	if opts.SegmentSize() <= 0 {
+		return errInvalidSpillSegment
+	}
+	if opts.MaxSize() < opts.SegmentSize() {
+		return errInvalidSpillMaxSize
+	}
+	if opts.ReplayInterval() <= 0 {
+		return errInvalidReplayInterval
+	}
+	if opts.SyncInterval() < 0 {
+		return errInvalidSyncInterval
+	}
 	return nil
 }
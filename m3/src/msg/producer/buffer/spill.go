@@ -0,0 +1,450 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/m3db/m3/src/msg/producer"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	spillSegmentPrefix = "spill-"
+	spillSegmentSuffix = ".db"
+
+	// Each entry is the length and checksum of its payload followed by the
+	// payload, which is the shard of the message followed by its bytes.
+	spillHeaderLen = 8
+	spillShardLen  = 4
+
+	spillDirMode  = 0755
+	spillFileMode = 0644
+)
+
+var (
+	errSpillFull    = errors.New("spill log full")
+	errSpillClosed  = errors.New("spill log closed")
+	errSpillCorrupt = errors.New("corrupt spill log entry")
+
+	spillCRCTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+type spillSegment struct {
+	index  uint64
+	path   string
+	size   int64
+	readFd *os.File
+
+	// sealed is true once no more messages are appended to the segment.
+	sealed bool
+	// fullyRead is true once all the messages of the segment were replayed.
+	fullyRead bool
+	// pending is the number of replayed messages not finalized yet.
+	pending int
+}
+
+// spillLog is a segmented log of messages on disk. Messages are appended to
+// the last segment and read back in order, a segment is removed once all its
+// messages were read and either consumed or dropped, so that a restart
+// replays the messages of the remaining segments. Appends are synced to disk
+// right away unless a sync interval is set, in which case the messages
+// appended since the last sync are lost if the host crashes.
+type spillLog struct {
+	sync.Mutex
+
+	dir         string
+	segmentSize int64
+	maxSize     int64
+	syncEach    bool
+	corrupt     tally.Counter
+	dropped     tally.Counter
+	logger      *zap.Logger
+
+	segments   []*spillSegment
+	writeFd    *os.File
+	nextIndex  uint64
+	readIdx    int
+	readOffset int64
+	size       int64
+	unread     int64
+	unsynced   bool
+	closed     bool
+}
+
+func openSpillLog(
+	opts SpillOptions,
+	corrupt tally.Counter,
+	dropped tally.Counter,
+	logger *zap.Logger,
+) (*spillLog, error) {
+	dir := opts.Directory()
+	if err := os.MkdirAll(dir, spillDirMode); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &spillLog{
+		dir:         dir,
+		segmentSize: int64(opts.SegmentSize()),
+		maxSize:     int64(opts.MaxSize()),
+		syncEach:    opts.SyncInterval() == 0,
+		corrupt:     corrupt,
+		dropped:     dropped,
+		logger:      logger,
+	}
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() ||
+			!strings.HasPrefix(name, spillSegmentPrefix) ||
+			!strings.HasSuffix(name, spillSegmentSuffix) {
+			continue
+		}
+		index, err := strconv.ParseUint(strings.TrimSuffix(
+			strings.TrimPrefix(name, spillSegmentPrefix), spillSegmentSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		// NB: segments of a previous process are never appended to since
+		// they may end with a partially written entry.
+		l.segments = append(l.segments, &spillSegment{
+			index:  index,
+			path:   filepath.Join(dir, name),
+			size:   f.Size(),
+			sealed: true,
+		})
+		l.size += f.Size()
+	}
+	sort.Slice(l.segments, func(i, j int) bool {
+		return l.segments[i].index < l.segments[j].index
+	})
+	if n := len(l.segments); n > 0 {
+		l.nextIndex = l.segments[n-1].index + 1
+	}
+	l.unread = l.size
+	return l, nil
+}
+
+// append appends a message to the log, errSpillFull is returned if the log
+// reached its max size.
+func (l *spillLog) append(shard uint32, data []byte) error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.closed {
+		return errSpillClosed
+	}
+	n := int64(spillHeaderLen + spillShardLen + len(data))
+	if l.size+n > l.maxSize {
+		return errSpillFull
+	}
+	if l.writeFd == nil {
+		if err := l.newWriteSegmentWithLock(); err != nil {
+			return err
+		}
+	}
+
+	buf := make([]byte, n)
+	binary.BigEndian.PutUint32(buf[0:], uint32(spillShardLen+len(data)))
+	binary.BigEndian.PutUint32(buf[spillHeaderLen:], shard)
+	copy(buf[spillHeaderLen+spillShardLen:], data)
+	binary.BigEndian.PutUint32(buf[4:], crc32.Checksum(buf[spillHeaderLen:], spillCRCTable))
+
+	if _, err := l.writeFd.Write(buf); err != nil {
+		// NB: the segment may end with a partially written entry, stop
+		// appending to it. The entry is beyond the size of the segment and
+		// is detected as corrupt if the segment is read after a restart.
+		l.sealWriteSegmentWithLock()
+		return err
+	}
+
+	seg := l.segments[len(l.segments)-1]
+	seg.size += n
+	l.size += n
+	l.unread += n
+	if seg.size >= l.segmentSize {
+		l.sealWriteSegmentWithLock()
+		return nil
+	}
+	l.unsynced = true
+	if l.syncEach {
+		return l.syncWithLock()
+	}
+	return nil
+}
+
+// sync syncs the messages appended since the last sync to disk.
+func (l *spillLog) sync() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.closed {
+		return errSpillClosed
+	}
+	return l.syncWithLock()
+}
+
+func (l *spillLog) syncWithLock() error {
+	if l.writeFd == nil || !l.unsynced {
+		return nil
+	}
+	if err := l.writeFd.Sync(); err != nil {
+		return err
+	}
+	l.unsynced = false
+	return nil
+}
+
+// next returns the next message of the log, false is returned if all the
+// messages were read.
+func (l *spillLog) next() (*spilledMessage, bool, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.closed {
+		return nil, false, errSpillClosed
+	}
+	for l.readIdx < len(l.segments) {
+		seg := l.segments[l.readIdx]
+		if l.readOffset >= seg.size {
+			// Empty segment left by a previous process.
+			l.advanceWithLock(seg)
+			continue
+		}
+
+		msg, n, err := l.readWithLock(seg, l.readOffset)
+		if err == nil {
+			l.readOffset += n
+			l.unread -= n
+			seg.pending++
+			if l.readOffset >= seg.size {
+				l.advanceWithLock(seg)
+			}
+			return msg, true, nil
+		}
+		if err != errSpillCorrupt {
+			return nil, false, err
+		}
+
+		// Skip the rest of the segment, the entry length can not be trusted
+		// to find the next entry.
+		l.corrupt.Inc(1)
+		l.logger.Error("skipping corrupt spill log segment",
+			zap.String("path", seg.path),
+			zap.Int64("offset", l.readOffset),
+			zap.Int64("skipped", seg.size-l.readOffset))
+		l.unread -= seg.size - l.readOffset
+		l.advanceWithLock(seg)
+	}
+	return nil, false, nil
+}
+
+// advanceWithLock moves the reader past a segment of which all the messages
+// were read.
+func (l *spillLog) advanceWithLock(seg *spillSegment) {
+	if !seg.sealed {
+		// Caught up with the write segment, seal it so that it is removed
+		// once consumed rather than replayed again after a restart.
+		l.sealWriteSegmentWithLock()
+	}
+	seg.fullyRead = true
+	l.readIdx++
+	l.readOffset = 0
+	l.maybeRemoveWithLock(seg)
+}
+
+func (l *spillLog) readWithLock(
+	seg *spillSegment,
+	offset int64,
+) (*spilledMessage, int64, error) {
+	if seg.readFd == nil {
+		fd, err := os.Open(seg.path)
+		if err != nil {
+			return nil, 0, err
+		}
+		seg.readFd = fd
+	}
+
+	if offset+spillHeaderLen > seg.size {
+		return nil, 0, errSpillCorrupt
+	}
+	var header [spillHeaderLen]byte
+	if _, err := seg.readFd.ReadAt(header[:], offset); err != nil {
+		return nil, 0, errSpillCorrupt
+	}
+	length := int64(binary.BigEndian.Uint32(header[0:]))
+	checksum := binary.BigEndian.Uint32(header[4:])
+	if length < spillShardLen || offset+spillHeaderLen+length > seg.size {
+		return nil, 0, errSpillCorrupt
+	}
+	payload := make([]byte, length)
+	if _, err := seg.readFd.ReadAt(payload, offset+spillHeaderLen); err != nil {
+		return nil, 0, errSpillCorrupt
+	}
+	if crc32.Checksum(payload, spillCRCTable) != checksum {
+		return nil, 0, errSpillCorrupt
+	}
+
+	return &spilledMessage{
+		log:   l,
+		seg:   seg,
+		shard: binary.BigEndian.Uint32(payload),
+		data:  payload[spillShardLen:],
+	}, spillHeaderLen + length, nil
+}
+
+// release is called once a replayed message is finalized, a dropped message
+// is dropped for good in the same way as a message that was never spilled.
+func (l *spillLog) release(seg *spillSegment, r producer.FinalizeReason) {
+	l.Lock()
+	defer l.Unlock()
+
+	seg.pending--
+	if r == producer.Dropped {
+		l.dropped.Inc(1)
+	}
+	l.maybeRemoveWithLock(seg)
+}
+
+func (l *spillLog) maybeRemoveWithLock(seg *spillSegment) {
+	if l.closed || !seg.fullyRead || seg.pending > 0 {
+		return
+	}
+	if seg.readFd != nil {
+		seg.readFd.Close()
+		seg.readFd = nil
+	}
+	if err := os.Remove(seg.path); err != nil {
+		l.logger.Error("could not remove spill log segment",
+			zap.String("path", seg.path), zap.Error(err))
+	}
+	for i, s := range l.segments {
+		if s != seg {
+			continue
+		}
+		l.segments = append(l.segments[:i], l.segments[i+1:]...)
+		if i < l.readIdx {
+			l.readIdx--
+		}
+		break
+	}
+	l.size -= seg.size
+}
+
+func (l *spillLog) newWriteSegmentWithLock() error {
+	path := filepath.Join(l.dir,
+		fmt.Sprintf("%s%020d%s", spillSegmentPrefix, l.nextIndex, spillSegmentSuffix))
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, spillFileMode)
+	if err != nil {
+		return err
+	}
+	l.segments = append(l.segments, &spillSegment{index: l.nextIndex, path: path})
+	l.writeFd = fd
+	l.nextIndex++
+	return nil
+}
+
+func (l *spillLog) sealWriteSegmentWithLock() {
+	if l.writeFd == nil {
+		return
+	}
+	if err := l.writeFd.Sync(); err != nil {
+		l.logger.Error("could not sync spill log segment", zap.Error(err))
+	}
+	l.writeFd.Close()
+	l.writeFd = nil
+	l.unsynced = false
+	l.segments[len(l.segments)-1].sealed = true
+}
+
+// empty returns true if all the messages of the log were read.
+func (l *spillLog) empty() bool {
+	l.Lock()
+	empty := l.unread == 0
+	l.Unlock()
+	return empty
+}
+
+// bytes returns the size of the log on disk.
+func (l *spillLog) bytes() int64 {
+	l.Lock()
+	size := l.size
+	l.Unlock()
+	return size
+}
+
+// close closes the log, the remaining segments are kept on disk to be
+// replayed on restart.
+func (l *spillLog) close() {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.closed {
+		return
+	}
+	l.closed = true
+	l.sealWriteSegmentWithLock()
+	for _, seg := range l.segments {
+		if seg.readFd != nil {
+			seg.readFd.Close()
+			seg.readFd = nil
+		}
+	}
+}
+
+// spilledMessage is a message replayed from the spill log.
+type spilledMessage struct {
+	log   *spillLog
+	seg   *spillSegment
+	shard uint32
+	data  []byte
+}
+
+func (m *spilledMessage) Shard() uint32 {
+	return m.shard
+}
+
+func (m *spilledMessage) Bytes() []byte {
+	return m.data
+}
+
+func (m *spilledMessage) Size() int {
+	return len(m.data)
+}
+
+func (m *spilledMessage) Finalize(r producer.FinalizeReason) {
+	m.log.release(m.seg, r)
+}
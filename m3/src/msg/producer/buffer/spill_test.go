@@ -0,0 +1,426 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/msg/producer"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+func newTestSpillDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "spill")
+	require.NoError(t, err)
+	return dir
+}
+
+func newTestSpillLog(t *testing.T, opts SpillOptions) (*spillLog, tally.TestScope) {
+	scope := tally.NewTestScope("", nil)
+	l, err := openSpillLog(opts, scope.Counter("corrupt"), scope.Counter("dropped"), zap.NewNop())
+	require.NoError(t, err)
+	return l, scope
+}
+
+func testSpillOptions(dir string) SpillOptions {
+	// Each test entry is 8 header bytes, 4 shard bytes and 4 data bytes,
+	// segments hold two entries.
+	return NewSpillOptions().
+		SetDirectory(dir).
+		SetSegmentSize(32).
+		SetMaxSize(1024)
+}
+
+func spillSegmentFiles(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, spillSegmentPrefix+"*"+spillSegmentSuffix))
+	require.NoError(t, err)
+	return files
+}
+
+func appendTestMessages(t *testing.T, l *spillLog, from, to int) {
+	for i := from; i < to; i++ {
+		require.NoError(t, l.append(uint32(i), []byte(fmt.Sprintf("m%03d", i))))
+	}
+}
+
+func requireNextMessages(t *testing.T, l *spillLog, from, to int) []*spilledMessage {
+	var msgs []*spilledMessage
+	for i := from; i < to; i++ {
+		m, ok, err := l.next()
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, uint32(i), m.Shard())
+		require.Equal(t, fmt.Sprintf("m%03d", i), string(m.Bytes()))
+		msgs = append(msgs, m)
+	}
+	return msgs
+}
+
+func requireNoNextMessage(t *testing.T, l *spillLog) {
+	_, ok, err := l.next()
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.True(t, l.empty())
+}
+
+func TestSpillOptionsValidation(t *testing.T) {
+	opts := NewSpillOptions()
+	require.Equal(t, errNoSpillDirectory, opts.Validate())
+
+	opts = opts.SetDirectory("/tmp/spill")
+	require.NoError(t, opts.Validate())
+
+	require.Equal(t, errInvalidSpillMaxSize, opts.SetMaxSize(1).Validate())
+	require.Equal(t, errInvalidSpillSegment, opts.SetSegmentSize(0).Validate())
+	require.Equal(t, errInvalidReplayInterval, opts.SetReplayInterval(0).Validate())
+	require.Equal(t, errInvalidSyncInterval, opts.SetSyncInterval(-1).Validate())
+	require.NoError(t, opts.SetSyncInterval(0).Validate())
+
+	bOpts := NewOptions().SetSpillOptions(NewSpillOptions())
+	require.Equal(t, errNoSpillDirectory, bOpts.Validate())
+}
+
+func TestSpillLogReplayInOrder(t *testing.T) {
+	dir := newTestSpillDir(t)
+	defer os.RemoveAll(dir)
+
+	l, _ := newTestSpillLog(t, testSpillOptions(dir))
+	defer l.close()
+
+	require.True(t, l.empty())
+	appendTestMessages(t, l, 0, 5)
+	require.False(t, l.empty())
+	require.Len(t, spillSegmentFiles(t, dir), 3)
+
+	msgs := requireNextMessages(t, l, 0, 3)
+	appendTestMessages(t, l, 5, 7)
+	msgs = append(msgs, requireNextMessages(t, l, 3, 7)...)
+	requireNoNextMessage(t, l)
+
+	// Segments are removed once all their messages are consumed.
+	for _, m := range msgs {
+		m.Finalize(producer.Consumed)
+	}
+	require.Empty(t, spillSegmentFiles(t, dir))
+	require.Equal(t, int64(0), l.bytes())
+
+	// Messages appended after catching up go to a new segment.
+	appendTestMessages(t, l, 7, 8)
+	require.Len(t, spillSegmentFiles(t, dir), 1)
+	requireNextMessages(t, l, 7, 8)
+}
+
+func TestSpillLogReplayAfterRestart(t *testing.T) {
+	dir := newTestSpillDir(t)
+	defer os.RemoveAll(dir)
+
+	opts := testSpillOptions(dir)
+	l, scope := newTestSpillLog(t, opts)
+	appendTestMessages(t, l, 0, 8)
+
+	// The first segment is consumed, the second segment is resolved with
+	// a message dropped, a message of the third segment is still in flight
+	// and the fourth segment is not replayed.
+	msgs := requireNextMessages(t, l, 0, 5)
+	msgs[0].Finalize(producer.Consumed)
+	msgs[1].Finalize(producer.Consumed)
+	msgs[2].Finalize(producer.Consumed)
+	msgs[3].Finalize(producer.Dropped)
+	require.Equal(t, int64(1), scope.Snapshot().Counters()["dropped+"].Value())
+	require.Equal(t, int64(64), l.bytes())
+	l.close()
+	require.Len(t, spillSegmentFiles(t, dir), 2)
+
+	l, _ = newTestSpillLog(t, opts)
+	defer l.close()
+
+	require.False(t, l.empty())
+	require.Equal(t, int64(64), l.bytes())
+	requireNextMessages(t, l, 4, 8)
+	requireNoNextMessage(t, l)
+
+	// New messages are appended to a new segment.
+	appendTestMessages(t, l, 8, 9)
+	require.Len(t, spillSegmentFiles(t, dir), 3)
+	requireNextMessages(t, l, 8, 9)
+}
+
+func TestSpillLogDroppedMessagesFreeUpRoom(t *testing.T) {
+	dir := newTestSpillDir(t)
+	defer os.RemoveAll(dir)
+
+	l, _ := newTestSpillLog(t, testSpillOptions(dir).SetMaxSize(32))
+	defer l.close()
+
+	appendTestMessages(t, l, 0, 2)
+	require.Equal(t, errSpillFull, l.append(2, []byte("m002")))
+
+	for _, m := range requireNextMessages(t, l, 0, 2) {
+		m.Finalize(producer.Dropped)
+	}
+	require.Empty(t, spillSegmentFiles(t, dir))
+	require.Equal(t, int64(0), l.bytes())
+	require.NoError(t, l.append(2, []byte("m002")))
+}
+
+func TestSpillLogSync(t *testing.T) {
+	dir := newTestSpillDir(t)
+	defer os.RemoveAll(dir)
+
+	// Appends are synced right away without a sync interval.
+	l, _ := newTestSpillLog(t, testSpillOptions(dir).SetSyncInterval(0))
+	appendTestMessages(t, l, 0, 1)
+	require.False(t, l.unsynced)
+	l.close()
+	require.Equal(t, errSpillClosed, l.sync())
+
+	l, _ = newTestSpillLog(t, testSpillOptions(dir).SetSyncInterval(time.Hour))
+	defer l.close()
+	appendTestMessages(t, l, 1, 2)
+	require.True(t, l.unsynced)
+	require.NoError(t, l.sync())
+	require.False(t, l.unsynced)
+}
+
+func TestSpillLogSkipsCorruptSegment(t *testing.T) {
+	dir := newTestSpillDir(t)
+	defer os.RemoveAll(dir)
+
+	opts := testSpillOptions(dir)
+	l, _ := newTestSpillLog(t, opts)
+	appendTestMessages(t, l, 0, 4)
+	l.close()
+
+	// Corrupt the data of the second message of the first segment.
+	files := spillSegmentFiles(t, dir)
+	require.Len(t, files, 2)
+	data, err := ioutil.ReadFile(files[0])
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(files[0], data, spillFileMode))
+
+	// Partially written entry at the end of the second segment.
+	fd, err := os.OpenFile(files[1], os.O_WRONLY|os.O_APPEND, spillFileMode)
+	require.NoError(t, err)
+	_, err = fd.Write([]byte{0, 0, 0, 8, 1})
+	require.NoError(t, err)
+	require.NoError(t, fd.Close())
+
+	l, scope := newTestSpillLog(t, opts)
+	defer l.close()
+
+	requireNextMessages(t, l, 0, 1)
+	requireNextMessages(t, l, 2, 4)
+	requireNoNextMessage(t, l)
+	require.Equal(t, int64(2), scope.Snapshot().Counters()["corrupt+"].Value())
+}
+
+func TestSpillLogFull(t *testing.T) {
+	dir := newTestSpillDir(t)
+	defer os.RemoveAll(dir)
+
+	l, _ := newTestSpillLog(t, testSpillOptions(dir).SetMaxSize(32))
+	defer l.close()
+
+	appendTestMessages(t, l, 0, 2)
+	require.Equal(t, errSpillFull, l.append(2, []byte("m002")))
+
+	// Consuming messages frees up room.
+	for _, m := range requireNextMessages(t, l, 0, 2) {
+		m.Finalize(producer.Consumed)
+	}
+	require.NoError(t, l.append(2, []byte("m002")))
+}
+
+func TestBufferSpillAndReplay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newTestSpillDir(t)
+	defer os.RemoveAll(dir)
+
+	opts := testOptions().
+		SetMaxMessageSize(4).
+		SetMaxBufferSize(4).
+		SetOnFullStrategy(ReturnError).
+		SetSpillOptions(testSpillOptions(dir).SetReplayInterval(time.Hour))
+
+	var written []*producer.RefCountedMessage
+	writeFn := func(rm *producer.RefCountedMessage) error {
+		written = append(written, rm)
+		return nil
+	}
+
+	newMessage := func(shard uint32) *producer.MockMessage {
+		mm := producer.NewMockMessage(ctrl)
+		mm.EXPECT().Size().Return(4).AnyTimes()
+		mm.EXPECT().Shard().Return(shard).AnyTimes()
+		mm.EXPECT().Bytes().Return([]byte(fmt.Sprintf("m%03d", shard))).AnyTimes()
+		return mm
+	}
+
+	b := mustNewBuffer(t, opts)
+	b.writeFn = writeFn
+
+	mm0 := newMessage(0)
+	rm0, err := b.Add(mm0)
+	require.NoError(t, err)
+	require.NotNil(t, rm0)
+
+	// The buffer is full, messages are spilled rather than rejected.
+	for i := 1; i < 3; i++ {
+		mm := newMessage(uint32(i))
+		mm.EXPECT().Finalize(producer.Spilled)
+		rm, err := b.Add(mm)
+		require.NoError(t, err)
+		require.Nil(t, rm)
+	}
+	require.Equal(t, uint64(4), b.size.Load())
+
+	// Nothing is replayed while the buffered message is not consumed.
+	b.replay()
+	require.Empty(t, written)
+
+	mm0.EXPECT().Finalize(producer.Consumed)
+	rm0.IncRef()
+	rm0.DecRef()
+
+	// Messages are still spilled while the spill log is not empty to keep
+	// the messages in order.
+	mm3 := newMessage(3)
+	mm3.EXPECT().Finalize(producer.Spilled)
+	rm, err := b.Add(mm3)
+	require.NoError(t, err)
+	require.Nil(t, rm)
+
+	for i := 1; i < 4; i++ {
+		b.replay()
+		require.Len(t, written, i)
+		require.Equal(t, uint32(i), written[i-1].Shard())
+		require.Equal(t, fmt.Sprintf("m%03d", i), string(written[i-1].Bytes()))
+
+		// The buffer is full until the replayed message is consumed.
+		b.replay()
+		require.Len(t, written, i)
+		written[i-1].IncRef()
+		written[i-1].DecRef()
+	}
+	require.Equal(t, uint64(0), b.size.Load())
+	require.Empty(t, spillSegmentFiles(t, dir))
+
+	// Once the spill log is empty messages are buffered in memory again.
+	mm4 := newMessage(4)
+	rm, err = b.Add(mm4)
+	require.NoError(t, err)
+	require.NotNil(t, rm)
+}
+
+func TestBufferSpillFullAppliesOnFullStrategy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newTestSpillDir(t)
+	defer os.RemoveAll(dir)
+
+	opts := testOptions().
+		SetMaxMessageSize(4).
+		SetMaxBufferSize(4).
+		SetOnFullStrategy(ReturnError).
+		SetSpillOptions(testSpillOptions(dir).SetMaxSize(32))
+
+	mm := producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(4).AnyTimes()
+	mm.EXPECT().Shard().Return(uint32(0)).AnyTimes()
+	mm.EXPECT().Bytes().Return([]byte("m000")).AnyTimes()
+	mm.EXPECT().Finalize(producer.Spilled).Times(2)
+
+	b := mustNewBuffer(t, opts)
+	_, err := b.Add(mm)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		rm, err := b.Add(mm)
+		require.NoError(t, err)
+		require.Nil(t, rm)
+	}
+
+	_, err = b.Add(mm)
+	require.Equal(t, ErrBufferFull, err)
+}
+
+func TestBufferSpillReplayOnRestart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := newTestSpillDir(t)
+	defer os.RemoveAll(dir)
+
+	opts := testOptions().
+		SetMaxMessageSize(4).
+		SetMaxBufferSize(4).
+		SetCloseCheckInterval(time.Millisecond).
+		SetSpillOptions(testSpillOptions(dir).SetReplayInterval(time.Millisecond))
+
+	mm := producer.NewMockMessage(ctrl)
+	mm.EXPECT().Size().Return(4).AnyTimes()
+	mm.EXPECT().Shard().Return(uint32(1)).AnyTimes()
+	mm.EXPECT().Bytes().Return([]byte("m001")).AnyTimes()
+	mm.EXPECT().Finalize(gomock.Any()).AnyTimes()
+
+	b := mustNewBuffer(t, opts)
+	_, err := b.Add(mm)
+	require.NoError(t, err)
+	rm, err := b.Add(mm)
+	require.NoError(t, err)
+	require.Nil(t, rm)
+	b.Init(func(rm *producer.RefCountedMessage) error { return nil })
+	b.Close(producer.DropEverything)
+	require.Len(t, spillSegmentFiles(t, dir), 1)
+
+	// The spilled message is replayed by the new buffer and removed from
+	// disk once consumed.
+	replayed := make(chan *producer.RefCountedMessage, 1)
+	b = mustNewBuffer(t, opts)
+	b.Init(func(rm *producer.RefCountedMessage) error {
+		replayed <- rm
+		return nil
+	})
+	defer b.Close(producer.DropEverything)
+
+	select {
+	case rm = <-replayed:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "spilled message not replayed")
+	}
+	require.Equal(t, uint32(1), rm.Shard())
+	require.Equal(t, "m001", string(rm.Bytes()))
+	rm.IncRef()
+	rm.DecRef()
+	require.Empty(t, spillSegmentFiles(t, dir))
+}
@@ -96,6 +96,13 @@ type Options interface {
 	// SetCleanupRetryOptions sets the cleanup retry options.
 	SetCleanupRetryOptions(value retry.Options) Options
 
+	// SpillOptions returns the options of the spill log, messages are only
+	// kept in memory if not set.
+	SpillOptions() SpillOptions
+
+	// SetSpillOptions sets the options of the spill log.
+	SetSpillOptions(value SpillOptions) Options
+
 	// InstrumentOptions returns the instrument options.
 	InstrumentOptions() instrument.Options
 
@@ -105,3 +112,47 @@ type Options interface {
 	// Validate validates the options.
 	Validate() error
 }
+
+// SpillOptions configs the spill log. When the buffer is full, messages are
+// appended to the spill log on disk rather than applying the on full
+// strategy, and replayed in order once the buffer has room again. Spilled
+// messages are kept on disk until consumed or dropped, including across
+// restarts, messages are delivered at least once so that the messages in
+// flight when restarting are replayed again.
+type SpillOptions interface {
+	// Directory returns the directory of the spill log segments.
+	Directory() string
+
+	// SetDirectory sets the directory of the spill log segments.
+	SetDirectory(value string) SpillOptions
+
+	// SegmentSize returns the size after which a new segment is started.
+	SegmentSize() int
+
+	// SetSegmentSize sets the size after which a new segment is started.
+	SetSegmentSize(value int) SpillOptions
+
+	// MaxSize returns the max size of the spill log on disk, the on full
+	// strategy applies once reached.
+	MaxSize() int
+
+	// SetMaxSize sets the max size of the spill log on disk.
+	SetMaxSize(value int) SpillOptions
+
+	// ReplayInterval returns the interval to replay spilled messages at.
+	ReplayInterval() time.Duration
+
+	// SetReplayInterval sets the interval to replay spilled messages at.
+	SetReplayInterval(value time.Duration) SpillOptions
+
+	// SyncInterval returns the interval to sync spilled messages to disk at,
+	// messages are synced as they are spilled if zero. Messages spilled
+	// within the interval are lost if the host crashes.
+	SyncInterval() time.Duration
+
+	// SetSyncInterval sets the interval to sync spilled messages to disk at.
+	SetSyncInterval(value time.Duration) SpillOptions
+
+	// Validate validates the options.
+	Validate() error
+}
@@ -38,6 +38,7 @@ type BufferConfiguration struct {
 	ScanBatchSize         *int                   `yaml:"scanBatchSize"`
 	AllowedSpilloverRatio *float64               `yaml:"allowedSpilloverRatio"`
 	CleanupRetry          *retry.Configuration   `yaml:"cleanupRetry"`
+	Spill                 *SpillConfiguration    `yaml:"spill"`
 }
 
 // NewOptions creates new buffer options.
@@ -67,5 +68,35 @@ func (c *BufferConfiguration) NewOptions(iOpts instrument.Options) buffer.Option
 	if c.CleanupRetry != nil {
 		opts = opts.SetCleanupRetryOptions(c.CleanupRetry.NewOptions(iOpts.MetricsScope()))
 	}
+	if c.Spill != nil {
+		opts = opts.SetSpillOptions(c.Spill.NewOptions())
+	}
 	return opts.SetInstrumentOptions(iOpts)
 }
+
+// SpillConfiguration configs the spill log of the buffer.
+type SpillConfiguration struct {
+	Directory      string         `yaml:"directory" validate:"nonzero"`
+	SegmentSize    *int           `yaml:"segmentSize"`
+	MaxSize        *int           `yaml:"maxSize"`
+	ReplayInterval *time.Duration `yaml:"replayInterval"`
+	SyncInterval   *time.Duration `yaml:"syncInterval"`
+}
+
+// NewOptions creates new spill options.
+func (c *SpillConfiguration) NewOptions() buffer.SpillOptions {
+	opts := buffer.NewSpillOptions().SetDirectory(c.Directory)
+	if c.SegmentSize != nil {
+		opts = opts.SetSegmentSize(*c.SegmentSize)
+	}
+	if c.MaxSize != nil {
+		opts = opts.SetMaxSize(*c.MaxSize)
+	}
+	if c.ReplayInterval != nil {
+		opts = opts.SetReplayInterval(*c.ReplayInterval)
+	}
+	if c.SyncInterval != nil {
+		opts = opts.SetSyncInterval(*c.SyncInterval)
+	}
+	return opts
+}
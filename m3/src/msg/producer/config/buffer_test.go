@@ -57,6 +57,30 @@ cleanupRetry:
 	require.Equal(t, 500*time.Millisecond, bOpts.DropOldestInterval())
 	require.Equal(t, 0.1, bOpts.AllowedSpilloverRatio())
 	require.Equal(t, 2*time.Second, bOpts.CleanupRetryOptions().InitialBackoff())
+	require.Nil(t, bOpts.SpillOptions())
+}
+
+func TestBufferSpillConfiguration(t *testing.T) {
+	str := `
+spill:
+  directory: /var/lib/m3/spill
+  segmentSize: 1024
+  maxSize: 4096
+  replayInterval: 1s
+  syncInterval: 0s
+`
+
+	var cfg BufferConfiguration
+	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
+
+	sOpts := cfg.NewOptions(instrument.NewOptions()).SpillOptions()
+	require.NotNil(t, sOpts)
+	require.Equal(t, "/var/lib/m3/spill", sOpts.Directory())
+	require.Equal(t, 1024, sOpts.SegmentSize())
+	require.Equal(t, 4096, sOpts.MaxSize())
+	require.Equal(t, time.Second, sOpts.ReplayInterval())
+	require.Equal(t, time.Duration(0), sOpts.SyncInterval())
+	require.NoError(t, sOpts.Validate())
 }
 
 func TestEmptyBufferConfiguration(t *testing.T) {
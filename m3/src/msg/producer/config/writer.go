@@ -33,25 +33,30 @@ import (
 	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/pool"
 	"github.com/m3db/m3/src/x/retry"
+	xtls "github.com/m3db/m3/src/x/tls"
 
 	"github.com/uber-go/tally"
 )
 
 // ConnectionConfiguration configs the connection options.
 type ConnectionConfiguration struct {
-	NumConnections  *int                 `yaml:"numConnections"`
-	DialTimeout     *time.Duration       `yaml:"dialTimeout"`
-	WriteTimeout    *time.Duration       `yaml:"writeTimeout"`
-	KeepAlivePeriod *time.Duration       `yaml:"keepAlivePeriod"`
-	ResetDelay      *time.Duration       `yaml:"resetDelay"`
-	Retry           *retry.Configuration `yaml:"retry"`
-	FlushInterval   *time.Duration       `yaml:"flushInterval"`
-	WriteBufferSize *int                 `yaml:"writeBufferSize"`
-	ReadBufferSize  *int                 `yaml:"readBufferSize"`
+	NumConnections  *int                   `yaml:"numConnections"`
+	DialTimeout     *time.Duration         `yaml:"dialTimeout"`
+	WriteTimeout    *time.Duration         `yaml:"writeTimeout"`
+	KeepAlivePeriod *time.Duration         `yaml:"keepAlivePeriod"`
+	ResetDelay      *time.Duration         `yaml:"resetDelay"`
+	Retry           *retry.Configuration   `yaml:"retry"`
+	FlushInterval   *time.Duration         `yaml:"flushInterval"`
+	WriteBufferSize *int                   `yaml:"writeBufferSize"`
+	ReadBufferSize  *int                   `yaml:"readBufferSize"`
+	Compression     *proto.CompressionType `yaml:"compression"`
+	TLS             *xtls.Configuration    `yaml:"tls"`
 }
 
 // NewOptions creates connection options.
-func (c *ConnectionConfiguration) NewOptions(iOpts instrument.Options) writer.ConnectionOptions {
+func (c *ConnectionConfiguration) NewOptions(
+	iOpts instrument.Options,
+) (writer.ConnectionOptions, error) {
 	opts := writer.NewConnectionOptions()
 	if c.NumConnections != nil {
 		opts = opts.SetNumConnections(*c.NumConnections)
@@ -80,7 +85,17 @@ func (c *ConnectionConfiguration) NewOptions(iOpts instrument.Options) writer.Co
 	if c.ReadBufferSize != nil {
 		opts = opts.SetReadBufferSize(*c.ReadBufferSize)
 	}
-	return opts.SetInstrumentOptions(iOpts)
+	if c.Compression != nil {
+		opts = opts.SetCompression(*c.Compression)
+	}
+	if c.TLS != nil {
+		tlsConfig, err := c.TLS.NewClientConfig(iOpts)
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetTLSConfig(tlsConfig)
+	}
+	return opts.SetInstrumentOptions(iOpts), nil
 }
 
 // WriterConfiguration configs the writer options.
@@ -173,7 +188,11 @@ func (c *WriterConfiguration) NewOptions(
 		opts = opts.SetDecoderOptions(c.Decoder.NewOptions(iOpts))
 	}
 	if c.Connection != nil {
-		opts = opts.SetConnectionOptions(c.Connection.NewOptions(iOpts))
+		connOpts, err := c.Connection.NewOptions(iOpts)
+		if err != nil {
+			return nil, err
+		}
+		opts = opts.SetConnectionOptions(connOpts)
 	}
 
 	return opts, nil
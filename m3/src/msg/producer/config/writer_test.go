@@ -27,6 +27,7 @@ import (
 	"github.com/m3db/m3/src/cluster/client"
 	"github.com/m3db/m3/src/cluster/kv"
 	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/msg/protocol/proto"
 	"github.com/m3db/m3/src/x/instrument"
 
 	"github.com/golang/mock/gomock"
@@ -46,12 +47,14 @@ retry:
 flushInterval: 2s
 writeBufferSize: 100
 readBufferSize: 200
+compression: snappy
 `
 
 	var cfg ConnectionConfiguration
 	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
 
-	cOpts := cfg.NewOptions(instrument.NewOptions())
+	cOpts, err := cfg.NewOptions(instrument.NewOptions())
+	require.NoError(t, err)
 	require.Equal(t, 3*time.Second, cOpts.DialTimeout())
 	require.Equal(t, 2*time.Second, cOpts.WriteTimeout())
 	require.Equal(t, 20*time.Second, cOpts.KeepAlivePeriod())
@@ -61,6 +64,14 @@ readBufferSize: 200
 	require.Equal(t, 2*time.Second, cOpts.FlushInterval())
 	require.Equal(t, 100, cOpts.WriteBufferSize())
 	require.Equal(t, 200, cOpts.ReadBufferSize())
+	require.Equal(t, proto.SnappyCompression, cOpts.Compression())
+}
+
+func TestConnectionConfigurationRejectsUnsupportedCompression(t *testing.T) {
+	var cfg ConnectionConfiguration
+	err := yaml.Unmarshal([]byte("compression: lz4"), &cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid compression type lz4")
 }
 
 func TestWriterConfiguration(t *testing.T) {
@@ -34,8 +34,13 @@ func NewProducer(opts Options) Producer {
 }
 
 func (p *producer) Init() error {
-	p.Buffer.Init()
-	return p.Writer.Init()
+	// NB: Must init writer first, the buffer may start replaying messages
+	// spilled to disk right away.
+	if err := p.Writer.Init(); err != nil {
+		return err
+	}
+	p.Buffer.Init(p.Writer.Write)
+	return nil
 }
 
 func (p *producer) Produce(m Message) error {
@@ -43,6 +48,11 @@ func (p *producer) Produce(m Message) error {
 	if err != nil {
 		return err
 	}
+	if rm == nil {
+		// The message was spilled to disk, it will be written by the
+		// buffer once replayed.
+		return nil
+	}
 	return p.Writer.Write(rm)
 }
 
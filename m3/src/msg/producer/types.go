@@ -33,6 +33,10 @@ const (
 
 	// Dropped means the message has been dropped.
 	Dropped
+
+	// Spilled means the message has been persisted to disk by the buffer,
+	// which delivers a copy of it once replayed.
+	Spilled
 )
 
 // Message contains the data that will be produced by the producer.
@@ -103,13 +107,19 @@ type Options interface {
 	SetWriter(value Writer) Options
 }
 
+// WriteFn writes a reference counted message out.
+type WriteFn func(rm *RefCountedMessage) error
+
 // Buffer buffers all the messages in the producer.
 type Buffer interface {
 	// Add adds message to the buffer and returns a reference counted message.
+	// If the buffer spilled the message to disk, a nil message is returned and
+	// the buffer writes the message itself once replayed.
 	Add(m Message) (*RefCountedMessage, error)
 
-	// Init initializes the buffer.
-	Init()
+	// Init initializes the buffer, messages replayed from disk are written
+	// with the given write function.
+	Init(fn WriteFn)
 
 	// Close stops the buffer from accepting new requests immediately.
 	// If the CloseType is WaitForConsumption, then it will block until all the messages have been consumed.
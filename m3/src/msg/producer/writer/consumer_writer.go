@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/msg/protocol/proto"
 	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/retry"
+	xtls "github.com/m3db/m3/src/x/tls"
 
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
@@ -74,6 +75,11 @@ type consumerWriterMetrics struct {
 	connectError            tally.Counter
 	setKeepAliveError       tally.Counter
 	setKeepAlivePeriodError tally.Counter
+	tlsHandshakeError       tally.Counter
+	compressError           tally.Counter
+	compressionEnabled      tally.Counter
+	uncompressedBytes       tally.Counter
+	compressedBytes         tally.Counter
 }
 
 func newConsumerWriterMetrics(scope tally.Scope) consumerWriterMetrics {
@@ -89,6 +95,11 @@ func newConsumerWriterMetrics(scope tally.Scope) consumerWriterMetrics {
 		connectError:            scope.Counter("connect-error"),
 		setKeepAliveError:       scope.Counter("set-keep-alive-error"),
 		setKeepAlivePeriodError: scope.Counter("set-keep-alive-period-error"),
+		tlsHandshakeError:       scope.Counter("tls-handshake-error"),
+		compressError:           scope.Counter("compress-error"),
+		compressionEnabled:      scope.Counter("compression-enabled"),
+		uncompressedBytes:       scope.Counter("uncompressed-bytes"),
+		compressedBytes:         scope.Counter("compressed-bytes"),
 	}
 }
 
@@ -136,6 +147,10 @@ type connection struct {
 	rw        *bufio.ReadWriter
 	decoder   proto.Decoder
 	ack       msgpb.Ack
+
+	// compressor is set once the consumer advertises that it accepts the
+	// configured compression, it is guarded by the write lock.
+	compressor proto.Compressor
 }
 
 func newConsumerWriter(
@@ -210,7 +225,7 @@ func (w *consumerWriterImpl) Write(connIndex int, b []byte) error {
 
 	// Make sure only writer to this connection.
 	writeConn.writeLock.Lock()
-	_, err := writeConn.rw.Write(b)
+	err := w.writeWithLock(writeConn, b)
 	writeConn.writeLock.Unlock()
 
 	// Hold onto the write state lock until done, since flushing and
@@ -225,6 +240,22 @@ func (w *consumerWriterImpl) Write(connIndex int, b []byte) error {
 	return err
 }
 
+func (w *consumerWriterImpl) writeWithLock(conn *connection, b []byte) error {
+	if conn.compressor != nil {
+		compressed, err := conn.compressor.Compress(b)
+		if err != nil {
+			// Fall back to writing the frame uncompressed.
+			w.m.compressError.Inc(1)
+		} else {
+			w.m.uncompressedBytes.Inc(int64(len(b)))
+			w.m.compressedBytes.Inc(int64(len(compressed)))
+			b = compressed
+		}
+	}
+	_, err := conn.rw.Write(b)
+	return err
+}
+
 func (w *consumerWriterImpl) Init() {
 	w.wg.Add(1)
 	go func() {
@@ -351,12 +382,16 @@ func (w *consumerWriterImpl) readAcks(idx int) error {
 	// NB(cw) The proto needs to be cleaned up because the gogo protobuf
 	// unmarshalling will append to the underlying slice.
 	conn.ack.Metadata = conn.ack.Metadata[:0]
+	conn.ack.Compressions = conn.ack.Compressions[:0]
 	err := conn.decoder.Decode(&conn.ack)
 	if err != nil {
 		w.notifyReset(err)
 		w.m.decodeError.Inc(1)
 		return err
 	}
+	if len(conn.ack.Compressions) > 0 {
+		w.maybeEnableCompression(conn, conn.ack.Compressions)
+	}
 	for _, m := range conn.ack.Metadata {
 		if err := w.router.Ack(newMetadataFromProto(m)); err != nil {
 			w.m.ackError.Inc(1)
@@ -368,6 +403,34 @@ func (w *consumerWriterImpl) readAcks(idx int) error {
 	return nil
 }
 
+// maybeEnableCompression enables compression on the connection if the
+// consumer accepts the configured compression type.
+func (w *consumerWriterImpl) maybeEnableCompression(
+	conn *connection,
+	accepted []msgpb.CompressionType,
+) {
+	t := w.connOpts.Compression()
+	if t == proto.NoCompression {
+		return
+	}
+	for _, a := range accepted {
+		if proto.CompressionType(a) != t {
+			continue
+		}
+		compressor, err := proto.NewCompressor(t, w.opts.EncoderOptions())
+		if err != nil {
+			w.m.compressError.Inc(1)
+			w.logger.Error("could not create compressor", zap.Error(err))
+			return
+		}
+		conn.writeLock.Lock()
+		conn.compressor = compressor
+		conn.writeLock.Unlock()
+		w.m.compressionEnabled.Inc(1)
+		return
+	}
+}
+
 func (w *consumerWriterImpl) Close() {
 	w.writeState.Lock()
 	wasClosed := w.writeState.closed
@@ -450,12 +513,22 @@ func (w *consumerWriterImpl) connectNoRetry(addr string) (io.ReadWriteCloser, er
 		w.m.setKeepAliveError.Inc(1)
 	}
 	keepAlivePeriod := w.connOpts.KeepAlivePeriod()
+	if keepAlivePeriod > 0 {
+		if err = tcpConn.SetKeepAlivePeriod(keepAlivePeriod); err != nil {
+			w.m.setKeepAlivePeriodError.Inc(1)
+		}
+	}
+	if tlsConfig := w.connOpts.TLSConfig(); tlsConfig != nil {
+		conn, err = xtls.NewClientConn(conn, tlsConfig, addr, w.connOpts.DialTimeout())
+		if err != nil {
+			w.m.tlsHandshakeError.Inc(1)
+			tcpConn.Close()
+			return nil, err
+		}
+	}
 	if keepAlivePeriod <= 0 {
 		return conn, nil
 	}
-	if err = tcpConn.SetKeepAlivePeriod(keepAlivePeriod); err != nil {
-		w.m.setKeepAlivePeriodError.Inc(1)
-	}
 	return newReadWriterWithTimeout(conn, w.connOpts.WriteTimeout(), w.nowFn), nil
 }
 
@@ -21,16 +21,23 @@
 package writer
 
 import (
+	"bytes"
+	"crypto/tls"
 	"io"
+	"io/ioutil"
 	"net"
+	"os"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/m3db/m3/src/msg/generated/proto/msgpb"
 	"github.com/m3db/m3/src/msg/protocol/proto"
+	"github.com/m3db/m3/src/x/instrument"
 	"github.com/m3db/m3/src/x/pool"
 	"github.com/m3db/m3/src/x/retry"
+	xtls "github.com/m3db/m3/src/x/tls"
+	"github.com/m3db/m3/src/x/tls/tlstest"
 
 	"github.com/fortytw2/leaktest"
 	"github.com/golang/mock/gomock"
@@ -96,6 +103,161 @@ func TestNewConsumerWriter(t *testing.T) {
 
 // TODO: tests for multiple connection writers.
 
+func TestConsumerWriterNegotiatesCompression(t *testing.T) {
+	for _, compression := range []proto.CompressionType{
+		proto.SnappyCompression,
+		proto.ZstdCompression,
+	} {
+		t.Run(compression.String(), func(t *testing.T) {
+			testConsumerWriterNegotiatesCompression(t, compression)
+		})
+	}
+}
+
+func testConsumerWriterNegotiatesCompression(t *testing.T, compression proto.CompressionType) {
+	defer leaktest.Check(t)()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRouter := NewMockackRouter(ctrl)
+
+	opts := testOptions()
+	opts = opts.SetConnectionOptions(opts.ConnectionOptions().SetCompression(compression))
+
+	w := newConsumerWriter(lis.Addr().String(), mockRouter, opts, testConsumerWriterMetrics()).(*consumerWriterImpl)
+	conn, err := lis.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// Advertise the accepted compressions like a consumer would.
+	serverEncoder := proto.NewEncoder(opts.EncoderOptions())
+	require.NoError(t, serverEncoder.Encode(&msgpb.Ack{
+		Compressions: []msgpb.CompressionType{
+			msgpb.CompressionType_SNAPPY,
+			msgpb.CompressionType_ZSTD,
+		},
+	}))
+	_, err = conn.Write(serverEncoder.Bytes())
+	require.NoError(t, err)
+
+	w.Init()
+	defer w.Close()
+
+	for {
+		w.writeState.RLock()
+		c := w.writeState.conns[0]
+		w.writeState.RUnlock()
+		c.writeLock.Lock()
+		enabled := c.compressor != nil
+		c.writeLock.Unlock()
+		if enabled {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	msg := msgpb.Message{
+		Metadata: msgpb.Metadata{Shard: 1, Id: 2},
+		Value:    bytes.Repeat([]byte("foo"), 100),
+	}
+	require.NoError(t, testEncoder.Encode(&msg))
+	uncompressedLen := len(testEncoder.Bytes())
+	require.NoError(t, w.Write(0, testEncoder.Bytes()))
+
+	// Read the raw frame to make sure it was compressed.
+	r := &countingReader{r: conn}
+	var decoded msgpb.Message
+	require.NoError(t, proto.NewDecoder(r, opts.DecoderOptions()).Decode(&decoded))
+	require.Equal(t, msg, decoded)
+	require.True(t, r.n < uncompressedLen)
+}
+
+func TestConsumerWriterTLS(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := tlstest.NewCA(t, 1)
+	serverFiles := tlstest.NewFiles(dir, "server")
+	serverFiles.WriteIssued(t, ca, 2)
+	clientFiles := tlstest.NewFiles(dir, "client")
+	clientFiles.WriteIssued(t, ca, 3)
+
+	clientAuth := xtls.RequireAndVerifyClientCert
+	serverConfig, err := xtls.Configuration{
+		CertFile:   serverFiles.CertFile,
+		KeyFile:    serverFiles.KeyFile,
+		CAFile:     serverFiles.CAFile,
+		ClientAuth: &clientAuth,
+	}.NewServerConfig(instrument.NewOptions())
+	require.NoError(t, err)
+	clientConfig, err := xtls.Configuration{
+		CertFile: clientFiles.CertFile,
+		KeyFile:  clientFiles.KeyFile,
+		CAFile:   clientFiles.CAFile,
+	}.NewClientConfig(instrument.NewOptions())
+	require.NoError(t, err)
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	require.NoError(t, err)
+	defer lis.Close()
+
+	connCh := make(chan net.Conn, 1)
+	go func() {
+		conn, err := lis.Accept()
+		assert.NoError(t, err)
+		assert.NoError(t, conn.(*tls.Conn).Handshake())
+		connCh <- conn
+	}()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := testOptions()
+	opts = opts.SetConnectionOptions(opts.ConnectionOptions().SetTLSConfig(clientConfig))
+
+	w := newConsumerWriter(lis.Addr().String(), NewMockackRouter(ctrl), opts, testConsumerWriterMetrics()).(*consumerWriterImpl)
+	conn := <-connCh
+	defer conn.Close()
+
+	w.Init()
+	defer w.Close()
+
+	require.NoError(t, testEncoder.Encode(&testMsg))
+	require.NoError(t, w.Write(0, testEncoder.Bytes()))
+
+	var decoded msgpb.Message
+	require.NoError(t, proto.NewDecoder(conn, opts.DecoderOptions()).Decode(&decoded))
+	require.Equal(t, testMsg, decoded)
+}
+
+func TestConsumerWriterIgnoresCompressionWhenNotConfigured(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRouter := NewMockackRouter(ctrl)
+
+	opts := testOptions()
+	w := newConsumerWriter(lis.Addr().String(), mockRouter, opts, testConsumerWriterMetrics()).(*consumerWriterImpl)
+	defer w.Close()
+
+	w.maybeEnableCompression(w.writeState.conns[0], []msgpb.CompressionType{msgpb.CompressionType_SNAPPY})
+	require.Nil(t, w.writeState.conns[0].compressor)
+}
+
 func TestConsumerWriterSignalResetConnection(t *testing.T) {
 	lis, err := net.Listen("tcp", "127.0.0.1:0")
 	require.NoError(t, err)
@@ -440,6 +602,17 @@ func testConsumeAndAckOnConnectionListener(
 	testConsumeAndAckOnConnection(t, conn, encOpts, decOpts)
 }
 
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += n
+	return n, err
+}
+
 func testConsumerWriterMetrics() consumerWriterMetrics {
 	return newConsumerWriterMetrics(tally.NoopScope)
 }
@@ -21,6 +21,7 @@
 package writer
 
 import (
+	"crypto/tls"
 	"time"
 
 	"github.com/m3db/m3/src/cluster/placement"
@@ -108,6 +109,20 @@ type ConnectionOptions interface {
 	// SetReadBufferSize sets the buffer size for read.
 	SetReadBufferSize(value int) ConnectionOptions
 
+	// Compression returns the compression type used for connections whose
+	// consumer accepts it.
+	Compression() proto.CompressionType
+
+	// SetCompression sets the compression type used for connections whose
+	// consumer accepts it.
+	SetCompression(value proto.CompressionType) ConnectionOptions
+
+	// TLSConfig returns the TLS config for connections, nil disables TLS.
+	TLSConfig() *tls.Config
+
+	// SetTLSConfig sets the TLS config for connections, nil disables TLS.
+	SetTLSConfig(value *tls.Config) ConnectionOptions
+
 	// InstrumentOptions returns the instrument options.
 	InstrumentOptions() instrument.Options
 
@@ -125,6 +140,8 @@ type connectionOptions struct {
 	flushInterval   time.Duration
 	writeBufferSize int
 	readBufferSize  int
+	compression     proto.CompressionType
+	tlsConfig       *tls.Config
 	iOpts           instrument.Options
 }
 
@@ -234,6 +251,26 @@ func (opts *connectionOptions) SetReadBufferSize(value int) ConnectionOptions {
 	return &o
 }
 
+func (opts *connectionOptions) Compression() proto.CompressionType {
+	return opts.compression
+}
+
+func (opts *connectionOptions) SetCompression(value proto.CompressionType) ConnectionOptions {
+	o := *opts
+	o.compression = value
+	return &o
+}
+
+func (opts *connectionOptions) TLSConfig() *tls.Config {
+	return opts.tlsConfig
+}
+
+func (opts *connectionOptions) SetTLSConfig(value *tls.Config) ConnectionOptions {
+	o := *opts
+	o.tlsConfig = value
+	return &o
+}
+
 func (opts *connectionOptions) InstrumentOptions() instrument.Options {
 	return opts.iOpts
 }
@@ -0,0 +1,229 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package proto
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// compressedFlag is set on the size of a frame whose payload is
+	// compressed, the size then covers the compression type byte followed
+	// by the compressed message.
+	compressedFlag uint32 = 1 << 31
+
+	compressionTypeEncodingLength = 1
+)
+
+var (
+	validCompressionTypes = []CompressionType{
+		NoCompression,
+		SnappyCompression,
+		ZstdCompression,
+	}
+
+	// zstdEncoder and zstdDecoder are safe for concurrent use when encoding
+	// and decoding whole frames, frames are encoded as single segments so
+	// that they always state their decompressed size.
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithSingleSegment(true))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecodeAllCapLimit(true))
+
+	errNoCompression          = errors.New("no compression is not a valid compressor type")
+	errZstdNoDecompressedSize = errors.New("zstd frame does not contain the decompressed size")
+)
+
+// CompressionType is the type of compression applied to the frames written
+// to a connection.
+type CompressionType int
+
+const (
+	// NoCompression writes frames uncompressed.
+	NoCompression CompressionType = iota
+
+	// SnappyCompression compresses frames with snappy.
+	SnappyCompression
+
+	// ZstdCompression compresses frames with zstd, which compresses better
+	// than snappy at a higher CPU cost.
+	ZstdCompression
+)
+
+func (t CompressionType) String() string {
+	switch t {
+	case NoCompression:
+		return "none"
+	case SnappyCompression:
+		return "snappy"
+	case ZstdCompression:
+		return "zstd"
+	}
+	return fmt.Sprintf("unknown(%d)", int(t))
+}
+
+// UnmarshalYAML unmarshals CompressionType from yaml.
+func (t *CompressionType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == "" {
+		*t = NoCompression
+		return nil
+	}
+	var validStrings []string
+	for _, valid := range validCompressionTypes {
+		validString := valid.String()
+		if validString == str {
+			*t = valid
+			return nil
+		}
+		validStrings = append(validStrings, validString)
+	}
+
+	return fmt.Errorf("invalid compression type %s, valid types are: %v", str, validStrings)
+}
+
+type compressor struct {
+	compressionType CompressionType
+	buffer          []byte
+	maxMessageSize  int
+}
+
+// NewCompressor creates a new compressor.
+func NewCompressor(t CompressionType, opts Options) (Compressor, error) {
+	if opts == nil {
+		opts = NewOptions()
+	}
+	switch t {
+	case SnappyCompression, ZstdCompression:
+	case NoCompression:
+		return nil, errNoCompression
+	default:
+		return nil, fmt.Errorf("unknown compression type: %d", int(t))
+	}
+	return &compressor{
+		compressionType: t,
+		maxMessageSize:  opts.MaxMessageSize(),
+	}, nil
+}
+
+func (c *compressor) Type() CompressionType {
+	return c.compressionType
+}
+
+func (c *compressor) Compress(frame []byte) ([]byte, error) {
+	if len(frame) < sizeEncodingLength {
+		return nil, fmt.Errorf("frame size %d is smaller than size encoding length", len(frame))
+	}
+	data := frame[sizeEncodingLength:]
+	if len(data) > c.maxMessageSize {
+		return nil, fmt.Errorf("message size %d is larger than maximum supported size %d", len(data), c.maxMessageSize)
+	}
+
+	headerLen := sizeEncodingLength + compressionTypeEncodingLength
+	if maxLen := headerLen + maxEncodedLen(c.compressionType, len(data)); cap(c.buffer) < maxLen {
+		c.buffer = make([]byte, maxLen)
+	}
+	c.buffer = c.buffer[:cap(c.buffer)]
+	var compressed []byte
+	switch c.compressionType {
+	case ZstdCompression:
+		compressed = zstdEncoder.EncodeAll(data, c.buffer[headerLen:headerLen])
+	default:
+		compressed = snappy.Encode(c.buffer[headerLen:], data)
+	}
+
+	// Send the frame as is if compressing it does not save anything.
+	if len(compressed)+compressionTypeEncodingLength >= len(data) {
+		return frame, nil
+	}
+	size := compressionTypeEncodingLength + len(compressed)
+	sizeEncodeDecoder.PutUint32(c.buffer, uint32(size)|compressedFlag)
+	c.buffer[sizeEncodingLength] = byte(c.compressionType)
+	return c.buffer[:sizeEncodingLength+size], nil
+}
+
+func maxEncodedLen(t CompressionType, size int) int {
+	if t == ZstdCompression {
+		return zstdEncoder.MaxEncodedSize(size)
+	}
+	return snappy.MaxEncodedLen(size)
+}
+
+// maxCompressedSize returns the maximum size of a compressed frame payload
+// for messages of the given maximum size.
+func maxCompressedSize(maxMessageSize int) int {
+	maxLen := maxEncodedLen(SnappyCompression, maxMessageSize)
+	if zstdLen := maxEncodedLen(ZstdCompression, maxMessageSize); zstdLen > maxLen {
+		maxLen = zstdLen
+	}
+	return compressionTypeEncodingLength + maxLen
+}
+
+func decompress(
+	t CompressionType,
+	dst []byte,
+	src []byte,
+	maxMessageSize int,
+) ([]byte, error) {
+	size, err := decompressedLen(t, src)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxMessageSize {
+		return nil, fmt.Errorf("decompressed message size %d is larger than maximum supported size %d", size, maxMessageSize)
+	}
+	if cap(dst) < size {
+		dst = make([]byte, size)
+	}
+	if t == ZstdCompression {
+		// The decoder is limited to the capacity of the destination, which
+		// bounds frames whose content is larger than their header states.
+		return zstdDecoder.DecodeAll(src, dst[:0:size])
+	}
+	return snappy.Decode(dst[:cap(dst)], src)
+}
+
+func decompressedLen(t CompressionType, src []byte) (int, error) {
+	switch t {
+	case SnappyCompression:
+		return snappy.DecodedLen(src)
+	case ZstdCompression:
+		var header zstd.Header
+		if err := header.Decode(src); err != nil {
+			return 0, err
+		}
+		if !header.HasFCS {
+			return 0, errZstdNoDecompressedSize
+		}
+		if header.FrameContentSize > uint64(math.MaxInt32) {
+			return 0, fmt.Errorf("decompressed message size %d is too large", header.FrameContentSize)
+		}
+		return int(header.FrameContentSize), nil
+	default:
+		return 0, fmt.Errorf("unknown compression type: %d", int(t))
+	}
+}
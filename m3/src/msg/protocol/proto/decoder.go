@@ -32,6 +32,7 @@ type decoder struct {
 	buffer         []byte
 	bytesPool      pool.BytesPool
 	maxMessageSize int
+	decompressed   []byte
 }
 
 // NewDecoder decodes a new decoder, the implementation is not thread safe.
@@ -53,6 +54,9 @@ func (d *decoder) Decode(m Unmarshaler) error {
 	if err != nil {
 		return err
 	}
+	if uint32(size)&compressedFlag != 0 {
+		return d.decodeCompressed(int(uint32(size)&^compressedFlag), m)
+	}
 	if size > d.maxMessageSize {
 		return fmt.Errorf("decoded message size %d is larger than maximum supported size %d", size, d.maxMessageSize)
 	}
@@ -60,6 +64,31 @@ func (d *decoder) Decode(m Unmarshaler) error {
 	return d.decodeData(d.buffer[sizeEncodingLength:sizeEncodingLength+size], m)
 }
 
+// decodeCompressed decodes a frame written by a Compressor, frames are
+// detected individually so that a writer may stop compressing at any time.
+func (d *decoder) decodeCompressed(size int, m Unmarshaler) error {
+	if size <= compressionTypeEncodingLength {
+		return fmt.Errorf("compressed message size %d is too small", size)
+	}
+	if maxSize := maxCompressedSize(d.maxMessageSize); size > maxSize {
+		return fmt.Errorf("compressed message size %d is larger than maximum supported size %d", size, maxSize)
+	}
+	d.buffer = growDataBufferIfNeeded(d.buffer, sizeEncodingLength+size, d.bytesPool)
+	buffer := d.buffer[sizeEncodingLength : sizeEncodingLength+size]
+	if _, err := io.ReadFull(d.r, buffer); err != nil {
+		return err
+	}
+	t := CompressionType(buffer[0])
+	decompressed, err := decompress(t, d.decompressed, buffer[compressionTypeEncodingLength:], d.maxMessageSize)
+	if err != nil {
+		return err
+	}
+	if cap(decompressed) > cap(d.decompressed) {
+		d.decompressed = decompressed
+	}
+	return m.Unmarshal(decompressed)
+}
+
 func (d *decoder) decodeSize() (int, error) {
 	if _, err := io.ReadFull(d.r, d.buffer[:sizeEncodingLength]); err != nil {
 		return 0, err
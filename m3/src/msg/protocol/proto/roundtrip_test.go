@@ -183,6 +183,95 @@ func TestEncodeDecodeRoundTrip(t *testing.T) {
 	require.Equal(t, testMsg, msg)
 }
 
+func TestEncodeDecodeRoundTripWithCompression(t *testing.T) {
+	for _, compression := range []CompressionType{SnappyCompression, ZstdCompression} {
+		t.Run(compression.String(), func(t *testing.T) {
+			testEncodeDecodeRoundTripWithCompression(t, compression)
+		})
+	}
+}
+
+func testEncodeDecodeRoundTripWithCompression(t *testing.T, compression CompressionType) {
+	enc := NewEncoder(nil)
+	comp, err := NewCompressor(compression, nil)
+	require.NoError(t, err)
+	require.Equal(t, compression, comp.Type())
+
+	var (
+		buf          bytes.Buffer
+		compressible = msgpb.Message{
+			Metadata: msgpb.Metadata{Shard: 1, Id: 2},
+			Value:    bytes.Repeat([]byte("abc"), 200),
+		}
+		incompressible = msgpb.Message{
+			Metadata: msgpb.Metadata{Shard: 3, Id: 4},
+			Value:    []byte("a"),
+		}
+	)
+	require.NoError(t, enc.Encode(&compressible))
+	frame := append([]byte(nil), enc.Bytes()...)
+	compressed, err := comp.Compress(frame)
+	require.NoError(t, err)
+	require.True(t, len(compressed) < len(frame))
+	buf.Write(compressed)
+
+	// Frames that do not compress are written as is.
+	require.NoError(t, enc.Encode(&incompressible))
+	frame = append([]byte(nil), enc.Bytes()...)
+	notCompressed, err := comp.Compress(frame)
+	require.NoError(t, err)
+	require.Equal(t, frame, notCompressed)
+	buf.Write(notCompressed)
+
+	// Uncompressed and compressed frames can be interleaved.
+	require.NoError(t, enc.Encode(&compressible))
+	compressed, err = comp.Compress(enc.Bytes())
+	require.NoError(t, err)
+	buf.Write(compressed)
+
+	dec := NewDecoder(&buf, nil)
+	for _, expected := range []msgpb.Message{compressible, incompressible, compressible} {
+		var msg msgpb.Message
+		require.NoError(t, dec.Decode(&msg))
+		require.Equal(t, expected, msg)
+	}
+}
+
+func TestDecodeCompressedMessageLargerThanMaxSize(t *testing.T) {
+	for _, compression := range []CompressionType{SnappyCompression, ZstdCompression} {
+		t.Run(compression.String(), func(t *testing.T) {
+			testDecodeCompressedMessageLargerThanMaxSize(t, compression)
+		})
+	}
+}
+
+func testDecodeCompressedMessageLargerThanMaxSize(t *testing.T, compression CompressionType) {
+	enc := NewEncoder(nil)
+	comp, err := NewCompressor(compression, nil)
+	require.NoError(t, err)
+
+	encodeMsg := msgpb.Message{
+		Metadata: msgpb.Metadata{Shard: 1, Id: 2},
+		Value:    make([]byte, 100),
+	}
+	require.NoError(t, enc.Encode(&encodeMsg))
+	compressed, err := comp.Compress(enc.Bytes())
+	require.NoError(t, err)
+
+	var decodeMsg msgpb.Message
+	dec := NewDecoder(bytes.NewReader(compressed), NewOptions().SetMaxMessageSize(50))
+	err = dec.Decode(&decodeMsg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "larger than maximum supported size")
+}
+
+func TestNewCompressorInvalidType(t *testing.T) {
+	_, err := NewCompressor(NoCompression, nil)
+	require.Error(t, err)
+	_, err = NewCompressor(CompressionType(100), nil)
+	require.Error(t, err)
+}
+
 // nolint: unparam
 func getBytesPool(bucketSizes int, bucketCaps []int) pool.BytesPool {
 	buckets := make([]pool.Bucket, len(bucketCaps))
@@ -51,6 +51,17 @@ type Encoder interface {
 	Bytes() []byte
 }
 
+// Compressor compresses encoded frames.
+type Compressor interface {
+	// Type returns the compression type.
+	Type() CompressionType
+
+	// Compress compresses an encoded frame, the returned bytes could be
+	// reused by the next compress call. The frame is returned as is if
+	// compressing it does not reduce its size.
+	Compress(frame []byte) ([]byte, error)
+}
+
 // Decoder decodes into an unmarshaler.
 type Decoder interface {
 	// Decode decodes the unmarshaler.
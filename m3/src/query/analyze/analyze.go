@@ -0,0 +1,326 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package analyze collects the execution stats of a query when it is
+// analyzed, the stats are held by the context of the query so that each of
+// the execution nodes and storages the query goes through can record into
+// them. All the methods of Stats are noops on a nil Stats so that callers
+// can record unconditionally.
+package analyze
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type contextKey struct{}
+
+type storageContextKey struct{}
+
+// NewContext returns a copy of the context holding the stats.
+func NewContext(ctx context.Context, s *Stats) context.Context {
+	return context.WithValue(ctx, contextKey{}, s)
+}
+
+// FromContext returns the stats held by the context, nil if the query is
+// not being analyzed.
+func FromContext(ctx context.Context) *Stats {
+	if ctx == nil {
+		return nil
+	}
+	s, _ := ctx.Value(contextKey{}).(*Stats)
+	return s
+}
+
+// NewStorageContext returns a copy of the context holding the name of the
+// storage the fetches made with it are against.
+func NewStorageContext(ctx context.Context, storage string) context.Context {
+	return context.WithValue(ctx, storageContextKey{}, storage)
+}
+
+// StorageFromContext returns the name of the storage held by the context, or
+// the default name if none.
+func StorageFromContext(ctx context.Context, defaultStorage string) string {
+	if ctx == nil {
+		return defaultStorage
+	}
+	if s, ok := ctx.Value(storageContextKey{}).(string); ok {
+		return s
+	}
+	return defaultStorage
+}
+
+// Stats are the execution stats of an analyzed query.
+type Stats struct {
+	datapoints int64
+
+	mu         sync.Mutex
+	nodes      map[string]*nodeStats
+	storages   map[string]*storageStats
+	namespaces map[string]*NamespaceReport
+}
+
+type nodeStats struct {
+	blocks     int
+	inclusive  time.Duration
+	downstream time.Duration
+}
+
+type storageStats struct {
+	fetches  int
+	errors   int
+	series   int
+	blocks   int
+	wallTime time.Duration
+}
+
+// NewStats returns new empty stats.
+func NewStats() *Stats {
+	return &Stats{
+		nodes:      make(map[string]*nodeStats),
+		storages:   make(map[string]*storageStats),
+		namespaces: make(map[string]*NamespaceReport),
+	}
+}
+
+// AddDatapointsDecoded records datapoints decoded from the fetched series.
+func (s *Stats) AddDatapointsDecoded(n int) {
+	if s == nil || n == 0 {
+		return
+	}
+	atomic.AddInt64(&s.datapoints, int64(n))
+}
+
+// RecordNode records the processing of a block coming from the parent node
+// by an execution node which took the given wall time, including the time
+// taken by its downstream nodes.
+func (s *Stats) RecordNode(id, parentID string, wallTime time.Duration) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	n := s.nodeWithLock(id)
+	n.blocks++
+	n.inclusive += wallTime
+	s.nodeWithLock(parentID).downstream += wallTime
+	s.mu.Unlock()
+}
+
+// RecordSource records the execution of a source node which took the given
+// wall time, including the time taken by its downstream nodes.
+func (s *Stats) RecordSource(id string, wallTime time.Duration) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.nodeWithLock(id).inclusive += wallTime
+	s.mu.Unlock()
+}
+
+func (s *Stats) nodeWithLock(id string) *nodeStats {
+	n, ok := s.nodes[id]
+	if !ok {
+		n = &nodeStats{}
+		s.nodes[id] = n
+	}
+	return n
+}
+
+// RecordFetch records a fetch from the named storage which returned the
+// given number of blocks.
+func (s *Stats) RecordFetch(
+	storage string,
+	blocks int,
+	wallTime time.Duration,
+	err error,
+) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	st := s.storageWithLock(storage)
+	st.fetches++
+	st.blocks += blocks
+	st.wallTime += wallTime
+	if err != nil {
+		st.errors++
+	}
+	s.mu.Unlock()
+}
+
+// AddSeriesFetched records series fetched from the named storage.
+func (s *Stats) AddSeriesFetched(storage string, series int) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.storageWithLock(storage).series += series
+	s.mu.Unlock()
+}
+
+func (s *Stats) storageWithLock(name string) *storageStats {
+	st, ok := s.storages[name]
+	if !ok {
+		st = &storageStats{}
+		s.storages[name] = st
+	}
+	return st
+}
+
+// NamespaceFetch describes a fetch from a namespace resolved for a query.
+type NamespaceFetch struct {
+	// Namespace is the ID of the namespace.
+	Namespace string
+	// MetricsType is the type of metrics held by the namespace.
+	MetricsType string
+	// Retention is the retention of the namespace.
+	Retention time.Duration
+	// Resolution is the resolution of the namespace.
+	Resolution time.Duration
+	// FanoutType describes whether the namespace covers the query range.
+	FanoutType string
+	// Series is the number of series fetched.
+	Series int
+}
+
+// RecordNamespaceFetch records a fetch from a namespace.
+func (s *Stats) RecordNamespaceFetch(f NamespaceFetch) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	ns, ok := s.namespaces[f.Namespace]
+	if !ok {
+		ns = &NamespaceReport{
+			Namespace:   f.Namespace,
+			MetricsType: f.MetricsType,
+			Retention:   f.Retention.String(),
+			Resolution:  f.Resolution.String(),
+			FanoutType:  f.FanoutType,
+		}
+		s.namespaces[f.Namespace] = ns
+	}
+	ns.Fetches++
+	ns.Series += f.Series
+	s.mu.Unlock()
+}
+
+// Report is the JSON representation of the stats.
+type Report struct {
+	DatapointsDecoded int64             `json:"datapointsDecoded"`
+	Nodes             []NodeReport      `json:"nodes"`
+	Storages          []StorageReport   `json:"storages"`
+	Namespaces        []NamespaceReport `json:"namespaces"`
+}
+
+// NodeReport is the report of an execution node.
+type NodeReport struct {
+	ID string `json:"id"`
+	// Blocks is the number of blocks processed, zero for source nodes.
+	Blocks int `json:"blocks"`
+	// WallTime is the time spent processing blocks excluding the time spent
+	// in downstream nodes.
+	WallTime string `json:"wallTime"`
+	// TotalWallTime is the time spent processing blocks including the time
+	// spent in downstream nodes.
+	TotalWallTime string `json:"totalWallTime"`
+}
+
+// StorageReport is the report of a storage.
+type StorageReport struct {
+	Name     string `json:"name"`
+	Fetches  int    `json:"fetches"`
+	Errors   int    `json:"errors"`
+	Series   int    `json:"series"`
+	Blocks   int    `json:"blocks"`
+	WallTime string `json:"wallTime"`
+}
+
+// NamespaceReport is the report of a namespace.
+type NamespaceReport struct {
+	Namespace   string `json:"namespace"`
+	MetricsType string `json:"metricsType"`
+	Retention   string `json:"retention"`
+	Resolution  string `json:"resolution"`
+	FanoutType  string `json:"fanoutType"`
+	Fetches     int    `json:"fetches"`
+	Series      int    `json:"series"`
+}
+
+// Report returns the report of the stats, sorted by node ID, storage name
+// and namespace.
+func (s *Stats) Report() Report {
+	r := Report{
+		DatapointsDecoded: atomic.LoadInt64(&s.datapoints),
+		Nodes:             []NodeReport{},
+		Storages:          []StorageReport{},
+		Namespaces:        []NamespaceReport{},
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, n := range s.nodes {
+		exclusive := n.inclusive - n.downstream
+		if exclusive < 0 {
+			exclusive = 0
+		}
+		r.Nodes = append(r.Nodes, NodeReport{
+			ID:            id,
+			Blocks:        n.blocks,
+			WallTime:      exclusive.String(),
+			TotalWallTime: n.inclusive.String(),
+		})
+	}
+	sort.Slice(r.Nodes, func(i, j int) bool {
+		return r.Nodes[i].ID < r.Nodes[j].ID
+	})
+
+	for name, st := range s.storages {
+		r.Storages = append(r.Storages, StorageReport{
+			Name:     name,
+			Fetches:  st.fetches,
+			Errors:   st.errors,
+			Series:   st.series,
+			Blocks:   st.blocks,
+			WallTime: st.wallTime.String(),
+		})
+	}
+	sort.Slice(r.Storages, func(i, j int) bool {
+		return r.Storages[i].Name < r.Storages[j].Name
+	})
+
+	for _, ns := range s.namespaces {
+		r.Namespaces = append(r.Namespaces, *ns)
+	}
+	sort.Slice(r.Namespaces, func(i, j int) bool {
+		return r.Namespaces[i].Namespace < r.Namespaces[j].Namespace
+	})
+
+	return r
+}
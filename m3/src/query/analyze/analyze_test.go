@@ -0,0 +1,105 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package analyze
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContext(t *testing.T) {
+	assert.Nil(t, FromContext(context.Background()))
+
+	s := NewStats()
+	ctx := NewContext(context.Background(), s)
+	assert.Equal(t, s, FromContext(ctx))
+
+	assert.Equal(t, "local", StorageFromContext(ctx, "local"))
+	ctx = NewStorageContext(ctx, "remote_store_a")
+	assert.Equal(t, "remote_store_a", StorageFromContext(ctx, "local"))
+}
+
+func TestNilStats(t *testing.T) {
+	var s *Stats
+	s.AddDatapointsDecoded(1)
+	s.RecordNode("1", "0", time.Second)
+	s.RecordSource("0", time.Second)
+	s.RecordFetch("local", 1, time.Second, nil)
+	s.AddSeriesFetched("local", 1)
+	s.RecordNamespaceFetch(NamespaceFetch{Namespace: "default"})
+}
+
+func TestReport(t *testing.T) {
+	s := NewStats()
+	s.AddDatapointsDecoded(10)
+	s.AddDatapointsDecoded(5)
+
+	s.RecordSource("0", 10*time.Millisecond)
+	s.RecordNode("1", "0", 8*time.Millisecond)
+	s.RecordNode("2", "1", 3*time.Millisecond)
+	s.RecordNode("2", "1", 2*time.Millisecond)
+
+	s.RecordFetch("local_store", 1, time.Millisecond, nil)
+	s.RecordFetch("remote_store_a", 0, time.Millisecond, errors.New("error"))
+	s.AddSeriesFetched("local_store", 3)
+
+	s.RecordNamespaceFetch(NamespaceFetch{
+		Namespace:   "metrics_10s",
+		MetricsType: "aggregated",
+		Retention:   48 * time.Hour,
+		Resolution:  10 * time.Second,
+		FanoutType:  "coversAllQueryRange",
+		Series:      3,
+	})
+
+	r := s.Report()
+	assert.Equal(t, int64(15), r.DatapointsDecoded)
+	assert.Equal(t, []NodeReport{
+		{ID: "0", Blocks: 0, WallTime: "2ms", TotalWallTime: "10ms"},
+		{ID: "1", Blocks: 1, WallTime: "3ms", TotalWallTime: "8ms"},
+		{ID: "2", Blocks: 2, WallTime: "5ms", TotalWallTime: "5ms"},
+	}, r.Nodes)
+
+	require.Len(t, r.Storages, 2)
+	assert.Equal(t, StorageReport{
+		Name:     "local_store",
+		Fetches:  1,
+		Series:   3,
+		Blocks:   1,
+		WallTime: "1ms",
+	}, r.Storages[0])
+	assert.Equal(t, 1, r.Storages[1].Errors)
+
+	assert.Equal(t, []NamespaceReport{{
+		Namespace:   "metrics_10s",
+		MetricsType: "aggregated",
+		Retention:   "48h0m0s",
+		Resolution:  "10s",
+		FanoutType:  "coversAllQueryRange",
+		Fetches:     1,
+		Series:      3,
+	}}, r.Namespaces)
+}
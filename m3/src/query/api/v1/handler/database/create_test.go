@@ -171,7 +171,11 @@ func testLocalType(t *testing.T, providedType string, placementExists bool) {
 							"blockSizeNanos": "3600000000000"
 						},
 						"schemaOptions": null,
-						"coldWritesEnabled": false
+						"coldWritesEnabled": false,
+						"coldTierOptions": {
+							"enabled": false,
+							"offloadAfterNanos": "604800000000000"
+						}
 					}
 				}
 			}
@@ -190,7 +194,8 @@ func testLocalType(t *testing.T, providedType string, placementExists bool) {
 						"hostname": "localhost",
 						"port": 9000,
 						"metadata": {
-							"debugPort": 0
+							"debugPort": 0,
+							"grpcPort": 0
 						}
 					}
 				},
@@ -333,7 +338,11 @@ func TestLocalTypeWithNumShards(t *testing.T) {
 							"blockSizeNanos": "3600000000000"
 						},
 						"schemaOptions": null,
-						"coldWritesEnabled": false
+						"coldWritesEnabled": false,
+						"coldTierOptions": {
+							"enabled": false,
+							"offloadAfterNanos": "604800000000000"
+						}
 					}
 				}
 			}
@@ -352,7 +361,8 @@ func TestLocalTypeWithNumShards(t *testing.T) {
 						"hostname": "localhost",
 						"port": 9000,
 						"metadata": {
-							"debugPort": 0
+							"debugPort": 0,
+							"grpcPort": 0
 						}
 					}
 				},
@@ -447,7 +457,11 @@ func TestLocalWithBlockSizeNanos(t *testing.T) {
 							"blockSizeNanos": "10800000000000"
 						},
 						"schemaOptions": null,
-						"coldWritesEnabled": false
+						"coldWritesEnabled": false,
+						"coldTierOptions": {
+							"enabled": false,
+							"offloadAfterNanos": "604800000000000"
+						}
 					}
 				}
 			}
@@ -466,7 +480,8 @@ func TestLocalWithBlockSizeNanos(t *testing.T) {
 						"hostname": "localhost",
 						"port": 9000,
 						"metadata": {
-							"debugPort": 0
+							"debugPort": 0,
+							"grpcPort": 0
 						}
 					}
 				},
@@ -567,7 +582,11 @@ func TestLocalWithBlockSizeExpectedSeriesDatapointsPerHour(t *testing.T) {
 							"blockSizeNanos": "%d"
 						},
 						"schemaOptions": null,
-						"coldWritesEnabled": false
+						"coldWritesEnabled": false,
+						"coldTierOptions": {
+							"enabled": false,
+							"offloadAfterNanos": "604800000000000"
+						}
 					}
 				}
 			}
@@ -586,7 +605,8 @@ func TestLocalWithBlockSizeExpectedSeriesDatapointsPerHour(t *testing.T) {
 						"hostname": "localhost",
 						"port": 9000,
 						"metadata": {
-							"debugPort": 0
+							"debugPort": 0,
+							"grpcPort": 0
 						}
 					}
 				},
@@ -817,7 +837,11 @@ func testClusterTypeHosts(t *testing.T, placementExists bool) {
 							"blockSizeNanos": "3600000000000"
 						},
 						"schemaOptions": null,
-						"coldWritesEnabled": false
+						"coldWritesEnabled": false,
+						"coldTierOptions": {
+							"enabled": false,
+							"offloadAfterNanos": "604800000000000"
+						}
 					}
 				}
 			}
@@ -836,7 +860,8 @@ func testClusterTypeHosts(t *testing.T, placementExists bool) {
 						"hostname": "host1",
 						"port": 9000,
 						"metadata": {
-							"debugPort": 0
+							"debugPort": 0,
+							"grpcPort": 0
 						}
 					},
 					"host2": {
@@ -850,7 +875,8 @@ func testClusterTypeHosts(t *testing.T, placementExists bool) {
 						"hostname": "host2",
 						"port": 9000,
 						"metadata": {
-							"debugPort": 0
+							"debugPort": 0,
+							"grpcPort": 0
 						}
 					}
 				},
@@ -960,7 +986,11 @@ func TestClusterTypeHostsWithIsolationGroup(t *testing.T) {
 							"blockSizeNanos": "3600000000000"
 						},
 						"schemaOptions": null,
-						"coldWritesEnabled": false
+						"coldWritesEnabled": false,
+						"coldTierOptions": {
+							"enabled": false,
+							"offloadAfterNanos": "604800000000000"
+						}
 					}
 				}
 			}
@@ -979,7 +1009,8 @@ func TestClusterTypeHostsWithIsolationGroup(t *testing.T) {
 						"hostname": "host1",
 						"port": 9000,
 						"metadata": {
-							"debugPort": 0
+							"debugPort": 0,
+							"grpcPort": 0
 						}
 					},
 					"host2": {
@@ -993,7 +1024,8 @@ func TestClusterTypeHostsWithIsolationGroup(t *testing.T) {
 						"hostname": "host2",
 						"port": 9000,
 						"metadata": {
-							"debugPort": 0
+							"debugPort": 0,
+							"grpcPort": 0
 						}
 					}
 				},
@@ -0,0 +1,121 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+)
+
+var errInvalidCacheSize = errors.New("cache size must be positive")
+
+// Cache caches the results of the intervals of range queries.
+type Cache interface {
+	// Get returns the value cached for a key, false is returned if the key is
+	// not cached.
+	Get(ctx context.Context, key string) ([]byte, bool)
+
+	// Set caches a value for a key.
+	Set(ctx context.Context, key string, value []byte)
+}
+
+// lruCache is an in-process cache evicting the least recently used values
+// once the size of the cached values exceeds the max size.
+type lruCache struct {
+	sync.Mutex
+
+	maxSize   int
+	size      int
+	evictList *list.List
+	items     map[string]*list.Element
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+// NewLRUCache returns an in-process cache holding at most maxSize bytes of
+// values, evicting the least recently used values.
+func NewLRUCache(maxSize int) (Cache, error) {
+	if maxSize <= 0 {
+		return nil, errInvalidCacheSize
+	}
+
+	return &lruCache{
+		maxSize:   maxSize,
+		evictList: list.New(),
+		items:     make(map[string]*list.Element),
+	}, nil
+}
+
+func (c *lruCache) Get(_ context.Context, key string) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.evictList.MoveToFront(elem)
+	return elem.Value.(*lruEntry).value, true
+}
+
+func (c *lruCache) Set(_ context.Context, key string, value []byte) {
+	if entrySize(key, value) > c.maxSize {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*lruEntry)
+		c.size += len(value) - len(entry.value)
+		entry.value = value
+		c.evictList.MoveToFront(elem)
+	} else {
+		entry := &lruEntry{key: key, value: value}
+		c.items[key] = c.evictList.PushFront(entry)
+		c.size += entrySize(key, value)
+	}
+
+	for c.size > c.maxSize {
+		c.removeOldestWithLock()
+	}
+}
+
+func (c *lruCache) removeOldestWithLock() {
+	elem := c.evictList.Back()
+	if elem == nil {
+		return
+	}
+
+	entry := c.evictList.Remove(elem).(*lruEntry)
+	delete(c.items, entry.key)
+	c.size -= entrySize(entry.key, entry.value)
+}
+
+func entrySize(key string, value []byte) int {
+	return len(key) + len(value)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache(t *testing.T) {
+	_, err := NewLRUCache(0)
+	require.Error(t, err)
+
+	ctx := context.Background()
+	cache, err := NewLRUCache(12)
+	require.NoError(t, err)
+
+	cache.Set(ctx, "a", []byte("aaa"))
+	cache.Set(ctx, "b", []byte("bbb"))
+	cache.Set(ctx, "c", []byte("cc"))
+
+	// Touch a so that b is the least recently used.
+	v, ok := cache.Get(ctx, "a")
+	require.True(t, ok)
+	assert.Equal(t, "aaa", string(v))
+
+	cache.Set(ctx, "d", []byte("dd"))
+	_, ok = cache.Get(ctx, "b")
+	assert.False(t, ok)
+	for _, key := range []string{"a", "c", "d"} {
+		_, ok = cache.Get(ctx, key)
+		assert.True(t, ok, key)
+	}
+
+	// Values larger than the cache are not cached.
+	cache.Set(ctx, "e", make([]byte, 12))
+	_, ok = cache.Get(ctx, "e")
+	assert.False(t, ok)
+	_, ok = cache.Get(ctx, "a")
+	assert.True(t, ok)
+}
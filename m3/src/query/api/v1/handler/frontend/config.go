@@ -0,0 +1,106 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	defaultSplitInterval     = time.Hour
+	defaultMaxParallelism    = 8
+	defaultMaxCacheFreshness = time.Minute
+)
+
+// Configuration configures the query frontend, which splits range queries
+// into intervals executed in parallel and caches the results of the intervals
+// that are complete.
+type Configuration struct {
+	// SplitInterval is the interval range queries are split at, defaults to
+	// one hour.
+	SplitInterval *time.Duration `yaml:"splitInterval"`
+
+	// MaxParallelism is the max number of intervals of a range query executed
+	// in parallel, defaults to 8.
+	MaxParallelism int `yaml:"maxParallelism"`
+
+	// MaxCacheFreshness is the age under which the results of an interval are
+	// not cached since more data may arrive, defaults to one minute.
+	MaxCacheFreshness *time.Duration `yaml:"maxCacheFreshness"`
+
+	// AlignQueriesWithStep aligns the start of range queries down to their
+	// step so that they can be split, which shifts the points of the results
+	// of unaligned queries. Queries with a start not aligned to their step
+	// are not split if not set.
+	AlignQueriesWithStep bool `yaml:"alignQueriesWithStep"`
+
+	// Cache configures the cache of the results of intervals, results are not
+	// cached if not set.
+	Cache *CacheConfiguration `yaml:"cache"`
+}
+
+// CacheConfiguration configures the cache of the results of intervals.
+type CacheConfiguration struct {
+	// LRU configures an in-process cache.
+	LRU *LRUCacheConfiguration `yaml:"lru"`
+}
+
+// LRUCacheConfiguration configures an in-process cache evicting the least
+// recently used results.
+type LRUCacheConfiguration struct {
+	// MaxSize is the max size in bytes of the cached results.
+	MaxSize int `yaml:"maxSize" validate:"nonzero"`
+}
+
+// NewOptions returns the options of the query frontend.
+func (c Configuration) NewOptions(
+	instrumentOpts instrument.Options,
+) (Options, error) {
+	opts := Options{
+		SplitInterval:        defaultSplitInterval,
+		MaxParallelism:       defaultMaxParallelism,
+		MaxCacheFreshness:    defaultMaxCacheFreshness,
+		AlignQueriesWithStep: c.AlignQueriesWithStep,
+		NowFn:                time.Now,
+		InstrumentOpts:       instrumentOpts,
+	}
+	if v := c.SplitInterval; v != nil {
+		opts.SplitInterval = *v
+	}
+	if v := c.MaxParallelism; v > 0 {
+		opts.MaxParallelism = v
+	}
+	if v := c.MaxCacheFreshness; v != nil {
+		opts.MaxCacheFreshness = *v
+	}
+
+	if c.Cache != nil && c.Cache.LRU != nil {
+		cache, err := NewLRUCache(c.Cache.LRU.MaxSize)
+		if err != nil {
+			return Options{}, err
+		}
+		opts.Cache = cache
+	}
+
+	return opts, nil
+}
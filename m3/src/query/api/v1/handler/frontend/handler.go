@@ -0,0 +1,592 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package frontend splits range queries into intervals executed in parallel
+// and caches the results of the intervals that are complete, so that only the
+// recent tail of the range of a refreshed dashboard is computed again.
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/tenant"
+	"github.com/m3db/m3/src/query/util"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	queryParam        = "query"
+	startParam        = "start"
+	endParam          = "end"
+	debugParam        = "debug"
+	endExclusiveParam = "end-exclusive"
+	nowTimeValue      = "now"
+
+	renderFormatHeader  = "X-M3-Render-Format"
+	headerContentLength = "Content-Length"
+	statusSuccess       = "success"
+	resultTypeMatrix    = "matrix"
+)
+
+var (
+	errInvalidSplitInterval  = errors.New("split interval must be positive")
+	errInvalidMaxParallelism = errors.New("max parallelism must be positive")
+	errNoNowFn               = errors.New("no now fn set")
+)
+
+// Options are the options of the query frontend.
+type Options struct {
+	// SplitInterval is the interval range queries are split at.
+	SplitInterval time.Duration
+	// MaxParallelism is the max number of intervals of a range query
+	// executed in parallel.
+	MaxParallelism int
+	// MaxCacheFreshness is the age under which the results of an interval
+	// are not cached.
+	MaxCacheFreshness time.Duration
+	// AlignQueriesWithStep aligns the start of range queries down to their
+	// step, queries with an unaligned start are not split otherwise.
+	AlignQueriesWithStep bool
+	// Cache caches the results of intervals, results are not cached if nil.
+	Cache Cache
+	// NowFn returns the current time.
+	NowFn clock.NowFn
+	// InstrumentOpts are the instrument options.
+	InstrumentOpts instrument.Options
+}
+
+// Validate validates the options.
+func (o Options) Validate() error {
+	if o.SplitInterval <= 0 {
+		return errInvalidSplitInterval
+	}
+	if o.MaxParallelism <= 0 {
+		return errInvalidMaxParallelism
+	}
+	if o.NowFn == nil {
+		return errNoNowFn
+	}
+	return nil
+}
+
+// Handler is the query frontend handler, it serves range queries by executing
+// their intervals with the handler it wraps.
+type Handler struct {
+	next           http.Handler
+	opts           Options
+	instrumentOpts instrument.Options
+	metrics        handlerMetrics
+}
+
+type handlerMetrics struct {
+	queries     tally.Counter
+	unaligned   tally.Counter
+	intervals   tally.Counter
+	cacheHits   tally.Counter
+	cacheMisses tally.Counter
+	errors      tally.Counter
+}
+
+func newHandlerMetrics(scope tally.Scope) handlerMetrics {
+	return handlerMetrics{
+		queries:     scope.Counter("split-queries"),
+		unaligned:   scope.Counter("unaligned-queries"),
+		intervals:   scope.Counter("intervals"),
+		cacheHits:   scope.Counter("cache-hits"),
+		cacheMisses: scope.Counter("cache-misses"),
+		errors:      scope.Counter("errors"),
+	}
+}
+
+// NewHandler returns a query frontend handler executing the intervals of
+// range queries with the given handler.
+func NewHandler(next http.Handler, opts Options) (http.Handler, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	instrumentOpts := opts.InstrumentOpts
+	if instrumentOpts == nil {
+		instrumentOpts = instrument.NewOptions()
+	}
+	scope := instrumentOpts.MetricsScope().SubScope("query-frontend")
+	return &Handler{
+		next:           next,
+		opts:           opts,
+		instrumentOpts: instrumentOpts,
+		metrics:        newHandlerMetrics(scope),
+	}, nil
+}
+
+type rangeQuery struct {
+	start time.Time
+	end   time.Time
+	step  time.Duration
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q, ok := h.parseRangeQuery(r)
+	if !ok {
+		// Let the wrapped handler serve or reject the query as is.
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	if !h.opts.AlignQueriesWithStep && !isAligned(q.start, q.step) {
+		// NB: the points of the intervals would not match the points of the
+		// query, serve it as is.
+		h.metrics.unaligned.Inc(1)
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	intervals := splitRange(q.start, q.end, q.step, h.opts.SplitInterval)
+	if len(intervals) == 1 && h.opts.Cache == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	h.metrics.queries.Inc(1)
+	h.metrics.intervals.Inc(int64(len(intervals)))
+
+	results, failed := h.executeIntervals(r, q, intervals)
+	if failed != nil {
+		h.metrics.errors.Inc(1)
+		failed.writeTo(w)
+		return
+	}
+
+	h.writeResults(w, r, results)
+}
+
+func (h *Handler) parseRangeQuery(r *http.Request) (rangeQuery, bool) {
+	if err := r.ParseForm(); err != nil {
+		return rangeQuery{}, false
+	}
+
+	// NB: queries changing the bounds or the format of the results are not
+	// split, nor are debug queries.
+	if r.FormValue(queryParam) == "" ||
+		r.FormValue(endExclusiveParam) != "" ||
+		r.FormValue(debugParam) != "" ||
+		r.Header.Get(renderFormatHeader) != "" {
+		return rangeQuery{}, false
+	}
+
+	step, ok, err := handleroptions.ParseStep(r)
+	if err != nil || !ok {
+		return rangeQuery{}, false
+	}
+
+	now := h.opts.NowFn()
+	start, err := parseTime(r.FormValue(startParam), now)
+	if err != nil {
+		return rangeQuery{}, false
+	}
+	end, err := parseTime(r.FormValue(endParam), now)
+	if err != nil || start.After(end) {
+		return rangeQuery{}, false
+	}
+
+	return rangeQuery{start: start, end: end, step: step}, true
+}
+
+func parseTime(v string, now time.Time) (time.Time, error) {
+	if v == nowTimeValue {
+		return now, nil
+	}
+	return util.ParseTimeString(v)
+}
+
+// intervalResult is the result of an interval, nil if the interval failed.
+type intervalResult struct {
+	series   []series
+	warnings []string
+	// header is the header of the response of the interval, it is not set
+	// for cached results.
+	header http.Header
+}
+
+// hasWarningHeaders returns whether the result is limited or has warnings
+// reported by headers.
+func (r *intervalResult) hasWarningHeaders() bool {
+	return r.header.Get(handleroptions.LimitHeader) != "" ||
+		r.header.Get(handleroptions.WarningsHeader) != ""
+}
+
+func (h *Handler) executeIntervals(
+	r *http.Request,
+	q rangeQuery,
+	intervals []interval,
+) ([]*intervalResult, *bufferedResponse) {
+	var (
+		results   = make([]*intervalResult, len(intervals))
+		responses = make([]*bufferedResponse, len(intervals))
+		keyPrefix = cacheKeyPrefix(r)
+		freshness = h.opts.NowFn().Add(-h.opts.MaxCacheFreshness)
+		sem       = make(chan struct{}, h.opts.MaxParallelism)
+		wg        sync.WaitGroup
+	)
+
+	for i, in := range intervals {
+		cacheable := h.opts.Cache != nil && in.end.Before(freshness)
+		key := cacheKey(keyPrefix, q.step, in)
+		if cacheable {
+			if result, ok := h.cachedResult(r.Context(), key); ok {
+				h.metrics.cacheHits.Inc(1)
+				results[i] = result
+				continue
+			}
+			h.metrics.cacheMisses.Inc(1)
+		}
+
+		i, in := i, in
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			resp := h.executeInterval(r, in)
+			responses[i] = resp
+			if resp.code != http.StatusOK {
+				return
+			}
+
+			result, err := resp.result()
+			if err != nil {
+				logging.WithContext(r.Context(), h.instrumentOpts).
+					Error("could not decode interval result", zap.Error(err))
+				responses[i] = newErrorResponse(err, http.StatusInternalServerError)
+				return
+			}
+			results[i] = result
+
+			// NB: limited results are not complete and must not be cached.
+			if cacheable && len(result.warnings) == 0 && !result.hasWarningHeaders() {
+				h.cacheResult(r.Context(), key, result)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, resp := range responses {
+		if resp != nil && resp.code != http.StatusOK {
+			return nil, resp
+		}
+	}
+	return results, nil
+}
+
+func (h *Handler) executeInterval(r *http.Request, in interval) *bufferedResponse {
+	form := make(url.Values, len(r.Form))
+	for k, v := range r.Form {
+		form[k] = v
+	}
+	form.Set(startParam, formatTime(in.start))
+	form.Set(endParam, formatTime(in.end))
+
+	req := r.Clone(r.Context())
+	req.Method = http.MethodGet
+	req.Body = http.NoBody
+	req.ContentLength = 0
+	req.Header.Del(xhttp.HeaderContentType)
+	req.URL.RawQuery = form.Encode()
+	req.RequestURI = req.URL.RequestURI()
+	req.Form = nil
+	req.PostForm = nil
+
+	resp := newBufferedResponse()
+	h.next.ServeHTTP(resp, req)
+	return resp
+}
+
+func formatTime(t time.Time) string {
+	return strconv.FormatFloat(float64(t.UnixNano())/float64(time.Second), 'f', -1, 64)
+}
+
+func (h *Handler) cachedResult(
+	ctx context.Context,
+	key string,
+) (*intervalResult, bool) {
+	value, ok := h.opts.Cache.Get(ctx, key)
+	if !ok {
+		return nil, false
+	}
+
+	var s []series
+	if err := json.Unmarshal(value, &s); err != nil {
+		return nil, false
+	}
+	return &intervalResult{series: s}, true
+}
+
+func (h *Handler) cacheResult(
+	ctx context.Context,
+	key string,
+	result *intervalResult,
+) {
+	value, err := json.Marshal(result.series)
+	if err != nil {
+		return
+	}
+	h.opts.Cache.Set(ctx, key, value)
+}
+
+// cacheKeyPrefix returns the part of the cache key of the intervals of a
+// query common to all its intervals, it covers everything that may change
+// the results of the query other than its bounds.
+func cacheKeyPrefix(r *http.Request) string {
+	form := make(url.Values, len(r.Form))
+	for k, v := range r.Form {
+		if k == startParam || k == endParam {
+			continue
+		}
+		form[k] = v
+	}
+
+	var b strings.Builder
+	b.WriteString(r.URL.Path)
+	b.WriteString("?")
+	b.WriteString(form.Encode())
+
+	names := make([]string, 0, len(r.Header))
+	for name := range r.Header {
+		if strings.HasPrefix(name, handleroptions.M3HeaderPrefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.WriteString("\n")
+		b.WriteString(name)
+		b.WriteString(": ")
+		b.WriteString(strings.Join(r.Header[name], ","))
+	}
+
+	if t, ok := tenant.FromContext(r.Context()); ok {
+		b.WriteString("\ntenant: ")
+		b.WriteString(t.Name())
+	}
+
+	return b.String()
+}
+
+func cacheKey(prefix string, step time.Duration, in interval) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\n%d:%d:%d",
+		prefix, step, in.start.UnixNano(), in.end.UnixNano())))
+	return hex.EncodeToString(sum[:])
+}
+
+func (h *Handler) writeResults(
+	w http.ResponseWriter,
+	r *http.Request,
+	results []*intervalResult,
+) {
+	var (
+		merged   []series
+		indices  = make(map[string]int)
+		warnings []string
+		header   = make(http.Header)
+	)
+	for _, result := range results {
+		warnings = appendUnique(warnings, result.warnings...)
+		mergeHeader(header, result.header)
+		for _, s := range result.series {
+			id := s.id()
+			idx, ok := indices[id]
+			if !ok {
+				indices[id] = len(merged)
+				merged = append(merged, s)
+				continue
+			}
+			merged[idx].Values = append(merged[idx].Values, s.Values...)
+		}
+	}
+
+	if merged == nil {
+		merged = []series{}
+	}
+
+	for k, v := range header {
+		w.Header()[k] = v
+	}
+	xhttp.WriteJSONResponse(w, response{
+		Status:   statusSuccess,
+		Warnings: warnings,
+		Data: responseData{
+			ResultType: resultTypeMatrix,
+			Result:     merged,
+		},
+	}, logging.WithContext(r.Context(), h.instrumentOpts))
+}
+
+// mergeHeader merges the header of the response of an interval into the
+// header of the response of the query, the distinct values of each header
+// across intervals are joined.
+func mergeHeader(dst, src http.Header) {
+	for k, values := range src {
+		if k == xhttp.HeaderContentType || k == headerContentLength {
+			continue
+		}
+
+		var merged []string
+		if existing := dst.Get(k); existing != "" {
+			merged = strings.Split(existing, ",")
+		}
+		for _, v := range values {
+			merged = appendUnique(merged, strings.Split(v, ",")...)
+		}
+		dst.Set(k, strings.Join(merged, ","))
+	}
+}
+
+func appendUnique(values []string, added ...string) []string {
+	for _, a := range added {
+		found := false
+		for _, v := range values {
+			if v == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, a)
+		}
+	}
+	return values
+}
+
+type response struct {
+	Status   string       `json:"status"`
+	Warnings []string     `json:"warnings,omitempty"`
+	Data     responseData `json:"data"`
+}
+
+type responseData struct {
+	ResultType string   `json:"resultType"`
+	Result     []series `json:"result"`
+}
+
+// series is a series of a range query result, its values are kept encoded
+// as returned by the query engine.
+type series struct {
+	Metric     map[string]string `json:"metric"`
+	Values     []json.RawMessage `json:"values"`
+	StepSizeMs *int              `json:"step_size_ms,omitempty"`
+}
+
+func (s series) id() string {
+	names := make([]string, 0, len(s.Metric))
+	for name := range s.Metric {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(strconv.Quote(name))
+		b.WriteString("=")
+		b.WriteString(strconv.Quote(s.Metric[name]))
+		b.WriteString(",")
+	}
+	return b.String()
+}
+
+// bufferedResponse is the response of an interval.
+type bufferedResponse struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func newBufferedResponse() *bufferedResponse {
+	return &bufferedResponse{
+		header: make(http.Header),
+		code:   http.StatusOK,
+	}
+}
+
+func newErrorResponse(err error, code int) *bufferedResponse {
+	resp := newBufferedResponse()
+	xhttp.Error(resp, err, code)
+	return resp
+}
+
+func (r *bufferedResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *bufferedResponse) Write(p []byte) (int, error) {
+	return r.body.Write(p)
+}
+
+func (r *bufferedResponse) WriteHeader(code int) {
+	r.code = code
+}
+
+func (r *bufferedResponse) result() (*intervalResult, error) {
+	var resp response
+	if err := json.Unmarshal(r.body.Bytes(), &resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != statusSuccess {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	if resp.Data.ResultType != resultTypeMatrix {
+		return nil, fmt.Errorf("unexpected result type: %s", resp.Data.ResultType)
+	}
+
+	return &intervalResult{
+		series:   resp.Data.Result,
+		warnings: resp.Warnings,
+		header:   r.header,
+	}, nil
+}
+
+func (r *bufferedResponse) writeTo(w http.ResponseWriter) {
+	for k, v := range r.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(r.code)
+	_, _ = w.Write(r.body.Bytes())
+}
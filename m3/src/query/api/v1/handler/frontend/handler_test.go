@@ -0,0 +1,327 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/util"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRangeHandler serves range queries with a single series whose value at
+// each step is its timestamp.
+type testRangeHandler struct {
+	sync.Mutex
+	requests []url.Values
+	fail     func(start time.Time) bool
+	limited  bool
+	warnings func(start time.Time) string
+}
+
+func (h *testRangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_ = r.ParseForm()
+	h.Lock()
+	h.requests = append(h.requests, r.Form)
+	h.Unlock()
+
+	start, err := util.ParseTimeString(r.FormValue("start"))
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+	end, err := util.ParseTimeString(r.FormValue("end"))
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+	step, _, err := handleroptions.ParseStep(r)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+	if h.fail != nil && h.fail(start) {
+		xhttp.Error(w, fmt.Errorf("query failed"), http.StatusUnprocessableEntity)
+		return
+	}
+
+	var values []string
+	for t := start; !t.After(end); t = t.Add(step) {
+		values = append(values, fmt.Sprintf(`[%d,"%d"]`, t.Unix(), t.Unix()))
+	}
+	if h.limited {
+		w.Header().Set(handleroptions.LimitHeader,
+			handleroptions.LimitHeaderSeriesLimitApplied)
+	}
+	if h.warnings != nil {
+		if v := h.warnings(start); v != "" {
+			w.Header().Set(handleroptions.WarningsHeader, v)
+		}
+	}
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+	fmt.Fprintf(w, `{"status":"success","data":{"resultType":"matrix",`+
+		`"result":[{"metric":{"__name__":"up"},"values":[%s]}]}}`,
+		strings.Join(values, ","))
+}
+
+func (h *testRangeHandler) numRequests() int {
+	h.Lock()
+	defer h.Unlock()
+	return len(h.requests)
+}
+
+func newTestHandler(
+	t *testing.T,
+	next http.Handler,
+	now time.Time,
+	cache Cache,
+) http.Handler {
+	return newTestHandlerWithOptions(t, next, Options{
+		SplitInterval:     time.Hour,
+		MaxParallelism:    2,
+		MaxCacheFreshness: time.Minute,
+		Cache:             cache,
+		NowFn:             func() time.Time { return now },
+		InstrumentOpts:    instrument.NewOptions(),
+	})
+}
+
+func newTestHandlerWithOptions(
+	t *testing.T,
+	next http.Handler,
+	opts Options,
+) http.Handler {
+	h, err := NewHandler(next, opts)
+	require.NoError(t, err)
+	return h
+}
+
+func newTestRangeRequest(start, end time.Time, step string) *http.Request {
+	values := url.Values{}
+	values.Set("query", "up")
+	values.Set("start", formatTime(start))
+	values.Set("end", formatTime(end))
+	values.Set("step", step)
+	return httptest.NewRequest(http.MethodGet,
+		"/api/v1/query_range?"+values.Encode(), nil)
+}
+
+type testResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []struct {
+			Metric map[string]string `json:"metric"`
+			Values [][2]interface{}  `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func requireContiguousValues(
+	t *testing.T,
+	recorder *httptest.ResponseRecorder,
+	start, end time.Time,
+	step time.Duration,
+) {
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var resp testResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Equal(t, "success", resp.Status)
+	require.Len(t, resp.Data.Result, 1)
+	assert.Equal(t, map[string]string{"__name__": "up"}, resp.Data.Result[0].Metric)
+
+	values := resp.Data.Result[0].Values
+	require.Equal(t, int(end.Sub(start)/step)+1, len(values))
+	for i, v := range values {
+		assert.Equal(t, float64(start.Add(time.Duration(i)*step).Unix()), v[0])
+	}
+}
+
+func TestHandlerSplitsAndCaches(t *testing.T) {
+	var (
+		now   = time.Unix(1600000000, 0).Truncate(time.Hour).Add(30 * time.Minute)
+		start = now.Add(-3 * time.Hour)
+		step  = 30 * time.Second
+		next  = &testRangeHandler{}
+	)
+
+	cache, err := NewLRUCache(1 << 20)
+	require.NoError(t, err)
+	handler := newTestHandler(t, next, now, cache)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRangeRequest(start, now, "30s"))
+	requireContiguousValues(t, recorder, start, now, step)
+	assert.Equal(t, 4, next.numRequests())
+
+	// Only the interval of the recent tail is executed again.
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRangeRequest(start, now, "30s"))
+	requireContiguousValues(t, recorder, start, now, step)
+	assert.Equal(t, 5, next.numRequests())
+	assert.Equal(t, formatTime(now.Add(-30*time.Minute)), next.requests[4].Get("start"))
+
+	// A different step is a different query.
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRangeRequest(start, now, "60"))
+	requireContiguousValues(t, recorder, start, now, time.Minute)
+	assert.Equal(t, 9, next.numRequests())
+}
+
+func TestHandlerDoesNotCacheLimitedResults(t *testing.T) {
+	var (
+		now   = time.Unix(1600000000, 0).Truncate(time.Hour)
+		start = now.Add(-2 * time.Hour)
+		next  = &testRangeHandler{limited: true}
+	)
+
+	cache, err := NewLRUCache(1 << 20)
+	require.NoError(t, err)
+	handler := newTestHandler(t, next, now, cache)
+
+	for i := 1; i <= 2; i++ {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newTestRangeRequest(start, now, "1m"))
+		requireContiguousValues(t, recorder, start, now, time.Minute)
+		assert.Equal(t, handleroptions.LimitHeaderSeriesLimitApplied,
+			recorder.Header().Get(handleroptions.LimitHeader))
+		assert.Equal(t, 3*i, next.numRequests())
+	}
+}
+
+func TestHandlerReturnsIntervalError(t *testing.T) {
+	var (
+		now   = time.Unix(1600000000, 0).Truncate(time.Hour)
+		start = now.Add(-2 * time.Hour)
+		next  = &testRangeHandler{
+			fail: func(start time.Time) bool { return start.Equal(now.Add(-time.Hour)) },
+		}
+	)
+
+	handler := newTestHandler(t, next, now, nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRangeRequest(start, now, "1m"))
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "query failed")
+}
+
+func TestHandlerPassesThroughUnsplitQueries(t *testing.T) {
+	var (
+		now  = time.Unix(1600000000, 0).Truncate(time.Hour).Add(30 * time.Minute)
+		next = &testRangeHandler{}
+	)
+	handler := newTestHandler(t, next, now, nil)
+
+	// Within a single interval and no cache.
+	start := now.Add(-10 * time.Minute)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRangeRequest(start, now, "1m"))
+	requireContiguousValues(t, recorder, start, now, time.Minute)
+	require.Equal(t, 1, next.numRequests())
+	assert.Equal(t, formatTime(start), next.requests[0].Get("start"))
+
+	// Debug queries.
+	req := newTestRangeRequest(now.Add(-3*time.Hour), now, "1m")
+	req.URL.RawQuery += "&debug=true"
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, 2, next.numRequests())
+}
+
+func TestHandlerPassesThroughUnalignedQueries(t *testing.T) {
+	var (
+		now   = time.Unix(1600000000, 0).Truncate(time.Hour)
+		start = now.Add(-2*time.Hour + 10*time.Second)
+		next  = &testRangeHandler{}
+	)
+	handler := newTestHandler(t, next, now, nil)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRangeRequest(start, now, "1m"))
+	requireContiguousValues(t, recorder, start, now.Add(-50*time.Second), time.Minute)
+	require.Equal(t, 1, next.numRequests())
+	assert.Equal(t, formatTime(start), next.requests[0].Get("start"))
+}
+
+func TestHandlerAlignsQueriesWithStep(t *testing.T) {
+	var (
+		now   = time.Unix(1600000000, 0).Truncate(time.Hour)
+		start = now.Add(-2*time.Hour + 10*time.Second)
+		next  = &testRangeHandler{}
+	)
+	handler := newTestHandlerWithOptions(t, next, Options{
+		SplitInterval:        time.Hour,
+		MaxParallelism:       2,
+		AlignQueriesWithStep: true,
+		NowFn:                func() time.Time { return now },
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRangeRequest(start, now, "1m"))
+	requireContiguousValues(t, recorder, now.Add(-2*time.Hour), now, time.Minute)
+	require.Equal(t, 3, next.numRequests())
+}
+
+func TestHandlerMergesIntervalHeaders(t *testing.T) {
+	var (
+		now   = time.Unix(1600000000, 0).Truncate(time.Hour)
+		start = now.Add(-2 * time.Hour)
+		next  = &testRangeHandler{
+			warnings: func(s time.Time) string {
+				if s.Before(now.Add(-time.Hour)) {
+					return "first,common"
+				}
+				return "common,second"
+			},
+		}
+	)
+
+	cache, err := NewLRUCache(1 << 20)
+	require.NoError(t, err)
+	handler := newTestHandler(t, next, now, cache)
+
+	for i := 1; i <= 2; i++ {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, newTestRangeRequest(start, now, "1m"))
+		requireContiguousValues(t, recorder, start, now, time.Minute)
+		assert.Equal(t, "first,common,second",
+			recorder.Header().Get(handleroptions.WarningsHeader))
+		assert.Equal(t, xhttp.ContentTypeJSON,
+			recorder.Header().Get(xhttp.HeaderContentType))
+
+		// Results with warnings are not cached.
+		assert.Equal(t, 3*i, next.numRequests())
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"time"
+)
+
+// interval is a step aligned interval of a range query, both ends are
+// inclusive.
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+// splitRange splits a range query into intervals at multiples of the split
+// interval, so that the intervals of subsequent queries over a moving range
+// are the same and can be cached. The start and end are aligned down to the
+// step, which only leaves the results unchanged if the start is aligned
+// already: the points of the query are then the multiples of the step that
+// are not after the end.
+func splitRange(
+	start, end time.Time,
+	step, splitInterval time.Duration,
+) []interval {
+	var (
+		stepNanos  = int64(step)
+		splitNanos = int64(splitInterval)
+		startNanos = alignDown(start.UnixNano(), stepNanos)
+		endNanos   = alignDown(end.UnixNano(), stepNanos)
+		intervals  []interval
+	)
+
+	for s := startNanos; s <= endNanos; {
+		// The last point of the interval is the last step before the next
+		// split boundary.
+		boundary := alignDown(s, splitNanos) + splitNanos
+		e := alignDown(boundary-1, stepNanos)
+		if e > endNanos {
+			e = endNanos
+		}
+
+		intervals = append(intervals, interval{
+			start: time.Unix(0, s),
+			end:   time.Unix(0, e),
+		})
+		s = e + stepNanos
+	}
+
+	return intervals
+}
+
+// isAligned returns whether t is a multiple of step.
+func isAligned(t time.Time, step time.Duration) bool {
+	return alignDown(t.UnixNano(), int64(step)) == t.UnixNano()
+}
+
+// alignDown returns the greatest multiple of unit lower or equal to v.
+func alignDown(v, unit int64) int64 {
+	r := v % unit
+	if r < 0 {
+		r += unit
+	}
+	return v - r
+}
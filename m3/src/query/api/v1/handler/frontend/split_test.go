@@ -0,0 +1,59 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitRange(t *testing.T) {
+	var (
+		base = time.Unix(1600000000, 0).Truncate(time.Hour)
+		step = 15 * time.Second
+	)
+
+	intervals := splitRange(base.Add(-20*time.Minute+3*time.Second),
+		base.Add(time.Hour+10*time.Minute+7*time.Second), step, time.Hour)
+	assert.Equal(t, []interval{
+		{start: base.Add(-20 * time.Minute), end: base.Add(-step)},
+		{start: base, end: base.Add(time.Hour - step)},
+		{start: base.Add(time.Hour), end: base.Add(time.Hour + 10*time.Minute)},
+	}, intervals)
+
+	// Steps not dividing the split interval.
+	step = 7 * time.Minute
+	intervals = splitRange(base, base.Add(2*time.Hour), step, time.Hour)
+	for i, in := range intervals {
+		assert.Equal(t, int64(0), in.start.UnixNano()%int64(step))
+		assert.Equal(t, int64(0), in.end.UnixNano()%int64(step))
+		assert.False(t, in.end.Before(in.start))
+		if i > 0 {
+			assert.Equal(t, intervals[i-1].end.Add(step), in.start)
+		}
+	}
+
+	// Single point.
+	intervals = splitRange(base, base, step, time.Hour)
+	assert.Len(t, intervals, 1)
+}
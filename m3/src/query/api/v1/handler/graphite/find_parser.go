@@ -63,38 +63,9 @@ func parseFindParamsToQueries(r *http.Request) (
 			xhttp.NewParseError(errors.ErrNoQueryFound, http.StatusBadRequest)
 	}
 
-	now := time.Now()
-	fromString, untilString := r.FormValue("from"), r.FormValue("until")
-	if len(fromString) == 0 {
-		fromString = "0"
-	}
-
-	if len(untilString) == 0 {
-		untilString = "now"
-	}
-
-	from, err := graphite.ParseTime(
-		fromString,
-		now,
-		tzOffsetForAbsoluteTime,
-	)
-
-	if err != nil {
-		return nil, nil, "",
-			xhttp.NewParseError(fmt.Errorf("invalid 'from': %s", fromString),
-				http.StatusBadRequest)
-	}
-
-	until, err := graphite.ParseTime(
-		untilString,
-		now,
-		tzOffsetForAbsoluteTime,
-	)
-
-	if err != nil {
-		return nil, nil, "",
-			xhttp.NewParseError(fmt.Errorf("invalid 'until': %s", untilString),
-				http.StatusBadRequest)
+	from, until, rErr := parseFromUntil(r)
+	if rErr != nil {
+		return nil, nil, "", rErr
 	}
 
 	matchers, err := graphiteStorage.TranslateQueryToMatchersWithTerminator(query)
@@ -138,6 +109,46 @@ func parseFindParamsToQueries(r *http.Request) (
 	return terminatedQuery, childQuery, query, nil
 }
 
+// parseFromUntil parses the from and until parameters of a request, which
+// default to the unix epoch and now respectively.
+func parseFromUntil(r *http.Request) (time.Time, time.Time, *xhttp.ParseError) {
+	now := time.Now()
+	fromString, untilString := r.FormValue("from"), r.FormValue("until")
+	if len(fromString) == 0 {
+		fromString = "0"
+	}
+
+	if len(untilString) == 0 {
+		untilString = "now"
+	}
+
+	from, err := graphite.ParseTime(
+		fromString,
+		now,
+		tzOffsetForAbsoluteTime,
+	)
+
+	if err != nil {
+		return time.Time{}, time.Time{},
+			xhttp.NewParseError(fmt.Errorf("invalid 'from': %s", fromString),
+				http.StatusBadRequest)
+	}
+
+	until, err := graphite.ParseTime(
+		untilString,
+		now,
+		tzOffsetForAbsoluteTime,
+	)
+
+	if err != nil {
+		return time.Time{}, time.Time{},
+			xhttp.NewParseError(fmt.Errorf("invalid 'until': %s", untilString),
+				http.StatusBadRequest)
+	}
+
+	return from, until, nil
+}
+
 func findResultsJSON(
 	w io.Writer,
 	prefix string,
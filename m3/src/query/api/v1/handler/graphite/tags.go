@@ -0,0 +1,367 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// TagsURL is the url for listing graphite tags.
+	TagsURL = handler.RoutePrefixV1 + "/graphite/tags"
+
+	// AutoCompleteTagsURL is the url for autocompleting graphite tags.
+	AutoCompleteTagsURL = TagsURL + "/autoComplete/tags"
+
+	// AutoCompleteValuesURL is the url for autocompleting graphite tag values.
+	AutoCompleteValuesURL = TagsURL + "/autoComplete/values"
+)
+
+var (
+	// TagsHTTPMethods are the HTTP methods for the tags handlers.
+	TagsHTTPMethods = []string{http.MethodGet, http.MethodPost}
+)
+
+type graphiteTagsHandler struct {
+	storage             storage.Storage
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	instrumentOpts      instrument.Options
+}
+
+// NewTagsHandler returns a new instance of the handler listing graphite tags.
+func NewTagsHandler(opts options.HandlerOptions) http.Handler {
+	return &graphiteTagsHandler{
+		storage:             opts.Storage(),
+		fetchOptionsBuilder: opts.FetchOptionsBuilder(),
+		instrumentOpts:      opts.InstrumentOpts(),
+	}
+}
+
+func (h *graphiteTagsHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	params, rErr := parseTagsParams(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	opts, rErr := h.fetchOptionsBuilder.NewFetchOptions(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	tags, meta, err := completeTagNames(ctx, h.storage, params.query, opts)
+	if err != nil {
+		logger.Error("unable to complete tags", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	filtered := tags[:0]
+	for _, tag := range tags {
+		if params.filter == nil || params.filter.MatchString(tag) {
+			filtered = append(filtered, tag)
+		}
+	}
+
+	handleroptions.AddWarningHeaders(w, meta)
+	if err := tagsResultsJSON(w, limitResults(filtered, params.limit)); err != nil {
+		logger.Error("unable to render tags results", zap.Error(err))
+	}
+}
+
+type graphiteAutoCompleteTagsHandler struct {
+	storage             storage.Storage
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	instrumentOpts      instrument.Options
+}
+
+// NewAutoCompleteTagsHandler returns a new instance of the handler
+// autocompleting graphite tags.
+func NewAutoCompleteTagsHandler(opts options.HandlerOptions) http.Handler {
+	return &graphiteAutoCompleteTagsHandler{
+		storage:             opts.Storage(),
+		fetchOptionsBuilder: opts.FetchOptionsBuilder(),
+		instrumentOpts:      opts.InstrumentOpts(),
+	}
+}
+
+func (h *graphiteAutoCompleteTagsHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	params, rErr := parseAutoCompleteParams(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	opts, rErr := h.fetchOptionsBuilder.NewFetchOptions(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	tags, meta, err := completeTagNames(ctx, h.storage, params.query, opts)
+	if err != nil {
+		logger.Error("unable to complete tags", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	prefix := r.FormValue("tagPrefix")
+	filtered := tags[:0]
+	for _, tag := range tags {
+		// NB: tags which are already part of the expressions are not
+		// suggested again.
+		if _, used := params.exprTags[tag]; used {
+			continue
+		}
+
+		if strings.HasPrefix(tag, prefix) {
+			filtered = append(filtered, tag)
+		}
+	}
+
+	handleroptions.AddWarningHeaders(w, meta)
+	if err := autoCompleteResultsJSON(w, limitResults(filtered, params.limit)); err != nil {
+		logger.Error("unable to render autocomplete results", zap.Error(err))
+	}
+}
+
+type graphiteAutoCompleteValuesHandler struct {
+	storage             storage.Storage
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	instrumentOpts      instrument.Options
+}
+
+// NewAutoCompleteValuesHandler returns a new instance of the handler
+// autocompleting graphite tag values.
+func NewAutoCompleteValuesHandler(opts options.HandlerOptions) http.Handler {
+	return &graphiteAutoCompleteValuesHandler{
+		storage:             opts.Storage(),
+		fetchOptionsBuilder: opts.FetchOptionsBuilder(),
+		instrumentOpts:      opts.InstrumentOpts(),
+	}
+}
+
+func (h *graphiteAutoCompleteValuesHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	tag := r.FormValue("tag")
+	if tag == "" {
+		xhttp.Error(w, errNoTag, http.StatusBadRequest)
+		return
+	}
+
+	params, rErr := parseAutoCompleteParams(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	opts, rErr := h.fetchOptionsBuilder.NewFetchOptions(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	var (
+		values []string
+		meta   block.ResultMetadata
+		err    error
+	)
+
+	if tag == graphite.NameTag {
+		values, meta, err = completeNameValues(ctx, h.storage, params.query, opts)
+	} else {
+		values, meta, err = completeTagValues(ctx, h.storage, tag, params.query, opts)
+	}
+
+	if err != nil {
+		logger.Error("unable to complete tag values", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	prefix := r.FormValue("valuePrefix")
+	filtered := values[:0]
+	for _, value := range values {
+		if strings.HasPrefix(value, prefix) {
+			filtered = append(filtered, value)
+		}
+	}
+
+	handleroptions.AddWarningHeaders(w, meta)
+	if err := autoCompleteResultsJSON(w, limitResults(filtered, params.limit)); err != nil {
+		logger.Error("unable to render autocomplete results", zap.Error(err))
+	}
+}
+
+// completeTagNames returns the sorted graphite tag names of series matching
+// the query; graphite path tags are collapsed into the name tag.
+func completeTagNames(
+	ctx context.Context,
+	store storage.Storage,
+	query *storage.CompleteTagsQuery,
+	opts *storage.FetchOptions,
+) ([]string, block.ResultMetadata, error) {
+	nameQuery := *query
+	nameQuery.CompleteNameOnly = true
+	result, err := store.CompleteTags(ctx, &nameQuery, opts)
+	if err != nil {
+		return nil, block.ResultMetadata{}, err
+	}
+
+	seen := make(map[string]struct{}, len(result.CompletedTags))
+	for _, tag := range result.CompletedTags {
+		name := string(tag.Name)
+		if _, ok := graphite.TagIndex(tag.Name); ok {
+			name = graphite.NameTag
+		}
+
+		seen[name] = struct{}{}
+	}
+
+	return sortedKeys(seen), result.Metadata, nil
+}
+
+// completeTagValues returns the sorted values of the given tag for series
+// matching the query.
+func completeTagValues(
+	ctx context.Context,
+	store storage.Storage,
+	tag string,
+	query *storage.CompleteTagsQuery,
+	opts *storage.FetchOptions,
+) ([]string, block.ResultMetadata, error) {
+	valuesQuery := *query
+	valuesQuery.CompleteNameOnly = false
+	valuesQuery.FilterNameTags = [][]byte{[]byte(tag)}
+	result, err := store.CompleteTags(ctx, &valuesQuery, opts)
+	if err != nil {
+		return nil, block.ResultMetadata{}, err
+	}
+
+	seen := make(map[string]struct{})
+	for _, completed := range result.CompletedTags {
+		if string(completed.Name) != tag {
+			continue
+		}
+
+		for _, value := range completed.Values {
+			seen[string(value)] = struct{}{}
+		}
+	}
+
+	return sortedKeys(seen), result.Metadata, nil
+}
+
+// completeNameValues returns the sorted paths of series matching the query.
+// Since a path is spread over a tag per node, which can not be recombined
+// from completed tag values, the matching series are searched instead.
+func completeNameValues(
+	ctx context.Context,
+	store storage.Storage,
+	query *storage.CompleteTagsQuery,
+	opts *storage.FetchOptions,
+) ([]string, block.ResultMetadata, error) {
+	result, err := store.SearchSeries(ctx, &storage.FetchQuery{
+		TagMatchers: query.TagMatchers,
+		Start:       query.Start,
+		End:         query.End,
+	}, opts)
+	if err != nil {
+		return nil, block.ResultMetadata{}, err
+	}
+
+	seen := make(map[string]struct{}, len(result.Metrics))
+	for _, metric := range result.Metrics {
+		var nodes []string
+		for _, tag := range metric.Tags.Tags {
+			idx, ok := graphite.TagIndex(tag.Name)
+			if !ok {
+				continue
+			}
+
+			for len(nodes) <= idx {
+				nodes = append(nodes, "")
+			}
+
+			nodes[idx] = string(tag.Value)
+		}
+
+		if len(nodes) > 0 {
+			seen[strings.Join(nodes, ".")] = struct{}{}
+		}
+	}
+
+	return sortedKeys(seen), result.Metadata, nil
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+func limitResults(results []string, limit int) []string {
+	if limit > 0 && len(results) > limit {
+		return results[:limit]
+	}
+
+	return results
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	graphiteStorage "github.com/m3db/m3/src/query/graphite/storage"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/util/json"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// defaultAutoCompleteLimit is the default maximum number of autocomplete
+	// results, matching graphite's default.
+	defaultAutoCompleteLimit = 100
+)
+
+var (
+	errNoTag = errors.New("no tag found")
+)
+
+// tagsParams are the parsed parameters of a tags request.
+type tagsParams struct {
+	query  *storage.CompleteTagsQuery
+	filter *regexp.Regexp
+	limit  int
+}
+
+// parseTagsParams parses an incoming request to list graphite tags, with an
+// optional filter regexp which is anchored to the start of the tag name.
+func parseTagsParams(r *http.Request) (tagsParams, *xhttp.ParseError) {
+	query, rErr := parseTagsQuery(r, nil)
+	if rErr != nil {
+		return tagsParams{}, rErr
+	}
+
+	limit, rErr := parseResultLimit(r, 0)
+	if rErr != nil {
+		return tagsParams{}, rErr
+	}
+
+	params := tagsParams{query: query, limit: limit}
+	if filter := r.FormValue("filter"); filter != "" {
+		re, err := regexp.Compile("^(?:" + filter + ")")
+		if err != nil {
+			return tagsParams{}, xhttp.NewParseError(
+				fmt.Errorf("invalid 'filter': %s", filter), http.StatusBadRequest)
+		}
+
+		params.filter = re
+	}
+
+	return params, nil
+}
+
+// autoCompleteParams are the parsed parameters of an autocomplete request.
+type autoCompleteParams struct {
+	query    *storage.CompleteTagsQuery
+	exprTags map[string]struct{}
+	limit    int
+}
+
+// parseAutoCompleteParams parses an incoming autocomplete request, which
+// restricts results to series matching any given tag expressions.
+func parseAutoCompleteParams(r *http.Request) (autoCompleteParams, *xhttp.ParseError) {
+	limit, rErr := parseResultLimit(r, defaultAutoCompleteLimit)
+	if rErr != nil {
+		return autoCompleteParams{}, rErr
+	}
+
+	// NB: the form has been parsed by the call to FormValue above.
+	exprs := r.Form["expr"]
+	query, rErr := parseTagsQuery(r, exprs)
+	if rErr != nil {
+		return autoCompleteParams{}, rErr
+	}
+
+	exprTags := make(map[string]struct{}, len(exprs))
+	for _, e := range exprs {
+		expr, err := graphite.ParseTagExpression(e)
+		if err != nil {
+			return autoCompleteParams{}, xhttp.NewParseError(
+				fmt.Errorf("invalid 'expr': %s", e), http.StatusBadRequest)
+		}
+
+		exprTags[expr.Tag] = struct{}{}
+	}
+
+	return autoCompleteParams{
+		query:    query,
+		exprTags: exprTags,
+		limit:    limit,
+	}, nil
+}
+
+func parseTagsQuery(
+	r *http.Request,
+	exprs []string,
+) (*storage.CompleteTagsQuery, *xhttp.ParseError) {
+	from, until, rErr := parseFromUntil(r)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	matchers, err := graphiteStorage.TranslateTagExpressionsToMatchers(exprs)
+	if err != nil {
+		return nil, xhttp.NewParseError(
+			fmt.Errorf("invalid 'expr': %v", err), http.StatusBadRequest)
+	}
+
+	return &storage.CompleteTagsQuery{
+		TagMatchers: matchers,
+		Start:       from,
+		End:         until,
+	}, nil
+}
+
+func parseResultLimit(r *http.Request, defaultLimit int) (int, *xhttp.ParseError) {
+	str := r.FormValue("limit")
+	if str == "" {
+		return defaultLimit, nil
+	}
+
+	limit, err := strconv.Atoi(str)
+	if err != nil || limit < 0 {
+		return 0, xhttp.NewParseError(
+			fmt.Errorf("invalid 'limit': %s", str), http.StatusBadRequest)
+	}
+
+	return limit, nil
+}
+
+func tagsResultsJSON(w io.Writer, tags []string) error {
+	jw := json.NewWriter(w)
+	jw.BeginArray()
+
+	for _, tag := range tags {
+		jw.BeginObject()
+		jw.BeginObjectField("tag")
+		jw.WriteString(tag)
+		jw.EndObject()
+	}
+
+	jw.EndArray()
+	return jw.Close()
+}
+
+func autoCompleteResultsJSON(w io.Writer, results []string) error {
+	jw := json.NewWriter(w)
+	jw.BeginArray()
+
+	for _, result := range results {
+		jw.WriteString(result)
+	}
+
+	jw.EndArray()
+	return jw.Close()
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	xtest "github.com/m3db/m3/src/x/test"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTagsTestHandlerOptions(store storage.Storage) options.HandlerOptions {
+	builder := handleroptions.
+		NewFetchOptionsBuilder(handleroptions.FetchOptionsBuilderOptions{})
+	return options.EmptyHandlerOptions().
+		SetFetchOptionsBuilder(builder).
+		SetStorage(store)
+}
+
+func serveTagsRequest(h http.Handler, params url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/?"+params.Encode(), nil)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestTags(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			query *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.True(t, query.CompleteNameOnly)
+			assert.Equal(t, models.Matchers{
+				{Type: models.MatchField, Name: b("__g0__")},
+			}, query.TagMatchers)
+
+			return &consolidators.CompleteTagsResult{
+				CompleteNameOnly: true,
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: b("__g0__")},
+					{Name: b("__g1__")},
+					{Name: b("host")},
+					{Name: b("dc")},
+					{Name: b("env")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewTagsHandler(newTagsTestHandlerOptions(store))
+	params := make(url.Values)
+	params.Set("filter", "[dn]")
+	recorder := serveTagsRequest(h, params)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var actual []map[string]string
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actual))
+	assert.Equal(t, []map[string]string{
+		{"tag": "dc"},
+		{"tag": "name"},
+	}, actual)
+}
+
+func TestAutoCompleteTags(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			query *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			require.Equal(t, 2, len(query.TagMatchers))
+			assert.Equal(t, "dc", string(query.TagMatchers[0].Name))
+			assert.Equal(t, "east", string(query.TagMatchers[0].Value))
+
+			return &consolidators.CompleteTagsResult{
+				CompleteNameOnly: true,
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: b("__g0__")},
+					{Name: b("host")},
+					{Name: b("dc")},
+					{Name: b("hw")},
+					{Name: b("env")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewAutoCompleteTagsHandler(newTagsTestHandlerOptions(store))
+	params := make(url.Values)
+	params.Add("expr", "dc=east")
+	params.Set("tagPrefix", "h")
+	params.Set("limit", "1")
+	recorder := serveTagsRequest(h, params)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var actual []string
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actual))
+	assert.Equal(t, []string{"host"}, actual)
+}
+
+func TestAutoCompleteValues(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			query *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.False(t, query.CompleteNameOnly)
+			assert.Equal(t, bs("dc"), query.FilterNameTags)
+
+			return &consolidators.CompleteTagsResult{
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: b("dc"), Values: bs("west", "east", "eu")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewAutoCompleteValuesHandler(newTagsTestHandlerOptions(store))
+	params := make(url.Values)
+	params.Set("tag", "dc")
+	params.Set("valuePrefix", "e")
+	recorder := serveTagsRequest(h, params)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var actual []string
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actual))
+	assert.Equal(t, []string{"east", "eu"}, actual)
+}
+
+func TestAutoCompleteNameValues(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	newMetric := func(tags ...string) models.Metric {
+		t := models.NewTags(len(tags)/2, nil)
+		for i := 0; i < len(tags); i += 2 {
+			t = t.AddTag(models.Tag{Name: b(tags[i]), Value: b(tags[i+1])})
+		}
+
+		return models.Metric{Tags: t}
+	}
+
+	store.EXPECT().SearchSeries(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&storage.SearchResults{
+			Metrics: models.Metrics{
+				newMetric("__g0__", "cpu", "__g1__", "load", "dc", "east"),
+				newMetric("__g0__", "cpu", "__g1__", "idle", "dc", "east"),
+				newMetric("__g0__", "mem", "__g1__", "free", "dc", "east"),
+				newMetric("__g0__", "cpu", "__g1__", "load", "dc", "west"),
+			},
+			Metadata: block.NewResultMetadata(),
+		}, nil)
+
+	h := NewAutoCompleteValuesHandler(newTagsTestHandlerOptions(store))
+	params := make(url.Values)
+	params.Set("tag", "name")
+	params.Set("valuePrefix", "cpu")
+	recorder := serveTagsRequest(h, params)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var actual []string
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actual))
+	assert.Equal(t, []string{"cpu.idle", "cpu.load"}, actual)
+}
+
+func TestAutoCompleteInvalidParams(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	opts := newTagsTestHandlerOptions(store)
+	tests := []struct {
+		name   string
+		h      http.Handler
+		params url.Values
+	}{
+		{"no tag", NewAutoCompleteValuesHandler(opts), url.Values{}},
+		{"bad expr", NewAutoCompleteTagsHandler(opts),
+			url.Values{"expr": []string{"dc"}}},
+		{"no positive expr", NewAutoCompleteTagsHandler(opts),
+			url.Values{"expr": []string{"dc!=east"}}},
+		{"name regexp", NewAutoCompleteValuesHandler(opts),
+			url.Values{"tag": []string{"dc"}, "expr": []string{"name=~cpu.*"}}},
+		{"bad limit", NewAutoCompleteTagsHandler(opts),
+			url.Values{"limit": []string{"-1"}}},
+		{"bad filter", NewTagsHandler(opts),
+			url.Values{"filter": []string{"(dc"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := serveTagsRequest(tt.h, tt.params)
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/query/generated/proto/otlppb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+)
+
+const (
+	totalSuffix  = "_total"
+	bucketSuffix = "_bucket"
+	sumSuffix    = "_sum"
+	countSuffix  = "_count"
+
+	bucketLabel   = "le"
+	quantileLabel = "quantile"
+)
+
+// series is a single datapoint of a series converted from OTLP.
+type series struct {
+	tags       models.Tags
+	datapoint  ts.Datapoint
+	attributes ts.SeriesAttributes
+}
+
+// conversionResult is the result of converting an OTLP export request.
+type conversionResult struct {
+	series []series
+	// unsupported are the names of the metrics with an unsupported data type,
+	// e.g. exponential histograms, which are dropped.
+	unsupported []string
+}
+
+type converter struct {
+	tagOpts models.TagOptions
+	nowFn   func() time.Time
+	result  conversionResult
+}
+
+// convertRequest maps the metrics of an OTLP export request onto M3 series,
+// following the OpenTelemetry to Prometheus compatibility conventions. Gauges
+// and non monotonic sums map to a series named after the metric, monotonic
+// sums to a series with the _total suffix. Histograms map to cumulative
+// _bucket series with a le tag, a _sum and a _count series, summaries to
+// series with a quantile tag, a _sum and a _count series.
+// Cumulative values are written as gauges, as with Prometheus remote write,
+// while delta values are written as counters so that downsampling sums them.
+// Resource and data point attributes become tags, the latter taking precedence.
+func convertRequest(
+	req *otlppb.ExportMetricsServiceRequest,
+	tagOpts models.TagOptions,
+	nowFn func() time.Time,
+) conversionResult {
+	c := &converter{tagOpts: tagOpts, nowFn: nowFn}
+	for _, rm := range req.ResourceMetrics {
+		var resourceAttrs []otlppb.KeyValue
+		if rm.Resource != nil {
+			resourceAttrs = rm.Resource.Attributes
+		}
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				c.convertMetric(resourceAttrs, m)
+			}
+		}
+		// NB: older SDKs send the scope metrics in the deprecated
+		// instrumentation library field.
+		for _, sm := range rm.InstrumentationLibraryMetrics {
+			for _, m := range sm.Metrics {
+				c.convertMetric(resourceAttrs, m)
+			}
+		}
+	}
+	return c.result
+}
+
+func (c *converter) convertMetric(resourceAttrs []otlppb.KeyValue, m otlppb.Metric) {
+	name := sanitizeMetricName(m.Name)
+	switch data := m.Data.(type) {
+	case *otlppb.Metric_Gauge:
+		for _, dp := range data.Gauge.DataPoints {
+			if noRecordedValue(dp.Flags) {
+				continue
+			}
+			attrs := c.tags(resourceAttrs, dp.Attributes)
+			c.add(attrs, name, nil, dp.TimeUnixNano, numberValue(dp), ts.MetricTypeGauge)
+		}
+	case *otlppb.Metric_Sum:
+		metricType := metricTypeOf(data.Sum.AggregationTemporality)
+		if data.Sum.IsMonotonic && !strings.HasSuffix(name, totalSuffix) {
+			name += totalSuffix
+		}
+		for _, dp := range data.Sum.DataPoints {
+			if noRecordedValue(dp.Flags) {
+				continue
+			}
+			attrs := c.tags(resourceAttrs, dp.Attributes)
+			c.add(attrs, name, nil, dp.TimeUnixNano, numberValue(dp), metricType)
+		}
+	case *otlppb.Metric_Histogram:
+		metricType := metricTypeOf(data.Histogram.AggregationTemporality)
+		for _, dp := range data.Histogram.DataPoints {
+			if noRecordedValue(dp.Flags) {
+				continue
+			}
+			c.addHistogram(c.tags(resourceAttrs, dp.Attributes), name, dp, metricType)
+		}
+	case *otlppb.Metric_Summary:
+		for _, dp := range data.Summary.DataPoints {
+			if noRecordedValue(dp.Flags) {
+				continue
+			}
+			c.addSummary(c.tags(resourceAttrs, dp.Attributes), name, dp)
+		}
+	default:
+		c.result.unsupported = append(c.result.unsupported, m.Name)
+	}
+}
+
+func (c *converter) addHistogram(
+	attrs []models.Tag,
+	name string,
+	dp otlppb.HistogramDataPoint,
+	metricType ts.MetricType,
+) {
+	// NB: OTLP bucket counts are per bucket while Prometheus buckets are
+	// cumulative, the last bucket count is the +Inf bucket.
+	var cumulative uint64
+	for i, count := range dp.BucketCounts {
+		cumulative += count
+		bound := math.Inf(1)
+		if i < len(dp.ExplicitBounds) {
+			bound = dp.ExplicitBounds[i]
+		}
+		le := models.Tag{
+			Name:  []byte(bucketLabel),
+			Value: []byte(formatFloat(bound)),
+		}
+		c.add(attrs, name+bucketSuffix, &le, dp.TimeUnixNano,
+			float64(cumulative), metricType)
+	}
+	if _, ok := dp.SumValue.(*otlppb.HistogramDataPoint_Sum); ok {
+		c.add(attrs, name+sumSuffix, nil, dp.TimeUnixNano, dp.GetSum(), metricType)
+	}
+	c.add(attrs, name+countSuffix, nil, dp.TimeUnixNano, float64(dp.Count), metricType)
+}
+
+func (c *converter) addSummary(
+	attrs []models.Tag,
+	name string,
+	dp otlppb.SummaryDataPoint,
+) {
+	for _, q := range dp.QuantileValues {
+		quantile := models.Tag{
+			Name:  []byte(quantileLabel),
+			Value: []byte(formatFloat(q.Quantile)),
+		}
+		c.add(attrs, name, &quantile, dp.TimeUnixNano, q.Value, ts.MetricTypeGauge)
+	}
+	c.add(attrs, name+sumSuffix, nil, dp.TimeUnixNano, dp.Sum, ts.MetricTypeGauge)
+	c.add(attrs, name+countSuffix, nil, dp.TimeUnixNano, float64(dp.Count), ts.MetricTypeGauge)
+}
+
+func (c *converter) add(
+	attrs []models.Tag,
+	name string,
+	extra *models.Tag,
+	timeUnixNano uint64,
+	value float64,
+	metricType ts.MetricType,
+) {
+	tags := models.NewTags(len(attrs)+2, c.tagOpts)
+	for _, tag := range attrs {
+		if extra != nil && string(tag.Name) == string(extra.Name) {
+			continue
+		}
+		tags = tags.AddTagWithoutNormalizing(tag)
+	}
+	if extra != nil {
+		tags = tags.AddTagWithoutNormalizing(*extra)
+	}
+	tags = tags.AddTagWithoutNormalizing(models.Tag{
+		Name:  c.tagOpts.MetricName(),
+		Value: []byte(name),
+	}).Normalize()
+
+	c.result.series = append(c.result.series, series{
+		tags: tags,
+		datapoint: ts.Datapoint{
+			Timestamp: c.timestamp(timeUnixNano),
+			Value:     value,
+		},
+		attributes: ts.SeriesAttributes{
+			Type:   metricType,
+			Source: ts.SourceTypePrometheus,
+		},
+	})
+}
+
+// tags returns the tags of a data point, the sanitized resource attributes
+// overridden by the sanitized data point attributes.
+func (c *converter) tags(resourceAttrs, attrs []otlppb.KeyValue) []models.Tag {
+	values := make(map[string]string, len(resourceAttrs)+len(attrs))
+	for _, kv := range resourceAttrs {
+		values[sanitizeLabelName(kv.Key)] = anyValueString(kv.Value)
+	}
+	for _, kv := range attrs {
+		values[sanitizeLabelName(kv.Key)] = anyValueString(kv.Value)
+	}
+
+	tags := make([]models.Tag, 0, len(values))
+	metricName := string(c.tagOpts.MetricName())
+	for name, value := range values {
+		if name == metricName || value == "" {
+			continue
+		}
+		tags = append(tags, models.Tag{Name: []byte(name), Value: []byte(value)})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return string(tags[i].Name) < string(tags[j].Name)
+	})
+	return tags
+}
+
+// timestamp returns the time of a data point at millisecond precision, which
+// is the precision series are written with.
+func (c *converter) timestamp(timeUnixNano uint64) time.Time {
+	if timeUnixNano == 0 {
+		return c.nowFn().Truncate(time.Millisecond)
+	}
+	return time.Unix(0, int64(timeUnixNano)).Truncate(time.Millisecond)
+}
+
+func metricTypeOf(temporality otlppb.AggregationTemporality) ts.MetricType {
+	if temporality == otlppb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA {
+		return ts.MetricTypeCounter
+	}
+	return ts.MetricTypeGauge
+}
+
+func noRecordedValue(flags uint32) bool {
+	mask := uint32(otlppb.DataPointFlags_DATA_POINT_FLAGS_NO_RECORDED_VALUE_MASK)
+	return flags&mask != 0
+}
+
+func numberValue(dp otlppb.NumberDataPoint) float64 {
+	if v, ok := dp.Value.(*otlppb.NumberDataPoint_AsInt); ok {
+		return float64(v.AsInt)
+	}
+	return dp.GetAsDouble()
+}
+
+func formatFloat(v float64) string {
+	if math.IsInf(v, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// anyValueString returns the tag value of an attribute value, arrays and
+// key value lists are JSON encoded.
+func anyValueString(v *otlppb.AnyValue) string {
+	if v == nil {
+		return ""
+	}
+	switch value := v.Value.(type) {
+	case *otlppb.AnyValue_StringValue:
+		return value.StringValue
+	case *otlppb.AnyValue_BoolValue:
+		return strconv.FormatBool(value.BoolValue)
+	case *otlppb.AnyValue_IntValue:
+		return strconv.FormatInt(value.IntValue, 10)
+	case *otlppb.AnyValue_DoubleValue:
+		return strconv.FormatFloat(value.DoubleValue, 'g', -1, 64)
+	case *otlppb.AnyValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(value.BytesValue)
+	case *otlppb.AnyValue_ArrayValue, *otlppb.AnyValue_KvlistValue:
+		b, err := json.Marshal(anyValueJSON(v))
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	default:
+		return ""
+	}
+}
+
+func anyValueJSON(v *otlppb.AnyValue) interface{} {
+	switch value := v.Value.(type) {
+	case *otlppb.AnyValue_StringValue:
+		return value.StringValue
+	case *otlppb.AnyValue_BoolValue:
+		return value.BoolValue
+	case *otlppb.AnyValue_IntValue:
+		return value.IntValue
+	case *otlppb.AnyValue_DoubleValue:
+		return value.DoubleValue
+	case *otlppb.AnyValue_BytesValue:
+		return value.BytesValue
+	case *otlppb.AnyValue_ArrayValue:
+		values := make([]interface{}, 0, len(value.ArrayValue.Values))
+		for i := range value.ArrayValue.Values {
+			values = append(values, anyValueJSON(&value.ArrayValue.Values[i]))
+		}
+		return values
+	case *otlppb.AnyValue_KvlistValue:
+		values := make(map[string]interface{}, len(value.KvlistValue.Values))
+		for _, kv := range value.KvlistValue.Values {
+			if kv.Value != nil {
+				values[kv.Key] = anyValueJSON(kv.Value)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// sanitizeMetricName replaces the characters not allowed in Prometheus metric
+// names with underscores.
+func sanitizeMetricName(name string) string {
+	return sanitize(name, func(r rune, first bool) bool {
+		return r == '_' || r == ':' || isLetter(r) || (!first && isDigit(r))
+	})
+}
+
+// sanitizeLabelName replaces the characters not allowed in Prometheus label
+// names with underscores, e.g. service.name becomes service_name.
+func sanitizeLabelName(name string) string {
+	return sanitize(name, func(r rune, first bool) bool {
+		return r == '_' || isLetter(r) || (!first && isDigit(r))
+	})
+}
+
+func sanitize(name string, valid func(r rune, first bool) bool) string {
+	if name == "" {
+		return name
+	}
+	var b strings.Builder
+	b.Grow(len(name) + 1)
+	for i, r := range name {
+		switch {
+		case valid(r, i == 0):
+			b.WriteRune(r)
+		case i == 0 && isDigit(r):
+			// Prefix rather than replace leading digits to keep them.
+			b.WriteByte('_')
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+func isLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,372 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package otlp ingests metrics sent with the OpenTelemetry protocol (OTLP)
+// over HTTP.
+package otlp
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/generated/proto/otlppb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	// WriteURL is the url for the OTLP/HTTP metrics write handler, the
+	// default path OTLP/HTTP exporters send metrics to.
+	WriteURL = "/v1/metrics"
+
+	// WriteHTTPMethod is the HTTP method used with this resource.
+	WriteHTTPMethod = http.MethodPost
+
+	// maxRequestBodySize is the maximum size of a request body as sent,
+	// larger requests are rejected before being read in full.
+	maxRequestBodySize = 32 << 20
+
+	// maxDecodedBodySize is the maximum size of a request body once
+	// decompressed, which guards against gzip bombs.
+	maxDecodedBodySize = 128 << 20
+)
+
+var (
+	errNoDownsamplerAndWriter = errors.New("no downsampler and writer set")
+	errNoTagOptions           = errors.New("no tag options set")
+	errNoNowFn                = errors.New("no now fn set")
+	errEmptyBody              = errors.New("empty request body")
+	errBodyTooLarge           = fmt.Errorf("request body too large, max is %d bytes", maxRequestBodySize)
+	errDecodedBodyTooLarge    = fmt.Errorf("decompressed request body too large, max is %d bytes", maxDecodedBodySize)
+
+	defaultValue = ingest.IterValue{
+		Tags:       models.EmptyTags(),
+		Attributes: ts.DefaultSeriesAttributes(),
+		Metadata:   ts.Metadata{},
+	}
+)
+
+// WriteHandler is the handler for OTLP/HTTP metrics exports.
+type WriteHandler struct {
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	tagOptions           models.TagOptions
+	nowFn                clock.NowFn
+	instrumentOpts       instrument.Options
+	metrics              writeMetrics
+}
+
+// NewWriteHandler returns a new OTLP/HTTP metrics write handler.
+func NewWriteHandler(opts options.HandlerOptions) (http.Handler, error) {
+	var (
+		downsamplerAndWriter = opts.DownsamplerAndWriter()
+		tagOptions           = opts.TagOptions()
+		nowFn                = opts.NowFn()
+		instrumentOpts       = opts.InstrumentOpts()
+	)
+
+	if downsamplerAndWriter == nil {
+		return nil, errNoDownsamplerAndWriter
+	}
+
+	if tagOptions == nil {
+		return nil, errNoTagOptions
+	}
+
+	if nowFn == nil {
+		return nil, errNoNowFn
+	}
+
+	scope := instrumentOpts.MetricsScope().
+		Tagged(map[string]string{"handler": "otlp-write"})
+	return &WriteHandler{
+		downsamplerAndWriter: downsamplerAndWriter,
+		tagOptions:           tagOptions,
+		nowFn:                nowFn,
+		instrumentOpts:       instrumentOpts,
+		metrics:              newWriteMetrics(scope),
+	}, nil
+}
+
+type writeMetrics struct {
+	writeSuccess      tally.Counter
+	writeErrorsServer tally.Counter
+	writeErrorsClient tally.Counter
+	datapoints        tally.Counter
+	unsupported       tally.Counter
+}
+
+func newWriteMetrics(scope tally.Scope) writeMetrics {
+	return writeMetrics{
+		writeSuccess:      scope.SubScope("write").Counter("success"),
+		writeErrorsServer: scope.SubScope("write").Tagged(map[string]string{"code": "5XX"}).Counter("errors"),
+		writeErrorsClient: scope.SubScope("write").Tagged(map[string]string{"code": "4XX"}).Counter("errors"),
+		datapoints:        scope.SubScope("write").Counter("datapoints"),
+		unsupported:       scope.SubScope("write").Counter("unsupported-metrics"),
+	}
+}
+
+func (h *WriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, rErr := parseRequest(w, r)
+	if rErr != nil {
+		h.metrics.writeErrorsClient.Inc(1)
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	result := convertRequest(req, h.tagOptions, h.nowFn)
+	h.metrics.datapoints.Inc(int64(len(result.series)))
+	h.metrics.unsupported.Inc(int64(len(result.unsupported)))
+
+	iter := newSeriesIter(result.series)
+	batchErr := h.downsamplerAndWriter.WriteBatch(r.Context(), iter, ingest.WriteOptions{})
+	if batchErr != nil {
+		h.writeError(w, r, batchErr)
+		return
+	}
+
+	var resp otlppb.ExportMetricsServiceResponse
+	if len(result.unsupported) > 0 {
+		// NB: a partial success without rejected data points is a warning,
+		// the number of data points of unsupported metrics is unknown since
+		// their data is skipped when decoding.
+		resp.PartialSuccess = &otlppb.ExportMetricsPartialSuccess{
+			ErrorMessage: fmt.Sprintf("unsupported metric data type, dropped metrics: %s",
+				strings.Join(result.unsupported, ", ")),
+		}
+	}
+
+	body, err := proto.Marshal(&resp)
+	if err != nil {
+		h.metrics.writeErrorsServer.Inc(1)
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+	h.metrics.writeSuccess.Inc(1)
+}
+
+func (h *WriteHandler) writeError(
+	w http.ResponseWriter,
+	r *http.Request,
+	batchErr ingest.BatchError,
+) {
+	var (
+		errs              = batchErr.Errors()
+		lastRegularErr    string
+		lastBadRequestErr string
+		numRegular        int
+		numBadRequest     int
+	)
+	for _, err := range errs {
+		switch {
+		case client.IsBadRequestError(err):
+			numBadRequest++
+			lastBadRequestErr = err.Error()
+		case xerrors.IsInvalidParams(err):
+			numBadRequest++
+			lastBadRequestErr = err.Error()
+		default:
+			numRegular++
+			lastRegularErr = err.Error()
+		}
+	}
+
+	var status int
+	switch {
+	case numBadRequest == len(errs):
+		status = http.StatusBadRequest
+		h.metrics.writeErrorsClient.Inc(1)
+	default:
+		status = http.StatusInternalServerError
+		h.metrics.writeErrorsServer.Inc(1)
+	}
+
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+	logger.Error("write error",
+		zap.String("remoteAddr", r.RemoteAddr),
+		zap.Int("httpResponseStatusCode", status),
+		zap.Int("numRegularErrors", numRegular),
+		zap.Int("numBadRequestErrors", numBadRequest),
+		zap.String("lastRegularError", lastRegularErr),
+		zap.String("lastBadRequestErr", lastBadRequestErr))
+
+	var resultErr string
+	if lastRegularErr != "" {
+		resultErr = fmt.Sprintf("retryable_errors: count=%d, last=%s",
+			numRegular, lastRegularErr)
+	}
+	if lastBadRequestErr != "" {
+		var sep string
+		if lastRegularErr != "" {
+			sep = ", "
+		}
+		resultErr = fmt.Sprintf("%s%sbad_request_errors: count=%d, last=%s",
+			resultErr, sep, numBadRequest, lastBadRequestErr)
+	}
+	xhttp.Error(w, errors.New(resultErr), status)
+}
+
+// parseRequest decodes the OTLP export request of the request body, only the
+// binary protobuf encoding is supported. Both the body as sent and once
+// decompressed are size limited.
+func parseRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+) (*otlppb.ExportMetricsServiceRequest, *xhttp.ParseError) {
+	if v := r.Header.Get(xhttp.HeaderContentType); v != "" {
+		contentType, _, err := mime.ParseMediaType(v)
+		if err != nil {
+			return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+		}
+		if contentType != xhttp.ContentTypeProtobuf {
+			err := fmt.Errorf("unsupported content type %s, expected %s",
+				contentType, xhttp.ContentTypeProtobuf)
+			return nil, xhttp.NewParseError(err, http.StatusUnsupportedMediaType)
+		}
+	}
+
+	if r.Body == nil {
+		return nil, xhttp.NewParseError(errEmptyBody, http.StatusBadRequest)
+	}
+	defer r.Body.Close()
+	if r.ContentLength > maxRequestBodySize {
+		return nil, xhttp.NewParseError(errBodyTooLarge, http.StatusRequestEntityTooLarge)
+	}
+
+	var (
+		limited           = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+		body    io.Reader = limited
+	)
+	switch encoding := r.Header.Get("Content-Encoding"); encoding {
+	case "", "identity":
+	case "gzip":
+		gr, err := gzip.NewReader(limited)
+		if err != nil {
+			if isMaxBytesError(err) {
+				return nil, xhttp.NewParseError(errBodyTooLarge, http.StatusRequestEntityTooLarge)
+			}
+			return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+		}
+		defer gr.Close()
+		body = gr
+	default:
+		err := fmt.Errorf("unsupported content encoding: %s", encoding)
+		return nil, xhttp.NewParseError(err, http.StatusUnsupportedMediaType)
+	}
+
+	// NB: read one byte past the limit to tell a body of exactly the limit
+	// apart from a larger one.
+	data, err := ioutil.ReadAll(io.LimitReader(body, maxDecodedBodySize+1))
+	if err != nil {
+		if isMaxBytesError(err) {
+			return nil, xhttp.NewParseError(errBodyTooLarge, http.StatusRequestEntityTooLarge)
+		}
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+	if len(data) > maxDecodedBodySize {
+		return nil, xhttp.NewParseError(errDecodedBodyTooLarge, http.StatusRequestEntityTooLarge)
+	}
+
+	var req otlppb.ExportMetricsServiceRequest
+	if err := proto.Unmarshal(data, &req); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+	return &req, nil
+}
+
+// isMaxBytesError returns whether the error is the result of reading past
+// the limit of a http.MaxBytesReader.
+func isMaxBytesError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "http: request body too large")
+}
+
+type seriesIter struct {
+	idx       int
+	series    []series
+	metadatas []ts.Metadata
+}
+
+func newSeriesIter(series []series) *seriesIter {
+	return &seriesIter{idx: -1, series: series}
+}
+
+func (i *seriesIter) Next() bool {
+	i.idx++
+	return i.idx < len(i.series)
+}
+
+func (i *seriesIter) Current() ingest.IterValue {
+	if i.idx < 0 || i.idx >= len(i.series) {
+		return defaultValue
+	}
+
+	s := i.series[i.idx]
+	value := ingest.IterValue{
+		Tags:       s.tags,
+		Datapoints: ts.Datapoints{s.datapoint},
+		Attributes: s.attributes,
+		Unit:       xtime.Millisecond,
+	}
+	if i.idx < len(i.metadatas) {
+		value.Metadata = i.metadatas[i.idx]
+	}
+	return value
+}
+
+func (i *seriesIter) Reset() error {
+	i.idx = -1
+	return nil
+}
+
+func (i *seriesIter) Error() error {
+	return nil
+}
+
+func (i *seriesIter) SetCurrentMetadata(metadata ts.Metadata) {
+	if len(i.metadatas) == 0 {
+		i.metadatas = make([]ts.Metadata, len(i.series))
+	}
+	if i.idx < 0 || i.idx >= len(i.metadatas) {
+		return
+	}
+	i.metadatas[i.idx] = metadata
+}
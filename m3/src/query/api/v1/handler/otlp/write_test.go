@@ -0,0 +1,434 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/generated/proto/otlppb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTime = time.Unix(1600000000, 123456789)
+
+type writtenSeries struct {
+	tags       map[string]string
+	value      float64
+	timestamp  time.Time
+	metricType ts.MetricType
+}
+
+func stringAttr(key, value string) otlppb.KeyValue {
+	return otlppb.KeyValue{
+		Key:   key,
+		Value: &otlppb.AnyValue{Value: &otlppb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func newTestRequest(metrics ...otlppb.Metric) *otlppb.ExportMetricsServiceRequest {
+	return &otlppb.ExportMetricsServiceRequest{
+		ResourceMetrics: []otlppb.ResourceMetrics{
+			{
+				Resource: &otlppb.Resource{
+					Attributes: []otlppb.KeyValue{
+						stringAttr("service.name", "checkout"),
+						stringAttr("host", "resource-host"),
+					},
+				},
+				ScopeMetrics: []otlppb.ScopeMetrics{{Metrics: metrics}},
+			},
+		},
+	}
+}
+
+func convertTestRequest(req *otlppb.ExportMetricsServiceRequest) []writtenSeries {
+	result := convertRequest(req, models.NewTagOptions(), time.Now)
+	written := make([]writtenSeries, 0, len(result.series))
+	for _, s := range result.series {
+		tags := make(map[string]string, s.tags.Len())
+		for _, tag := range s.tags.Tags {
+			tags[string(tag.Name)] = string(tag.Value)
+		}
+		written = append(written, writtenSeries{
+			tags:       tags,
+			value:      s.datapoint.Value,
+			timestamp:  s.datapoint.Timestamp,
+			metricType: s.attributes.Type,
+		})
+	}
+	return written
+}
+
+func TestConvertGaugeAndSum(t *testing.T) {
+	ns := uint64(testTime.UnixNano())
+	req := newTestRequest(
+		otlppb.Metric{
+			Name: "process.memory.usage",
+			Data: &otlppb.Metric_Gauge{Gauge: &otlppb.Gauge{
+				DataPoints: []otlppb.NumberDataPoint{
+					{
+						Attributes:   []otlppb.KeyValue{stringAttr("host", "point-host")},
+						TimeUnixNano: ns,
+						Value:        &otlppb.NumberDataPoint_AsDouble{AsDouble: 42.5},
+					},
+					{
+						TimeUnixNano: ns,
+						Flags:        uint32(otlppb.DataPointFlags_DATA_POINT_FLAGS_NO_RECORDED_VALUE_MASK),
+					},
+				},
+			}},
+		},
+		otlppb.Metric{
+			Name: "http.requests",
+			Data: &otlppb.Metric_Sum{Sum: &otlppb.Sum{
+				IsMonotonic:            true,
+				AggregationTemporality: otlppb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				DataPoints: []otlppb.NumberDataPoint{
+					{TimeUnixNano: ns, Value: &otlppb.NumberDataPoint_AsInt{AsInt: 10}},
+				},
+			}},
+		},
+		otlppb.Metric{
+			Name: "queue.delta",
+			Data: &otlppb.Metric_Sum{Sum: &otlppb.Sum{
+				AggregationTemporality: otlppb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				DataPoints: []otlppb.NumberDataPoint{
+					{TimeUnixNano: ns, Value: &otlppb.NumberDataPoint_AsInt{AsInt: 3}},
+				},
+			}},
+		},
+	)
+
+	expectedTime := time.Unix(1600000000, 123000000)
+	assert.Equal(t, []writtenSeries{
+		{
+			tags: map[string]string{
+				"__name__":     "process_memory_usage",
+				"service_name": "checkout",
+				"host":         "point-host",
+			},
+			value:      42.5,
+			timestamp:  expectedTime,
+			metricType: ts.MetricTypeGauge,
+		},
+		{
+			tags: map[string]string{
+				"__name__":     "http_requests_total",
+				"service_name": "checkout",
+				"host":         "resource-host",
+			},
+			value:      10,
+			timestamp:  expectedTime,
+			metricType: ts.MetricTypeGauge,
+		},
+		{
+			tags: map[string]string{
+				"__name__":     "queue_delta",
+				"service_name": "checkout",
+				"host":         "resource-host",
+			},
+			value:      3,
+			timestamp:  expectedTime,
+			metricType: ts.MetricTypeCounter,
+		},
+	}, convertTestRequest(req))
+}
+
+func TestConvertHistogram(t *testing.T) {
+	ns := uint64(testTime.UnixNano())
+	req := newTestRequest(otlppb.Metric{
+		Name: "rpc.duration",
+		Data: &otlppb.Metric_Histogram{Histogram: &otlppb.Histogram{
+			AggregationTemporality: otlppb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			DataPoints: []otlppb.HistogramDataPoint{
+				{
+					TimeUnixNano:   ns,
+					Count:          6,
+					SumValue:       &otlppb.HistogramDataPoint_Sum{Sum: 12.5},
+					BucketCounts:   []uint64{1, 2, 3},
+					ExplicitBounds: []float64{0.5, 1},
+				},
+			},
+		}},
+	})
+
+	type bucket struct {
+		name, le string
+		value    float64
+	}
+	var actual []bucket
+	for _, s := range convertTestRequest(req) {
+		assert.Equal(t, ts.MetricTypeGauge, s.metricType)
+		assert.Equal(t, "checkout", s.tags["service_name"])
+		actual = append(actual, bucket{name: s.tags["__name__"], le: s.tags["le"], value: s.value})
+	}
+	assert.Equal(t, []bucket{
+		{name: "rpc_duration_bucket", le: "0.5", value: 1},
+		{name: "rpc_duration_bucket", le: "1", value: 3},
+		{name: "rpc_duration_bucket", le: "+Inf", value: 6},
+		{name: "rpc_duration_sum", value: 12.5},
+		{name: "rpc_duration_count", value: 6},
+	}, actual)
+}
+
+func TestConvertSummary(t *testing.T) {
+	req := newTestRequest(otlppb.Metric{
+		Name: "latency",
+		Data: &otlppb.Metric_Summary{Summary: &otlppb.Summary{
+			DataPoints: []otlppb.SummaryDataPoint{
+				{
+					TimeUnixNano: uint64(testTime.UnixNano()),
+					Count:        4,
+					Sum:          8,
+					QuantileValues: []otlppb.SummaryDataPoint_ValueAtQuantile{
+						{Quantile: 0.5, Value: 1.5},
+						{Quantile: 0.99, Value: 3},
+					},
+				},
+			},
+		}},
+	})
+
+	type quantile struct {
+		name, quantile string
+		value          float64
+	}
+	var actual []quantile
+	for _, s := range convertTestRequest(req) {
+		actual = append(actual, quantile{
+			name:     s.tags["__name__"],
+			quantile: s.tags["quantile"],
+			value:    s.value,
+		})
+	}
+	assert.Equal(t, []quantile{
+		{name: "latency", quantile: "0.5", value: 1.5},
+		{name: "latency", quantile: "0.99", value: 3},
+		{name: "latency_sum", value: 8},
+		{name: "latency_count", value: 4},
+	}, actual)
+}
+
+func TestConvertUnsupported(t *testing.T) {
+	req := newTestRequest(otlppb.Metric{Name: "exponential"})
+	result := convertRequest(req, models.NewTagOptions(), time.Now)
+	assert.Empty(t, result.series)
+	assert.Equal(t, []string{"exponential"}, result.unsupported)
+}
+
+func TestSanitizeNames(t *testing.T) {
+	assert.Equal(t, "http_server_duration", sanitizeMetricName("http.server.duration"))
+	assert.Equal(t, "ns:requests", sanitizeMetricName("ns:requests"))
+	assert.Equal(t, "_1xx", sanitizeMetricName("1xx"))
+	assert.Equal(t, "k8s_pod_name", sanitizeLabelName("k8s.pod.name"))
+	assert.Equal(t, "a_b", sanitizeLabelName("a:b"))
+}
+
+func newTestHandler(t *testing.T, ds ingest.DownsamplerAndWriter) http.Handler {
+	opts := options.EmptyHandlerOptions().
+		SetNowFn(time.Now).
+		SetDownsamplerAndWriter(ds).
+		SetTagOptions(models.NewTagOptions())
+	handler, err := NewWriteHandler(opts)
+	require.NoError(t, err)
+	return handler
+}
+
+func newTestHTTPRequest(t *testing.T, req *otlppb.ExportMetricsServiceRequest, gzipped bool) *http.Request {
+	data, err := proto.Marshal(req)
+	require.NoError(t, err)
+
+	if gzipped {
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		_, err := gw.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, gw.Close())
+		data = buf.Bytes()
+	}
+
+	r := httptest.NewRequest(WriteHTTPMethod, WriteURL, bytes.NewReader(data))
+	r.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+	if gzipped {
+		r.Header.Set("Content-Encoding", "gzip")
+	}
+	return r
+}
+
+func TestWrite(t *testing.T) {
+	for _, gzipped := range []bool{false, true} {
+		ctrl := gomock.NewController(t)
+
+		var written []ingest.IterValue
+		mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+		mockDownsamplerAndWriter.EXPECT().
+			WriteBatch(gomock.Any(), gomock.Any(), ingest.WriteOptions{}).
+			DoAndReturn(func(
+				_ context.Context,
+				iter ingest.DownsampleAndWriteIter,
+				_ ingest.WriteOptions,
+			) ingest.BatchError {
+				for iter.Next() {
+					written = append(written, iter.Current())
+				}
+				return nil
+			})
+
+		req := newTestRequest(
+			otlppb.Metric{
+				Name: "up",
+				Data: &otlppb.Metric_Gauge{Gauge: &otlppb.Gauge{
+					DataPoints: []otlppb.NumberDataPoint{
+						{
+							TimeUnixNano: uint64(testTime.UnixNano()),
+							Value:        &otlppb.NumberDataPoint_AsDouble{AsDouble: 1},
+						},
+					},
+				}},
+			},
+			otlppb.Metric{Name: "exponential"},
+		)
+
+		w := httptest.NewRecorder()
+		newTestHandler(t, mockDownsamplerAndWriter).
+			ServeHTTP(w, newTestHTTPRequest(t, req, gzipped))
+
+		resp := w.Result()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, xhttp.ContentTypeProtobuf, resp.Header.Get(xhttp.HeaderContentType))
+
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		var exportResp otlppb.ExportMetricsServiceResponse
+		require.NoError(t, proto.Unmarshal(body, &exportResp))
+		require.NotNil(t, exportResp.PartialSuccess)
+		assert.Equal(t, int64(0), exportResp.PartialSuccess.RejectedDataPoints)
+		assert.Contains(t, exportResp.PartialSuccess.ErrorMessage, "exponential")
+
+		require.Len(t, written, 1)
+		name, ok := written[0].Tags.Name()
+		require.True(t, ok)
+		assert.Equal(t, "up", string(name))
+		assert.Equal(t, ts.Datapoints{{Timestamp: time.Unix(1600000000, 123000000), Value: 1}},
+			written[0].Datapoints)
+
+		ctrl.Finish()
+	}
+}
+
+func TestWriteUnsupportedContentType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := httptest.NewRequest(WriteHTTPMethod, WriteURL, bytes.NewReader([]byte("{}")))
+	r.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	w := httptest.NewRecorder()
+	newTestHandler(t, ingest.NewMockDownsamplerAndWriter(ctrl)).ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Result().StatusCode)
+}
+
+func TestWriteInvalidBody(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := httptest.NewRequest(WriteHTTPMethod, WriteURL, bytes.NewReader([]byte{0xff, 0xff}))
+	r.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+
+	w := httptest.NewRecorder()
+	newTestHandler(t, ingest.NewMockDownsamplerAndWriter(ctrl)).ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func TestWriteBodyTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, contentLength := range []int64{maxRequestBodySize + 1, -1} {
+		body := bytes.NewReader(make([]byte, maxRequestBodySize+1))
+		r := httptest.NewRequest(WriteHTTPMethod, WriteURL, body)
+		r.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+		r.ContentLength = contentLength
+
+		w := httptest.NewRecorder()
+		newTestHandler(t, ingest.NewMockDownsamplerAndWriter(ctrl)).ServeHTTP(w, r)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Result().StatusCode)
+	}
+}
+
+func TestWriteDecodedBodyTooLarge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err := gw.Write(make([]byte, maxDecodedBodySize+1))
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+	require.True(t, buf.Len() < maxRequestBodySize)
+
+	r := httptest.NewRequest(WriteHTTPMethod, WriteURL, &buf)
+	r.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+	r.Header.Set("Content-Encoding", "gzip")
+
+	w := httptest.NewRecorder()
+	newTestHandler(t, ingest.NewMockDownsamplerAndWriter(ctrl)).ServeHTTP(w, r)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Result().StatusCode)
+}
+
+func TestWriteError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	batchErr := ingest.BatchError(xerrors.NewMultiError().Add(errors.New("an error")))
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(batchErr)
+
+	w := httptest.NewRecorder()
+	newTestHandler(t, mockDownsamplerAndWriter).
+		ServeHTTP(w, newTestHTTPRequest(t, newTestRequest(), false))
+
+	resp := w.Result()
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "an error")
+}
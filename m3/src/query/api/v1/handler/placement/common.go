@@ -154,6 +154,24 @@ func ServiceWithAlgo(
 	}
 
 	sid := opts.ServiceID()
+	pOpts := NewPlacementOptions(opts, now, validationFn)
+	ps, err := cs.PlacementService(sid, pOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	alg := algo.NewAlgorithm(pOpts)
+
+	return ps, alg, nil
+}
+
+// NewPlacementOptions returns the placement options used to update the
+// placement of a service.
+func NewPlacementOptions(
+	opts handleroptions.ServiceOptions,
+	now time.Time,
+	validationFn placement.ValidateFn,
+) placement.Options {
 	pOpts := placement.NewOptions().
 		SetValidZone(opts.ServiceZone).
 		SetIsSharded(true).
@@ -195,14 +213,7 @@ func ServiceWithAlgo(
 	if validationFn != nil {
 		pOpts = pOpts.SetValidateFnBeforeUpdate(validationFn)
 	}
-	ps, err := cs.PlacementService(sid, pOpts)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	alg := algo.NewAlgorithm(pOpts)
-
-	return ps, alg, nil
+	return pOpts
 }
 
 // ConvertInstancesProto converts a slice of protobuf `Instance`s to `placement.Instance`s
@@ -298,6 +309,15 @@ func RegisterRoutes(
 	r.HandleFunc(M3DBSetURL, setFn).Methods(SetHTTPMethod)
 	r.HandleFunc(M3AggSetURL, setFn).Methods(SetHTTPMethod)
 	r.HandleFunc(M3CoordinatorSetURL, setFn).Methods(SetHTTPMethod)
+
+	// Simulate
+	var (
+		simulateHandler = NewSimulateHandler(opts)
+		simulateFn      = applyMiddleware(simulateHandler.ServeHTTP, defaults, opts.instrumentOptions)
+	)
+	r.HandleFunc(M3DBSimulateURL, simulateFn).Methods(SimulateHTTPMethod)
+	r.HandleFunc(M3AggSimulateURL, simulateFn).Methods(SimulateHTTPMethod)
+	r.HandleFunc(M3CoordinatorSimulateURL, simulateFn).Methods(SimulateHTTPMethod)
 }
 
 func newPlacementCutoverNanosFn(
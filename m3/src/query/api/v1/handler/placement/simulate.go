@@ -0,0 +1,366 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/placementpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/placement/simulator"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"go.uber.org/zap"
+)
+
+const (
+	// SimulateHTTPMethod is the HTTP method for the the simulate endpoint.
+	SimulateHTTPMethod = http.MethodPost
+
+	simulatePathName = "simulate"
+)
+
+var (
+	// M3DBSimulateURL is the url for the m3db simulate handler (method POST).
+	M3DBSimulateURL = path.Join(handler.RoutePrefixV1,
+		M3DBServicePlacementPathName, simulatePathName)
+
+	// M3AggSimulateURL is the url for the m3aggregator simulate handler
+	// (method POST).
+	M3AggSimulateURL = path.Join(handler.RoutePrefixV1,
+		M3AggServicePlacementPathName, simulatePathName)
+
+	// M3CoordinatorSimulateURL is the url for the m3coordinator simulate
+	// handler (method POST).
+	M3CoordinatorSimulateURL = path.Join(handler.RoutePrefixV1,
+		M3CoordinatorServicePlacementPathName, simulatePathName)
+
+	errNoSimulateSequences = errors.New("no sequences of changes to simulate")
+)
+
+// SimulateRequest is the request to simulate sequences of changes to the
+// current placement.
+type SimulateRequest struct {
+	// EstimatedShardSizes is the size in bytes of each shard as supplied by
+	// the caller, shard sizes are not fetched from the nodes and bytes moved
+	// are only as accurate as these estimates.
+	EstimatedShardSizes map[uint32]int64 `json:"estimatedShardSizes"`
+
+	// DefaultEstimatedShardSize is the size in bytes of shards without an
+	// estimated size.
+	DefaultEstimatedShardSize int64 `json:"defaultEstimatedShardSize"`
+
+	// ImbalanceTolerance is the fraction an isolation group's share of shard
+	// replicas may differ from its share of weight, defaults to 0.1.
+	ImbalanceTolerance *float64 `json:"imbalanceTolerance"`
+
+	// Sequences are the alternative sequences of changes to simulate.
+	Sequences []SimulateSequence `json:"sequences"`
+}
+
+// SimulateSequence is a named sequence of changes.
+type SimulateSequence struct {
+	Name    string           `json:"name"`
+	Changes []SimulateChange `json:"changes"`
+}
+
+// SimulateChange is a change to a placement, one of add, remove, replace
+// or update.
+type SimulateChange struct {
+	Type               string            `json:"type"`
+	Instances          []json.RawMessage `json:"instances"`
+	LeavingInstanceIDs []string          `json:"leavingInstanceIDs"`
+}
+
+// SimulateResponse is the response of a simulation.
+type SimulateResponse struct {
+	// Sequences are the results of each sequence in order.
+	Sequences []SimulateSequenceResult `json:"sequences"`
+
+	// Best is the name of the sequence with the fewest violations, breaking
+	// ties by the fewest bytes moved and then the lowest peak bytes.
+	Best string `json:"best"`
+}
+
+// SimulateSequenceResult is the result of simulating a sequence of changes.
+type SimulateSequenceResult struct {
+	Name        string                 `json:"name"`
+	ShardsMoved int                    `json:"shardsMoved"`
+	BytesMoved  int64                  `json:"bytesMoved"`
+	PeakBytes   int64                  `json:"peakBytes"`
+	Steps       []SimulateStepResult   `json:"steps"`
+	Instances   []SimulateInstanceLoad `json:"instances"`
+	Violations  []SimulateViolation    `json:"violations"`
+	Placement   json.RawMessage        `json:"placement"`
+}
+
+// SimulateStepResult is the result of simulating a single change.
+type SimulateStepResult struct {
+	Type        string                 `json:"type"`
+	ShardsMoved int                    `json:"shardsMoved"`
+	BytesMoved  int64                  `json:"bytesMoved"`
+	Instances   []SimulateInstanceLoad `json:"instances"`
+	Violations  []SimulateViolation    `json:"violations"`
+}
+
+// SimulateInstanceLoad is the shard movement to and from an instance.
+type SimulateInstanceLoad struct {
+	ID             string `json:"id"`
+	IsolationGroup string `json:"isolationGroup"`
+	ShardsIn       int    `json:"shardsIn"`
+	ShardsOut      int    `json:"shardsOut"`
+	BytesIn        int64  `json:"bytesIn"`
+	BytesOut       int64  `json:"bytesOut"`
+	PeakShards     int    `json:"peakShards"`
+	PeakBytes      int64  `json:"peakBytes"`
+}
+
+// SimulateViolation is a balance violation in a placement.
+type SimulateViolation struct {
+	Type           string `json:"type"`
+	IsolationGroup string `json:"isolationGroup,omitempty"`
+	Shard          uint32 `json:"shard,omitempty"`
+	Message        string `json:"message"`
+}
+
+// SimulateHandler is the handler for placement simulations.
+type SimulateHandler Handler
+
+// NewSimulateHandler returns a new SimulateHandler.
+func NewSimulateHandler(opts HandlerOptions) *SimulateHandler {
+	return &SimulateHandler{HandlerOptions: opts, nowFn: time.Now}
+}
+
+func (h *SimulateHandler) ServeHTTP(
+	svc handleroptions.ServiceNameAndDefaults,
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx, h.instrumentOptions)
+
+	req, pErr := h.parseRequest(r)
+	if pErr != nil {
+		xhttp.Error(w, pErr.Inner(), pErr.Code())
+		return
+	}
+
+	sequences, err := convertSimulateSequences(req.Sequences)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	serviceOpts := handleroptions.NewServiceOptions(svc, r.Header,
+		h.m3AggServiceOptions)
+	service, err := Service(h.clusterClient, serviceOpts, h.nowFn(), nil)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	current, err := service.Placement()
+	if err == kv.ErrNotFound {
+		xhttp.Error(w, errPlacementDoesNotExist, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		logger.Error("unable to get placement", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	opts := simulator.NewOptions().
+		SetPlacementOptions(NewPlacementOptions(serviceOpts, h.nowFn(), nil)).
+		SetEstimatedShardSizes(req.EstimatedShardSizes).
+		SetDefaultEstimatedShardSize(req.DefaultEstimatedShardSize)
+	if req.ImbalanceTolerance != nil {
+		opts = opts.SetImbalanceTolerance(*req.ImbalanceTolerance)
+	}
+	sim, err := simulator.NewSimulator(opts)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	comparison, err := sim.Compare(current, sequences)
+	if err != nil {
+		logger.Error("unable to simulate placement changes", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	resp, err := newSimulateResponse(sequences, comparison)
+	if err != nil {
+		logger.Error("unable to convert simulation results", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	xhttp.WriteJSONResponse(w, resp, logger)
+}
+
+func (h *SimulateHandler) parseRequest(r *http.Request) (*SimulateRequest, *xhttp.ParseError) {
+	defer r.Body.Close()
+
+	req := &SimulateRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+	if len(req.Sequences) == 0 {
+		return nil, xhttp.NewParseError(errNoSimulateSequences, http.StatusBadRequest)
+	}
+
+	return req, nil
+}
+
+func convertSimulateSequences(
+	sequences []SimulateSequence,
+) ([]simulator.Sequence, error) {
+	result := make([]simulator.Sequence, 0, len(sequences))
+	for i, sequence := range sequences {
+		name := sequence.Name
+		if name == "" {
+			name = fmt.Sprintf("%d", i)
+		}
+
+		changes := make([]simulator.Change, 0, len(sequence.Changes))
+		for _, change := range sequence.Changes {
+			changeType, err := simulator.ParseChangeType(change.Type)
+			if err != nil {
+				return nil, err
+			}
+
+			instances := make([]*placementpb.Instance, 0, len(change.Instances))
+			for _, data := range change.Instances {
+				instance := &placementpb.Instance{}
+				if err := jsonpb.Unmarshal(bytes.NewReader(data), instance); err != nil {
+					return nil, err
+				}
+				instances = append(instances, instance)
+			}
+			converted, err := ConvertInstancesProto(instances)
+			if err != nil {
+				return nil, err
+			}
+
+			changes = append(changes, simulator.Change{
+				Type:               changeType,
+				Instances:          converted,
+				LeavingInstanceIDs: change.LeavingInstanceIDs,
+			})
+		}
+		result = append(result, simulator.Sequence{Name: name, Changes: changes})
+	}
+	return result, nil
+}
+
+func newSimulateResponse(
+	sequences []simulator.Sequence,
+	comparison simulator.Comparison,
+) (SimulateResponse, error) {
+	resp := SimulateResponse{
+		Sequences: make([]SimulateSequenceResult, 0, len(comparison.Reports)),
+		Best:      sequences[comparison.Best].Name,
+	}
+	for i, report := range comparison.Reports {
+		placementJSON, err := marshalPlacementJSON(report.Placement)
+		if err != nil {
+			return SimulateResponse{}, err
+		}
+
+		result := SimulateSequenceResult{
+			Name:        sequences[i].Name,
+			ShardsMoved: report.ShardsMoved,
+			BytesMoved:  report.BytesMoved,
+			PeakBytes:   report.PeakBytes,
+			Steps:       make([]SimulateStepResult, 0, len(report.Steps)),
+			Instances:   newSimulateInstanceLoads(report.Instances),
+			Violations:  newSimulateViolations(report.Violations),
+			Placement:   placementJSON,
+		}
+		for _, step := range report.Steps {
+			result.Steps = append(result.Steps, SimulateStepResult{
+				Type:        step.Change.Type.String(),
+				ShardsMoved: step.ShardsMoved,
+				BytesMoved:  step.BytesMoved,
+				Instances:   newSimulateInstanceLoads(step.Instances),
+				Violations:  newSimulateViolations(step.Violations),
+			})
+		}
+		resp.Sequences = append(resp.Sequences, result)
+	}
+	return resp, nil
+}
+
+func marshalPlacementJSON(p placement.Placement) (json.RawMessage, error) {
+	placementProto, err := p.Proto()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{EmitDefaults: true}).Marshal(&buf, placementProto); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func newSimulateInstanceLoads(reports []simulator.InstanceReport) []SimulateInstanceLoad {
+	loads := make([]SimulateInstanceLoad, 0, len(reports))
+	for _, report := range reports {
+		loads = append(loads, SimulateInstanceLoad{
+			ID:             report.ID,
+			IsolationGroup: report.IsolationGroup,
+			ShardsIn:       report.ShardsIn,
+			ShardsOut:      report.ShardsOut,
+			BytesIn:        report.BytesIn,
+			BytesOut:       report.BytesOut,
+			PeakShards:     report.PeakShards,
+			PeakBytes:      report.PeakBytes,
+		})
+	}
+	return loads
+}
+
+func newSimulateViolations(violations []simulator.Violation) []SimulateViolation {
+	result := make([]SimulateViolation, 0, len(violations))
+	for _, violation := range violations {
+		result = append(result, SimulateViolation{
+			Type:           violation.Type.String(),
+			IsolationGroup: violation.IsolationGroup,
+			Shard:          violation.Shard,
+			Message:        violation.Message,
+		})
+	}
+	return result
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package placement
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/placement"
+	"github.com/m3db/m3/src/cluster/placement/algo"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSimulateRequest = `{
+	"defaultEstimatedShardSize": 100,
+	"sequences": [
+		{
+			"name": "replace",
+			"changes": [
+				{
+					"type": "replace",
+					"leavingInstanceIDs": ["host1"],
+					"instances": [
+						{"id": "host4", "isolationGroup": "rack1", "zone": "embedded", "weight": 1, "endpoint": "host4:9000"}
+					]
+				}
+			]
+		},
+		{
+			"name": "add",
+			"changes": [
+				{
+					"type": "add",
+					"instances": [
+						{"id": "host4", "isolation_group": "rack1", "zone": "embedded", "weight": 1, "endpoint": "host4:9000"}
+					]
+				}
+			]
+		}
+	]
+}`
+
+func newSimulateTestPlacement(t *testing.T) placement.Placement {
+	var (
+		instances = []placement.Instance{
+			placement.NewEmptyInstance("host1", "rack1", handleroptions.DefaultServiceZone, "host1:9000", 1),
+			placement.NewEmptyInstance("host2", "rack2", handleroptions.DefaultServiceZone, "host2:9000", 1),
+			placement.NewEmptyInstance("host3", "rack3", handleroptions.DefaultServiceZone, "host3:9000", 1),
+		}
+		shards = []uint32{0, 1, 2, 3}
+		a      = algo.NewAlgorithm(placement.NewOptions())
+	)
+	p, err := a.InitialPlacement(instances, shards, 3)
+	require.NoError(t, err)
+	p, _, err = a.MarkAllShardsAvailable(p)
+	require.NoError(t, err)
+	return p
+}
+
+func TestPlacementSimulateHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := setupPlacementTest(t, ctrl, newSimulateTestPlacement(t))
+	handlerOpts, err := NewHandlerOptions(mockClient, config.Configuration{}, nil, instrument.NewOptions())
+	require.NoError(t, err)
+	handler := NewSimulateHandler(handlerOpts)
+
+	svcDefaults := handleroptions.ServiceNameAndDefaults{
+		ServiceName: handleroptions.M3DBServiceName,
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(SimulateHTTPMethod, M3DBSimulateURL,
+		strings.NewReader(testSimulateRequest))
+	handler.ServeHTTP(svcDefaults, w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	var resp SimulateResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "add", resp.Best)
+	require.Len(t, resp.Sequences, 2)
+
+	replace := resp.Sequences[0]
+	assert.Equal(t, "replace", replace.Name)
+	assert.Equal(t, 4, replace.ShardsMoved)
+	assert.Equal(t, int64(400), replace.BytesMoved)
+	require.Len(t, replace.Steps, 1)
+	assert.Equal(t, "replace", replace.Steps[0].Type)
+	assert.Empty(t, replace.Violations)
+	assert.Contains(t, string(replace.Placement), `"host4"`)
+
+	add := resp.Sequences[1]
+	assert.Equal(t, "add", add.Name)
+	assert.Equal(t, 2, add.ShardsMoved)
+	assert.Equal(t, int64(200), add.BytesMoved)
+	assert.Equal(t, int64(400), add.PeakBytes)
+}
+
+func TestPlacementSimulateHandlerErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svcDefaults := handleroptions.ServiceNameAndDefaults{
+		ServiceName: handleroptions.M3DBServiceName,
+	}
+
+	tests := []struct {
+		name      string
+		placement placement.Placement
+		body      string
+		code      int
+	}{
+		{
+			name:      "invalid json",
+			placement: newSimulateTestPlacement(t),
+			body:      `{`,
+			code:      http.StatusBadRequest,
+		},
+		{
+			name:      "no sequences",
+			placement: newSimulateTestPlacement(t),
+			body:      `{}`,
+			code:      http.StatusBadRequest,
+		},
+		{
+			name:      "invalid change type",
+			placement: newSimulateTestPlacement(t),
+			body:      `{"sequences": [{"changes": [{"type": "move"}]}]}`,
+			code:      http.StatusBadRequest,
+		},
+		{
+			name:      "unknown instance",
+			placement: newSimulateTestPlacement(t),
+			body:      `{"sequences": [{"changes": [{"type": "remove", "leavingInstanceIDs": ["nope"]}]}]}`,
+			code:      http.StatusBadRequest,
+		},
+		{
+			name: "no placement",
+			body: `{"sequences": [{"changes": [{"type": "remove", "leavingInstanceIDs": ["host1"]}]}]}`,
+			code: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := setupPlacementTest(t, ctrl, test.placement)
+			handlerOpts, err := NewHandlerOptions(mockClient, config.Configuration{}, nil, instrument.NewOptions())
+			require.NoError(t, err)
+			handler := NewSimulateHandler(handlerOpts)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(SimulateHTTPMethod, M3DBSimulateURL,
+				strings.NewReader(test.body))
+			handler.ServeHTTP(svcDefaults, w, req)
+			assert.Equal(t, test.code, w.Code, w.Body.String())
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/query/errors"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/tenant"
 	xhttp "github.com/m3db/m3/src/x/net/http"
 )
 
@@ -204,6 +205,10 @@ func (b fetchOptionsBuilder) NewFetchOptions(
 		fetchOpts.RestrictQueryOptions.RestrictByTag = defaultTagOpts
 	}
 
+	// Restrict the fetch to the tenant of the request after any header since
+	// clients must not be able to override it.
+	tenant.ApplyFetchOptions(req.Context(), fetchOpts)
+
 	if restrict := fetchOpts.RestrictQueryOptions; restrict != nil {
 		if err := restrict.Validate(); err != nil {
 			err = fmt.Errorf(
@@ -34,6 +34,8 @@ import (
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/tenant"
+	"github.com/m3db/m3/src/x/instrument"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -396,3 +398,60 @@ func TestFetchOptionsWithHeader(t *testing.T) {
 func stripSpace(str string) string {
 	return regexp.MustCompile(`\s+`).ReplaceAllString(str, "")
 }
+
+func TestFetchOptionsRestrictedToTenant(t *testing.T) {
+	cfg := tenant.Configuration{
+		Tenants: []tenant.TenantConfiguration{
+			{Name: "a"},
+			{Name: "admin", Unrestricted: true},
+		},
+	}
+	tenants, err := cfg.NewTenants(nil, time.Now, instrument.NewOptions())
+	require.NoError(t, err)
+
+	tests := []struct {
+		tenant   string
+		expected models.Matchers
+	}{
+		{
+			tenant: "a",
+			expected: models.Matchers{
+				mustMatcher(tenant.DefaultTagName, "b", models.MatchEqual),
+				mustMatcher(tenant.DefaultTagName, "a", models.MatchEqual),
+			},
+		},
+		{
+			tenant: "admin",
+			expected: models.Matchers{
+				mustMatcher(tenant.DefaultTagName, "b", models.MatchEqual),
+			},
+		},
+	}
+
+	builder := NewFetchOptionsBuilder(FetchOptionsBuilderOptions{})
+	for _, tt := range tests {
+		t.Run(tt.tenant, func(t *testing.T) {
+			var opts *storage.FetchOptions
+			h := tenant.NewHandler(tenants, http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					var err error
+					opts, err = builder.NewFetchOptions(r)
+					require.NoError(t, err)
+				}))
+
+			// Tenants must not be able to read the series of other tenants by
+			// overriding the tag restrictions with the header.
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(tenant.DefaultHeader, tt.tenant)
+			req.Header.Set(RestrictByTagsJSONHeader,
+				`{"match":[{"name":"tenant","value":"b","type":"EQUAL"}]}`)
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			require.NotNil(t, opts)
+			require.NotNil(t, opts.RestrictQueryOptions)
+			require.NotNil(t, opts.RestrictQueryOptions.RestrictByTag)
+			assert.Equal(t, tt.expected,
+				opts.RestrictQueryOptions.RestrictByTag.Restrict)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/tenant"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DeleteSeriesURL is the url for deleting series matching a set of
+	// matchers over a time range.
+	DeleteSeriesURL = handler.RoutePrefixV1 + "/admin/tsdb/delete_series"
+)
+
+var (
+	// DeleteSeriesHTTPMethods are the HTTP methods for this handler.
+	DeleteSeriesHTTPMethods = []string{http.MethodPost, http.MethodPut}
+
+	errDeleteSeriesNoClusters = errors.New("no clusters configured to delete series from")
+)
+
+// DeleteSeriesHandler represents a handler for the delete series endpoint.
+type DeleteSeriesHandler struct {
+	clusters       m3.Clusters
+	tagOptions     models.TagOptions
+	instrumentOpts instrument.Options
+}
+
+// DeleteSeriesResponse is the response returned by the delete series endpoint.
+type DeleteSeriesResponse struct {
+	NumSeries int64 `json:"numSeries"`
+}
+
+// NewDeleteSeriesHandler returns a new instance of handler.
+func NewDeleteSeriesHandler(opts options.HandlerOptions) http.Handler {
+	return &DeleteSeriesHandler{
+		clusters:       opts.Clusters(),
+		tagOptions:     opts.TagOptions(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *DeleteSeriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	if h.clusters == nil {
+		xhttp.Error(w, errDeleteSeriesNoClusters, http.StatusBadRequest)
+		return
+	}
+
+	queries, rErr := prometheus.ParseSeriesMatchQuery(r, h.tagOptions)
+	if rErr != nil {
+		logger.Error("unable to parse delete series request", zap.Error(rErr))
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	var numSeries int64
+	for _, query := range queries {
+		n, err := h.deleteSeries(r.Context(), query)
+		if err != nil {
+			logger.Error("unable to delete series",
+				zap.String("query", query.Raw), zap.Error(err))
+			xhttp.Error(w, err, http.StatusInternalServerError)
+			return
+		}
+		numSeries += n
+	}
+
+	xhttp.WriteJSONResponse(w, DeleteSeriesResponse{NumSeries: numSeries}, logger)
+}
+
+func (h *DeleteSeriesHandler) deleteSeries(
+	ctx context.Context,
+	query *storage.FetchQuery,
+) (int64, error) {
+	// NB: a tenant can only delete its own series.
+	fetchOpts := storage.NewFetchOptions()
+	tenant.ApplyFetchOptions(ctx, fetchOpts)
+
+	m3query, err := storage.FetchQueryToM3Query(query, fetchOpts)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		start = query.Start
+		// NB: the end of the range is inclusive to match Prometheus semantics.
+		end       = query.End.Add(time.Nanosecond)
+		numSeries int64
+	)
+	for _, namespace := range h.clusters.ClusterNamespaces() {
+		session, ok := namespace.Session().(client.AdminSession)
+		if !ok {
+			return 0, fmt.Errorf("session for namespace %s does not support "+
+				"deleting series", namespace.NamespaceID().String())
+		}
+
+		n, err := session.DeleteSeries(namespace.NamespaceID(), m3query, start, end)
+		if err != nil {
+			return 0, err
+		}
+		numSeries += n
+	}
+
+	return numSeries, nil
+}
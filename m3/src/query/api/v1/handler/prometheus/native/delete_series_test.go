@@ -0,0 +1,112 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockAdminSession(ctrl)
+	clusters, err := m3.NewClusters(m3.UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("unagg"),
+		Retention:   time.Hour,
+		Session:     session,
+	}, m3.AggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("agg"),
+		Retention:   24 * time.Hour,
+		Resolution:  time.Minute,
+		Session:     session,
+	})
+	require.NoError(t, err)
+
+	var (
+		start = time.Unix(100, 0)
+		end   = time.Unix(200, 0)
+	)
+	for _, ns := range []string{"unagg", "agg"} {
+		session.EXPECT().
+			DeleteSeries(ident.NewIDMatcher(ns), gomock.Any(),
+				start, end.Add(time.Nanosecond)).
+			Return(int64(3), nil)
+	}
+
+	opts := options.EmptyHandlerOptions().
+		SetClusters(clusters).
+		SetTagOptions(models.NewTagOptions())
+	h := NewDeleteSeriesHandler(opts)
+
+	form := url.Values{}
+	form.Add("match[]", `{__name__="foo",bar="baz"}`)
+	form.Add("start", "100")
+	form.Add("end", "200")
+	req := httptest.NewRequest(http.MethodPost, DeleteSeriesURL,
+		strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"numSeries":6}`, w.Body.String())
+}
+
+func TestDeleteSeriesNoMatchers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := client.NewMockAdminSession(ctrl)
+	clusters, err := m3.NewClusters(m3.UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("unagg"),
+		Retention:   time.Hour,
+		Session:     session,
+	})
+	require.NoError(t, err)
+
+	opts := options.EmptyHandlerOptions().
+		SetClusters(clusters).
+		SetTagOptions(models.NewTagOptions())
+	h := NewDeleteSeriesHandler(opts)
+
+	req := httptest.NewRequest(http.MethodPost, DeleteSeriesURL, nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/storage/prometheus/metadata"
+	"github.com/m3db/m3/src/query/tenant"
+	"github.com/m3db/m3/src/query/util/json"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// MetadataURL is the url for the metric metadata endpoint.
+	MetadataURL = handler.RoutePrefixV1 + "/metadata"
+
+	// MetadataHTTPMethod is the HTTP method used with this resource.
+	MetadataHTTPMethod = http.MethodGet
+
+	metadataMetricParam = "metric"
+	metadataLimitParam  = "limit"
+)
+
+var errMetadataNoStore = errors.New("no metric metadata store configured")
+
+// MetadataHandler represents a handler for the metric metadata endpoint,
+// serving the metric metadata received via Prometheus remote write.
+type MetadataHandler struct {
+	store          metadata.Store
+	instrumentOpts instrument.Options
+}
+
+// NewMetadataHandler returns a new instance of handler.
+func NewMetadataHandler(opts options.HandlerOptions) http.Handler {
+	return &MetadataHandler{
+		store:          opts.MetricMetadataStore(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *MetadataHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	if h.store == nil {
+		xhttp.Error(w, errMetadataNoStore, http.StatusBadRequest)
+		return
+	}
+
+	limit := 0
+	if str := r.FormValue(metadataLimitParam); str != "" {
+		value, err := strconv.Atoi(str)
+		if err != nil {
+			err = fmt.Errorf("invalid %s param: %v", metadataLimitParam, err)
+			xhttp.Error(w, err, http.StatusBadRequest)
+			return
+		}
+		limit = value
+	}
+
+	// NB: restricted tenants only read back the metadata they have written.
+	scope := tenant.Scope(r.Context())
+	result, err := h.store.Read(scope, r.FormValue(metadataMetricParam), limit)
+	if err != nil {
+		logger.Error("unable to read metric metadata", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	if err := renderMetadataResultsJSON(w, result); err != nil {
+		logger.Error("unable to render results", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+}
+
+func renderMetadataResultsJSON(
+	w io.Writer,
+	result map[string][]prompb.MetricMetadata,
+) error {
+	names := make([]string, 0, len(result))
+	for name := range result {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	jw := json.NewWriter(w)
+	jw.BeginObject()
+
+	jw.BeginObjectField("status")
+	jw.WriteString("success")
+
+	jw.BeginObjectField("data")
+	jw.BeginObject()
+
+	for _, name := range names {
+		jw.BeginObjectField(name)
+		jw.BeginArray()
+		for _, m := range result[name] {
+			jw.BeginObject()
+			jw.BeginObjectField("type")
+			jw.WriteString(strings.ToLower(m.Type.String()))
+			jw.BeginObjectField("help")
+			jw.WriteString(m.Help)
+			jw.BeginObjectField("unit")
+			jw.WriteString(m.Unit)
+			jw.EndObject()
+		}
+		jw.EndArray()
+	}
+
+	jw.EndObject()
+
+	jw.EndObject()
+
+	return jw.Close()
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/storage/prometheus/metadata"
+	"github.com/m3db/m3/src/query/tenant"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMetadataHandler(t *testing.T) http.Handler {
+	kvStore := mem.NewStore()
+	store := metadata.NewStore(func() (kv.Store, error) {
+		return kvStore, nil
+	}, instrument.NewOptions())
+
+	require.NoError(t, store.Write("", []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricMetadata_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total number of HTTP requests.",
+		},
+		{
+			Type:             prompb.MetricMetadata_HISTOGRAM,
+			MetricFamilyName: "http_request_duration_seconds",
+			Help:             "HTTP request latency.",
+			Unit:             "seconds",
+		},
+	}))
+	require.NoError(t, store.Flush())
+
+	opts := options.EmptyHandlerOptions().SetMetricMetadataStore(store)
+	return NewMetadataHandler(opts)
+}
+
+func TestMetadataHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		code     int
+		expected string
+	}{
+		{
+			name: "all",
+			url:  MetadataURL,
+			code: http.StatusOK,
+			expected: `{"status":"success","data":{` +
+				`"http_request_duration_seconds":[{"type":"histogram","help":"HTTP request latency.","unit":"seconds"}],` +
+				`"http_requests_total":[{"type":"counter","help":"Total number of HTTP requests.","unit":""}]}}`,
+		},
+		{
+			name: "metric",
+			url:  MetadataURL + "?metric=http_requests_total",
+			code: http.StatusOK,
+			expected: `{"status":"success","data":{` +
+				`"http_requests_total":[{"type":"counter","help":"Total number of HTTP requests.","unit":""}]}}`,
+		},
+		{
+			name: "limit",
+			url:  MetadataURL + "?limit=1",
+			code: http.StatusOK,
+			expected: `{"status":"success","data":{` +
+				`"http_request_duration_seconds":[{"type":"histogram","help":"HTTP request latency.","unit":"seconds"}]}}`,
+		},
+		{
+			name:     "unknown metric",
+			url:      MetadataURL + "?metric=unknown",
+			code:     http.StatusOK,
+			expected: `{"status":"success","data":{}}`,
+		},
+		{
+			name: "invalid limit",
+			url:  MetadataURL + "?limit=foo",
+			code: http.StatusBadRequest,
+		},
+	}
+
+	handler := newTestMetadataHandler(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(MetadataHTTPMethod, tt.url, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			r := w.Result()
+			defer r.Body.Close()
+			require.Equal(t, tt.code, r.StatusCode)
+			if tt.expected == "" {
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(body))
+		})
+	}
+}
+
+func TestMetadataHandlerNoStore(t *testing.T) {
+	handler := NewMetadataHandler(options.EmptyHandlerOptions())
+	req := httptest.NewRequest(MetadataHTTPMethod, MetadataURL, nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func TestMetadataHandlerRestrictedToTenant(t *testing.T) {
+	kvStore := mem.NewStore()
+	store := metadata.NewStore(func() (kv.Store, error) {
+		return kvStore, nil
+	}, instrument.NewOptions())
+	defer store.Close()
+
+	for _, scope := range []string{"a", "b", ""} {
+		require.NoError(t, store.Write(scope, []prompb.MetricMetadata{
+			{
+				Type:             prompb.MetricMetadata_COUNTER,
+				MetricFamilyName: "requests_" + scope,
+				Help:             "Requests.",
+			},
+		}))
+	}
+	require.NoError(t, store.Flush())
+
+	opts := options.EmptyHandlerOptions().SetMetricMetadataStore(store)
+	handler := tenant.NewHandler(newTestTenants(t), NewMetadataHandler(opts))
+
+	tests := []struct {
+		tenant   string
+		expected string
+	}{
+		{
+			tenant: "a",
+			expected: `{"status":"success","data":{` +
+				`"requests_a":[{"type":"counter","help":"Requests.","unit":""}]}}`,
+		},
+		{
+			tenant: "b",
+			expected: `{"status":"success","data":{` +
+				`"requests_b":[{"type":"counter","help":"Requests.","unit":""}]}}`,
+		},
+		{
+			tenant: "admin",
+			expected: `{"status":"success","data":{` +
+				`"requests_":[{"type":"counter","help":"Requests.","unit":""}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tenant, func(t *testing.T) {
+			req := newTestTenantRequest(MetadataHTTPMethod, MetadataURL, tt.tenant)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.expected, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/query/analyze"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/plan"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// QueryExplainURL is the url for the query explain handler, it returns
+	// the plans of a PromQL query and, when analyzing it, executes it and
+	// returns the stats of its execution.
+	QueryExplainURL = handler.RoutePrefixV1 + "/query_explain"
+
+	analyzeParam = "analyze"
+)
+
+var (
+	// QueryExplainHTTPMethods are the HTTP methods for the query explain
+	// handler.
+	QueryExplainHTTPMethods = []string{
+		http.MethodGet,
+		http.MethodPost,
+	}
+)
+
+// QueryExplainResponse is the response of the query explain handler.
+type QueryExplainResponse struct {
+	Query    string                `json:"query"`
+	Logical  LogicalPlanResponse   `json:"logical"`
+	Physical PhysicalPlanResponse  `json:"physical"`
+	Analyze  *QueryAnalyzeResponse `json:"analyze,omitempty"`
+}
+
+// PlanNodeResponse is a node of the DAG of a query plan.
+type PlanNodeResponse struct {
+	ID          string   `json:"id"`
+	Op          string   `json:"op"`
+	Description string   `json:"description"`
+	Parents     []string `json:"parents"`
+	Children    []string `json:"children"`
+}
+
+// LogicalPlanResponse is the logical plan of a query, its nodes are in
+// pipeline order.
+type LogicalPlanResponse struct {
+	Nodes []PlanNodeResponse `json:"nodes"`
+}
+
+// PhysicalPlanResponse is the physical plan of a query, its nodes are in
+// pipeline order and its start is shifted to include the lookback and
+// ranges of the query.
+type PhysicalPlanResponse struct {
+	Nodes    []PlanNodeResponse `json:"nodes"`
+	Result   string             `json:"result"`
+	Start    time.Time          `json:"start"`
+	End      time.Time          `json:"end"`
+	Step     string             `json:"step"`
+	Lookback string             `json:"lookback"`
+}
+
+// QueryAnalyzeResponse is the execution stats of an analyzed query.
+type QueryAnalyzeResponse struct {
+	analyze.Report
+	WallTime string `json:"wallTime"`
+	Series   int    `json:"series"`
+}
+
+type queryExplainHandler struct {
+	opts options.HandlerOptions
+}
+
+// NewQueryExplainHandler returns a new query explain handler.
+func NewQueryExplainHandler(opts options.HandlerOptions) http.Handler {
+	return &queryExplainHandler{opts: opts}
+}
+
+func (h *queryExplainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.opts.InstrumentOpts())
+
+	analyzeQuery, err := parseAnalyzeFlag(r)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	// Explain an instant query unless given a range.
+	instant := r.FormValue(startParam) == "" && r.FormValue(endParam) == ""
+	parsed, rErr := ParseRequest(ctx, r, instant, h.opts)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	params := parsed.Params
+	params.Analyze = analyzeQuery
+	parsed.Params = params
+
+	engine := h.opts.Engine()
+	dagParser, err := parsePromQL(params.Query, params.Step,
+		h.opts.TagOptions(), engine.Options())
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	nodes, edges, err := dagParser.DAG()
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	lp, err := plan.NewLogicalPlan(nodes, edges)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	pp, err := plan.NewPhysicalPlan(lp, params)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	resp := QueryExplainResponse{
+		Query:    params.Query,
+		Logical:  newLogicalPlanResponse(lp),
+		Physical: newPhysicalPlanResponse(pp),
+	}
+
+	if analyzeQuery {
+		stats := analyze.NewStats()
+		start := time.Now()
+		result, err := read(analyze.NewContext(ctx, stats), parsed, h.opts,
+			parsePromQL)
+		if err != nil {
+			logger.Error("analyze query error", zap.Error(err))
+			xhttp.Error(w, err, http.StatusInternalServerError)
+			return
+		}
+
+		resp.Analyze = &QueryAnalyzeResponse{
+			Report:   stats.Report(),
+			WallTime: time.Since(start).String(),
+			Series:   len(result.Series),
+		}
+	}
+
+	xhttp.WriteJSONResponse(w, resp, logger)
+}
+
+func parseAnalyzeFlag(r *http.Request) (bool, error) {
+	str := r.FormValue(analyzeParam)
+	if str == "" {
+		return false, nil
+	}
+
+	v, err := strconv.ParseBool(str)
+	if err != nil {
+		return false, fmt.Errorf("could not parse %s: input=%s, err=%v",
+			analyzeParam, str, err)
+	}
+
+	return v, nil
+}
+
+func newLogicalPlanResponse(lp plan.LogicalPlan) LogicalPlanResponse {
+	nodes := make([]PlanNodeResponse, 0, len(lp.Pipeline))
+	for _, id := range lp.Pipeline {
+		nodes = append(nodes, newPlanNodeResponse(lp.Steps[id]))
+	}
+
+	return LogicalPlanResponse{Nodes: nodes}
+}
+
+func newPhysicalPlanResponse(pp plan.PhysicalPlan) PhysicalPlanResponse {
+	pipeline := pp.Pipeline()
+	nodes := make([]PlanNodeResponse, 0, len(pipeline))
+	for _, id := range pipeline {
+		if step, ok := pp.Step(id); ok {
+			nodes = append(nodes, newPlanNodeResponse(step))
+		}
+	}
+
+	return PhysicalPlanResponse{
+		Nodes:    nodes,
+		Result:   string(pp.ResultStep.Parent),
+		Start:    pp.TimeSpec.Start,
+		End:      pp.TimeSpec.End,
+		Step:     pp.TimeSpec.Step.String(),
+		Lookback: pp.LookbackDuration.String(),
+	}
+}
+
+func newPlanNodeResponse(step plan.LogicalStep) PlanNodeResponse {
+	return PlanNodeResponse{
+		ID:          string(step.ID()),
+		Op:          step.Transform.Op.OpType(),
+		Description: step.Transform.Op.String(),
+		Parents:     nodeIDs(step.Parents),
+		Children:    nodeIDs(step.Children),
+	}
+}
+
+func nodeIDs(ids []parser.NodeID) []string {
+	strs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strs = append(strs, string(id))
+	}
+
+	return strs
+}
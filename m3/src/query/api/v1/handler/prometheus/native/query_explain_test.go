@@ -0,0 +1,116 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryExplainHandler(t *testing.T) {
+	setup := newTestSetup()
+	h := NewQueryExplainHandler(setup.options)
+
+	params := defaultParams()
+	params.Set(queryParam, `sum(rate(http_requests_total[1m]))`)
+	req := httptest.NewRequest(http.MethodGet, QueryExplainURL+"?"+params.Encode(), nil)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var resp QueryExplainResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Nil(t, resp.Analyze)
+
+	ops := make([]string, 0, len(resp.Logical.Nodes))
+	for _, n := range resp.Logical.Nodes {
+		ops = append(ops, n.Op)
+	}
+	assert.Equal(t, []string{"fetch", "rate", "sum"}, ops)
+	assert.Empty(t, resp.Logical.Nodes[0].Parents)
+	assert.Equal(t, []string{resp.Logical.Nodes[1].ID}, resp.Logical.Nodes[0].Children)
+
+	require.Len(t, resp.Physical.Nodes, 3)
+	assert.Equal(t, resp.Logical.Nodes[2].ID, resp.Physical.Result)
+	assert.Equal(t, "10s", resp.Physical.Step)
+	// The start is shifted back by the range of the rate.
+	assert.True(t, resp.Physical.Start.Before(resp.Physical.End))
+}
+
+func TestQueryExplainHandlerAnalyze(t *testing.T) {
+	values, bounds := test.GenerateValuesAndBounds(nil, nil)
+
+	setup := newTestSetup()
+	seriesMeta := test.NewSeriesMeta("dummy", len(values))
+	meta := block.Metadata{
+		Bounds:         bounds,
+		Tags:           models.NewTags(0, models.NewTagOptions()),
+		ResultMetadata: block.NewResultMetadata(),
+	}
+
+	b := test.NewBlockFromValuesWithMetaAndSeriesMeta(meta, seriesMeta, values)
+	setup.Storage.SetFetchBlocksResult(block.Result{Blocks: []block.Block{b}}, nil)
+
+	h := NewQueryExplainHandler(setup.options)
+	params := defaultParams()
+	params.Set(queryParam, `abs(http_requests_total)`)
+	params.Set(analyzeParam, "true")
+	req := httptest.NewRequest(http.MethodGet, QueryExplainURL+"?"+params.Encode(), nil)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var resp QueryExplainResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Analyze)
+	assert.Equal(t, 2, resp.Analyze.Series)
+	assert.NotEmpty(t, resp.Analyze.WallTime)
+
+	require.Len(t, resp.Analyze.Nodes, 2)
+	byID := make(map[string]int, len(resp.Analyze.Nodes))
+	for _, n := range resp.Analyze.Nodes {
+		byID[n.ID] = n.Blocks
+	}
+	fetchID, absID := resp.Logical.Nodes[0].ID, resp.Logical.Nodes[1].ID
+	assert.Equal(t, 0, byID[fetchID])
+	assert.Equal(t, 1, byID[absID])
+}
+
+func TestQueryExplainHandlerInvalidAnalyze(t *testing.T) {
+	setup := newTestSetup()
+	h := NewQueryExplainHandler(setup.options)
+
+	params := defaultParams()
+	params.Set(analyzeParam, "maybe")
+	req := httptest.NewRequest(http.MethodGet, QueryExplainURL+"?"+params.Encode(), nil)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
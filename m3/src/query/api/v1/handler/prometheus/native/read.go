@@ -46,6 +46,9 @@ const (
 	// handler, this matches the  default URL for the query endpoint
 	// found on a Prometheus server.
 	PromReadInstantURL = handler.RoutePrefixV1 + "/query"
+
+	// M3QLReadURL is the url for the M3QL range query handler.
+	M3QLReadURL = handler.RoutePrefixV1 + "/m3ql/query_range"
 )
 
 var (
@@ -60,38 +63,55 @@ var (
 		http.MethodGet,
 		http.MethodPost,
 	}
+
+	// M3QLReadHTTPMethods are the HTTP methods for the M3QL read handler.
+	M3QLReadHTTPMethods = []string{
+		http.MethodGet,
+		http.MethodPost,
+	}
 )
 
 // promReadHandler represents a handler for prometheus read endpoint.
 type promReadHandler struct {
 	instant         bool
+	m3ql            bool
+	parse           queryParseFn
 	promReadMetrics promReadMetrics
 	opts            options.HandlerOptions
 }
 
 // NewPromReadHandler returns a new prometheus-compatible read handler.
 func NewPromReadHandler(opts options.HandlerOptions) http.Handler {
-	return newHandler(opts, false)
+	return newHandler(opts, "native-read", false, false, parsePromQL)
 }
 
 // NewPromReadInstantHandler returns a new pro instance of handler.
 func NewPromReadInstantHandler(opts options.HandlerOptions) http.Handler {
-	return newHandler(opts, true)
+	return newHandler(opts, "native-instant-read", true, false, parsePromQL)
 }
 
-// newHandler returns a new pro instance of handler.
-func newHandler(opts options.HandlerOptions, instant bool) http.Handler {
-	name := "native-read"
-	if instant {
-		name = "native-instant-read"
-	}
+// NewM3QLReadHandler returns a new M3QL range query handler, which responds
+// in the M3QL render format.
+func NewM3QLReadHandler(opts options.HandlerOptions) http.Handler {
+	return newHandler(opts, "m3ql-read", false, true, parseM3QL)
+}
 
+// newHandler returns a new pro instance of handler.
+func newHandler(
+	opts options.HandlerOptions,
+	name string,
+	instant bool,
+	m3ql bool,
+	parse queryParseFn,
+) http.Handler {
 	taggedScope := opts.InstrumentOpts().MetricsScope().
 		Tagged(map[string]string{"handler": name})
 	h := &promReadHandler{
 		promReadMetrics: newPromReadMetrics(taggedScope),
 		opts:            opts,
 		instant:         instant,
+		m3ql:            m3ql,
+		parse:           parse,
 	}
 
 	maxDatapoints := opts.Config().Limits.MaxComputedDatapoints()
@@ -114,10 +134,14 @@ func (h *promReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if h.m3ql {
+		parsedOptions.Params.FormatType = models.FormatM3QL
+	}
+
 	watcher := handler.NewResponseWriterCanceller(w, h.opts.InstrumentOpts())
 	parsedOptions.CancelWatcher = watcher
 
-	result, err := read(ctx, parsedOptions, h.opts)
+	result, err := read(ctx, parsedOptions, h.opts, h.parse)
 	if err != nil {
 		sp := xopentracing.SpanFromContextOrNoop(ctx)
 		sp.LogFields(opentracinglog.Error(err))
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"time"
 
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
@@ -32,6 +33,8 @@ import (
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/parser/m3ql"
 	"github.com/m3db/m3/src/query/parser/promql"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/ts"
@@ -62,6 +65,32 @@ func newPromReadMetrics(scope tally.Scope) promReadMetrics {
 	}
 }
 
+// queryParseFn parses a query string into a DAG parser.
+type queryParseFn func(
+	query string,
+	stepSize time.Duration,
+	tagOpts models.TagOptions,
+	engineOpts executor.EngineOptions,
+) (parser.Parser, error)
+
+func parsePromQL(
+	query string,
+	stepSize time.Duration,
+	tagOpts models.TagOptions,
+	engineOpts executor.EngineOptions,
+) (parser.Parser, error) {
+	return promql.Parse(query, stepSize, tagOpts, engineOpts.ParseOptions())
+}
+
+func parseM3QL(
+	query string,
+	stepSize time.Duration,
+	tagOpts models.TagOptions,
+	_ executor.EngineOptions,
+) (parser.Parser, error) {
+	return m3ql.Parse(query, stepSize, tagOpts)
+}
+
 // ReadResponse is the response that gets returned to the user
 type ReadResponse struct {
 	Results []ts.Series `json:"results,omitempty"`
@@ -158,6 +187,7 @@ func read(
 	ctx context.Context,
 	parsed ParsedOptions,
 	handlerOpts options.HandlerOptions,
+	parse queryParseFn,
 ) (ReadResult, error) {
 	var (
 		opts          = parsed.QueryOpts
@@ -183,8 +213,7 @@ func read(
 	}
 
 	// TODO: Capture timing
-	parseOpts := engine.Options().ParseOptions()
-	parser, err := promql.Parse(params.Query, params.Step, tagOpts, parseOpts)
+	parser, err := parse(params.Query, params.Step, tagOpts, engine.Options())
 	if err != nil {
 		return emptyResult, err
 	}
@@ -85,7 +85,7 @@ func testPromReadHandlerRead(
 		Params:    r,
 	}
 
-	result, err := read(context.TODO(), parsed, promRead.opts)
+	result, err := read(context.TODO(), parsed, promRead.opts, parsePromQL)
 	require.NoError(t, err)
 	seriesList := result.Series
 
@@ -156,6 +156,54 @@ func testM3PromReadHandlerRead(
 	assert.Equal(t, 10000, m3qlResp[1].StepSizeMs)
 }
 
+func TestM3QLReadHandlerRead(t *testing.T) {
+	values, bounds := test.GenerateValuesAndBounds(nil, nil)
+
+	setup := newTestSetup()
+	m3qlRead := setup.Handlers.m3qlRead
+
+	seriesMeta := test.NewSeriesMeta("dummy", len(values))
+	meta := block.Metadata{
+		Bounds:         bounds,
+		Tags:           models.NewTags(0, models.NewTagOptions()),
+		ResultMetadata: block.NewResultMetadata(),
+	}
+
+	b := test.NewBlockFromValuesWithMetaAndSeriesMeta(meta, seriesMeta, values)
+	setup.Storage.SetFetchBlocksResult(block.Result{Blocks: []block.Block{b}}, nil)
+
+	params := defaultParams()
+	params.Set(queryParam, "fetch name:dummy* | abs")
+	req, _ := http.NewRequest("GET", M3QLReadURL, nil)
+	req.URL.RawQuery = params.Encode()
+
+	recorder := httptest.NewRecorder()
+	m3qlRead.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var m3qlResp M3QLResp
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &m3qlResp))
+
+	require.Len(t, m3qlResp, 2)
+	assert.Equal(t, "dummy0", m3qlResp[0].Target)
+	assert.Equal(t, 10000, m3qlResp[0].StepSizeMs)
+	assert.Equal(t, "dummy1", m3qlResp[1].Target)
+}
+
+func TestM3QLReadHandlerInvalidQuery(t *testing.T) {
+	setup := newTestSetup()
+	m3qlRead := setup.Handlers.m3qlRead
+
+	params := defaultParams()
+	params.Set(queryParam, "sum | abs")
+	req, _ := http.NewRequest("GET", M3QLReadURL, nil)
+	req.URL.RawQuery = params.Encode()
+
+	recorder := httptest.NewRecorder()
+	m3qlRead.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
+
 func newReadRequest(t *testing.T, params url.Values) *http.Request {
 	req, err := http.NewRequest("GET", PromReadURL, nil)
 	require.NoError(t, err)
@@ -175,6 +223,7 @@ type testSetup struct {
 type testSetupHandlers struct {
 	read        *promReadHandler
 	instantRead *promReadHandler
+	m3qlRead    *promReadHandler
 }
 
 func newTestSetup() *testSetup {
@@ -208,12 +257,14 @@ func newTestSetup() *testSetup {
 
 	read := NewPromReadHandler(opts).(*promReadHandler)
 	instantRead := NewPromReadInstantHandler(opts).(*promReadHandler)
+	m3qlRead := NewM3QLReadHandler(opts).(*promReadHandler)
 
 	return &testSetup{
 		Storage: mockStorage,
 		Handlers: testSetupHandlers{
 			read:        read,
 			instantRead: instantRead,
+			m3qlRead:    m3qlRead,
 		},
 		QueryOpts:   &executor.QueryOptions{},
 		FetchOpts:   storage.NewFetchOptions(),
@@ -0,0 +1,322 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/rules"
+	"github.com/m3db/m3/src/query/tenant"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+const (
+	// RulesURL is the url for the rules endpoint.
+	RulesURL = handler.RoutePrefixV1 + "/rules"
+
+	// RulesHTTPMethod is the HTTP method used with the rules resource.
+	RulesHTTPMethod = http.MethodGet
+
+	// AlertsURL is the url for the alerts endpoint.
+	AlertsURL = handler.RoutePrefixV1 + "/alerts"
+
+	// AlertsHTTPMethod is the HTTP method used with the alerts resource.
+	AlertsHTTPMethod = http.MethodGet
+
+	rulesTypeParam     = "type"
+	rulesTypeAlerting  = "alert"
+	rulesTypeRecording = "record"
+)
+
+var errRulesNoManager = errors.New("no rules configured")
+
+// RulesHandler represents a handler for the rules endpoint, serving the
+// recording and alerting rules evaluated by the coordinator. Tenants
+// restricted to their series are only served the rules and alerts labeled
+// with their tenant tag.
+type RulesHandler struct {
+	manager        rules.Manager
+	instrumentOpts instrument.Options
+}
+
+// AlertsHandler represents a handler for the alerts endpoint, serving the
+// pending and firing alerts of the alerting rules. Tenants restricted to their
+// series are only served the alerts labeled with their tenant tag.
+type AlertsHandler struct {
+	manager        rules.Manager
+	instrumentOpts instrument.Options
+}
+
+// RulesResponse is the response returned by the rules endpoint.
+type RulesResponse struct {
+	Status string    `json:"status"`
+	Data   RulesData `json:"data"`
+}
+
+// RulesData is the data of the rules response.
+type RulesData struct {
+	Groups []RuleGroup `json:"groups"`
+}
+
+// RuleGroup is a rule group of the rules response, its rules are either
+// alerting or recording rules.
+type RuleGroup struct {
+	Name           string        `json:"name"`
+	File           string        `json:"file"`
+	Rules          []interface{} `json:"rules"`
+	Interval       float64       `json:"interval"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+}
+
+// AlertingRule is an alerting rule of the rules response.
+type AlertingRule struct {
+	State          string        `json:"state"`
+	Name           string        `json:"name"`
+	Query          string        `json:"query"`
+	Duration       float64       `json:"duration"`
+	Labels         labels.Labels `json:"labels"`
+	Annotations    labels.Labels `json:"annotations"`
+	Alerts         []Alert       `json:"alerts"`
+	Health         string        `json:"health"`
+	LastError      string        `json:"lastError,omitempty"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+	Type           string        `json:"type"`
+}
+
+// RecordingRule is a recording rule of the rules response.
+type RecordingRule struct {
+	Name           string        `json:"name"`
+	Query          string        `json:"query"`
+	Labels         labels.Labels `json:"labels"`
+	Health         string        `json:"health"`
+	LastError      string        `json:"lastError,omitempty"`
+	EvaluationTime float64       `json:"evaluationTime"`
+	LastEvaluation time.Time     `json:"lastEvaluation"`
+	Type           string        `json:"type"`
+}
+
+// AlertsResponse is the response returned by the alerts endpoint.
+type AlertsResponse struct {
+	Status string     `json:"status"`
+	Data   AlertsData `json:"data"`
+}
+
+// AlertsData is the data of the alerts response.
+type AlertsData struct {
+	Alerts []Alert `json:"alerts"`
+}
+
+// Alert is an alert of the rules and alerts responses.
+type Alert struct {
+	Labels      labels.Labels `json:"labels"`
+	Annotations labels.Labels `json:"annotations"`
+	State       string        `json:"state"`
+	ActiveAt    *time.Time    `json:"activeAt,omitempty"`
+	Value       string        `json:"value"`
+}
+
+// NewRulesHandler returns a new instance of handler.
+func NewRulesHandler(opts options.HandlerOptions) http.Handler {
+	return &RulesHandler{
+		manager:        opts.RulesManager(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *RulesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	if h.manager == nil {
+		xhttp.Error(w, errRulesNoManager, http.StatusBadRequest)
+		return
+	}
+
+	includeAlerting, includeRecording := true, true
+	switch typ := r.FormValue(rulesTypeParam); typ {
+	case "":
+	case rulesTypeAlerting:
+		includeRecording = false
+	case rulesTypeRecording:
+		includeAlerting = false
+	default:
+		err := fmt.Errorf("invalid %s param: %s", rulesTypeParam, typ)
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	var (
+		restricted = tenant.Scope(r.Context()) != ""
+		matches    = tenantLabelsMatcher(r.Context())
+		groups     = h.manager.Groups()
+		result     = make([]RuleGroup, 0, len(groups))
+	)
+	for _, g := range groups {
+		if restricted && !hasMatchingRule(g.Rules, matches) {
+			// NB: omit the groups that are not visible to the tenant at all.
+			continue
+		}
+		group := RuleGroup{
+			Name:           g.Name,
+			File:           g.File,
+			Rules:          make([]interface{}, 0, len(g.Rules)),
+			Interval:       g.Interval.Seconds(),
+			EvaluationTime: g.EvaluationTime.Seconds(),
+			LastEvaluation: g.LastEvaluation,
+		}
+		for _, s := range g.Rules {
+			if !matches(s.Labels) {
+				continue
+			}
+			switch s.Type {
+			case rules.RuleTypeAlerting:
+				if !includeAlerting {
+					continue
+				}
+				group.Rules = append(group.Rules, AlertingRule{
+					State:          s.State.String(),
+					Name:           s.Name,
+					Query:          s.Query,
+					Duration:       s.Duration.Seconds(),
+					Labels:         s.Labels,
+					Annotations:    s.Annotations,
+					Alerts:         toRulesAlerts(s.Alerts, matches),
+					Health:         string(s.Health),
+					LastError:      s.LastError,
+					EvaluationTime: s.EvaluationTime.Seconds(),
+					LastEvaluation: s.LastEvaluation,
+					Type:           string(s.Type),
+				})
+			case rules.RuleTypeRecording:
+				if !includeRecording {
+					continue
+				}
+				group.Rules = append(group.Rules, RecordingRule{
+					Name:           s.Name,
+					Query:          s.Query,
+					Labels:         s.Labels,
+					Health:         string(s.Health),
+					LastError:      s.LastError,
+					EvaluationTime: s.EvaluationTime.Seconds(),
+					LastEvaluation: s.LastEvaluation,
+					Type:           string(s.Type),
+				})
+			}
+		}
+		result = append(result, group)
+	}
+
+	xhttp.WriteJSONResponse(w, RulesResponse{
+		Status: "success",
+		Data:   RulesData{Groups: result},
+	}, logger)
+}
+
+// NewAlertsHandler returns a new instance of handler.
+func NewAlertsHandler(opts options.HandlerOptions) http.Handler {
+	return &AlertsHandler{
+		manager:        opts.RulesManager(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *AlertsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	if h.manager == nil {
+		xhttp.Error(w, errRulesNoManager, http.StatusBadRequest)
+		return
+	}
+
+	xhttp.WriteJSONResponse(w, AlertsResponse{
+		Status: "success",
+		Data: AlertsData{
+			Alerts: toRulesAlerts(h.manager.Alerts(), tenantLabelsMatcher(r.Context())),
+		},
+	}, logger)
+}
+
+// tenantLabelsMatcher returns whether labels carry the tag of the tenant held
+// by the context, all labels match if the tenant is not restricted to its
+// series.
+func tenantLabelsMatcher(ctx context.Context) func(labels.Labels) bool {
+	t, ok := tenant.FromContext(ctx)
+	if !ok {
+		return matchAllLabels
+	}
+	tag, restricted := t.Tag()
+	if !restricted {
+		return matchAllLabels
+	}
+	name, value := string(tag.Name), string(tag.Value)
+	return func(l labels.Labels) bool {
+		return l.Get(name) == value
+	}
+}
+
+func matchAllLabels(labels.Labels) bool {
+	return true
+}
+
+func hasMatchingRule(
+	statuses []rules.RuleStatus,
+	matches func(labels.Labels) bool,
+) bool {
+	for _, s := range statuses {
+		if matches(s.Labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func toRulesAlerts(alerts []rules.Alert, matches func(labels.Labels) bool) []Alert {
+	result := make([]Alert, 0, len(alerts))
+	for _, a := range alerts {
+		if !matches(a.Labels) {
+			continue
+		}
+		activeAt := a.ActiveAt
+		result = append(result, Alert{
+			Labels:      a.Labels,
+			Annotations: a.Annotations,
+			State:       a.State.String(),
+			ActiveAt:    &activeAt,
+			Value:       strconv.FormatFloat(a.Value, 'e', -1, 64),
+		})
+	}
+	return result
+}
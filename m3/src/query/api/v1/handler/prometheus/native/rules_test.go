@@ -0,0 +1,325 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package native
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/rules"
+	"github.com/m3db/m3/src/query/tenant"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRulesManager struct {
+	groups []rules.GroupStatus
+	alerts []rules.Alert
+}
+
+func (m *testRulesManager) Start() error                { return nil }
+func (m *testRulesManager) Groups() []rules.GroupStatus { return m.groups }
+func (m *testRulesManager) Alerts() []rules.Alert       { return m.alerts }
+func (m *testRulesManager) Close() error                { return nil }
+
+func newTestRulesManager() rules.Manager {
+	var (
+		evaluatedAt = time.Unix(1000, 0).UTC()
+		alert       = rules.Alert{
+			State:       rules.StateFiring,
+			Labels:      labels.FromStrings("alertname", "InstanceDown", "job", "api"),
+			Annotations: labels.FromStrings("summary", "api down"),
+			Value:       0,
+			ActiveAt:    time.Unix(900, 0).UTC(),
+		}
+	)
+	return &testRulesManager{
+		groups: []rules.GroupStatus{
+			{
+				Name:     "example",
+				File:     "rules.yml",
+				Interval: time.Minute,
+				Rules: []rules.RuleStatus{
+					{
+						Type:           rules.RuleTypeRecording,
+						Name:           "job:up:sum",
+						Query:          "sum by (job) (up)",
+						Health:         rules.RuleHealthGood,
+						LastEvaluation: evaluatedAt,
+						EvaluationTime: 10 * time.Millisecond,
+					},
+					{
+						Type:           rules.RuleTypeAlerting,
+						Name:           "InstanceDown",
+						Query:          "up == 0",
+						Duration:       5 * time.Minute,
+						Labels:         labels.FromStrings("severity", "page"),
+						State:          rules.StateFiring,
+						Alerts:         []rules.Alert{alert},
+						Health:         rules.RuleHealthBad,
+						LastError:      "boom",
+						LastEvaluation: evaluatedAt,
+						EvaluationTime: 20 * time.Millisecond,
+					},
+				},
+				LastEvaluation: evaluatedAt,
+				EvaluationTime: 30 * time.Millisecond,
+			},
+		},
+		alerts: []rules.Alert{alert},
+	}
+}
+
+const (
+	testRecordingRuleJSON = `{
+		"name": "job:up:sum",
+		"query": "sum by (job) (up)",
+		"labels": {},
+		"health": "ok",
+		"evaluationTime": 0.01,
+		"lastEvaluation": "1970-01-01T00:16:40Z",
+		"type": "recording"
+	}`
+	testAlertJSON = `{
+		"labels": {"alertname": "InstanceDown", "job": "api"},
+		"annotations": {"summary": "api down"},
+		"state": "firing",
+		"activeAt": "1970-01-01T00:15:00Z",
+		"value": "0e+00"
+	}`
+	testAlertingRuleJSON = `{
+		"state": "firing",
+		"name": "InstanceDown",
+		"query": "up == 0",
+		"duration": 300,
+		"labels": {"severity": "page"},
+		"annotations": {},
+		"alerts": [` + testAlertJSON + `],
+		"health": "err",
+		"lastError": "boom",
+		"evaluationTime": 0.02,
+		"lastEvaluation": "1970-01-01T00:16:40Z",
+		"type": "alerting"
+	}`
+)
+
+func testRulesJSON(ruleJSONs ...string) string {
+	result := `{"status": "success", "data": {"groups": [{
+		"name": "example",
+		"file": "rules.yml",
+		"interval": 60,
+		"evaluationTime": 0.03,
+		"lastEvaluation": "1970-01-01T00:16:40Z",
+		"rules": [`
+	for i, r := range ruleJSONs {
+		if i > 0 {
+			result += ","
+		}
+		result += r
+	}
+	return result + "]}]}}"
+}
+
+func TestRulesHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		code     int
+		expected string
+	}{
+		{
+			name:     "all",
+			url:      RulesURL,
+			code:     http.StatusOK,
+			expected: testRulesJSON(testRecordingRuleJSON, testAlertingRuleJSON),
+		},
+		{
+			name:     "alerting",
+			url:      RulesURL + "?type=alert",
+			code:     http.StatusOK,
+			expected: testRulesJSON(testAlertingRuleJSON),
+		},
+		{
+			name:     "recording",
+			url:      RulesURL + "?type=record",
+			code:     http.StatusOK,
+			expected: testRulesJSON(testRecordingRuleJSON),
+		},
+		{
+			name: "invalid type",
+			url:  RulesURL + "?type=foo",
+			code: http.StatusBadRequest,
+		},
+	}
+
+	opts := options.EmptyHandlerOptions().SetRulesManager(newTestRulesManager())
+	handler := NewRulesHandler(opts)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(RulesHTTPMethod, tt.url, nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, tt.code, w.Code)
+			if tt.expected != "" {
+				assert.JSONEq(t, tt.expected, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAlertsHandler(t *testing.T) {
+	opts := options.EmptyHandlerOptions().SetRulesManager(newTestRulesManager())
+	req := httptest.NewRequest(AlertsHTTPMethod, AlertsURL, nil)
+	w := httptest.NewRecorder()
+	NewAlertsHandler(opts).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status": "success", "data": {"alerts": [`+
+		testAlertJSON+`]}}`, w.Body.String())
+}
+
+func newTestTenantRulesManager() rules.Manager {
+	newRule := func(name, tenantName string) rules.RuleStatus {
+		return rules.RuleStatus{
+			Type:   rules.RuleTypeAlerting,
+			Name:   name,
+			Query:  "up == 0",
+			Labels: labels.FromStrings(tenant.DefaultTagName, tenantName),
+			Health: rules.RuleHealthGood,
+		}
+	}
+	newAlert := func(name, tenantName string) rules.Alert {
+		return rules.Alert{
+			State:  rules.StateFiring,
+			Labels: labels.FromStrings("alertname", name, tenant.DefaultTagName, tenantName),
+		}
+	}
+	return &testRulesManager{
+		groups: []rules.GroupStatus{
+			{
+				Name:  "a",
+				Rules: []rules.RuleStatus{newRule("DownA", "a")},
+			},
+			{
+				Name: "mixed",
+				Rules: []rules.RuleStatus{
+					newRule("DownA", "a"),
+					newRule("DownB", "b"),
+				},
+			},
+		},
+		alerts: []rules.Alert{
+			newAlert("DownA", "a"),
+			newAlert("DownB", "b"),
+		},
+	}
+}
+
+func TestRulesHandlersRestrictedToTenant(t *testing.T) {
+	tests := []struct {
+		tenant string
+		groups map[string][]string
+		alerts []string
+	}{
+		{
+			tenant: "a",
+			groups: map[string][]string{"a": {"DownA"}, "mixed": {"DownA"}},
+			alerts: []string{"DownA"},
+		},
+		{
+			tenant: "b",
+			groups: map[string][]string{"mixed": {"DownB"}},
+			alerts: []string{"DownB"},
+		},
+		{
+			tenant: "admin",
+			groups: map[string][]string{"a": {"DownA"}, "mixed": {"DownA", "DownB"}},
+			alerts: []string{"DownA", "DownB"},
+		},
+	}
+
+	var (
+		tenants       = newTestTenants(t)
+		opts          = options.EmptyHandlerOptions().SetRulesManager(newTestTenantRulesManager())
+		rulesHandler  = tenant.NewHandler(tenants, NewRulesHandler(opts))
+		alertsHandler = tenant.NewHandler(tenants, NewAlertsHandler(opts))
+	)
+	for _, tt := range tests {
+		t.Run(tt.tenant, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			rulesHandler.ServeHTTP(w,
+				newTestTenantRequest(RulesHTTPMethod, RulesURL, tt.tenant))
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var rulesResp struct {
+				Data struct {
+					Groups []struct {
+						Name  string `json:"name"`
+						Rules []struct {
+							Name string `json:"name"`
+						} `json:"rules"`
+					} `json:"groups"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &rulesResp))
+			groups := make(map[string][]string)
+			for _, g := range rulesResp.Data.Groups {
+				groups[g.Name] = []string{}
+				for _, r := range g.Rules {
+					groups[g.Name] = append(groups[g.Name], r.Name)
+				}
+			}
+			assert.Equal(t, tt.groups, groups)
+
+			w = httptest.NewRecorder()
+			alertsHandler.ServeHTTP(w,
+				newTestTenantRequest(AlertsHTTPMethod, AlertsURL, tt.tenant))
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var alertsResp AlertsResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &alertsResp))
+			alerts := make([]string, 0, len(alertsResp.Data.Alerts))
+			for _, a := range alertsResp.Data.Alerts {
+				alerts = append(alerts, a.Labels.Get("alertname"))
+			}
+			assert.Equal(t, tt.alerts, alerts)
+		})
+	}
+}
+
+func TestRulesHandlersNoManager(t *testing.T) {
+	opts := options.EmptyHandlerOptions()
+	for _, h := range []http.Handler{
+		NewRulesHandler(opts),
+		NewAlertsHandler(opts),
+	} {
+		req := httptest.NewRequest(http.MethodGet, RulesURL, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/tenant"
+	"github.com/m3db/m3/src/query/util"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// TSDBStatusURL is the url for the TSDB cardinality status endpoint.
+	TSDBStatusURL = handler.RoutePrefixV1 + "/status/tsdb"
+
+	// TSDBStatusHTTPMethod is the HTTP method used with this resource.
+	TSDBStatusHTTPMethod = http.MethodGet
+
+	tsdbStatusNamespaceParam = "namespace"
+	tsdbStatusLimitParam     = "limit"
+	tsdbStatusStartParam     = "start"
+	tsdbStatusEndParam       = "end"
+)
+
+var errTSDBStatusNoClusters = errors.New("no clusters configured to compute the TSDB status of")
+
+// TSDBStatusHandler represents a handler for the TSDB status endpoint,
+// serving the cardinality of the index blocks of a namespace computed by
+// the dbnodes of the placement. The cardinality is restricted to the series
+// of the tenant of the request, if any.
+type TSDBStatusHandler struct {
+	clusters       m3.Clusters
+	tagOptions     models.TagOptions
+	nowFn          func() time.Time
+	instrumentOpts instrument.Options
+}
+
+// TSDBStatusResponse is the response returned by the TSDB status endpoint.
+type TSDBStatusResponse struct {
+	Status string         `json:"status"`
+	Data   TSDBStatusData `json:"data"`
+}
+
+// TSDBStatusData is the data of the TSDB status response.
+type TSDBStatusData struct {
+	Namespace string            `json:"namespace"`
+	Blocks    []TSDBStatusBlock `json:"blocks"`
+}
+
+// TSDBStatusBlock is the cardinality of an index block, the statistics
+// mirror the ones of the Prometheus TSDB status endpoint.
+type TSDBStatusBlock struct {
+	BlockStart                  time.Time        `json:"blockStart"`
+	NumSeries                   int64            `json:"numSeries"`
+	SeriesCountByMetricName     []TSDBStatusStat `json:"seriesCountByMetricName"`
+	SeriesCountByLabelName      []TSDBStatusStat `json:"seriesCountByLabelName"`
+	SeriesCountByLabelValuePair []TSDBStatusStat `json:"seriesCountByLabelValuePair"`
+	LabelValueCountByLabelName  []TSDBStatusStat `json:"labelValueCountByLabelName"`
+}
+
+// TSDBStatusStat is a single statistic of an index block.
+type TSDBStatusStat struct {
+	Name  string `json:"name"`
+	Value int64  `json:"value"`
+}
+
+// NewTSDBStatusHandler returns a new instance of handler.
+func NewTSDBStatusHandler(opts options.HandlerOptions) http.Handler {
+	return &TSDBStatusHandler{
+		clusters:       opts.Clusters(),
+		tagOptions:     opts.TagOptions(),
+		nowFn:          time.Now,
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *TSDBStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	if h.clusters == nil {
+		xhttp.Error(w, errTSDBStatusNoClusters, http.StatusBadRequest)
+		return
+	}
+
+	namespace, start, end, opts, err := h.parseRequest(r)
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	opts.Query, err = tenantQuery(r.Context())
+	if err != nil {
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	session, ok := namespace.Session().(client.AdminSession)
+	if !ok {
+		err := fmt.Errorf("session for namespace %s does not support "+
+			"index cardinality", namespace.NamespaceID().String())
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	blocks, err := session.IndexCardinality(namespace.NamespaceID(), start, end, opts)
+	if err != nil {
+		logger.Error("unable to compute index cardinality", zap.Error(err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	xhttp.WriteJSONResponse(w, TSDBStatusResponse{
+		Status: "success",
+		Data: TSDBStatusData{
+			Namespace: namespace.NamespaceID().String(),
+			Blocks:    toTSDBStatusBlocks(blocks),
+		},
+	}, logger)
+}
+
+func (h *TSDBStatusHandler) parseRequest(
+	r *http.Request,
+) (m3.ClusterNamespace, time.Time, time.Time, index.CardinalityOptions, error) {
+	var (
+		namespace = h.clusters.UnaggregatedClusterNamespace()
+		now       = h.nowFn()
+		start     = now
+		end       = now
+		opts      = index.CardinalityOptions{
+			Limit:         index.DefaultCardinalityLimit,
+			MetricNameTag: h.tagOptions.MetricName(),
+		}
+		err error
+	)
+
+	if name := r.FormValue(tsdbStatusNamespaceParam); name != "" {
+		namespace = nil
+		for _, ns := range h.clusters.ClusterNamespaces() {
+			if ns.NamespaceID().String() == name {
+				namespace = ns
+				break
+			}
+		}
+		if namespace == nil {
+			return nil, time.Time{}, time.Time{}, opts,
+				fmt.Errorf("unknown namespace: %s", name)
+		}
+	}
+
+	if str := r.FormValue(tsdbStatusLimitParam); str != "" {
+		opts.Limit, err = strconv.Atoi(str)
+		if err == nil {
+			err = opts.Validate()
+		}
+		if err != nil {
+			return nil, time.Time{}, time.Time{}, opts,
+				fmt.Errorf("invalid %s param: %v", tsdbStatusLimitParam, err)
+		}
+	}
+
+	if str := r.FormValue(tsdbStatusStartParam); str != "" {
+		if start, err = util.ParseTimeString(str); err != nil {
+			return nil, time.Time{}, time.Time{}, opts,
+				fmt.Errorf("invalid %s param: %v", tsdbStatusStartParam, err)
+		}
+	}
+
+	if str := r.FormValue(tsdbStatusEndParam); str != "" {
+		if end, err = util.ParseTimeString(str); err != nil {
+			return nil, time.Time{}, time.Time{}, opts,
+				fmt.Errorf("invalid %s param: %v", tsdbStatusEndParam, err)
+		}
+	}
+
+	if end.Before(start) {
+		return nil, time.Time{}, time.Time{}, opts,
+			fmt.Errorf("%s param is before %s param",
+				tsdbStatusEndParam, tsdbStatusStartParam)
+	}
+
+	// NB: the end of the range is inclusive so that by default only the
+	// current index block is returned, like the Prometheus head block.
+	return namespace, start, end.Add(time.Nanosecond), opts, nil
+}
+
+// tenantQuery returns the query matching the series of the tenant held by the
+// context, nil is returned if the tenant is not restricted to its series.
+func tenantQuery(ctx context.Context) (*index.Query, error) {
+	fetchOpts := storage.NewFetchOptions()
+	tenant.ApplyFetchOptions(ctx, fetchOpts)
+	if fetchOpts.RestrictQueryOptions == nil {
+		return nil, nil
+	}
+	q, err := storage.FetchQueryToM3Query(&storage.FetchQuery{}, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+func toTSDBStatusBlocks(blocks []index.BlockCardinality) []TSDBStatusBlock {
+	result := make([]TSDBStatusBlock, 0, len(blocks))
+	for _, b := range blocks {
+		result = append(result, TSDBStatusBlock{
+			BlockStart:                  b.BlockStart,
+			NumSeries:                   b.NumSeries,
+			SeriesCountByMetricName:     toTSDBStatusStats(b.SeriesCountByMetricName),
+			SeriesCountByLabelName:      toTSDBStatusStats(b.SeriesCountByLabelName),
+			SeriesCountByLabelValuePair: toTSDBStatusStats(b.SeriesCountByLabelValuePair),
+			LabelValueCountByLabelName:  toTSDBStatusStats(b.LabelValueCountByLabelName),
+		})
+	}
+	return result
+}
+
+func toTSDBStatusStats(entries []index.CardinalityEntry) []TSDBStatusStat {
+	result := make([]TSDBStatusStat, 0, len(entries))
+	for _, e := range entries {
+		name := string(e.Name)
+		if len(e.Value) > 0 {
+			name += "=" + string(e.Value)
+		}
+		result = append(result, TSDBStatusStat{Name: name, Value: e.Count})
+	}
+	return result
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/tenant"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTSDBStatusHandler(
+	t *testing.T,
+	session client.AdminSession,
+	now time.Time,
+) http.Handler {
+	clusters, err := m3.NewClusters(m3.UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("unagg"),
+		Retention:   time.Hour,
+		Session:     session,
+	}, m3.AggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("agg"),
+		Retention:   24 * time.Hour,
+		Resolution:  time.Minute,
+		Session:     session,
+	})
+	require.NoError(t, err)
+
+	opts := options.EmptyHandlerOptions().
+		SetClusters(clusters).
+		SetTagOptions(models.NewTagOptions())
+	h := NewTSDBStatusHandler(opts).(*TSDBStatusHandler)
+	h.nowFn = func() time.Time { return now }
+	return h
+}
+
+func TestTSDBStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now        = time.Unix(7200, 0).UTC()
+		blockStart = time.Unix(3600, 0).UTC()
+		session    = client.NewMockAdminSession(ctrl)
+	)
+	session.EXPECT().
+		IndexCardinality(ident.NewIDMatcher("unagg"), now, now.Add(time.Nanosecond),
+			index.CardinalityOptions{
+				Limit:         index.DefaultCardinalityLimit,
+				MetricNameTag: []byte("__name__"),
+			}).
+		Return([]index.BlockCardinality{
+			{
+				BlockStart: blockStart,
+				NumSeries:  3,
+				SeriesCountByMetricName: []index.CardinalityEntry{
+					{Name: []byte("up"), Count: 2},
+				},
+				SeriesCountByLabelName: []index.CardinalityEntry{
+					{Name: []byte("job"), Count: 3},
+				},
+				SeriesCountByLabelValuePair: []index.CardinalityEntry{
+					{Name: []byte("job"), Value: []byte("api"), Count: 2},
+				},
+				LabelValueCountByLabelName: []index.CardinalityEntry{
+					{Name: []byte("job"), Count: 2},
+				},
+			},
+		}, nil)
+
+	h := newTestTSDBStatusHandler(t, session, now)
+	req := httptest.NewRequest(TSDBStatusHTTPMethod, TSDBStatusURL, nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{
+		"status": "success",
+		"data": {
+			"namespace": "unagg",
+			"blocks": [{
+				"blockStart": "1970-01-01T01:00:00Z",
+				"numSeries": 3,
+				"seriesCountByMetricName": [{"name": "up", "value": 2}],
+				"seriesCountByLabelName": [{"name": "job", "value": 3}],
+				"seriesCountByLabelValuePair": [{"name": "job=api", "value": 2}],
+				"labelValueCountByLabelName": [{"name": "job", "value": 2}]
+			}]
+		}
+	}`, w.Body.String())
+}
+
+func TestTSDBStatusParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now     = time.Unix(7200, 0)
+		session = client.NewMockAdminSession(ctrl)
+	)
+	session.EXPECT().
+		IndexCardinality(ident.NewIDMatcher("agg"), time.Unix(100, 0),
+			time.Unix(200, 0).Add(time.Nanosecond),
+			index.CardinalityOptions{
+				Limit:         5,
+				MetricNameTag: []byte("__name__"),
+			}).
+		Return(nil, nil)
+
+	h := newTestTSDBStatusHandler(t, session, now)
+	req := httptest.NewRequest(TSDBStatusHTTPMethod,
+		TSDBStatusURL+"?namespace=agg&limit=5&start=100&end=200", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"success","data":{"namespace":"agg","blocks":[]}}`,
+		w.Body.String())
+}
+
+func newTestTenants(t *testing.T) *tenant.Tenants {
+	cfg := tenant.Configuration{
+		Tenants: []tenant.TenantConfiguration{
+			{Name: "a"},
+			{Name: "b"},
+			{Name: "admin", Unrestricted: true},
+		},
+	}
+	tenants, err := cfg.NewTenants(nil, time.Now, instrument.NewOptions())
+	require.NoError(t, err)
+	return tenants
+}
+
+func newTestTenantRequest(method, url, name string) *http.Request {
+	req := httptest.NewRequest(method, url, nil)
+	req.Header.Set(tenant.DefaultHeader, name)
+	return req
+}
+
+func TestTSDBStatusRestrictedToTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now     = time.Unix(7200, 0)
+		session = client.NewMockAdminSession(ctrl)
+		queries = make(map[string]*index.Query)
+	)
+	session.EXPECT().
+		IndexCardinality(ident.NewIDMatcher("unagg"), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ ident.ID,
+			_, _ time.Time,
+			opts index.CardinalityOptions,
+		) ([]index.BlockCardinality, error) {
+			var name string
+			if opts.Query != nil {
+				name = opts.Query.String()
+			}
+			queries[name] = opts.Query
+			return nil, nil
+		}).
+		Times(3)
+
+	h := tenant.NewHandler(newTestTenants(t), newTestTSDBStatusHandler(t, session, now))
+	for _, name := range []string{"a", "b", "admin"} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newTestTenantRequest(TSDBStatusHTTPMethod, TSDBStatusURL, name))
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+
+	// Each tenant only sees the cardinality of its own series, unrestricted
+	// tenants see the cardinality of all the series.
+	require.Len(t, queries, 3)
+	for _, name := range []string{"a", "b"} {
+		expected := idx.NewTermQuery([]byte(tenant.DefaultTagName), []byte(name))
+		q, ok := queries[expected.String()]
+		require.True(t, ok, name)
+		assert.True(t, expected.Equal(q.Query))
+	}
+	q, ok := queries[""]
+	require.True(t, ok)
+	assert.Nil(t, q)
+}
+
+func TestTSDBStatusInvalidParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := newTestTSDBStatusHandler(t, client.NewMockAdminSession(ctrl), time.Now())
+	for _, query := range []string{
+		"namespace=unknown",
+		"limit=foo",
+		"limit=0",
+		"start=foo",
+		"start=200&end=100",
+	} {
+		req := httptest.NewRequest(TSDBStatusHTTPMethod, TSDBStatusURL+"?"+query, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+}
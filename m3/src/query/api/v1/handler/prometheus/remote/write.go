@@ -42,6 +42,8 @@ import (
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/storage/prometheus/metadata"
+	"github.com/m3db/m3/src/query/tenant"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/logging"
 	"github.com/m3db/m3/src/x/clock"
@@ -104,6 +106,7 @@ type PromWriteHandler struct {
 	forwardingBoundWorkers xsync.WorkerPool
 	forwardContext         context.Context
 	forwardRetrier         retry.Retrier
+	metadataStore          metadata.Store
 	nowFn                  clock.NowFn
 	instrumentOpts         instrument.Options
 	metrics                promWriteMetrics
@@ -173,6 +176,7 @@ func NewPromWriteHandler(options options.HandlerOptions) (http.Handler, error) {
 		forwardingBoundWorkers: forwardingBoundWorkers,
 		forwardContext:         context.Background(),
 		forwardRetrier:         retry.NewRetrier(forwardRetryOpts),
+		metadataStore:          options.MetricMetadataStore(),
 		nowFn:                  nowFn,
 		metrics:                metrics,
 		instrumentOpts:         instrumentOpts,
@@ -191,6 +195,9 @@ type promWriteMetrics struct {
 	forwardErrors            tally.Counter
 	forwardDropped           tally.Counter
 	forwardLatency           tally.Histogram
+	metadataSuccess          tally.Counter
+	metadataErrors           tally.Counter
+	metadataDropped          tally.Counter
 }
 
 func newPromWriteMetrics(scope tally.Scope) (promWriteMetrics, error) {
@@ -251,6 +258,9 @@ func newPromWriteMetrics(scope tally.Scope) (promWriteMetrics, error) {
 		forwardErrors:            scope.SubScope("forward").Counter("errors"),
 		forwardDropped:           scope.SubScope("forward").Counter("dropped"),
 		forwardLatency:           scope.SubScope("forward").Histogram("latency", writeLatencyBuckets),
+		metadataSuccess:          scope.SubScope("metadata").Counter("success"),
+		metadataErrors:           scope.SubScope("metadata").Counter("errors"),
+		metadataDropped:          scope.SubScope("metadata").Counter("dropped"),
 	}, nil
 }
 
@@ -314,6 +324,10 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if len(req.Metadata) > 0 {
+		h.writeMetadata(r.Context(), req.Metadata)
+	}
+
 	batchErr := h.write(r.Context(), req, opts)
 
 	// Record ingestion delay latency
@@ -498,6 +512,29 @@ func (h *PromWriteHandler) write(
 	return h.downsamplerAndWriter.WriteBatch(ctx, iter, opts)
 }
 
+// writeMetadata queues the metric metadata of the request to be persisted
+// asynchronously, so it never blocks or fails the samples. Metadata dropped
+// because the store is backed up is only counted since Prometheus
+// periodically resends metadata.
+func (h *PromWriteHandler) writeMetadata(
+	ctx context.Context,
+	metadata []prompb.MetricMetadata,
+) {
+	if h.metadataStore == nil {
+		h.metrics.metadataDropped.Inc(int64(len(metadata)))
+		return
+	}
+
+	if err := h.metadataStore.Write(tenant.Scope(ctx), metadata); err != nil {
+		h.metrics.metadataErrors.Inc(1)
+		logger := logging.WithContext(ctx, h.instrumentOpts)
+		logger.Warn("metric metadata write error", zap.Error(err))
+		return
+	}
+
+	h.metrics.metadataSuccess.Inc(1)
+}
+
 func (h *PromWriteHandler) forward(
 	ctx context.Context,
 	request prometheus.ParsePromCompressedRequestResult,
@@ -31,14 +31,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/mem"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/remote/test"
 	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/storage/prometheus/metadata"
+	"github.com/m3db/m3/src/query/tenant"
 	xclock "github.com/m3db/m3/src/x/clock"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/instrument"
@@ -283,6 +288,139 @@ func TestPromWriteAggregatedMetricsWithHeader(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPromWriteMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
+
+	kvStore := mem.NewStore()
+	store := metadata.NewStore(func() (kv.Store, error) {
+		return kvStore, nil
+	}, instrument.NewOptions())
+	defer store.Close()
+
+	opts := makeOptions(mockDownsamplerAndWriter).SetMetricMetadataStore(store)
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	promReq := test.GeneratePromWriteRequest()
+	promReq.Metadata = []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricMetadata_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total number of HTTP requests.",
+		},
+	}
+	promReqBody := test.GeneratePromWriteRequestBody(t, promReq)
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.NoError(t, store.Flush())
+	result, err := store.Read("", "http_requests_total", 0)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]prompb.MetricMetadata{
+		"http_requests_total": promReq.Metadata,
+	}, result)
+}
+
+func TestPromWriteMetadataScopedToTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
+
+	kvStore := mem.NewStore()
+	store := metadata.NewStore(func() (kv.Store, error) {
+		return kvStore, nil
+	}, instrument.NewOptions())
+	defer store.Close()
+
+	opts := makeOptions(mockDownsamplerAndWriter).SetMetricMetadataStore(store)
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	cfg := tenant.Configuration{
+		Tenants: []tenant.TenantConfiguration{{Name: "a"}, {Name: "b"}},
+	}
+	tenants, err := cfg.NewTenants(nil, time.Now, instrument.NewOptions())
+	require.NoError(t, err)
+
+	promReq := test.GeneratePromWriteRequest()
+	promReq.Metadata = []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricMetadata_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total number of HTTP requests.",
+		},
+	}
+	promReqBody := test.GeneratePromWriteRequestBody(t, promReq)
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+	req.Header.Set(tenant.DefaultHeader, "a")
+
+	writer := httptest.NewRecorder()
+	tenant.NewHandler(tenants, handler).ServeHTTP(writer, req)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	require.NoError(t, store.Flush())
+	result, err := store.Read("a", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]prompb.MetricMetadata{
+		"http_requests_total": promReq.Metadata,
+	}, result)
+
+	for _, scope := range []string{"b", ""} {
+		result, err := store.Read(scope, "", 0)
+		require.NoError(t, err)
+		require.Empty(t, result)
+	}
+}
+
+func TestPromWriteMetadataWithoutStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
+
+	scope := tally.NewTestScope("",
+		map[string]string{"test": "metadata-metric-test"})
+
+	iopts := instrument.NewOptions().SetMetricsScope(scope)
+	opts := makeOptions(mockDownsamplerAndWriter).SetInstrumentOpts(iopts)
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	promReq := test.GeneratePromWriteRequest()
+	promReq.Metadata = []prompb.MetricMetadata{
+		{MetricFamilyName: "first"},
+		{MetricFamilyName: "second"},
+	}
+	promReqBody := test.GeneratePromWriteRequestBody(t, promReq)
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	found, ok := scope.Snapshot().Counters()["metadata.dropped+handler=remote-write,test=metadata-metric-test"]
+	require.True(t, ok)
+	require.Equal(t, int64(2), found.Value())
+}
+
 func BenchmarkWriteDatapoints(b *testing.B) {
 	ctrl := gomock.NewController(b)
 	defer ctrl.Finish()
@@ -30,11 +30,13 @@ import (
 	"github.com/m3db/m3/src/query/api/experimental/annotated"
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/database"
+	"github.com/m3db/m3/src/query/api/v1/handler/frontend"
 	"github.com/m3db/m3/src/query/api/v1/handler/graphite"
 	"github.com/m3db/m3/src/query/api/v1/handler/influxdb"
 	m3json "github.com/m3db/m3/src/query/api/v1/handler/json"
 	"github.com/m3db/m3/src/query/api/v1/handler/namespace"
 	"github.com/m3db/m3/src/query/api/v1/handler/openapi"
+	"github.com/m3db/m3/src/query/api/v1/handler/otlp"
 	"github.com/m3db/m3/src/query/api/v1/handler/placement"
 	"github.com/m3db/m3/src/query/api/v1/handler/prom"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
@@ -42,6 +44,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/remote"
 	"github.com/m3db/m3/src/query/api/v1/handler/topic"
 	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/tenant"
 	"github.com/m3db/m3/src/query/util/logging"
 	xdebug "github.com/m3db/m3/src/x/debug"
 	xhttp "github.com/m3db/m3/src/x/net/http"
@@ -91,7 +94,8 @@ func NewHandler(
 	customHandlers ...options.CustomHandler,
 ) *Handler {
 	r := mux.NewRouter()
-	handlerWithMiddleware := applyMiddleware(r, opentracing.GlobalTracer())
+	handlerWithMiddleware := applyMiddleware(r, handlerOptions.Tenants(),
+		opentracing.GlobalTracer())
 
 	return &Handler{
 		router:         r,
@@ -101,9 +105,20 @@ func NewHandler(
 	}
 }
 
-func applyMiddleware(base *mux.Router, tracer opentracing.Tracer) http.Handler {
+func applyMiddleware(
+	base *mux.Router,
+	tenants *tenant.Tenants,
+	tracer opentracing.Tracer,
+) http.Handler {
+	withTenant := http.Handler(base)
+	if tenants != nil {
+		// Resolve the tenant of requests, restricting and limiting their
+		// reads and writes.
+		withTenant = tenant.NewHandler(tenants, base)
+	}
+
 	withMiddleware := http.Handler(&cors.Handler{
-		Handler: base,
+		Handler: withTenant,
 		Info: &cors.Info{
 			"*": true,
 		},
@@ -181,6 +196,7 @@ func (h *Handler) RegisterRoutes() error {
 	promqlInstantQueryHandler := wrapped(prom.NewReadInstantHandler(opts, nativeSourceOpts))
 	nativePromReadHandler := wrapped(native.NewPromReadHandler(nativeSourceOpts))
 	nativePromReadInstantHandler := wrapped(native.NewPromReadInstantHandler(nativeSourceOpts))
+	m3qlReadHandler := wrapped(native.NewM3QLReadHandler(nativeSourceOpts))
 
 	h.options.QueryRouter().Setup(options.QueryRouterOptions{
 		DefaultQueryEngine: h.options.DefaultQueryEngine(),
@@ -194,14 +210,37 @@ func (h *Handler) RegisterRoutes() error {
 		M3QueryHandler:     nativePromReadInstantHandler.ServeHTTP,
 	})
 
+	// Split range queries into intervals and cache their results if a query
+	// frontend is configured.
+	var (
+		queryRangeHandler       = http.Handler(http.HandlerFunc(h.options.QueryRouter().ServeHTTP))
+		promqlQueryRangeHandler = promqlQueryHandler
+		nativeQueryRangeHandler = nativePromReadHandler
+	)
+	if cfg := h.options.Config().Query.Frontend; cfg != nil {
+		frontendOpts, err := cfg.NewOptions(instrumentOpts)
+		if err != nil {
+			return err
+		}
+		for _, handler := range []*http.Handler{
+			&queryRangeHandler,
+			&promqlQueryRangeHandler,
+			&nativeQueryRangeHandler,
+		} {
+			if *handler, err = frontend.NewHandler(*handler, frontendOpts); err != nil {
+				return err
+			}
+		}
+	}
+
 	h.router.
-		HandleFunc(native.PromReadURL, h.options.QueryRouter().ServeHTTP).
+		Handle(native.PromReadURL, queryRangeHandler).
 		Methods(native.PromReadHTTPMethods...)
 	h.router.
 		HandleFunc(native.PromReadInstantURL, h.options.InstantQueryRouter().ServeHTTP).
 		Methods(native.PromReadInstantHTTPMethods...)
 
-	h.router.HandleFunc("/prometheus"+native.PromReadURL, promqlQueryHandler.ServeHTTP).Methods(native.PromReadHTTPMethods...)
+	h.router.HandleFunc("/prometheus"+native.PromReadURL, promqlQueryRangeHandler.ServeHTTP).Methods(native.PromReadHTTPMethods...)
 	h.router.HandleFunc("/prometheus"+native.PromReadInstantURL, promqlInstantQueryHandler.ServeHTTP).Methods(native.PromReadInstantHTTPMethods...)
 
 	h.router.HandleFunc(remote.PromReadURL,
@@ -210,9 +249,23 @@ func (h *Handler) RegisterRoutes() error {
 	h.router.HandleFunc(remote.PromWriteURL,
 		panicOnly(promRemoteWriteHandler).ServeHTTP,
 	).Methods(remote.PromWriteHTTPMethod)
-	h.router.HandleFunc("/m3query"+native.PromReadURL, nativePromReadHandler.ServeHTTP).Methods(native.PromReadHTTPMethods...)
+	h.router.HandleFunc("/m3query"+native.PromReadURL, nativeQueryRangeHandler.ServeHTTP).Methods(native.PromReadHTTPMethods...)
 	h.router.HandleFunc("/m3query"+native.PromReadInstantURL, nativePromReadInstantHandler.ServeHTTP).Methods(native.PromReadInstantHTTPMethods...)
 
+	// M3QL endpoints.
+	h.router.HandleFunc(native.M3QLReadURL,
+		m3qlReadHandler.ServeHTTP,
+	).Methods(native.M3QLReadHTTPMethods...)
+
+	// OpenTelemetry OTLP/HTTP metrics write endpoint.
+	otlpWriteHandler, err := otlp.NewWriteHandler(remoteSourceOpts)
+	if err != nil {
+		return err
+	}
+	h.router.HandleFunc(otlp.WriteURL,
+		panicOnly(otlpWriteHandler).ServeHTTP,
+	).Methods(otlp.WriteHTTPMethod)
+
 	// InfluxDB write endpoint.
 	h.router.HandleFunc(influxdb.InfluxWriteURL,
 		wrapped(influxdb.NewInfluxWriterHandler(h.options)).ServeHTTP).Methods(influxdb.InfluxWriteHTTPMethod)
@@ -246,11 +299,45 @@ func (h *Handler) RegisterRoutes() error {
 		wrapped(native.NewPromThresholdHandler(h.options)).ServeHTTP,
 	).Methods(native.PromThresholdHTTPMethod)
 
+	// Query explain endpoints.
+	h.router.HandleFunc(native.QueryExplainURL,
+		wrapped(native.NewQueryExplainHandler(nativeSourceOpts)).ServeHTTP,
+	).Methods(native.QueryExplainHTTPMethods...)
+
 	// Series match endpoints.
 	h.router.HandleFunc(remote.PromSeriesMatchURL,
 		wrapped(remote.NewPromSeriesMatchHandler(h.options)).ServeHTTP,
 	).Methods(remote.PromSeriesMatchHTTPMethods...)
 
+	// Metric metadata endpoints.
+	if h.options.MetricMetadataStore() != nil {
+		h.router.HandleFunc(native.MetadataURL,
+			wrapped(native.NewMetadataHandler(h.options)).ServeHTTP,
+		).Methods(native.MetadataHTTPMethod)
+	}
+
+	// Rules and alerts endpoints.
+	if h.options.RulesManager() != nil {
+		h.router.HandleFunc(native.RulesURL,
+			wrapped(native.NewRulesHandler(h.options)).ServeHTTP,
+		).Methods(native.RulesHTTPMethod)
+		h.router.HandleFunc(native.AlertsURL,
+			wrapped(native.NewAlertsHandler(h.options)).ServeHTTP,
+		).Methods(native.AlertsHTTPMethod)
+	}
+
+	// Series delete endpoints.
+	if h.options.Clusters() != nil {
+		h.router.HandleFunc(native.DeleteSeriesURL,
+			wrapped(native.NewDeleteSeriesHandler(h.options)).ServeHTTP,
+		).Methods(native.DeleteSeriesHTTPMethods...)
+
+		// TSDB status endpoint.
+		h.router.HandleFunc(native.TSDBStatusURL,
+			wrapped(native.NewTSDBStatusHandler(h.options)).ServeHTTP,
+		).Methods(native.TSDBStatusHTTPMethod)
+	}
+
 	// Graphite endpoints.
 	h.router.HandleFunc(graphite.ReadURL,
 		wrapped(graphite.NewRenderHandler(h.options)).ServeHTTP,
@@ -260,6 +347,18 @@ func (h *Handler) RegisterRoutes() error {
 		wrapped(graphite.NewFindHandler(h.options)).ServeHTTP,
 	).Methods(graphite.FindHTTPMethods...)
 
+	h.router.HandleFunc(graphite.TagsURL,
+		wrapped(graphite.NewTagsHandler(h.options)).ServeHTTP,
+	).Methods(graphite.TagsHTTPMethods...)
+
+	h.router.HandleFunc(graphite.AutoCompleteTagsURL,
+		wrapped(graphite.NewAutoCompleteTagsHandler(h.options)).ServeHTTP,
+	).Methods(graphite.TagsHTTPMethods...)
+
+	h.router.HandleFunc(graphite.AutoCompleteValuesURL,
+		wrapped(graphite.NewAutoCompleteValuesHandler(h.options)).ServeHTTP,
+	).Methods(graphite.TagsHTTPMethods...)
+
 	placementOpts, err := h.placementOpts()
 	if err != nil {
 		return err
@@ -233,6 +233,19 @@ func TestPromNativeReadPost(t *testing.T) {
 	}
 }
 
+func TestM3QLReadGet(t *testing.T) {
+	req := httptest.NewRequest("GET", native.M3QLReadURL, nil)
+	res := httptest.NewRecorder()
+	ctrl := gomock.NewController(t)
+	storage, _ := m3.NewStorageAndSession(t, ctrl)
+
+	h, err := setupHandler(storage)
+	require.NoError(t, err, "unable to setup handler")
+	h.RegisterRoutes()
+	h.Router().ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code, "Empty request")
+}
+
 func TestJSONWritePost(t *testing.T) {
 	req := httptest.NewRequest("POST", m3json.WriteJSONURL, nil)
 	res := httptest.NewRecorder()
@@ -328,7 +341,7 @@ func TestTracingMiddleware(t *testing.T) {
 	router := mux.NewRouter()
 	setupTestRoute(router)
 
-	handler := applyMiddleware(router, mtr)
+	handler := applyMiddleware(router, nil, mtr)
 	doTestRequest(handler)
 
 	assert.NotEmpty(t, mtr.FinishedSpans())
@@ -339,7 +352,7 @@ func TestCompressionMiddleware(t *testing.T) {
 	router := mux.NewRouter()
 	setupTestRoute(router)
 
-	handler := applyMiddleware(router, mtr)
+	handler := applyMiddleware(router, nil, mtr)
 	req := httptest.NewRequest("GET", testRoute, nil)
 	req.Header.Add("Accept-Encoding", "gzip")
 	res := httptest.NewRecorder()
@@ -35,8 +35,11 @@ import (
 	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/rules"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/storage/prometheus/metadata"
+	"github.com/m3db/m3/src/query/tenant"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/instrument"
@@ -124,6 +127,21 @@ type HandlerOptions interface {
 	// SetClusterClient sets the cluster client.
 	SetClusterClient(c clusterclient.Client) HandlerOptions
 
+	// MetricMetadataStore returns the Prometheus metric metadata store.
+	MetricMetadataStore() metadata.Store
+	// SetMetricMetadataStore sets the Prometheus metric metadata store.
+	SetMetricMetadataStore(s metadata.Store) HandlerOptions
+
+	// RulesManager returns the Prometheus rules manager.
+	RulesManager() rules.Manager
+	// SetRulesManager sets the Prometheus rules manager.
+	SetRulesManager(m rules.Manager) HandlerOptions
+
+	// Tenants returns the tenants of the coordinator.
+	Tenants() *tenant.Tenants
+	// SetTenants sets the tenants of the coordinator.
+	SetTenants(t *tenant.Tenants) HandlerOptions
+
 	// Config returns the config.
 	Config() config.Configuration
 	// SetConfig sets the config.
@@ -211,6 +229,9 @@ type handlerOptions struct {
 	defaultEngine         QueryEngine
 	clusters              m3.Clusters
 	clusterClient         clusterclient.Client
+	metricMetadataStore   metadata.Store
+	rulesManager          rules.Manager
+	tenants               *tenant.Tenants
 	config                config.Configuration
 	embeddedDbCfg         *dbconfig.DBConfiguration
 	createdAt             time.Time
@@ -263,6 +284,11 @@ func NewHandlerOptions(
 		timeout = *embeddedDbCfg.Client.FetchTimeout
 	}
 
+	var metricMetadataStore metadata.Store
+	if clusterClient != nil {
+		metricMetadataStore = metadata.NewStore(clusterClient.KV, instrumentOpts)
+	}
+
 	return &handlerOptions{
 		storage:               downsamplerAndWriter.Storage(),
 		downsamplerAndWriter:  downsamplerAndWriter,
@@ -271,6 +297,7 @@ func NewHandlerOptions(
 		defaultEngine:         getDefaultQueryEngine(cfg.Query.DefaultEngine),
 		clusters:              m3dbClusters,
 		clusterClient:         clusterClient,
+		metricMetadataStore:   metricMetadataStore,
 		config:                cfg,
 		embeddedDbCfg:         embeddedDbCfg,
 		createdAt:             time.Now(),
@@ -357,6 +384,37 @@ func (o *handlerOptions) SetClusterClient(
 	return &opts
 }
 
+func (o *handlerOptions) MetricMetadataStore() metadata.Store {
+	return o.metricMetadataStore
+}
+
+func (o *handlerOptions) SetMetricMetadataStore(
+	s metadata.Store) HandlerOptions {
+	opts := *o
+	opts.metricMetadataStore = s
+	return &opts
+}
+
+func (o *handlerOptions) RulesManager() rules.Manager {
+	return o.rulesManager
+}
+
+func (o *handlerOptions) SetRulesManager(m rules.Manager) HandlerOptions {
+	opts := *o
+	opts.rulesManager = m
+	return &opts
+}
+
+func (o *handlerOptions) Tenants() *tenant.Tenants {
+	return o.tenants
+}
+
+func (o *handlerOptions) SetTenants(t *tenant.Tenants) HandlerOptions {
+	opts := *o
+	opts.tenants = t
+	return &opts
+}
+
 func (o *handlerOptions) Config() config.Configuration {
 	return o.config
 }
@@ -115,6 +115,29 @@ func NewChainedEnforcer(rootResourceName string, models []cost.Enforcer) (Chaine
 	}, nil
 }
 
+// NewChildEnforcer constructs a chainedEnforcer enforced by local which rolls
+// up to parent, its children are created using the models of parent. This adds
+// a level between parent and its children, e.g. to enforce limits across all
+// the queries of a tenant.
+func NewChildEnforcer(
+	parent ChainedEnforcer,
+	resourceName string,
+	local cost.Enforcer,
+) (ChainedEnforcer, error) {
+	p, ok := parent.(*chainedEnforcer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported parent enforcer type: %T", parent)
+	}
+
+	return &chainedEnforcer{
+		resourceName: resourceName,
+		parent:       p,
+		local:        local,
+		models:       p.models,
+		reporter:     upcastReporterOrNoop(local.Reporter()),
+	}, nil
+}
+
 func upcastReporterOrNoop(r cost.EnforcerReporter) ChainedReporter {
 	if r, ok := r.(ChainedReporter); ok {
 		return r
@@ -60,6 +60,38 @@ func TestChainedEnforcer_Child(t *testing.T) {
 	})
 }
 
+func TestNewChildEnforcer(t *testing.T) {
+	t.Run("enforces local limit across children", func(t *testing.T) {
+		globalEnforcer := newTestEnforcer(cost.Limit{Threshold: 100.0, Enabled: true})
+		queryEnforcer := newTestEnforcer(cost.Limit{Threshold: 10.0, Enabled: true})
+		tenantEnforcer := newTestEnforcer(cost.Limit{Threshold: 15.0, Enabled: true})
+
+		global, err := NewChainedEnforcer(GlobalLevel, []cost.Enforcer{globalEnforcer, queryEnforcer})
+		require.NoError(t, err)
+
+		tenant, err := NewChildEnforcer(global, "tenant", tenantEnforcer)
+		require.NoError(t, err)
+
+		q1, q2 := tenant.Child(QueryLevel), tenant.Child(QueryLevel)
+		assert.NoError(t, q1.Add(8).Error)
+		assert.Error(t, q2.Add(8).Error)
+
+		test.AssertCurrentCost(t, 16.0, tenantEnforcer)
+		test.AssertCurrentCost(t, 16.0, globalEnforcer)
+
+		q1.Close()
+		q2.Close()
+		test.AssertCurrentCost(t, 0.0, tenantEnforcer)
+		test.AssertCurrentCost(t, 0.0, globalEnforcer)
+	})
+
+	t.Run("errors for unsupported parent", func(t *testing.T) {
+		_, err := NewChildEnforcer(NewMockChainedEnforcer(gomock.NewController(t)),
+			"tenant", cost.NoopEnforcer())
+		require.Error(t, err)
+	})
+}
+
 func TestChainedEnforcer_Close(t *testing.T) {
 	t.Run("removes local total from global", func(t *testing.T) {
 		parentIface, err := NewChainedEnforcer(
@@ -29,6 +29,7 @@ import (
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/tenant"
 	"github.com/m3db/m3/src/x/opentracing"
 
 	"github.com/uber-go/tally"
@@ -120,7 +121,8 @@ func (e *engine) ExecuteExpr(
 	fetchOpts *storage.FetchOptions,
 	params models.RequestParams,
 ) (block.Block, error) {
-	perQueryEnforcer := e.opts.GlobalEnforcer().Child(qcost.QueryLevel)
+	perQueryEnforcer := tenant.Enforcer(ctx, e.opts.GlobalEnforcer()).
+		Child(qcost.QueryLevel)
 	defer perQueryEnforcer.Close()
 	req := newRequest(e, params, fetchOpts, e.opts.InstrumentOptions())
 	nodes, edges, err := req.compile(ctx, parser)
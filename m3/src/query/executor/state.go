@@ -23,7 +23,10 @@ package executor
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/m3db/m3/src/query/analyze"
+	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/executor/transform"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
@@ -143,14 +146,14 @@ func (s *ExecutionState) createNode(
 	if ok {
 		source, controller := CreateSource(step.ID(), sourceParams,
 			s.storage, options)
-		s.sources = append(s.sources, source)
+		s.addSource(step.ID(), source)
 		return controller, nil
 	}
 
 	scalarParams, ok := step.Transform.Op.(ScalarParams)
 	if ok {
 		source, controller := CreateScalarSource(step.ID(), scalarParams, options)
-		s.sources = append(s.sources, source)
+		s.addSource(step.ID(), source)
 		return controller, nil
 	}
 
@@ -161,6 +164,10 @@ func (s *ExecutionState) createNode(
 
 	transformNode, controller := CreateTransform(step.ID(),
 		transformParams, options)
+	if s.plan.Analyze {
+		transformNode = analyzedNode{id: step.ID(), node: transformNode}
+	}
+
 	for _, parentID := range step.Parents {
 		parentStep, ok := s.plan.Step(parentID)
 		if !ok {
@@ -179,6 +186,14 @@ func (s *ExecutionState) createNode(
 	return controller, nil
 }
 
+func (s *ExecutionState) addSource(ID parser.NodeID, source parser.Source) {
+	if s.plan.Analyze {
+		source = analyzedSource{id: ID, source: source}
+	}
+
+	s.sources = append(s.sources, source)
+}
+
 // Execute the sources in parallel and return the first error.
 func (s *ExecutionState) Execute(queryCtx *models.QueryContext) error {
 	requests := make([]execution.Request, 0, len(s.sources))
@@ -207,3 +222,36 @@ func (s sourceRequest) Process(ctx context.Context) error {
 	// make sure to propagate the new context.Context object down.
 	return s.source.Execute(s.queryCtx.WithContext(ctx))
 }
+
+// analyzedSource records the execution of a source in the analyze stats of
+// the query.
+type analyzedSource struct {
+	id     parser.NodeID
+	source parser.Source
+}
+
+func (s analyzedSource) Execute(queryCtx *models.QueryContext) error {
+	start := time.Now()
+	err := s.source.Execute(queryCtx)
+	analyze.FromContext(queryCtx.Ctx).RecordSource(string(s.id), time.Since(start))
+	return err
+}
+
+// analyzedNode records the processing of blocks by a transform in the
+// analyze stats of the query.
+type analyzedNode struct {
+	id   parser.NodeID
+	node transform.OpNode
+}
+
+func (n analyzedNode) Process(
+	queryCtx *models.QueryContext,
+	ID parser.NodeID,
+	b block.Block,
+) error {
+	start := time.Now()
+	err := n.node.Process(queryCtx, ID, b)
+	analyze.FromContext(queryCtx.Ctx).RecordNode(string(n.id), string(ID),
+		time.Since(start))
+	return err
+}
@@ -37,6 +37,10 @@ const (
 	BottomKType = "bottomk"
 	// TopKType gathers the largest k non nan elements in a list of series
 	TopKType = "topk"
+	// HeadType gathers the first k series in a list of series
+	HeadType = "head"
+	// TailType gathers the last k series in a list of series
+	TailType = "tail"
 )
 
 type takeFunc func(values []float64, buckets [][]int) []float64
@@ -46,8 +50,11 @@ func NewTakeOp(
 	opType string,
 	params NodeParams,
 ) (parser.Params, error) {
-	takeTop := opType == TopKType
-	if !takeTop && opType != BottomKType {
+	var (
+		takeTop        = opType == TopKType
+		takePositional = opType == HeadType || opType == TailType
+	)
+	if !takeTop && !takePositional && opType != BottomKType {
 		return baseOp{}, fmt.Errorf("operator not supported: %s", opType)
 	}
 
@@ -57,6 +64,11 @@ func NewTakeOp(
 		fn = func(values []float64, buckets [][]int) []float64 {
 			return takeNone(values, buckets)
 		}
+	} else if takePositional {
+		takeHead := opType == HeadType
+		fn = func(values []float64, buckets [][]int) []float64 {
+			return takePositionalFn(takeHead, k, values, buckets)
+		}
 	} else {
 		heap := utils.NewFloatHeap(takeTop, k)
 		fn = func(values []float64, buckets [][]int) []float64 {
@@ -198,3 +210,29 @@ func takeFn(heap utils.FloatHeap, values []float64, buckets [][]int) []float64 {
 
 	return values
 }
+
+// takePositionalFn keeps the values of the first (or last) k series of each
+// bucket, in series order, regardless of the values themselves.
+func takePositionalFn(
+	takeHead bool,
+	k int,
+	values []float64,
+	buckets [][]int,
+) []float64 {
+	for _, bucket := range buckets {
+		if len(bucket) <= k {
+			continue
+		}
+
+		dropped := bucket[k:]
+		if !takeHead {
+			dropped = bucket[:len(bucket)-k]
+		}
+
+		for _, idx := range dropped {
+			values[idx] = math.NaN()
+		}
+	}
+
+	return values
+}
@@ -139,3 +139,35 @@ func TestTakeTopFunctionFilteringWithoutALessThanOne(t *testing.T) {
 	test.EqualsWithNansWithDelta(t, expected, sink.Values, math.Pow10(-5))
 	assert.Equal(t, bounds, sink.Meta.Bounds)
 }
+
+func TestTakeHeadAndTailArePositional(t *testing.T) {
+	nan := math.NaN()
+	nans := []float64{nan, nan, nan, nan, nan}
+	tests := []struct {
+		opType   string
+		expected [][]float64
+	}{
+		{
+			// Taking the first two series, regardless of their values
+			opType:   HeadType,
+			expected: [][]float64{v[0], v[1], nans, nans, nans, nans},
+		},
+		{
+			// Taking the last two series, regardless of their values
+			opType:   TailType,
+			expected: [][]float64{nans, nans, nans, nans, v[4], v[5]},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.opType, func(t *testing.T) {
+			op, err := NewTakeOp(tt.opType, NodeParams{Parameter: 2})
+			require.NoError(t, err)
+			sink := processTakeOp(t, op)
+
+			// Should have the same metas as when started
+			assert.Equal(t, seriesMetas, sink.Metas)
+			test.EqualsWithNansWithDelta(t, tt.expected, sink.Values, math.Pow10(-5))
+		})
+	}
+}
@@ -36,7 +36,8 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 type WriteRequest struct {
-	Timeseries []TimeSeries `protobuf:"bytes,1,rep,name=timeseries" json:"timeseries"`
+	Timeseries []TimeSeries     `protobuf:"bytes,1,rep,name=timeseries" json:"timeseries"`
+	Metadata   []MetricMetadata `protobuf:"bytes,3,rep,name=metadata" json:"metadata"`
 }
 
 func (m *WriteRequest) Reset()                    { *m = WriteRequest{} }
@@ -51,6 +52,13 @@ func (m *WriteRequest) GetTimeseries() []TimeSeries {
 	return nil
 }
 
+func (m *WriteRequest) GetMetadata() []MetricMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type ReadRequest struct {
 	Queries []*Query `protobuf:"bytes,1,rep,name=queries" json:"queries,omitempty"`
 }
@@ -166,6 +174,18 @@ func (m *WriteRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for _, msg := range m.Metadata {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintRemote(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -317,6 +337,12 @@ func (m *WriteRequest) Size() (n int) {
 			n += 1 + l + sovRemote(uint64(l))
 		}
 	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovRemote(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -447,6 +473,37 @@ func (m *WriteRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, MetricMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRemote(dAtA[iNdEx:])
@@ -940,28 +997,30 @@ func init() {
 }
 
 var fileDescriptorRemote = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x9b, 0xdb, 0x7b, 0xdb, 0xcb, 0xb4, 0x48, 0x89, 0x9b, 0xd8, 0x45, 0x94, 0xac, 0xba,
-	0xb0, 0x19, 0x30, 0x20, 0x2e, 0x44, 0xa5, 0x2e, 0xdc, 0x58, 0xc1, 0xb1, 0x20, 0xb8, 0x29, 0x49,
-	0x73, 0x4c, 0x03, 0x9d, 0x4c, 0x3a, 0x73, 0xb2, 0xe8, 0x5b, 0xb8, 0xf3, 0x95, 0xba, 0xf4, 0x09,
-	0x44, 0xea, 0x8b, 0x48, 0x66, 0x88, 0xa6, 0xe0, 0x46, 0x37, 0x21, 0x39, 0xff, 0xf7, 0xff, 0xfc,
-	0x39, 0x33, 0xe4, 0x22, 0x49, 0x71, 0x5e, 0x44, 0xfe, 0x4c, 0x70, 0xca, 0x83, 0x38, 0xa2, 0x3c,
-	0xa0, 0x4a, 0xce, 0xe8, 0xb2, 0x00, 0xb9, 0xa2, 0x09, 0x64, 0x20, 0x43, 0x84, 0x98, 0xe6, 0x52,
-	0xa0, 0x28, 0x9f, 0x3c, 0x8f, 0xa8, 0x04, 0x2e, 0x10, 0x7c, 0x3d, 0xb3, 0xbb, 0x3c, 0x28, 0xc7,
-	0x80, 0x73, 0x28, 0x54, 0xff, 0xfc, 0x37, 0x79, 0xb8, 0xca, 0x41, 0x99, 0xb8, 0xfe, 0xb0, 0x16,
-	0x90, 0x88, 0x44, 0x18, 0x32, 0x2a, 0x1e, 0xf5, 0x97, 0xb1, 0x95, 0x6f, 0x06, 0xf7, 0x6e, 0x48,
-	0xf7, 0x5e, 0xa6, 0x08, 0x0c, 0x96, 0x05, 0x28, 0xb4, 0xcf, 0x08, 0xc1, 0x94, 0x83, 0x02, 0x99,
-	0x82, 0x72, 0xac, 0x83, 0xe6, 0xa0, 0x73, 0xe4, 0xf8, 0xf5, 0x8a, 0xfe, 0x24, 0xe5, 0x70, 0xa7,
-	0xf5, 0xd1, 0xdf, 0xf5, 0xeb, 0x7e, 0x83, 0xd5, 0x1c, 0xde, 0x29, 0xe9, 0x30, 0x08, 0xe3, 0x2a,
-	0x6e, 0x48, 0xda, 0x65, 0xf3, 0xaf, 0xac, 0xdd, 0xed, 0xac, 0xdb, 0xf2, 0xb7, 0x58, 0xc5, 0x78,
-	0x97, 0xa4, 0x6b, 0xdc, 0x2a, 0x17, 0x99, 0x02, 0x3b, 0x20, 0x6d, 0x09, 0xaa, 0x58, 0x60, 0x65,
-	0xdf, 0xfb, 0xce, 0xae, 0x09, 0x56, 0x91, 0xde, 0xb3, 0x45, 0xfe, 0x69, 0xc1, 0x3e, 0x24, 0xb6,
-	0xc2, 0x50, 0xe2, 0x54, 0x17, 0xc4, 0x90, 0xe7, 0x53, 0x5e, 0x26, 0x59, 0x83, 0x26, 0xeb, 0x69,
-	0x65, 0x52, 0x09, 0x63, 0x65, 0x0f, 0x48, 0x0f, 0xb2, 0x78, 0x9b, 0xfd, 0xa3, 0xd9, 0x1d, 0xc8,
-	0xe2, 0x3a, 0x79, 0x4c, 0xfe, 0xf3, 0x10, 0x67, 0x73, 0x90, 0xca, 0x69, 0xea, 0x5e, 0xfd, 0xed,
-	0x5e, 0xd7, 0x61, 0x04, 0x8b, 0xb1, 0x41, 0xd8, 0x27, 0xeb, 0x5d, 0x91, 0x4e, 0xad, 0xb1, 0x7d,
-	0xf2, 0x93, 0x5d, 0xd7, 0xb7, 0x3c, 0x72, 0xd6, 0x1b, 0xd7, 0x7a, 0xd9, 0xb8, 0xd6, 0xdb, 0xc6,
-	0xb5, 0x9e, 0xde, 0xdd, 0xc6, 0x43, 0xcb, 0x5c, 0x85, 0xa8, 0xa5, 0x8f, 0x35, 0xf8, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x24, 0xf8, 0x63, 0xea, 0x98, 0x02, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6a, 0xa3, 0x40,
+	0x18, 0xc7, 0xe3, 0x66, 0x37, 0x09, 0x93, 0xb0, 0x84, 0xd9, 0x8b, 0x1b, 0x16, 0x77, 0xf1, 0x94,
+	0xc3, 0x46, 0xa1, 0x42, 0xe9, 0xa1, 0xa4, 0x25, 0x3d, 0xf4, 0x52, 0x0f, 0xb5, 0x81, 0x42, 0x2f,
+	0x61, 0xd4, 0xaf, 0x46, 0xc8, 0xa8, 0x99, 0xf9, 0x3c, 0xe4, 0x25, 0x4a, 0x6f, 0x7d, 0xa5, 0x1c,
+	0xfb, 0x04, 0xa5, 0xa4, 0x2f, 0x52, 0x1c, 0x63, 0x50, 0xe8, 0xa5, 0xbd, 0x88, 0xce, 0xf7, 0xfb,
+	0xfd, 0xf9, 0x3b, 0x33, 0xe4, 0x3c, 0x8a, 0x71, 0x99, 0xfb, 0x56, 0x90, 0x72, 0x9b, 0x3b, 0xa1,
+	0x6f, 0x73, 0xc7, 0x96, 0x22, 0xb0, 0xd7, 0x39, 0x88, 0x8d, 0x1d, 0x41, 0x02, 0x82, 0x21, 0x84,
+	0x76, 0x26, 0x52, 0x4c, 0x8b, 0x27, 0xcf, 0x7c, 0x5b, 0x00, 0x4f, 0x11, 0x2c, 0xb5, 0x46, 0x07,
+	0xdc, 0x29, 0x96, 0x01, 0x97, 0x90, 0xcb, 0xd1, 0xd9, 0x57, 0xf2, 0x70, 0x93, 0x81, 0x2c, 0xe3,
+	0x46, 0x93, 0x5a, 0x40, 0x94, 0x46, 0x69, 0x49, 0xfa, 0xf9, 0xbd, 0xfa, 0x2a, 0xb5, 0xe2, 0xad,
+	0xc4, 0xcd, 0x07, 0x8d, 0x0c, 0x6e, 0x45, 0x8c, 0xe0, 0xc1, 0x3a, 0x07, 0x89, 0x74, 0x4a, 0x08,
+	0xc6, 0x1c, 0x24, 0x88, 0x18, 0xa4, 0xae, 0xfd, 0x6b, 0x8f, 0xfb, 0x47, 0xba, 0x55, 0xef, 0x68,
+	0xcd, 0x63, 0x0e, 0x37, 0x6a, 0x3e, 0xfb, 0xbe, 0x7d, 0xf9, 0xdb, 0xf2, 0x6a, 0x06, 0x9d, 0x92,
+	0x1e, 0x07, 0x64, 0x21, 0x43, 0xa6, 0xb7, 0x95, 0xfd, 0xa7, 0x69, 0xbb, 0x80, 0x22, 0x0e, 0xdc,
+	0x3d, 0xb3, 0x4f, 0x38, 0x38, 0xe6, 0x29, 0xe9, 0x7b, 0xc0, 0xc2, 0xaa, 0xce, 0x84, 0x74, 0xd7,
+	0x79, 0xbd, 0xcb, 0xaf, 0x66, 0xda, 0x75, 0xb1, 0x2f, 0x5e, 0xc5, 0x98, 0x17, 0x64, 0x50, 0xda,
+	0x32, 0x4b, 0x13, 0x09, 0xd4, 0x21, 0x5d, 0x01, 0x32, 0x5f, 0x61, 0xa5, 0xff, 0xfe, 0x48, 0x57,
+	0x84, 0x57, 0x91, 0xe6, 0x93, 0x46, 0x7e, 0xa8, 0x01, 0xfd, 0x4f, 0xa8, 0x44, 0x26, 0x70, 0xa1,
+	0x7e, 0x10, 0x19, 0xcf, 0x16, 0xbc, 0x48, 0xd2, 0xc6, 0x6d, 0x6f, 0xa8, 0x26, 0xf3, 0x6a, 0xe0,
+	0x4a, 0x3a, 0x26, 0x43, 0x48, 0xc2, 0x26, 0xfb, 0x4d, 0xb1, 0x3f, 0x21, 0x09, 0xeb, 0xe4, 0x31,
+	0xe9, 0x71, 0x86, 0xc1, 0x12, 0x84, 0xdc, 0x6f, 0xd2, 0xa8, 0xd9, 0xeb, 0x8a, 0xf9, 0xb0, 0x72,
+	0x4b, 0xc4, 0x3b, 0xb0, 0xe6, 0x25, 0xe9, 0xd7, 0x1a, 0xd3, 0x93, 0xcf, 0x9c, 0x55, 0xfd, 0x94,
+	0x66, 0xfa, 0x76, 0x67, 0x68, 0xcf, 0x3b, 0x43, 0x7b, 0xdd, 0x19, 0xda, 0xe3, 0x9b, 0xd1, 0xba,
+	0xeb, 0x94, 0x77, 0xc9, 0xef, 0xa8, 0x7b, 0xe1, 0xbc, 0x0f, 0x00, 0xda, 0x28, 0xe3, 0x18, 0xd9,
+	0x02, 0x00, 0x00,
 }
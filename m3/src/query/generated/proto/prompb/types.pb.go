@@ -109,6 +109,47 @@ func (x LabelMatcher_Type) String() string {
 }
 func (LabelMatcher_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{4, 0} }
 
+type MetricMetadata_MetricType int32
+
+const (
+	MetricMetadata_UNKNOWN        MetricMetadata_MetricType = 0
+	MetricMetadata_COUNTER        MetricMetadata_MetricType = 1
+	MetricMetadata_GAUGE          MetricMetadata_MetricType = 2
+	MetricMetadata_HISTOGRAM      MetricMetadata_MetricType = 3
+	MetricMetadata_GAUGEHISTOGRAM MetricMetadata_MetricType = 4
+	MetricMetadata_SUMMARY        MetricMetadata_MetricType = 5
+	MetricMetadata_INFO           MetricMetadata_MetricType = 6
+	MetricMetadata_STATESET       MetricMetadata_MetricType = 7
+)
+
+var MetricMetadata_MetricType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "COUNTER",
+	2: "GAUGE",
+	3: "HISTOGRAM",
+	4: "GAUGEHISTOGRAM",
+	5: "SUMMARY",
+	6: "INFO",
+	7: "STATESET",
+}
+var MetricMetadata_MetricType_value = map[string]int32{
+	"UNKNOWN":        0,
+	"COUNTER":        1,
+	"GAUGE":          2,
+	"HISTOGRAM":      3,
+	"GAUGEHISTOGRAM": 4,
+	"SUMMARY":        5,
+	"INFO":           6,
+	"STATESET":       7,
+}
+
+func (x MetricMetadata_MetricType) String() string {
+	return proto.EnumName(MetricMetadata_MetricType_name, int32(x))
+}
+func (MetricMetadata_MetricType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{5, 0}
+}
+
 type Sample struct {
 	Value     float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
 	Timestamp int64   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -248,15 +289,77 @@ func (m *LabelMatcher) GetValue() []byte {
 	return nil
 }
 
+type MetricMetadata struct {
+	// Represents the metric type, these match the set from Prometheus.
+	Type             MetricMetadata_MetricType `protobuf:"varint,1,opt,name=type,proto3,enum=m3prometheus.MetricMetadata_MetricType" json:"type,omitempty"`
+	MetricFamilyName string                    `protobuf:"bytes,2,opt,name=metric_family_name,json=metricFamilyName,proto3" json:"metric_family_name,omitempty"`
+	Help             string                    `protobuf:"bytes,4,opt,name=help,proto3" json:"help,omitempty"`
+	Unit             string                    `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (m *MetricMetadata) Reset()                    { *m = MetricMetadata{} }
+func (m *MetricMetadata) String() string            { return proto.CompactTextString(m) }
+func (*MetricMetadata) ProtoMessage()               {}
+func (*MetricMetadata) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{5} }
+
+func (m *MetricMetadata) GetType() MetricMetadata_MetricType {
+	if m != nil {
+		return m.Type
+	}
+	return MetricMetadata_UNKNOWN
+}
+
+func (m *MetricMetadata) GetMetricFamilyName() string {
+	if m != nil {
+		return m.MetricFamilyName
+	}
+	return ""
+}
+
+func (m *MetricMetadata) GetHelp() string {
+	if m != nil {
+		return m.Help
+	}
+	return ""
+}
+
+func (m *MetricMetadata) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+// MetricMetadataSet is a set of metric metadata. NB: This is a custom message
+// that M3 uses to persist the metadata received via remote write.
+type MetricMetadataSet struct {
+	Metadata []MetricMetadata `protobuf:"bytes,1,rep,name=metadata" json:"metadata"`
+}
+
+func (m *MetricMetadataSet) Reset()                    { *m = MetricMetadataSet{} }
+func (m *MetricMetadataSet) String() string            { return proto.CompactTextString(m) }
+func (*MetricMetadataSet) ProtoMessage()               {}
+func (*MetricMetadataSet) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{6} }
+
+func (m *MetricMetadataSet) GetMetadata() []MetricMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Sample)(nil), "m3prometheus.Sample")
 	proto.RegisterType((*TimeSeries)(nil), "m3prometheus.TimeSeries")
 	proto.RegisterType((*Label)(nil), "m3prometheus.Label")
 	proto.RegisterType((*Labels)(nil), "m3prometheus.Labels")
 	proto.RegisterType((*LabelMatcher)(nil), "m3prometheus.LabelMatcher")
+	proto.RegisterType((*MetricMetadata)(nil), "m3prometheus.MetricMetadata")
+	proto.RegisterType((*MetricMetadataSet)(nil), "m3prometheus.MetricMetadataSet")
 	proto.RegisterEnum("m3prometheus.Type", Type_name, Type_value)
 	proto.RegisterEnum("m3prometheus.Source", Source_name, Source_value)
 	proto.RegisterEnum("m3prometheus.LabelMatcher_Type", LabelMatcher_Type_name, LabelMatcher_Type_value)
+	proto.RegisterEnum("m3prometheus.MetricMetadata_MetricType", MetricMetadata_MetricType_name, MetricMetadata_MetricType_value)
 }
 func (m *Sample) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -438,6 +541,77 @@ func (m *LabelMatcher) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MetricMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricMetadata) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+	}
+	if len(m.MetricFamilyName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetricFamilyName)))
+		i += copy(dAtA[i:], m.MetricFamilyName)
+	}
+	if len(m.Help) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Help)))
+		i += copy(dAtA[i:], m.Help)
+	}
+	if len(m.Unit) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Unit)))
+		i += copy(dAtA[i:], m.Unit)
+	}
+	return i, nil
+}
+
+func (m *MetricMetadataSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricMetadataSet) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, msg := range m.Metadata {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -526,6 +700,39 @@ func (m *LabelMatcher) Size() (n int) {
 	return n
 }
 
+func (m *MetricMetadata) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.MetricFamilyName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Help)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MetricMetadataSet) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	for {
 		n++
@@ -1093,6 +1300,243 @@ func (m *LabelMatcher) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MetricMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (MetricMetadata_MetricType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricFamilyName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricFamilyName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Help = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricMetadataSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricMetadataSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricMetadataSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, MetricMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1203,35 +1647,45 @@ func init() {
 }
 
 var fileDescriptorTypes = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4f, 0x6b, 0xdb, 0x4e,
-	0x10, 0xd5, 0x4a, 0xb6, 0xfc, 0xcb, 0xc4, 0x84, 0x65, 0x7f, 0x39, 0x88, 0x52, 0x1c, 0xa3, 0x43,
-	0x50, 0x43, 0x6b, 0x91, 0xa8, 0xb7, 0x16, 0x4a, 0x52, 0x16, 0x27, 0x10, 0xe7, 0xcf, 0x5a, 0xbe,
-	0xf4, 0x26, 0x39, 0x13, 0xdb, 0xe0, 0x8d, 0x54, 0xad, 0x54, 0xf0, 0xb7, 0xe8, 0xad, 0x5f, 0x29,
-	0xd0, 0x4b, 0x3f, 0x41, 0x29, 0xee, 0x17, 0x29, 0xda, 0x55, 0x88, 0x53, 0x72, 0xe9, 0x45, 0xec,
-	0xbc, 0x79, 0x6f, 0xf4, 0xe6, 0x31, 0xf0, 0x61, 0xb6, 0x28, 0xe7, 0x55, 0x3a, 0x98, 0x66, 0x32,
-	0x94, 0xd1, 0x4d, 0x1a, 0xca, 0x28, 0x54, 0xc5, 0x34, 0xfc, 0x5c, 0x61, 0xb1, 0x0a, 0x67, 0x78,
-	0x87, 0x45, 0x52, 0xe2, 0x4d, 0x98, 0x17, 0x59, 0x99, 0xd5, 0x5f, 0x99, 0xa7, 0x61, 0xb9, 0xca,
-	0x51, 0x0d, 0x34, 0xc4, 0xba, 0x32, 0xaa, 0x51, 0x2c, 0xe7, 0x58, 0xa9, 0x17, 0x6f, 0x36, 0xc6,
-	0xcd, 0xb2, 0x59, 0x66, 0x74, 0x69, 0x75, 0xab, 0x2b, 0x33, 0xa4, 0x7e, 0x19, 0xb1, 0xff, 0x1e,
-	0xdc, 0x71, 0x22, 0xf3, 0x25, 0xb2, 0x5d, 0x68, 0x7f, 0x49, 0x96, 0x15, 0x7a, 0xa4, 0x4f, 0x02,
-	0x22, 0x4c, 0xc1, 0x5e, 0xc2, 0x56, 0xb9, 0x90, 0xa8, 0xca, 0x44, 0xe6, 0x9e, 0xdd, 0x27, 0x81,
-	0x23, 0x1e, 0x01, 0xff, 0x3b, 0x01, 0x88, 0x17, 0x12, 0xc7, 0x58, 0x2c, 0x50, 0xb1, 0x43, 0x70,
-	0x97, 0x49, 0x8a, 0x4b, 0xe5, 0x91, 0xbe, 0x13, 0x6c, 0x1f, 0xfd, 0x3f, 0xd8, 0xb4, 0x36, 0x38,
-	0xaf, 0x7b, 0x27, 0xad, 0xfb, 0x9f, 0x7b, 0x96, 0x68, 0x88, 0xec, 0x2d, 0x74, 0x94, 0xfe, 0xbf,
-	0xf2, 0x6c, 0xad, 0xd9, 0x7d, 0xaa, 0x31, 0xe6, 0x1a, 0xd1, 0x03, 0x95, 0xed, 0x43, 0xab, 0x4e,
-	0xc0, 0xc3, 0x3e, 0x09, 0x76, 0x8e, 0xd8, 0x53, 0x49, 0xbc, 0xca, 0x51, 0xe8, 0x3e, 0x7b, 0x0d,
-	0xae, 0xca, 0xaa, 0x62, 0x8a, 0xde, 0xad, 0x66, 0xfe, 0x3d, 0x5c, 0xf7, 0x44, 0xc3, 0xf1, 0x0f,
-	0xa1, 0xad, 0x2d, 0x32, 0x06, 0xad, 0xbb, 0x44, 0x9a, 0x24, 0xba, 0x42, 0xbf, 0x1f, 0xe3, 0xb1,
-	0x35, 0x68, 0x0a, 0xff, 0x1d, 0xb8, 0xe7, 0x66, 0x91, 0x7f, 0xdf, 0xdd, 0xff, 0x46, 0xa0, 0xab,
-	0xf1, 0x51, 0x52, 0x4e, 0xe7, 0x58, 0xb0, 0xa8, 0x59, 0x8b, 0x68, 0xb3, 0x7b, 0xcf, 0x4c, 0x68,
-	0x98, 0x9b, 0x3b, 0x3e, 0x98, 0xb5, 0x9f, 0x33, 0xeb, 0x6c, 0x9a, 0x0d, 0xa0, 0x55, 0xeb, 0x98,
-	0x0b, 0x36, 0xbf, 0xa6, 0x16, 0xeb, 0x80, 0x73, 0xc1, 0xaf, 0x29, 0xa9, 0x01, 0xc1, 0xa9, 0xad,
-	0x01, 0xc1, 0xa9, 0x73, 0xf0, 0xaa, 0x61, 0x6e, 0x41, 0x7b, 0x78, 0x3c, 0x19, 0x72, 0x6a, 0xb1,
-	0x6d, 0xe8, 0x7c, 0xbc, 0x9c, 0x5c, 0xc4, 0x5c, 0x50, 0x52, 0xe3, 0xf1, 0xd9, 0x88, 0x0b, 0x6a,
-	0x1f, 0xec, 0x83, 0x6b, 0x62, 0x64, 0x3b, 0x00, 0x57, 0xe2, 0x72, 0xc4, 0xe3, 0x53, 0x3e, 0x19,
-	0x53, 0x8b, 0x75, 0xe1, 0xbf, 0xa1, 0x38, 0xbe, 0x3a, 0x3d, 0x8b, 0x39, 0x25, 0x27, 0xde, 0xfd,
-	0xba, 0x47, 0x7e, 0xac, 0x7b, 0xe4, 0xd7, 0xba, 0x47, 0xbe, 0xfe, 0xee, 0x59, 0x9f, 0x5c, 0x73,
-	0xcb, 0xa9, 0xab, 0x2f, 0x31, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xad, 0x94, 0xb6, 0x09,
-	0x03, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0xcd, 0x38, 0x8e, 0xd3, 0xdc, 0xe6, 0x45, 0xf3, 0xe6, 0x75, 0x61, 0x3d, 0x55, 0x69, 0xe4,
+	0x45, 0x09, 0x15, 0xc4, 0x6a, 0xc3, 0xae, 0x08, 0x94, 0x22, 0x37, 0x8d, 0xa8, 0x9d, 0x76, 0xec,
+	0x08, 0xc1, 0xa6, 0x72, 0xd2, 0x69, 0x62, 0x29, 0x6e, 0x8c, 0x3d, 0x46, 0xca, 0x5f, 0xb0, 0x63,
+	0xc7, 0xf7, 0x54, 0x62, 0xc3, 0x17, 0x20, 0x54, 0x7e, 0x04, 0xcd, 0xd8, 0x6d, 0x62, 0xa8, 0x90,
+	0xd8, 0x44, 0xf7, 0x9e, 0x7b, 0xcf, 0x9d, 0x73, 0xee, 0x4c, 0x0c, 0x2f, 0xa7, 0x01, 0x9f, 0xa5,
+	0xe3, 0xce, 0x64, 0x11, 0x9a, 0x61, 0xf7, 0x72, 0x6c, 0x86, 0x5d, 0x33, 0x89, 0x27, 0xe6, 0xfb,
+	0x94, 0xc5, 0x4b, 0x73, 0xca, 0xae, 0x59, 0xec, 0x73, 0x76, 0x69, 0x46, 0xf1, 0x82, 0x2f, 0xc4,
+	0x6f, 0x18, 0x8d, 0x4d, 0xbe, 0x8c, 0x58, 0xd2, 0x91, 0x10, 0xa9, 0x87, 0x5d, 0x81, 0x32, 0x3e,
+	0x63, 0x69, 0xf2, 0xff, 0xd3, 0xb5, 0x71, 0xd3, 0xc5, 0x74, 0x91, 0xf1, 0xc6, 0xe9, 0x95, 0xcc,
+	0xb2, 0x21, 0x22, 0xca, 0xc8, 0xc6, 0x73, 0xd0, 0x5c, 0x3f, 0x8c, 0xe6, 0x8c, 0x6c, 0x41, 0xe5,
+	0x83, 0x3f, 0x4f, 0x99, 0x8e, 0x5a, 0xa8, 0x8d, 0x68, 0x96, 0x90, 0x6d, 0xa8, 0xf1, 0x20, 0x64,
+	0x09, 0xf7, 0xc3, 0x48, 0x57, 0x5a, 0xa8, 0x5d, 0xa6, 0x2b, 0xc0, 0xf8, 0x82, 0x00, 0xbc, 0x20,
+	0x64, 0x2e, 0x8b, 0x03, 0x96, 0x90, 0x7d, 0xd0, 0xe6, 0xfe, 0x98, 0xcd, 0x13, 0x1d, 0xb5, 0xca,
+	0xed, 0xcd, 0x83, 0xff, 0x3a, 0xeb, 0xd2, 0x3a, 0xa7, 0xa2, 0x76, 0xa4, 0xde, 0x7c, 0xdb, 0x29,
+	0xd1, 0xbc, 0x91, 0x3c, 0x83, 0x6a, 0x22, 0xcf, 0x4f, 0x74, 0x45, 0x72, 0xb6, 0x8a, 0x9c, 0x4c,
+	0x5c, 0x4e, 0xba, 0x6b, 0x25, 0xbb, 0xa0, 0x8a, 0x0d, 0xe8, 0xac, 0x85, 0xda, 0x8d, 0x03, 0x52,
+	0xa4, 0x78, 0xcb, 0x88, 0x51, 0x59, 0x27, 0x4f, 0x40, 0x4b, 0x16, 0x69, 0x3c, 0x61, 0xfa, 0x95,
+	0xec, 0xfc, 0x75, 0xb8, 0xac, 0xd1, 0xbc, 0xc7, 0xd8, 0x87, 0x8a, 0x94, 0x48, 0x08, 0xa8, 0xd7,
+	0x7e, 0x98, 0x6d, 0xa2, 0x4e, 0x65, 0xbc, 0x5a, 0x8f, 0x22, 0xc1, 0x2c, 0x31, 0x0e, 0x41, 0x3b,
+	0xcd, 0x8c, 0xfc, 0xbd, 0x77, 0xe3, 0x13, 0x82, 0xba, 0xc4, 0x6d, 0x9f, 0x4f, 0x66, 0x2c, 0x26,
+	0xdd, 0xdc, 0x16, 0x92, 0x62, 0x77, 0x1e, 0x98, 0x90, 0x77, 0xae, 0x7b, 0xbc, 0x13, 0xab, 0x3c,
+	0x24, 0xb6, 0xbc, 0x2e, 0xb6, 0x0d, 0xaa, 0xe0, 0x11, 0x0d, 0x14, 0xeb, 0x1c, 0x97, 0x48, 0x15,
+	0xca, 0x8e, 0x75, 0x8e, 0x91, 0x00, 0xa8, 0x85, 0x15, 0x09, 0x50, 0x0b, 0x97, 0x8d, 0xcf, 0x0a,
+	0x34, 0x6c, 0xc6, 0xe3, 0x60, 0x62, 0x33, 0xee, 0x5f, 0xfa, 0xdc, 0x27, 0x87, 0x05, 0x6d, 0x8f,
+	0x8a, 0xda, 0x8a, 0xbd, 0x79, 0x5a, 0xb8, 0x07, 0x12, 0x4a, 0xec, 0xe2, 0xca, 0x0f, 0x83, 0xf9,
+	0xf2, 0xe2, 0x5e, 0x71, 0x8d, 0xe2, 0xac, 0x72, 0x2c, 0x0b, 0x8e, 0x50, 0x4f, 0x40, 0x9d, 0xb1,
+	0x79, 0xa4, 0xab, 0xb2, 0x2e, 0x63, 0x81, 0xa5, 0xd7, 0x01, 0xd7, 0x2b, 0x19, 0x26, 0x62, 0x63,
+	0x09, 0xb0, 0x3a, 0x89, 0x6c, 0x42, 0x75, 0xe4, 0xbc, 0x76, 0x86, 0x6f, 0x1c, 0x5c, 0x12, 0xc9,
+	0xab, 0xe1, 0xc8, 0xf1, 0x2c, 0x8a, 0x11, 0xa9, 0x41, 0xa5, 0xdf, 0x1b, 0xf5, 0x85, 0xc3, 0x7f,
+	0xa0, 0x76, 0x32, 0x70, 0xbd, 0x61, 0x9f, 0xf6, 0x6c, 0x5c, 0x26, 0x04, 0x1a, 0xb2, 0xb2, 0xc2,
+	0x54, 0x41, 0x75, 0x47, 0xb6, 0xdd, 0xa3, 0x6f, 0x71, 0x85, 0x6c, 0x80, 0x3a, 0x70, 0x8e, 0x87,
+	0x58, 0x23, 0x75, 0xd8, 0x70, 0xbd, 0x9e, 0x67, 0xb9, 0x96, 0x87, 0xab, 0x86, 0x0b, 0xff, 0x16,
+	0x3d, 0xbb, 0x8c, 0x93, 0x17, 0xb0, 0x11, 0xe6, 0x69, 0xfe, 0x08, 0xb6, 0xff, 0xb4, 0xa6, 0xfc,
+	0x35, 0xdc, 0x73, 0xf6, 0x1e, 0xe7, 0xf7, 0x73, 0xaf, 0xf7, 0x77, 0x1f, 0xde, 0xc0, 0xb6, 0x28,
+	0x56, 0xf6, 0x76, 0x41, 0xcb, 0x1e, 0x2f, 0x69, 0x00, 0x9c, 0xd1, 0xa1, 0x6d, 0x79, 0x27, 0xd6,
+	0xc8, 0xc5, 0x25, 0xa1, 0xb3, 0x4f, 0x7b, 0x67, 0x27, 0x03, 0xcf, 0xc2, 0xe8, 0x48, 0xbf, 0xb9,
+	0x6d, 0xa2, 0xaf, 0xb7, 0x4d, 0xf4, 0xfd, 0xb6, 0x89, 0x3e, 0xfe, 0x68, 0x96, 0xde, 0x69, 0xd9,
+	0x17, 0x64, 0xac, 0xc9, 0xff, 0x7f, 0xf7, 0xe7, 0x00, 0xa2, 0xd2, 0x0d, 0x4f, 0x7f, 0x04, 0x00,
+	0x00,
 }
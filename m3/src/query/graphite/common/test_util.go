@@ -22,6 +22,7 @@ package common
 
 import (
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -135,6 +136,15 @@ func (s *MovingAverageStorage) FetchByQuery(
 	return s.fetchByIDs(ctx, []string{query}, opts)
 }
 
+// FetchByTags builds a new series from the input tag expressions
+func (s *MovingAverageStorage) FetchByTags(
+	ctx context.Context,
+	tagExpressions []string,
+	opts storage.FetchOptions,
+) (*storage.FetchResult, error) {
+	return s.fetchByIDs(ctx, []string{strings.Join(tagExpressions, ";")}, opts)
+}
+
 // FetchByIDs builds a new series from the input query
 func (s *MovingAverageStorage) fetchByIDs(
 	ctx context.Context,
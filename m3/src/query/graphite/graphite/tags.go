@@ -20,7 +20,13 @@
 
 package graphite
 
-import "fmt"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 const (
 	// graphiteFormat is the format for graphite metric tag names, which will be
@@ -38,8 +44,122 @@ const (
 
 	// MatchAllPattern that is used to match all metrics.
 	MatchAllPattern = ".*"
+
+	// NameTag is the graphite tag which holds the dotted path of a series.
+	NameTag = "name"
+
+	// tagSeparator separates the name and tags of a tagged series name, e.g.
+	// cpu.load;dc=us-east;host=web01
+	tagSeparator = ";"
 )
 
+// TagOperator is an operator for a graphite tag expression.
+type TagOperator string
+
+const (
+	// TagEqual matches series whose tag value is equal to the given value.
+	TagEqual TagOperator = "="
+	// TagNotEqual matches series whose tag value is not equal to the given
+	// value.
+	TagNotEqual TagOperator = "!="
+	// TagRegexp matches series whose tag value matches the given regexp.
+	TagRegexp TagOperator = "=~"
+	// TagNotRegexp matches series whose tag value does not match the given
+	// regexp.
+	TagNotRegexp TagOperator = "!=~"
+)
+
+var errEmptyTagExpression = errors.New("tag expression must not be empty")
+
+// TagExpression is a parsed graphite tag expression, e.g. `dc=~us-.*`.
+type TagExpression struct {
+	Tag      string
+	Operator TagOperator
+	Value    string
+}
+
+// ParseTagExpression parses a graphite tag expression of the form
+// `tag<operator>value`, where operator is one of =, !=, =~ or !=~.
+func ParseTagExpression(expr string) (TagExpression, error) {
+	if len(expr) == 0 {
+		return TagExpression{}, errEmptyTagExpression
+	}
+
+	idx := strings.Index(expr, "=")
+	if idx <= 0 {
+		return TagExpression{}, fmt.Errorf("invalid tag expression: %s", expr)
+	}
+
+	var (
+		tag   = expr[:idx]
+		value = expr[idx+1:]
+		op    = TagEqual
+	)
+
+	if strings.HasSuffix(tag, "!") {
+		tag = tag[:len(tag)-1]
+		op = TagNotEqual
+	}
+
+	if strings.HasPrefix(value, "~") {
+		value = value[1:]
+		if op == TagEqual {
+			op = TagRegexp
+		} else {
+			op = TagNotRegexp
+		}
+	}
+
+	if len(tag) == 0 {
+		return TagExpression{}, fmt.Errorf("invalid tag expression: %s", expr)
+	}
+
+	return TagExpression{
+		Tag:      tag,
+		Operator: op,
+		Value:    value,
+	}, nil
+}
+
+// ParseTaggedName parses the tags out of a series name in the graphite tagged
+// series format `path;tag1=value1;tag2=value2`. The path is returned under
+// the name tag.
+func ParseTaggedName(name string) map[string]string {
+	parts := strings.Split(name, tagSeparator)
+	tags := make(map[string]string, len(parts))
+	tags[NameTag] = parts[0]
+	for _, part := range parts[1:] {
+		idx := strings.Index(part, "=")
+		if idx <= 0 {
+			continue
+		}
+
+		tags[part[:idx]] = part[idx+1:]
+	}
+
+	return tags
+}
+
+// TagIndex returns the graphite path index for the given tag name, and false
+// if the tag name is not a graphite path tag.
+func TagIndex(name []byte) (int, bool) {
+	if !bytes.HasPrefix(name, []byte("__g")) || !bytes.HasSuffix(name, []byte("__")) {
+		return 0, false
+	}
+
+	digits := name[len("__g") : len(name)-len("__")]
+	if len(digits) == 0 {
+		return 0, false
+	}
+
+	idx, err := strconv.Atoi(string(digits))
+	if err != nil || idx < 0 {
+		return 0, false
+	}
+
+	return idx, true
+}
+
 var (
 	// Should never be modified after init().
 	preFormattedTagNames [][]byte
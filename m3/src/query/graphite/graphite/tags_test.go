@@ -33,3 +33,50 @@ func TestTagName(t *testing.T) {
 		require.Equal(t, expected, TagName(i))
 	}
 }
+
+func TestParseTagExpression(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected TagExpression
+	}{
+		{"dc=us-east", TagExpression{Tag: "dc", Operator: TagEqual, Value: "us-east"}},
+		{"dc!=us-east", TagExpression{Tag: "dc", Operator: TagNotEqual, Value: "us-east"}},
+		{"dc=~us-.*", TagExpression{Tag: "dc", Operator: TagRegexp, Value: "us-.*"}},
+		{"dc!=~us-.*", TagExpression{Tag: "dc", Operator: TagNotRegexp, Value: "us-.*"}},
+		{"dc=", TagExpression{Tag: "dc", Operator: TagEqual, Value: ""}},
+		{"name=a.b=c", TagExpression{Tag: "name", Operator: TagEqual, Value: "a.b=c"}},
+	}
+
+	for _, tt := range tests {
+		actual, err := ParseTagExpression(tt.expr)
+		require.NoError(t, err, tt.expr)
+		require.Equal(t, tt.expected, actual, tt.expr)
+	}
+
+	for _, invalid := range []string{"", "dc", "=us-east", "!=us-east"} {
+		_, err := ParseTagExpression(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestParseTaggedName(t *testing.T) {
+	require.Equal(t, map[string]string{"name": "a.b.c"}, ParseTaggedName("a.b.c"))
+	require.Equal(t, map[string]string{
+		"name": "cpu.load",
+		"dc":   "us-east",
+		"host": "web01",
+	}, ParseTaggedName("cpu.load;dc=us-east;host=web01"))
+}
+
+func TestTagIndex(t *testing.T) {
+	for i := 0; i < 2*numPreFormattedTagNames; i++ {
+		idx, ok := TagIndex(TagName(i))
+		require.True(t, ok)
+		require.Equal(t, i, idx)
+	}
+
+	for _, name := range []string{"__name__", "__g__", "__gx__", "dc", "__g1"} {
+		_, ok := TagIndex([]byte(name))
+		require.False(t, ok, name)
+	}
+}
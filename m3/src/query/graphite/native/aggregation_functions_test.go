@@ -140,6 +140,12 @@ type mockEngine struct {
 		query string,
 		options storage.FetchOptions,
 	) (*storage.FetchResult, error)
+
+	tagsFn func(
+		ctx context.Context,
+		tagExpressions []string,
+		options storage.FetchOptions,
+	) (*storage.FetchResult, error)
 }
 
 func (e mockEngine) FetchByQuery(
@@ -150,6 +156,14 @@ func (e mockEngine) FetchByQuery(
 	return e.fn(ctx, query, opts)
 }
 
+func (e mockEngine) FetchByTags(
+	ctx context.Context,
+	tagExpressions []string,
+	opts storage.FetchOptions,
+) (*storage.FetchResult, error) {
+	return e.tagsFn(ctx, tagExpressions, opts)
+}
+
 func TestVariadicSumSeries(t *testing.T) {
 	expr, err := compile("sumSeries(foo.bar.*, foo.baz.*)")
 	require.NoError(t, err)
@@ -184,6 +184,7 @@ var (
 		"min":           minFuncInfo,
 		"last":          lastFuncInfo,
 		"avg":           avgFuncInfo,
+		"average":       avgFuncInfo,
 		"sumSeries":     sumFuncInfo,
 		"maxSeries":     maxFuncInfo,
 		"minSeries":     minFuncInfo,
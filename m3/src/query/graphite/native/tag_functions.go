@@ -0,0 +1,301 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/errors"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/graphite/storage"
+	"github.com/m3db/m3/src/query/graphite/ts"
+)
+
+// seriesByTag returns the series matching all of the given tag expressions.
+// Each expression is of the form `tag<operator>value` where operator is one
+// of =, !=, =~ or !=~, e.g.
+//
+//    &target=seriesByTag('name=cpu.load', 'dc=~us-.*', 'host!=web01')
+//
+// At least one expression must match a non-empty value.
+func seriesByTag(ctx *common.Context, tagExpressions ...string) (ts.SeriesList, error) {
+	if len(tagExpressions) == 0 {
+		err := errors.NewInvalidParamsError(errors.New("seriesByTag requires at least one tag expression"))
+		return ts.NewSeriesList(), err
+	}
+
+	begin := time.Now()
+	opts := storage.FetchOptions{
+		StartTime: ctx.StartTime,
+		EndTime:   ctx.EndTime,
+		DataOptions: storage.DataOptions{
+			Timeout: ctx.Timeout,
+			Limit:   ctx.Limit,
+		},
+	}
+
+	result, err := ctx.Engine.FetchByTags(ctx, tagExpressions, opts)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+
+	spec := seriesByTagSpecification(tagExpressions)
+	if ctx.TracingEnabled() {
+		ctx.Trace(common.Trace{
+			ActivityName: fmt.Sprintf("fetch %s", spec),
+			Duration:     time.Since(begin),
+			Outputs:      common.TraceStats{NumSeries: len(result.SeriesList)},
+		})
+	}
+
+	for _, r := range result.SeriesList {
+		r.Specification = spec
+	}
+
+	return ts.SeriesList{
+		Values:   result.SeriesList,
+		Metadata: result.Metadata,
+	}, nil
+}
+
+func seriesByTagSpecification(tagExpressions []string) string {
+	quoted := make([]string, 0, len(tagExpressions))
+	for _, expr := range tagExpressions {
+		quoted = append(quoted, fmt.Sprintf("'%s'", expr))
+	}
+
+	return fmt.Sprintf("seriesByTag(%s)", strings.Join(quoted, ","))
+}
+
+// groupByTags takes a serieslist and groups it by the values of the given
+// tags, combining each group with the given aggregation function
+//
+//    &target=groupByTags(seriesByTag('name=cpu.load'),"sum","dc","env")
+//
+// Each resulting series is named for the aggregation function followed by the
+// grouped tags, e.g. sum;dc=us-east;env=prod. If the name tag is one of the
+// grouped tags the series path is used in place of the function name.
+func groupByTags(ctx *common.Context, series singlePathSpec, fname string, tags ...string) (ts.SeriesList, error) {
+	if len(tags) == 0 {
+		err := errors.NewInvalidParamsError(errors.New("groupByTags requires at least one tag"))
+		return ts.NewSeriesList(), err
+	}
+
+	f, fexists := summarizeFuncs[fname]
+	if !fexists {
+		return ts.NewSeriesList(), errors.NewInvalidParamsError(fmt.Errorf("invalid func %s", fname))
+	}
+
+	var (
+		groupTags = make([]string, 0, len(tags))
+		byName    bool
+	)
+
+	for _, tag := range tags {
+		if tag == graphite.NameTag {
+			byName = true
+			continue
+		}
+
+		groupTags = append(groupTags, tag)
+	}
+
+	sort.Strings(groupTags)
+
+	metaSeries := make(map[string][]*ts.Series)
+	for _, s := range series.Values {
+		seriesTags := s.Tags()
+		key := fname
+		if byName {
+			key = seriesTags[graphite.NameTag]
+		}
+
+		for _, tag := range groupTags {
+			key += fmt.Sprintf(";%s=%s", tag, seriesTags[tag])
+		}
+
+		metaSeries[key] = append(metaSeries[key], s)
+	}
+
+	newSeries := make([]*ts.Series, 0, len(metaSeries))
+	for key, metaSeries := range metaSeries {
+		seriesList := ts.SeriesList{
+			Values:   metaSeries,
+			Metadata: series.Metadata,
+		}
+		output, err := combineSeries(ctx, multiplePathSpecs(seriesList), key, f.consolidationFunc)
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+		output.Values[0].Specification = f.specificationFunc(seriesList)
+		newSeries = append(newSeries, output.Values...)
+	}
+
+	r := ts.SeriesList(series)
+
+	r.Values = newSeries
+
+	// Ranging over hash map to create results destroys
+	// any sort order on the incoming series list
+	r.SortApplied = false
+
+	return r, nil
+}
+
+// aliasByTags renames each series according to the given tags and nodes. A
+// numeric argument selects the node at that index of the series path, while
+// a string argument selects the value of that tag; the parts are joined
+// with dots.
+//
+//    &target=aliasByTags(seriesByTag('name=cpu.load'),1,"dc")
+func aliasByTags(ctx *common.Context, series singlePathSpec, tags ...genericInterface) (ts.SeriesList, error) {
+	renamed := make([]*ts.Series, 0, len(series.Values))
+	for _, s := range series.Values {
+		var (
+			seriesTags = s.Tags()
+			path       = strings.Split(seriesTags[graphite.NameTag], ".")
+			parts      = make([]string, 0, len(tags))
+		)
+
+		for _, tag := range tags {
+			switch v := tag.(type) {
+			case string:
+				parts = append(parts, seriesTags[v])
+			case float64, int:
+				node := toNodeIndex(v)
+				if node < 0 {
+					node += len(path)
+				}
+
+				if node < 0 || node >= len(path) {
+					err := errors.NewInvalidParamsError(fmt.Errorf(
+						"could not alias %s by node %v; not enough parts", s.Name(), v))
+					return ts.NewSeriesList(), err
+				}
+
+				parts = append(parts, path[node])
+			default:
+				err := errors.NewInvalidParamsError(fmt.Errorf(
+					"invalid tag or node %v of type %T", tag, tag))
+				return ts.NewSeriesList(), err
+			}
+		}
+
+		renamed = append(renamed, s.RenamedTo(strings.Join(parts, ".")))
+	}
+
+	series.Values = renamed
+	return ts.SeriesList(series), nil
+}
+
+func toNodeIndex(v genericInterface) int {
+	if f, ok := v.(float64); ok {
+		return int(f)
+	}
+
+	return v.(int)
+}
+
+// aggregateWithWildcards splits the given set of series into sub-groupings
+// based on wildcard matches in the hierarchy, then combines the values in each
+// grouping with the given aggregation function
+//
+//    &target=aggregateWithWildcards(host.cpu-[0-7].cpu-user.value,"sum",1)
+func aggregateWithWildcards(
+	ctx *common.Context,
+	series singlePathSpec,
+	fname string,
+	positions ...int,
+) (ts.SeriesList, error) {
+	f, fexists := summarizeFuncs[fname]
+	if !fexists {
+		return ts.NewSeriesList(), errors.NewInvalidParamsError(fmt.Errorf("invalid func %s", fname))
+	}
+
+	return combineSeriesWithWildcards(ctx, series, positions, f.specificationFunc, f.consolidationFunc)
+}
+
+// applyByNode takes a seriesList and applies the template function to each
+// unique prefix of the series paths up to the given node. Each occurrence of
+// % in the template is replaced by the prefix, e.g.
+//
+//    &target=applyByNode(servers.*.disk.bytes_free,1,"divideSeries(%.disk.bytes_free,sumSeries(%.disk.bytes_*))")
+//
+// If newName is given, the resulting series are renamed to it with each
+// occurrence of % replaced by the prefix.
+func applyByNode(
+	ctx *common.Context,
+	series singlePathSpec,
+	nodeNum int,
+	templateFunction string,
+	newName string,
+) (ts.SeriesList, error) {
+	var (
+		prefixes = make([]string, 0, len(series.Values))
+		seen     = make(map[string]struct{}, len(series.Values))
+	)
+
+	for _, s := range series.Values {
+		parts := strings.Split(s.Name(), ".")
+		if nodeNum < 0 || nodeNum >= len(parts) {
+			err := errors.NewInvalidParamsError(fmt.Errorf(
+				"could not apply %s by node %d; not enough parts", s.Name(), nodeNum))
+			return ts.NewSeriesList(), err
+		}
+
+		prefix := strings.Join(parts[:nodeNum+1], ".")
+		if _, ok := seen[prefix]; ok {
+			continue
+		}
+
+		seen[prefix] = struct{}{}
+		prefixes = append(prefixes, prefix)
+	}
+
+	r := ts.SeriesList(series)
+	r.Values = make([]*ts.Series, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		expr, err := compile(strings.Replace(templateFunction, "%", prefix, -1))
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+
+		result, err := expr.Execute(ctx)
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+
+		r.Metadata = r.Metadata.CombineMetadata(result.Metadata)
+		for _, s := range result.Values {
+			if newName != "" {
+				s = s.RenamedTo(strings.Replace(newName, "%", prefix, -1))
+			}
+
+			r.Values = append(r.Values, s)
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/context"
+	"github.com/m3db/m3/src/query/graphite/storage"
+	"github.com/m3db/m3/src/query/graphite/ts"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTaggedTestSeries(ctx context.Context) []*ts.Series {
+	start := consolidationStartTime
+	return []*ts.Series{
+		ts.NewSeries(ctx, "cpu.load;dc=east;host=a", start, ts.NewConstantValues(ctx, 1, 3, 1000)),
+		ts.NewSeries(ctx, "cpu.load;dc=east;host=b", start, ts.NewConstantValues(ctx, 2, 3, 1000)),
+		ts.NewSeries(ctx, "cpu.load;dc=west;host=c", start, ts.NewConstantValues(ctx, 4, 3, 1000)),
+		ts.NewSeries(ctx, "cpu.idle;dc=west;host=c", start, ts.NewConstantValues(ctx, 8, 3, 1000)),
+	}
+}
+
+func TestSeriesByTag(t *testing.T) {
+	expr, err := compile("seriesByTag('name=cpu.load', 'dc=~e.*')")
+	require.NoError(t, err)
+
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	ctx.Engine = mockEngine{tagsFn: func(
+		ctx context.Context,
+		tagExpressions []string,
+		options storage.FetchOptions,
+	) (*storage.FetchResult, error) {
+		assert.Equal(t, []string{"name=cpu.load", "dc=~e.*"}, tagExpressions)
+		return storage.NewFetchResult(ctx, newTaggedTestSeries(ctx)[:2],
+			block.NewResultMetadata()), nil
+	}}
+
+	r, err := expr.Execute(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, r.Len())
+	for _, s := range r.Values {
+		assert.Equal(t, "seriesByTag('name=cpu.load','dc=~e.*')", s.Specification)
+	}
+}
+
+func TestSeriesByTagNoExpressions(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	_, err := seriesByTag(ctx)
+	require.Error(t, err)
+}
+
+func TestGroupByTags(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	input := singlePathSpec{Values: newTaggedTestSeries(ctx)}
+	tests := []struct {
+		fname    string
+		tags     []string
+		expected map[string]float64
+	}{
+		{"sum", []string{"dc"}, map[string]float64{
+			"sum;dc=east": 3,
+			"sum;dc=west": 12,
+		}},
+		{"max", []string{"name", "dc"}, map[string]float64{
+			"cpu.load;dc=east": 2,
+			"cpu.load;dc=west": 4,
+			"cpu.idle;dc=west": 8,
+		}},
+		{"avg", []string{"host", "dc"}, map[string]float64{
+			"avg;dc=east;host=a": 1,
+			"avg;dc=east;host=b": 2,
+			"avg;dc=west;host=c": 6,
+		}},
+	}
+
+	for _, test := range tests {
+		out, err := groupByTags(ctx, input, test.fname, test.tags...)
+		require.NoError(t, err)
+
+		actual := make(map[string]float64, out.Len())
+		for _, s := range out.Values {
+			actual[s.Name()] = s.ValueAt(0)
+		}
+
+		assert.Equal(t, test.expected, actual)
+	}
+
+	out, err := groupByTags(ctx, input, "sum", "dc")
+	require.NoError(t, err)
+	sort.Sort(ts.SeriesByName(out.Values))
+	assert.Equal(t, map[string]string{"name": "sum", "dc": "east"}, out.Values[0].Tags())
+}
+
+func TestGroupByTagsErrors(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	input := singlePathSpec{Values: newTaggedTestSeries(ctx)}
+	_, err := groupByTags(ctx, input, "sum")
+	require.Error(t, err)
+
+	_, err = groupByTags(ctx, input, "unknown", "dc")
+	require.Error(t, err)
+}
+
+func TestAliasByTags(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	ctx.Engine = mockEngine{tagsFn: func(
+		ctx context.Context,
+		_ []string,
+		_ storage.FetchOptions,
+	) (*storage.FetchResult, error) {
+		return storage.NewFetchResult(ctx, newTaggedTestSeries(ctx),
+			block.NewResultMetadata()), nil
+	}}
+
+	expr, err := compile("aliasByTags(seriesByTag('name=~cpu.*'), 1, 'dc', -2, 'missing')")
+	require.NoError(t, err)
+
+	r, err := expr.Execute(ctx)
+	require.NoError(t, err)
+
+	names := make([]string, 0, r.Len())
+	for _, s := range r.Values {
+		names = append(names, s.Name())
+	}
+
+	assert.Equal(t, []string{
+		"load.east.cpu.",
+		"load.east.cpu.",
+		"load.west.cpu.",
+		"idle.west.cpu.",
+	}, names)
+}
+
+func TestAliasByTagsInvalidNode(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	input := singlePathSpec{Values: newTaggedTestSeries(ctx)}
+	_, err := aliasByTags(ctx, input, 2.0)
+	require.Error(t, err)
+
+	_, err = aliasByTags(ctx, input, true)
+	require.Error(t, err)
+}
+
+func TestAggregateWithWildcards(t *testing.T) {
+	ctx, input := newConsolidationTestSeries()
+	defer ctx.Close()
+
+	out, err := aggregateWithWildcards(ctx, singlePathSpec{Values: []*ts.Series{
+		input[0].RenamedTo("foo.a.bar"),
+		input[1].RenamedTo("foo.b.bar"),
+		input[2].RenamedTo("foo.c.baz"),
+	}}, "max", 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, out.Len())
+
+	sort.Sort(ts.SeriesByName(out.Values))
+	assert.Equal(t, "foo.bar", out.Values[0].Name())
+	assert.Equal(t, "foo.baz", out.Values[1].Name())
+	assert.Equal(t, "maxSeries(a,b)", out.Values[0].Specification)
+
+	_, err = aggregateWithWildcards(ctx, singlePathSpec{Values: input}, "unknown", 1)
+	require.Error(t, err)
+}
+
+func TestApplyByNode(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	start := ctx.StartTime
+	ctx.Engine = mockEngine{fn: func(
+		ctx context.Context,
+		query string,
+		options storage.FetchOptions,
+	) (*storage.FetchResult, error) {
+		var series []*ts.Series
+		switch query {
+		case "servers.s1.disk.bytes_free":
+			series = []*ts.Series{
+				ts.NewSeries(ctx, query, start, ts.NewConstantValues(ctx, 10, 3, 1000)),
+			}
+		case "servers.s2.disk.bytes_free":
+			series = []*ts.Series{
+				ts.NewSeries(ctx, query, start, ts.NewConstantValues(ctx, 20, 3, 1000)),
+			}
+		case "servers.s1.disk.bytes_*":
+			series = []*ts.Series{
+				ts.NewSeries(ctx, "servers.s1.disk.bytes_free", start, ts.NewConstantValues(ctx, 10, 3, 1000)),
+				ts.NewSeries(ctx, "servers.s1.disk.bytes_used", start, ts.NewConstantValues(ctx, 30, 3, 1000)),
+			}
+		case "servers.s2.disk.bytes_*":
+			series = []*ts.Series{
+				ts.NewSeries(ctx, "servers.s2.disk.bytes_free", start, ts.NewConstantValues(ctx, 20, 3, 1000)),
+				ts.NewSeries(ctx, "servers.s2.disk.bytes_used", start, ts.NewConstantValues(ctx, 20, 3, 1000)),
+			}
+		default:
+			return nil, fmt.Errorf("unexpected query: %s", query)
+		}
+
+		return storage.NewFetchResult(ctx, series, block.NewResultMetadata()), nil
+	}}
+
+	input := singlePathSpec{Values: []*ts.Series{
+		ts.NewSeries(ctx, "servers.s1.disk.bytes_free", start, ts.NewConstantValues(ctx, 10, 3, 1000)),
+		ts.NewSeries(ctx, "servers.s1.disk.bytes_used", start, ts.NewConstantValues(ctx, 30, 3, 1000)),
+		ts.NewSeries(ctx, "servers.s2.disk.bytes_free", start, ts.NewConstantValues(ctx, 20, 3, 1000)),
+	}}
+
+	out, err := applyByNode(ctx, input, 1,
+		"divideSeries(%.disk.bytes_free,sumSeries(%.disk.bytes_*))", "%.disk.pct_free")
+	require.NoError(t, err)
+	require.Equal(t, 2, out.Len())
+	assert.Equal(t, "servers.s1.disk.pct_free", out.Values[0].Name())
+	assert.Equal(t, []float64{0.25, 0.25, 0.25}, out.Values[0].SafeValues())
+	assert.Equal(t, "servers.s2.disk.pct_free", out.Values[1].Name())
+	assert.Equal(t, []float64{0.5, 0.5, 0.5}, out.Values[1].SafeValues())
+
+	_, err = applyByNode(ctx, input, 4, "%", "")
+	require.Error(t, err)
+}
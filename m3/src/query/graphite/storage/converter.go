@@ -21,6 +21,10 @@
 package storage
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models"
 )
@@ -65,3 +69,159 @@ func matcherTerminator(count int) models.Matcher {
 		Name: graphite.TagName(count),
 	}
 }
+
+// nameFilter filters fetched series by their dotted path; it is used for
+// expressions on the name tag which can not be resolved by the index.
+type nameFilter func(name string) bool
+
+// anchorTagRegexp anchors a graphite tag regexp to the start of the value,
+// matching graphite's prefix semantics for the =~ and !=~ operators.
+func anchorTagRegexp(value string) string {
+	return "(?:" + value + ").*"
+}
+
+// convertTagExpressionsToMatchers converts graphite tag expressions, as used
+// by seriesByTag, to tag matchers. Expressions on the name tag other than
+// equality are returned as name filters to apply to the fetched series.
+func convertTagExpressionsToMatchers(
+	exprs []string,
+) (models.Matchers, []nameFilter, error) {
+	var (
+		matchers    = make(models.Matchers, 0, len(exprs))
+		filters     []nameFilter
+		hasPositive bool
+		hasPath     bool
+	)
+
+	for _, e := range exprs {
+		expr, err := graphite.ParseTagExpression(e)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch expr.Operator {
+		case graphite.TagEqual:
+			hasPositive = hasPositive || len(expr.Value) > 0
+		case graphite.TagRegexp:
+			hasPositive = true
+		}
+
+		if expr.Tag == graphite.NameTag {
+			pathMatchers, filter, err := convertNameExpression(expr)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			matchers = append(matchers, pathMatchers...)
+			if filter != nil {
+				filters = append(filters, filter)
+			}
+
+			hasPath = true
+			continue
+		}
+
+		m, err := convertTagExpression(expr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		matchers = append(matchers, m)
+	}
+
+	if !hasPositive {
+		return nil, nil, fmt.Errorf("at least one tag expression must match "+
+			"a non-empty value: %s", strings.Join(exprs, ","))
+	}
+
+	if !hasPath {
+		// NB: restrict results to graphite series, which always have a first
+		// path part.
+		matchers = append(matchers, models.Matcher{
+			Type: models.MatchField,
+			Name: graphite.TagName(0),
+		})
+	}
+
+	return matchers, filters, nil
+}
+
+func convertTagExpression(expr graphite.TagExpression) (models.Matcher, error) {
+	var (
+		name  = []byte(expr.Tag)
+		value = []byte(expr.Value)
+	)
+
+	switch expr.Operator {
+	case graphite.TagEqual:
+		if len(value) == 0 {
+			return models.NewMatcher(models.MatchNotField, name, nil)
+		}
+
+		return models.NewMatcher(models.MatchEqual, name, value)
+	case graphite.TagNotEqual:
+		if len(value) == 0 {
+			return models.NewMatcher(models.MatchField, name, nil)
+		}
+
+		return models.NewMatcher(models.MatchNotEqual, name, value)
+	case graphite.TagRegexp:
+		return models.NewMatcher(models.MatchRegexp, name,
+			[]byte(anchorTagRegexp(expr.Value)))
+	case graphite.TagNotRegexp:
+		return models.NewMatcher(models.MatchNotRegexp, name,
+			[]byte(anchorTagRegexp(expr.Value)))
+	}
+
+	return models.Matcher{}, fmt.Errorf("unknown tag operator: %s", expr.Operator)
+}
+
+func convertNameExpression(
+	expr graphite.TagExpression,
+) (models.Matchers, nameFilter, error) {
+	if expr.Operator == graphite.TagEqual {
+		parts := strings.Split(expr.Value, ".")
+		matchers := make(models.Matchers, 0, len(parts)+1)
+		for i, part := range parts {
+			if len(part) == 0 {
+				return nil, nil, fmt.Errorf("invalid name: %q", expr.Value)
+			}
+
+			matchers = append(matchers, models.Matcher{
+				Type:  models.MatchEqual,
+				Name:  graphite.TagName(i),
+				Value: []byte(part),
+			})
+		}
+
+		matchers = append(matchers, matcherTerminator(len(parts)))
+		return matchers, nil, nil
+	}
+
+	// NB: the remaining operators can not be expressed on the path tags, so
+	// fetch every graphite series matching the other expressions and filter
+	// by name afterwards.
+	matchers := models.Matchers{{
+		Type: models.MatchField,
+		Name: graphite.TagName(0),
+	}}
+
+	switch expr.Operator {
+	case graphite.TagNotEqual:
+		value := expr.Value
+		return matchers, func(name string) bool { return name != value }, nil
+	case graphite.TagRegexp, graphite.TagNotRegexp:
+		re, err := regexp.Compile("^(?:" + expr.Value + ")")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if expr.Operator == graphite.TagRegexp {
+			return matchers, re.MatchString, nil
+		}
+
+		return matchers, func(name string) bool { return !re.MatchString(name) }, nil
+	}
+
+	return nil, nil, fmt.Errorf("unknown tag operator: %s", expr.Operator)
+}
@@ -83,3 +83,90 @@ func TestMatcherTerminator(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	}
 }
+
+func TestConvertTagExpressionsToMatchers(t *testing.T) {
+	matchers, filters, err := convertTagExpressionsToMatchers([]string{
+		"name=foo.bar",
+		"dc=us-east",
+		"host!=web01",
+		"env=",
+		"role!=",
+		"rack=~r[12]",
+		"zone!=~z-.*",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(filters))
+
+	expected := []struct {
+		t    models.MatchType
+		name string
+		val  string
+	}{
+		{models.MatchEqual, "__g0__", "foo"},
+		{models.MatchEqual, "__g1__", "bar"},
+		{models.MatchNotField, "__g2__", ""},
+		{models.MatchEqual, "dc", "us-east"},
+		{models.MatchNotEqual, "host", "web01"},
+		{models.MatchNotField, "env", ""},
+		{models.MatchField, "role", ""},
+		{models.MatchRegexp, "rack", "(?:r[12]).*"},
+		{models.MatchNotRegexp, "zone", "(?:z-.*).*"},
+	}
+
+	require.Equal(t, len(expected), len(matchers))
+	for i, ex := range expected {
+		assert.Equal(t, ex.t, matchers[i].Type)
+		assert.Equal(t, ex.name, string(matchers[i].Name))
+		assert.Equal(t, ex.val, string(matchers[i].Value))
+	}
+}
+
+func TestConvertTagExpressionsNameFilters(t *testing.T) {
+	matchers, filters, err := convertTagExpressionsToMatchers([]string{
+		"dc=us-east",
+		"name=~foo\\.ba[rz]",
+		"name!=foo.baz",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(filters))
+	require.Equal(t, 3, len(matchers))
+	assert.Equal(t, models.MatchField, matchers[1].Type)
+	assert.Equal(t, graphite.TagName(0), matchers[1].Name)
+
+	for _, tt := range []struct {
+		name     string
+		expected bool
+	}{
+		{"foo.bar", true},
+		{"foo.bar.qux", true},
+		{"foo.baz", false},
+		{"qux.foo.bar", false},
+	} {
+		assert.Equal(t, tt.expected, matchesNameFilters(tt.name, filters), tt.name)
+	}
+}
+
+func TestConvertTagExpressionsRestrictsToGraphiteSeries(t *testing.T) {
+	matchers, _, err := convertTagExpressionsToMatchers([]string{"dc=us-east"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(matchers))
+	assert.Equal(t, models.MatchField, matchers[1].Type)
+	assert.Equal(t, graphite.TagName(0), matchers[1].Name)
+}
+
+func TestConvertInvalidTagExpressions(t *testing.T) {
+	for _, exprs := range [][]string{
+		{},
+		{"dc"},
+		{"=foo"},
+		{"dc!=us-east"},
+		{"dc="},
+		{"name="},
+		{"name=foo..bar"},
+		{"dc=~(foo"},
+		{"dc=us-east", "name=~(foo"},
+	} {
+		_, _, err := convertTagExpressionsToMatchers(exprs)
+		assert.Error(t, err, "%v", exprs)
+	}
+}
@@ -22,18 +22,20 @@ package storage
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/cost"
 	xctx "github.com/m3db/m3/src/query/graphite/context"
+	"github.com/m3db/m3/src/query/graphite/errors"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/tenant"
 	"github.com/m3db/m3/src/query/util/logging"
 	"github.com/m3db/m3/src/x/instrument"
 
@@ -41,7 +43,9 @@ import (
 )
 
 var (
-	errSeriesNoResolution = errors.New("series has no resolution set")
+	errSeriesNoResolution        = errors.New("series has no resolution set")
+	errUnsupportedNameExpression = errors.New("only equality expressions are " +
+		"supported on the name tag")
 )
 
 type m3WrappedStore struct {
@@ -97,6 +101,32 @@ func TranslateQueryToMatchersWithTerminator(
 	return matchers, nil
 }
 
+// TranslateTagExpressionsToMatchers converts graphite tag expressions to tag
+// matchers. Given no expressions, it matches every graphite series. Only
+// equality expressions are supported on the name tag, since the others can
+// not be resolved by the index alone.
+func TranslateTagExpressionsToMatchers(
+	tagExpressions []string,
+) (models.Matchers, error) {
+	if len(tagExpressions) == 0 {
+		return models.Matchers{{
+			Type: models.MatchField,
+			Name: graphite.TagName(0),
+		}}, nil
+	}
+
+	matchers, filters, err := convertTagExpressionsToMatchers(tagExpressions)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filters) > 0 {
+		return nil, errUnsupportedNameExpression
+	}
+
+	return matchers, nil
+}
+
 // GetQueryTerminatorTagName will return the name for the terminator matcher in
 // the given pattern. This is useful for filtering out any additional results.
 func GetQueryTerminatorTagName(query string) []byte {
@@ -194,7 +224,8 @@ func translateTimeseries(
 		}
 
 		name := string(seriesMetas[idx].Name)
-		series = append(series, ts.NewSeries(ctx, name, start, values))
+		tags := seriesTags(name, seriesMetas[idx].Tags)
+		series = append(series, ts.NewSeries(ctx, name, start, values).WithTags(tags))
 	}
 
 	if err := iter.Err(); err != nil {
@@ -204,6 +235,22 @@ func translateTimeseries(
 	return series, nil
 }
 
+// seriesTags returns the graphite tags of a fetched series: its dotted path
+// under the name tag, along with any tags which are not graphite path tags.
+func seriesTags(name string, tags models.Tags) map[string]string {
+	result := make(map[string]string, len(tags.Tags)+1)
+	for _, tag := range tags.Tags {
+		if _, ok := graphite.TagIndex(tag.Name); ok {
+			continue
+		}
+
+		result[string(tag.Name)] = string(tag.Value)
+	}
+
+	result[graphite.NameTag] = name
+	return result
+}
+
 func (s *m3WrappedStore) FetchByQuery(
 	ctx xctx.Context, query string, opts FetchOptions,
 ) (*FetchResult, error) {
@@ -220,11 +267,69 @@ func (s *m3WrappedStore) FetchByQuery(
 		}, nil
 	}
 
+	series, meta, err := s.fetch(ctx, m3query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFetchResult(ctx, series, meta), nil
+}
+
+func (s *m3WrappedStore) FetchByTags(
+	ctx xctx.Context, tagExpressions []string, opts FetchOptions,
+) (*FetchResult, error) {
+	matchers, filters, err := convertTagExpressionsToMatchers(tagExpressions)
+	if err != nil {
+		return nil, errors.NewInvalidParamsError(err)
+	}
+
+	m3query := &storage.FetchQuery{
+		Raw:         fmt.Sprintf("seriesByTag(%s)", strings.Join(tagExpressions, ",")),
+		TagMatchers: matchers,
+		Start:       opts.StartTime,
+		End:         opts.EndTime,
+		Interval:    time.Duration(0),
+	}
+
+	series, meta, err := s.fetch(ctx, m3query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filters) > 0 {
+		filtered := series[:0]
+		for _, fetched := range series {
+			if matchesNameFilters(fetched.Tags()[graphite.NameTag], filters) {
+				filtered = append(filtered, fetched)
+			}
+		}
+
+		series = filtered
+	}
+
+	return NewFetchResult(ctx, series, meta), nil
+}
+
+func matchesNameFilters(name string, filters []nameFilter) bool {
+	for _, filter := range filters {
+		if !filter(name) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *m3WrappedStore) fetch(
+	ctx xctx.Context, m3query *storage.FetchQuery, opts FetchOptions,
+) ([]*ts.Series, block.ResultMetadata, error) {
 	m3ctx, cancel := context.WithTimeout(ctx.RequestContext(), opts.Timeout)
 	defer cancel()
 	fetchOptions := storage.NewFetchOptions()
 	fetchOptions.SeriesLimit = opts.Limit
-	perQueryEnforcer := s.enforcer.Child(cost.QueryLevel)
+	tenant.ApplyFetchOptions(ctx.RequestContext(), fetchOptions)
+	perQueryEnforcer := tenant.Enforcer(ctx.RequestContext(), s.enforcer).
+		Child(cost.QueryLevel)
 	defer perQueryEnforcer.Close()
 
 	// NB: ensure single block return.
@@ -239,17 +344,17 @@ func (s *m3WrappedStore) FetchByQuery(
 
 	res, err := s.m3.FetchBlocks(m3ctx, m3query, fetchOptions)
 	if err != nil {
-		return nil, err
+		return nil, block.ResultMetadata{}, err
 	}
 
 	if blockCount := len(res.Blocks); blockCount > 1 {
-		return nil, fmt.Errorf("expected at most one block, received %d", blockCount)
+		return nil, block.ResultMetadata{}, fmt.Errorf("expected at most one block, received %d", blockCount)
 	}
 
 	series, err := translateTimeseries(ctx, res, opts.StartTime, opts.EndTime)
 	if err != nil {
-		return nil, err
+		return nil, block.ResultMetadata{}, err
 	}
 
-	return NewFetchResult(ctx, series, res.Metadata), nil
+	return series, res.Metadata, nil
 }
@@ -23,16 +23,20 @@ package storage
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/cost"
 	xctx "github.com/m3db/m3/src/query/graphite/context"
+	"github.com/m3db/m3/src/query/graphite/errors"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/mock"
+	"github.com/m3db/m3/src/query/tenant"
 	m3ts "github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/x/instrument"
 	xtest "github.com/m3db/m3/src/x/test"
@@ -227,6 +231,64 @@ func TestFetchByQuery(t *testing.T) {
 	require.Equal(t, "foo_bar", result.Metadata.Warnings[0].Header())
 }
 
+func TestFetchByQueryRestrictedToTenant(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := tenant.Configuration{
+		Tenants: []tenant.TenantConfiguration{{Name: "a"}},
+	}
+	tenants, err := cfg.NewTenants(nil, time.Now, instrument.NewOptions())
+	require.NoError(t, err)
+
+	var reqCtx context.Context
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(tenant.DefaultHeader, "a")
+	tenant.NewHandler(tenants, http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			reqCtx = r.Context()
+		})).ServeHTTP(httptest.NewRecorder(), req)
+	require.NotNil(t, reqCtx)
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().FetchBlocks(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ *storage.FetchQuery,
+			opts *storage.FetchOptions,
+		) (block.Result, error) {
+			require.NotNil(t, opts.RestrictQueryOptions)
+			require.NotNil(t, opts.RestrictQueryOptions.RestrictByTag)
+			matcher, err := models.NewMatcher(models.MatchEqual,
+				[]byte(tenant.DefaultTagName), []byte("a"))
+			require.NoError(t, err)
+			assert.Equal(t, models.Matchers{matcher},
+				opts.RestrictQueryOptions.RestrictByTag.Restrict)
+			return block.Result{}, nil
+		})
+
+	childEnforcer := cost.NewMockChainedEnforcer(ctrl)
+	childEnforcer.EXPECT().Close()
+	enforcer := cost.NewMockChainedEnforcer(ctrl)
+	enforcer.EXPECT().Child(cost.QueryLevel).Return(childEnforcer)
+
+	wrapper := NewM3WrappedStorage(store, enforcer, instrument.NewOptions())
+	ctx := xctx.New()
+	ctx.SetRequestContext(reqCtx)
+	start := time.Now().Add(-time.Hour)
+	opts := FetchOptions{
+		StartTime: start,
+		EndTime:   start.Add(time.Minute),
+		DataOptions: DataOptions{
+			Timeout: time.Minute,
+		},
+	}
+
+	result, err := wrapper.FetchByQuery(ctx, "a*b", opts)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(result.SeriesList))
+}
+
 func TestFetchByInvalidQuery(t *testing.T) {
 	store := mock.NewMockStorage()
 	start := time.Now().Add(time.Hour * -1)
@@ -246,3 +308,71 @@ func TestFetchByInvalidQuery(t *testing.T) {
 	assert.NoError(t, err)
 	require.Equal(t, 0, len(result.SeriesList))
 }
+
+func TestFetchByTags(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	resolution := 10 * time.Second
+	start := time.Now().Add(time.Hour * -1).Truncate(resolution).Add(time.Second)
+	steps := 3
+	res := buildResult(ctrl, resolution, 2, steps, start)
+
+	store.EXPECT().FetchBlocks(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			query *storage.FetchQuery,
+			_ *storage.FetchOptions,
+		) (block.Result, error) {
+			assert.Equal(t, "seriesByTag(dc=us-east,name!=a0)", query.Raw)
+			require.Equal(t, 2, len(query.TagMatchers))
+			assert.Equal(t, "dc", string(query.TagMatchers[0].Name))
+			return res, nil
+		})
+
+	wrapper := NewM3WrappedStorage(store, nil, instrument.NewOptions())
+	ctx := xctx.New()
+	ctx.SetRequestContext(context.TODO())
+	end := start.Add(time.Duration(steps) * resolution)
+	opts := FetchOptions{
+		StartTime: start,
+		EndTime:   end,
+		DataOptions: DataOptions{
+			Timeout: time.Minute,
+		},
+	}
+
+	result, err := wrapper.FetchByTags(ctx,
+		[]string{"dc=us-east", "name!=a0"}, opts)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.SeriesList))
+	series := result.SeriesList[0]
+	assert.Equal(t, "a1", series.Name())
+	assert.Equal(t, map[string]string{"name": "a1"}, series.Tags())
+}
+
+func TestFetchByInvalidTags(t *testing.T) {
+	store := mock.NewMockStorage()
+	ctx := xctx.New()
+	wrapper := NewM3WrappedStorage(store, nil, instrument.NewOptions())
+	_, err := wrapper.FetchByTags(ctx, []string{"dc!=us-east"}, FetchOptions{})
+	require.Error(t, err)
+	assert.True(t, errors.IsInvalidParams(err))
+}
+
+func TestTranslateTagExpressionsToMatchers(t *testing.T) {
+	matchers, err := TranslateTagExpressionsToMatchers(nil)
+	require.NoError(t, err)
+	assert.Equal(t, models.Matchers{
+		{Type: models.MatchField, Name: graphite.TagName(0)},
+	}, matchers)
+
+	matchers, err = TranslateTagExpressionsToMatchers([]string{"name=a.b", "dc=east"})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(matchers))
+	assert.Equal(t, "dc", string(matchers[3].Name))
+
+	_, err = TranslateTagExpressionsToMatchers([]string{"dc=east", "name=~a.*"})
+	require.Error(t, err)
+}
@@ -55,6 +55,12 @@ type Storage interface {
 	FetchByQuery(
 		ctx context.Context, query string, opts FetchOptions,
 	) (*FetchResult, error)
+
+	// FetchByTags fetches timeseries data matching all of the given graphite
+	// tag expressions, e.g. `dc=us-east` or `name=~cpu\..*`.
+	FetchByTags(
+		ctx context.Context, tagExpressions []string, opts FetchOptions,
+	) (*FetchResult, error)
 }
 
 // FetchResult provides a fetch result and meta information.
@@ -27,6 +27,7 @@ import (
 
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/graphite/context"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/stats"
 )
 
@@ -55,6 +56,10 @@ type Series struct {
 	// consolidationFunc specifies how the series will be consolidated when the
 	// number of data points in the series is more than the maximum number allowed.
 	consolidationFunc ConsolidationFunc
+
+	// tags are the graphite tags of the series; if unset they are parsed
+	// from the series name.
+	tags map[string]string
 }
 
 // SeriesByName implements sort.Interface for sorting collections of series by name
@@ -85,12 +90,37 @@ func (b *Series) DerivedSeries(startTime time.Time, vals Values) *Series {
 	series := NewSeries(b.ctx, b.name, startTime, vals)
 	series.Specification = b.Specification
 	series.consolidationFunc = b.consolidationFunc
+	series.tags = b.tags
 	return series
 }
 
 // Name returns the name of the timeseries block
 func (b *Series) Name() string { return b.name }
 
+// Tags returns the graphite tags of the timeseries. Series which were not
+// given explicit tags have them parsed from their name, which includes the
+// dotted path of the series under the name tag.
+func (b *Series) Tags() map[string]string {
+	if b.tags != nil {
+		return b.tags
+	}
+
+	return graphite.ParseTaggedName(b.name)
+}
+
+// WithTags returns a new timeseries with the same values but the given tags.
+func (b *Series) WithTags(tags map[string]string) *Series {
+	return &Series{
+		name:              b.name,
+		startTime:         b.startTime,
+		vals:              b.vals,
+		ctx:               b.ctx,
+		Specification:     b.Specification,
+		consolidationFunc: b.consolidationFunc,
+		tags:              tags,
+	}
+}
+
 // RenamedTo returns a new timeseries with the same values but a different name
 func (b *Series) RenamedTo(name string) *Series {
 	return &Series{
@@ -100,6 +130,7 @@ func (b *Series) RenamedTo(name string) *Series {
 		ctx:               b.ctx,
 		Specification:     b.Specification,
 		consolidationFunc: b.consolidationFunc,
+		tags:              b.tags,
 	}
 }
 
@@ -112,6 +143,7 @@ func (b *Series) Shift(shift time.Duration) *Series {
 		ctx:               b.ctx,
 		Specification:     b.Specification,
 		consolidationFunc: b.consolidationFunc,
+		tags:              b.tags,
 	}
 }
 
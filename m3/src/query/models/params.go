@@ -80,6 +80,7 @@ type RequestParams struct {
 	Step             time.Duration
 	Query            string
 	Debug            bool
+	Analyze          bool
 	KeepNans         bool
 	IncludeEnd       bool
 	BlockType        FetchedBlockType
@@ -0,0 +1,601 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3ql
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/binary"
+	"github.com/m3db/m3/src/query/functions/linear"
+	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/functions/temporal"
+	"github.com/m3db/m3/src/query/functions/unconsolidated"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	fetchFn      = "fetch"
+	perSecondFn  = "persecond"
+	movingFn     = "moving"
+	scaleFn      = "scale"
+	offsetFn     = "offset"
+	timestampFn  = "timestamp"
+	sortFn       = "sort"
+	sortSeriesFn = "sortseries"
+
+	nameKeyword = "name"
+
+	// defaultPerSecondWindow is the window over which perSecond looks back
+	// for the two most recent datapoints when no window is specified.
+	defaultPerSecondWindow = 5 * time.Minute
+)
+
+var (
+	errEmptyQuery = errors.New("m3ql query does not contain a pipeline")
+
+	// NB: function names are matched case insensitively, so all keys in the
+	// function tables below are lower case.
+	aggregationFns = map[string]string{
+		"sum":           aggregation.SumType,
+		"sumseries":     aggregation.SumType,
+		"min":           aggregation.MinType,
+		"minseries":     aggregation.MinType,
+		"max":           aggregation.MaxType,
+		"maxseries":     aggregation.MaxType,
+		"avg":           aggregation.AverageType,
+		"averageseries": aggregation.AverageType,
+		"count":         aggregation.CountType,
+	}
+
+	takeFns = map[string]string{
+		"head": aggregation.HeadType,
+		"tail": aggregation.TailType,
+	}
+
+	mathFns = map[string]string{
+		"abs":        linear.AbsType,
+		"absolute":   linear.AbsType,
+		"sqrt":       linear.SqrtType,
+		"squareroot": linear.SqrtType,
+		"logarithm":  linear.Log10Type,
+	}
+
+	movingFns = map[string]string{
+		"sum":   temporal.SumType,
+		"min":   temporal.MinType,
+		"max":   temporal.MaxType,
+		"avg":   temporal.AvgType,
+		"count": temporal.CountType,
+	}
+
+	comparisonFns = map[string]string{
+		"eq": binary.EqType,
+		"==": binary.EqType,
+		"ne": binary.NotEqType,
+		"!=": binary.NotEqType,
+		"gt": binary.GreaterType,
+		">":  binary.GreaterType,
+		"lt": binary.LesserType,
+		"<":  binary.LesserType,
+		"ge": binary.GreaterEqType,
+		">=": binary.GreaterEqType,
+		"le": binary.LesserEqType,
+		"<=": binary.LesserEqType,
+	}
+)
+
+type m3qlParser struct {
+	query    string
+	script   script
+	stepSize time.Duration
+	tagOpts  models.TagOptions
+}
+
+// Parse takes an M3QL string and parses it into a DAG.
+func Parse(
+	q string,
+	stepSize time.Duration,
+	tagOpts models.TagOptions,
+) (parser.Parser, error) {
+	builder := newASTBuilder()
+	m := &m3ql{
+		Buffer:        q,
+		scriptBuilder: builder,
+	}
+
+	m.Init()
+	if err := m.Parse(); err != nil {
+		return nil, err
+	}
+
+	m.Execute()
+	if builder.err != nil {
+		return nil, builder.err
+	}
+
+	if builder.script.pipeline == nil {
+		return nil, errEmptyQuery
+	}
+
+	return &m3qlParser{
+		query:    q,
+		script:   builder.script,
+		stepSize: stepSize,
+		tagOpts:  tagOpts,
+	}, nil
+}
+
+func (p *m3qlParser) DAG() (parser.Nodes, parser.Edges, error) {
+	state := &parseState{
+		macros:    p.script.macros,
+		expanding: make(map[string]struct{}),
+		stepSize:  p.stepSize,
+		tagOpts:   p.tagOpts,
+	}
+
+	if _, err := state.walkPipeline(p.script.pipeline); err != nil {
+		return nil, nil, err
+	}
+
+	return state.transforms, state.edges, nil
+}
+
+func (p *m3qlParser) String() string {
+	return p.query
+}
+
+type parseState struct {
+	macros     map[string]*pipeline
+	expanding  map[string]struct{}
+	stepSize   time.Duration
+	tagOpts    models.TagOptions
+	edges      parser.Edges
+	transforms parser.Nodes
+}
+
+// addTransform adds the given operation to the DAG, linking it to each of
+// the given parents, and returns the ID of the new node.
+func (p *parseState) addTransform(
+	op parser.Params,
+	parents ...parser.NodeID,
+) parser.NodeID {
+	opTransform := parser.NewTransformFromOperation(op, len(p.transforms))
+	for _, parent := range parents {
+		p.edges = append(p.edges, parser.Edge{
+			ParentID: parent,
+			ChildID:  opTransform.ID,
+		})
+	}
+
+	p.transforms = append(p.transforms, opTransform)
+	return opTransform.ID
+}
+
+// walkPipeline lowers a pipeline into the DAG, returning the ID of the node
+// that produces the output of the pipeline.
+func (p *parseState) walkPipeline(pl *pipeline) (parser.NodeID, error) {
+	if len(pl.expressions) == 0 {
+		return "", errEmptyQuery
+	}
+
+	id, err := p.walkSource(pl.expressions[0])
+	if err != nil {
+		return "", err
+	}
+
+	for _, expr := range pl.expressions[1:] {
+		if expr.nested != nil {
+			return "", errors.New("nested pipelines may only start a pipeline " +
+				"or be used as function arguments")
+		}
+
+		if id, err = p.walkTransform(expr, id); err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+// walkSource lowers the first expression in a pipeline, which must produce
+// series without any input.
+func (p *parseState) walkSource(expr *expression) (parser.NodeID, error) {
+	if expr.nested != nil {
+		return p.walkPipeline(expr.nested)
+	}
+
+	if macro, ok := p.macros[expr.name]; ok {
+		if len(expr.arguments) != 0 {
+			return "", fmt.Errorf("macro %s does not take arguments", expr.name)
+		}
+
+		// NB: guard against macros that reference themselves, which would
+		// otherwise never terminate.
+		if _, ok := p.expanding[expr.name]; ok {
+			return "", fmt.Errorf("macro %s is recursive", expr.name)
+		}
+
+		p.expanding[expr.name] = struct{}{}
+		id, err := p.walkPipeline(macro)
+		delete(p.expanding, expr.name)
+		return id, err
+	}
+
+	if strings.ToLower(expr.name) != fetchFn {
+		return "", fmt.Errorf("pipeline must start with %s or a macro, "+
+			"received: %s", fetchFn, expr.name)
+	}
+
+	op, err := p.newFetchOp(expr)
+	if err != nil {
+		return "", err
+	}
+
+	return p.addTransform(op), nil
+}
+
+func (p *parseState) newFetchOp(expr *expression) (parser.Params, error) {
+	matchers := make(models.Matchers, 0, len(expr.arguments))
+	for _, arg := range expr.arguments {
+		if arg.keyword == "" {
+			return nil, fmt.Errorf("%s arguments must be of the form "+
+				"tag:value, received: %s", fetchFn, arg.value)
+		}
+
+		if arg.argType == pipelineArgument {
+			return nil, fmt.Errorf("%s argument %s cannot be a pipeline",
+				fetchFn, arg.keyword)
+		}
+
+		name := []byte(arg.keyword)
+		if arg.keyword == nameKeyword {
+			name = p.tagOpts.MetricName()
+		}
+
+		matcher, err := newTagMatcher(name, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = append(matchers, matcher)
+	}
+
+	if len(matchers) == 0 {
+		return nil, fmt.Errorf("%s requires at least one tag:value argument",
+			fetchFn)
+	}
+
+	return functions.FetchOp{Matchers: matchers}, nil
+}
+
+// walkTransform lowers a function applied to the output of the given node.
+func (p *parseState) walkTransform(
+	expr *expression,
+	input parser.NodeID,
+) (parser.NodeID, error) {
+	name := strings.ToLower(expr.name)
+	if _, ok := p.macros[expr.name]; ok {
+		return "", fmt.Errorf("macro %s may only start a pipeline", expr.name)
+	}
+
+	if opType, ok := aggregationFns[name]; ok {
+		tags, err := patternArguments(expr)
+		if err != nil {
+			return "", err
+		}
+
+		op, err := aggregation.NewAggregationOp(opType, aggregation.NodeParams{
+			MatchingTags: tags,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return p.addTransform(op, input), nil
+	}
+
+	if opType, ok := takeFns[name]; ok {
+		k, err := singleNumericArgument(expr)
+		if err != nil {
+			return "", err
+		}
+
+		op, err := aggregation.NewTakeOp(opType, aggregation.NodeParams{
+			Parameter: k,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return p.addTransform(op, input), nil
+	}
+
+	if opType, ok := mathFns[name]; ok {
+		if len(expr.arguments) != 0 {
+			return "", fmt.Errorf("%s does not take arguments", expr.name)
+		}
+
+		op, err := linear.NewMathOp(opType)
+		if err != nil {
+			return "", err
+		}
+
+		return p.addTransform(op, input), nil
+	}
+
+	if opType, ok := comparisonFns[name]; ok {
+		return p.walkBinary(expr, opType, input)
+	}
+
+	switch name {
+	case scaleFn:
+		return p.walkBinary(expr, binary.MultiplyType, input)
+
+	case offsetFn:
+		return p.walkBinary(expr, binary.PlusType, input)
+
+	case perSecondFn:
+		window := defaultPerSecondWindow
+		switch len(expr.arguments) {
+		case 0:
+		case 1:
+			d, err := durationArgument(expr.name, expr.arguments[0])
+			if err != nil {
+				return "", err
+			}
+
+			window = d
+		default:
+			return "", fmt.Errorf("%s takes at most one argument, received %d",
+				expr.name, len(expr.arguments))
+		}
+
+		op, err := temporal.NewRateOp([]interface{}{window}, temporal.IRateType)
+		if err != nil {
+			return "", err
+		}
+
+		return p.addTransform(op, input), nil
+
+	case movingFn:
+		return p.walkMoving(expr, input)
+
+	case timestampFn:
+		if len(expr.arguments) != 0 {
+			return "", fmt.Errorf("%s does not take arguments", expr.name)
+		}
+
+		op, err := unconsolidated.NewTimestampOp(unconsolidated.TimestampType)
+		if err != nil {
+			return "", err
+		}
+
+		return p.addTransform(op, input), nil
+
+	// NB: the DAG returns series in fetch order and has no operation to
+	// reorder them, so reject sorts rather than silently ignore them.
+	case sortFn, sortSeriesFn:
+		return "", fmt.Errorf("function not supported: %s, series are "+
+			"returned in fetch order", expr.name)
+
+	default:
+		return "", fmt.Errorf("function not supported: %s", expr.name)
+	}
+}
+
+// walkBinary lowers a function that combines its input with either a
+// numeric argument or the output of a nested pipeline.
+func (p *parseState) walkBinary(
+	expr *expression,
+	opType string,
+	input parser.NodeID,
+) (parser.NodeID, error) {
+	if len(expr.arguments) != 1 {
+		return "", fmt.Errorf("%s takes exactly one argument, received %d",
+			expr.name, len(expr.arguments))
+	}
+
+	var (
+		arg   = expr.arguments[0]
+		rhsID parser.NodeID
+	)
+
+	switch arg.argType {
+	case numericArgument:
+		val, err := strconv.ParseFloat(arg.value, 64)
+		if err != nil {
+			return "", err
+		}
+
+		op, err := scalar.NewScalarOp(val, p.tagOpts)
+		if err != nil {
+			return "", err
+		}
+
+		rhsID = p.addTransform(op)
+
+	case pipelineArgument:
+		id, err := p.walkPipeline(arg.pipeline)
+		if err != nil {
+			return "", err
+		}
+
+		rhsID = id
+
+	default:
+		return "", fmt.Errorf("%s argument must be a number or a pipeline, "+
+			"received: %s", expr.name, arg.value)
+	}
+
+	op, err := binary.NewOp(opType, binary.NodeParams{
+		LNode: input,
+		RNode: rhsID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return p.addTransform(op, input, rhsID), nil
+}
+
+// walkMoving lowers `moving <window> [fn]`, which applies fn (defaulting to
+// avg) over a sliding window.
+func (p *parseState) walkMoving(
+	expr *expression,
+	input parser.NodeID,
+) (parser.NodeID, error) {
+	if len(expr.arguments) < 1 || len(expr.arguments) > 2 {
+		return "", fmt.Errorf("%s takes a window and an optional function, "+
+			"received %d arguments", expr.name, len(expr.arguments))
+	}
+
+	window, err := durationArgument(expr.name, expr.arguments[0])
+	if err != nil {
+		return "", err
+	}
+
+	opType := temporal.AvgType
+	if len(expr.arguments) == 2 {
+		fn := strings.ToLower(expr.arguments[1].value)
+		t, ok := movingFns[fn]
+		if !ok {
+			return "", fmt.Errorf("unsupported %s function: %s", expr.name, fn)
+		}
+
+		opType = t
+	}
+
+	op, err := temporal.NewAggOp([]interface{}{window}, opType)
+	if err != nil {
+		return "", err
+	}
+
+	return p.addTransform(op, input), nil
+}
+
+func patternArguments(expr *expression) ([][]byte, error) {
+	values := make([][]byte, 0, len(expr.arguments))
+	for _, arg := range expr.arguments {
+		if arg.argType != patternArgument && arg.argType != stringLiteralArgument {
+			return nil, fmt.Errorf("%s arguments must be tag names, received: %s",
+				expr.name, arg.value)
+		}
+
+		values = append(values, []byte(arg.value))
+	}
+
+	return values, nil
+}
+
+func singleNumericArgument(expr *expression) (float64, error) {
+	if len(expr.arguments) != 1 || expr.arguments[0].argType != numericArgument {
+		return 0, fmt.Errorf("%s takes exactly one numeric argument", expr.name)
+	}
+
+	return strconv.ParseFloat(expr.arguments[0].value, 64)
+}
+
+func durationArgument(fn string, arg argument) (time.Duration, error) {
+	if arg.argType != patternArgument && arg.argType != stringLiteralArgument {
+		return 0, fmt.Errorf("%s argument must be a duration, received: %s",
+			fn, arg.value)
+	}
+
+	d, err := xtime.ParseExtendedDuration(arg.value)
+	if err != nil {
+		return 0, err
+	}
+
+	if d <= 0 {
+		return 0, fmt.Errorf("%s duration must be positive, received: %s",
+			fn, arg.value)
+	}
+
+	return d, nil
+}
+
+// newTagMatcher creates a matcher for the given tag, treating pattern
+// arguments containing glob symbols as regular expressions.
+func newTagMatcher(name []byte, arg argument) (models.Matcher, error) {
+	if arg.argType != patternArgument {
+		return models.NewMatcher(models.MatchEqual, name, []byte(arg.value))
+	}
+
+	re, isGlob := globToRegex(arg.value)
+	if !isGlob {
+		return models.NewMatcher(models.MatchEqual, name, []byte(arg.value))
+	}
+
+	return models.NewMatcher(models.MatchRegexp, name, []byte(re))
+}
+
+// globToRegex converts an M3QL glob into a regular expression, returning
+// false if the value contains no glob symbols.
+func globToRegex(glob string) (string, bool) {
+	var (
+		sb      strings.Builder
+		isGlob  bool
+		inGroup bool
+		inRange bool
+	)
+
+	for _, r := range glob {
+		switch {
+		case inRange:
+			sb.WriteRune(r)
+			if r == ']' {
+				inRange = false
+			}
+		case r == '*':
+			sb.WriteString(".*")
+			isGlob = true
+		case r == '?':
+			sb.WriteString(".")
+			isGlob = true
+		case r == '[':
+			sb.WriteRune(r)
+			inRange = true
+			isGlob = true
+		case r == '{':
+			sb.WriteString("(?:")
+			inGroup = true
+			isGlob = true
+		case r == '}' && inGroup:
+			sb.WriteRune(')')
+			inGroup = false
+		case r == ',' && inGroup:
+			sb.WriteRune('|')
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	return sb.String(), isGlob
+}
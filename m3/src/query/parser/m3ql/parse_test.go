@@ -0,0 +1,179 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3ql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/binary"
+	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/functions/temporal"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAGWithFetchAndAggregation(t *testing.T) {
+	q := "fetch name:http.requests host:web-* | sum dc"
+	p, err := Parse(q, time.Second, models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 2)
+
+	fetch, ok := transforms[0].Op.(functions.FetchOp)
+	require.True(t, ok)
+	require.Len(t, fetch.Matchers, 2)
+	assert.Equal(t, models.MatchEqual, fetch.Matchers[0].Type)
+	assert.Equal(t, []byte("__name__"), fetch.Matchers[0].Name)
+	assert.Equal(t, []byte("http.requests"), fetch.Matchers[0].Value)
+	assert.Equal(t, models.MatchRegexp, fetch.Matchers[1].Type)
+	assert.Equal(t, []byte("host"), fetch.Matchers[1].Name)
+	assert.Equal(t, []byte("web-.*"), fetch.Matchers[1].Value)
+
+	assert.Equal(t, aggregation.SumType, transforms[1].Op.OpType())
+	require.Len(t, edges, 1)
+	assert.Equal(t, parser.Edge{ParentID: "0", ChildID: "1"}, edges[0])
+}
+
+func TestDAGWithScalarComparison(t *testing.T) {
+	q := "fetch name:foo | perSecond | >= 5"
+	p, err := Parse(q, time.Second, models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 4)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+	assert.Equal(t, temporal.IRateType, transforms[1].Op.OpType())
+	assert.Equal(t, scalar.ScalarType, transforms[2].Op.OpType())
+	assert.Equal(t, binary.GreaterEqType, transforms[3].Op.OpType())
+	assert.Equal(t, parser.Edges{
+		{ParentID: "0", ChildID: "1"},
+		{ParentID: "1", ChildID: "3"},
+		{ParentID: "2", ChildID: "3"},
+	}, edges)
+}
+
+func TestDAGWithNestedPipeline(t *testing.T) {
+	q := "fetch name:foo | > (fetch name:bar | max)"
+	p, err := Parse(q, time.Second, models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 4)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+	assert.Equal(t, functions.FetchType, transforms[1].Op.OpType())
+	assert.Equal(t, aggregation.MaxType, transforms[2].Op.OpType())
+	assert.Equal(t, binary.GreaterType, transforms[3].Op.OpType())
+	assert.Equal(t, parser.Edges{
+		{ParentID: "1", ChildID: "2"},
+		{ParentID: "0", ChildID: "3"},
+		{ParentID: "2", ChildID: "3"},
+	}, edges)
+}
+
+func TestDAGWithMacro(t *testing.T) {
+	q := "requests = fetch name:foo | moving 5m sum; requests | scale 2"
+	p, err := Parse(q, time.Second, models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 4)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+	assert.Equal(t, temporal.SumType, transforms[1].Op.OpType())
+	assert.Equal(t, scalar.ScalarType, transforms[2].Op.OpType())
+	assert.Equal(t, binary.MultiplyType, transforms[3].Op.OpType())
+	assert.Len(t, edges, 3)
+}
+
+func TestDAGWithPositionalTake(t *testing.T) {
+	tests := []struct {
+		query  string
+		opType string
+	}{
+		{"fetch name:foo | head 5", aggregation.HeadType},
+		{"fetch name:foo | tail 5", aggregation.TailType},
+	}
+
+	for _, tt := range tests {
+		p, err := Parse(tt.query, time.Second, models.NewTagOptions())
+		require.NoError(t, err)
+		transforms, _, err := p.DAG()
+		require.NoError(t, err)
+		require.Len(t, transforms, 2)
+		assert.Equal(t, tt.opType, transforms[1].Op.OpType(), tt.query)
+	}
+}
+
+func TestInvalidQueries(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"syntax error", "fetch name:foo |"},
+		{"missing fetch", "sum | abs"},
+		{"positional fetch argument", "fetch foo"},
+		{"unknown function", "fetch name:foo | unknownFn"},
+		{"bad comparison argument", "fetch name:foo | > bar"},
+		{"bad moving function", "fetch name:foo | moving 5m median"},
+		{"macro in transform position", "a = fetch name:foo; fetch name:bar | a"},
+		{"duplicate macro", "a = fetch name:foo; a = fetch name:bar; a"},
+		{"sort", "fetch name:foo | sort"},
+		{"sortSeries", "fetch name:foo | sortSeries"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse(tt.query, time.Second, models.NewTagOptions())
+			if err != nil {
+				return
+			}
+
+			_, _, err = p.DAG()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGlobToRegex(t *testing.T) {
+	tests := []struct {
+		glob   string
+		regex  string
+		isGlob bool
+	}{
+		{"foo.bar", `foo\.bar`, false},
+		{"foo*", "foo.*", true},
+		{"fo?", "fo.", true},
+		{"{foo,bar}.baz", `(?:foo|bar)\.baz`, true},
+		{"host[0-9]", "host[0-9]", true},
+	}
+
+	for _, tt := range tests {
+		regex, isGlob := globToRegex(tt.glob)
+		assert.Equal(t, tt.regex, regex, tt.glob)
+		assert.Equal(t, tt.isGlob, isGlob, tt.glob)
+	}
+}
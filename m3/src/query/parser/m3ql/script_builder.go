@@ -0,0 +1,174 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3ql
+
+import "fmt"
+
+type argumentType int
+
+const (
+	booleanArgument argumentType = iota
+	numericArgument
+	patternArgument
+	stringLiteralArgument
+	pipelineArgument
+)
+
+// argument is a single argument to an expression, optionally preceded by a
+// keyword specifier, e.g. `name:foo.bar`.
+type argument struct {
+	keyword  string
+	argType  argumentType
+	value    string
+	pipeline *pipeline
+}
+
+// expression is either a function call or a nested pipeline.
+type expression struct {
+	name      string
+	arguments []argument
+	nested    *pipeline
+}
+
+// pipeline is an ordered set of expressions, where the output of each
+// expression is fed into the next.
+type pipeline struct {
+	expressions []*expression
+}
+
+// script is a parsed M3QL query consisting of macro definitions followed by
+// the pipeline to execute.
+type script struct {
+	macros   map[string]*pipeline
+	pipeline *pipeline
+}
+
+// openExpression tracks an expression that is still accepting arguments along
+// with the depth of the pipeline it belongs to.
+type openExpression struct {
+	expr  *expression
+	depth int
+}
+
+// astBuilder implements scriptBuilder, assembling the grammar callbacks into
+// a script.
+type astBuilder struct {
+	script         script
+	pipelines      []*pipeline
+	expressions    []openExpression
+	pendingMacro   string
+	pendingKeyword string
+	err            error
+}
+
+func newASTBuilder() *astBuilder {
+	return &astBuilder{
+		script: script{
+			macros: make(map[string]*pipeline),
+		},
+	}
+}
+
+func (b *astBuilder) newMacro(name string) {
+	if _, ok := b.script.macros[name]; ok && b.err == nil {
+		b.err = fmt.Errorf("macro %s is defined more than once", name)
+	}
+
+	b.pendingMacro = name
+}
+
+func (b *astBuilder) newPipeline() {
+	b.pipelines = append(b.pipelines, &pipeline{})
+}
+
+func (b *astBuilder) endPipeline() {
+	last := len(b.pipelines) - 1
+	p := b.pipelines[last]
+	b.pipelines = b.pipelines[:last]
+
+	if len(b.pipelines) == 0 {
+		if b.pendingMacro != "" {
+			b.script.macros[b.pendingMacro] = p
+			b.pendingMacro = ""
+			return
+		}
+
+		b.script.pipeline = p
+		return
+	}
+
+	// NB: a nested pipeline is an argument if the innermost open expression
+	// belongs to the enclosing pipeline, otherwise it is a standalone
+	// expression within the enclosing pipeline.
+	if n := len(b.expressions); n > 0 && b.expressions[n-1].depth == len(b.pipelines) {
+		b.addArgument(pipelineArgument, "", p)
+		return
+	}
+
+	parent := b.pipelines[len(b.pipelines)-1]
+	parent.expressions = append(parent.expressions, &expression{nested: p})
+}
+
+func (b *astBuilder) newExpression(name string) {
+	expr := &expression{name: name}
+	p := b.pipelines[len(b.pipelines)-1]
+	p.expressions = append(p.expressions, expr)
+	b.expressions = append(b.expressions, openExpression{
+		expr:  expr,
+		depth: len(b.pipelines),
+	})
+}
+
+func (b *astBuilder) endExpression() {
+	b.expressions = b.expressions[:len(b.expressions)-1]
+}
+
+func (b *astBuilder) newBooleanArgument(value string) {
+	b.addArgument(booleanArgument, value, nil)
+}
+
+func (b *astBuilder) newNumericArgument(value string) {
+	b.addArgument(numericArgument, value, nil)
+}
+
+func (b *astBuilder) newPatternArgument(value string) {
+	b.addArgument(patternArgument, value, nil)
+}
+
+func (b *astBuilder) newStringLiteralArgument(value string) {
+	b.addArgument(stringLiteralArgument, value, nil)
+}
+
+func (b *astBuilder) newKeywordArgument(keyword string) {
+	b.pendingKeyword = keyword
+}
+
+func (b *astBuilder) addArgument(argType argumentType, value string, p *pipeline) {
+	expr := b.expressions[len(b.expressions)-1].expr
+	expr.arguments = append(expr.arguments, argument{
+		keyword:  b.pendingKeyword,
+		argType:  argType,
+		value:    value,
+		pipeline: p,
+	})
+
+	b.pendingKeyword = ""
+}
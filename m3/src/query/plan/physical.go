@@ -36,6 +36,7 @@ type PhysicalPlan struct {
 	ResultStep       ResultOp
 	TimeSpec         transform.TimeSpec
 	Debug            bool
+	Analyze          bool
 	BlockType        models.FetchedBlockType
 	LookbackDuration time.Duration
 }
@@ -71,6 +72,7 @@ func NewPhysicalPlan(
 			Step:  params.Step,
 		},
 		Debug:            params.Debug,
+		Analyze:          params.Analyze,
 		BlockType:        params.BlockType,
 		LookbackDuration: params.LookbackDuration,
 	}
@@ -160,6 +162,11 @@ func (p PhysicalPlan) Step(ID parser.NodeID) (LogicalStep, bool) {
 	return step, ok
 }
 
+// Pipeline returns the ordered IDs of the steps of the plan.
+func (p PhysicalPlan) Pipeline() []parser.NodeID {
+	return p.pipeline
+}
+
 // String representation of the physical plan.
 func (p PhysicalPlan) String() string {
 	return fmt.Sprintf("StepCount: %s, Pipeline: %s, Result: %s, TimeSpec: %v",
@@ -26,6 +26,7 @@ import (
 	"sync"
 
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/analyze"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/errors"
 	rpc "github.com/m3db/m3/src/query/generated/proto/rpcpb"
@@ -204,6 +205,12 @@ func (c *grpcClient) FetchBlocks(
 		}, err
 	}
 
+	if stats := analyze.FromContext(ctx); stats != nil {
+		stats.AddSeriesFetched(analyze.StorageFromContext(ctx, "remote"),
+			fetchResult.Count())
+		opts = opts.SetAnalyzeStats(stats)
+	}
+
 	return m3.FetchResultToBlockResult(fetchResult, query, options, opts)
 }
 
@@ -0,0 +1,263 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/template"
+)
+
+const (
+	// resolvedRetention is how long resolved alerts are kept so that their
+	// resolution keeps being sent to Alertmanager.
+	resolvedRetention = 15 * time.Minute
+
+	// templateDefs are the variables available to label and annotation
+	// templates, as in Prometheus.
+	templateDefs = "{{$labels := .Labels}}" +
+		"{{$externalLabels := .ExternalLabels}}" +
+		"{{$value := .Value}}"
+)
+
+// alertingRule evaluates an expression and produces an alert for each of the
+// resulting series, alerts fire once active for the hold duration.
+type alertingRule struct {
+	// ruleEvaluation also guards the active alerts.
+	ruleEvaluation
+
+	name         string
+	query        string
+	holdDuration time.Duration
+	labels       labels.Labels
+	annotations  labels.Labels
+	opts         *ruleOptions
+	active       map[uint64]*Alert
+}
+
+func newAlertingRule(cfg RuleConfiguration, opts *ruleOptions) *alertingRule {
+	return &alertingRule{
+		name:         cfg.Alert,
+		query:        cfg.Expr,
+		holdDuration: time.Duration(cfg.For),
+		labels:       labels.FromMap(cfg.Labels),
+		annotations:  labels.FromMap(cfg.Annotations),
+		opts:         opts,
+		active:       make(map[uint64]*Alert),
+	}
+}
+
+func (r *alertingRule) eval(ctx context.Context, evalTime time.Time) error {
+	vector, err := r.opts.queryFn(ctx, r.query, evalTime)
+	if err != nil {
+		return err
+	}
+
+	alerts := make(map[uint64]*Alert, len(vector))
+	for _, sample := range vector {
+		var (
+			data = template.AlertTemplateData(sample.Metric.Map(),
+				r.opts.externalLabels.Map(), sample.V)
+			expand = func(text string) string {
+				expander := template.NewTemplateExpander(ctx, templateDefs+text,
+					"__alert_"+r.name, data, model.Time(timestamp(evalTime)),
+					template.QueryFunc(r.opts.queryFn), r.opts.externalURL)
+				result, err := expander.Expand()
+				if err != nil {
+					result = fmt.Sprintf("<error expanding template: %v>", err)
+				}
+				return result
+			}
+		)
+
+		b := labels.NewBuilder(sample.Metric).Del(labels.MetricName)
+		for _, l := range r.labels {
+			b.Set(l.Name, expand(l.Value))
+		}
+		b.Set(labels.AlertName, r.name)
+
+		annotations := make(labels.Labels, 0, len(r.annotations))
+		for _, a := range r.annotations {
+			annotations = append(annotations, labels.Label{
+				Name:  a.Name,
+				Value: expand(a.Value),
+			})
+		}
+
+		lset := b.Labels()
+		hash := lset.Hash()
+		if _, ok := alerts[hash]; ok {
+			return errDuplicateLabelSet
+		}
+		alerts[hash] = &Alert{
+			State:       StatePending,
+			Labels:      lset,
+			Annotations: annotations,
+			Value:       sample.V,
+			ActiveAt:    evalTime,
+		}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	for hash, alert := range alerts {
+		if existing, ok := r.active[hash]; ok && existing.State != StateInactive {
+			existing.Value = alert.Value
+			existing.Annotations = alert.Annotations
+			continue
+		}
+		r.active[hash] = alert
+	}
+
+	for hash, alert := range r.active {
+		if _, ok := alerts[hash]; !ok {
+			// Pending alerts are dropped right away while resolved alerts are
+			// kept for a while to send their resolution.
+			if alert.State == StatePending ||
+				(alert.State == StateInactive &&
+					evalTime.Sub(alert.ResolvedAt) > resolvedRetention) {
+				delete(r.active, hash)
+				continue
+			}
+			if alert.State != StateInactive {
+				alert.State = StateInactive
+				alert.ResolvedAt = evalTime
+			}
+			continue
+		}
+
+		if alert.State == StatePending &&
+			evalTime.Sub(alert.ActiveAt) >= r.holdDuration {
+			alert.State = StateFiring
+			alert.FiredAt = evalTime
+		}
+	}
+
+	return nil
+}
+
+// alertsToSend returns the alerts that need to be sent to Alertmanager and
+// marks them as sent.
+func (r *alertingRule) alertsToSend(
+	ts time.Time,
+	resendDelay time.Duration,
+	interval time.Duration,
+) []Alert {
+	r.Lock()
+	defer r.Unlock()
+
+	var result []Alert
+	for _, alert := range r.active {
+		if !alert.needsSending(ts, resendDelay) {
+			continue
+		}
+
+		// Alertmanager resolves alerts that are not resent before they
+		// become invalid, leave room for a few missed evaluations.
+		delta := resendDelay
+		if interval > delta {
+			delta = interval
+		}
+		alert.LastSentAt = ts
+		alert.ValidUntil = ts.Add(4 * delta)
+		result = append(result, *alert)
+	}
+	return result
+}
+
+// activeAlerts returns the pending and firing alerts of the rule.
+func (r *alertingRule) activeAlerts() []Alert {
+	r.RLock()
+	defer r.RUnlock()
+	return r.activeAlertsWithRLock()
+}
+
+func (r *alertingRule) activeAlertsWithRLock() []Alert {
+	result := make([]Alert, 0, len(r.active))
+	for _, alert := range r.active {
+		if alert.State != StateInactive {
+			result = append(result, *alert)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return labels.Compare(result[i].Labels, result[j].Labels) < 0
+	})
+	return result
+}
+
+// copyState copies the active alerts of a rule replaced by a reload.
+func (r *alertingRule) copyState(other *alertingRule) {
+	other.RLock()
+	defer other.RUnlock()
+	r.Lock()
+	defer r.Unlock()
+	for hash, alert := range other.active {
+		copied := *alert
+		r.active[hash] = &copied
+	}
+}
+
+// resetAlerts removes the active alerts of the rule.
+func (r *alertingRule) resetAlerts() {
+	r.Lock()
+	defer r.Unlock()
+	r.active = make(map[uint64]*Alert)
+}
+
+func (r *alertingRule) status() RuleStatus {
+	s := RuleStatus{
+		Type:        RuleTypeAlerting,
+		Name:        r.name,
+		Query:       r.query,
+		Duration:    r.holdDuration,
+		Labels:      r.labels,
+		Annotations: r.annotations,
+	}
+
+	r.RLock()
+	r.fillStatus(&s)
+	s.Alerts = r.activeAlertsWithRLock()
+	r.RUnlock()
+
+	for _, alert := range s.Alerts {
+		if alert.State > s.State {
+			s.State = alert.State
+		}
+	}
+	return s
+}
+
+func (a *Alert) needsSending(ts time.Time, resendDelay time.Duration) bool {
+	if a.State == StatePending {
+		return false
+	}
+	// Alerts resolved since they were last sent are sent right away.
+	if a.ResolvedAt.After(a.LastSentAt) {
+		return true
+	}
+	return !a.LastSentAt.Add(resendDelay).After(ts)
+}
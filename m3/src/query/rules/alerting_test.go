@@ -0,0 +1,168 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package rules
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testQueryFn struct {
+	sync.Mutex
+
+	results map[string]promql.Vector
+}
+
+func (f *testQueryFn) query(
+	_ context.Context,
+	query string,
+	_ time.Time,
+) (promql.Vector, error) {
+	f.Lock()
+	defer f.Unlock()
+	return f.results[query], nil
+}
+
+func newTestSample(value float64, lbls ...string) promql.Sample {
+	return promql.Sample{
+		Point:  promql.Point{V: value},
+		Metric: labels.FromStrings(lbls...),
+	}
+}
+
+func newTestRuleOptions(queryFn QueryFn) *ruleOptions {
+	return &ruleOptions{
+		queryFn:        queryFn,
+		externalLabels: labels.FromStrings("cluster", "test"),
+		externalURL:    &url.URL{},
+	}
+}
+
+func TestAlertingRuleLifecycle(t *testing.T) {
+	query := &testQueryFn{results: map[string]promql.Vector{
+		"up == 0": {newTestSample(0, "__name__", "up", "job", "api")},
+	}}
+	r := newAlertingRule(RuleConfiguration{
+		Alert: "InstanceDown",
+		Expr:  "up == 0",
+		For:   model.Duration(2 * time.Minute),
+		Labels: map[string]string{
+			"severity": "page",
+		},
+		Annotations: map[string]string{
+			"summary": "{{ $labels.job }} down in {{ $externalLabels.cluster }}, value {{ $value }}",
+		},
+	}, newTestRuleOptions(query.query))
+
+	var (
+		start       = time.Unix(1000, 0)
+		resendDelay = time.Minute
+		interval    = time.Minute
+	)
+
+	// Pending until active for the hold duration.
+	require.NoError(t, r.eval(context.Background(), start))
+	alerts := r.activeAlerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, StatePending, alerts[0].State)
+	assert.Equal(t, labels.FromStrings("alertname", "InstanceDown",
+		"job", "api", "severity", "page"), alerts[0].Labels)
+	assert.Equal(t, labels.FromStrings("summary", "api down in test, value 0"),
+		alerts[0].Annotations)
+	assert.Empty(t, r.alertsToSend(start, resendDelay, interval))
+
+	// Firing once active for the hold duration.
+	firedAt := start.Add(2 * time.Minute)
+	require.NoError(t, r.eval(context.Background(), firedAt))
+	status := r.status()
+	assert.Equal(t, StateFiring, status.State)
+	require.Len(t, status.Alerts, 1)
+	assert.Equal(t, start, status.Alerts[0].ActiveAt)
+	assert.Equal(t, firedAt, status.Alerts[0].FiredAt)
+
+	toSend := r.alertsToSend(firedAt, resendDelay, interval)
+	require.Len(t, toSend, 1)
+	assert.Equal(t, firedAt.Add(4*time.Minute), toSend[0].ValidUntil)
+
+	// Not resent before the resend delay.
+	assert.Empty(t, r.alertsToSend(firedAt.Add(30*time.Second), resendDelay, interval))
+	assert.Len(t, r.alertsToSend(firedAt.Add(time.Minute), resendDelay, interval), 1)
+
+	// Resolved once the expression no longer returns the series.
+	query.results = nil
+	resolvedAt := firedAt.Add(2 * time.Minute)
+	require.NoError(t, r.eval(context.Background(), resolvedAt))
+	assert.Empty(t, r.activeAlerts())
+	assert.Equal(t, StateInactive, r.status().State)
+
+	toSend = r.alertsToSend(resolvedAt, resendDelay, interval)
+	require.Len(t, toSend, 1)
+	assert.Equal(t, StateInactive, toSend[0].State)
+	assert.Equal(t, resolvedAt, toSend[0].ResolvedAt)
+
+	// Resolved alerts are eventually dropped.
+	require.NoError(t, r.eval(context.Background(),
+		resolvedAt.Add(resolvedRetention+time.Minute)))
+	assert.Empty(t, r.active)
+}
+
+func TestAlertingRulePendingDropped(t *testing.T) {
+	query := &testQueryFn{results: map[string]promql.Vector{
+		"up == 0": {newTestSample(0, "__name__", "up", "job", "api")},
+	}}
+	r := newAlertingRule(RuleConfiguration{
+		Alert: "InstanceDown",
+		Expr:  "up == 0",
+		For:   model.Duration(time.Hour),
+	}, newTestRuleOptions(query.query))
+
+	now := time.Unix(1000, 0)
+	require.NoError(t, r.eval(context.Background(), now))
+	require.Len(t, r.active, 1)
+
+	query.results = nil
+	require.NoError(t, r.eval(context.Background(), now.Add(time.Minute)))
+	assert.Empty(t, r.active)
+}
+
+func TestAlertingRuleDuplicateLabelSet(t *testing.T) {
+	query := &testQueryFn{results: map[string]promql.Vector{
+		"up": {
+			newTestSample(1, "__name__", "up", "job", "api"),
+			newTestSample(1, "__name__", "up_other", "job", "api"),
+		},
+	}}
+	r := newAlertingRule(RuleConfiguration{
+		Alert: "Up",
+		Expr:  "up",
+	}, newTestRuleOptions(query.query))
+
+	err := r.eval(context.Background(), time.Now())
+	require.Equal(t, errDuplicateLabelSet, err)
+}
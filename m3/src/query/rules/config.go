@@ -0,0 +1,243 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/services"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	defaultEvaluationInterval  = time.Minute
+	defaultResendDelay         = time.Minute
+	defaultAlertmanagerTimeout = 10 * time.Second
+	defaultElectionID          = "rules"
+	defaultElectionServiceName = "m3coordinator"
+)
+
+var (
+	errGroupNameEmpty      = errors.New("rule group name must not be empty")
+	errRuleRecordAndAlert  = errors.New("only one of record and alert must be set")
+	errRuleNoRecordOrAlert = errors.New("one of record or alert must be set")
+	errRuleExprEmpty       = errors.New("rule expr must not be empty")
+)
+
+// Configuration configures the evaluation of Prometheus recording and
+// alerting rules by the coordinator.
+type Configuration struct {
+	// EvaluationInterval is the interval rule groups are evaluated at unless
+	// they specify their own interval.
+	EvaluationInterval *time.Duration `yaml:"evaluationInterval"`
+
+	// Files are Prometheus rule files to load rule groups from.
+	Files []string `yaml:"files"`
+
+	// Groups are rule groups in the Prometheus rule file format.
+	Groups []GroupConfiguration `yaml:"groups"`
+
+	// KVKey is a KV key holding a Prometheus rule file as a string value,
+	// the rule groups are reloaded whenever the key is updated.
+	KVKey string `yaml:"kvKey"`
+
+	// ExternalLabels are the labels added to alerts sent to Alertmanager.
+	ExternalLabels map[string]string `yaml:"externalLabels"`
+
+	// ExternalURL is the URL the coordinator is reachable at, used as the
+	// generator URL of alerts and available to templates.
+	ExternalURL string `yaml:"externalURL"`
+
+	// Alertmanager configures the Alertmanager compatible webhook alerts are
+	// sent to, alerts are only exposed by the API if not set.
+	Alertmanager *AlertmanagerConfiguration `yaml:"alertmanager"`
+
+	// Election configures the election of the coordinator evaluating the rule
+	// groups, every coordinator evaluates them if not set.
+	Election *ElectionConfiguration `yaml:"election"`
+}
+
+// ElectionConfiguration configures the election of a single coordinator
+// evaluating the rule groups amongst the coordinators sharing the same rules,
+// the other coordinators load the rule groups without evaluating them.
+type ElectionConfiguration struct {
+	services.ElectionConfiguration `yaml:",inline"`
+
+	// ServiceID is the service the election is held for, the service name
+	// defaults to "m3coordinator".
+	ServiceID services.ServiceIDConfiguration `yaml:"serviceID"`
+
+	// ElectionID is the ID of the election, defaults to "rules".
+	ElectionID string `yaml:"electionID"`
+
+	// LeaderValue is the value announced when elected, defaults to the
+	// hostname.
+	LeaderValue string `yaml:"leaderValue"`
+}
+
+// AlertmanagerConfiguration configures the Alertmanager compatible webhook
+// alerts are sent to.
+type AlertmanagerConfiguration struct {
+	// URL is the URL alerts are posted to, e.g.
+	// http://alertmanager:9093/api/v1/alerts.
+	URL string `yaml:"url" validate:"nonzero"`
+
+	// Timeout is the timeout for sending alerts.
+	Timeout *time.Duration `yaml:"timeout"`
+
+	// ResendDelay is the minimum delay before resending a firing alert.
+	ResendDelay *time.Duration `yaml:"resendDelay"`
+}
+
+// GroupConfiguration is a rule group in the Prometheus rule file format.
+type GroupConfiguration struct {
+	Name     string              `yaml:"name"`
+	Interval model.Duration      `yaml:"interval,omitempty"`
+	Rules    []RuleConfiguration `yaml:"rules"`
+}
+
+// RuleConfiguration is a recording or alerting rule in the Prometheus rule
+// file format.
+type RuleConfiguration struct {
+	Record      string            `yaml:"record,omitempty"`
+	Alert       string            `yaml:"alert,omitempty"`
+	Expr        string            `yaml:"expr"`
+	For         model.Duration    `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+type ruleFile struct {
+	Groups []GroupConfiguration `yaml:"groups"`
+}
+
+// ParseGroups parses and validates the rule groups of a Prometheus rule file.
+func ParseGroups(content []byte) ([]GroupConfiguration, error) {
+	var file ruleFile
+	if err := yaml.UnmarshalStrict(content, &file); err != nil {
+		return nil, err
+	}
+	if err := validateGroups(file.Groups); err != nil {
+		return nil, err
+	}
+	return file.Groups, nil
+}
+
+func validateGroups(groups []GroupConfiguration) error {
+	names := make(map[string]struct{}, len(groups))
+	for _, g := range groups {
+		if g.Name == "" {
+			return errGroupNameEmpty
+		}
+		if _, ok := names[g.Name]; ok {
+			return fmt.Errorf("duplicate rule group name: %s", g.Name)
+		}
+		names[g.Name] = struct{}{}
+
+		for i, r := range g.Rules {
+			if err := r.Validate(); err != nil {
+				return fmt.Errorf("invalid rule %d of group %s: %v", i, g.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate validates the rule.
+func (r RuleConfiguration) Validate() error {
+	switch {
+	case r.Record != "" && r.Alert != "":
+		return errRuleRecordAndAlert
+	case r.Record == "" && r.Alert == "":
+		return errRuleNoRecordOrAlert
+	case r.Expr == "":
+		return errRuleExprEmpty
+	}
+
+	if _, err := parser.ParseExpr(r.Expr); err != nil {
+		return fmt.Errorf("could not parse expression: %v", err)
+	}
+
+	if r.Record != "" {
+		if !model.IsValidMetricName(model.LabelValue(r.Record)) {
+			return fmt.Errorf("invalid recording rule name: %s", r.Record)
+		}
+		if len(r.Annotations) > 0 {
+			return fmt.Errorf("invalid field annotations in recording rule %s", r.Record)
+		}
+		if r.For != 0 {
+			return fmt.Errorf("invalid field for in recording rule %s", r.Record)
+		}
+	}
+
+	for name := range r.Labels {
+		if !model.LabelName(name).IsValid() {
+			return fmt.Errorf("invalid label name: %s", name)
+		}
+	}
+	for name := range r.Annotations {
+		if !model.LabelName(name).IsValid() {
+			return fmt.Errorf("invalid annotation name: %s", name)
+		}
+	}
+	return nil
+}
+
+func (c Configuration) evaluationIntervalOrDefault() time.Duration {
+	if c.EvaluationInterval != nil && *c.EvaluationInterval > 0 {
+		return *c.EvaluationInterval
+	}
+	return defaultEvaluationInterval
+}
+
+func (c AlertmanagerConfiguration) timeoutOrDefault() time.Duration {
+	if c.Timeout != nil && *c.Timeout > 0 {
+		return *c.Timeout
+	}
+	return defaultAlertmanagerTimeout
+}
+
+func (c AlertmanagerConfiguration) resendDelayOrDefault() time.Duration {
+	if c.ResendDelay != nil && *c.ResendDelay > 0 {
+		return *c.ResendDelay
+	}
+	return defaultResendDelay
+}
+
+func (c ElectionConfiguration) serviceIDOrDefault() services.ServiceID {
+	sid := c.ServiceID.NewServiceID()
+	if sid.Name() == "" {
+		sid = sid.SetName(defaultElectionServiceName)
+	}
+	return sid
+}
+
+func (c ElectionConfiguration) electionIDOrDefault() string {
+	if c.ElectionID != "" {
+		return c.ElectionID
+	}
+	return defaultElectionID
+}
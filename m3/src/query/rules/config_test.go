@@ -0,0 +1,109 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGroups(t *testing.T) {
+	groups, err := ParseGroups([]byte(`
+groups:
+  - name: example
+    interval: 30s
+    rules:
+      - record: job:http_requests:rate5m
+        expr: sum by (job) (rate(http_requests_total[5m]))
+      - alert: HighErrorRate
+        expr: job:http_errors:rate5m > 0.5
+        for: 10m
+        labels:
+          severity: page
+        annotations:
+          summary: High error rate on {{ $labels.job }}
+`))
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	g := groups[0]
+	assert.Equal(t, "example", g.Name)
+	assert.Equal(t, model.Duration(30*time.Second), g.Interval)
+	require.Len(t, g.Rules, 2)
+	assert.Equal(t, "job:http_requests:rate5m", g.Rules[0].Record)
+	assert.Equal(t, "HighErrorRate", g.Rules[1].Alert)
+	assert.Equal(t, model.Duration(10*time.Minute), g.Rules[1].For)
+	assert.Equal(t, map[string]string{"severity": "page"}, g.Rules[1].Labels)
+}
+
+func TestParseGroupsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name:    "unknown field",
+			content: "groups:\n  - name: a\n    foo: bar\n",
+		},
+		{
+			name:    "no group name",
+			content: "groups:\n  - rules:\n      - record: a\n        expr: up\n",
+		},
+		{
+			name:    "duplicate group name",
+			content: "groups:\n  - name: a\n  - name: a\n",
+		},
+		{
+			name:    "record and alert",
+			content: "groups:\n  - name: a\n    rules:\n      - record: a\n        alert: a\n        expr: up\n",
+		},
+		{
+			name:    "no record or alert",
+			content: "groups:\n  - name: a\n    rules:\n      - expr: up\n",
+		},
+		{
+			name:    "invalid expression",
+			content: "groups:\n  - name: a\n    rules:\n      - record: a\n        expr: sum(\n",
+		},
+		{
+			name:    "invalid record name",
+			content: "groups:\n  - name: a\n    rules:\n      - record: a-b\n        expr: up\n",
+		},
+		{
+			name:    "recording rule annotations",
+			content: "groups:\n  - name: a\n    rules:\n      - record: a\n        expr: up\n        annotations:\n          a: b\n",
+		},
+		{
+			name:    "invalid label name",
+			content: "groups:\n  - name: a\n    rules:\n      - alert: a\n        expr: up\n        labels:\n          a-b: c\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseGroups([]byte(tt.content))
+			require.Error(t, err)
+		})
+	}
+}
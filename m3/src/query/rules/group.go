@@ -0,0 +1,255 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/x/clock"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+type groupMetrics struct {
+	evaluations        tally.Counter
+	evaluationFailures tally.Counter
+	evaluationLatency  tally.Timer
+	notifications      tally.Counter
+	notificationErrors tally.Counter
+}
+
+func newGroupMetrics(scope tally.Scope) groupMetrics {
+	return groupMetrics{
+		evaluations:        scope.Counter("evaluations"),
+		evaluationFailures: scope.Counter("evaluation-failures"),
+		evaluationLatency:  scope.Timer("evaluation-latency"),
+		notifications:      scope.Counter("notifications"),
+		notificationErrors: scope.Counter("notification-errors"),
+	}
+}
+
+// group is a set of rules evaluated sequentially at a regular interval.
+type group struct {
+	sync.RWMutex
+
+	name        string
+	file        string
+	interval    time.Duration
+	rules       []rule
+	notifier    *notifier
+	resendDelay time.Duration
+	leaderFn    func() bool
+	nowFn       clock.NowFn
+	logger      *zap.Logger
+	metrics     groupMetrics
+
+	// evaluating is only accessed by the goroutine running the group.
+	evaluating bool
+
+	lastEvaluation time.Time
+	evaluationTime time.Duration
+
+	done       chan struct{}
+	terminated chan struct{}
+}
+
+type groupOptions struct {
+	defaultInterval time.Duration
+	ruleOptions     *ruleOptions
+	notifier        *notifier
+	resendDelay     time.Duration
+	// leaderFn returns whether the coordinator is elected to evaluate the
+	// rule groups, nil if every coordinator evaluates them.
+	leaderFn func() bool
+	nowFn    clock.NowFn
+	logger   *zap.Logger
+	metrics  groupMetrics
+}
+
+func newGroup(cfg GroupConfiguration, file string, opts groupOptions) *group {
+	interval := time.Duration(cfg.Interval)
+	if interval <= 0 {
+		interval = opts.defaultInterval
+	}
+
+	rules := make([]rule, 0, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		if r.Alert != "" {
+			rules = append(rules, newAlertingRule(r, opts.ruleOptions))
+		} else {
+			rules = append(rules, newRecordingRule(r, opts.ruleOptions))
+		}
+	}
+
+	return &group{
+		name:        cfg.Name,
+		file:        file,
+		interval:    interval,
+		rules:       rules,
+		notifier:    opts.notifier,
+		resendDelay: opts.resendDelay,
+		leaderFn:    opts.leaderFn,
+		nowFn:       opts.nowFn,
+		logger: opts.logger.With(zap.String("group", cfg.Name),
+			zap.String("file", file)),
+		metrics:    opts.metrics,
+		done:       make(chan struct{}),
+		terminated: make(chan struct{}),
+	}
+}
+
+// key returns the key identifying the group across reloads.
+func (g *group) key() string {
+	return g.file + ";" + g.name
+}
+
+// run evaluates the group at each interval until stopped.
+func (g *group) run() {
+	defer close(g.terminated)
+
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	g.tick()
+	for {
+		select {
+		case <-g.done:
+			return
+		case <-ticker.C:
+			g.tick()
+		}
+	}
+}
+
+// tick evaluates the group unless another coordinator is elected to. The
+// alerts are reset when the coordinator is no longer elected so that alerts
+// active before are not considered active for the hold duration once elected
+// again.
+func (g *group) tick() {
+	if g.leaderFn != nil && !g.leaderFn() {
+		if g.evaluating {
+			g.evaluating = false
+			g.resetAlerts()
+		}
+		return
+	}
+	g.evaluating = true
+	g.eval(g.nowFn())
+}
+
+func (g *group) stop() {
+	close(g.done)
+	<-g.terminated
+}
+
+// eval evaluates the rules of the group at the given time and sends the
+// resulting alerts.
+func (g *group) eval(ts time.Time) {
+	// NB: evaluations must complete before the next one is due.
+	ctx, cancel := context.WithTimeout(context.Background(), g.interval)
+	defer cancel()
+
+	start := g.nowFn()
+	for _, r := range g.rules {
+		ruleStart := g.nowFn()
+		err := r.eval(ctx, ts)
+		r.setEvaluation(ts, g.nowFn().Sub(ruleStart), err)
+
+		g.metrics.evaluations.Inc(1)
+		if err != nil {
+			g.metrics.evaluationFailures.Inc(1)
+			g.logger.Warn("rule evaluation failed", zap.Error(err))
+			continue
+		}
+
+		alerting, ok := r.(*alertingRule)
+		if !ok || g.notifier == nil {
+			continue
+		}
+
+		alerts := alerting.alertsToSend(ts, g.resendDelay, g.interval)
+		if err := g.notifier.send(ctx, alerting.query, alerts); err != nil {
+			g.metrics.notificationErrors.Inc(1)
+			g.logger.Warn("unable to send alerts",
+				zap.String("alert", alerting.name), zap.Error(err))
+			continue
+		}
+		g.metrics.notifications.Inc(int64(len(alerts)))
+	}
+
+	evaluationTime := g.nowFn().Sub(start)
+	g.metrics.evaluationLatency.Record(evaluationTime)
+
+	g.Lock()
+	g.lastEvaluation = ts
+	g.evaluationTime = evaluationTime
+	g.Unlock()
+}
+
+// copyState copies the alerts of the alerting rules of a group replaced by
+// a reload, rules are matched by name and query.
+func (g *group) copyState(other *group) {
+	previous := make(map[string]*alertingRule, len(other.rules))
+	for _, r := range other.rules {
+		if alerting, ok := r.(*alertingRule); ok {
+			previous[alerting.name+";"+alerting.query] = alerting
+		}
+	}
+	for _, r := range g.rules {
+		alerting, ok := r.(*alertingRule)
+		if !ok {
+			continue
+		}
+		if prev, ok := previous[alerting.name+";"+alerting.query]; ok {
+			alerting.copyState(prev)
+		}
+	}
+}
+
+// resetAlerts resets the alerts of the alerting rules of the group.
+func (g *group) resetAlerts() {
+	for _, r := range g.rules {
+		if alerting, ok := r.(*alertingRule); ok {
+			alerting.resetAlerts()
+		}
+	}
+}
+
+func (g *group) status() GroupStatus {
+	s := GroupStatus{
+		Name:     g.name,
+		File:     g.file,
+		Interval: g.interval,
+		Rules:    make([]RuleStatus, 0, len(g.rules)),
+	}
+	for _, r := range g.rules {
+		s.Rules = append(s.Rules, r.status())
+	}
+
+	g.RLock()
+	s.LastEvaluation = g.lastEvaluation
+	s.EvaluationTime = g.evaluationTime
+	g.RUnlock()
+	return s
+}
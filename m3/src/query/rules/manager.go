@@ -0,0 +1,473 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/cluster/services/leader/campaign"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"go.uber.org/zap"
+)
+
+const (
+	// configGroupsFile is the file reported for the rule groups defined inline
+	// in the configuration.
+	configGroupsFile = "config"
+
+	// campaignRetryInterval is the delay before campaigning again once a
+	// campaign ended, e.g. because the etcd session expired.
+	campaignRetryInterval = 5 * time.Second
+)
+
+var (
+	errManagerNoEngine    = errors.New("rule manager requires an engine or a query function")
+	errManagerNoStorage   = errors.New("rule manager requires a storage")
+	errManagerNoKVStore   = errors.New("rule manager requires a KV store to load rules from KV")
+	errManagerNoLeader    = errors.New("rule manager requires a leader service to hold an election")
+	errManagerAlreadyOpen = errors.New("rule manager already started")
+	errManagerClosed      = errors.New("rule manager closed")
+)
+
+type manager struct {
+	sync.RWMutex
+
+	// updateLock serializes replacing and stopping groups, which is done
+	// without holding the manager lock since stopping a group waits for its
+	// evaluation to complete.
+	updateLock sync.Mutex
+
+	cfg       Configuration
+	kvStoreFn KVStoreFn
+	groupOpts groupOptions
+	logger    *zap.Logger
+
+	groups    []*group
+	watch     kv.ValueWatch
+	kvVersion int
+	started   bool
+	closed    bool
+
+	// election is only set if an election is configured, the leader service
+	// is created and only accessed by the campaign goroutine until it is
+	// done, leader is set atomically while elected.
+	election        *ElectionConfiguration
+	leaderServiceFn LeaderServiceFn
+	leaderService   services.LeaderService
+	campaignOpts    services.CampaignOptions
+	leader          int32
+	campaignWg      sync.WaitGroup
+	doneCh          chan struct{}
+}
+
+// NewManager returns a new rule manager.
+func NewManager(opts ManagerOptions) (Manager, error) {
+	cfg := opts.Configuration
+	if opts.Engine == nil && opts.QueryFn == nil {
+		return nil, errManagerNoEngine
+	}
+	if opts.Storage == nil {
+		return nil, errManagerNoStorage
+	}
+	if cfg.KVKey != "" && opts.KVStoreFn == nil {
+		return nil, errManagerNoKVStore
+	}
+	if cfg.Election != nil && opts.LeaderServiceFn == nil {
+		return nil, errManagerNoLeader
+	}
+	if err := validateGroups(cfg.Groups); err != nil {
+		return nil, err
+	}
+
+	externalURL, err := url.Parse(cfg.ExternalURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid external URL: %v", err)
+	}
+
+	clockOpts := opts.ClockOptions
+	if clockOpts == nil {
+		clockOpts = clock.NewOptions()
+	}
+	instrumentOpts := opts.InstrumentOptions
+	if instrumentOpts == nil {
+		instrumentOpts = instrument.NewOptions()
+	}
+
+	interval := cfg.evaluationIntervalOrDefault()
+	queryFn := opts.QueryFn
+	if queryFn == nil {
+		queryFn = NewEngineQueryFn(opts.Engine, opts.TagOptions, interval)
+	}
+
+	externalLabels := labels.FromMap(cfg.ExternalLabels)
+	groupOpts := groupOptions{
+		defaultInterval: interval,
+		ruleOptions: &ruleOptions{
+			queryFn:        queryFn,
+			storage:        opts.Storage,
+			tagOptions:     opts.TagOptions,
+			externalLabels: externalLabels,
+			externalURL:    externalURL,
+		},
+		nowFn:   clockOpts.NowFn(),
+		logger:  instrumentOpts.Logger(),
+		metrics: newGroupMetrics(instrumentOpts.MetricsScope().SubScope("rules")),
+	}
+	if am := cfg.Alertmanager; am != nil {
+		groupOpts.notifier = newNotifier(*am, externalLabels, externalURL)
+		groupOpts.resendDelay = am.resendDelayOrDefault()
+	}
+
+	m := &manager{
+		cfg:             cfg,
+		kvStoreFn:       opts.KVStoreFn,
+		groupOpts:       groupOpts,
+		logger:          instrumentOpts.Logger(),
+		election:        cfg.Election,
+		leaderServiceFn: opts.LeaderServiceFn,
+		doneCh:          make(chan struct{}),
+	}
+	if election := cfg.Election; election != nil {
+		leaderValue := election.LeaderValue
+		if leaderValue == "" {
+			if leaderValue, err = os.Hostname(); err != nil {
+				return nil, err
+			}
+		}
+		campaignOpts, err := services.NewCampaignOptions()
+		if err != nil {
+			return nil, err
+		}
+		m.campaignOpts = campaignOpts.SetLeaderValue(leaderValue)
+		m.groupOpts.leaderFn = m.isLeader
+	}
+	return m, nil
+}
+
+func (m *manager) Start() error {
+	m.Lock()
+	if m.started {
+		m.Unlock()
+		return errManagerAlreadyOpen
+	}
+	m.started = true
+	m.Unlock()
+
+	if m.election != nil {
+		m.campaignWg.Add(1)
+		go m.campaign()
+	}
+
+	m.updateGroups(configGroupsFile, m.cfg.Groups)
+
+	for _, file := range m.cfg.Files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		groups, err := ParseGroups(content)
+		if err != nil {
+			return fmt.Errorf("invalid rule file %s: %v", file, err)
+		}
+		m.updateGroups(file, groups)
+	}
+
+	if m.cfg.KVKey == "" {
+		return nil
+	}
+	return m.watchKV()
+}
+
+// campaign campaigns to evaluate the rule groups until the manager is closed,
+// campaigning again whenever the campaign ends.
+func (m *manager) campaign() {
+	defer m.campaignWg.Done()
+
+	electionID := m.election.electionIDOrDefault()
+	for {
+		// NB: the leader service is created lazily, like the KV store, since
+		// the cluster client may only be available once connected.
+		var err error
+		if m.leaderService == nil {
+			m.leaderService, err = m.leaderServiceFn(m.election.serviceIDOrDefault(),
+				m.election.ElectionConfiguration.NewOptions())
+		}
+
+		var statusCh <-chan campaign.Status
+		if err == nil {
+			statusCh, err = m.leaderService.Campaign(electionID, m.campaignOpts)
+		}
+		if err != nil {
+			m.logger.Error("could not campaign to evaluate rules", zap.Error(err))
+		} else if closed := m.watchCampaign(statusCh); closed {
+			return
+		}
+		atomic.StoreInt32(&m.leader, 0)
+
+		select {
+		case <-m.doneCh:
+			return
+		case <-time.After(campaignRetryInterval):
+		}
+	}
+}
+
+// watchCampaign tracks whether the manager is elected until the campaign
+// ends, returning true if it ends because the manager is closed.
+func (m *manager) watchCampaign(statusCh <-chan campaign.Status) bool {
+	for {
+		select {
+		case <-m.doneCh:
+			return true
+		case status, ok := <-statusCh:
+			if !ok {
+				m.logger.Warn("rules evaluation campaign ended, campaigning again")
+				return false
+			}
+			switch status.State {
+			case campaign.Leader:
+				m.logger.Info("elected to evaluate rules")
+				atomic.StoreInt32(&m.leader, 1)
+			case campaign.Error:
+				m.logger.Error("error campaigning to evaluate rules",
+					zap.Error(status.Err))
+				atomic.StoreInt32(&m.leader, 0)
+			default:
+				atomic.StoreInt32(&m.leader, 0)
+			}
+		}
+	}
+}
+
+func (m *manager) isLeader() bool {
+	return atomic.LoadInt32(&m.leader) == 1
+}
+
+func (m *manager) watchKV() error {
+	store, err := m.kvStoreFn()
+	if err != nil {
+		return err
+	}
+
+	// NB: load the rules eagerly so they are evaluated as soon as started,
+	// the watch may only notify of the existing value later on.
+	key := m.cfg.KVKey
+	value, err := store.Get(key)
+	if err != nil && err != kv.ErrNotFound {
+		return err
+	}
+	if err == nil {
+		m.updateKVGroups(value)
+	}
+
+	watch, err := store.Watch(key)
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	if m.closed {
+		m.Unlock()
+		watch.Close()
+		return errManagerClosed
+	}
+	m.watch = watch
+	m.Unlock()
+
+	go func() {
+		for range watch.C() {
+			m.updateKVGroups(watch.Get())
+		}
+	}()
+	return nil
+}
+
+func (m *manager) updateKVGroups(value kv.Value) {
+	key := m.cfg.KVKey
+	if value == nil {
+		m.logger.Info("rules KV key deleted, removing rule groups",
+			zap.String("key", key))
+		m.updateGroups(key, nil)
+		m.Lock()
+		m.kvVersion = 0
+		m.Unlock()
+		return
+	}
+
+	// NB: the watch notifies of the value loaded eagerly on start.
+	m.RLock()
+	unchanged := value.Version() == m.kvVersion
+	m.RUnlock()
+	if unchanged {
+		return
+	}
+
+	var content commonpb.StringProto
+	if err := value.Unmarshal(&content); err != nil {
+		m.logger.Error("could not unmarshal rules KV key",
+			zap.String("key", key), zap.Error(err))
+		return
+	}
+
+	groups, err := ParseGroups([]byte(content.Value))
+	if err != nil {
+		m.logger.Error("invalid rules in KV key, keeping current rules",
+			zap.String("key", key), zap.Error(err))
+		return
+	}
+
+	m.logger.Info("loaded rules from KV", zap.String("key", key),
+		zap.Int("version", value.Version()), zap.Int("groups", len(groups)))
+	m.updateGroups(key, groups)
+
+	m.Lock()
+	m.kvVersion = value.Version()
+	m.Unlock()
+}
+
+// updateGroups replaces the rule groups loaded from the given file, keeping
+// the alerts of the rules still present.
+func (m *manager) updateGroups(file string, cfgs []GroupConfiguration) {
+	m.updateLock.Lock()
+	defer m.updateLock.Unlock()
+
+	m.Lock()
+	if m.closed {
+		m.Unlock()
+		return
+	}
+
+	var (
+		groups   = make([]*group, 0, len(m.groups)+len(cfgs))
+		previous []*group
+		added    []*group
+	)
+	for _, g := range m.groups {
+		if g.file != file {
+			groups = append(groups, g)
+			continue
+		}
+		previous = append(previous, g)
+	}
+	for _, cfg := range cfgs {
+		g := newGroup(cfg, file, m.groupOpts)
+		added = append(added, g)
+		groups = append(groups, g)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].file != groups[j].file {
+			return groups[i].file < groups[j].file
+		}
+		return groups[i].name < groups[j].name
+	})
+	m.groups = groups
+	m.Unlock()
+
+	// NB: the previous groups are stopped before their state is copied so
+	// that no evaluation is lost, without blocking readers of the groups.
+	byKey := make(map[string]*group, len(previous))
+	for _, g := range previous {
+		g.stop()
+		byKey[g.key()] = g
+	}
+	for _, g := range added {
+		if prev, ok := byKey[g.key()]; ok {
+			g.copyState(prev)
+		}
+		go g.run()
+	}
+}
+
+func (m *manager) Groups() []GroupStatus {
+	m.RLock()
+	defer m.RUnlock()
+
+	result := make([]GroupStatus, 0, len(m.groups))
+	for _, g := range m.groups {
+		result = append(result, g.status())
+	}
+	return result
+}
+
+func (m *manager) Alerts() []Alert {
+	m.RLock()
+	defer m.RUnlock()
+
+	var result []Alert
+	for _, g := range m.groups {
+		for _, r := range g.rules {
+			if alerting, ok := r.(*alertingRule); ok {
+				result = append(result, alerting.activeAlerts()...)
+			}
+		}
+	}
+	return result
+}
+
+func (m *manager) Close() error {
+	m.updateLock.Lock()
+	defer m.updateLock.Unlock()
+
+	m.Lock()
+	if m.closed {
+		m.Unlock()
+		return errManagerClosed
+	}
+	m.closed = true
+	close(m.doneCh)
+
+	if m.watch != nil {
+		m.watch.Close()
+	}
+	groups := m.groups
+	m.groups = nil
+	started := m.started
+	m.Unlock()
+
+	for _, g := range groups {
+		g.stop()
+	}
+
+	if m.election == nil || !started {
+		return nil
+	}
+	m.campaignWg.Wait()
+	atomic.StoreInt32(&m.leader, 0)
+	if m.leaderService == nil {
+		return nil
+	}
+	return m.leaderService.Resign(m.election.electionIDOrDefault())
+}
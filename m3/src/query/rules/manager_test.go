@@ -0,0 +1,338 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package rules
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/cluster/services/leader/campaign"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	xclock "github.com/m3db/m3/src/x/clock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKVKey = "_rules/prometheus"
+
+func newTestManager(
+	t *testing.T,
+	ctrl *gomock.Controller,
+	cfg Configuration,
+	query *testQueryFn,
+	store kv.Store,
+) Manager {
+	interval := time.Hour
+	cfg.EvaluationInterval = &interval
+
+	writes := storage.NewMockStorage(ctrl)
+	writes.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	m, err := NewManager(ManagerOptions{
+		Configuration: cfg,
+		QueryFn:       query.query,
+		Storage:       writes,
+		TagOptions:    models.NewTagOptions(),
+		KVStoreFn: func() (kv.Store, error) {
+			return store, nil
+		},
+	})
+	require.NoError(t, err)
+	return m
+}
+
+func waitForEvaluations(t *testing.T, m Manager, numGroups int) []GroupStatus {
+	var groups []GroupStatus
+	evaluated := xclock.WaitUntil(func() bool {
+		groups = m.Groups()
+		if len(groups) != numGroups {
+			return false
+		}
+		for _, g := range groups {
+			if g.LastEvaluation.IsZero() {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second)
+	require.True(t, evaluated)
+	return groups
+}
+
+func TestManagerConfigAndFileGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "rules")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := path.Join(dir, "rules.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`
+groups:
+  - name: file
+    rules:
+      - alert: InstanceDown
+        expr: up == 0
+`), 0644))
+
+	query := &testQueryFn{results: map[string]promql.Vector{
+		"up == 0": {newTestSample(0, "__name__", "up", "job", "api")},
+		"up":      {newTestSample(1, "__name__", "up", "job", "web")},
+	}}
+	m := newTestManager(t, ctrl, Configuration{
+		Files: []string{file},
+		Groups: []GroupConfiguration{
+			{
+				Name:  "inline",
+				Rules: []RuleConfiguration{{Record: "up:copy", Expr: "up"}},
+			},
+		},
+	}, query, mem.NewStore())
+	require.NoError(t, m.Start())
+	defer m.Close()
+
+	// Groups are sorted by file then name.
+	groups := waitForEvaluations(t, m, 2)
+	assert.Equal(t, "file", groups[0].Name)
+	assert.Equal(t, file, groups[0].File)
+	require.Len(t, groups[0].Rules, 1)
+	assert.Equal(t, RuleTypeAlerting, groups[0].Rules[0].Type)
+	assert.Equal(t, StateFiring, groups[0].Rules[0].State)
+
+	assert.Equal(t, "inline", groups[1].Name)
+	assert.Equal(t, configGroupsFile, groups[1].File)
+	assert.Equal(t, time.Hour, groups[1].Interval)
+	require.Len(t, groups[1].Rules, 1)
+	assert.Equal(t, RuleTypeRecording, groups[1].Rules[0].Type)
+	assert.Equal(t, RuleHealthGood, groups[1].Rules[0].Health)
+
+	alerts := m.Alerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "api", alerts[0].Labels.Get("job"))
+
+	require.NoError(t, m.Close())
+	assert.Empty(t, m.Groups())
+}
+
+func TestManagerKVGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mem.NewStore()
+	_, err := store.Set(testKVKey, &commonpb.StringProto{Value: `
+groups:
+  - name: kv
+    rules:
+      - alert: InstanceDown
+        expr: up == 0
+`})
+	require.NoError(t, err)
+
+	query := &testQueryFn{results: map[string]promql.Vector{
+		"up == 0": {newTestSample(0, "__name__", "up", "job", "api")},
+	}}
+	m := newTestManager(t, ctrl, Configuration{KVKey: testKVKey}, query, store)
+	require.NoError(t, m.Start())
+	defer m.Close()
+
+	groups := waitForEvaluations(t, m, 1)
+	assert.Equal(t, "kv", groups[0].Name)
+	assert.Equal(t, testKVKey, groups[0].File)
+	activeAt := m.Alerts()[0].ActiveAt
+
+	// Updating the rules keeps the state of the unchanged alerting rules.
+	_, err = store.Set(testKVKey, &commonpb.StringProto{Value: `
+groups:
+  - name: kv
+    rules:
+      - alert: InstanceDown
+        expr: up == 0
+      - record: up:copy
+        expr: up
+`})
+	require.NoError(t, err)
+
+	require.True(t, xclock.WaitUntil(func() bool {
+		groups := m.Groups()
+		return len(groups) == 1 && len(groups[0].Rules) == 2 && len(m.Alerts()) == 1
+	}, 5*time.Second))
+	alerts := m.Alerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, activeAt, alerts[0].ActiveAt)
+
+	// Invalid rules are ignored.
+	_, err = store.Set(testKVKey, &commonpb.StringProto{Value: "groups: ["})
+	require.NoError(t, err)
+
+	// Deleting the key removes the rules.
+	_, err = store.Delete(testKVKey)
+	require.NoError(t, err)
+	require.True(t, xclock.WaitUntil(func() bool {
+		return len(m.Groups()) == 0
+	}, 5*time.Second))
+}
+
+func TestManagerUpdateDoesNotBlockOnEvaluation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mem.NewStore()
+	_, err := store.Set(testKVKey, &commonpb.StringProto{Value: `
+groups:
+  - name: kv
+    rules:
+      - record: up:copy
+        expr: up
+`})
+	require.NoError(t, err)
+
+	// Block the evaluations until the rules have been updated.
+	query := &testQueryFn{results: map[string]promql.Vector{}}
+	query.Lock()
+	m := newTestManager(t, ctrl, Configuration{KVKey: testKVKey}, query, store)
+	require.NoError(t, m.Start())
+	defer m.Close()
+
+	_, err = store.Set(testKVKey, &commonpb.StringProto{Value: `
+groups:
+  - name: kv
+    rules:
+      - record: up:copy
+        expr: up
+      - record: up:other
+        expr: up
+`})
+	require.NoError(t, err)
+
+	// The groups are replaced while the previous group is still evaluating.
+	require.True(t, xclock.WaitUntil(func() bool {
+		groups := m.Groups()
+		return len(groups) == 1 && len(groups[0].Rules) == 2
+	}, 5*time.Second))
+
+	query.Unlock()
+	groups := waitForEvaluations(t, m, 1)
+	require.Len(t, groups[0].Rules, 2)
+}
+
+func TestManagerElection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	statusCh := make(chan campaign.Status)
+	leaderService := services.NewMockLeaderService(ctrl)
+	leaderService.EXPECT().
+		Campaign("rules", gomock.Any()).
+		DoAndReturn(func(_ string, opts services.CampaignOptions) (<-chan campaign.Status, error) {
+			assert.Equal(t, "coordinator-a", opts.LeaderValue())
+			return statusCh, nil
+		})
+	leaderService.EXPECT().Resign("rules").Return(nil)
+
+	writes := storage.NewMockStorage(ctrl)
+	writes.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	query := &testQueryFn{results: map[string]promql.Vector{
+		"up == 0": {newTestSample(0, "__name__", "up", "job", "api")},
+	}}
+	interval := 10 * time.Millisecond
+	m, err := NewManager(ManagerOptions{
+		Configuration: Configuration{
+			EvaluationInterval: &interval,
+			Groups: []GroupConfiguration{
+				{
+					Name:  "inline",
+					Rules: []RuleConfiguration{{Alert: "InstanceDown", Expr: "up == 0"}},
+				},
+			},
+			Election: &ElectionConfiguration{LeaderValue: "coordinator-a"},
+		},
+		QueryFn: query.query,
+		Storage: writes,
+		LeaderServiceFn: func(
+			sid services.ServiceID,
+			_ services.ElectionOptions,
+		) (services.LeaderService, error) {
+			assert.Equal(t, "m3coordinator", sid.Name())
+			return leaderService, nil
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.Start())
+
+	// Followers load the rule groups without evaluating them.
+	statusCh <- campaign.NewStatus(campaign.Follower)
+	time.Sleep(5 * interval)
+	groups := m.Groups()
+	require.Len(t, groups, 1)
+	assert.True(t, groups[0].LastEvaluation.IsZero())
+
+	statusCh <- campaign.NewStatus(campaign.Leader)
+	waitForEvaluations(t, m, 1)
+	require.True(t, xclock.WaitUntil(func() bool {
+		return len(m.Alerts()) == 1
+	}, 5*time.Second))
+
+	// The alerts are reset once no longer elected.
+	statusCh <- campaign.NewStatus(campaign.Follower)
+	require.True(t, xclock.WaitUntil(func() bool {
+		return len(m.Alerts()) == 0
+	}, 5*time.Second))
+
+	require.NoError(t, m.Close())
+}
+
+func TestNewManagerInvalidOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := &testQueryFn{}
+	_, err := NewManager(ManagerOptions{Storage: storage.NewMockStorage(ctrl)})
+	require.Equal(t, errManagerNoEngine, err)
+
+	_, err = NewManager(ManagerOptions{QueryFn: query.query})
+	require.Equal(t, errManagerNoStorage, err)
+
+	_, err = NewManager(ManagerOptions{
+		Configuration: Configuration{KVKey: testKVKey},
+		QueryFn:       query.query,
+		Storage:       storage.NewMockStorage(ctrl),
+	})
+	require.Equal(t, errManagerNoKVStore, err)
+
+	_, err = NewManager(ManagerOptions{
+		Configuration: Configuration{Election: &ElectionConfiguration{}},
+		QueryFn:       query.query,
+		Storage:       storage.NewMockStorage(ctrl),
+	})
+	require.Equal(t, errManagerNoLeader, err)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// alertmanagerAlert is an alert in the format of the Alertmanager API.
+type alertmanagerAlert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+// notifier sends alerts to an Alertmanager compatible webhook.
+type notifier struct {
+	url            string
+	client         *http.Client
+	externalLabels labels.Labels
+	externalURL    *url.URL
+}
+
+func newNotifier(
+	cfg AlertmanagerConfiguration,
+	externalLabels labels.Labels,
+	externalURL *url.URL,
+) *notifier {
+	return &notifier{
+		url:            cfg.URL,
+		client:         &http.Client{Timeout: cfg.timeoutOrDefault()},
+		externalLabels: externalLabels,
+		externalURL:    externalURL,
+	}
+}
+
+// send posts the given alerts, produced by the given rule query.
+func (n *notifier) send(ctx context.Context, query string, alerts []Alert) error {
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	payload := make([]alertmanagerAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		b := labels.NewBuilder(alert.Labels)
+		for _, l := range n.externalLabels {
+			if alert.Labels.Get(l.Name) == "" {
+				b.Set(l.Name, l.Value)
+			}
+		}
+
+		a := alertmanagerAlert{
+			Labels:       b.Labels().Map(),
+			Annotations:  alert.Annotations.Map(),
+			StartsAt:     alert.ActiveAt,
+			GeneratorURL: n.generatorURL(query),
+		}
+		if alert.State == StateInactive {
+			a.EndsAt = alert.ResolvedAt
+		} else {
+			a.EndsAt = alert.ValidUntil
+		}
+		payload = append(payload, a)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	resp, err := n.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("bad response status from %s: %s", n.url, resp.Status)
+	}
+	return nil
+}
+
+func (n *notifier) generatorURL(query string) string {
+	if n.externalURL == nil || n.externalURL.Host == "" {
+		return ""
+	}
+	u := *n.externalURL
+	u.Path += "/graph"
+	u.RawQuery = url.Values{"g0.expr": []string{query}, "g0.tab": []string{"1"}}.Encode()
+	return u.String()
+}
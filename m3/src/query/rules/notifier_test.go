@@ -0,0 +1,110 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifierSend(t *testing.T) {
+	var received []alertmanagerAlert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	externalURL, err := url.Parse("http://m3coordinator:7201")
+	require.NoError(t, err)
+	n := newNotifier(AlertmanagerConfiguration{URL: server.URL},
+		labels.FromStrings("cluster", "test", "job", "ignored"), externalURL)
+
+	var (
+		activeAt   = time.Unix(1000, 0).UTC()
+		validUntil = time.Unix(2000, 0).UTC()
+		resolvedAt = time.Unix(3000, 0).UTC()
+	)
+	err = n.send(context.Background(), "up == 0", []Alert{
+		{
+			State:       StateFiring,
+			Labels:      labels.FromStrings("alertname", "InstanceDown", "job", "api"),
+			Annotations: labels.FromStrings("summary", "api down"),
+			ActiveAt:    activeAt,
+			ValidUntil:  validUntil,
+		},
+		{
+			State:      StateInactive,
+			Labels:     labels.FromStrings("alertname", "InstanceDown", "job", "web"),
+			ActiveAt:   activeAt,
+			ResolvedAt: resolvedAt,
+		},
+	})
+	require.NoError(t, err)
+
+	generatorURL := "http://m3coordinator:7201/graph?g0.expr=up+%3D%3D+0&g0.tab=1"
+	assert.Equal(t, []alertmanagerAlert{
+		{
+			Labels: map[string]string{
+				"alertname": "InstanceDown",
+				"cluster":   "test",
+				"job":       "api",
+			},
+			Annotations:  map[string]string{"summary": "api down"},
+			StartsAt:     activeAt,
+			EndsAt:       validUntil,
+			GeneratorURL: generatorURL,
+		},
+		{
+			Labels: map[string]string{
+				"alertname": "InstanceDown",
+				"cluster":   "test",
+				"job":       "web",
+			},
+			Annotations:  map[string]string{},
+			StartsAt:     activeAt,
+			EndsAt:       resolvedAt,
+			GeneratorURL: generatorURL,
+		},
+	}, received)
+}
+
+func TestNotifierSendError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	n := newNotifier(AlertmanagerConfiguration{URL: server.URL}, nil, &url.URL{})
+	err := n.send(context.Background(), "up", []Alert{
+		{State: StateFiring, Labels: labels.FromStrings("alertname", "Up")},
+	})
+	require.Error(t, err)
+}
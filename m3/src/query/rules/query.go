@@ -0,0 +1,154 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	prompromql "github.com/prometheus/prometheus/promql"
+)
+
+// instantQueryStep is the step of the instant queries rules are evaluated
+// with, it matches the step of the instant query endpoint.
+const instantQueryStep = time.Second
+
+// NewEngineQueryFn returns a function evaluating instant queries with the
+// given engine, samples without a value at the query time are omitted.
+func NewEngineQueryFn(
+	engine executor.Engine,
+	tagOpts models.TagOptions,
+	timeout time.Duration,
+) QueryFn {
+	return func(
+		ctx context.Context,
+		query string,
+		ts time.Time,
+	) (prompromql.Vector, error) {
+		engineOpts := engine.Options()
+		parser, err := promql.Parse(query, instantQueryStep, tagOpts,
+			engineOpts.ParseOptions())
+		if err != nil {
+			return nil, err
+		}
+
+		fetchOpts := storage.NewFetchOptions()
+		fetchOpts.Timeout = timeout
+		params := models.RequestParams{
+			Start:            ts,
+			End:              ts,
+			Now:              ts,
+			Timeout:          timeout,
+			Step:             instantQueryStep,
+			Query:            query,
+			IncludeEnd:       true,
+			BlockType:        models.TypeSingleBlock,
+			FormatType:       models.FormatPromQL,
+			LookbackDuration: engineOpts.LookbackDuration(),
+		}
+
+		bl, err := engine.ExecuteExpr(ctx, parser, &executor.QueryOptions{},
+			fetchOpts, params)
+		if err != nil {
+			return nil, err
+		}
+
+		vector, err := blockToVector(bl, ts)
+		if closeErr := bl.Close(); err == nil {
+			err = closeErr
+		}
+		return vector, err
+	}
+}
+
+func blockToVector(bl block.Block, ts time.Time) (prompromql.Vector, error) {
+	it, err := bl.StepIter()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		seriesMeta = it.SeriesMeta()
+		blockTags  = bl.Meta().Tags.Tags
+		values     = make([]float64, len(seriesMeta))
+	)
+	for i := range values {
+		values[i] = math.NaN()
+	}
+	for it.Next() {
+		for i, v := range it.Current().Values() {
+			if !math.IsNaN(v) {
+				values[i] = v
+			}
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	vector := make(prompromql.Vector, 0, len(seriesMeta))
+	for i, meta := range seriesMeta {
+		if math.IsNaN(values[i]) {
+			continue
+		}
+		tags := meta.Tags.AddTags(blockTags)
+		vector = append(vector, prompromql.Sample{
+			Point:  prompromql.Point{T: timestamp(ts), V: values[i]},
+			Metric: tagsToLabels(tags),
+		})
+	}
+	return vector, nil
+}
+
+func tagsToLabels(tags models.Tags) labels.Labels {
+	result := make(labels.Labels, 0, tags.Len())
+	for _, t := range tags.Tags {
+		result = append(result, labels.Label{
+			Name:  string(t.Name),
+			Value: string(t.Value),
+		})
+	}
+	return labels.New(result...)
+}
+
+func labelsToTags(lset labels.Labels, opts models.TagOptions) models.Tags {
+	tags := models.NewTags(len(lset), opts)
+	for _, l := range lset {
+		tags = tags.AddTagWithoutNormalizing(models.Tag{
+			Name:  []byte(l.Name),
+			Value: []byte(l.Value),
+		})
+	}
+	return tags.Normalize()
+}
+
+// timestamp returns the Prometheus timestamp, in milliseconds, of a time.
+func timestamp(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
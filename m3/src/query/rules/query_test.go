@@ -0,0 +1,80 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package rules
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/test"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngineQueryFn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Unix(1000, 0)
+	bounds := models.Bounds{
+		Start:    now,
+		Duration: instantQueryStep,
+		StepSize: instantQueryStep,
+	}
+	bl := test.NewBlockFromValuesWithSeriesMeta(bounds, []block.SeriesMeta{
+		test.MustMakeSeriesMeta("__name__", "up", "job", "api"),
+		test.MustMakeSeriesMeta("__name__", "up", "job", "web"),
+	}, [][]float64{{1}, {math.NaN()}})
+
+	engine := executor.NewMockEngine(ctrl)
+	engine.EXPECT().Options().Return(executor.NewEngineOptions()).AnyTimes()
+	engine.EXPECT().
+		ExecuteExpr(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ interface{},
+			_ *executor.QueryOptions,
+			_ interface{},
+			params models.RequestParams,
+		) (block.Block, error) {
+			assert.Equal(t, "up", params.Query)
+			assert.Equal(t, now, params.Start)
+			assert.Equal(t, now, params.End)
+			assert.True(t, params.IncludeEnd)
+			return bl, nil
+		})
+
+	queryFn := NewEngineQueryFn(engine, models.NewTagOptions(), time.Minute)
+	vector, err := queryFn(context.Background(), "up", now)
+	require.NoError(t, err)
+	require.Len(t, vector, 1)
+	assert.Equal(t, labels.FromStrings("__name__", "up", "job", "api"),
+		vector[0].Metric)
+	assert.Equal(t, float64(1), vector[0].V)
+	assert.Equal(t, timestamp(now), vector[0].T)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"time"
+
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// recordingRule evaluates an expression and writes its result back to
+// storage as a new series.
+type recordingRule struct {
+	ruleEvaluation
+
+	name   string
+	query  string
+	labels labels.Labels
+	opts   *ruleOptions
+}
+
+func newRecordingRule(cfg RuleConfiguration, opts *ruleOptions) *recordingRule {
+	return &recordingRule{
+		name:   cfg.Record,
+		query:  cfg.Expr,
+		labels: labels.FromMap(cfg.Labels),
+		opts:   opts,
+	}
+}
+
+func (r *recordingRule) eval(ctx context.Context, evalTime time.Time) error {
+	vector, err := r.opts.queryFn(ctx, r.query, evalTime)
+	if err != nil {
+		return err
+	}
+
+	var (
+		series = make([]labels.Labels, 0, len(vector))
+		seen   = make(map[uint64]struct{}, len(vector))
+	)
+	for _, sample := range vector {
+		b := labels.NewBuilder(sample.Metric).Set(labels.MetricName, r.name)
+		for _, l := range r.labels {
+			if l.Value == "" {
+				b.Del(l.Name)
+			} else {
+				b.Set(l.Name, l.Value)
+			}
+		}
+
+		lset := b.Labels()
+		hash := lset.Hash()
+		if _, ok := seen[hash]; ok {
+			return errDuplicateLabelSet
+		}
+		seen[hash] = struct{}{}
+		series = append(series, lset)
+	}
+
+	var multiErr xerrors.MultiError
+	for i, lset := range series {
+		query, err := storage.NewWriteQuery(storage.WriteQueryOptions{
+			Tags: labelsToTags(lset, r.opts.tagOptions),
+			Datapoints: ts.Datapoints{
+				{Timestamp: evalTime, Value: vector[i].V},
+			},
+			Unit: xtime.Millisecond,
+			Attributes: storagemetadata.Attributes{
+				MetricsType: storagemetadata.UnaggregatedMetricsType,
+			},
+		})
+		if err == nil {
+			err = r.opts.storage.Write(ctx, query)
+		}
+		if err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
+func (r *recordingRule) status() RuleStatus {
+	s := RuleStatus{
+		Type:   RuleTypeRecording,
+		Name:   r.name,
+		Query:  r.query,
+		Labels: r.labels,
+	}
+
+	r.RLock()
+	r.fillStatus(&s)
+	r.RUnlock()
+	return s
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package rules
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingRuleWrites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := &testQueryFn{results: map[string]promql.Vector{
+		"sum by (job) (rate(http_requests_total[5m]))": {
+			newTestSample(1.5, "job", "api"),
+			newTestSample(2.5, "job", "web", "env", "prod"),
+		},
+	}}
+
+	var written []*storage.WriteQuery
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().Write(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, q *storage.WriteQuery) error {
+			written = append(written, q)
+			return nil
+		}).Times(2)
+
+	opts := newTestRuleOptions(query.query)
+	opts.storage = store
+	opts.tagOptions = models.NewTagOptions()
+	r := newRecordingRule(RuleConfiguration{
+		Record: "job:http_requests:rate5m",
+		Expr:   "sum by (job) (rate(http_requests_total[5m]))",
+		Labels: map[string]string{"source": "rules", "env": ""},
+	}, opts)
+
+	now := time.Unix(1000, 0)
+	require.NoError(t, r.eval(context.Background(), now))
+	require.Len(t, written, 2)
+
+	expected := []struct {
+		tags  models.Tags
+		value float64
+	}{
+		{
+			tags: models.MustMakeTags("__name__", "job:http_requests:rate5m",
+				"job", "api", "source", "rules"),
+			value: 1.5,
+		},
+		{
+			tags: models.MustMakeTags("__name__", "job:http_requests:rate5m",
+				"job", "web", "source", "rules"),
+			value: 2.5,
+		},
+	}
+	for i, q := range written {
+		assert.Equal(t, expected[i].tags.Tags, q.Tags().Tags)
+		require.Len(t, q.Datapoints(), 1)
+		assert.Equal(t, now, q.Datapoints()[0].Timestamp)
+		assert.Equal(t, expected[i].value, q.Datapoints()[0].Value)
+		assert.Equal(t, storagemetadata.UnaggregatedMetricsType,
+			q.Attributes().MetricsType)
+	}
+}
+
+func TestRecordingRuleWriteError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query := &testQueryFn{results: map[string]promql.Vector{
+		"up": {newTestSample(1, "__name__", "up", "job", "api")},
+	}}
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().Write(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	opts := newTestRuleOptions(query.query)
+	opts.storage = store
+	opts.tagOptions = models.NewTagOptions()
+	r := newRecordingRule(RuleConfiguration{Record: "up:copy", Expr: "up"}, opts)
+
+	err := r.eval(context.Background(), time.Now())
+	require.Error(t, err)
+
+	r.setEvaluation(time.Now(), time.Millisecond, err)
+	status := r.status()
+	assert.Equal(t, RuleHealthBad, status.Health)
+	assert.Equal(t, "boom", status.LastError)
+}
+
+func TestRecordingRuleDuplicateLabelSet(t *testing.T) {
+	query := &testQueryFn{results: map[string]promql.Vector{
+		"up": {
+			newTestSample(1, "__name__", "up", "job", "api"),
+			newTestSample(1, "__name__", "up_other", "job", "api"),
+		},
+	}}
+
+	r := newRecordingRule(RuleConfiguration{Record: "up:copy", Expr: "up"},
+		newTestRuleOptions(query.query))
+	err := r.eval(context.Background(), time.Now())
+	require.Equal(t, errDuplicateLabelSet, err)
+}
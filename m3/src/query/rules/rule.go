@@ -0,0 +1,98 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rules
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+var errDuplicateLabelSet = errors.New("vector contains metrics with the " +
+	"same labelset after applying rule labels")
+
+// rule is a recording or alerting rule.
+type rule interface {
+	// eval evaluates the rule at the given time.
+	eval(ctx context.Context, ts time.Time) error
+
+	// status returns the status of the rule.
+	status() RuleStatus
+
+	// setEvaluation records the outcome of the last evaluation of the rule.
+	setEvaluation(ts time.Time, evaluationTime time.Duration, err error)
+}
+
+// ruleOptions are the dependencies shared by the rules of a manager.
+type ruleOptions struct {
+	queryFn        QueryFn
+	storage        storage.Storage
+	tagOptions     models.TagOptions
+	externalLabels labels.Labels
+	externalURL    *url.URL
+}
+
+// ruleEvaluation tracks the outcome of the last evaluation of a rule.
+type ruleEvaluation struct {
+	sync.RWMutex
+
+	health         RuleHealth
+	lastError      error
+	lastEvaluation time.Time
+	evaluationTime time.Duration
+}
+
+func (e *ruleEvaluation) setEvaluation(
+	ts time.Time,
+	evaluationTime time.Duration,
+	err error,
+) {
+	e.Lock()
+	defer e.Unlock()
+	e.health = RuleHealthGood
+	if err != nil {
+		e.health = RuleHealthBad
+	}
+	e.lastError = err
+	e.lastEvaluation = ts
+	e.evaluationTime = evaluationTime
+}
+
+// fillStatus fills the evaluation fields of a rule status, the caller must
+// hold the read lock.
+func (e *ruleEvaluation) fillStatus(s *RuleStatus) {
+	s.Health = e.health
+	if s.Health == "" {
+		s.Health = RuleHealthUnknown
+	}
+	if e.lastError != nil {
+		s.LastError = e.lastError.Error()
+	}
+	s.LastEvaluation = e.lastEvaluation
+	s.EvaluationTime = e.evaluationTime
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package rules evaluates Prometheus recording and alerting rules with the
+// query engine, writing recording rule results back to storage and sending
+// alerts to an Alertmanager compatible webhook.
+package rules
+
+import (
+	"context"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+)
+
+// Manager loads rule groups and evaluates them on schedule.
+type Manager interface {
+	// Start loads the rule groups and starts evaluating them.
+	Start() error
+
+	// Groups returns the status of the rule groups.
+	Groups() []GroupStatus
+
+	// Alerts returns the pending and firing alerts.
+	Alerts() []Alert
+
+	// Close stops evaluating the rule groups.
+	Close() error
+}
+
+// QueryFn evaluates an instant query at the given time.
+type QueryFn func(ctx context.Context, query string, ts time.Time) (promql.Vector, error)
+
+// KVStoreFn returns the KV store to load rule groups from.
+type KVStoreFn func() (kv.Store, error)
+
+// LeaderServiceFn returns the leader service to hold the election of the
+// coordinator evaluating the rule groups with.
+type LeaderServiceFn func(
+	sid services.ServiceID,
+	opts services.ElectionOptions,
+) (services.LeaderService, error)
+
+// ManagerOptions are the options of a rule manager.
+type ManagerOptions struct {
+	// Configuration is the rules configuration.
+	Configuration Configuration
+	// Engine is the engine rules are evaluated with.
+	Engine executor.Engine
+	// QueryFn evaluates the rule queries, if set it is used instead of the
+	// engine.
+	QueryFn QueryFn
+	// Storage is the storage recording rule results are written to.
+	Storage storage.Storage
+	// TagOptions are the tag options of the written series.
+	TagOptions models.TagOptions
+	// KVStoreFn returns the KV store, required if a KV key is configured.
+	KVStoreFn KVStoreFn
+	// LeaderServiceFn returns the leader service, required if an election is
+	// configured.
+	LeaderServiceFn LeaderServiceFn
+	// ClockOptions are the clock options.
+	ClockOptions clock.Options
+	// InstrumentOptions are the instrument options.
+	InstrumentOptions instrument.Options
+}
+
+// RuleType is the type of a rule.
+type RuleType string
+
+const (
+	// RuleTypeRecording is the type of recording rules.
+	RuleTypeRecording RuleType = "recording"
+	// RuleTypeAlerting is the type of alerting rules.
+	RuleTypeAlerting RuleType = "alerting"
+)
+
+// RuleHealth is the health of a rule as of its last evaluation.
+type RuleHealth string
+
+const (
+	// RuleHealthUnknown is the health of rules not evaluated yet.
+	RuleHealthUnknown RuleHealth = "unknown"
+	// RuleHealthGood is the health of rules successfully evaluated.
+	RuleHealthGood RuleHealth = "ok"
+	// RuleHealthBad is the health of rules which failed to evaluate.
+	RuleHealthBad RuleHealth = "err"
+)
+
+// AlertState is the state of an alert.
+type AlertState int
+
+const (
+	// StateInactive is the state of resolved alerts.
+	StateInactive AlertState = iota
+	// StatePending is the state of alerts active for less than the for
+	// duration of their rule.
+	StatePending
+	// StateFiring is the state of alerts active for at least the for
+	// duration of their rule.
+	StateFiring
+)
+
+func (s AlertState) String() string {
+	switch s {
+	case StatePending:
+		return "pending"
+	case StateFiring:
+		return "firing"
+	default:
+		return "inactive"
+	}
+}
+
+// Alert is an alert produced by an alerting rule.
+type Alert struct {
+	State       AlertState
+	Labels      labels.Labels
+	Annotations labels.Labels
+	Value       float64
+	ActiveAt    time.Time
+	FiredAt     time.Time
+	ResolvedAt  time.Time
+	LastSentAt  time.Time
+	ValidUntil  time.Time
+}
+
+// GroupStatus is the status of a rule group.
+type GroupStatus struct {
+	Name           string
+	File           string
+	Interval       time.Duration
+	Rules          []RuleStatus
+	LastEvaluation time.Time
+	EvaluationTime time.Duration
+}
+
+// RuleStatus is the status of a rule.
+type RuleStatus struct {
+	Type           RuleType
+	Name           string
+	Query          string
+	Duration       time.Duration
+	Labels         labels.Labels
+	Annotations    labels.Labels
+	State          AlertState
+	Alerts         []Alert
+	Health         RuleHealth
+	LastError      string
+	LastEvaluation time.Time
+	EvaluationTime time.Duration
+}
@@ -33,6 +33,7 @@ import (
 
 	clusterclient "github.com/m3db/m3/src/cluster/client"
 	etcdclient "github.com/m3db/m3/src/cluster/client/etcd"
+	"github.com/m3db/m3/src/cluster/services"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/downsample"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
 	ingestcarbon "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/carbon"
@@ -51,6 +52,7 @@ import (
 	"github.com/m3db/m3/src/query/policy/filter"
 	"github.com/m3db/m3/src/query/pools"
 	tsdbRemote "github.com/m3db/m3/src/query/remote"
+	"github.com/m3db/m3/src/query/rules"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/fanout"
 	"github.com/m3db/m3/src/query/storage/m3"
@@ -58,6 +60,7 @@ import (
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	"github.com/m3db/m3/src/query/storage/remote"
 	"github.com/m3db/m3/src/query/stores/m3db"
+	"github.com/m3db/m3/src/query/tenant"
 	tsdb "github.com/m3db/m3/src/query/ts/m3db"
 	"github.com/m3db/m3/src/query/ts/m3db/consolidators"
 	"github.com/m3db/m3/src/x/clock"
@@ -69,6 +72,7 @@ import (
 	"github.com/m3db/m3/src/x/serialize"
 	xserver "github.com/m3db/m3/src/x/server"
 	xsync "github.com/m3db/m3/src/x/sync"
+	xtcp "github.com/m3db/m3/src/x/tcp"
 	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/go-kit/kit/log"
@@ -446,9 +450,24 @@ func Run(runOpts RunOptions) {
 		}
 	}
 
+	var tenants *tenant.Tenants
+	if cfg.Tenants != nil {
+		tenants, err = cfg.Tenants.NewTenants(chainedEnforcer, time.Now,
+			instrumentOptions)
+		if err != nil {
+			logger.Fatal("unable to create tenants", zap.Error(err))
+		}
+	}
+
+	handlerDownsamplerAndWriter := downsamplerAndWriter
+	if tenants != nil {
+		handlerDownsamplerAndWriter = tenant.NewDownsamplerAndWriter(
+			downsamplerAndWriter)
+	}
+
 	prometheusEngine := newPromQLEngine(cfg.Query, prometheusEngineRegistry,
 		instrumentOptions)
-	handlerOptions, err := options.NewHandlerOptions(downsamplerAndWriter,
+	handlerOptions, err := options.NewHandlerOptions(handlerDownsamplerAndWriter,
 		tagOptions, engine, prometheusEngine, m3dbClusters, clusterClient, cfg,
 		runOpts.DBConfig, chainedEnforcer, fetchOptsBuilder, queryCtxOpts,
 		instrumentOptions, cpuProfileDuration, []string{handleroptions.M3DBServiceName},
@@ -456,6 +475,31 @@ func Run(runOpts RunOptions) {
 	if err != nil {
 		logger.Fatal("unable to set up handler options", zap.Error(err))
 	}
+	if store := handlerOptions.MetricMetadataStore(); store != nil {
+		defer func() {
+			if err := store.Close(); err != nil {
+				logger.Warn("unable to flush metric metadata", zap.Error(err))
+			}
+		}()
+	}
+
+	if cfg.Rules != nil {
+		rulesManager, err := newRulesManager(*cfg.Rules, engine, backendStorage,
+			tagOptions, clusterClient, instrumentOptions)
+		if err != nil {
+			logger.Fatal("unable to create rules manager", zap.Error(err))
+		}
+		if err := rulesManager.Start(); err != nil {
+			logger.Fatal("unable to start rules manager", zap.Error(err))
+		}
+		defer rulesManager.Close()
+
+		handlerOptions = handlerOptions.SetRulesManager(rulesManager)
+	}
+
+	if tenants != nil {
+		handlerOptions = handlerOptions.SetTenants(tenants)
+	}
 
 	if fn := runOpts.CustomHandlerOptions.OptionTransformFn; fn != nil {
 		handlerOptions = fn(handlerOptions)
@@ -486,6 +530,13 @@ func Run(runOpts RunOptions) {
 			zap.String("address", listenAddress),
 			zap.Error(err))
 	}
+	if cfg.TLS != nil {
+		tlsConfig, err := cfg.TLS.NewServerConfig(instrumentOptions)
+		if err != nil {
+			logger.Fatal("unable to create TLS config", zap.Error(err))
+		}
+		listener = xtcp.NewTLSListener(listener, tlsConfig)
+	}
 	if runOpts.ListenerCh != nil {
 		runOpts.ListenerCh <- listener
 	}
@@ -547,6 +598,37 @@ func Run(runOpts RunOptions) {
 	})
 }
 
+func newRulesManager(
+	cfg rules.Configuration,
+	engine executor.Engine,
+	backendStorage storage.Storage,
+	tagOptions models.TagOptions,
+	clusterClient clusterclient.Client,
+	instrumentOptions instrument.Options,
+) (rules.Manager, error) {
+	opts := rules.ManagerOptions{
+		Configuration:     cfg,
+		Engine:            engine,
+		Storage:           backendStorage,
+		TagOptions:        tagOptions,
+		InstrumentOptions: instrumentOptions,
+	}
+	if clusterClient != nil {
+		opts.KVStoreFn = clusterClient.KV
+		opts.LeaderServiceFn = func(
+			sid services.ServiceID,
+			electionOpts services.ElectionOptions,
+		) (services.LeaderService, error) {
+			svcs, err := clusterClient.Services(nil)
+			if err != nil {
+				return nil, err
+			}
+			return svcs.LeaderService(sid, electionOpts)
+		}
+	}
+	return rules.NewManager(opts)
+}
+
 // make connections to the m3db cluster(s) and generate sessions for those clusters along with the storage
 func newM3DBStorage(
 	cfg config.Configuration,
@@ -25,7 +25,9 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/m3db/m3/src/query/analyze"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/errors"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
@@ -161,7 +163,7 @@ func (s *fanoutStorage) FetchBlocks(
 	stores := filterStores(s.stores, s.fetchFilter, query)
 	// Optimization for the single store case
 	if len(stores) == 1 {
-		return stores[0].FetchBlocks(ctx, query, options)
+		return fetchBlocks(ctx, stores[0], query, options)
 	}
 
 	var (
@@ -179,7 +181,7 @@ func (s *fanoutStorage) FetchBlocks(
 		store := store
 		go func() {
 			defer wg.Done()
-			result, err := store.FetchBlocks(ctx, query, options)
+			result, err := fetchBlocks(ctx, store, query, options)
 			mu.Lock()
 			defer mu.Unlock()
 
@@ -276,6 +278,26 @@ func (s *fanoutStorage) FetchBlocks(
 	}, nil
 }
 
+// fetchBlocks fetches blocks from the store, recording the fetch in the
+// analyze stats of the query if any.
+func fetchBlocks(
+	ctx context.Context,
+	store storage.Storage,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (block.Result, error) {
+	stats := analyze.FromContext(ctx)
+	if stats == nil {
+		return store.FetchBlocks(ctx, query, options)
+	}
+
+	start := time.Now()
+	ctx = analyze.NewStorageContext(ctx, store.Name())
+	result, err := store.FetchBlocks(ctx, query, options)
+	stats.RecordFetch(store.Name(), len(result.Blocks), time.Since(start), err)
+	return result, err
+}
+
 func (s *fanoutStorage) SearchSeries(
 	ctx context.Context,
 	query *storage.FetchQuery,
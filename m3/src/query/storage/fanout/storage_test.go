@@ -30,6 +30,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/analyze"
 	"github.com/m3db/m3/src/query/block"
 	errs "github.com/m3db/m3/src/query/errors"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
@@ -418,6 +419,53 @@ func TestFanoutFetchBlocksErrorContinues(t *testing.T) {
 	}
 }
 
+func TestFanoutFetchBlocksAnalyze(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	filter := func(_ storage.Query, _ storage.Storage) bool { return true }
+	tFilter := func(_ storage.CompleteTagsQuery, _ storage.Storage) bool { return true }
+	newStore := func(name string, value float64) storage.Storage {
+		store := storage.NewMockStorage(ctrl)
+		store.EXPECT().FetchBlocks(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				ctx context.Context,
+				_ *storage.FetchQuery,
+				_ *storage.FetchOptions,
+			) (block.Result, error) {
+				// Stores record the series they fetch against their name.
+				analyze.FromContext(ctx).AddSeriesFetched(
+					analyze.StorageFromContext(ctx, ""), 1)
+				return block.Result{
+					Blocks: []block.Block{block.NewScalar(value, block.Metadata{
+						Tags: models.EmptyTags(),
+					})},
+				}, nil
+			})
+		store.EXPECT().Name().Return(name).AnyTimes()
+		return store
+	}
+
+	stores := []storage.Storage{newStore("a", 1), newStore("b", 2)}
+	store := NewStorage(stores, filter, filter, tFilter,
+		models.NewTagOptions(), instrument.NewOptions())
+
+	stats := analyze.NewStats()
+	ctx := analyze.NewContext(context.TODO(), stats)
+	_, err := store.FetchBlocks(ctx, &storage.FetchQuery{},
+		storage.NewFetchOptions())
+	require.NoError(t, err)
+
+	storages := stats.Report().Storages
+	require.Len(t, storages, 2)
+	for i, name := range []string{"a", "b"} {
+		assert.Equal(t, name, storages[i].Name)
+		assert.Equal(t, 1, storages[i].Fetches)
+		assert.Equal(t, 1, storages[i].Blocks)
+		assert.Equal(t, 1, storages[i].Series)
+	}
+}
+
 func TestFanoutFetchErrorContinues(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
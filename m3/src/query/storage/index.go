@@ -23,6 +23,7 @@ package storage
 import (
 	"bytes"
 	"fmt"
+	"regexp/syntax"
 
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/m3ninx/idx"
@@ -155,6 +156,8 @@ func matcherToQuery(matcher models.Matcher) (idx.Query, error) {
 		)
 		if bytes.Equal(dotStar, matcher.Value) {
 			query = idx.NewFieldQuery(matcher.Name)
+		} else if prefix, ok := literalPrefix(matcher.Value); ok {
+			query = idx.NewPrefixQuery(matcher.Name, prefix)
 		} else {
 			query, err = idx.NewRegexpQuery(matcher.Name, matcher.Value)
 		}
@@ -195,3 +198,46 @@ func matcherToQuery(matcher models.Matcher) (idx.Query, error) {
 		return idx.Query{}, fmt.Errorf("unsupported query type: %v", matcher)
 	}
 }
+
+// literalPrefix returns the literal prefix of the given regexp if the regexp
+// matches exactly the values starting with that prefix, i.e. it takes the form
+// of a literal followed by ".*", in which case it can be evaluated with a
+// prefix query rather than a full regexp against the terms of a field.
+// NB: ".*" does not match newlines, but label values are not expected to
+// contain any so the prefix query is considered equivalent.
+func literalPrefix(re []byte) ([]byte, bool) {
+	parsed, err := syntax.Parse(string(re), syntax.Perl)
+	if err != nil {
+		return nil, false
+	}
+
+	parsed = parsed.Simplify()
+	if parsed.Op != syntax.OpConcat {
+		return nil, false
+	}
+
+	// Regexps are always fully anchored, so explicit anchors can be ignored.
+	subs := parsed.Sub
+	if len(subs) > 0 && subs[0].Op == syntax.OpBeginText {
+		subs = subs[1:]
+	}
+	if len(subs) > 0 && subs[len(subs)-1].Op == syntax.OpEndText {
+		subs = subs[:len(subs)-1]
+	}
+	if len(subs) != 2 {
+		return nil, false
+	}
+
+	literal, star := subs[0], subs[1]
+	if literal.Op != syntax.OpLiteral || literal.Flags&syntax.FoldCase != 0 {
+		return nil, false
+	}
+	if star.Op != syntax.OpStar || len(star.Sub) != 1 {
+		return nil, false
+	}
+	if op := star.Sub[0].Op; op != syntax.OpAnyChar && op != syntax.OpAnyCharNotNL {
+		return nil, false
+	}
+
+	return []byte(string(literal.Rune)), true
+}
@@ -122,6 +122,61 @@ func TestFetchQueryToM3Query(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "regexp match literal prefix -> prefix",
+			expected: "prefix(t1, web-)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("web-.*"),
+				},
+			},
+		},
+		{
+			name:     "regexp match anchored escaped literal prefix -> prefix",
+			expected: "prefix(t1, web.prod)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("^web\\.prod.*$"),
+				},
+			},
+		},
+		{
+			name:     "regexp match non literal prefix",
+			expected: "regexp(t1, web-.+)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("web-.+"),
+				},
+			},
+		},
+		{
+			name:     "regexp match case insensitive prefix",
+			expected: "regexp(t1, (?i)web-.*)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("(?i)web-.*"),
+				},
+			},
+		},
+		{
+			name:     "regexp match literal prefix negated",
+			expected: "negation(prefix(t1, web-))",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchNotRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("web-.*"),
+				},
+			},
+		},
 		{
 			name:     "regexp match negated",
 			expected: "negation(regexp(t1, v1))",
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/analyze"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/errors"
@@ -176,6 +177,12 @@ func (s *m3storage) FetchBlocks(
 		}, err
 	}
 
+	if stats := analyze.FromContext(ctx); stats != nil {
+		stats.AddSeriesFetched(analyze.StorageFromContext(ctx, s.Name()),
+			result.Count())
+		opts = opts.SetAnalyzeStats(stats)
+	}
+
 	return FetchResultToBlockResult(result, query, options, opts)
 }
 
@@ -286,6 +293,7 @@ func (s *m3storage) fetchCompressed(
 		return nil, fmt.Errorf("unable to retrieve iterator pools: %v", err)
 	}
 
+	analyzeStats := analyze.FromContext(ctx)
 	matchOpts := s.opts.SeriesConsolidationMatchOptions()
 	tagOpts := s.opts.TagOptions()
 	result := consolidators.NewMultiFetchResult(fanout, pools, matchOpts, tagOpts)
@@ -311,6 +319,18 @@ func (s *m3storage) fetchCompressed(
 				)
 			}
 
+			if err == nil && analyzeStats != nil {
+				attrs := namespace.Options().Attributes()
+				analyzeStats.RecordNamespaceFetch(analyze.NamespaceFetch{
+					Namespace:   namespaceID.String(),
+					MetricsType: attrs.MetricsType.String(),
+					Retention:   attrs.Retention,
+					Resolution:  attrs.Resolution,
+					FanoutType:  fanout.String(),
+					Series:      iters.Len(),
+				})
+			}
+
 			blockMeta := block.NewResultMetadata()
 			blockMeta.Exhaustive = metadata.Exhaustive
 			// Ignore error from getting iterator pools, since operation
@@ -0,0 +1,541 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package metadata persists the Prometheus metric metadata (type, help and
+// unit of metric families) received via remote write in KV so that it can be
+// served back by the metadata API.
+package metadata
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/spaolacci/murmur3"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	// keyFormat is the format of the KV keys the metadata is stored under.
+	keyFormat = "_prometheus/metric_metadata/%d"
+
+	// scopedKeyFormat is the format of the KV keys the metadata of a scope
+	// other than the default one is stored under.
+	scopedKeyFormat = "_prometheus/metric_metadata/%s/%d"
+
+	// numShards is the number of KV keys the metadata is spread across to
+	// keep each value well below the KV value size limits, changing it
+	// orphans any previously persisted metadata.
+	numShards = 64
+
+	// maxEntriesPerMetric is the maximum number of distinct metadata kept for
+	// a single metric family, the oldest metadata is evicted first.
+	maxEntriesPerMetric = 10
+
+	// maxUpdateAttempts is the maximum number of attempts to update a shard
+	// when racing with concurrent writers.
+	maxUpdateAttempts = 5
+
+	// flushInterval is the interval at which the queued metadata is
+	// persisted, each flush updates every shard at most once.
+	flushInterval = 10 * time.Second
+
+	// maxPending is the maximum number of metadata queued to be persisted,
+	// metadata written once the queue is full is dropped.
+	maxPending = 100000
+
+	// persistedTTL is the duration for which metadata known to be persisted
+	// is not rewritten, after which it is merged into KV again in case it was
+	// evicted by other writers.
+	persistedTTL = 10 * time.Minute
+
+	// maxPersisted is the maximum number of metadata tracked as persisted,
+	// the expired metadata is removed once it is reached and the tracked
+	// metadata is reset if none expired.
+	maxPersisted = 100000
+)
+
+var (
+	errStoreClosed    = errors.New("metric metadata store is closed")
+	errTooManyPending = errors.New("too many metric metadata pending to be persisted")
+)
+
+// Store persists and retrieves Prometheus metric metadata. Metadata is
+// persisted per scope, such as a tenant, metadata written to a scope is only
+// read back from the same scope. The empty scope is the default scope.
+type Store interface {
+	// Write queues the given metric metadata of the scope to be persisted by
+	// the next flush, metadata that is already persisted or queued is skipped.
+	Write(scope string, metadata []prompb.MetricMetadata) error
+
+	// Read returns the persisted metric metadata of the scope keyed by metric
+	// family name, restricted to the given metric if it is not empty. At most
+	// limit metric families are returned, a non-positive limit returns all
+	// of them.
+	Read(
+		scope string,
+		metric string,
+		limit int,
+	) (map[string][]prompb.MetricMetadata, error)
+
+	// Flush persists the queued metric metadata.
+	Flush() error
+
+	// Close flushes the queued metric metadata and stops the periodic flushes.
+	Close() error
+}
+
+// KVStoreFn returns the KV store to persist metadata in.
+type KVStoreFn func() (kv.Store, error)
+
+type storeMetrics struct {
+	persisted tally.Counter
+	skipped   tally.Counter
+	dropped   tally.Counter
+	errors    tally.Counter
+	flushes   tally.Counter
+}
+
+func newStoreMetrics(scope tally.Scope) storeMetrics {
+	return storeMetrics{
+		persisted: scope.Counter("persisted"),
+		skipped:   scope.Counter("skipped"),
+		dropped:   scope.Counter("dropped"),
+		errors:    scope.Counter("errors"),
+		flushes:   scope.Counter("flushes"),
+	}
+}
+
+// scopedMetadata is metric metadata of a scope.
+type scopedMetadata struct {
+	scope    string
+	metadata prompb.MetricMetadata
+}
+
+// shardID identifies a shard of a scope.
+type shardID struct {
+	scope string
+	shard uint32
+}
+
+type store struct {
+	sync.RWMutex
+
+	kvStoreFn KVStoreFn
+	nowFn     func() time.Time
+	// persisted tracks the metadata known to be persisted until the time it
+	// expires so that the metadata that is periodically resent by Prometheus
+	// is not rewritten.
+	persisted map[scopedMetadata]time.Time
+	// pending is the metadata queued to be persisted by the next flush in
+	// the order it was written, queued tracks the same metadata for lookups.
+	pending []scopedMetadata
+	queued  map[scopedMetadata]struct{}
+	closed  bool
+
+	flushLock sync.Mutex
+	closeCh   chan struct{}
+	doneCh    chan struct{}
+	logger    *zap.Logger
+	metrics   storeMetrics
+}
+
+// NewStore returns a new metadata store persisting to the KV store returned
+// by the given function, which is resolved lazily on each access. Written
+// metadata is persisted asynchronously by periodic flushes so that writes
+// never wait on KV.
+func NewStore(kvStoreFn KVStoreFn, instrumentOpts instrument.Options) Store {
+	scope := instrumentOpts.MetricsScope().SubScope("metric-metadata")
+	s := &store{
+		kvStoreFn: kvStoreFn,
+		nowFn:     time.Now,
+		persisted: make(map[scopedMetadata]time.Time),
+		queued:    make(map[scopedMetadata]struct{}),
+		closeCh:   make(chan struct{}),
+		doneCh:    make(chan struct{}),
+		logger:    instrumentOpts.Logger(),
+		metrics:   newStoreMetrics(scope),
+	}
+	go s.flushLoop(flushInterval)
+	return s
+}
+
+func (s *store) Write(scope string, metadata []prompb.MetricMetadata) error {
+	var numSkipped, numDropped int
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		return errStoreClosed
+	}
+	now := s.nowFn()
+	for _, entry := range metadata {
+		if entry.MetricFamilyName == "" {
+			numSkipped++
+			continue
+		}
+		m := scopedMetadata{scope: scope, metadata: entry}
+		if expiry, ok := s.persisted[m]; ok && now.Before(expiry) {
+			numSkipped++
+			continue
+		}
+		if _, ok := s.queued[m]; ok {
+			numSkipped++
+			continue
+		}
+		if !s.enqueueWithLock(m) {
+			numDropped++
+		}
+	}
+	s.Unlock()
+
+	s.metrics.skipped.Inc(int64(numSkipped))
+	if numDropped > 0 {
+		s.metrics.dropped.Inc(int64(numDropped))
+		return fmt.Errorf("dropped %d metric metadata: %v", numDropped, errTooManyPending)
+	}
+	return nil
+}
+
+func (s *store) flushLoop(interval time.Duration) {
+	defer close(s.doneCh)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Flush(); err != nil {
+				s.logger.Warn("metric metadata flush error", zap.Error(err))
+			}
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+func (s *store) Flush() error {
+	s.flushLock.Lock()
+	defer s.flushLock.Unlock()
+
+	s.Lock()
+	pending := s.pending
+	s.pending = nil
+	s.queued = make(map[scopedMetadata]struct{})
+	s.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+	s.metrics.flushes.Inc(1)
+
+	byShard := make(map[shardID][]scopedMetadata)
+	for _, m := range pending {
+		shard := shardID{
+			scope: m.scope,
+			shard: shardForMetric(m.metadata.MetricFamilyName),
+		}
+		byShard[shard] = append(byShard[shard], m)
+	}
+
+	kvStore, err := s.kvStoreFn()
+	if err != nil {
+		s.metrics.errors.Inc(1)
+		for _, entries := range byShard {
+			s.requeue(entries)
+		}
+		return err
+	}
+
+	var multiErr xerrors.MultiError
+	for shard, entries := range byShard {
+		evicted, err := s.updateShard(kvStore, shard, entries)
+		if err != nil {
+			s.metrics.errors.Inc(1)
+			multiErr = multiErr.Add(err)
+			s.requeue(entries)
+			continue
+		}
+
+		s.markPersisted(shard.scope, entries, evicted)
+		s.metrics.persisted.Inc(int64(len(entries)))
+	}
+
+	return multiErr.FinalError()
+}
+
+// markPersisted tracks the metadata persisted to a shard of the scope and
+// stops tracking the metadata the shard evicted so that it is rewritten the
+// next time it is received.
+func (s *store) markPersisted(
+	scope string,
+	persisted []scopedMetadata,
+	evicted []prompb.MetricMetadata,
+) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, m := range evicted {
+		delete(s.persisted, scopedMetadata{scope: scope, metadata: m})
+	}
+
+	now := s.nowFn()
+	if len(s.persisted)+len(persisted) > maxPersisted {
+		for m, expiry := range s.persisted {
+			if !now.Before(expiry) {
+				delete(s.persisted, m)
+			}
+		}
+		if len(s.persisted)+len(persisted) > maxPersisted {
+			s.persisted = make(map[scopedMetadata]time.Time)
+		}
+	}
+
+	expiry := now.Add(persistedTTL)
+	for _, m := range persisted {
+		s.persisted[m] = expiry
+	}
+}
+
+// requeue queues metadata that failed to be persisted for the next flush.
+func (s *store) requeue(metadata []scopedMetadata) {
+	var numDropped int
+	s.Lock()
+	for _, m := range metadata {
+		if _, ok := s.queued[m]; ok {
+			continue
+		}
+		if !s.enqueueWithLock(m) {
+			numDropped++
+		}
+	}
+	s.Unlock()
+	s.metrics.dropped.Inc(int64(numDropped))
+}
+
+func (s *store) enqueueWithLock(m scopedMetadata) bool {
+	if len(s.pending) >= maxPending {
+		return false
+	}
+	s.pending = append(s.pending, m)
+	s.queued[m] = struct{}{}
+	return true
+}
+
+func (s *store) Close() error {
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		return errStoreClosed
+	}
+	s.closed = true
+	s.Unlock()
+
+	close(s.closeCh)
+	<-s.doneCh
+	return s.Flush()
+}
+
+// updateShard merges the entries into the shard, returning the metadata the
+// shard evicted to make room for them.
+func (s *store) updateShard(
+	kvStore kv.Store,
+	shard shardID,
+	entries []scopedMetadata,
+) ([]prompb.MetricMetadata, error) {
+	metadata := make([]prompb.MetricMetadata, 0, len(entries))
+	for _, m := range entries {
+		metadata = append(metadata, m.metadata)
+	}
+
+	key := shardKey(shard.scope, shard.shard)
+	for attempt := 1; ; attempt++ {
+		set, version, err := readShard(kvStore, key)
+		if err != nil {
+			return nil, err
+		}
+
+		updated, evicted, changed := merge(set.Metadata, metadata)
+		if !changed {
+			return nil, nil
+		}
+
+		// NB: a version of zero only succeeds if the key does not exist yet.
+		_, err = kvStore.CheckAndSet(key, version, &prompb.MetricMetadataSet{
+			Metadata: updated,
+		})
+		if err == kv.ErrVersionMismatch && attempt < maxUpdateAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to update metric metadata key %s: %v",
+				key, err)
+		}
+		return evicted, nil
+	}
+}
+
+func (s *store) Read(
+	scope string,
+	metric string,
+	limit int,
+) (map[string][]prompb.MetricMetadata, error) {
+	kvStore, err := s.kvStoreFn()
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata []prompb.MetricMetadata
+	if metric != "" {
+		set, _, err := readShard(kvStore, shardKey(scope, shardForMetric(metric)))
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range set.Metadata {
+			if m.MetricFamilyName == metric {
+				metadata = append(metadata, m)
+			}
+		}
+	} else {
+		for shard := uint32(0); shard < numShards; shard++ {
+			set, _, err := readShard(kvStore, shardKey(scope, shard))
+			if err != nil {
+				return nil, err
+			}
+			metadata = append(metadata, set.Metadata...)
+		}
+	}
+
+	// NB: shards are sorted by metric family name, however the families need
+	// to be sorted across shards for the limit to be deterministic.
+	sort.SliceStable(metadata, func(i, j int) bool {
+		return metadata[i].MetricFamilyName < metadata[j].MetricFamilyName
+	})
+
+	result := make(map[string][]prompb.MetricMetadata)
+	for _, m := range metadata {
+		entries, ok := result[m.MetricFamilyName]
+		if !ok && limit > 0 && len(result) >= limit {
+			break
+		}
+		result[m.MetricFamilyName] = append(entries, m)
+	}
+
+	return result, nil
+}
+
+func readShard(
+	kvStore kv.Store,
+	key string,
+) (prompb.MetricMetadataSet, int, error) {
+	var set prompb.MetricMetadataSet
+	value, err := kvStore.Get(key)
+	if err == kv.ErrNotFound {
+		return set, 0, nil
+	}
+	if err != nil {
+		return set, 0, fmt.Errorf("unable to read metric metadata key %s: %v",
+			key, err)
+	}
+	if err := value.Unmarshal(&set); err != nil {
+		return set, 0, fmt.Errorf("unable to unmarshal metric metadata key %s: %v",
+			key, err)
+	}
+	return set, value.Version(), nil
+}
+
+// merge merges the entries into the existing metadata, which is grouped by
+// metric family name with the most recent metadata of each family first.
+// It returns the merged metadata, the metadata evicted from it and whether
+// it differs from the existing.
+func merge(
+	existing []prompb.MetricMetadata,
+	entries []prompb.MetricMetadata,
+) ([]prompb.MetricMetadata, []prompb.MetricMetadata, bool) {
+	byMetric := make(map[string][]prompb.MetricMetadata, len(existing))
+	for _, m := range existing {
+		byMetric[m.MetricFamilyName] = append(byMetric[m.MetricFamilyName], m)
+	}
+
+	var (
+		evicted []prompb.MetricMetadata
+		changed bool
+	)
+	for _, m := range entries {
+		metricEntries := byMetric[m.MetricFamilyName]
+		if contains(metricEntries, m) {
+			continue
+		}
+
+		updated := make([]prompb.MetricMetadata, 0, len(metricEntries)+1)
+		updated = append(updated, m)
+		updated = append(updated, metricEntries...)
+		if len(updated) > maxEntriesPerMetric {
+			evicted = append(evicted, updated[maxEntriesPerMetric:]...)
+			updated = updated[:maxEntriesPerMetric]
+		}
+		byMetric[m.MetricFamilyName] = updated
+		changed = true
+	}
+
+	if !changed {
+		return existing, nil, false
+	}
+
+	names := make([]string, 0, len(byMetric))
+	for name := range byMetric {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	merged := make([]prompb.MetricMetadata, 0, len(existing)+len(entries))
+	for _, name := range names {
+		merged = append(merged, byMetric[name]...)
+	}
+	return merged, evicted, true
+}
+
+func contains(metadata []prompb.MetricMetadata, m prompb.MetricMetadata) bool {
+	for _, existing := range metadata {
+		if existing == m {
+			return true
+		}
+	}
+	return false
+}
+
+func shardForMetric(metric string) uint32 {
+	return murmur3.Sum32([]byte(metric)) % numShards
+}
+
+func shardKey(scope string, shard uint32) string {
+	if scope == "" {
+		return fmt.Sprintf(keyFormat, shard)
+	}
+	return fmt.Sprintf(scopedKeyFormat, scope, shard)
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metadata
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore() (*store, kv.Store) {
+	kvStore := mem.NewStore()
+	s := NewStore(func() (kv.Store, error) {
+		return kvStore, nil
+	}, instrument.NewOptions())
+	return s.(*store), kvStore
+}
+
+func counter(name, help string) prompb.MetricMetadata {
+	return prompb.MetricMetadata{
+		Type:             prompb.MetricMetadata_COUNTER,
+		MetricFamilyName: name,
+		Help:             help,
+	}
+}
+
+func TestStoreWriteRead(t *testing.T) {
+	s, _ := newTestStore()
+	defer s.Close()
+
+	var metadata []prompb.MetricMetadata
+	for i := 0; i < 100; i++ {
+		metadata = append(metadata, counter(fmt.Sprintf("metric_%02d", i), "help"))
+	}
+	metadata = append(metadata, counter("", "no name"))
+	require.NoError(t, s.Write("", metadata))
+	require.NoError(t, s.Flush())
+
+	result, err := s.Read("", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 100, len(result))
+	for _, m := range metadata[:100] {
+		assert.Equal(t, []prompb.MetricMetadata{m}, result[m.MetricFamilyName])
+	}
+
+	result, err = s.Read("", "metric_42", 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]prompb.MetricMetadata{
+		"metric_42": {counter("metric_42", "help")},
+	}, result)
+
+	result, err = s.Read("", "", 3)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]prompb.MetricMetadata{
+		"metric_00": {counter("metric_00", "help")},
+		"metric_01": {counter("metric_01", "help")},
+		"metric_02": {counter("metric_02", "help")},
+	}, result)
+
+	result, err = s.Read("", "unknown", 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(result))
+}
+
+func TestStoreWriteKeepsDistinctMetadataMostRecentFirst(t *testing.T) {
+	s, _ := newTestStore()
+	defer s.Close()
+
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{counter("foo", "first")}))
+	require.NoError(t, s.Flush())
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{
+		counter("foo", "second"),
+		counter("foo", "first"),
+	}))
+	require.NoError(t, s.Flush())
+
+	result, err := s.Read("", "foo", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []prompb.MetricMetadata{
+		counter("foo", "second"),
+		counter("foo", "first"),
+	}, result["foo"])
+
+	for i := 0; i < 2*maxEntriesPerMetric; i++ {
+		require.NoError(t, s.Write("", []prompb.MetricMetadata{
+			counter("foo", fmt.Sprintf("help %d", i)),
+		}))
+	}
+	require.NoError(t, s.Flush())
+
+	result, err = s.Read("", "foo", 0)
+	require.NoError(t, err)
+	require.Equal(t, maxEntriesPerMetric, len(result["foo"]))
+	assert.Equal(t, counter("foo", fmt.Sprintf("help %d", 2*maxEntriesPerMetric-1)),
+		result["foo"][0])
+}
+
+func TestStoreWriteSkipsPersistedMetadata(t *testing.T) {
+	s, kvStore := newTestStore()
+	defer s.Close()
+
+	m := counter("foo", "help")
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{m}))
+	require.NoError(t, s.Flush())
+
+	key := shardKey("", shardForMetric(m.MetricFamilyName))
+	value, err := kvStore.Get(key)
+	require.NoError(t, err)
+	require.Equal(t, 1, value.Version())
+
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{m}))
+	require.Empty(t, s.pending)
+	require.NoError(t, s.Flush())
+
+	value, err = kvStore.Get(key)
+	require.NoError(t, err)
+	assert.Equal(t, 1, value.Version())
+}
+
+func TestStoreWriteRewritesExpiredPersistedMetadata(t *testing.T) {
+	s, kvStore := newTestStore()
+	defer s.Close()
+
+	now := time.Now()
+	s.nowFn = func() time.Time { return now }
+
+	m := counter("foo", "help")
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{m}))
+	require.NoError(t, s.Flush())
+
+	// Remove the metadata from KV as another writer evicting it would.
+	key := shardKey("", shardForMetric(m.MetricFamilyName))
+	_, err := kvStore.Delete(key)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{m}))
+	require.Empty(t, s.pending)
+
+	now = now.Add(persistedTTL)
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{m}))
+	require.Equal(t, []scopedMetadata{{metadata: m}}, s.pending)
+	require.NoError(t, s.Flush())
+
+	result, err := s.Read("", "foo", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []prompb.MetricMetadata{m}, result["foo"])
+}
+
+func TestStoreWriteRewritesEvictedMetadata(t *testing.T) {
+	s, _ := newTestStore()
+	defer s.Close()
+
+	first := counter("foo", "first")
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{first}))
+	require.NoError(t, s.Flush())
+
+	for i := 0; i < maxEntriesPerMetric; i++ {
+		require.NoError(t, s.Write("", []prompb.MetricMetadata{
+			counter("foo", fmt.Sprintf("help %d", i)),
+		}))
+	}
+	require.NoError(t, s.Flush())
+	require.NotContains(t, s.persisted, scopedMetadata{metadata: first})
+
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{first}))
+	require.Equal(t, []scopedMetadata{{metadata: first}}, s.pending)
+}
+
+func TestStoreWriteMergesConcurrentWriters(t *testing.T) {
+	kvStore := mem.NewStore()
+	kvStoreFn := func() (kv.Store, error) {
+		return kvStore, nil
+	}
+	first := NewStore(kvStoreFn, instrument.NewOptions())
+	defer first.Close()
+	second := NewStore(kvStoreFn, instrument.NewOptions())
+	defer second.Close()
+
+	require.NoError(t, first.Write("", []prompb.MetricMetadata{counter("foo", "first")}))
+	require.NoError(t, second.Write("", []prompb.MetricMetadata{counter("foo", "second")}))
+	require.NoError(t, first.Flush())
+	require.NoError(t, second.Flush())
+
+	result, err := first.Read("", "foo", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []prompb.MetricMetadata{
+		counter("foo", "second"),
+		counter("foo", "first"),
+	}, result["foo"])
+}
+
+func TestStoreWriteSkipsPendingMetadata(t *testing.T) {
+	s, _ := newTestStore()
+	defer s.Close()
+
+	m := counter("foo", "help")
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{m, m}))
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{m}))
+	require.Equal(t, []scopedMetadata{{metadata: m}}, s.pending)
+}
+
+func TestStoreWriteDropsWhenTooManyPending(t *testing.T) {
+	s, _ := newTestStore()
+	defer s.Close()
+
+	metadata := make([]prompb.MetricMetadata, 0, maxPending+1)
+	for i := 0; i <= maxPending; i++ {
+		metadata = append(metadata, counter(fmt.Sprintf("metric_%d", i), "help"))
+	}
+	require.Error(t, s.Write("", metadata))
+	require.Equal(t, maxPending, len(s.pending))
+}
+
+func TestStoreCloseFlushes(t *testing.T) {
+	s, kvStore := newTestStore()
+
+	m := counter("foo", "help")
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{m}))
+	require.NoError(t, s.Close())
+
+	_, err := kvStore.Get(shardKey("", shardForMetric(m.MetricFamilyName)))
+	require.NoError(t, err)
+	require.Error(t, s.Write("", []prompb.MetricMetadata{m}))
+}
+
+func TestStoreKVStoreError(t *testing.T) {
+	var kvErr error = errors.New("kv unavailable")
+	kvStore := mem.NewStore()
+	s := NewStore(func() (kv.Store, error) {
+		if kvErr != nil {
+			return nil, kvErr
+		}
+		return kvStore, nil
+	}, instrument.NewOptions())
+	defer s.Close()
+
+	// Writes only queue metadata and never fail because of the KV store.
+	m := counter("foo", "help")
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{m}))
+	require.Error(t, s.Flush())
+	_, err := s.Read("", "", 0)
+	require.Error(t, err)
+
+	// Metadata that failed to be persisted is retried by the next flush.
+	kvErr = nil
+	require.NoError(t, s.Flush())
+	result, err := s.Read("", "foo", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []prompb.MetricMetadata{m}, result["foo"])
+}
+
+func TestStoreScopesAreIsolated(t *testing.T) {
+	s, kvStore := newTestStore()
+	defer s.Close()
+
+	require.NoError(t, s.Write("a", []prompb.MetricMetadata{counter("foo", "a")}))
+	require.NoError(t, s.Write("b", []prompb.MetricMetadata{counter("foo", "b")}))
+	require.NoError(t, s.Write("", []prompb.MetricMetadata{counter("bar", "default")}))
+	require.NoError(t, s.Flush())
+
+	for _, scope := range []string{"a", "b"} {
+		result, err := s.Read(scope, "", 0)
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]prompb.MetricMetadata{
+			"foo": {counter("foo", scope)},
+		}, result)
+	}
+
+	result, err := s.Read("", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]prompb.MetricMetadata{
+		"bar": {counter("bar", "default")},
+	}, result)
+
+	_, err = kvStore.Get(shardKey("a", shardForMetric("foo")))
+	require.NoError(t, err)
+	_, err = kvStore.Get(shardKey("", shardForMetric("foo")))
+	require.Equal(t, kv.ErrNotFound, err)
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tenant
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/m3db/m3/src/aggregator/rate"
+	qcost "github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/cost"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+var (
+	errNoTenants = errors.New("no tenants configured")
+	errNoName    = errors.New("tenant name must not be empty")
+)
+
+// Configuration configures the tenants of the coordinator.
+type Configuration struct {
+	// Source is where the tenant of a request is taken from, either "header"
+	// (the default) or "certificate" for the common name of the subject of
+	// the verified client certificate.
+	Source Source `yaml:"source"`
+
+	// Header is the header the tenant of a request is taken from when the
+	// source is "header", defaults to M3-Tenant. Any client can set the
+	// header, it should only be used behind a proxy authenticating clients.
+	Header string `yaml:"header"`
+
+	// Required rejects requests that do not specify a tenant, defaults to
+	// true. Requests that do not specify a tenant are neither restricted nor
+	// limited if set to false, so any client can bypass the restrictions of
+	// its tenant, it should only be set to false if all clients are trusted.
+	Required *bool `yaml:"required"`
+
+	// TagName is the name of the tag identifying the series of a tenant,
+	// defaults to "tenant".
+	TagName string `yaml:"tagName"`
+
+	// Tenants are the tenants of the coordinator.
+	Tenants []TenantConfiguration `yaml:"tenants"`
+}
+
+// TenantConfiguration configures a tenant.
+type TenantConfiguration struct {
+	// Name is the name of the tenant.
+	Name string `yaml:"name" validate:"nonzero"`
+
+	// TagValue is the value of the tag identifying the series of the tenant,
+	// defaults to the name of the tenant.
+	TagValue string `yaml:"tagValue"`
+
+	// Unrestricted lets the tenant write and read any series, e.g. for
+	// administrators, its limits still apply.
+	Unrestricted bool `yaml:"unrestricted"`
+
+	// Limits are the limits of the tenant.
+	Limits LimitsConfiguration `yaml:"limits"`
+}
+
+// LimitsConfiguration configures the limits of a tenant. Zero or negative
+// values imply no limit.
+type LimitsConfiguration struct {
+	// MaxWriteDatapointsPerSecond limits the number of datapoints the tenant
+	// can write per second.
+	MaxWriteDatapointsPerSecond int64 `yaml:"maxWriteDatapointsPerSecond"`
+
+	// MaxFetchedSeries limits the number of time series returned by any given
+	// individual storage node per query of the tenant. It only limits reads,
+	// the series written by the tenant are only limited by its write rate.
+	MaxFetchedSeries int `yaml:"maxFetchedSeries"`
+
+	// MaxFetchedDatapoints limits the max number of datapoints allowed to be
+	// used by all queries of the tenant at any point in time.
+	MaxFetchedDatapoints int `yaml:"maxFetchedDatapoints"`
+}
+
+// NewTenants returns the configured tenants, their query cost enforcers roll
+// up to the given global enforcer.
+func (c Configuration) NewTenants(
+	globalEnforcer qcost.ChainedEnforcer,
+	nowFn clock.NowFn,
+	instrumentOpts instrument.Options,
+) (*Tenants, error) {
+	if len(c.Tenants) == 0 {
+		return nil, errNoTenants
+	}
+
+	source := c.Source
+	switch source {
+	case "":
+		source = HeaderSource
+	case HeaderSource, CertificateSource:
+	default:
+		return nil, fmt.Errorf("unknown tenant source: %s", source)
+	}
+
+	header := c.Header
+	if header == "" {
+		header = DefaultHeader
+	}
+
+	tagName := c.TagName
+	if tagName == "" {
+		tagName = DefaultTagName
+	}
+
+	required := true
+	if c.Required != nil {
+		required = *c.Required
+	}
+
+	tenants := &Tenants{
+		source:   source,
+		header:   header,
+		required: required,
+		tenants:  make(map[string]*Tenant, len(c.Tenants)),
+	}
+	for _, tc := range c.Tenants {
+		if tc.Name == "" {
+			return nil, errNoName
+		}
+		if _, ok := tenants.tenants[tc.Name]; ok {
+			return nil, fmt.Errorf("duplicate tenant: %s", tc.Name)
+		}
+
+		t, err := tc.newTenant(tagName, globalEnforcer, nowFn, instrumentOpts)
+		if err != nil {
+			return nil, err
+		}
+		tenants.tenants[tc.Name] = t
+	}
+	return tenants, nil
+}
+
+func (c TenantConfiguration) newTenant(
+	tagName string,
+	globalEnforcer qcost.ChainedEnforcer,
+	nowFn clock.NowFn,
+	instrumentOpts instrument.Options,
+) (*Tenant, error) {
+	tagValue := c.TagValue
+	if tagValue == "" {
+		tagValue = c.Name
+	}
+
+	scope := instrumentOpts.MetricsScope().
+		SubScope("tenant").
+		Tagged(map[string]string{"tenant": c.Name})
+	t := &Tenant{
+		name: c.Name,
+		tag: models.Tag{
+			Name:  []byte(tagName),
+			Value: []byte(tagValue),
+		},
+		restricted:         !c.Unrestricted,
+		fetchedSeriesLimit: c.Limits.MaxFetchedSeries,
+		metrics:            newTenantMetrics(scope),
+	}
+
+	if v := c.Limits.MaxWriteDatapointsPerSecond; v > 0 {
+		t.limiter = rate.NewLimiter(v, nowFn)
+	}
+
+	if v := c.Limits.MaxFetchedDatapoints; v > 0 {
+		enforcer := cost.NewEnforcer(
+			cost.NewStaticLimitManager(cost.NewLimitManagerOptions().
+				SetDefaultLimit(cost.Limit{Threshold: cost.Cost(v), Enabled: true})),
+			cost.NewTracker(),
+			cost.NewEnforcerOptions().
+				SetCostExceededMessage(fmt.Sprintf(
+					"exceeded tenants.%s.limits.maxFetchedDatapoints", c.Name)))
+
+		chained, err := qcost.NewChildEnforcer(globalEnforcer, "tenant", enforcer)
+		if err != nil {
+			return nil, err
+		}
+		t.enforcer = chained
+	}
+
+	return t, nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tenant
+
+import (
+	"net/http"
+
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+// NewHandler returns a handler resolving the tenant of requests before
+// serving them with the given handler, the tenant is held by the context of
+// the request. Requests of unknown tenants, or without tenant if tenants are
+// required, are rejected.
+func NewHandler(tenants *Tenants, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t, err := tenants.Resolve(r)
+		if err != nil {
+			xhttp.Error(w, err.Inner(), err.Code())
+			return
+		}
+		if t == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		t.metrics.requests.Inc(1)
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), t)))
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package tenant isolates the tenants of a coordinator shared by several
+// teams, each tenant has its series identified by a tag which is added to its
+// writes and enforced on its reads, and has its own ingest and query limits.
+package tenant
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/m3db/m3/src/aggregator/rate"
+	qcost "github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/uber-go/tally"
+)
+
+// Source is where the tenant of a request is taken from.
+type Source string
+
+const (
+	// HeaderSource takes the tenant of a request from a header.
+	HeaderSource Source = "header"
+	// CertificateSource takes the tenant of a request from the common name
+	// of the subject of the verified client certificate.
+	CertificateSource Source = "certificate"
+
+	// DefaultHeader is the default header the tenant of a request is taken
+	// from.
+	DefaultHeader = "M3-Tenant"
+	// DefaultTagName is the default name of the tag identifying the series of
+	// a tenant.
+	DefaultTagName = "tenant"
+)
+
+var (
+	errNoTenant      = errors.New("no tenant specified")
+	errNoCertificate = errors.New("no verified client certificate")
+)
+
+type contextKey struct{}
+
+// NewContext returns a copy of the context holding the tenant.
+func NewContext(ctx context.Context, t *Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns the tenant held by the context if any.
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	t, ok := ctx.Value(contextKey{}).(*Tenant)
+	return t, ok && t != nil
+}
+
+// Tenant is a tenant of the coordinator.
+type Tenant struct {
+	name               string
+	tag                models.Tag
+	restricted         bool
+	limiter            *rate.Limiter
+	fetchedSeriesLimit int
+	enforcer           qcost.ChainedEnforcer
+	metrics            tenantMetrics
+}
+
+type tenantMetrics struct {
+	requests         tally.Counter
+	writeRateLimited tally.Counter
+}
+
+func newTenantMetrics(scope tally.Scope) tenantMetrics {
+	return tenantMetrics{
+		requests:         scope.Counter("requests"),
+		writeRateLimited: scope.Counter("write-rate-limited"),
+	}
+}
+
+// Name returns the name of the tenant.
+func (t *Tenant) Name() string {
+	return t.name
+}
+
+// Tag returns the tag identifying the series of the tenant, false is returned
+// if the tenant is not restricted to its series.
+func (t *Tenant) Tag() (models.Tag, bool) {
+	return t.tag, t.restricted
+}
+
+// Scope returns the name of the tenant held by the context if it is
+// restricted to its series, used to scope the data that is not stored as
+// series such as metric metadata. The empty scope is returned otherwise.
+func Scope(ctx context.Context) string {
+	t, ok := FromContext(ctx)
+	if !ok || !t.restricted {
+		return ""
+	}
+	return t.name
+}
+
+// ApplyFetchOptions restricts fetch options to the series of the tenant held
+// by the context and to the fetched series limit of the tenant, if any.
+func ApplyFetchOptions(ctx context.Context, fetchOpts *storage.FetchOptions) {
+	t, ok := FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if t.fetchedSeriesLimit > 0 &&
+		(fetchOpts.SeriesLimit <= 0 || fetchOpts.SeriesLimit > t.fetchedSeriesLimit) {
+		fetchOpts.SeriesLimit = t.fetchedSeriesLimit
+	}
+
+	if !t.restricted {
+		return
+	}
+
+	// NB: never mutate the existing restrictions, they may be the defaults
+	// shared by all requests.
+	var restrict storage.RestrictQueryOptions
+	if existing := fetchOpts.RestrictQueryOptions; existing != nil {
+		restrict = *existing
+	}
+
+	var byTag storage.RestrictByTag
+	if existing := restrict.RestrictByTag; existing != nil {
+		byTag.Restrict = append(byTag.Restrict, existing.Restrict...)
+		byTag.Strip = existing.Strip
+	}
+	byTag.Restrict = append(byTag.Restrict, models.Matcher{
+		Type:  models.MatchEqual,
+		Name:  t.tag.Name,
+		Value: t.tag.Value,
+	})
+
+	restrict.RestrictByTag = &byTag
+	fetchOpts.RestrictQueryOptions = &restrict
+}
+
+// Enforcer returns the query cost enforcer of the tenant held by the context,
+// or the given enforcer if there is no such tenant or it has no cost limit.
+func Enforcer(
+	ctx context.Context,
+	enforcer qcost.ChainedEnforcer,
+) qcost.ChainedEnforcer {
+	if t, ok := FromContext(ctx); ok && t.enforcer != nil {
+		return t.enforcer
+	}
+	return enforcer
+}
+
+// Tenants are the tenants of the coordinator.
+type Tenants struct {
+	source   Source
+	header   string
+	required bool
+	tenants  map[string]*Tenant
+}
+
+// Resolve returns the tenant of a request, nil is returned if the request
+// does not specify a tenant and a tenant is not required.
+func (t *Tenants) Resolve(r *http.Request) (*Tenant, *xhttp.ParseError) {
+	var name string
+	switch t.source {
+	case CertificateSource:
+		cert, err := verifiedCertificate(r)
+		if err != nil {
+			if !t.required {
+				return nil, nil
+			}
+			return nil, xhttp.NewParseError(err, http.StatusUnauthorized)
+		}
+		name = cert.Subject.CommonName
+	default:
+		name = strings.TrimSpace(r.Header.Get(t.header))
+	}
+
+	if name == "" {
+		if !t.required {
+			return nil, nil
+		}
+		return nil, xhttp.NewParseError(errNoTenant, http.StatusUnauthorized)
+	}
+
+	tenant, ok := t.tenants[name]
+	if !ok {
+		err := fmt.Errorf("unknown tenant: %s", name)
+		return nil, xhttp.NewParseError(err, http.StatusForbidden)
+	}
+	return tenant, nil
+}
+
+func verifiedCertificate(r *http.Request) (*x509.Certificate, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 ||
+		len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, errNoCertificate
+	}
+	return r.TLS.VerifiedChains[0][0], nil
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tenant
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	qcost "github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/x/cost"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGlobalEnforcer(t *testing.T) qcost.ChainedEnforcer {
+	newEnforcer := func() cost.Enforcer {
+		return cost.NewEnforcer(cost.NewStaticLimitManager(
+			cost.NewLimitManagerOptions()), cost.NewTracker(), nil)
+	}
+	enforcer, err := qcost.NewChainedEnforcer(qcost.GlobalLevel,
+		[]cost.Enforcer{newEnforcer(), newEnforcer(), newEnforcer()})
+	require.NoError(t, err)
+	return enforcer
+}
+
+func newTestTenants(t *testing.T, cfg Configuration) *Tenants {
+	if len(cfg.Tenants) == 0 {
+		cfg.Tenants = []TenantConfiguration{
+			{
+				Name: "foo",
+				Limits: LimitsConfiguration{
+					MaxWriteDatapointsPerSecond: 10,
+					MaxFetchedSeries:            100,
+					MaxFetchedDatapoints:        1000,
+				},
+			},
+			{Name: "bar", TagValue: "baz"},
+			{Name: "admin", Unrestricted: true},
+		}
+	}
+	tenants, err := cfg.NewTenants(newTestGlobalEnforcer(t), time.Now,
+		instrument.NewOptions())
+	require.NoError(t, err)
+	return tenants
+}
+
+func newTestRequest(tenant string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/query", nil)
+	if tenant != "" {
+		req.Header.Set(DefaultHeader, tenant)
+	}
+	return req
+}
+
+func TestNewTenantsErrors(t *testing.T) {
+	iOpts := instrument.NewOptions()
+	for _, cfg := range []Configuration{
+		{},
+		{Source: "foo", Tenants: []TenantConfiguration{{Name: "foo"}}},
+		{Tenants: []TenantConfiguration{{Name: ""}}},
+		{Tenants: []TenantConfiguration{{Name: "foo"}, {Name: "foo"}}},
+	} {
+		_, err := cfg.NewTenants(newTestGlobalEnforcer(t), time.Now, iOpts)
+		assert.Error(t, err)
+	}
+}
+
+func TestResolveFromHeader(t *testing.T) {
+	tenants := newTestTenants(t, Configuration{})
+
+	tenant, err := tenants.Resolve(newTestRequest("bar"))
+	require.Nil(t, err)
+	require.NotNil(t, tenant)
+	assert.Equal(t, "bar", tenant.Name())
+	tag, ok := tenant.Tag()
+	require.True(t, ok)
+	assert.Equal(t, "tenant", string(tag.Name))
+	assert.Equal(t, "baz", string(tag.Value))
+
+	// Tenants are required by default.
+	_, err = tenants.Resolve(newTestRequest(""))
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, err.Code())
+
+	_, err = tenants.Resolve(newTestRequest("qux"))
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusForbidden, err.Code())
+}
+
+func TestResolveNotRequired(t *testing.T) {
+	required := false
+	tenants := newTestTenants(t, Configuration{Required: &required})
+
+	tenant, err := tenants.Resolve(newTestRequest(""))
+	require.Nil(t, err)
+	assert.Nil(t, tenant)
+}
+
+func TestResolveFromCertificate(t *testing.T) {
+	tenants := newTestTenants(t, Configuration{Source: CertificateSource})
+
+	// The header is ignored.
+	_, err := tenants.Resolve(newTestRequest("foo"))
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, err.Code())
+
+	req := newTestRequest("")
+	req.TLS = &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{
+			{{Subject: pkix.Name{CommonName: "foo"}}},
+		},
+	}
+	tenant, err := tenants.Resolve(req)
+	require.Nil(t, err)
+	require.NotNil(t, tenant)
+	assert.Equal(t, "foo", tenant.Name())
+}
+
+func TestApplyFetchOptions(t *testing.T) {
+	tenants := newTestTenants(t, Configuration{})
+	foo, err := tenants.Resolve(newTestRequest("foo"))
+	require.Nil(t, err)
+	admin, err := tenants.Resolve(newTestRequest("admin"))
+	require.Nil(t, err)
+
+	defaultRestrict := &storage.RestrictQueryOptions{
+		RestrictByTag: &storage.RestrictByTag{
+			Restrict: models.Matchers{{
+				Type:  models.MatchEqual,
+				Name:  []byte("env"),
+				Value: []byte("prod"),
+			}},
+		},
+	}
+
+	fetchOpts := storage.NewFetchOptions()
+	fetchOpts.SeriesLimit = 1000
+	fetchOpts.RestrictQueryOptions = defaultRestrict
+	ApplyFetchOptions(NewContext(newTestRequest("").Context(), foo), fetchOpts)
+
+	assert.Equal(t, 100, fetchOpts.SeriesLimit)
+	assert.Equal(t, models.Matchers{
+		{Type: models.MatchEqual, Name: []byte("env"), Value: []byte("prod")},
+		{Type: models.MatchEqual, Name: []byte("tenant"), Value: []byte("foo")},
+	}, fetchOpts.RestrictQueryOptions.GetRestrictByTag().GetMatchers())
+	// The default restrictions are left unchanged.
+	assert.Len(t, defaultRestrict.RestrictByTag.Restrict, 1)
+
+	fetchOpts = storage.NewFetchOptions()
+	fetchOpts.SeriesLimit = 10
+	ApplyFetchOptions(NewContext(newTestRequest("").Context(), admin), fetchOpts)
+	assert.Equal(t, 10, fetchOpts.SeriesLimit)
+	assert.Nil(t, fetchOpts.RestrictQueryOptions)
+}
+
+func TestEnforcer(t *testing.T) {
+	tenants := newTestTenants(t, Configuration{})
+	foo, err := tenants.Resolve(newTestRequest("foo"))
+	require.Nil(t, err)
+	bar, err := tenants.Resolve(newTestRequest("bar"))
+	require.Nil(t, err)
+
+	global := newTestGlobalEnforcer(t)
+	ctx := newTestRequest("").Context()
+	assert.Equal(t, global, Enforcer(ctx, global))
+	// No limit for bar.
+	assert.Equal(t, global, Enforcer(NewContext(ctx, bar), global))
+
+	query := Enforcer(NewContext(ctx, foo), global).Child(qcost.QueryLevel)
+	defer query.Close()
+	assert.NoError(t, query.Add(999).Error)
+	assert.Error(t, query.Add(1).Error)
+}
+
+func TestHandler(t *testing.T) {
+	tenants := newTestTenants(t, Configuration{})
+
+	var served *Tenant
+	handler := NewHandler(tenants, http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			served, _ = FromContext(r.Context())
+		}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRequest("foo"))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	require.NotNil(t, served)
+	assert.Equal(t, "foo", served.Name())
+
+	served = nil
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRequest(""))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.Nil(t, served)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newTestRequest("qux"))
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Nil(t, served)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+type downsamplerAndWriter struct {
+	ingest.DownsamplerAndWriter
+}
+
+// NewDownsamplerAndWriter returns a downsampler and writer which tags the
+// series written by the tenant held by the context with the tag of the tenant
+// and enforces the ingest rate limit of the tenant. Writes without tenant are
+// passed through as is.
+func NewDownsamplerAndWriter(
+	writer ingest.DownsamplerAndWriter,
+) ingest.DownsamplerAndWriter {
+	return &downsamplerAndWriter{DownsamplerAndWriter: writer}
+}
+
+func (w *downsamplerAndWriter) Write(
+	ctx context.Context,
+	tags models.Tags,
+	datapoints ts.Datapoints,
+	unit xtime.Unit,
+	annotation []byte,
+	overrides ingest.WriteOptions,
+) error {
+	t, ok := FromContext(ctx)
+	if !ok {
+		return w.DownsamplerAndWriter.Write(ctx, tags, datapoints, unit,
+			annotation, overrides)
+	}
+
+	if err := t.allowWrite(int64(len(datapoints))); err != nil {
+		return err
+	}
+	if tag, ok := t.Tag(); ok {
+		tags = withTag(tags, tag)
+	}
+	return w.DownsamplerAndWriter.Write(ctx, tags, datapoints, unit,
+		annotation, overrides)
+}
+
+func (w *downsamplerAndWriter) WriteBatch(
+	ctx context.Context,
+	iter ingest.DownsampleAndWriteIter,
+	overrides ingest.WriteOptions,
+) ingest.BatchError {
+	t, ok := FromContext(ctx)
+	if !ok {
+		return w.DownsamplerAndWriter.WriteBatch(ctx, iter, overrides)
+	}
+
+	if t.limiter != nil {
+		var n int64
+		for iter.Next() {
+			n += int64(len(iter.Current().Datapoints))
+		}
+		if err := iter.Error(); err != nil {
+			return xerrors.NewMultiError().Add(err)
+		}
+		if err := iter.Reset(); err != nil {
+			return xerrors.NewMultiError().Add(err)
+		}
+		if err := t.allowWrite(n); err != nil {
+			return xerrors.NewMultiError().Add(err)
+		}
+	}
+
+	if tag, ok := t.Tag(); ok {
+		iter = &taggedIter{DownsampleAndWriteIter: iter, tag: tag}
+	}
+	return w.DownsamplerAndWriter.WriteBatch(ctx, iter, overrides)
+}
+
+func (t *Tenant) allowWrite(datapoints int64) error {
+	if t.limiter == nil || t.limiter.IsAllowed(datapoints) {
+		return nil
+	}
+
+	t.metrics.writeRateLimited.Inc(1)
+	// NB: rejected as a bad request, retrying would only add to the load of a
+	// tenant already over its limit.
+	return xerrors.NewInvalidParamsError(fmt.Errorf(
+		"tenant %s exceeded limits.maxWriteDatapointsPerSecond of %d",
+		t.name, t.limiter.Limit()))
+}
+
+// taggedIter adds the tag of a tenant to the series of an iterator, replacing
+// any value set by the client.
+type taggedIter struct {
+	ingest.DownsampleAndWriteIter
+	tag models.Tag
+}
+
+func (i *taggedIter) Current() ingest.IterValue {
+	value := i.DownsampleAndWriteIter.Current()
+	value.Tags = withTag(value.Tags, i.tag)
+	return value
+}
+
+// withTag adds or updates a tag without modifying the given tags, which are
+// sorted in place when a tag is added.
+func withTag(tags models.Tags, tag models.Tag) models.Tags {
+	tags.Tags = append(make([]models.Tag, 0, len(tags.Tags)+1), tags.Tags...)
+	return tags.AddOrUpdateTag(tag)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testIter struct {
+	idx    int
+	values []ingest.IterValue
+}
+
+func newTestIter(values ...ingest.IterValue) *testIter {
+	return &testIter{idx: -1, values: values}
+}
+
+func (i *testIter) Next() bool {
+	i.idx++
+	return i.idx < len(i.values)
+}
+
+func (i *testIter) Current() ingest.IterValue {
+	return i.values[i.idx]
+}
+
+func (i *testIter) Reset() error {
+	i.idx = -1
+	return nil
+}
+
+func (i *testIter) Error() error {
+	return nil
+}
+
+func (i *testIter) SetCurrentMetadata(ts.Metadata) {}
+
+func newTestIterValue(name string, numDatapoints int) ingest.IterValue {
+	tags := models.NewTags(2, models.NewTagOptions()).
+		SetName([]byte(name)).
+		AddTag(models.Tag{Name: []byte("tenant"), Value: []byte("spoofed")})
+	return ingest.IterValue{
+		Tags:       tags,
+		Datapoints: make(ts.Datapoints, numDatapoints),
+		Unit:       xtime.Millisecond,
+	}
+}
+
+func newTestTenantContext(t *testing.T, name string) context.Context {
+	tenant, err := newTestTenants(t, Configuration{}).Resolve(newTestRequest(name))
+	require.Nil(t, err)
+	return NewContext(context.Background(), tenant)
+}
+
+func requireTenantTag(t *testing.T, expected string, tags models.Tags) {
+	value, ok := tags.Get([]byte("tenant"))
+	require.True(t, ok)
+	assert.Equal(t, expected, string(value))
+}
+
+func TestDownsamplerAndWriterWriteBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	inner := ingest.NewMockDownsamplerAndWriter(ctrl)
+	writer := NewDownsamplerAndWriter(inner)
+	ctx := newTestTenantContext(t, "foo")
+
+	var written []models.Tags
+	inner.EXPECT().WriteBatch(ctx, gomock.Any(), ingest.WriteOptions{}).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			for iter.Next() {
+				written = append(written, iter.Current().Tags)
+			}
+			return nil
+		})
+
+	iter := newTestIter(newTestIterValue("a", 5), newTestIterValue("b", 5))
+	require.Nil(t, writer.WriteBatch(ctx, iter, ingest.WriteOptions{}))
+	require.Len(t, written, 2)
+	for _, tags := range written {
+		requireTenantTag(t, "foo", tags)
+	}
+	// The tags of the iterator are left unchanged.
+	requireTenantTag(t, "spoofed", iter.values[0].Tags)
+
+	// Over the limit of 10 datapoints per second.
+	batchErr := writer.WriteBatch(ctx, newTestIter(newTestIterValue("c", 1)),
+		ingest.WriteOptions{})
+	require.NotNil(t, batchErr)
+	require.Len(t, batchErr.Errors(), 1)
+	assert.True(t, xerrors.IsInvalidParams(batchErr.Errors()[0]))
+}
+
+func TestDownsamplerAndWriterWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	inner := ingest.NewMockDownsamplerAndWriter(ctrl)
+	writer := NewDownsamplerAndWriter(inner)
+	value := newTestIterValue("a", 1)
+
+	ctx := newTestTenantContext(t, "bar")
+	inner.EXPECT().
+		Write(ctx, gomock.Any(), value.Datapoints, value.Unit, nil, ingest.WriteOptions{}).
+		Do(func(_ context.Context, tags models.Tags, _ ts.Datapoints,
+			_ xtime.Unit, _ []byte, _ ingest.WriteOptions) {
+			requireTenantTag(t, "baz", tags)
+		})
+	require.NoError(t, writer.Write(ctx, value.Tags, value.Datapoints,
+		value.Unit, nil, ingest.WriteOptions{}))
+
+	// Writes of unrestricted tenants and without tenant are passed through.
+	for _, ctx := range []context.Context{
+		newTestTenantContext(t, "admin"),
+		context.Background(),
+	} {
+		inner.EXPECT().
+			Write(ctx, value.Tags, value.Datapoints, value.Unit, nil, ingest.WriteOptions{}).
+			Return(nil)
+		require.NoError(t, writer.Write(ctx, value.Tags, value.Datapoints,
+			value.Unit, nil, ingest.WriteOptions{}))
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/analyze"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util"
@@ -37,6 +38,7 @@ func NewEncodedSeriesIter(
 	seriesIters []encoding.SeriesIterator,
 	lookback time.Duration,
 	instrumented bool,
+	analyzeStats *analyze.Stats,
 ) block.SeriesIter {
 	return &encodedSeriesIter{
 		idx:              -1,
@@ -45,6 +47,7 @@ func NewEncodedSeriesIter(
 		seriesIters:      seriesIters,
 		lookbackDuration: lookback,
 		instrumented:     instrumented,
+		analyzeStats:     analyzeStats,
 	}
 }
 
@@ -58,12 +61,14 @@ type encodedSeriesIter struct {
 	seriesMeta       []block.SeriesMeta
 	seriesIters      []encoding.SeriesIterator
 	instrumented     bool
+	analyzeStats     *analyze.Stats
 }
 
 func (b *encodedBlock) SeriesIter() (block.SeriesIter, error) {
 	return NewEncodedSeriesIter(
 		b.meta, b.seriesMetas, b.seriesBlockIterators,
 		b.options.LookbackDuration(), b.options.Instrumented(),
+		b.options.AnalyzeStats(),
 	), nil
 }
 
@@ -114,6 +119,7 @@ func (it *encodedSeriesIter) Next() bool {
 		decodeDuration = time.Since(decodeStart)
 	}
 
+	it.analyzeStats.AddDatapointsDecoded(len(it.datapoints))
 	if it.err = iter.Err(); it.err != nil {
 		return false
 	}
@@ -196,6 +202,7 @@ func iteratorBatchingFn(
 		iter := NewEncodedSeriesIter(
 			meta, seriesMetas[start:end], seriesBlockIterators[start:end],
 			opts.LookbackDuration(), opts.Instrumented(),
+			opts.AnalyzeStats(),
 		)
 
 		iters = append(iters, block.SeriesIterBatch{
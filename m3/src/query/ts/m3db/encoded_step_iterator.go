@@ -72,7 +72,8 @@ func (b *encodedBlock) StepIter() (
 			seriesIters:      iters,
 			seriesCollectors: seriesCollectors,
 
-			workerPool: b.options.ReadWorkerPool(),
+			workerPool:   b.options.ReadWorkerPool(),
+			analyzeStats: b.options.AnalyzeStats(),
 		},
 	}
 
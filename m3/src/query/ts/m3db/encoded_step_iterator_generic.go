@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/analyze"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/ts/m3db/consolidators"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -50,8 +51,9 @@ type encodedStepIterWithCollector struct {
 
 	updateFn updateFn
 
-	workerPool xsync.PooledWorkerPool
-	wg         sync.WaitGroup
+	workerPool   xsync.PooledWorkerPool
+	wg           sync.WaitGroup
+	analyzeStats *analyze.Stats
 }
 
 // Moves to the next step for the i-th series in the block, populating
@@ -97,6 +99,7 @@ func nextForStep(
 	// a value, set the next peek value.
 	for iter.Next() {
 		dp, _, _ := iter.Current()
+		peek.decoded++
 
 		// If this datapoint is before the current timestamp, add it as a
 		// consolidation candidate.
@@ -140,6 +143,8 @@ func (it *encodedStepIterWithCollector) nextParallel(steps int) error {
 				collector.BufferStep()
 			}
 
+			it.analyzeStats.AddDatapointsDecoded(peek.decoded)
+			peek.decoded = 0
 			it.seriesPeek[i] = peek
 			it.seriesCollectors[i] = collector
 			if err != nil {
@@ -177,6 +182,8 @@ func (it *encodedStepIterWithCollector) nextSequential(steps int) error {
 			collector.BufferStep()
 		}
 
+		it.analyzeStats.AddDatapointsDecoded(peek.decoded)
+		peek.decoded = 0
 		it.seriesPeek[i] = peek
 		it.seriesCollectors[i] = collector
 		if err != nil {
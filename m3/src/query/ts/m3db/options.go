@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/query/analyze"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/pools"
 	queryconsolidator "github.com/m3db/m3/src/query/storage/m3/consolidators"
@@ -64,6 +65,7 @@ type encodedBlockOptions struct {
 	batchingFn                    IteratorBatchingFn
 	adminOptions                  []client.CustomAdminOption
 	instrumented                  bool
+	analyzeStats                  *analyze.Stats
 }
 
 type nextDetails struct {
@@ -239,6 +241,16 @@ func (o *encodedBlockOptions) Instrumented() bool {
 	return o.instrumented
 }
 
+func (o *encodedBlockOptions) SetAnalyzeStats(s *analyze.Stats) Options {
+	opts := *o
+	opts.analyzeStats = s
+	return &opts
+}
+
+func (o *encodedBlockOptions) AnalyzeStats() *analyze.Stats {
+	return o.analyzeStats
+}
+
 func (o *encodedBlockOptions) Validate() error {
 	if o.lookbackDuration < 0 {
 		return errors.New("unable to validate block options; negative lookback")
@@ -26,6 +26,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
 	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/analyze"
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/models"
 	queryconsolidator "github.com/m3db/m3/src/query/storage/m3/consolidators"
@@ -92,6 +93,11 @@ type Options interface {
 	SetInstrumented(bool) Options
 	// Instrumented returns if the encoding step should have instrumentation enabled.
 	Instrumented() bool
+	// SetAnalyzeStats sets the analyze stats of the query the decoded
+	// datapoints are recorded in.
+	SetAnalyzeStats(*analyze.Stats) Options
+	// AnalyzeStats returns the analyze stats of the query if any.
+	AnalyzeStats() *analyze.Stats
 	// Validate ensures that the given block options are valid.
 	Validate() error
 }
@@ -109,4 +115,5 @@ type peekValue struct {
 	started  bool
 	finished bool
 	point    ts.Datapoint
+	decoded  int
 }
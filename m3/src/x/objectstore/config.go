@@ -0,0 +1,79 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"errors"
+)
+
+var errStoreNotConfigured = errors.New(
+	"exactly one of the filesystem or s3 object store must be configured")
+
+// Configuration is the configuration of an object store, exactly one of
+// the filesystem or s3 stores must be set.
+type Configuration struct {
+	// Filesystem is a store backed by a local directory.
+	Filesystem *FilesystemConfiguration `yaml:"filesystem"`
+
+	// S3 is a store backed by a bucket of an S3 compatible object store.
+	S3 *S3Configuration `yaml:"s3"`
+}
+
+// FilesystemConfiguration is the configuration of a filesystem store.
+type FilesystemConfiguration struct {
+	// Path is the directory objects are stored under.
+	Path string `yaml:"path" validate:"nonzero"`
+}
+
+// S3Configuration is the configuration of an S3 store.
+type S3Configuration struct {
+	// Endpoint is the URL of the S3 API.
+	Endpoint string `yaml:"endpoint" validate:"nonzero"`
+
+	// Bucket is the bucket objects are stored in.
+	Bucket string `yaml:"bucket" validate:"nonzero"`
+
+	// Region is the region requests are signed for.
+	Region string `yaml:"region"`
+
+	// AccessKeyID is the access key ID requests are signed with.
+	AccessKeyID string `yaml:"accessKeyID"`
+
+	// SecretAccessKey is the secret access key requests are signed with.
+	SecretAccessKey string `yaml:"secretAccessKey"`
+}
+
+// NewStore returns the configured object store.
+func (c Configuration) NewStore() (Store, error) {
+	switch {
+	case c.Filesystem != nil && c.S3 == nil:
+		return NewFilesystemStore(c.Filesystem.Path), nil
+	case c.S3 != nil && c.Filesystem == nil:
+		return NewS3Store(S3Options{
+			Endpoint:        c.S3.Endpoint,
+			Bucket:          c.S3.Bucket,
+			Region:          c.S3.Region,
+			AccessKeyID:     c.S3.AccessKeyID,
+			SecretAccessKey: c.S3.SecretAccessKey,
+		})
+	}
+	return nil, errStoreNotConfigured
+}
@@ -0,0 +1,353 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package objectstore
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3DefaultRegion     = "us-east-1"
+	s3SigningAlgorithm  = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload   = "UNSIGNED-PAYLOAD"
+	s3DateTimeFormat    = "20060102T150405Z"
+	s3DateFormat        = "20060102"
+	s3ServiceName       = "s3"
+	s3ErrorBodyMaxBytes = 4096
+)
+
+var (
+	errS3EndpointNotSet = errors.New("s3 endpoint not set")
+	errS3BucketNotSet   = errors.New("s3 bucket not set")
+
+	// s3EmptyPayloadHash is the SHA256 of an empty payload.
+	s3EmptyPayloadHash = hex.EncodeToString(sha256.New().Sum(nil))
+)
+
+// S3Options are the options of an S3 compatible store, such as AWS S3 or
+// MinIO. Objects are addressed path style, i.e. as endpoint/bucket/key.
+type S3Options struct {
+	// Endpoint is the URL of the S3 API, e.g. https://s3.us-east-1.amazonaws.com
+	// or http://localhost:9000 for a local MinIO.
+	Endpoint string
+	// Bucket is the bucket objects are stored in.
+	Bucket string
+	// Region is the region requests are signed for, defaults to us-east-1.
+	Region string
+	// AccessKeyID and SecretAccessKey are the credentials requests are
+	// signed with, requests are anonymous if they are empty.
+	AccessKeyID     string
+	SecretAccessKey string
+	// HTTPClient is the client requests are made with, defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+	// NowFn returns the time requests are signed at, defaults to time.Now.
+	NowFn func() time.Time
+}
+
+type s3Store struct {
+	opts     S3Options
+	endpoint *url.URL
+}
+
+// NewS3Store returns a Store backed by a bucket of an S3 compatible object
+// store, requests are signed with AWS signature version 4.
+func NewS3Store(opts S3Options) (Store, error) {
+	if opts.Endpoint == "" {
+		return nil, errS3EndpointNotSet
+	}
+	if opts.Bucket == "" {
+		return nil, errS3BucketNotSet
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(opts.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %v", err)
+	}
+	if opts.Region == "" {
+		opts.Region = s3DefaultRegion
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	if opts.NowFn == nil {
+		opts.NowFn = time.Now
+	}
+	return &s3Store{opts: opts, endpoint: endpoint}, nil
+}
+
+func (s *s3Store) Put(key string, r io.Reader, size int64) error {
+	req, err := s.newRequest(http.MethodPut, key, nil, r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		// A zero content length with a non nil body is sent chunked.
+		req.Body = http.NoBody
+	}
+
+	resp, err := s.do(req, s3UnsignedPayload)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (s *s3Store) Get(key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req, s3EmptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *s3Store) Delete(key string) error {
+	req, err := s.newRequest(http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req, s3EmptyPayloadHash)
+	if err == ErrObjectNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *s3Store) List(prefix string) ([]string, error) {
+	var (
+		keys  []string
+		token string
+	)
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		req, err := s.newRequest(http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.do(req, s3EmptyPayloadHash)
+		if err != nil {
+			return nil, err
+		}
+
+		var result s3ListBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not decode s3 list result: %v", err)
+		}
+
+		for _, content := range result.Contents {
+			keys = append(keys, content.Key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *s3Store) newRequest(
+	method string,
+	key string,
+	query url.Values,
+	body io.Reader,
+) (*http.Request, error) {
+	path := s.opts.Bucket
+	if key != "" {
+		path += "/" + key
+	}
+
+	u := *s.endpoint
+	u.Path = s.endpoint.Path + "/" + path
+	u.RawPath = s.endpoint.Path + "/" + s3EscapePath(path)
+	u.RawQuery = s3EscapeQuery(query)
+	return http.NewRequest(method, u.String(), body)
+}
+
+func (s *s3Store) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	if s.opts.AccessKeyID != "" {
+		s3Sign(req, payloadHash, s.opts.AccessKeyID, s.opts.SecretAccessKey,
+			s.opts.Region, s.opts.NowFn())
+	}
+
+	resp, err := s.opts.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound && req.Method != http.MethodPut {
+		return nil, ErrObjectNotFound
+	}
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, s3ErrorBodyMaxBytes))
+	return nil, fmt.Errorf("s3 %s %s failed with status %d: %s",
+		req.Method, req.URL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// s3Sign signs a request with AWS signature version 4, signing the host and
+// every header already set on the request.
+func s3Sign(
+	req *http.Request,
+	payloadHash string,
+	accessKeyID string,
+	secretAccessKey string,
+	region string,
+	now time.Time,
+) {
+	now = now.UTC()
+	req.Header.Set("X-Amz-Date", now.Format(s3DateTimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{
+		now.Format(s3DateFormat), region, s3ServiceName, "aws4_request",
+	}, "/")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3SigningAlgorithm,
+		now.Format(s3DateTimeFormat),
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	key := s3HMAC([]byte("AWS4"+secretAccessKey), now.Format(s3DateFormat))
+	key = s3HMAC(key, region)
+	key = s3HMAC(key, s3ServiceName)
+	key = s3HMAC(key, "aws4_request")
+	signature := hex.EncodeToString(s3HMAC(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, accessKeyID, scope, signedHeaders, signature))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3EscapePath escapes every segment of a path as required by signature
+// version 4, which only leaves unreserved characters unescaped.
+func s3EscapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = s3Escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// s3EscapeQuery encodes a query sorted by key as required by signature
+// version 4.
+func s3EscapeQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, value := range query[key] {
+			params = append(params, s3Escape(key)+"="+s3Escape(value))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+func s3Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') ||
+			('0' <= c && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package objectstore provides object stores, such as S3 compatible buckets
+// or local directories, that files can be uploaded to and fetched from.
+package objectstore
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrObjectNotFound is returned by a Store when an object does not exist.
+var ErrObjectNotFound = errors.New("object not found")
+
+// Store is an object store.
+type Store interface {
+	// Put uploads size bytes read from r as the object with the given key.
+	Put(key string, r io.Reader, size int64) error
+
+	// Get returns a reader of the object with the given key, or
+	// ErrObjectNotFound if it does not exist.
+	Get(key string) (io.ReadCloser, error)
+
+	// List returns the keys of all the objects starting with the given
+	// prefix, sorted in ascending order.
+	List(prefix string) ([]string, error)
+
+	// Delete deletes the object with the given key, deleting an object that
+	// does not exist is not an error.
+	Delete(key string) error
+}
@@ -43,9 +43,28 @@ keepAlivePeriod: 5s
 	require.True(t, *cfg.KeepAliveEnabled)
 	require.Equal(t, 5*time.Second, *cfg.KeepAlivePeriod)
 
-	opts := cfg.NewOptions(instrument.NewOptions())
+	opts, err := cfg.NewOptions(instrument.NewOptions())
+	require.NoError(t, err)
 	require.Equal(t, 5*time.Second, opts.TCPConnectionKeepAlivePeriod())
 	require.True(t, opts.TCPConnectionKeepAlive())
+	require.Nil(t, opts.TLSConfig())
 
-	require.NotNil(t, cfg.NewServer(nil, instrument.NewOptions()))
+	s, err := cfg.NewServer(nil, instrument.NewOptions())
+	require.NoError(t, err)
+	require.NotNil(t, s)
+}
+
+func TestServerConfigurationTLSError(t *testing.T) {
+	str := `
+listenAddress: addr
+tls:
+  certFile: /does/not/exist
+  keyFile: /does/not/exist
+`
+
+	var cfg Configuration
+	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
+
+	_, err := cfg.NewServer(nil, instrument.NewOptions())
+	require.Error(t, err)
 }
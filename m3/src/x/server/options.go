@@ -21,6 +21,7 @@
 package server
 
 import (
+	"crypto/tls"
 	"time"
 
 	"github.com/m3db/m3/src/x/instrument"
@@ -71,6 +72,12 @@ type Options interface {
 
 	// ListenerOptions sets the listener options for the server.
 	ListenerOptions() xnet.ListenerOptions
+
+	// SetTLSConfig sets the TLS config for connections, nil disables TLS.
+	SetTLSConfig(value *tls.Config) Options
+
+	// TLSConfig returns the TLS config for connections, nil disables TLS.
+	TLSConfig() *tls.Config
 }
 
 type options struct {
@@ -79,6 +86,7 @@ type options struct {
 	tcpConnectionKeepAlive       bool
 	tcpConnectionKeepAlivePeriod time.Duration
 	listenerOpts                 xnet.ListenerOptions
+	tlsConfig                    *tls.Config
 }
 
 // NewOptions creates a new set of server options
@@ -141,3 +149,13 @@ func (o *options) SetListenerOptions(value xnet.ListenerOptions) Options {
 func (o *options) ListenerOptions() xnet.ListenerOptions {
 	return o.listenerOpts
 }
+
+func (o *options) SetTLSConfig(value *tls.Config) Options {
+	opts := *o
+	opts.tlsConfig = value
+	return &opts
+}
+
+func (o *options) TLSConfig() *tls.Config {
+	return o.tlsConfig
+}
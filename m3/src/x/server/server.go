@@ -22,6 +22,7 @@
 package server
 
 import (
+	"crypto/tls"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -91,6 +92,7 @@ type server struct {
 	metrics      serverMetrics
 	handler      Handler
 	listenerOpts xnet.ListenerOptions
+	tlsConfig    *tls.Config
 
 	addConnectionFn    addConnectionFn
 	removeConnectionFn removeConnectionFn
@@ -112,6 +114,7 @@ func NewServer(address string, handler Handler, opts Options) Server {
 		metrics:                      newServerMetrics(scope),
 		handler:                      handler,
 		listenerOpts:                 opts.ListenerOptions(),
+		tlsConfig:                    opts.TLSConfig(),
 	}
 
 	// Set up the connection functions.
@@ -150,6 +153,9 @@ func (s *server) serve() {
 				tcpConn.SetKeepAlivePeriod(s.tcpConnectionKeepAlivePeriod)
 			}
 		}
+		if s.tlsConfig != nil {
+			conn = tls.Server(conn, s.tlsConfig)
+		}
 		if !s.addConnectionFn(conn) {
 			conn.Close()
 		} else {
@@ -22,6 +22,7 @@
 package tcp
 
 import (
+	"crypto/tls"
 	"net"
 	"time"
 )
@@ -55,3 +56,13 @@ func (ln tcpKeepAliveListener) Accept() (c net.Conn, err error) {
 	tc.SetKeepAlivePeriod(ln.keepAlivePeriod)
 	return tc, nil
 }
+
+// NewTLSListener returns a listener accepting TLS connections over the
+// connections accepted by the given listener, or the listener itself if the
+// TLS config is nil so that servers can make TLS optional.
+func NewTLSListener(l net.Listener, tlsConfig *tls.Config) net.Listener {
+	if tlsConfig == nil {
+		return l
+	}
+	return tls.NewListener(l, tlsConfig)
+}
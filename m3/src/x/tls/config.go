@@ -0,0 +1,194 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package tls provides TLS configuration with certificate reloading for
+// servers and clients.
+package tls
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+// ClientAuthType is the policy of servers for client certificates.
+type ClientAuthType int
+
+const (
+	// NoClientCert does not request client certificates.
+	NoClientCert ClientAuthType = iota
+
+	// RequestClientCert requests client certificates without requiring or
+	// verifying them.
+	RequestClientCert
+
+	// RequireAnyClientCert requires client certificates without verifying them.
+	RequireAnyClientCert
+
+	// VerifyClientCertIfGiven verifies client certificates if given.
+	VerifyClientCertIfGiven
+
+	// RequireAndVerifyClientCert requires and verifies client certificates,
+	// authenticating clients with mutual TLS.
+	RequireAndVerifyClientCert
+)
+
+var (
+	validClientAuthTypes = []ClientAuthType{
+		NoClientCert,
+		RequestClientCert,
+		RequireAnyClientCert,
+		VerifyClientCertIfGiven,
+		RequireAndVerifyClientCert,
+	}
+
+	errClientAuthTypeUnspecified = errors.New("client auth type not specified")
+	errClientAuthTypeInvalid     = errors.New("client auth type invalid")
+)
+
+// String returns the client auth type as a string.
+func (t ClientAuthType) String() string {
+	switch t {
+	case NoClientCert:
+		return "none"
+	case RequestClientCert:
+		return "request"
+	case RequireAnyClientCert:
+		return "requireAny"
+	case VerifyClientCertIfGiven:
+		return "verifyIfGiven"
+	case RequireAndVerifyClientCert:
+		return "requireAndVerify"
+	}
+	return "unknown"
+}
+
+// Validate returns nil when the client auth type is valid, otherwise it
+// returns an error.
+func (t ClientAuthType) Validate() error {
+	for _, valid := range validClientAuthTypes {
+		if valid == t {
+			return nil
+		}
+	}
+	return errClientAuthTypeInvalid
+}
+
+// UnmarshalYAML unmarshals a ClientAuthType into a valid type from string.
+func (t *ClientAuthType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == "" {
+		return errClientAuthTypeUnspecified
+	}
+	strs := make([]string, 0, len(validClientAuthTypes))
+	for _, valid := range validClientAuthTypes {
+		if str == valid.String() {
+			*t = valid
+			return nil
+		}
+		strs = append(strs, "'"+valid.String()+"'")
+	}
+	return fmt.Errorf("invalid ClientAuthType '%s' valid types are: %s",
+		str, strings.Join(strs, ", "))
+}
+
+func (t ClientAuthType) tlsClientAuth() tls.ClientAuthType {
+	switch t {
+	case RequestClientCert:
+		return tls.RequestClientCert
+	case RequireAnyClientCert:
+		return tls.RequireAnyClientCert
+	case VerifyClientCertIfGiven:
+		return tls.VerifyClientCertIfGiven
+	case RequireAndVerifyClientCert:
+		return tls.RequireAndVerifyClientCert
+	}
+	return tls.NoClientCert
+}
+
+// Configuration configs TLS.
+type Configuration struct {
+	// CertFile is the path to the PEM encoded certificate presented to peers.
+	CertFile string `yaml:"certFile"`
+
+	// KeyFile is the path to the PEM encoded private key of the certificate.
+	KeyFile string `yaml:"keyFile"`
+
+	// CAFile is the path to the PEM encoded CA certificates used to verify
+	// peer certificates, the system roots are used if not set.
+	CAFile string `yaml:"caFile"`
+
+	// ClientAuth is the policy of servers for client certificates.
+	ClientAuth *ClientAuthType `yaml:"clientAuth"`
+
+	// ServerName is the name clients verify server certificates against,
+	// the host of the address dialed is used if not set.
+	ServerName string `yaml:"serverName"`
+
+	// InsecureSkipVerify skips verifying server certificates on clients.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify"`
+
+	// ReloadInterval is the interval at which the certificate, key and CA
+	// files are checked for changes, zero disables reloading.
+	ReloadInterval *time.Duration `yaml:"reloadInterval"`
+}
+
+// NewOptions creates TLS options.
+func (c Configuration) NewOptions(iOpts instrument.Options) Options {
+	opts := NewOptions().
+		SetCertFile(c.CertFile).
+		SetKeyFile(c.KeyFile).
+		SetCAFile(c.CAFile).
+		SetServerName(c.ServerName).
+		SetInsecureSkipVerify(c.InsecureSkipVerify).
+		SetInstrumentOptions(iOpts)
+	if c.ClientAuth != nil {
+		opts = opts.SetClientAuth(*c.ClientAuth)
+	}
+	if c.ReloadInterval != nil {
+		opts = opts.SetReloadInterval(*c.ReloadInterval)
+	}
+	return opts
+}
+
+// NewServerConfig creates a TLS config for servers.
+func (c Configuration) NewServerConfig(iOpts instrument.Options) (*tls.Config, error) {
+	m, err := NewManager(c.NewOptions(iOpts))
+	if err != nil {
+		return nil, err
+	}
+	return m.ServerConfig()
+}
+
+// NewClientConfig creates a TLS config for clients.
+func (c Configuration) NewClientConfig(iOpts instrument.Options) (*tls.Config, error) {
+	m, err := NewManager(c.NewOptions(iOpts))
+	if err != nil {
+		return nil, err
+	}
+	return m.ClientConfig(), nil
+}
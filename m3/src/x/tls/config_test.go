@@ -0,0 +1,65 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tls
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/stretchr/testify/require"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func TestConfiguration(t *testing.T) {
+	str := `
+certFile: /path/to/cert
+keyFile: /path/to/key
+caFile: /path/to/ca
+clientAuth: requireAndVerify
+serverName: m3
+reloadInterval: 10s
+`
+
+	var cfg Configuration
+	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
+
+	opts := cfg.NewOptions(instrument.NewOptions())
+	require.Equal(t, "/path/to/cert", opts.CertFile())
+	require.Equal(t, "/path/to/key", opts.KeyFile())
+	require.Equal(t, "/path/to/ca", opts.CAFile())
+	require.Equal(t, RequireAndVerifyClientCert, opts.ClientAuth())
+	require.Equal(t, "m3", opts.ServerName())
+	require.False(t, opts.InsecureSkipVerify())
+	require.Equal(t, 10*time.Second, opts.ReloadInterval())
+}
+
+func TestClientAuthTypeUnmarshalYAML(t *testing.T) {
+	for _, valid := range validClientAuthTypes {
+		var clientAuth ClientAuthType
+		require.NoError(t, yaml.Unmarshal([]byte(valid.String()), &clientAuth))
+		require.Equal(t, valid, clientAuth)
+	}
+
+	var clientAuth ClientAuthType
+	require.Error(t, yaml.Unmarshal([]byte("always"), &clientAuth))
+}
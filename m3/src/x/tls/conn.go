@@ -0,0 +1,82 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tls
+
+import (
+	"crypto/tls"
+	"net"
+	"time"
+)
+
+// NewClientConn performs a TLS handshake as a client on a connection to the
+// address within the timeout, verifying the server certificate against the
+// host of the address unless the config has a server name.
+func NewClientConn(
+	conn net.Conn,
+	config *tls.Config,
+	address string,
+	timeout time.Duration,
+) (net.Conn, error) {
+	config, err := ClientConfigForAddress(config, address)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConn := tls.Client(conn, config)
+	if timeout > 0 {
+		if err := tlsConn.SetDeadline(time.Now().Add(timeout)); err != nil {
+			return nil, err
+		}
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, err
+	}
+	if err := tlsConn.SetDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+// ClientConfigForAddress returns the client config to connect to the address
+// with, verifying the server certificate against the host of the address
+// unless the config has a server name.
+func ClientConfigForAddress(config *tls.Config, address string) (*tls.Config, error) {
+	if config.ServerName != "" {
+		return config, nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	config = config.Clone()
+	config.ServerName = host
+	if verify := config.VerifyConnection; verify != nil {
+		// NB: the connection state only has the server name sent to the
+		// server, which excludes IP addresses.
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			if state.ServerName == "" {
+				state.ServerName = host
+			}
+			return verify(state)
+		}
+	}
+	return config, nil
+}
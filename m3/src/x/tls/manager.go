@@ -0,0 +1,273 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tls
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/x/clock"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+var (
+	errNoCertificate     = errors.New("no certificate configured")
+	errNoPeerCertificate = errors.New("no server certificate presented")
+	errNoServerName      = errors.New("no server name to verify the server certificate against")
+)
+
+// Manager loads certificates and CA certificates from files, reloading them
+// once they change, and creates TLS configs that use the latest ones.
+type Manager interface {
+	// ServerConfig returns a TLS config for servers, the certificate and the
+	// CA certificates used to verify clients are reloaded on handshakes.
+	ServerConfig() (*tls.Config, error)
+
+	// ClientConfig returns a TLS config for clients, the certificate and the
+	// CA certificates used to verify servers are reloaded on handshakes. The
+	// config verifies servers against its server name, connections made to
+	// an address should use ClientConfigForAddress when it has none.
+	ClientConfig() *tls.Config
+}
+
+type managerMetrics struct {
+	reloaded    tally.Counter
+	reloadError tally.Counter
+}
+
+func newManagerMetrics(scope tally.Scope) managerMetrics {
+	scope = scope.SubScope("tls")
+	return managerMetrics{
+		reloaded:    scope.Counter("reloaded"),
+		reloadError: scope.Counter("reload-error"),
+	}
+}
+
+type manager struct {
+	sync.RWMutex
+
+	opts    Options
+	nowFn   clock.NowFn
+	logger  *zap.Logger
+	metrics managerMetrics
+
+	loaded    bool
+	lastCheck time.Time
+	certPEM   []byte
+	keyPEM    []byte
+	caPEM     []byte
+	cert      *tls.Certificate
+	caPool    *x509.CertPool
+}
+
+// NewManager creates a new TLS manager, returning an error if the files
+// cannot be loaded.
+func NewManager(opts Options) (Manager, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	iOpts := opts.InstrumentOptions()
+	m := &manager{
+		opts:    opts,
+		nowFn:   opts.ClockOptions().NowFn(),
+		logger:  iOpts.Logger(),
+		metrics: newManagerMetrics(iOpts.MetricsScope()),
+	}
+	if err := m.reloadWithLock(); err != nil {
+		return nil, err
+	}
+	m.lastCheck = m.nowFn()
+	return m, nil
+}
+
+func (m *manager) ServerConfig() (*tls.Config, error) {
+	if m.opts.CertFile() == "" {
+		return nil, errNoServerCert
+	}
+	clientAuth := m.opts.ClientAuth().tlsClientAuth()
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, caPool := m.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   clientAuth,
+				ClientCAs:    caPool,
+			}, nil
+		},
+	}, nil
+}
+
+func (m *manager) ClientConfig() *tls.Config {
+	// NB: the default verification uses the CA certificates of the config,
+	// which cannot be swapped once the config is in use, so skip it and
+	// verify servers against the current CA certificates instead.
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         m.opts.ServerName(),
+		InsecureSkipVerify: true,
+	}
+	if !m.opts.InsecureSkipVerify() {
+		serverName := m.opts.ServerName()
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			if state.ServerName == "" {
+				state.ServerName = serverName
+			}
+			_, caPool := m.current()
+			return verifyServer(state, caPool)
+		}
+	}
+	if m.opts.CertFile() != "" {
+		config.GetClientCertificate = func(
+			*tls.CertificateRequestInfo,
+		) (*tls.Certificate, error) {
+			cert, _ := m.current()
+			if cert == nil {
+				return nil, errNoCertificate
+			}
+			return cert, nil
+		}
+	}
+	return config
+}
+
+// verifyServer verifies the certificate chain presented by a server against
+// the CA certificates, or the system ones if nil, and the server name the
+// connection was made to, as done by default by clients.
+func verifyServer(state tls.ConnectionState, caPool *x509.CertPool) error {
+	if state.ServerName == "" {
+		return errNoServerName
+	}
+	if len(state.PeerCertificates) == 0 {
+		return errNoPeerCertificate
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       state.ServerName,
+		Roots:         caPool,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
+// current returns the current certificate and CA certificates, reloading
+// them first if the reload interval has passed since they were last checked.
+func (m *manager) current() (*tls.Certificate, *x509.CertPool) {
+	if interval := m.opts.ReloadInterval(); interval > 0 {
+		now := m.nowFn()
+		m.RLock()
+		due := now.Sub(m.lastCheck) >= interval
+		m.RUnlock()
+		if due {
+			m.maybeReload(now, interval)
+		}
+	}
+
+	m.RLock()
+	cert, caPool := m.cert, m.caPool
+	m.RUnlock()
+	return cert, caPool
+}
+
+func (m *manager) maybeReload(now time.Time, interval time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+
+	// Check again since another handshake may have reloaded concurrently.
+	if now.Sub(m.lastCheck) < interval {
+		return
+	}
+	m.lastCheck = now
+	if err := m.reloadWithLock(); err != nil {
+		m.metrics.reloadError.Inc(1)
+		m.logger.Error("could not reload TLS certificates, using previous ones",
+			zap.Error(err))
+	}
+}
+
+func (m *manager) reloadWithLock() error {
+	certPEM, err := readFile(m.opts.CertFile())
+	if err != nil {
+		return err
+	}
+	keyPEM, err := readFile(m.opts.KeyFile())
+	if err != nil {
+		return err
+	}
+	caPEM, err := readFile(m.opts.CAFile())
+	if err != nil {
+		return err
+	}
+
+	if m.loaded {
+		if bytes.Equal(certPEM, m.certPEM) &&
+			bytes.Equal(keyPEM, m.keyPEM) &&
+			bytes.Equal(caPEM, m.caPEM) {
+			return nil
+		}
+	}
+
+	var cert *tls.Certificate
+	if len(certPEM) > 0 {
+		c, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("could not load certificate %s: %v",
+				m.opts.CertFile(), err)
+		}
+		cert = &c
+	}
+
+	var caPool *x509.CertPool
+	if len(caPEM) > 0 {
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPEM) {
+			return fmt.Errorf("could not load CA certificates %s", m.opts.CAFile())
+		}
+	}
+
+	if m.loaded {
+		m.metrics.reloaded.Inc(1)
+		m.logger.Info("reloaded TLS certificates")
+	}
+	m.loaded = true
+	m.certPEM, m.keyPEM, m.caPEM = certPEM, keyPEM, caPEM
+	m.cert, m.caPool = cert, caPool
+	return nil
+}
+
+func readFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return ioutil.ReadFile(path)
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tls
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/tls/tlstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOptions(f tlstest.Files) Options {
+	return NewOptions().
+		SetCertFile(f.CertFile).
+		SetKeyFile(f.KeyFile).
+		SetCAFile(f.CAFile)
+}
+
+// handshake performs a handshake between a server and a client and returns
+// the serial number of the server certificate seen by the client.
+func handshake(
+	t *testing.T,
+	serverConfig *tls.Config,
+	clientConfig *tls.Config,
+) (int64, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	serverErrCh := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErrCh <- err
+			return
+		}
+		defer conn.Close()
+		serverErrCh <- conn.(*tls.Conn).Handshake()
+	}()
+
+	address := listener.Addr().String()
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	tlsConn, err := NewClientConn(conn, clientConfig, address, 5*time.Second)
+	if err == nil {
+		// Wait for the server to verify the client certificate.
+		err = <-serverErrCh
+	}
+	if err != nil {
+		return 0, err
+	}
+	state := tlsConn.(*tls.Conn).ConnectionState()
+	return state.PeerCertificates[0].SerialNumber.Int64(), nil
+}
+
+func TestManagerMutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := tlstest.NewCA(t, 1)
+	serverFiles := tlstest.NewFiles(dir, "server")
+	serverFiles.WriteIssued(t, ca, 2)
+	clientFiles := tlstest.NewFiles(dir, "client")
+	clientCert, clientKey := ca.Issue(t, 3)
+	clientFiles.Write(t, clientCert, clientKey, ca.PEM)
+
+	server, err := NewManager(newTestOptions(serverFiles).
+		SetClientAuth(RequireAndVerifyClientCert))
+	require.NoError(t, err)
+	serverConfig, err := server.ServerConfig()
+	require.NoError(t, err)
+
+	client, err := NewManager(newTestOptions(clientFiles))
+	require.NoError(t, err)
+	serial, err := handshake(t, serverConfig, client.ClientConfig())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), serial)
+
+	// Clients without a certificate are rejected.
+	noCert, err := NewManager(NewOptions().SetCAFile(clientFiles.CAFile))
+	require.NoError(t, err)
+	_, err = handshake(t, serverConfig, noCert.ClientConfig())
+	require.Error(t, err)
+
+	// Clients that do not trust the server CA reject the server.
+	untrusted := tlstest.NewCA(t, 4)
+	untrustedFiles := tlstest.NewFiles(dir, "untrusted")
+	untrustedFiles.Write(t, clientCert, clientKey, untrusted.PEM)
+	untrustedClient, err := NewManager(newTestOptions(untrustedFiles))
+	require.NoError(t, err)
+	_, err = handshake(t, serverConfig, untrustedClient.ClientConfig())
+	require.Error(t, err)
+
+	// Clients reject servers whose certificate is not for the server name.
+	otherName, err := NewManager(newTestOptions(clientFiles).
+		SetServerName("example.com"))
+	require.NoError(t, err)
+	_, err = handshake(t, serverConfig, otherName.ClientConfig())
+	require.Error(t, err)
+
+	// Clients skipping verification accept any server.
+	insecure, err := NewManager(newTestOptions(untrustedFiles).
+		SetInsecureSkipVerify(true))
+	require.NoError(t, err)
+	_, err = handshake(t, serverConfig, insecure.ClientConfig())
+	require.NoError(t, err)
+}
+
+func TestManagerReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := tlstest.NewCA(t, 1)
+	serverFiles := tlstest.NewFiles(dir, "server")
+	serverFiles.WriteIssued(t, ca, 2)
+	clientFiles := tlstest.NewFiles(dir, "client")
+	clientFiles.WriteIssued(t, ca, 3)
+
+	now := time.Now()
+	clockOpts := clock.NewOptions().SetNowFn(func() time.Time {
+		return now
+	})
+	server, err := NewManager(newTestOptions(serverFiles).
+		SetClientAuth(RequireAndVerifyClientCert).
+		SetReloadInterval(time.Minute).
+		SetClockOptions(clockOpts))
+	require.NoError(t, err)
+	serverConfig, err := server.ServerConfig()
+	require.NoError(t, err)
+
+	client, err := NewManager(newTestOptions(clientFiles))
+	require.NoError(t, err)
+	clientConfig := client.ClientConfig()
+
+	// Rotate the server certificate.
+	rotatedCert, rotatedKey := ca.Issue(t, 5)
+	serverFiles.Write(t, rotatedCert, rotatedKey, ca.PEM)
+
+	// The previous certificate is used until the reload interval passes.
+	serial, err := handshake(t, serverConfig, clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), serial)
+
+	now = now.Add(time.Minute)
+	serial, err = handshake(t, serverConfig, clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), serial)
+
+	// Invalid files are not loaded and the previous certificate is used.
+	serverFiles.Write(t, []byte("invalid"), rotatedKey, ca.PEM)
+	now = now.Add(time.Minute)
+	serial, err = handshake(t, serverConfig, clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), serial)
+}
+
+func TestManagerReloadCA(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := tlstest.NewCA(t, 1)
+	serverFiles := tlstest.NewFiles(dir, "server")
+	serverFiles.WriteIssued(t, ca, 2)
+	server, err := NewManager(newTestOptions(serverFiles))
+	require.NoError(t, err)
+	serverConfig, err := server.ServerConfig()
+	require.NoError(t, err)
+
+	now := time.Now()
+	clockOpts := clock.NewOptions().SetNowFn(func() time.Time {
+		return now
+	})
+	clientFiles := tlstest.NewFiles(dir, "client")
+	clientFiles.Write(t, nil, nil, ca.PEM)
+	client, err := NewManager(NewOptions().
+		SetCAFile(clientFiles.CAFile).
+		SetReloadInterval(time.Minute).
+		SetClockOptions(clockOpts))
+	require.NoError(t, err)
+	clientConfig := client.ClientConfig()
+
+	serial, err := handshake(t, serverConfig, clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), serial)
+
+	// Rotate the CA of the server, the config created before the rotation
+	// verifies the server once the client reloads the CA certificates.
+	rotated := tlstest.NewCA(t, 3)
+	serverFiles.WriteIssued(t, rotated, 4)
+	clientFiles.Write(t, nil, nil, rotated.PEM)
+	rotatedServer, err := NewManager(newTestOptions(serverFiles))
+	require.NoError(t, err)
+	rotatedConfig, err := rotatedServer.ServerConfig()
+	require.NoError(t, err)
+
+	_, err = handshake(t, rotatedConfig, clientConfig)
+	require.Error(t, err)
+
+	now = now.Add(time.Minute)
+	serial, err = handshake(t, rotatedConfig, clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), serial)
+
+	_, err = handshake(t, serverConfig, clientConfig)
+	require.Error(t, err)
+}
+
+func TestNewManagerErrors(t *testing.T) {
+	_, err := NewManager(NewOptions().SetCertFile("cert"))
+	require.Equal(t, errCertWithoutKey, err)
+
+	_, err = NewManager(NewOptions().SetCAFile("/does/not/exist"))
+	require.Error(t, err)
+
+	m, err := NewManager(NewOptions())
+	require.NoError(t, err)
+	_, err = m.ServerConfig()
+	require.Equal(t, errNoServerCert, err)
+}
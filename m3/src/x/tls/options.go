@@ -0,0 +1,226 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tls
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	// By default certificates are checked for changes every minute.
+	defaultReloadInterval = time.Minute
+)
+
+var (
+	errCertWithoutKey = errors.New("certificate file and key file must be set together")
+	errNoServerCert   = errors.New("certificate file and key file must be set for servers")
+)
+
+// Options provide a set of TLS options.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetCertFile sets the path to the PEM encoded certificate presented to peers.
+	SetCertFile(value string) Options
+
+	// CertFile returns the path to the PEM encoded certificate presented to peers.
+	CertFile() string
+
+	// SetKeyFile sets the path to the PEM encoded private key of the certificate.
+	SetKeyFile(value string) Options
+
+	// KeyFile returns the path to the PEM encoded private key of the certificate.
+	KeyFile() string
+
+	// SetCAFile sets the path to the PEM encoded CA certificates used to
+	// verify peer certificates.
+	SetCAFile(value string) Options
+
+	// CAFile returns the path to the PEM encoded CA certificates used to
+	// verify peer certificates.
+	CAFile() string
+
+	// SetClientAuth sets the policy of servers for client certificates.
+	SetClientAuth(value ClientAuthType) Options
+
+	// ClientAuth returns the policy of servers for client certificates.
+	ClientAuth() ClientAuthType
+
+	// SetServerName sets the name clients verify server certificates
+	// against, instead of the host of the address dialed.
+	SetServerName(value string) Options
+
+	// ServerName returns the name clients verify server certificates
+	// against, instead of the host of the address dialed.
+	ServerName() string
+
+	// SetInsecureSkipVerify sets whether clients skip verifying server
+	// certificates.
+	SetInsecureSkipVerify(value bool) Options
+
+	// InsecureSkipVerify returns whether clients skip verifying server
+	// certificates.
+	InsecureSkipVerify() bool
+
+	// SetReloadInterval sets the interval at which the certificate, key and
+	// CA files are checked for changes, zero disables reloading.
+	SetReloadInterval(value time.Duration) Options
+
+	// ReloadInterval returns the interval at which the certificate, key and
+	// CA files are checked for changes, zero disables reloading.
+	ReloadInterval() time.Duration
+
+	// SetClockOptions sets the clock options.
+	SetClockOptions(value clock.Options) Options
+
+	// ClockOptions returns the clock options.
+	ClockOptions() clock.Options
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+}
+
+type options struct {
+	certFile           string
+	keyFile            string
+	caFile             string
+	clientAuth         ClientAuthType
+	serverName         string
+	insecureSkipVerify bool
+	reloadInterval     time.Duration
+	clockOpts          clock.Options
+	instrumentOpts     instrument.Options
+}
+
+// NewOptions creates a new set of TLS options.
+func NewOptions() Options {
+	return &options{
+		clientAuth:     NoClientCert,
+		reloadInterval: defaultReloadInterval,
+		clockOpts:      clock.NewOptions(),
+		instrumentOpts: instrument.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if (o.certFile == "") != (o.keyFile == "") {
+		return errCertWithoutKey
+	}
+	return o.clientAuth.Validate()
+}
+
+func (o *options) SetCertFile(value string) Options {
+	opts := *o
+	opts.certFile = value
+	return &opts
+}
+
+func (o *options) CertFile() string {
+	return o.certFile
+}
+
+func (o *options) SetKeyFile(value string) Options {
+	opts := *o
+	opts.keyFile = value
+	return &opts
+}
+
+func (o *options) KeyFile() string {
+	return o.keyFile
+}
+
+func (o *options) SetCAFile(value string) Options {
+	opts := *o
+	opts.caFile = value
+	return &opts
+}
+
+func (o *options) CAFile() string {
+	return o.caFile
+}
+
+func (o *options) SetClientAuth(value ClientAuthType) Options {
+	opts := *o
+	opts.clientAuth = value
+	return &opts
+}
+
+func (o *options) ClientAuth() ClientAuthType {
+	return o.clientAuth
+}
+
+func (o *options) SetServerName(value string) Options {
+	opts := *o
+	opts.serverName = value
+	return &opts
+}
+
+func (o *options) ServerName() string {
+	return o.serverName
+}
+
+func (o *options) SetInsecureSkipVerify(value bool) Options {
+	opts := *o
+	opts.insecureSkipVerify = value
+	return &opts
+}
+
+func (o *options) InsecureSkipVerify() bool {
+	return o.insecureSkipVerify
+}
+
+func (o *options) SetReloadInterval(value time.Duration) Options {
+	opts := *o
+	opts.reloadInterval = value
+	return &opts
+}
+
+func (o *options) ReloadInterval() time.Duration {
+	return o.reloadInterval
+}
+
+func (o *options) SetClockOptions(value clock.Options) Options {
+	opts := *o
+	opts.clockOpts = value
+	return &opts
+}
+
+func (o *options) ClockOptions() clock.Options {
+	return o.clockOpts
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
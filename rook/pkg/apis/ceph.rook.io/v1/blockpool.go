@@ -31,3 +31,11 @@ func (p *PoolSpec) IsCompressionEnabled() bool {
 func (p *ReplicatedSpec) IsTargetRatioEnabled() bool {
 	return p.TargetSizeRatio != 0
 }
+
+func (p *PoolSpec) IsMirroringEnabled() bool {
+	return p.Mirroring.Enabled
+}
+
+func (p *MirroringSpec) SnapshotSchedulesEnabled() bool {
+	return len(p.SnapshotSchedules) > 0
+}
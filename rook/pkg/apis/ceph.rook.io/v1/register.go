@@ -62,6 +62,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CephBlockPoolList{},
 		&CephFilesystem{},
 		&CephFilesystemList{},
+		&CephFilesystemSubVolumeGroup{},
+		&CephFilesystemSubVolumeGroupList{},
 		&CephNFS{},
 		&CephNFSList{},
 		&CephObjectStore{},
@@ -74,6 +76,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CephObjectZoneGroupList{},
 		&CephObjectZone{},
 		&CephObjectZoneList{},
+		&CephBucketTopic{},
+		&CephBucketTopicList{},
+		&CephBucketNotification{},
+		&CephBucketNotificationList{},
 		&CephRBDMirror{},
 		&CephRBDMirrorList{},
 	)
@@ -21,6 +21,7 @@ import (
 
 	rookv1 "github.com/rook/rook/pkg/apis/rook.io/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -108,6 +109,11 @@ type ClusterSpec struct {
 	// Indicates user intent when deleting a cluster; blocks orchestration and should not be set if cluster
 	// deletion is not imminent.
 	CleanupPolicy CleanupPolicySpec `json:"cleanupPolicy,omitempty"`
+
+	// Ceph config options to apply in the centralized mon configuration database, keyed by daemon section
+	// such as "global", "osd" or "osd.0", then by option name
+	// +optional
+	CephConfig map[string]map[string]string `json:"cephConfig,omitempty"`
 }
 
 // VersionSpec represents the settings for the Ceph version that Rook is orchestrating.
@@ -191,6 +197,10 @@ const (
 	ConditionUpgrading   ConditionType = "Upgrading"
 	ConditionDeleting    ConditionType = "Deleting"
 	ConditionHealthy     ConditionType = "Healthy"
+	// ConditionCephConfigDrift is true when the cephConfig options were changed outside of the cluster spec
+	ConditionCephConfigDrift ConditionType = "CephConfigDrift"
+	// ConditionCephConfigInvalid is true when the cephConfig has unknown or invalid options
+	ConditionCephConfigInvalid ConditionType = "CephConfigInvalid"
 	// DefaultFailureDomain for PoolSpec
 	DefaultFailureDomain = "host"
 )
@@ -240,8 +250,8 @@ type CrashCollectorSpec struct {
 type CephBlockPool struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
-	Spec              PoolSpec `json:"spec"`
-	Status            *Status  `json:"status"`
+	Spec              PoolSpec             `json:"spec"`
+	Status            *CephBlockPoolStatus `json:"status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -273,12 +283,143 @@ type PoolSpec struct {
 
 	// Parameters is a list of properties to enable on a given pool
 	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// The mirroring settings, only supported by the CephBlockPool CR
+	Mirroring MirroringSpec `json:"mirroring,omitempty"`
+
+	// The quota settings
+	Quotas QuotaSpec `json:"quotas,omitempty"`
+}
+
+// QuotaSpec represents the quotas of a pool, a quota that is not specified or zero is removed
+type QuotaSpec struct {
+	// MaxBytes is the maximum number of bytes stored in the pool
+	MaxBytes *uint64 `json:"maxBytes,omitempty"`
+
+	// MaxObjects is the maximum number of objects stored in the pool
+	MaxObjects *uint64 `json:"maxObjects,omitempty"`
+}
+
+// MirroringSpec represents the mirroring settings of a pool
+type MirroringSpec struct {
+	// Enabled whether the pool is mirrored to the peer clusters
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Mode is the mirroring mode: either "pool" to mirror all the images or "image" to mirror only the images enabled for mirroring
+	Mode string `json:"mode,omitempty"`
+
+	// SnapshotSchedules are the intervals at which mirror snapshots are taken for snapshot-based mirroring
+	SnapshotSchedules []SnapshotScheduleSpec `json:"snapshotSchedules,omitempty"`
+
+	// Peers are the peer clusters the pool is mirrored with
+	Peers MirroringPeerSpec `json:"peers,omitempty"`
+}
+
+// SnapshotScheduleSpec represents a snapshot schedule
+type SnapshotScheduleSpec struct {
+	// Path to snapshot, only used by filesystem snapshot schedules, defaults to the root of the filesystem or subvolume group
+	Path string `json:"path,omitempty"`
+
+	// Interval between snapshots, with a "d", "h" or "m" suffix, e.g. "24h"
+	Interval string `json:"interval,omitempty"`
+
+	// StartTime of the schedule in ISO 8601 format, e.g. "14:00:00-05:00"
+	StartTime string `json:"startTime,omitempty"`
+}
+
+// MirroringPeerSpec represents the peer clusters of a mirrored pool
+type MirroringPeerSpec struct {
+	// SecretNames are the names of the secrets holding the bootstrap peer tokens of the peer clusters
+	SecretNames []string `json:"secretNames,omitempty"`
 }
 
 type Status struct {
 	Phase string `json:"phase,omitempty"`
 }
 
+// CephBlockPoolStatus represents the status of a pool
+type CephBlockPoolStatus struct {
+	Phase string `json:"phase,omitempty"`
+
+	// MirroringStatus is the mirroring health of the pool
+	MirroringStatus *MirroringStatusSpec `json:"mirroringStatus,omitempty"`
+
+	// Info holds the names of the resources created for the pool, e.g. the bootstrap peer token secret
+	Info map[string]string `json:"info,omitempty"`
+
+	// PoolStatus is the usage, placement group states and pg autoscaler status of the pool
+	PoolStatus *PoolStatus `json:"poolStatus,omitempty"`
+}
+
+// PoolStatus represents the usage, placement group states and pg autoscaler status of a pool
+type PoolStatus struct {
+	Usage       *PoolUsageStatus     `json:"usage,omitempty"`
+	PGs         *PoolPGStatus        `json:"pgs,omitempty"`
+	Autoscale   *PoolAutoscaleStatus `json:"autoscale,omitempty"`
+	LastChecked string               `json:"lastChecked,omitempty"`
+	Details     string               `json:"details,omitempty"`
+}
+
+// PoolUsageStatus represents the capacity usage of a pool
+type PoolUsageStatus struct {
+	// StoredBytes is the amount of data stored by clients
+	StoredBytes uint64 `json:"storedBytes"`
+
+	// UsedBytes is the raw capacity used including replication or erasure coding overhead
+	UsedBytes uint64 `json:"usedBytes"`
+
+	// MaxAvailableBytes is the amount of data that can still be stored in the pool
+	MaxAvailableBytes uint64 `json:"maxAvailableBytes"`
+
+	// Objects is the number of objects stored in the pool
+	Objects uint64 `json:"objects"`
+
+	// PercentUsed is the percentage of the capacity available to the pool that is used
+	PercentUsed float64 `json:"percentUsed"`
+
+	// QuotaMaxBytes is the quota in bytes of the pool, zero if not set
+	QuotaMaxBytes uint64 `json:"quotaMaxBytes,omitempty"`
+
+	// QuotaMaxObjects is the quota in objects of the pool, zero if not set
+	QuotaMaxObjects uint64 `json:"quotaMaxObjects,omitempty"`
+
+	// PercentOfQuotaBytes is the percentage of the bytes quota that is stored
+	PercentOfQuotaBytes float64 `json:"percentOfQuotaBytes,omitempty"`
+
+	// PercentOfQuotaObjects is the percentage of the objects quota that is stored
+	PercentOfQuotaObjects float64 `json:"percentOfQuotaObjects,omitempty"`
+}
+
+// PoolPGStatus represents the placement group states of a pool
+type PoolPGStatus struct {
+	// Total is the number of placement groups of the pool
+	Total int `json:"total"`
+
+	// States is the number of placement groups per state, e.g. "active+clean"
+	States map[string]int `json:"states,omitempty"`
+}
+
+// PoolAutoscaleStatus represents the pg autoscaler status of a pool
+type PoolAutoscaleStatus struct {
+	Mode        string  `json:"mode,omitempty"`
+	PgNumTarget int     `json:"pgNumTarget"`
+	PgNumIdeal  int     `json:"pgNumIdeal"`
+	TargetBytes uint64  `json:"targetBytes,omitempty"`
+	TargetRatio float64 `json:"targetRatio,omitempty"`
+	WouldAdjust bool    `json:"wouldAdjust"`
+}
+
+// MirroringStatusSpec represents the mirroring health of a pool
+type MirroringStatusSpec struct {
+	Health       string         `json:"health,omitempty"`
+	DaemonHealth string         `json:"daemonHealth,omitempty"`
+	ImageHealth  string         `json:"imageHealth,omitempty"`
+	States       map[string]int `json:"states,omitempty"`
+	LastChecked  string         `json:"lastChecked,omitempty"`
+	LastChanged  string         `json:"lastChanged,omitempty"`
+	Details      string         `json:"details,omitempty"`
+}
+
 // ReplicatedSpec represents the spec for replication in a pool
 type ReplicatedSpec struct {
 	// Size - Number of copies per object in a replicated storage pool, including the object itself (required for replicated pool type)
@@ -310,8 +451,8 @@ type ErasureCodedSpec struct {
 type CephFilesystem struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
-	Spec              FilesystemSpec `json:"spec"`
-	Status            *Status        `json:"status"`
+	Spec              FilesystemSpec        `json:"spec"`
+	Status            *CephFilesystemStatus `json:"status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -335,6 +476,122 @@ type FilesystemSpec struct {
 
 	// The mds pod info
 	MetadataServer MetadataServerSpec `json:"metadataServer"`
+
+	// The snapshot schedules and retention policies of the filesystem
+	SnapshotSchedules SnapshotSchedulesSpec `json:"snapshotSchedules,omitempty"`
+}
+
+// CephFilesystemStatus represents the status of a filesystem
+type CephFilesystemStatus struct {
+	Phase string `json:"phase,omitempty"`
+
+	// SnapshotScheduleStatus is the status of the snapshot schedules of the filesystem
+	SnapshotScheduleStatus *SnapshotScheduleStatusSpec `json:"snapshotScheduleStatus,omitempty"`
+}
+
+// SnapshotSchedulesSpec represents the snapshot schedules and retention policies of a filesystem or subvolume group
+type SnapshotSchedulesSpec struct {
+	// Schedules are the intervals at which snapshots are taken
+	Schedules []SnapshotScheduleSpec `json:"schedules,omitempty"`
+
+	// Retention are the policies deciding how many snapshots are kept
+	Retention []SnapshotScheduleRetentionSpec `json:"retention,omitempty"`
+}
+
+// SnapshotScheduleRetentionSpec represents a snapshot retention policy
+type SnapshotScheduleRetentionSpec struct {
+	// Path of the snapshots, defaults to the root of the filesystem or subvolume group
+	Path string `json:"path,omitempty"`
+
+	// Duration is the retention spec, e.g. "24h" to keep 24 hourly snapshots or "7d4w" to keep 7 daily and 4 weekly snapshots
+	Duration string `json:"duration,omitempty"`
+}
+
+// SnapshotScheduleStatusSpec represents the status of the snapshot schedules
+type SnapshotScheduleStatusSpec struct {
+	// SnapshotSchedules is the status of each scheduled path
+	SnapshotSchedules []SnapshotSchedulePathStatus `json:"snapshotSchedules,omitempty"`
+
+	// LastChecked is the last time the status was checked
+	LastChecked string `json:"lastChecked,omitempty"`
+
+	// Details contains the errors, e.g. the retention policies which could not be applied
+	Details string `json:"details,omitempty"`
+}
+
+// SnapshotSchedulePathStatus represents the status of a snapshot schedule of a path
+type SnapshotSchedulePathStatus struct {
+	Path         string         `json:"path,omitempty"`
+	Schedule     string         `json:"schedule,omitempty"`
+	StartTime    string         `json:"startTime,omitempty"`
+	Retention    map[string]int `json:"retention,omitempty"`
+	LastSnapshot string         `json:"lastSnapshot,omitempty"`
+	LastPruned   string         `json:"lastPruned,omitempty"`
+	CreatedCount int            `json:"createdCount,omitempty"`
+	PrunedCount  int            `json:"prunedCount,omitempty"`
+	Active       bool           `json:"active,omitempty"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CephFilesystemSubVolumeGroup represents a subvolume group of a Ceph filesystem
+type CephFilesystemSubVolumeGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              CephFilesystemSubVolumeGroupSpec    `json:"spec"`
+	Status            *CephFilesystemSubVolumeGroupStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CephFilesystemSubVolumeGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []CephFilesystemSubVolumeGroup `json:"items"`
+}
+
+// CephFilesystemSubVolumeGroupSpec represents the spec of a subvolume group
+type CephFilesystemSubVolumeGroupSpec struct {
+	// FilesystemName is the name of the CephFilesystem in the same namespace holding the subvolume group
+	FilesystemName string `json:"filesystemName"`
+
+	// DataPoolName is the data pool of the filesystem the subvolume group is laid out on, defaults to the filesystem's default data pool
+	DataPoolName string `json:"dataPoolName,omitempty"`
+
+	// Pinning pins the subvolume group to the MDS ranks
+	Pinning SubVolumeGroupPinningSpec `json:"pinning,omitempty"`
+
+	// Quota is the maximum size of the subvolume group, removed if not set. Requires Ceph 17.2.1 or newer
+	Quota *resource.Quantity `json:"quota,omitempty"`
+
+	// The snapshot schedules and retention policies of the subvolume group, the paths are relative to the subvolume group
+	SnapshotSchedules SnapshotSchedulesSpec `json:"snapshotSchedules,omitempty"`
+}
+
+// SubVolumeGroupPinningSpec represents the MDS pinning of a subvolume group, only one of the settings can be set
+// For reference: https://docs.ceph.com/en/latest/cephfs/multimds/#setting-subtree-partitioning-policies
+type SubVolumeGroupPinningSpec struct {
+	// Export pins the subvolume group to the given MDS rank
+	Export *int `json:"export,omitempty"`
+
+	// Distributed spreads the subdirectories of the subvolume group across the MDS ranks when set to 1
+	Distributed *int `json:"distributed,omitempty"`
+
+	// Random pins the subdirectories of the subvolume group to random MDS ranks with the given probability
+	Random *float64 `json:"random,omitempty"`
+}
+
+// CephFilesystemSubVolumeGroupStatus represents the status of a subvolume group
+type CephFilesystemSubVolumeGroupStatus struct {
+	Phase string `json:"phase,omitempty"`
+
+	// Info holds the path of the subvolume group in the filesystem
+	Info map[string]string `json:"info,omitempty"`
+
+	// SnapshotScheduleStatus is the status of the snapshot schedules of the subvolume group
+	SnapshotScheduleStatus *SnapshotScheduleStatusSpec `json:"snapshotScheduleStatus,omitempty"`
 }
 
 type MetadataServerSpec struct {
@@ -482,6 +739,182 @@ type ObjectStoreUserSpec struct {
 	Store string `json:"store,omitempty"`
 	//The display name for the ceph users
 	DisplayName string `json:"displayName,omitempty"`
+	// The quotas applied to the user
+	// +optional
+	Quotas *ObjectUserQuotaSpec `json:"quotas,omitempty"`
+	// The admin API capabilities granted to the user
+	// +optional
+	Capabilities *ObjectUserCapSpec `json:"capabilities,omitempty"`
+}
+
+// ObjectUserQuotaSpec can be used to set quotas for the object store user to limit their usage
+type ObjectUserQuotaSpec struct {
+	// Maximum number of buckets the user can own, zero means unlimited
+	// +optional
+	MaxBuckets *int `json:"maxBuckets,omitempty"`
+	// Maximum size of all objects across all the user's buckets
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// Maximum number of objects across all the user's buckets
+	// +optional
+	MaxObjects *int64 `json:"maxObjects,omitempty"`
+}
+
+// ObjectUserCapSpec represents the admin API capabilities of an object store user.
+// Each capability accepts one of "*", "read", "write" or "read, write".
+type ObjectUserCapSpec struct {
+	// Admin capabilities to read/write Ceph object store users
+	// +optional
+	User string `json:"user,omitempty"`
+	// Admin capabilities to read/write Ceph object store buckets
+	// +optional
+	Bucket string `json:"bucket,omitempty"`
+	// Admin capabilities to read/write Ceph object store metadata
+	// +optional
+	MetaData string `json:"metadata,omitempty"`
+	// Admin capabilities to read/write Ceph object store usage
+	// +optional
+	Usage string `json:"usage,omitempty"`
+	// Admin capabilities to read/write Ceph object store zones
+	// +optional
+	Zone string `json:"zone,omitempty"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CephBucketTopic represents a topic on an object store that bucket notifications are sent to
+type CephBucketTopic struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              BucketTopicSpec    `json:"spec"`
+	Status            *BucketTopicStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CephBucketTopicList represents a list of Ceph bucket topics
+type CephBucketTopicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []CephBucketTopic `json:"items"`
+}
+
+// BucketTopicSpec represents the spec of a bucket topic
+type BucketTopicSpec struct {
+	// The name of the object store the topic is created in
+	ObjectStoreName string `json:"objectStoreName"`
+	// Data which is sent in each event
+	// +optional
+	OpaqueData string `json:"opaqueData,omitempty"`
+	// Whether notifications to this endpoint are persistent, requires Ceph Pacific or newer
+	// +optional
+	Persistent bool `json:"persistent,omitempty"`
+	// The endpoint the notifications are sent to
+	Endpoint TopicEndpointSpec `json:"endpoint"`
+}
+
+// TopicEndpointSpec contains the endpoint of a topic, exactly one endpoint must be set
+type TopicEndpointSpec struct {
+	// Spec of an HTTP endpoint
+	// +optional
+	HTTP *HTTPEndpointSpec `json:"http,omitempty"`
+	// Spec of an AMQP endpoint
+	// +optional
+	AMQP *AMQPEndpointSpec `json:"amqp,omitempty"`
+}
+
+// HTTPEndpointSpec represents the spec of an HTTP endpoint of a bucket topic
+type HTTPEndpointSpec struct {
+	// The URI of the HTTP endpoint to push notifications to
+	URI string `json:"uri"`
+	// Indicate whether the server certificate is validated by the client or not
+	// +optional
+	DisableVerifySSL bool `json:"disableVerifySSL,omitempty"`
+}
+
+// AMQPEndpointSpec represents the spec of an AMQP endpoint of a bucket topic
+type AMQPEndpointSpec struct {
+	// The URI of the AMQP endpoint to push notifications to
+	URI string `json:"uri"`
+	// Name of the exchange that is used to route messages based on topics
+	Exchange string `json:"exchange"`
+	// Indicate whether the server certificate is validated by the client or not
+	// +optional
+	DisableVerifySSL bool `json:"disableVerifySSL,omitempty"`
+	// The ack level required for this topic (none/broker/routable)
+	// +optional
+	AckLevel string `json:"ackLevel,omitempty"`
+}
+
+// BucketTopicStatus represents the status of a bucket topic
+type BucketTopicStatus struct {
+	Phase string `json:"phase,omitempty"`
+	// The ARN of the topic generated by the RGW
+	// +optional
+	ARN *string `json:"ARN,omitempty"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CephBucketNotification represents a notification sent to a bucket topic on object changes in buckets
+type CephBucketNotification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              BucketNotificationSpec    `json:"spec"`
+	Status            *BucketNotificationStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CephBucketNotificationList represents a list of Ceph bucket notifications
+type CephBucketNotificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []CephBucketNotification `json:"items"`
+}
+
+// BucketNotificationEvent is the type of an object event that triggers a notification
+type BucketNotificationEvent string
+
+// BucketNotificationSpec represents the spec of a bucket notification
+type BucketNotificationSpec struct {
+	// The name of the CephBucketTopic the notifications are sent to
+	Topic string `json:"topic"`
+	// The buckets of the topic's object store the notification is configured on
+	Buckets []string `json:"buckets"`
+	// List of events that trigger the notification, all events when empty
+	// +optional
+	Events []BucketNotificationEvent `json:"events,omitempty"`
+	// Filters on the object key the notification is sent for
+	// +optional
+	Filter *NotificationFilterSpec `json:"filter,omitempty"`
+}
+
+// NotificationFilterSpec represents the filters of a bucket notification
+type NotificationFilterSpec struct {
+	// Filters based on the object's key
+	// +optional
+	KeyFilters []NotificationKeyFilterRule `json:"keyFilters,omitempty"`
+}
+
+// NotificationKeyFilterRule represents a single key rule in the notification filter
+type NotificationKeyFilterRule struct {
+	// Name of the rule, one of prefix, suffix or regex
+	Name string `json:"name"`
+	// Value to filter on
+	Value string `json:"value"`
+}
+
+// BucketNotificationStatus represents the status of a bucket notification
+type BucketNotificationStatus struct {
+	Phase string `json:"phase,omitempty"`
+	// The buckets the notification is currently configured on
+	// +optional
+	Buckets []string `json:"buckets,omitempty"`
 }
 
 // +genclient
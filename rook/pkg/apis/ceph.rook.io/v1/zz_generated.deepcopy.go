@@ -26,6 +26,22 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMQPEndpointSpec) DeepCopyInto(out *AMQPEndpointSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMQPEndpointSpec.
+func (in *AMQPEndpointSpec) DeepCopy() *AMQPEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AMQPEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketHealthCheckSpec) DeepCopyInto(out *BucketHealthCheckSpec) {
 	*out = *in
@@ -42,6 +58,58 @@ func (in *BucketHealthCheckSpec) DeepCopy() *BucketHealthCheckSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketNotificationSpec) DeepCopyInto(out *BucketNotificationSpec) {
+	*out = *in
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]BucketNotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(NotificationFilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketNotificationSpec.
+func (in *BucketNotificationSpec) DeepCopy() *BucketNotificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketNotificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketNotificationStatus) DeepCopyInto(out *BucketNotificationStatus) {
+	*out = *in
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketNotificationStatus.
+func (in *BucketNotificationStatus) DeepCopy() *BucketNotificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketNotificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
 	*out = *in
@@ -58,6 +126,44 @@ func (in *BucketStatus) DeepCopy() *BucketStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketTopicSpec) DeepCopyInto(out *BucketTopicSpec) {
+	*out = *in
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketTopicSpec.
+func (in *BucketTopicSpec) DeepCopy() *BucketTopicSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketTopicSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketTopicStatus) DeepCopyInto(out *BucketTopicStatus) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketTopicStatus.
+func (in *BucketTopicStatus) DeepCopy() *BucketTopicStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketTopicStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CephBlockPool) DeepCopyInto(out *CephBlockPool) {
 	*out = *in
@@ -66,8 +172,8 @@ func (in *CephBlockPool) DeepCopyInto(out *CephBlockPool) {
 	in.Spec.DeepCopyInto(&out.Spec)
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
-		*out = new(Status)
-		**out = **in
+		*out = new(CephBlockPoolStatus)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -124,26 +230,64 @@ func (in *CephBlockPoolList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephClient) DeepCopyInto(out *CephClient) {
+func (in *CephBlockPoolStatus) DeepCopyInto(out *CephBlockPoolStatus) {
+	*out = *in
+	if in.MirroringStatus != nil {
+		in, out := &in.MirroringStatus, &out.MirroringStatus
+		*out = new(MirroringStatusSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Info != nil {
+		in, out := &in.Info, &out.Info
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PoolStatus != nil {
+		in, out := &in.PoolStatus, &out.PoolStatus
+		*out = new(PoolStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephBlockPoolStatus.
+func (in *CephBlockPoolStatus) DeepCopy() *CephBlockPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephBlockPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephBucketNotification) DeepCopyInto(out *CephBucketNotification) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(BucketNotificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephClient.
-func (in *CephClient) DeepCopy() *CephClient {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephBucketNotification.
+func (in *CephBucketNotification) DeepCopy() *CephBucketNotification {
 	if in == nil {
 		return nil
 	}
-	out := new(CephClient)
+	out := new(CephBucketNotification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephClient) DeepCopyObject() runtime.Object {
+func (in *CephBucketNotification) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -151,13 +295,13 @@ func (in *CephClient) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephClientList) DeepCopyInto(out *CephClientList) {
+func (in *CephBucketNotificationList) DeepCopyInto(out *CephBucketNotificationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CephClient, len(*in))
+		*out = make([]CephBucketNotification, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -165,18 +309,18 @@ func (in *CephClientList) DeepCopyInto(out *CephClientList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephClientList.
-func (in *CephClientList) DeepCopy() *CephClientList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephBucketNotificationList.
+func (in *CephBucketNotificationList) DeepCopy() *CephBucketNotificationList {
 	if in == nil {
 		return nil
 	}
-	out := new(CephClientList)
+	out := new(CephBucketNotificationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephClientList) DeepCopyObject() runtime.Object {
+func (in *CephBucketNotificationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -184,27 +328,31 @@ func (in *CephClientList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephCluster) DeepCopyInto(out *CephCluster) {
+func (in *CephBucketTopic) DeepCopyInto(out *CephBucketTopic) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(BucketTopicStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephCluster.
-func (in *CephCluster) DeepCopy() *CephCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephBucketTopic.
+func (in *CephBucketTopic) DeepCopy() *CephBucketTopic {
 	if in == nil {
 		return nil
 	}
-	out := new(CephCluster)
+	out := new(CephBucketTopic)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephCluster) DeepCopyObject() runtime.Object {
+func (in *CephBucketTopic) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -212,13 +360,13 @@ func (in *CephCluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephClusterList) DeepCopyInto(out *CephClusterList) {
+func (in *CephBucketTopicList) DeepCopyInto(out *CephBucketTopicList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CephCluster, len(*in))
+		*out = make([]CephBucketTopic, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -226,18 +374,18 @@ func (in *CephClusterList) DeepCopyInto(out *CephClusterList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephClusterList.
-func (in *CephClusterList) DeepCopy() *CephClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephBucketTopicList.
+func (in *CephBucketTopicList) DeepCopy() *CephBucketTopicList {
 	if in == nil {
 		return nil
 	}
-	out := new(CephClusterList)
+	out := new(CephBucketTopicList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephClusterList) DeepCopyObject() runtime.Object {
+func (in *CephBucketTopicList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -245,31 +393,26 @@ func (in *CephClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephFilesystem) DeepCopyInto(out *CephFilesystem) {
+func (in *CephClient) DeepCopyInto(out *CephClient) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(Status)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephFilesystem.
-func (in *CephFilesystem) DeepCopy() *CephFilesystem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephClient.
+func (in *CephClient) DeepCopy() *CephClient {
 	if in == nil {
 		return nil
 	}
-	out := new(CephFilesystem)
+	out := new(CephClient)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephFilesystem) DeepCopyObject() runtime.Object {
+func (in *CephClient) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -277,13 +420,13 @@ func (in *CephFilesystem) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephFilesystemList) DeepCopyInto(out *CephFilesystemList) {
+func (in *CephClientList) DeepCopyInto(out *CephClientList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CephFilesystem, len(*in))
+		*out = make([]CephClient, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -291,18 +434,18 @@ func (in *CephFilesystemList) DeepCopyInto(out *CephFilesystemList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephFilesystemList.
-func (in *CephFilesystemList) DeepCopy() *CephFilesystemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephClientList.
+func (in *CephClientList) DeepCopy() *CephClientList {
 	if in == nil {
 		return nil
 	}
-	out := new(CephFilesystemList)
+	out := new(CephClientList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephFilesystemList) DeepCopyObject() runtime.Object {
+func (in *CephClientList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -310,47 +453,27 @@ func (in *CephFilesystemList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephHealthMessage) DeepCopyInto(out *CephHealthMessage) {
-	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephHealthMessage.
-func (in *CephHealthMessage) DeepCopy() *CephHealthMessage {
-	if in == nil {
-		return nil
-	}
-	out := new(CephHealthMessage)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephNFS) DeepCopyInto(out *CephNFS) {
+func (in *CephCluster) DeepCopyInto(out *CephCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(Status)
-		**out = **in
-	}
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephNFS.
-func (in *CephNFS) DeepCopy() *CephNFS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephCluster.
+func (in *CephCluster) DeepCopy() *CephCluster {
 	if in == nil {
 		return nil
 	}
-	out := new(CephNFS)
+	out := new(CephCluster)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephNFS) DeepCopyObject() runtime.Object {
+func (in *CephCluster) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -358,13 +481,13 @@ func (in *CephNFS) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephNFSList) DeepCopyInto(out *CephNFSList) {
+func (in *CephClusterList) DeepCopyInto(out *CephClusterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CephNFS, len(*in))
+		*out = make([]CephCluster, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -372,18 +495,18 @@ func (in *CephNFSList) DeepCopyInto(out *CephNFSList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephNFSList.
-func (in *CephNFSList) DeepCopy() *CephNFSList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephClusterList.
+func (in *CephClusterList) DeepCopy() *CephClusterList {
 	if in == nil {
 		return nil
 	}
-	out := new(CephNFSList)
+	out := new(CephClusterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephNFSList) DeepCopyObject() runtime.Object {
+func (in *CephClusterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -391,31 +514,31 @@ func (in *CephNFSList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephObjectRealm) DeepCopyInto(out *CephObjectRealm) {
+func (in *CephFilesystem) DeepCopyInto(out *CephFilesystem) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
-		*out = new(Status)
-		**out = **in
+		*out = new(CephFilesystemStatus)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephObjectRealm.
-func (in *CephObjectRealm) DeepCopy() *CephObjectRealm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephFilesystem.
+func (in *CephFilesystem) DeepCopy() *CephFilesystem {
 	if in == nil {
 		return nil
 	}
-	out := new(CephObjectRealm)
+	out := new(CephFilesystem)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephObjectRealm) DeepCopyObject() runtime.Object {
+func (in *CephFilesystem) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -423,13 +546,13 @@ func (in *CephObjectRealm) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephObjectRealmList) DeepCopyInto(out *CephObjectRealmList) {
+func (in *CephFilesystemList) DeepCopyInto(out *CephFilesystemList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CephObjectRealm, len(*in))
+		*out = make([]CephFilesystem, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -437,18 +560,18 @@ func (in *CephObjectRealmList) DeepCopyInto(out *CephObjectRealmList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephObjectRealmList.
-func (in *CephObjectRealmList) DeepCopy() *CephObjectRealmList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephFilesystemList.
+func (in *CephFilesystemList) DeepCopy() *CephFilesystemList {
 	if in == nil {
 		return nil
 	}
-	out := new(CephObjectRealmList)
+	out := new(CephFilesystemList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephObjectRealmList) DeepCopyObject() runtime.Object {
+func (in *CephFilesystemList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -456,31 +579,52 @@ func (in *CephObjectRealmList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephObjectStore) DeepCopyInto(out *CephObjectStore) {
+func (in *CephFilesystemStatus) DeepCopyInto(out *CephFilesystemStatus) {
+	*out = *in
+	if in.SnapshotScheduleStatus != nil {
+		in, out := &in.SnapshotScheduleStatus, &out.SnapshotScheduleStatus
+		*out = new(SnapshotScheduleStatusSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephFilesystemStatus.
+func (in *CephFilesystemStatus) DeepCopy() *CephFilesystemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephFilesystemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephFilesystemSubVolumeGroup) DeepCopyInto(out *CephFilesystemSubVolumeGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
-		*out = new(ObjectStoreStatus)
+		*out = new(CephFilesystemSubVolumeGroupStatus)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephObjectStore.
-func (in *CephObjectStore) DeepCopy() *CephObjectStore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephFilesystemSubVolumeGroup.
+func (in *CephFilesystemSubVolumeGroup) DeepCopy() *CephFilesystemSubVolumeGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(CephObjectStore)
+	out := new(CephFilesystemSubVolumeGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephObjectStore) DeepCopyObject() runtime.Object {
+func (in *CephFilesystemSubVolumeGroup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -488,13 +632,13 @@ func (in *CephObjectStore) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephObjectStoreList) DeepCopyInto(out *CephObjectStoreList) {
+func (in *CephFilesystemSubVolumeGroupList) DeepCopyInto(out *CephFilesystemSubVolumeGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CephObjectStore, len(*in))
+		*out = make([]CephFilesystemSubVolumeGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -502,18 +646,18 @@ func (in *CephObjectStoreList) DeepCopyInto(out *CephObjectStoreList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephObjectStoreList.
-func (in *CephObjectStoreList) DeepCopy() *CephObjectStoreList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephFilesystemSubVolumeGroupList.
+func (in *CephFilesystemSubVolumeGroupList) DeepCopy() *CephFilesystemSubVolumeGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(CephObjectStoreList)
+	out := new(CephFilesystemSubVolumeGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CephObjectStoreList) DeepCopyObject() runtime.Object {
+func (in *CephFilesystemSubVolumeGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -521,14 +665,276 @@ func (in *CephObjectStoreList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CephObjectStoreUser) DeepCopyInto(out *CephObjectStoreUser) {
+func (in *CephFilesystemSubVolumeGroupSpec) DeepCopyInto(out *CephFilesystemSubVolumeGroupSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(Status)
+	in.Pinning.DeepCopyInto(&out.Pinning)
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	in.SnapshotSchedules.DeepCopyInto(&out.SnapshotSchedules)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephFilesystemSubVolumeGroupSpec.
+func (in *CephFilesystemSubVolumeGroupSpec) DeepCopy() *CephFilesystemSubVolumeGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CephFilesystemSubVolumeGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephFilesystemSubVolumeGroupStatus) DeepCopyInto(out *CephFilesystemSubVolumeGroupStatus) {
+	*out = *in
+	if in.Info != nil {
+		in, out := &in.Info, &out.Info
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SnapshotScheduleStatus != nil {
+		in, out := &in.SnapshotScheduleStatus, &out.SnapshotScheduleStatus
+		*out = new(SnapshotScheduleStatusSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephFilesystemSubVolumeGroupStatus.
+func (in *CephFilesystemSubVolumeGroupStatus) DeepCopy() *CephFilesystemSubVolumeGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CephFilesystemSubVolumeGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephHealthMessage) DeepCopyInto(out *CephHealthMessage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephHealthMessage.
+func (in *CephHealthMessage) DeepCopy() *CephHealthMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(CephHealthMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephNFS) DeepCopyInto(out *CephNFS) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephNFS.
+func (in *CephNFS) DeepCopy() *CephNFS {
+	if in == nil {
+		return nil
+	}
+	out := new(CephNFS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephNFS) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephNFSList) DeepCopyInto(out *CephNFSList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CephNFS, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephNFSList.
+func (in *CephNFSList) DeepCopy() *CephNFSList {
+	if in == nil {
+		return nil
+	}
+	out := new(CephNFSList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephNFSList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephObjectRealm) DeepCopyInto(out *CephObjectRealm) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephObjectRealm.
+func (in *CephObjectRealm) DeepCopy() *CephObjectRealm {
+	if in == nil {
+		return nil
+	}
+	out := new(CephObjectRealm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephObjectRealm) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephObjectRealmList) DeepCopyInto(out *CephObjectRealmList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CephObjectRealm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephObjectRealmList.
+func (in *CephObjectRealmList) DeepCopy() *CephObjectRealmList {
+	if in == nil {
+		return nil
+	}
+	out := new(CephObjectRealmList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephObjectRealmList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephObjectStore) DeepCopyInto(out *CephObjectStore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ObjectStoreStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephObjectStore.
+func (in *CephObjectStore) DeepCopy() *CephObjectStore {
+	if in == nil {
+		return nil
+	}
+	out := new(CephObjectStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephObjectStore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephObjectStoreList) DeepCopyInto(out *CephObjectStoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CephObjectStore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephObjectStoreList.
+func (in *CephObjectStoreList) DeepCopy() *CephObjectStoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(CephObjectStoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CephObjectStoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephObjectStoreUser) DeepCopyInto(out *CephObjectStoreUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Status)
 		**out = **in
 	}
 	return
@@ -910,12 +1316,29 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	out.External = in.External
 	in.Mgr.DeepCopyInto(&out.Mgr)
 	out.CleanupPolicy = in.CleanupPolicy
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
-func (in *ClusterSpec) DeepCopy() *ClusterSpec {
-	if in == nil {
+	if in.CephConfig != nil {
+		in, out := &in.CephConfig, &out.CephConfig
+		*out = make(map[string]map[string]string, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
+func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+	if in == nil {
 		return nil
 	}
 	out := new(ClusterSpec)
@@ -1082,6 +1505,7 @@ func (in *FilesystemSpec) DeepCopyInto(out *FilesystemSpec) {
 		}
 	}
 	in.MetadataServer.DeepCopyInto(&out.MetadataServer)
+	in.SnapshotSchedules.DeepCopyInto(&out.SnapshotSchedules)
 	return
 }
 
@@ -1168,6 +1592,22 @@ func (in *GatewaySpec) DeepCopy() *GatewaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPEndpointSpec) DeepCopyInto(out *HTTPEndpointSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPEndpointSpec.
+func (in *HTTPEndpointSpec) DeepCopy() *HTTPEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthCheckSpec) DeepCopyInto(out *HealthCheckSpec) {
 	*out = *in
@@ -1231,6 +1671,72 @@ func (in *MgrSpec) DeepCopy() *MgrSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirroringPeerSpec) DeepCopyInto(out *MirroringPeerSpec) {
+	*out = *in
+	if in.SecretNames != nil {
+		in, out := &in.SecretNames, &out.SecretNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirroringPeerSpec.
+func (in *MirroringPeerSpec) DeepCopy() *MirroringPeerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MirroringPeerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirroringSpec) DeepCopyInto(out *MirroringSpec) {
+	*out = *in
+	if in.SnapshotSchedules != nil {
+		in, out := &in.SnapshotSchedules, &out.SnapshotSchedules
+		*out = make([]SnapshotScheduleSpec, len(*in))
+		copy(*out, *in)
+	}
+	in.Peers.DeepCopyInto(&out.Peers)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirroringSpec.
+func (in *MirroringSpec) DeepCopy() *MirroringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MirroringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirroringStatusSpec) DeepCopyInto(out *MirroringStatusSpec) {
+	*out = *in
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirroringStatusSpec.
+func (in *MirroringStatusSpec) DeepCopy() *MirroringStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MirroringStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Module) DeepCopyInto(out *Module) {
 	*out = *in
@@ -1319,6 +1825,43 @@ func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationFilterSpec) DeepCopyInto(out *NotificationFilterSpec) {
+	*out = *in
+	if in.KeyFilters != nil {
+		in, out := &in.KeyFilters, &out.KeyFilters
+		*out = make([]NotificationKeyFilterRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationFilterSpec.
+func (in *NotificationFilterSpec) DeepCopy() *NotificationFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationKeyFilterRule) DeepCopyInto(out *NotificationKeyFilterRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationKeyFilterRule.
+func (in *NotificationKeyFilterRule) DeepCopy() *NotificationKeyFilterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationKeyFilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectRealmSpec) DeepCopyInto(out *ObjectRealmSpec) {
 	*out = *in
@@ -1380,6 +1923,16 @@ func (in *ObjectStoreStatus) DeepCopy() *ObjectStoreStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStoreUserSpec) DeepCopyInto(out *ObjectStoreUserSpec) {
 	*out = *in
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = new(ObjectUserQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(ObjectUserCapSpec)
+		**out = **in
+	}
 	return
 }
 
@@ -1393,6 +1946,53 @@ func (in *ObjectStoreUserSpec) DeepCopy() *ObjectStoreUserSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectUserCapSpec) DeepCopyInto(out *ObjectUserCapSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectUserCapSpec.
+func (in *ObjectUserCapSpec) DeepCopy() *ObjectUserCapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectUserCapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectUserQuotaSpec) DeepCopyInto(out *ObjectUserQuotaSpec) {
+	*out = *in
+	if in.MaxBuckets != nil {
+		in, out := &in.MaxBuckets, &out.MaxBuckets
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxObjects != nil {
+		in, out := &in.MaxObjects, &out.MaxObjects
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectUserQuotaSpec.
+func (in *ObjectUserQuotaSpec) DeepCopy() *ObjectUserQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectUserQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectZoneGroupSpec) DeepCopyInto(out *ObjectZoneGroupSpec) {
 	*out = *in
@@ -1425,6 +2025,45 @@ func (in *ObjectZoneSpec) DeepCopy() *ObjectZoneSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolAutoscaleStatus) DeepCopyInto(out *PoolAutoscaleStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolAutoscaleStatus.
+func (in *PoolAutoscaleStatus) DeepCopy() *PoolAutoscaleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolAutoscaleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolPGStatus) DeepCopyInto(out *PoolPGStatus) {
+	*out = *in
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolPGStatus.
+func (in *PoolPGStatus) DeepCopy() *PoolPGStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolPGStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PoolSpec) DeepCopyInto(out *PoolSpec) {
 	*out = *in
@@ -1437,6 +2076,8 @@ func (in *PoolSpec) DeepCopyInto(out *PoolSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.Mirroring.DeepCopyInto(&out.Mirroring)
+	in.Quotas.DeepCopyInto(&out.Quotas)
 	return
 }
 
@@ -1450,6 +2091,79 @@ func (in *PoolSpec) DeepCopy() *PoolSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolStatus) DeepCopyInto(out *PoolStatus) {
+	*out = *in
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(PoolUsageStatus)
+		**out = **in
+	}
+	if in.PGs != nil {
+		in, out := &in.PGs, &out.PGs
+		*out = new(PoolPGStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscale != nil {
+		in, out := &in.Autoscale, &out.Autoscale
+		*out = new(PoolAutoscaleStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolStatus.
+func (in *PoolStatus) DeepCopy() *PoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolUsageStatus) DeepCopyInto(out *PoolUsageStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolUsageStatus.
+func (in *PoolUsageStatus) DeepCopy() *PoolUsageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolUsageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
+	*out = *in
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxObjects != nil {
+		in, out := &in.MaxObjects, &out.MaxObjects
+		*out = new(uint64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSpec.
+func (in *QuotaSpec) DeepCopy() *QuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RBDMirroringSpec) DeepCopyInto(out *RBDMirroringSpec) {
 	*out = *in
@@ -1491,6 +2205,110 @@ func (in *ReplicatedSpec) DeepCopy() *ReplicatedSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSchedulePathStatus) DeepCopyInto(out *SnapshotSchedulePathStatus) {
+	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSchedulePathStatus.
+func (in *SnapshotSchedulePathStatus) DeepCopy() *SnapshotSchedulePathStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSchedulePathStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotScheduleRetentionSpec) DeepCopyInto(out *SnapshotScheduleRetentionSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotScheduleRetentionSpec.
+func (in *SnapshotScheduleRetentionSpec) DeepCopy() *SnapshotScheduleRetentionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotScheduleRetentionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotScheduleSpec) DeepCopyInto(out *SnapshotScheduleSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotScheduleSpec.
+func (in *SnapshotScheduleSpec) DeepCopy() *SnapshotScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotScheduleStatusSpec) DeepCopyInto(out *SnapshotScheduleStatusSpec) {
+	*out = *in
+	if in.SnapshotSchedules != nil {
+		in, out := &in.SnapshotSchedules, &out.SnapshotSchedules
+		*out = make([]SnapshotSchedulePathStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotScheduleStatusSpec.
+func (in *SnapshotScheduleStatusSpec) DeepCopy() *SnapshotScheduleStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotScheduleStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSchedulesSpec) DeepCopyInto(out *SnapshotSchedulesSpec) {
+	*out = *in
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]SnapshotScheduleSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = make([]SnapshotScheduleRetentionSpec, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSchedulesSpec.
+func (in *SnapshotSchedulesSpec) DeepCopy() *SnapshotSchedulesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSchedulesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Status) DeepCopyInto(out *Status) {
 	*out = *in
@@ -1507,6 +2325,63 @@ func (in *Status) DeepCopy() *Status {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubVolumeGroupPinningSpec) DeepCopyInto(out *SubVolumeGroupPinningSpec) {
+	*out = *in
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(int)
+		**out = **in
+	}
+	if in.Distributed != nil {
+		in, out := &in.Distributed, &out.Distributed
+		*out = new(int)
+		**out = **in
+	}
+	if in.Random != nil {
+		in, out := &in.Random, &out.Random
+		*out = new(float64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubVolumeGroupPinningSpec.
+func (in *SubVolumeGroupPinningSpec) DeepCopy() *SubVolumeGroupPinningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubVolumeGroupPinningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicEndpointSpec) DeepCopyInto(out *TopicEndpointSpec) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPEndpointSpec)
+		**out = **in
+	}
+	if in.AMQP != nil {
+		in, out := &in.AMQP, &out.AMQP
+		*out = new(AMQPEndpointSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicEndpointSpec.
+func (in *TopicEndpointSpec) DeepCopy() *TopicEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZoneSpec) DeepCopyInto(out *ZoneSpec) {
 	*out = *in
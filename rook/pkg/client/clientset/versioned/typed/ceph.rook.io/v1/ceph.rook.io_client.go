@@ -27,9 +27,12 @@ import (
 type CephV1Interface interface {
 	RESTClient() rest.Interface
 	CephBlockPoolsGetter
+	CephBucketNotificationsGetter
+	CephBucketTopicsGetter
 	CephClientsGetter
 	CephClustersGetter
 	CephFilesystemsGetter
+	CephFilesystemSubVolumeGroupsGetter
 	CephNFSesGetter
 	CephObjectRealmsGetter
 	CephObjectStoresGetter
@@ -48,6 +51,14 @@ func (c *CephV1Client) CephBlockPools(namespace string) CephBlockPoolInterface {
 	return newCephBlockPools(c, namespace)
 }
 
+func (c *CephV1Client) CephBucketNotifications(namespace string) CephBucketNotificationInterface {
+	return newCephBucketNotifications(c, namespace)
+}
+
+func (c *CephV1Client) CephBucketTopics(namespace string) CephBucketTopicInterface {
+	return newCephBucketTopics(c, namespace)
+}
+
 func (c *CephV1Client) CephClients(namespace string) CephClientInterface {
 	return newCephClients(c, namespace)
 }
@@ -60,6 +71,10 @@ func (c *CephV1Client) CephFilesystems(namespace string) CephFilesystemInterface
 	return newCephFilesystems(c, namespace)
 }
 
+func (c *CephV1Client) CephFilesystemSubVolumeGroups(namespace string) CephFilesystemSubVolumeGroupInterface {
+	return newCephFilesystemSubVolumeGroups(c, namespace)
+}
+
 func (c *CephV1Client) CephNFSes(namespace string) CephNFSInterface {
 	return newCephNFSes(c, namespace)
 }
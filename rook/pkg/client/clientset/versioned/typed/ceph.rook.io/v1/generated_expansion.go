@@ -20,12 +20,18 @@ package v1
 
 type CephBlockPoolExpansion interface{}
 
+type CephBucketNotificationExpansion interface{}
+
+type CephBucketTopicExpansion interface{}
+
 type CephClientExpansion interface{}
 
 type CephClusterExpansion interface{}
 
 type CephFilesystemExpansion interface{}
 
+type CephFilesystemSubVolumeGroupExpansion interface{}
+
 type CephNFSExpansion interface{}
 
 type CephObjectRealmExpansion interface{}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -32,6 +33,9 @@ const (
 	// MultiFsEnv defines the name of the Rook environment variable which controls if Rook is
 	// allowed to create multiple Ceph filesystems.
 	MultiFsEnv = "ROOK_ALLOW_MULTIPLE_FILESYSTEMS"
+
+	// SnapScheduleModuleName is the name of the mgr module taking the filesystem snapshots
+	SnapScheduleModuleName = "snap_schedule"
 )
 
 // CephFilesystem is a representation of the json structure returned by 'ceph fs ls'
@@ -75,6 +79,23 @@ type MDSInfo struct {
 	Address string `json:"addr"`
 }
 
+// SnapshotScheduleStatus is a representation of the json structure returned by 'ceph fs snap-schedule status'
+type SnapshotScheduleStatus struct {
+	Filesystem   string         `json:"fs"`
+	Path         string         `json:"path"`
+	RelativePath string         `json:"rel_path"`
+	Schedule     string         `json:"schedule"`
+	Retention    map[string]int `json:"retention"`
+	Start        string         `json:"start"`
+	Created      string         `json:"created"`
+	First        string         `json:"first"`
+	Last         string         `json:"last"`
+	LastPruned   string         `json:"last_pruned"`
+	CreatedCount int            `json:"created_count"`
+	PrunedCount  int            `json:"pruned_count"`
+	Active       bool           `json:"active"`
+}
+
 // ListFilesystems lists all filesystems provided by the Ceph cluster.
 func ListFilesystems(context *clusterd.Context, clusterName string) ([]CephFilesystem, error) {
 	args := []string{"fs", "ls"}
@@ -323,3 +344,145 @@ func deleteFSPool(context *clusterd.Context, clusterName string, poolNames map[i
 	}
 	return DeletePool(context, clusterName, name)
 }
+
+// CreateSubVolumeGroup creates a subvolume group in a filesystem, it does nothing if the group already exists.
+// The group is laid out on the given data pool, or on the filesystem's default data pool if empty.
+func CreateSubVolumeGroup(context *clusterd.Context, clusterName, fsName, groupName, poolName string) error {
+	logger.Infof("creating subvolume group %q in filesystem %q", groupName, fsName)
+	args := []string{"fs", "subvolumegroup", "create", fsName, groupName}
+	if poolName != "" {
+		args = append(args, "--pool_layout", poolName)
+	}
+	_, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to create subvolume group %q in filesystem %q", groupName, fsName)
+	}
+	return nil
+}
+
+// GetSubVolumeGroupPath gets the path of a subvolume group in its filesystem
+func GetSubVolumeGroupPath(context *clusterd.Context, clusterName, fsName, groupName string) (string, error) {
+	args := []string{"fs", "subvolumegroup", "getpath", fsName, groupName}
+	buf, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the path of subvolume group %q in filesystem %q", groupName, fsName)
+	}
+	return strings.TrimSpace(string(buf)), nil
+}
+
+// PinSubVolumeGroup pins a subvolume group to the MDS ranks. The pin type is one of "export",
+// "distributed" or "random". This works only from pacific version of Ceph onwards.
+func PinSubVolumeGroup(context *clusterd.Context, clusterName, fsName, groupName, pinType, pinSetting string) error {
+	logger.Infof("setting %s pin %q on subvolume group %q in filesystem %q", pinType, pinSetting, groupName, fsName)
+	args := []string{"fs", "subvolumegroup", "pin", fsName, groupName, pinType, pinSetting}
+	_, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to set %s pin %q on subvolume group %q in filesystem %q", pinType, pinSetting, groupName, fsName)
+	}
+	return nil
+}
+
+// ResizeSubVolumeGroup sets the quota of a subvolume group to the given size in bytes, a size of zero
+// removes the quota. This works only from quincy version 17.2.1 of Ceph onwards.
+func ResizeSubVolumeGroup(context *clusterd.Context, clusterName, fsName, groupName string, size int64) error {
+	quota := "inf"
+	if size > 0 {
+		quota = strconv.FormatInt(size, 10)
+	}
+	logger.Infof("setting quota of subvolume group %q in filesystem %q to %s bytes", groupName, fsName, quota)
+	args := []string{"fs", "subvolumegroup", "resize", fsName, groupName, quota}
+	_, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to set quota of subvolume group %q in filesystem %q to %s bytes", groupName, fsName, quota)
+	}
+	return nil
+}
+
+// DeleteSubVolumeGroup deletes a subvolume group from a filesystem, it fails if the group still holds subvolumes
+func DeleteSubVolumeGroup(context *clusterd.Context, clusterName, fsName, groupName string) error {
+	logger.Infof("deleting subvolume group %q from filesystem %q", groupName, fsName)
+	args := []string{"fs", "subvolumegroup", "rm", fsName, groupName}
+	_, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete subvolume group %q from filesystem %q", groupName, fsName)
+	}
+	return nil
+}
+
+// GetSnapshotScheduleStatus gets the snapshot schedules of a path in a filesystem.
+// This works only from pacific version of Ceph onwards.
+func GetSnapshotScheduleStatus(context *clusterd.Context, clusterName, fsName, path string) ([]SnapshotScheduleStatus, error) {
+	args := []string{"fs", "snap-schedule", "status", path, "--fs", fsName}
+	buf, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get snapshot schedules of path %q in filesystem %q", path, fsName)
+	}
+
+	// no schedules may be reported as an empty output instead of an empty list
+	if len(strings.TrimSpace(string(buf))) == 0 {
+		return []SnapshotScheduleStatus{}, nil
+	}
+
+	var statuses []SnapshotScheduleStatus
+	if err := json.Unmarshal(buf, &statuses); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal failed raw buffer response %s", string(buf))
+	}
+
+	return statuses, nil
+}
+
+// AddSnapshotScheduleToPath adds a snapshot schedule to a path in a filesystem, the start time is optional
+func AddSnapshotScheduleToPath(context *clusterd.Context, clusterName, fsName, path, interval, startTime string) error {
+	logger.Infof("adding snapshot schedule %q to path %q in filesystem %q", interval, path, fsName)
+	args := []string{"fs", "snap-schedule", "add", path, interval}
+	if startTime != "" {
+		args = append(args, startTime)
+	}
+	args = append(args, "--fs", fsName)
+	_, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to add snapshot schedule %q to path %q in filesystem %q", interval, path, fsName)
+	}
+	return nil
+}
+
+// RemoveSnapshotScheduleFromPath removes a snapshot schedule from a path in a filesystem. If the interval
+// is empty, all the schedules of the path are removed.
+func RemoveSnapshotScheduleFromPath(context *clusterd.Context, clusterName, fsName, path, interval, startTime string) error {
+	logger.Infof("removing snapshot schedule %q from path %q in filesystem %q", interval, path, fsName)
+	args := []string{"fs", "snap-schedule", "remove", path}
+	if interval != "" {
+		args = append(args, interval)
+		if startTime != "" {
+			args = append(args, startTime)
+		}
+	}
+	args = append(args, "--fs", fsName)
+	_, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to remove snapshot schedule %q from path %q in filesystem %q", interval, path, fsName)
+	}
+	return nil
+}
+
+// AddSnapshotRetention adds a retention policy to the snapshots of a path in a filesystem, e.g. "24h"
+func AddSnapshotRetention(context *clusterd.Context, clusterName, fsName, path, retention string) error {
+	logger.Infof("adding snapshot retention %q to path %q in filesystem %q", retention, path, fsName)
+	args := []string{"fs", "snap-schedule", "retention", "add", path, retention, "--fs", fsName}
+	_, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to add snapshot retention %q to path %q in filesystem %q", retention, path, fsName)
+	}
+	return nil
+}
+
+// RemoveSnapshotRetention removes a retention policy from the snapshots of a path in a filesystem
+func RemoveSnapshotRetention(context *clusterd.Context, clusterName, fsName, path, retention string) error {
+	logger.Infof("removing snapshot retention %q from path %q in filesystem %q", retention, path, fsName)
+	args := []string{"fs", "snap-schedule", "retention", "remove", path, retention, "--fs", fsName}
+	_, err := NewCephCommand(context, clusterName, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to remove snapshot retention %q from path %q in filesystem %q", retention, path, fsName)
+	}
+	return nil
+}
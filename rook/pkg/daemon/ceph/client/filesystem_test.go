@@ -166,3 +166,71 @@ func TestFilesystemRemove(t *testing.T) {
 	assert.True(t, dataDeleted)
 	assert.True(t, crushDeleted)
 }
+
+func TestCreateSubVolumeGroup(t *testing.T) {
+	executor := &exectest.MockExecutor{}
+	context := &clusterd.Context{Executor: executor}
+	var commandArgs []string
+	executor.MockExecuteCommandWithOutputFile = func(command, outputFile string, args ...string) (string, error) {
+		logger.Infof("Command: %s %v", command, args)
+		commandArgs = args
+		return "", nil
+	}
+
+	err := CreateSubVolumeGroup(context, "ns", "myfs", "csi", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fs", "subvolumegroup", "create", "myfs", "csi"}, commandArgs[:5])
+	assert.NotContains(t, commandArgs, "--pool_layout")
+
+	err = CreateSubVolumeGroup(context, "ns", "myfs", "csi", "myfs-data1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fs", "subvolumegroup", "create", "myfs", "csi", "--pool_layout", "myfs-data1"}, commandArgs[:7])
+
+	executor.MockExecuteCommandWithOutputFile = func(command, outputFile string, args ...string) (string, error) {
+		return "/volumes/csi\n", nil
+	}
+	path, err := GetSubVolumeGroupPath(context, "ns", "myfs", "csi")
+	assert.NoError(t, err)
+	assert.Equal(t, "/volumes/csi", path)
+}
+
+func TestGetSnapshotScheduleStatus(t *testing.T) {
+	executor := &exectest.MockExecutor{}
+	context := &clusterd.Context{Executor: executor}
+	output := `[{"fs": "myfs", "subvol": null, "path": "/volumes/csi", "rel_path": "/volumes/csi", "schedule": "1h", "retention": {"h": 24}, "start": "2020-09-01T00:00:00", "created": "2020-09-01T10:00:00", "first": "2020-09-01T11:00:00", "last": "2020-09-02T10:00:00", "last_pruned": null, "created_count": 24, "pruned_count": 0, "active": true}]`
+	executor.MockExecuteCommandWithOutputFile = func(command, outputFile string, args ...string) (string, error) {
+		logger.Infof("Command: %s %v", command, args)
+		assert.Equal(t, []string{"fs", "snap-schedule", "status", "/volumes/csi", "--fs", "myfs"}, args[:6])
+		return output, nil
+	}
+
+	statuses, err := GetSnapshotScheduleStatus(context, "ns", "myfs", "/volumes/csi")
+	assert.NoError(t, err)
+	assert.Equal(t, []SnapshotScheduleStatus{
+		{
+			Filesystem:   "myfs",
+			Path:         "/volumes/csi",
+			RelativePath: "/volumes/csi",
+			Schedule:     "1h",
+			Retention:    map[string]int{"h": 24},
+			Start:        "2020-09-01T00:00:00",
+			Created:      "2020-09-01T10:00:00",
+			First:        "2020-09-01T11:00:00",
+			Last:         "2020-09-02T10:00:00",
+			CreatedCount: 24,
+			Active:       true,
+		},
+	}, statuses)
+
+	// no schedules
+	output = ""
+	statuses, err = GetSnapshotScheduleStatus(context, "ns", "myfs", "/volumes/csi")
+	assert.NoError(t, err)
+	assert.Empty(t, statuses)
+
+	executor.MockExecuteCommandWithOutputFile = func(command, outputFile string, args ...string) (string, error) {
+		return "", errors.New("module 'snap_schedule' is not enabled")
+	}
+	_, err = GetSnapshotScheduleStatus(context, "ns", "myfs", "/volumes/csi")
+	assert.Error(t, err)
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rook/rook/pkg/clusterd"
+)
+
+const (
+	// MirroringModeDisabled is the mirroring mode reported by ceph when a pool is not mirrored
+	MirroringModeDisabled = "disabled"
+	// MirroringModePool mirrors all the images of a pool with journaling enabled
+	MirroringModePool = "pool"
+	// MirroringModeImage mirrors only the images explicitly enabled for mirroring
+	MirroringModeImage = "image"
+	// MirroringPeerDirection is the direction of the peers imported from bootstrap tokens
+	MirroringPeerDirection = "rx-tx"
+)
+
+// PoolMirroringInfo is the mirroring info of a given pool
+type PoolMirroringInfo struct {
+	Mode     string              `json:"mode"`
+	SiteName string              `json:"site_name"`
+	Peers    []PoolMirroringPeer `json:"peers"`
+}
+
+// PoolMirroringPeer is a mirroring peer of a given pool
+type PoolMirroringPeer struct {
+	UUID       string `json:"uuid"`
+	Direction  string `json:"direction"`
+	SiteName   string `json:"site_name"`
+	MirrorUUID string `json:"mirror_uuid"`
+	ClientName string `json:"client_name"`
+}
+
+// PoolMirroringStatus is the mirroring status of a given pool
+type PoolMirroringStatus struct {
+	Summary struct {
+		Health       string         `json:"health"`
+		DaemonHealth string         `json:"daemon_health"`
+		ImageHealth  string         `json:"image_health"`
+		States       map[string]int `json:"states"`
+	} `json:"summary"`
+}
+
+// SnapshotSchedule is a mirroring snapshot schedule of a given pool
+type SnapshotSchedule struct {
+	Interval  string `json:"interval"`
+	StartTime string `json:"start_time"`
+}
+
+// PeerToken is the content of a base64 encoded bootstrap peer token
+type PeerToken struct {
+	ClusterFSID string `json:"fsid"`
+	ClientID    string `json:"client_id"`
+	Key         string `json:"key"`
+	MonHost     string `json:"mon_host"`
+}
+
+// EnablePoolMirroring enables mirroring of a pool with the given mode
+func EnablePoolMirroring(context *clusterd.Context, namespace, poolName, mode string) error {
+	logger.Infof("enabling mirroring of pool %q with mode %q", poolName, mode)
+	args := []string{"mirror", "pool", "enable", poolName, mode}
+	output, err := NewRBDCommand(context, namespace, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to enable mirroring of pool %q with mode %q. %s", poolName, mode, output)
+	}
+
+	return nil
+}
+
+// DisablePoolMirroring disables mirroring of a pool
+func DisablePoolMirroring(context *clusterd.Context, namespace, poolName string) error {
+	logger.Infof("disabling mirroring of pool %q", poolName)
+	args := []string{"mirror", "pool", "disable", poolName}
+	output, err := NewRBDCommand(context, namespace, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to disable mirroring of pool %q. %s", poolName, output)
+	}
+
+	return nil
+}
+
+// GetPoolMirroringInfo returns the mirroring mode and the peers of a pool
+func GetPoolMirroringInfo(context *clusterd.Context, namespace, poolName string) (*PoolMirroringInfo, error) {
+	args := []string{"mirror", "pool", "info", poolName}
+	cmd := NewRBDCommand(context, namespace, args)
+	cmd.JsonOutput = true
+	output, err := cmd.Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get mirroring info of pool %q. %s", poolName, output)
+	}
+
+	var info PoolMirroringInfo
+	if err := json.Unmarshal(output, &info); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal mirroring info of pool %q", poolName)
+	}
+
+	return &info, nil
+}
+
+// GetPoolMirroringStatus returns the mirroring health of a pool
+func GetPoolMirroringStatus(context *clusterd.Context, namespace, poolName string) (*PoolMirroringStatus, error) {
+	args := []string{"mirror", "pool", "status", poolName}
+	cmd := NewRBDCommand(context, namespace, args)
+	cmd.JsonOutput = true
+	output, err := cmd.Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get mirroring status of pool %q. %s", poolName, output)
+	}
+
+	var status PoolMirroringStatus
+	if err := json.Unmarshal(output, &status); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal mirroring status of pool %q", poolName)
+	}
+
+	return &status, nil
+}
+
+// CreateRBDMirrorBootstrapPeer creates the bootstrap token a peer cluster imports to mirror a pool
+func CreateRBDMirrorBootstrapPeer(context *clusterd.Context, namespace, poolName, siteName string) ([]byte, error) {
+	logger.Infof("creating mirroring bootstrap peer token for pool %q", poolName)
+	args := []string{"mirror", "pool", "peer", "bootstrap", "create", poolName, "--site-name", siteName}
+	output, err := NewRBDCommand(context, namespace, args).Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create mirroring bootstrap peer token for pool %q. %s", poolName, output)
+	}
+
+	return []byte(strings.TrimSpace(string(output))), nil
+}
+
+// ImportRBDMirrorBootstrapPeer imports the bootstrap token of a peer cluster to mirror a pool
+func ImportRBDMirrorBootstrapPeer(context *clusterd.Context, namespace, poolName, siteName string, token []byte) error {
+	logger.Infof("importing mirroring bootstrap peer token for pool %q", poolName)
+
+	// the rbd tool only reads bootstrap tokens from a file
+	tokenFile, err := ioutil.TempFile("", "rbd-mirror-token")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary token file")
+	}
+	defer os.Remove(tokenFile.Name())
+
+	if _, err := tokenFile.Write(token); err != nil {
+		tokenFile.Close()
+		return errors.Wrap(err, "failed to write temporary token file")
+	}
+	if err := tokenFile.Close(); err != nil {
+		return errors.Wrap(err, "failed to close temporary token file")
+	}
+
+	args := []string{"mirror", "pool", "peer", "bootstrap", "import", poolName, "--site-name", siteName, "--direction", MirroringPeerDirection, tokenFile.Name()}
+	output, err := NewRBDCommand(context, namespace, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to import mirroring bootstrap peer token for pool %q. %s", poolName, output)
+	}
+
+	return nil
+}
+
+// DecodePeerToken decodes a base64 encoded bootstrap peer token
+func DecodePeerToken(token []byte) (*PeerToken, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(token)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode bootstrap peer token")
+	}
+
+	var peerToken PeerToken
+	if err := json.Unmarshal(decoded, &peerToken); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal bootstrap peer token")
+	}
+	if peerToken.ClusterFSID == "" {
+		return nil, errors.New("bootstrap peer token has no cluster fsid")
+	}
+
+	return &peerToken, nil
+}
+
+// ListSnapshotSchedules lists the mirroring snapshot schedules of a pool
+func ListSnapshotSchedules(context *clusterd.Context, namespace, poolName string) ([]SnapshotSchedule, error) {
+	args := []string{"mirror", "snapshot", "schedule", "ls", "--pool", poolName}
+	cmd := NewRBDCommand(context, namespace, args)
+	cmd.JsonOutput = true
+	output, err := cmd.Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list mirroring snapshot schedules of pool %q. %s", poolName, output)
+	}
+
+	// no schedules are reported as an empty output instead of an empty list
+	if len(strings.TrimSpace(string(output))) == 0 {
+		return []SnapshotSchedule{}, nil
+	}
+
+	var schedules []SnapshotSchedule
+	if err := json.Unmarshal(output, &schedules); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal mirroring snapshot schedules of pool %q", poolName)
+	}
+
+	return schedules, nil
+}
+
+// AddSnapshotSchedule adds a mirroring snapshot schedule to a pool
+func AddSnapshotSchedule(context *clusterd.Context, namespace, poolName string, schedule SnapshotSchedule) error {
+	logger.Infof("adding mirroring snapshot schedule %q to pool %q", schedule.Interval, poolName)
+	args := append([]string{"mirror", "snapshot", "schedule", "add", "--pool", poolName}, schedule.args()...)
+	output, err := NewRBDCommand(context, namespace, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to add mirroring snapshot schedule %q to pool %q. %s", schedule.Interval, poolName, output)
+	}
+
+	return nil
+}
+
+// RemoveSnapshotSchedule removes a mirroring snapshot schedule from a pool
+func RemoveSnapshotSchedule(context *clusterd.Context, namespace, poolName string, schedule SnapshotSchedule) error {
+	logger.Infof("removing mirroring snapshot schedule %q from pool %q", schedule.Interval, poolName)
+	args := append([]string{"mirror", "snapshot", "schedule", "remove", "--pool", poolName}, schedule.args()...)
+	output, err := NewRBDCommand(context, namespace, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to remove mirroring snapshot schedule %q from pool %q. %s", schedule.Interval, poolName, output)
+	}
+
+	return nil
+}
+
+func (s SnapshotSchedule) args() []string {
+	if s.StartTime == "" {
+		return []string{s.Interval}
+	}
+	return []string{s.Interval, s.StartTime}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/rook/rook/pkg/clusterd"
+	exectest "github.com/rook/rook/pkg/util/exec/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPoolMirroringInfo(t *testing.T) {
+	executor := &exectest.MockExecutor{}
+	executor.MockExecuteCommandWithOutput = func(command string, args ...string) (string, error) {
+		logger.Infof("Command: %s %v", command, args)
+		if args[0] == "mirror" && args[1] == "pool" && args[2] == "info" {
+			assert.Equal(t, "mypool", args[3])
+			return `{"mode":"image","site_name":"site-a","peers":[{"uuid":"4a6983c0","direction":"rx-tx","site_name":"site-b","mirror_uuid":"","client_name":"client.rbd-mirror-peer"}]}`, nil
+		}
+		return "", errors.Errorf("unexpected rbd command %q", args)
+	}
+	context := &clusterd.Context{Executor: executor}
+
+	info, err := GetPoolMirroringInfo(context, "myns", "mypool")
+	assert.NoError(t, err)
+	assert.Equal(t, MirroringModeImage, info.Mode)
+	assert.Equal(t, "site-a", info.SiteName)
+	assert.Equal(t, 1, len(info.Peers))
+	assert.Equal(t, "site-b", info.Peers[0].SiteName)
+	assert.Equal(t, MirroringPeerDirection, info.Peers[0].Direction)
+}
+
+func TestGetPoolMirroringStatus(t *testing.T) {
+	executor := &exectest.MockExecutor{}
+	executor.MockExecuteCommandWithOutput = func(command string, args ...string) (string, error) {
+		logger.Infof("Command: %s %v", command, args)
+		if args[0] == "mirror" && args[1] == "pool" && args[2] == "status" {
+			return `{"summary":{"health":"WARNING","daemon_health":"OK","image_health":"WARNING","states":{"starting_replay":1,"replaying":2}}}`, nil
+		}
+		return "", errors.Errorf("unexpected rbd command %q", args)
+	}
+	context := &clusterd.Context{Executor: executor}
+
+	status, err := GetPoolMirroringStatus(context, "myns", "mypool")
+	assert.NoError(t, err)
+	assert.Equal(t, "WARNING", status.Summary.Health)
+	assert.Equal(t, "OK", status.Summary.DaemonHealth)
+	assert.Equal(t, "WARNING", status.Summary.ImageHealth)
+	assert.Equal(t, map[string]int{"starting_replay": 1, "replaying": 2}, status.Summary.States)
+}
+
+func TestImportRBDMirrorBootstrapPeer(t *testing.T) {
+	executor := &exectest.MockExecutor{}
+	imported := false
+	executor.MockExecuteCommandWithOutput = func(command string, args ...string) (string, error) {
+		logger.Infof("Command: %s %v", command, args)
+		if args[0] == "mirror" && args[1] == "pool" && args[2] == "peer" && args[4] == "import" {
+			assert.Equal(t, []string{"mypool", "--site-name", "site-a", "--direction", "rx-tx"}, args[5:10])
+			token, err := ioutil.ReadFile(args[10])
+			assert.NoError(t, err)
+			assert.Equal(t, "token", string(token))
+			imported = true
+			return "", nil
+		}
+		return "", errors.Errorf("unexpected rbd command %q", args)
+	}
+	context := &clusterd.Context{Executor: executor}
+
+	err := ImportRBDMirrorBootstrapPeer(context, "myns", "mypool", "site-a", []byte("token"))
+	assert.NoError(t, err)
+	assert.True(t, imported)
+}
+
+func TestDecodePeerToken(t *testing.T) {
+	// {"fsid":"c47cac40-9bee-4d52-823b-ccd803ba5bfe","client_id":"rbd-mirror-peer","key":"AQA==","mon_host":"[v2:10.0.0.1:3300]"}
+	token := []byte("eyJmc2lkIjoiYzQ3Y2FjNDAtOWJlZS00ZDUyLTgyM2ItY2NkODAzYmE1YmZlIiwiY2xpZW50X2lkIjoicmJkLW1pcnJvci1wZWVyIiwia2V5IjoiQVFBPT0iLCJtb25faG9zdCI6Ilt2MjoxMC4wLjAuMTozMzAwXSJ9\n")
+	peerToken, err := DecodePeerToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "c47cac40-9bee-4d52-823b-ccd803ba5bfe", peerToken.ClusterFSID)
+	assert.Equal(t, "rbd-mirror-peer", peerToken.ClientID)
+	assert.Equal(t, "AQA==", peerToken.Key)
+	assert.Equal(t, "[v2:10.0.0.1:3300]", peerToken.MonHost)
+
+	// fail with a token that is not base64 encoded
+	_, err = DecodePeerToken([]byte("not a token"))
+	assert.Error(t, err)
+
+	// fail with a token without fsid
+	_, err = DecodePeerToken([]byte("e30="))
+	assert.Error(t, err)
+}
+
+func TestListSnapshotSchedules(t *testing.T) {
+	executor := &exectest.MockExecutor{}
+	output := ""
+	executor.MockExecuteCommandWithOutput = func(command string, args ...string) (string, error) {
+		logger.Infof("Command: %s %v", command, args)
+		if args[0] == "mirror" && args[1] == "snapshot" && args[2] == "schedule" && args[3] == "ls" {
+			assert.Equal(t, []string{"--pool", "mypool"}, args[4:6])
+			return output, nil
+		}
+		return "", errors.Errorf("unexpected rbd command %q", args)
+	}
+	context := &clusterd.Context{Executor: executor}
+
+	// no schedules
+	schedules, err := ListSnapshotSchedules(context, "myns", "mypool")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(schedules))
+
+	output = `[{"interval":"1d","start_time":""},{"interval":"4h","start_time":"14:00:00-05:00"}]`
+	schedules, err = ListSnapshotSchedules(context, "myns", "mypool")
+	assert.NoError(t, err)
+	assert.Equal(t, []SnapshotSchedule{{Interval: "1d"}, {Interval: "4h", StartTime: "14:00:00-05:00"}}, schedules)
+}
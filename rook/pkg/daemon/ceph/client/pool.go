@@ -59,7 +59,9 @@ type CephStoragePoolStats struct {
 		Name  string `json:"name"`
 		ID    int    `json:"id"`
 		Stats struct {
+			Stored       float64 `json:"stored"`
 			BytesUsed    float64 `json:"bytes_used"`
+			PercentUsed  float64 `json:"percent_used"`
 			RawBytesUsed float64 `json:"raw_bytes_used"`
 			MaxAvail     float64 `json:"max_avail"`
 			Objects      float64 `json:"objects"`
@@ -68,10 +70,32 @@ type CephStoragePoolStats struct {
 			ReadBytes    float64 `json:"rd_bytes"`
 			WriteIO      float64 `json:"wr"`
 			WriteBytes   float64 `json:"wr_bytes"`
+			QuotaBytes   float64 `json:"quota_bytes"`
+			QuotaObjects float64 `json:"quota_objects"`
 		} `json:"stats"`
 	} `json:"pools"`
 }
 
+// CephStoragePoolPGs is the list of the placement groups of a pool
+type CephStoragePoolPGs struct {
+	PGStats []struct {
+		PGID  string `json:"pgid"`
+		State string `json:"state"`
+	} `json:"pg_stats"`
+}
+
+// CephStoragePoolAutoscaleStatus is the pg autoscaler status of a pool
+type CephStoragePoolAutoscaleStatus struct {
+	PoolName    string  `json:"pool_name"`
+	Mode        string  `json:"pg_autoscale_mode"`
+	PgNumTarget int     `json:"pg_num_target"`
+	PgNumIdeal  int     `json:"pg_num_ideal"`
+	PgNumFinal  int     `json:"pg_num_final"`
+	TargetBytes uint64  `json:"target_bytes"`
+	TargetRatio float64 `json:"target_ratio"`
+	WouldAdjust bool    `json:"would_adjust"`
+}
+
 type PoolStatistics struct {
 	Images struct {
 		Count            int `json:"count"`
@@ -248,6 +272,11 @@ func setCommonPoolProperties(context *clusterd.Context, pool cephv1.PoolSpec, na
 		pool.Parameters[compressionModeProperty] = pool.CompressionMode
 	}
 
+	// Apply quotas, the quotas that are not specified are removed
+	if err := SetPoolQuota(context, namespace, poolName, pool.Quotas); err != nil {
+		return errors.Wrapf(err, "failed to set quotas on pool %q", poolName)
+	}
+
 	// Apply properties
 	for propName, propValue := range pool.Parameters {
 		err := SetPoolProperty(context, namespace, poolName, propName, propValue)
@@ -371,6 +400,74 @@ func SetPoolReplicatedSizeProperty(context *clusterd.Context, namespace, poolNam
 	return nil
 }
 
+// SetPoolQuota sets the quotas of a given pool, a quota that is not specified or zero is removed
+func SetPoolQuota(context *clusterd.Context, namespace, poolName string, quotas cephv1.QuotaSpec) error {
+	var maxBytes, maxObjects uint64
+	if quotas.MaxBytes != nil {
+		maxBytes = *quotas.MaxBytes
+	}
+	if quotas.MaxObjects != nil {
+		maxObjects = *quotas.MaxObjects
+	}
+	if err := setPoolQuota(context, namespace, poolName, "max_bytes", maxBytes); err != nil {
+		return err
+	}
+	return setPoolQuota(context, namespace, poolName, "max_objects", maxObjects)
+}
+
+func setPoolQuota(context *clusterd.Context, namespace, poolName, quotaName string, quotaVal uint64) error {
+	args := []string{"osd", "pool", "set-quota", poolName, quotaName, strconv.FormatUint(quotaVal, 10)}
+	logger.Infof("setting quota %q to %d on pool %q", quotaName, quotaVal, poolName)
+	_, err := NewCephCommand(context, namespace, args).Run()
+	if err != nil {
+		return errors.Wrapf(err, "failed to set quota %q on pool %q", quotaName, poolName)
+	}
+	return nil
+}
+
+// GetPoolPGStates returns the number of placement groups of a given pool per state
+func GetPoolPGStates(context *clusterd.Context, namespace, poolName string) (map[string]int, error) {
+	args := []string{"pg", "ls-by-pool", poolName}
+	output, err := NewCephCommand(context, namespace, args).Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list placement groups of pool %q", poolName)
+	}
+
+	var pgs CephStoragePoolPGs
+	if err := json.Unmarshal(output, &pgs); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal placement groups response")
+	}
+
+	states := make(map[string]int)
+	for _, pg := range pgs.PGStats {
+		states[pg.State]++
+	}
+
+	return states, nil
+}
+
+// GetPoolAutoscaleStatus returns the pg autoscaler status of a given pool
+func GetPoolAutoscaleStatus(context *clusterd.Context, namespace, poolName string) (*CephStoragePoolAutoscaleStatus, error) {
+	args := []string{"osd", "pool", "autoscale-status"}
+	output, err := NewCephCommand(context, namespace, args).Run()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get pg autoscaler status")
+	}
+
+	var statuses []CephStoragePoolAutoscaleStatus
+	if err := json.Unmarshal(output, &statuses); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal pg autoscaler status response")
+	}
+
+	for i := range statuses {
+		if statuses[i].PoolName == poolName {
+			return &statuses[i], nil
+		}
+	}
+
+	return nil, errors.Errorf("pool %q not found in pg autoscaler status", poolName)
+}
+
 func GetPoolStats(context *clusterd.Context, namespace string) (*CephStoragePoolStats, error) {
 	args := []string{"df", "detail"}
 	output, err := NewCephCommand(context, namespace, args).Run()
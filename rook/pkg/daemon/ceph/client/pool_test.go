@@ -80,6 +80,11 @@ func testCreateECPool(t *testing.T, overwrite bool, compressionMode string) {
 				assert.Equal(t, "myapp", args[5])
 				return "", nil
 			}
+			if args[2] == "set-quota" {
+				assert.Equal(t, "mypool", args[3])
+				assert.Equal(t, "0", args[5])
+				return "", nil
+			}
 		}
 		return "", errors.Errorf("unexpected ceph command %q", args)
 	}
@@ -141,6 +146,11 @@ func testCreateReplicaPool(t *testing.T, failureDomain, crushRoot, deviceClass,
 				assert.Equal(t, "myapp", args[5])
 				return "", nil
 			}
+			if args[2] == "set-quota" {
+				assert.Equal(t, "mypool", args[3])
+				assert.Equal(t, "0", args[5])
+				return "", nil
+			}
 		}
 		if args[1] == "crush" {
 			crushRuleCreated = true
@@ -266,3 +276,85 @@ func TestSetPoolReplicatedSizeProperty(t *testing.T) {
 	err = SetPoolReplicatedSizeProperty(context, "myns", poolName, "1")
 	assert.NoError(t, err)
 }
+
+func TestSetPoolQuota(t *testing.T) {
+	poolName := "mypool"
+	quotas := map[string]string{}
+	executor := &exectest.MockExecutor{}
+	context := &clusterd.Context{Executor: executor}
+	executor.MockExecuteCommandWithOutputFile = func(command, outputFile string, args ...string) (string, error) {
+		logger.Infof("Command: %s %v", command, args)
+
+		if args[1] == "pool" && args[2] == "set-quota" {
+			assert.Equal(t, poolName, args[3])
+			quotas[args[4]] = args[5]
+			return "", nil
+		}
+
+		return "", errors.Errorf("unexpected ceph command %q", args)
+	}
+
+	// the quotas that are not specified are removed
+	maxBytes := uint64(10737418240)
+	err := SetPoolQuota(context, "myns", poolName, cephv1.QuotaSpec{MaxBytes: &maxBytes})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"max_bytes": "10737418240", "max_objects": "0"}, quotas)
+
+	// a quota of zero removes it
+	maxObjects := uint64(1000)
+	maxBytes = 0
+	err = SetPoolQuota(context, "myns", poolName, cephv1.QuotaSpec{MaxBytes: &maxBytes, MaxObjects: &maxObjects})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"max_bytes": "0", "max_objects": "1000"}, quotas)
+
+	// removing the quotas from the spec clears them
+	err = SetPoolQuota(context, "myns", poolName, cephv1.QuotaSpec{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"max_bytes": "0", "max_objects": "0"}, quotas)
+}
+
+func TestGetPoolPGStates(t *testing.T) {
+	executor := &exectest.MockExecutor{}
+	context := &clusterd.Context{Executor: executor}
+	executor.MockExecuteCommandWithOutputFile = func(command, outputFile string, args ...string) (string, error) {
+		logger.Infof("Command: %s %v", command, args)
+
+		if args[0] == "pg" && args[1] == "ls-by-pool" {
+			assert.Equal(t, "mypool", args[2])
+			return `{"pg_ready":true,"pg_stats":[{"pgid":"1.0","state":"active+clean"},{"pgid":"1.1","state":"active+clean"},{"pgid":"1.2","state":"active+undersized+degraded"}]}`, nil
+		}
+
+		return "", errors.Errorf("unexpected ceph command %q", args)
+	}
+
+	states, err := GetPoolPGStates(context, "myns", "mypool")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"active+clean": 2, "active+undersized+degraded": 1}, states)
+}
+
+func TestGetPoolAutoscaleStatus(t *testing.T) {
+	executor := &exectest.MockExecutor{}
+	context := &clusterd.Context{Executor: executor}
+	executor.MockExecuteCommandWithOutputFile = func(command, outputFile string, args ...string) (string, error) {
+		logger.Infof("Command: %s %v", command, args)
+
+		if args[0] == "osd" && args[1] == "pool" && args[2] == "autoscale-status" {
+			return `[{"pool_name":"otherpool","pg_autoscale_mode":"warn","pg_num_target":8},` +
+				`{"pool_name":"mypool","pg_autoscale_mode":"on","pg_num_target":32,"pg_num_ideal":64,"pg_num_final":64,"target_bytes":0,"target_ratio":0.5,"would_adjust":true}]`, nil
+		}
+
+		return "", errors.Errorf("unexpected ceph command %q", args)
+	}
+
+	status, err := GetPoolAutoscaleStatus(context, "myns", "mypool")
+	assert.NoError(t, err)
+	assert.Equal(t, "on", status.Mode)
+	assert.Equal(t, 32, status.PgNumTarget)
+	assert.Equal(t, 64, status.PgNumIdeal)
+	assert.Equal(t, 0.5, status.TargetRatio)
+	assert.True(t, status.WouldAdjust)
+
+	// fail if the pool is not in the autoscaler status
+	_, err = GetPoolAutoscaleStatus(context, "myns", "doesntexist")
+	assert.Error(t, err)
+}
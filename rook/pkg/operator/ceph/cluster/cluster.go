@@ -22,6 +22,7 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -42,10 +43,14 @@ import (
 	v1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
 	detectVersionName = "rook-ceph-detect-version"
+	// cephConfigCheckInterval is the interval to revert the drift of the cephConfig options between
+	// the reconciles of the cluster
+	cephConfigCheckInterval = 10 * time.Minute
 )
 
 type cluster struct {
@@ -65,6 +70,8 @@ type cluster struct {
 	orchMux              sync.Mutex
 	isUpgrade            bool
 	monitoringActivated  bool
+	cephConfig           *config.CephConfigReconciler
+	allowedConfigDrift   string
 }
 
 func newCluster(c *cephv1.CephCluster, context *clusterd.Context, csiMutex *sync.Mutex, ownerRef *metav1.OwnerReference) *cluster {
@@ -72,14 +79,15 @@ func newCluster(c *cephv1.CephCluster, context *clusterd.Context, csiMutex *sync
 		// at this phase of the cluster creation process, the identity components of the cluster are
 		// not yet established. we reserve this struct which is filled in as soon as the cluster's
 		// identity can be established.
-		Info:      nil,
-		Namespace: c.Namespace,
-		Spec:      &c.Spec,
-		context:   context,
-		crdName:   c.Name,
-		stopCh:    make(chan struct{}),
-		ownerRef:  *ownerRef,
-		mons:      mon.New(context, c.Namespace, c.Spec.DataDirHostPath, c.Spec.Network, *ownerRef, csiMutex),
+		Info:       nil,
+		Namespace:  c.Namespace,
+		Spec:       &c.Spec,
+		context:    context,
+		crdName:    c.Name,
+		stopCh:     make(chan struct{}),
+		ownerRef:   *ownerRef,
+		mons:       mon.New(context, c.Namespace, c.Spec.DataDirHostPath, c.Spec.Network, *ownerRef, csiMutex),
+		cephConfig: config.NewCephConfigReconciler(context, c.Namespace, *ownerRef),
 	}
 }
 
@@ -136,6 +144,9 @@ func (c *cluster) doOrchestration(rookImage string, cephVersion cephver.CephVers
 		return errors.Wrap(err, "failed to execute post actions after all the ceph monitors started")
 	}
 
+	// Apply the ceph config options of the cluster spec
+	c.reconcileCephConfig(spec)
+
 	// If this is an upgrade, notify all the child controllers
 	if c.isUpgrade {
 		logger.Info("upgrade in progress, notifying child CRs")
@@ -182,6 +193,7 @@ func (c *cluster) doOrchestration(rookImage string, cephVersion cephver.CephVers
 
 func (c *ClusterController) initializeCluster(cluster *cluster, clusterObj *cephv1.CephCluster) error {
 	cluster.Spec = &clusterObj.Spec
+	cluster.allowedConfigDrift = clusterObj.Annotations[config.AllowedDriftAnnotation]
 
 	// Check if the dataDirHostPath is located in the disallowed paths list
 	cleanDataDirHostPath := path.Clean(cluster.Spec.DataDirHostPath)
@@ -249,6 +261,8 @@ func (c *ClusterController) startClusterMonitoring(cluster *cluster, cephUser st
 	// note: the error return below is ignored and is expected to be removed from the
 	//   bucket library's `NewProvisioner` function
 	bucketController, _ := bucket.NewBucketController(c.context.KubeConfig, bucketProvisioner)
+	// the bucket library only applies the bucket lifecycle of an OBC when provisioning it
+	bucketProvisioner.StartLifecycleWatch(cluster.stopCh)
 	go bucketController.Run(cluster.stopCh)
 
 	// Start mon health checker
@@ -264,6 +278,11 @@ func (c *ClusterController) startClusterMonitoring(cluster *cluster, cephUser st
 	// Start the ceph status checker
 	cephChecker := newCephStatusChecker(c.context, cluster.Namespace, cephUser, c.namespacedName)
 	go cephChecker.checkCephStatus(cluster.stopCh)
+
+	if !cluster.Spec.External.Enable {
+		// Start the ceph config drift checker, the cephConfig is only applied to local clusters
+		go cluster.checkCephConfig(cluster.stopCh)
+	}
 }
 
 func (c *ClusterController) configureLocalCephCluster(cluster *cluster, clusterObj *cephv1.CephCluster) error {
@@ -397,6 +416,32 @@ func (c *ClusterController) preClusterStartValidation(cluster *cluster, clusterO
 	return nil
 }
 
+// reconcileCephConfig applies the cephConfig of the spec to the centralized mon configuration database
+// and reports the drift and the invalid options in the conditions of the cluster
+func (c *cluster) reconcileCephConfig(spec *cephv1.ClusterSpec) {
+	status, err := c.cephConfig.Reconcile(spec.CephConfig, c.allowedConfigDrift)
+	if err != nil {
+		logger.Errorf("failed to reconcile the ceph config. %v", err)
+		return
+	}
+	namespacedName := types.NamespacedName{Namespace: c.Namespace, Name: c.crdName}
+	config.ExportCephConfigConditions(c.context, namespacedName, spec.CephConfig, status)
+}
+
+// checkCephConfig periodically reconciles the cephConfig of the spec to revert the drift
+func (c *cluster) checkCephConfig(stopCh chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			logger.Infof("stopping the ceph config drift checks")
+			return
+
+		case <-time.After(cephConfigCheckInterval):
+			c.reconcileCephConfig(c.Spec)
+		}
+	}
+}
+
 // postMonStartupActions is a collection of actions to run once the monitors are up and running
 // It gets executed right after the main mon Start() method
 // Basically, it is executed between the monitors and the manager sequence
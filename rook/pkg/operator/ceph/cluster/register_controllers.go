@@ -27,9 +27,12 @@ import (
 	"github.com/rook/rook/pkg/operator/ceph/disruption/machinelabel"
 	"github.com/rook/rook/pkg/operator/ceph/disruption/nodedrain"
 	"github.com/rook/rook/pkg/operator/ceph/file"
+	"github.com/rook/rook/pkg/operator/ceph/file/subvolumegroup"
 	"github.com/rook/rook/pkg/operator/ceph/nfs"
 	"github.com/rook/rook/pkg/operator/ceph/object"
+	"github.com/rook/rook/pkg/operator/ceph/object/notification"
 	"github.com/rook/rook/pkg/operator/ceph/object/realm"
+	"github.com/rook/rook/pkg/operator/ceph/object/topic"
 	objectuser "github.com/rook/rook/pkg/operator/ceph/object/user"
 	"github.com/rook/rook/pkg/operator/ceph/object/zone"
 	"github.com/rook/rook/pkg/operator/ceph/object/zonegroup"
@@ -64,7 +67,10 @@ var AddToManagerFuncs = []func(manager.Manager, *clusterd.Context) error{
 	zonegroup.Add,
 	zone.Add,
 	object.Add,
+	topic.Add,
+	notification.Add,
 	file.Add,
+	subvolumegroup.Add,
 	nfs.Add,
 	rbd.Add,
 }
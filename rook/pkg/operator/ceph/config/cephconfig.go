@@ -0,0 +1,329 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	"github.com/rook/rook/pkg/operator/k8sutil"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// AllowedDriftAnnotation is the CephCluster annotation listing the cephConfig options which can be
+	// changed manually without being reverted, as a comma-separated list of "<section>/<option>".
+	// An option of "*" allows all the options of the section.
+	AllowedDriftAnnotation = "ceph.rook.io/allowed-config-drift"
+
+	// the ConfigMap holding the cephConfig options applied by the operator, so that the drift is still
+	// detected after the operator restarts
+	appliedConfigMapName = "rook-ceph-config-applied"
+	appliedConfigKey     = "applied"
+)
+
+// the daemon types of the sections of the centralized mon configuration database
+var configSectionTypes = []string{"global", "mon", "mgr", "osd", "mds", "client"}
+
+// CephConfigStatus is the result of the reconcile of the cephConfig of a cluster, each option
+// being identified by "<section>/<option>"
+type CephConfigStatus struct {
+	// Drifted are the options which were changed manually and have been reverted
+	Drifted []string
+	// AllowedDrift are the options which were changed manually and have been kept
+	AllowedDrift []string
+	// Unknown are the options which are not known by the mons
+	Unknown []string
+	// Invalid are the options the mons refused, or whose section is invalid
+	Invalid []string
+}
+
+// appliedOption is an option of the cephConfig applied by the operator
+type appliedOption struct {
+	// Desired is the value of the option in the cephConfig
+	Desired string `json:"desired"`
+	// Observed is the value of the option in the config dump once applied, which may have been
+	// normalized by the mons
+	Observed string `json:"observed"`
+}
+
+// CephConfigReconciler applies the cephConfig of the cluster spec to the centralized mon
+// configuration database and detects the options changed outside of the cluster spec.
+// The options applied are stored in a ConfigMap, keyed by "<section>/<option>".
+type CephConfigReconciler struct {
+	monStore *MonStore
+	store    *k8sutil.ConfigMapKVStore
+	// serializes the reconciles of the cluster orchestration and of the periodic drift checks
+	mutex sync.Mutex
+}
+
+// NewCephConfigReconciler returns a new CephConfigReconciler for the cluster.
+func NewCephConfigReconciler(context *clusterd.Context, namespace string, ownerRef metav1.OwnerReference) *CephConfigReconciler {
+	return &CephConfigReconciler{
+		monStore: GetMonStore(context, namespace),
+		store:    k8sutil.NewConfigMapKVStore(namespace, context.Clientset, ownerRef),
+	}
+}
+
+// Reconcile applies the options of the cephConfig whose value differs in the config database.
+// An option applied earlier whose value changed since is drift, which is reverted unless it is allowed
+// in the allowedDrift annotation value. The options removed from the cephConfig are left unchanged.
+func (r *CephConfigReconciler) Reconcile(cephConfig map[string]map[string]string, allowedDrift string) (*CephConfigStatus, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	status := &CephConfigStatus{}
+	if len(cephConfig) == 0 {
+		if err := r.store.ClearStore(appliedConfigMapName); err != nil {
+			return nil, errors.Wrap(err, "failed to clear the applied cephConfig options")
+		}
+		return status, nil
+	}
+
+	previouslyApplied, err := r.loadApplied()
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := r.monStore.ListOptions()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list the known config options")
+	}
+	knownOptions := map[string]bool{}
+	for _, name := range names {
+		knownOptions[name] = true
+	}
+
+	current, err := r.dump()
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := parseAllowedDrift(allowedDrift)
+	applied := map[string]appliedOption{}
+	changed := false
+	for _, who := range sortedSections(cephConfig) {
+		options := cephConfig[who]
+		if !validSection(who) {
+			logger.Errorf("invalid cephConfig section %q", who)
+			for _, option := range sortedOptions(options) {
+				status.Invalid = append(status.Invalid, configKey(who, normalizeKey(option)))
+			}
+			continue
+		}
+
+		for _, option := range sortedOptions(options) {
+			name := normalizeKey(option)
+			value := options[option]
+			key := configKey(who, name)
+			if !knownOptions[name] {
+				logger.Errorf("unknown cephConfig option %q", key)
+				status.Unknown = append(status.Unknown, key)
+				continue
+			}
+
+			currentValue, isSet := current[key]
+			previous, isApplied := previouslyApplied[key]
+			if isApplied && previous.Desired == value {
+				if isSet && currentValue == previous.Observed {
+					applied[key] = previous
+					continue
+				}
+				if allowed[key] || allowed[configKey(who, "*")] {
+					logger.Infof("cephConfig option %q was changed to %q, keeping it as the drift is allowed", key, currentValue)
+					status.AllowedDrift = append(status.AllowedDrift, key)
+					applied[key] = previous
+					continue
+				}
+				logger.Warningf("cephConfig option %q was changed to %q, reverting it to %q", key, currentValue, value)
+				status.Drifted = append(status.Drifted, key)
+			} else if isSet && currentValue == value {
+				applied[key] = appliedOption{Desired: value, Observed: value}
+				continue
+			}
+
+			if err := r.monStore.Set(who, name, value); err != nil {
+				logger.Errorf("failed to set cephConfig option %q. %v", key, err)
+				status.Invalid = append(status.Invalid, key)
+				continue
+			}
+			applied[key] = appliedOption{Desired: value}
+			changed = true
+		}
+	}
+
+	if changed {
+		// the mons may normalize the values, record them as they are in the config database
+		current, err = r.dump()
+		if err != nil {
+			return nil, err
+		}
+		for key, option := range applied {
+			if option.Observed == "" {
+				option.Observed = current[key]
+				applied[key] = option
+			}
+		}
+	}
+	if !reflect.DeepEqual(applied, previouslyApplied) {
+		if err := r.saveApplied(applied); err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
+}
+
+// loadApplied returns the options applied by the operator, keyed by "<section>/<option>"
+func (r *CephConfigReconciler) loadApplied() (map[string]appliedOption, error) {
+	applied := map[string]appliedOption{}
+	value, err := r.store.GetValue(appliedConfigMapName, appliedConfigKey)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return applied, nil
+		}
+		return nil, errors.Wrap(err, "failed to get the applied cephConfig options")
+	}
+	if err := json.Unmarshal([]byte(value), &applied); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the applied cephConfig options")
+	}
+	return applied, nil
+}
+
+// saveApplied stores the options applied by the operator
+func (r *CephConfigReconciler) saveApplied(applied map[string]appliedOption) error {
+	value, err := json.Marshal(applied)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize the applied cephConfig options")
+	}
+	if err := r.store.SetValue(appliedConfigMapName, appliedConfigKey, string(value)); err != nil {
+		return errors.Wrap(err, "failed to store the applied cephConfig options")
+	}
+	return nil
+}
+
+// dump returns the values of the config database keyed by "<section>/<option>"
+func (r *CephConfigReconciler) dump() (map[string]string, error) {
+	options, err := r.monStore.Dump()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dump the config database")
+	}
+	values := map[string]string{}
+	for _, option := range options {
+		values[configKey(option.Who, option.Option)] = option.Value
+	}
+	return values, nil
+}
+
+// ExportCephConfigConditions reports the status of the cephConfig in the conditions of the cluster.
+// The conditions are only reported once the cluster has a cephConfig.
+func ExportCephConfigConditions(context *clusterd.Context, namespaceName types.NamespacedName, cephConfig map[string]map[string]string, status *CephConfigStatus) {
+	if len(cephConfig) == 0 && conditionMap[cephv1.ConditionCephConfigDrift] == "" && conditionMap[cephv1.ConditionCephConfigInvalid] == "" {
+		return
+	}
+
+	if len(status.Drifted) > 0 {
+		message := fmt.Sprintf("Reverted the options changed manually: %s", strings.Join(status.Drifted, ", "))
+		if len(status.AllowedDrift) > 0 {
+			message = fmt.Sprintf("%s. Kept the allowed options changed manually: %s", message, strings.Join(status.AllowedDrift, ", "))
+		}
+		ConditionExport(context, namespaceName, cephv1.ConditionCephConfigDrift, v1.ConditionTrue, "ConfigDriftReverted", message)
+	} else if len(status.AllowedDrift) > 0 {
+		message := fmt.Sprintf("Kept the allowed options changed manually: %s", strings.Join(status.AllowedDrift, ", "))
+		ConditionExport(context, namespaceName, cephv1.ConditionCephConfigDrift, v1.ConditionTrue, "ConfigDriftAllowed", message)
+	} else {
+		ConditionExport(context, namespaceName, cephv1.ConditionCephConfigDrift, v1.ConditionFalse, "ConfigInSync", "Ceph config is in sync with the cephConfig")
+	}
+
+	var invalid []string
+	if len(status.Unknown) > 0 {
+		invalid = append(invalid, fmt.Sprintf("Unknown options: %s", strings.Join(status.Unknown, ", ")))
+	}
+	if len(status.Invalid) > 0 {
+		invalid = append(invalid, fmt.Sprintf("Invalid options: %s", strings.Join(status.Invalid, ", ")))
+	}
+	if len(invalid) > 0 {
+		ConditionExport(context, namespaceName, cephv1.ConditionCephConfigInvalid, v1.ConditionTrue, "InvalidConfig", strings.Join(invalid, ". "))
+	} else {
+		ConditionExport(context, namespaceName, cephv1.ConditionCephConfigInvalid, v1.ConditionFalse, "ConfigValid", "All the cephConfig options are valid")
+	}
+}
+
+// validSection returns whether the section is a daemon type, optionally followed by a daemon id
+// and a mask, such as "osd", "osd.0" or "osd/class:ssd"
+func validSection(who string) bool {
+	if who == "" || strings.HasSuffix(who, "/") {
+		return false
+	}
+	daemonType := strings.SplitN(strings.SplitN(who, "/", 2)[0], ".", 2)[0]
+	for _, sectionType := range configSectionTypes {
+		if daemonType == sectionType {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAllowedDrift parses the comma-separated options of the allowed drift annotation
+func parseAllowedDrift(allowedDrift string) map[string]bool {
+	allowed := map[string]bool{}
+	for _, key := range strings.Split(allowedDrift, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		// the section may have a mask with a slash, the option never has one
+		i := strings.LastIndex(key, "/")
+		if i < 0 {
+			continue
+		}
+		allowed[configKey(key[:i], normalizeKey(key[i+1:]))] = true
+	}
+	return allowed
+}
+
+func configKey(who, option string) string {
+	return who + "/" + option
+}
+
+func sortedSections(cephConfig map[string]map[string]string) []string {
+	sections := []string{}
+	for who := range cephConfig {
+		sections = append(sections, who)
+	}
+	sort.Strings(sections)
+	return sections
+}
+
+func sortedOptions(options map[string]string) []string {
+	names := []string{}
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
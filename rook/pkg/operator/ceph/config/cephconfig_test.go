@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/rook/rook/pkg/clusterd"
+	testop "github.com/rook/rook/pkg/operator/test"
+	exectest "github.com/rook/rook/pkg/util/exec/test"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCephConfigReconcile(t *testing.T) {
+	// a fake config database keyed by "<section>/<option>"
+	database := map[string]string{"global/mon_max_pg_per_osd": "250"}
+	setCount := 0
+	executor := &exectest.MockExecutor{
+		MockExecuteCommandWithOutputFile: func(command, outfile string, args ...string) (string, error) {
+			switch {
+			case args[0] == "config" && args[1] == "ls":
+				return `["mon_max_pg_per_osd","osd_max_backfills","osd_memory_target","bluestore_cache_size"]`, nil
+			case args[0] == "config" && args[1] == "dump":
+				entries := []configDumpEntry{}
+				for key, value := range database {
+					i := strings.LastIndex(key, "/")
+					who, mask := key[:i], ""
+					if j := strings.Index(who, "/"); j >= 0 {
+						who, mask = who[:j], who[j+1:]
+					}
+					entries = append(entries, configDumpEntry{Section: who, Name: key[i+1:], Value: value, Mask: mask})
+				}
+				out, _ := json.Marshal(entries)
+				return string(out), nil
+			case args[0] == "config" && args[1] == "set":
+				if args[4] == "invalid" {
+					return "Error EINVAL", errors.New("invalid value")
+				}
+				setCount++
+				// the mons normalize the sizes
+				value := strings.Replace(args[4], "4G", "4294967296", 1)
+				database[configKey(args[2], args[3])] = value
+				return "", nil
+			}
+			return "", errors.Errorf("unexpected command %v", args)
+		},
+	}
+	context := &clusterd.Context{Executor: executor, Clientset: testop.New(t, 1)}
+	r := NewCephConfigReconciler(context, "ns", metav1.OwnerReference{})
+
+	// nothing to do without cephConfig
+	status, err := r.Reconcile(nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &CephConfigStatus{}, status)
+
+	cephConfig := map[string]map[string]string{
+		"global":        {"mon_max_pg_per_osd": "250"},
+		"osd":           {"osd-max-backfills": "2", "osd_memory_target": "4G"},
+		"osd/class:ssd": {"osd_max_backfills": "4"},
+	}
+	status, err = r.Reconcile(cephConfig, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &CephConfigStatus{}, status)
+	assert.Equal(t, 3, setCount)
+	assert.Equal(t, "2", database["osd/osd_max_backfills"])
+	assert.Equal(t, "4", database["osd/class:ssd/osd_max_backfills"])
+	assert.Equal(t, "4294967296", database["osd/osd_memory_target"])
+
+	// in sync, the normalized values are not drift
+	setCount = 0
+	status, err = r.Reconcile(cephConfig, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &CephConfigStatus{}, status)
+	assert.Equal(t, 0, setCount)
+
+	// manual changes are reverted
+	database["osd/osd_max_backfills"] = "10"
+	delete(database, "global/mon_max_pg_per_osd")
+	status, err = r.Reconcile(cephConfig, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"global/mon_max_pg_per_osd", "osd/osd_max_backfills"}, status.Drifted)
+	assert.Equal(t, 2, setCount)
+	assert.Equal(t, "2", database["osd/osd_max_backfills"])
+	assert.Equal(t, "250", database["global/mon_max_pg_per_osd"])
+
+	// the applied options are kept across operator restarts, the drift is still detected
+	database["osd/osd_max_backfills"] = "10"
+	r = NewCephConfigReconciler(context, "ns", metav1.OwnerReference{})
+	status, err = r.Reconcile(cephConfig, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"osd/osd_max_backfills"}, status.Drifted)
+	assert.Equal(t, "2", database["osd/osd_max_backfills"])
+
+	// allowed manual changes are kept
+	setCount = 0
+	database["osd/osd_max_backfills"] = "10"
+	database["osd/class:ssd/osd_max_backfills"] = "8"
+	status, err = r.Reconcile(cephConfig, "osd/osd-max-backfills, osd/class:ssd/*")
+	assert.NoError(t, err)
+	assert.Empty(t, status.Drifted)
+	assert.Equal(t, []string{"osd/osd_max_backfills", "osd/class:ssd/osd_max_backfills"}, status.AllowedDrift)
+	assert.Equal(t, 0, setCount)
+	assert.Equal(t, "10", database["osd/osd_max_backfills"])
+
+	// a spec change is applied and is not drift, the drift no longer allowed is reverted
+	cephConfig["osd"]["osd-max-backfills"] = "3"
+	status, err = r.Reconcile(cephConfig, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"osd/class:ssd/osd_max_backfills"}, status.Drifted)
+	assert.Equal(t, "3", database["osd/osd_max_backfills"])
+	assert.Equal(t, "4", database["osd/class:ssd/osd_max_backfills"])
+
+	// unknown and invalid options are reported
+	setCount = 0
+	cephConfig["osd"]["osd_unknown"] = "1"
+	cephConfig["global"]["bluestore_cache_size"] = "invalid"
+	cephConfig["foo"] = map[string]string{"osd_max_backfills": "1"}
+	status, err = r.Reconcile(cephConfig, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"osd/osd_unknown"}, status.Unknown)
+	assert.Equal(t, []string{"foo/osd_max_backfills", "global/bluestore_cache_size"}, status.Invalid)
+	assert.Empty(t, status.Drifted)
+	assert.Equal(t, 0, setCount)
+
+	// the applied options are cleared without cephConfig
+	status, err = r.Reconcile(nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &CephConfigStatus{}, status)
+	_, err = context.Clientset.CoreV1().ConfigMaps("ns").Get(appliedConfigMapName, metav1.GetOptions{})
+	assert.True(t, kerrors.IsNotFound(err))
+}
+
+func TestValidSection(t *testing.T) {
+	assert.True(t, validSection("global"))
+	assert.True(t, validSection("osd"))
+	assert.True(t, validSection("osd.0"))
+	assert.True(t, validSection("mds.*"))
+	assert.True(t, validSection("client.rgw.my.store.a"))
+	assert.True(t, validSection("osd/class:ssd"))
+	assert.True(t, validSection("osd/host:node-1"))
+	assert.False(t, validSection(""))
+	assert.False(t, validSection("foo"))
+	assert.False(t, validSection("osd/"))
+	assert.False(t, validSection("osds"))
+}
+
+func TestParseAllowedDrift(t *testing.T) {
+	assert.Empty(t, parseAllowedDrift(""))
+	assert.Equal(t, map[string]bool{
+		"osd/osd_max_backfills":           true,
+		"osd/class:ssd/osd_max_backfills": true,
+		"global/*":                        true,
+	}, parseAllowedDrift("osd/osd-max-backfills, osd/class:ssd/osd_max_backfills,global/*,invalid"))
+}
@@ -80,7 +80,7 @@ func setCondition(c *clusterd.Context, namespaceName types.NamespacedName, newCo
 	}
 	cluster.Status.Conditions = *conditions
 
-	if newCondition.Status == v1.ConditionTrue {
+	if newCondition.Status == v1.ConditionTrue && isPhaseCondition(newCondition.Type) {
 		cluster.Status.Phase = newCondition.Type
 		if state := translatePhasetoState(newCondition.Type); state != "" {
 			cluster.Status.State = state
@@ -128,6 +128,12 @@ func translatePhasetoState(phase cephv1.ConditionType) cephv1.ClusterState {
 	}
 }
 
+// isPhaseCondition returns whether the condition is a phase of the cluster, the cephConfig conditions
+// are reported alongside the phase
+func isPhaseCondition(conditionType cephv1.ConditionType) bool {
+	return conditionType != cephv1.ConditionCephConfigDrift && conditionType != cephv1.ConditionCephConfigInvalid
+}
+
 // Updating the status of Progressing, Updating or Upgrading to False once cluster is Ready
 func checkConditionFalse(context *clusterd.Context, namespaceName types.NamespacedName) {
 	tempConditionList := []cephv1.ConditionType{cephv1.ConditionUpdating, cephv1.ConditionUpgrading, cephv1.ConditionProgressing}
@@ -113,6 +113,55 @@ func (m *MonStore) GetDaemon(who string) ([]Option, error) {
 	return daemonOptions, nil
 }
 
+// configDumpEntry is an option of the output of "ceph config dump"
+type configDumpEntry struct {
+	Section string `json:"section"`
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Mask    string `json:"mask"`
+}
+
+// Dump retrieves all configs in the centralized mon configuration database. The who of the options
+// set with a mask is "<section>/<mask>", such as "osd/class:ssd".
+func (m *MonStore) Dump() ([]Option, error) {
+	args := []string{"config", "dump"}
+	cephCmd := client.NewCephCommand(m.context, m.namespace, args)
+	out, err := cephCmd.Run()
+	if err != nil {
+		return []Option{}, errors.Wrapf(err, "failed to dump config. output: %s", string(out))
+	}
+	var entries []configDumpEntry
+	err = json.Unmarshal(out, &entries)
+	if err != nil {
+		return []Option{}, errors.Wrapf(err, "failed to parse json config dump. json: %s", string(out))
+	}
+	options := []Option{}
+	for _, entry := range entries {
+		who := entry.Section
+		if entry.Mask != "" {
+			who = who + "/" + entry.Mask
+		}
+		options = append(options, Option{who, entry.Name, entry.Value})
+	}
+	return options, nil
+}
+
+// ListOptions retrieves the names of all the config options known by the mons.
+func (m *MonStore) ListOptions() ([]string, error) {
+	args := []string{"config", "ls"}
+	cephCmd := client.NewCephCommand(m.context, m.namespace, args)
+	out, err := cephCmd.Run()
+	if err != nil {
+		return []string{}, errors.Wrapf(err, "failed to list config options. output: %s", string(out))
+	}
+	var names []string
+	err = json.Unmarshal(out, &names)
+	if err != nil {
+		return []string{}, errors.Wrapf(err, "failed to parse json config options. json: %s", string(out))
+	}
+	return names, nil
+}
+
 // DeleteDaemon delete all configs for a specific daemon in the centralized mon configuration database.
 func (m *MonStore) DeleteDaemon(who string) error {
 	configOptions, err := m.GetDaemon(who)
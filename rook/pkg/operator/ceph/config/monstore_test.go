@@ -155,6 +155,49 @@ func TestMonStore_GetDaemon(t *testing.T) {
 	assert.Contains(t, execedCmd, " config get mon.* ")
 }
 
+func TestMonStore_Dump(t *testing.T) {
+	executor := &exectest.MockExecutor{}
+	clientset := testop.New(t, 1)
+	ctx := &clusterd.Context{
+		Clientset: clientset,
+		Executor:  executor,
+	}
+
+	execedCmd := ""
+	execReturn := "[{\"section\":\"global\",\"name\":\"mon_max_pg_per_osd\",\"value\":\"250\",\"level\":\"advanced\"," +
+		"\"can_update_at_runtime\":true,\"mask\":\"\"}," +
+		"{\"section\":\"osd\",\"name\":\"osd_max_backfills\",\"value\":\"4\",\"level\":\"advanced\"," +
+		"\"can_update_at_runtime\":true,\"mask\":\"class:ssd\",\"location_type\":\"class\",\"location_value\":\"ssd\"}]"
+	execInjectErr := false
+	executor.MockExecuteCommandWithOutputFile =
+		func(command string, outfile string, args ...string) (string, error) {
+			execedCmd = command + " " + strings.Join(args, " ")
+			if execInjectErr {
+				return "output from cmd with error", errors.New("mocked error")
+			}
+			return execReturn, nil
+		}
+
+	monStore := GetMonStore(ctx, "ns")
+
+	// ceph config dump called as expected, the mask is added to the section
+	options, e := monStore.Dump()
+	assert.NoError(t, e)
+	assert.Contains(t, execedCmd, "ceph config dump")
+	assert.Equal(t, []Option{{"global", "mon_max_pg_per_osd", "250"}, {"osd/class:ssd", "osd_max_backfills", "4"}}, options)
+
+	// json parse exception return as expected
+	execReturn = "bad json output"
+	_, e = monStore.Dump()
+	assert.Error(t, e)
+	assert.Contains(t, e.Error(), "failed to parse json config dump")
+
+	// errors returned as expected
+	execInjectErr = true
+	_, e = monStore.Dump()
+	assert.Error(t, e)
+}
+
 func TestMonStore_DeleteDaemon(t *testing.T) {
 	executor := &exectest.MockExecutor{}
 	clientset := testop.New(t, 1)
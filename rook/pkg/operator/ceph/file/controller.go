@@ -74,6 +74,12 @@ type ReconcileCephFilesystem struct {
 	context         *clusterd.Context
 	cephClusterSpec *cephv1.ClusterSpec
 	clusterInfo     *cephconfig.ClusterInfo
+	fsChannels      map[string]*fsHealth
+}
+
+type fsHealth struct {
+	stopChan          chan struct{}
+	monitoringRunning bool
 }
 
 // Add creates a new CephFilesystem Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -89,9 +95,10 @@ func newReconciler(mgr manager.Manager, context *clusterd.Context) reconcile.Rec
 	cephv1.AddToScheme(mgr.GetScheme())
 
 	return &ReconcileCephFilesystem{
-		client:  mgr.GetClient(),
-		scheme:  mgrScheme,
-		context: context,
+		client:     mgr.GetClient(),
+		scheme:     mgrScheme,
+		context:    context,
+		fsChannels: make(map[string]*fsHealth),
 	}
 }
 
@@ -202,6 +209,10 @@ func (r *ReconcileCephFilesystem) reconcile(request reconcile.Request) (reconcil
 	// DELETE: the CR was deleted
 	if !cephFilesystem.GetDeletionTimestamp().IsZero() {
 		logger.Debugf("deleting filesystem %q", cephFilesystem.Name)
+
+		// Stop the status check of the filesystem
+		r.stopMonitoring(request.NamespacedName)
+
 		err = r.reconcileDeleteFilesystem(cephFilesystem)
 		if err != nil {
 			return reconcile.Result{}, errors.Wrapf(err, "failed to delete filesystem %q. ", cephFilesystem.Name)
@@ -230,6 +241,24 @@ func (r *ReconcileCephFilesystem) reconcile(request reconcile.Request) (reconcil
 		return reconcileResponse, err
 	}
 
+	// SNAPSHOT SCHEDULES
+	var snapshotScheduleStatus *cephv1.SnapshotScheduleStatusSpec
+	if cephFilesystem.Status != nil {
+		snapshotScheduleStatus = cephFilesystem.Status.SnapshotScheduleStatus
+	}
+	err = ReconcileSnapshotSchedules(r.context, cephFilesystem.Namespace, cephFilesystem.Name, filesystemRootPath, r.clusterInfo.CephVersion, cephFilesystem.Spec.SnapshotSchedules, snapshotScheduleStatus)
+	if err != nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus)
+		return reconcile.Result{}, errors.Wrapf(err, "failed to configure snapshot schedules of filesystem %q", cephFilesystem.Name)
+	}
+
+	// Start the status check of the snapshot schedules
+	if cephFilesystem.Spec.SnapshotSchedules.IsEnabled() {
+		r.startMonitoring(request.NamespacedName)
+	} else {
+		r.stopMonitoring(request.NamespacedName)
+	}
+
 	// Set Ready status, we are done reconciling
 	updateStatus(r.client, request.NamespacedName, k8sutil.ReadyStatus)
 
@@ -292,13 +321,51 @@ func updateStatus(client client.Client, name types.NamespacedName, status string
 	}
 
 	if fs.Status == nil {
-		fs.Status = &cephv1.Status{}
+		fs.Status = &cephv1.CephFilesystemStatus{}
 	}
 
 	fs.Status.Phase = status
+	if !fs.Spec.SnapshotSchedules.IsEnabled() {
+		fs.Status.SnapshotScheduleStatus = nil
+	}
 	if err := opcontroller.UpdateStatus(client, fs); err != nil {
 		logger.Errorf("failed to set filesystem %q status to %q. %v", fs.Name, status, err)
 		return
 	}
 	logger.Debugf("filesystem %q status updated to %q", name, status)
 }
+
+func (r *ReconcileCephFilesystem) startMonitoring(fsName types.NamespacedName) {
+	// Initialize the channel for this filesystem
+	// This allows us to track multiple filesystems in the same namespace
+	channel, ok := r.fsChannels[fsName.String()]
+	if !ok {
+		channel = &fsHealth{stopChan: make(chan struct{})}
+		r.fsChannels[fsName.String()] = channel
+	}
+
+	if channel.monitoringRunning {
+		logger.Debugf("filesystem %q monitoring go routine already running", fsName.Name)
+		return
+	}
+
+	// Set the monitoring flag so we don't start more than one go routine
+	channel.monitoringRunning = true
+
+	checker := newFSStatusChecker(r.context, r.client, fsName)
+	logger.Infof("starting status check of filesystem %q", fsName.Name)
+	go checker.checkFSStatus(channel.stopChan)
+}
+
+func (r *ReconcileCephFilesystem) stopMonitoring(fsName types.NamespacedName) {
+	channel, ok := r.fsChannels[fsName.String()]
+	if !ok {
+		return
+	}
+
+	// Close the channel to stop the status check of the filesystem
+	close(channel.stopChan)
+
+	// Remove the filesystem from the map
+	delete(r.fsChannels, fsName.String())
+}
@@ -143,6 +143,9 @@ func validateFilesystem(context *clusterd.Context, f *cephv1.CephFilesystem) err
 	if f.Spec.MetadataServer.ActiveCount < 1 {
 		return errors.New("MetadataServer.ActiveCount must be at least 1")
 	}
+	if err := ValidateSnapshotSchedules(f.Spec.SnapshotSchedules); err != nil {
+		return errors.Wrap(err, "invalid snapshot schedules")
+	}
 	// No data pool means that we expect the fs to exist already
 	if len(f.Spec.DataPools) == 0 {
 		return nil
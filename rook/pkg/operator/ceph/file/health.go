@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"context"
+	"time"
+
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	opcontroller "github.com/rook/rook/pkg/operator/ceph/controller"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultStatusCheckInterval = 1 * time.Minute
+
+	// the snapshot schedules of a filesystem are relative to its root
+	filesystemRootPath = "/"
+)
+
+// fsStatusChecker aggregates the filesystem info needed to check the snapshot schedules of a filesystem
+type fsStatusChecker struct {
+	context        *clusterd.Context
+	interval       time.Duration
+	client         client.Client
+	namespacedName types.NamespacedName
+}
+
+// newFSStatusChecker creates a new fsStatusChecker object
+func newFSStatusChecker(context *clusterd.Context, client client.Client, namespacedName types.NamespacedName) *fsStatusChecker {
+	return &fsStatusChecker{
+		context:        context,
+		interval:       defaultStatusCheckInterval,
+		client:         client,
+		namespacedName: namespacedName,
+	}
+}
+
+// checkFSStatus periodically checks the snapshot schedules of the filesystem
+func (c *fsStatusChecker) checkFSStatus(stopCh chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			logger.Infof("stopping monitoring of filesystem %q", c.namespacedName.Name)
+			return
+
+		case <-time.After(c.interval):
+			logger.Debugf("checking status of filesystem %q", c.namespacedName.Name)
+			c.checkStatus()
+		}
+	}
+}
+
+// checkStatus queries the snapshot schedules of the filesystem then updates the CR status
+func (c *fsStatusChecker) checkStatus() {
+	fs := &cephv1.CephFilesystem{}
+	if err := c.client.Get(context.TODO(), c.namespacedName, fs); err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephFilesystem resource not found. Ignoring since object must be deleted.")
+			return
+		}
+		logger.Warningf("failed to retrieve filesystem %q to update status. %v", c.namespacedName, err)
+		return
+	}
+
+	if fs.Status == nil {
+		fs.Status = &cephv1.CephFilesystemStatus{}
+	}
+
+	if fs.Spec.SnapshotSchedules.IsEnabled() {
+		fs.Status.SnapshotScheduleStatus = GetSnapshotScheduleStatus(c.context, c.namespacedName.Namespace, c.namespacedName.Name, filesystemRootPath, fs.Spec.SnapshotSchedules)
+	} else {
+		fs.Status.SnapshotScheduleStatus = nil
+	}
+
+	if err := opcontroller.UpdateStatus(c.client, fs); err != nil {
+		logger.Errorf("failed to update filesystem %q status. %v", c.namespacedName, err)
+		return
+	}
+	logger.Debugf("filesystem %q status updated", c.namespacedName)
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	cephclient "github.com/rook/rook/pkg/daemon/ceph/client"
+	cephver "github.com/rook/rook/pkg/operator/ceph/version"
+)
+
+var (
+	// the retention spec is a list of counts followed by a period, e.g. "24h7d", the period "n" keeps the last n snapshots
+	retentionRegex       = regexp.MustCompile(`^([0-9]+[hdwmyn])+$`)
+	retentionPeriodRegex = regexp.MustCompile(`([0-9]+)([hdwmyn])`)
+)
+
+// ValidateSnapshotSchedules validates the snapshot schedules and retention policies of a filesystem or subvolume group
+func ValidateSnapshotSchedules(spec cephv1.SnapshotSchedulesSpec) error {
+	for _, s := range spec.Schedules {
+		if s.Interval == "" {
+			return errors.Errorf("snapshot schedule interval of path %q is required", s.Path)
+		}
+	}
+	for _, r := range spec.Retention {
+		if _, err := parseRetention(r.Duration); err != nil {
+			return errors.Wrapf(err, "invalid snapshot retention of path %q", r.Path)
+		}
+	}
+	return nil
+}
+
+// ReconcileSnapshotSchedules adds the snapshot schedules and retention policies of the spec to their paths under the
+// root path and removes the ones which are not in the spec anymore. The paths of the previous status are reconciled as
+// well so that the schedules of the paths removed from the spec are removed. Failing to apply a retention policy is
+// not fatal, it is reported in the status of the snapshot schedules.
+func ReconcileSnapshotSchedules(context *clusterd.Context, namespace, fsName, rootPath string, cephVersion cephver.CephVersion, spec cephv1.SnapshotSchedulesSpec, status *cephv1.SnapshotScheduleStatusSpec) error {
+	paths := snapshotPaths(rootPath, spec)
+	if status != nil {
+		for _, s := range status.SnapshotSchedules {
+			paths = appendPath(paths, s.Path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	if spec.IsEnabled() {
+		if !cephVersion.IsAtLeastPacific() {
+			return errors.New("snapshot schedules are only supported from Ceph Pacific")
+		}
+		if err := cephclient.MgrEnableModule(context, namespace, cephclient.SnapScheduleModuleName, false); err != nil {
+			return errors.Wrapf(err, "failed to enable mgr module %q", cephclient.SnapScheduleModuleName)
+		}
+	}
+
+	for _, p := range paths {
+		current, err := cephclient.GetSnapshotScheduleStatus(context, namespace, fsName, p)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get snapshot schedules of path %q", p)
+		}
+
+		// add the missing schedules, then remove the ones not in the spec anymore
+		desired := map[string]bool{}
+		for _, s := range spec.Schedules {
+			if snapshotPath(rootPath, s.Path) != p {
+				continue
+			}
+			desired[s.Interval] = true
+			if !hasSchedule(current, s.Interval) {
+				if err := cephclient.AddSnapshotScheduleToPath(context, namespace, fsName, p, s.Interval, s.StartTime); err != nil {
+					return err
+				}
+			}
+		}
+		for _, s := range current {
+			if !desired[s.Schedule] {
+				if err := cephclient.RemoveSnapshotScheduleFromPath(context, namespace, fsName, p, s.Schedule, s.Start); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(desired) > 0 {
+			reconcileSnapshotRetention(context, namespace, fsName, rootPath, p, spec, current)
+		}
+	}
+
+	return nil
+}
+
+// reconcileSnapshotRetention sets the retention policies of a path, the failures are only logged since they are
+// reported in the status of the snapshot schedules
+func reconcileSnapshotRetention(context *clusterd.Context, namespace, fsName, rootPath, p string, spec cephv1.SnapshotSchedulesSpec, current []cephclient.SnapshotScheduleStatus) {
+	desired := desiredRetention(rootPath, p, spec)
+	applied := map[string]int{}
+	if len(current) > 0 {
+		applied = current[0].Retention
+	}
+
+	for period, count := range desired {
+		if applied[period] != count {
+			retention := fmt.Sprintf("%d%s", count, period)
+			if err := cephclient.AddSnapshotRetention(context, namespace, fsName, p, retention); err != nil {
+				logger.Warningf("failed to apply snapshot retention %q of path %q. %v", retention, p, err)
+			}
+		}
+	}
+	for period, count := range applied {
+		if _, ok := desired[period]; !ok {
+			retention := fmt.Sprintf("%d%s", count, period)
+			if err := cephclient.RemoveSnapshotRetention(context, namespace, fsName, p, retention); err != nil {
+				logger.Warningf("failed to remove snapshot retention %q of path %q. %v", retention, p, err)
+			}
+		}
+	}
+}
+
+// GetSnapshotScheduleStatus returns the status of the snapshot schedules of the spec. The retention policies of the
+// spec which are not applied in Ceph are reported in the details of the status.
+func GetSnapshotScheduleStatus(context *clusterd.Context, namespace, fsName, rootPath string, spec cephv1.SnapshotSchedulesSpec) *cephv1.SnapshotScheduleStatusSpec {
+	s := &cephv1.SnapshotScheduleStatusSpec{
+		LastChecked: time.Now().UTC().Format(time.RFC3339),
+	}
+	var details []string
+
+	for _, p := range snapshotPaths(rootPath, spec) {
+		current, err := cephclient.GetSnapshotScheduleStatus(context, namespace, fsName, p)
+		if err != nil {
+			logger.Warningf("failed to get snapshot schedules of path %q in filesystem %q. %v", p, fsName, err)
+			details = append(details, err.Error())
+			continue
+		}
+		for _, c := range current {
+			s.SnapshotSchedules = append(s.SnapshotSchedules, toSnapshotSchedulePathStatus(c))
+		}
+
+		applied := map[string]int{}
+		if len(current) > 0 {
+			applied = current[0].Retention
+		}
+		for period, count := range desiredRetention(rootPath, p, spec) {
+			if applied[period] != count {
+				details = append(details, fmt.Sprintf("snapshot retention \"%d%s\" of path %q is not applied", count, period, p))
+			}
+		}
+	}
+
+	// the retention policies are iterated from maps
+	sort.Strings(details)
+	s.Details = strings.Join(details, "; ")
+	return s
+}
+
+func toSnapshotSchedulePathStatus(s cephclient.SnapshotScheduleStatus) cephv1.SnapshotSchedulePathStatus {
+	return cephv1.SnapshotSchedulePathStatus{
+		Path:         s.Path,
+		Schedule:     s.Schedule,
+		StartTime:    s.Start,
+		Retention:    s.Retention,
+		LastSnapshot: s.Last,
+		LastPruned:   s.LastPruned,
+		CreatedCount: s.CreatedCount,
+		PrunedCount:  s.PrunedCount,
+		Active:       s.Active,
+	}
+}
+
+// desiredRetention merges the retention policies of the spec for a path into a count for each period
+func desiredRetention(rootPath, p string, spec cephv1.SnapshotSchedulesSpec) map[string]int {
+	desired := map[string]int{}
+	for _, r := range spec.Retention {
+		if snapshotPath(rootPath, r.Path) != p {
+			continue
+		}
+		// the retention was validated already
+		periods, _ := parseRetention(r.Duration)
+		for period, count := range periods {
+			desired[period] = count
+		}
+	}
+	return desired
+}
+
+// parseRetention parses a retention spec such as "24h7d" into a count for each period
+func parseRetention(retention string) (map[string]int, error) {
+	if !retentionRegex.MatchString(retention) {
+		return nil, errors.Errorf("retention %q must be a list of counts followed by one of the periods h, d, w, m, y or n, e.g. \"24h7d\"", retention)
+	}
+	periods := map[string]int{}
+	for _, match := range retentionPeriodRegex.FindAllStringSubmatch(retention, -1) {
+		count, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid count in retention %q", retention)
+		}
+		periods[match[2]] = count
+	}
+	return periods, nil
+}
+
+// snapshotPaths returns the distinct paths of the snapshot schedules and retention policies of the spec
+func snapshotPaths(rootPath string, spec cephv1.SnapshotSchedulesSpec) []string {
+	var paths []string
+	for _, s := range spec.Schedules {
+		paths = appendPath(paths, snapshotPath(rootPath, s.Path))
+	}
+	for _, r := range spec.Retention {
+		paths = appendPath(paths, snapshotPath(rootPath, r.Path))
+	}
+	return paths
+}
+
+func snapshotPath(rootPath, p string) string {
+	return path.Join(rootPath, p)
+}
+
+func appendPath(paths []string, p string) []string {
+	for _, existing := range paths {
+		if existing == p {
+			return paths
+		}
+	}
+	return append(paths, p)
+}
+
+func hasSchedule(schedules []cephclient.SnapshotScheduleStatus, interval string) bool {
+	for _, s := range schedules {
+		if s.Schedule == interval {
+			return true
+		}
+	}
+	return false
+}
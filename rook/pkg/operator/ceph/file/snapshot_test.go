@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package file
+
+import (
+	"strings"
+	"testing"
+
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	cephver "github.com/rook/rook/pkg/operator/ceph/version"
+	exectest "github.com/rook/rook/pkg/util/exec/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRetention(t *testing.T) {
+	periods, err := parseRetention("24h")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"h": 24}, periods)
+
+	periods, err = parseRetention("7d4w12n")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"d": 7, "w": 4, "n": 12}, periods)
+
+	for _, invalid := range []string{"", "h", "24", "24x", "h 24", "24h-1d"} {
+		_, err = parseRetention(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestValidateSnapshotSchedules(t *testing.T) {
+	spec := cephv1.SnapshotSchedulesSpec{}
+	assert.NoError(t, ValidateSnapshotSchedules(spec))
+
+	spec.Schedules = []cephv1.SnapshotScheduleSpec{{Path: "/", Interval: "1h"}}
+	spec.Retention = []cephv1.SnapshotScheduleRetentionSpec{{Path: "/", Duration: "24h"}}
+	assert.NoError(t, ValidateSnapshotSchedules(spec))
+
+	// the interval is required
+	spec.Schedules = append(spec.Schedules, cephv1.SnapshotScheduleSpec{Path: "/"})
+	assert.Error(t, ValidateSnapshotSchedules(spec))
+
+	spec.Schedules = spec.Schedules[:1]
+	spec.Retention[0].Duration = "forever"
+	assert.Error(t, ValidateSnapshotSchedules(spec))
+}
+
+func TestReconcileSnapshotSchedules(t *testing.T) {
+	var commands []string
+	statusOutput := map[string]string{}
+	executor := &exectest.MockExecutor{
+		MockExecuteCommandWithOutputFile: func(command, outfile string, args ...string) (string, error) {
+			if args[0] == "fs" && args[1] == "snap-schedule" && args[2] == "status" {
+				return statusOutput[args[3]], nil
+			}
+			if args[0] == "mgr" {
+				return "", nil
+			}
+			// the trailing arguments are the cluster connection and format flags
+			commands = append(commands, strings.Join(args[:indexOf(args, "--fs")], " "))
+			return "", nil
+		},
+	}
+	context := &clusterd.Context{Executor: executor}
+	spec := cephv1.SnapshotSchedulesSpec{
+		Schedules: []cephv1.SnapshotScheduleSpec{
+			{Interval: "1h"},
+			{Path: "archive", Interval: "1d", StartTime: "2020-09-01T00:00:00"},
+		},
+		Retention: []cephv1.SnapshotScheduleRetentionSpec{
+			{Duration: "24h7d"},
+		},
+	}
+
+	// snapshot schedules require pacific
+	err := ReconcileSnapshotSchedules(context, "ns", "myfs", "/volumes/csi", cephver.Octopus, spec, nil)
+	assert.Error(t, err)
+	assert.Empty(t, commands)
+
+	// the schedules and the retention are added, the stale schedule and retention are removed
+	statusOutput["/volumes/csi"] = `[{"path": "/volumes/csi", "schedule": "30m", "start": "2020-09-01T00:00:00", "retention": {"h": 12, "m": 1}}]`
+	err = ReconcileSnapshotSchedules(context, "ns", "myfs", "/volumes/csi", cephver.Pacific, spec, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, commands, "fs snap-schedule add /volumes/csi 1h")
+	assert.Contains(t, commands, "fs snap-schedule remove /volumes/csi 30m 2020-09-01T00:00:00")
+	assert.Contains(t, commands, "fs snap-schedule retention add /volumes/csi 24h")
+	assert.Contains(t, commands, "fs snap-schedule retention add /volumes/csi 7d")
+	assert.Contains(t, commands, "fs snap-schedule retention remove /volumes/csi 1m")
+	assert.Contains(t, commands, "fs snap-schedule add /volumes/csi/archive 1d 2020-09-01T00:00:00")
+	assert.NotContains(t, commands, "fs snap-schedule retention add /volumes/csi/archive 24h")
+
+	// nothing to do when the schedules are applied already
+	commands = nil
+	statusOutput["/volumes/csi"] = `[{"path": "/volumes/csi", "schedule": "1h", "retention": {"h": 24, "d": 7}}]`
+	statusOutput["/volumes/csi/archive"] = `[{"path": "/volumes/csi/archive", "schedule": "1d"}]`
+	err = ReconcileSnapshotSchedules(context, "ns", "myfs", "/volumes/csi", cephver.Pacific, spec, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, commands)
+
+	// the paths of the previous status are cleaned up when removed from the spec
+	commands = nil
+	status := &cephv1.SnapshotScheduleStatusSpec{
+		SnapshotSchedules: []cephv1.SnapshotSchedulePathStatus{{Path: "/volumes/csi/archive", Schedule: "1d"}},
+	}
+	err = ReconcileSnapshotSchedules(context, "ns", "myfs", "/volumes/csi", cephver.Pacific, cephv1.SnapshotSchedulesSpec{}, status)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fs snap-schedule remove /volumes/csi/archive 1d"}, commands)
+}
+
+func TestGetSnapshotScheduleStatus(t *testing.T) {
+	executor := &exectest.MockExecutor{
+		MockExecuteCommandWithOutputFile: func(command, outfile string, args ...string) (string, error) {
+			if args[0] == "fs" && args[1] == "snap-schedule" && args[2] == "status" && args[3] == "/" {
+				return `[{"fs": "myfs", "path": "/", "schedule": "1h", "start": "2020-09-01T00:00:00", "retention": {"h": 24}, "last": "2020-09-02T10:00:00", "created_count": 34, "pruned_count": 10, "active": true}]`, nil
+			}
+			return "", nil
+		},
+	}
+	context := &clusterd.Context{Executor: executor}
+	spec := cephv1.SnapshotSchedulesSpec{
+		Schedules: []cephv1.SnapshotScheduleSpec{{Interval: "1h"}},
+		Retention: []cephv1.SnapshotScheduleRetentionSpec{{Duration: "24h4w"}},
+	}
+
+	status := GetSnapshotScheduleStatus(context, "ns", "myfs", filesystemRootPath, spec)
+	assert.NotEmpty(t, status.LastChecked)
+	assert.Equal(t, []cephv1.SnapshotSchedulePathStatus{
+		{
+			Path:         "/",
+			Schedule:     "1h",
+			StartTime:    "2020-09-01T00:00:00",
+			Retention:    map[string]int{"h": 24},
+			LastSnapshot: "2020-09-02T10:00:00",
+			CreatedCount: 34,
+			PrunedCount:  10,
+			Active:       true,
+		},
+	}, status.SnapshotSchedules)
+	// the weekly retention was not applied
+	assert.Equal(t, `snapshot retention "4w" of path "/" is not applied`, status.Details)
+}
+
+func indexOf(args []string, arg string) int {
+	for i, a := range args {
+		if a == arg {
+			return i
+		}
+	}
+	return len(args)
+}
@@ -0,0 +1,409 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package subvolumegroup to manage the subvolume groups of a CephFS filesystem.
+package subvolumegroup
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/coreos/pkg/capnslog"
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	cephclient "github.com/rook/rook/pkg/daemon/ceph/client"
+	opcontroller "github.com/rook/rook/pkg/operator/ceph/controller"
+	"github.com/rook/rook/pkg/operator/ceph/file"
+	cephver "github.com/rook/rook/pkg/operator/ceph/version"
+	"github.com/rook/rook/pkg/operator/k8sutil"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	controllerName = "ceph-fs-subvolumegroup-controller"
+
+	// SubVolumeGroupPathKey is the key of the path of the subvolume group in the status info
+	SubVolumeGroupPathKey = "path"
+)
+
+var logger = capnslog.NewPackageLogger("github.com/rook/rook", controllerName)
+
+// resizeMinVersion is the first version of Ceph able to resize subvolume groups
+var resizeMinVersion = cephver.CephVersion{Major: 17, Minor: 2, Extra: 1}
+
+var cephFilesystemSubVolumeGroupKind = reflect.TypeOf(cephv1.CephFilesystemSubVolumeGroup{}).Name()
+
+// Sets the type meta for the controller main object
+var controllerTypeMeta = metav1.TypeMeta{
+	Kind:       cephFilesystemSubVolumeGroupKind,
+	APIVersion: fmt.Sprintf("%s/%s", cephv1.CustomResourceGroup, cephv1.Version),
+}
+
+var _ reconcile.Reconciler = &ReconcileCephFilesystemSubVolumeGroup{}
+
+// ReconcileCephFilesystemSubVolumeGroup reconciles a CephFilesystemSubVolumeGroup object
+type ReconcileCephFilesystemSubVolumeGroup struct {
+	client                 client.Client
+	scheme                 *runtime.Scheme
+	context                *clusterd.Context
+	subVolumeGroupChannels map[string]*subVolumeGroupHealth
+}
+
+type subVolumeGroupHealth struct {
+	stopChan          chan struct{}
+	monitoringRunning bool
+}
+
+// Add creates a new CephFilesystemSubVolumeGroup Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager, context *clusterd.Context) error {
+	return add(mgr, newReconciler(mgr, context))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager, context *clusterd.Context) reconcile.Reconciler {
+	// Add the cephv1 scheme to the manager scheme so that the controller knows about it
+	mgrScheme := mgr.GetScheme()
+	cephv1.AddToScheme(mgr.GetScheme())
+
+	return &ReconcileCephFilesystemSubVolumeGroup{
+		client:                 mgr.GetClient(),
+		scheme:                 mgrScheme,
+		context:                context,
+		subVolumeGroupChannels: make(map[string]*subVolumeGroupHealth),
+	}
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New(controllerName, mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+	logger.Info("successfully started")
+
+	// Watch for changes on the CephFilesystemSubVolumeGroup CRD object
+	err = c.Watch(&source.Kind{Type: &cephv1.CephFilesystemSubVolumeGroup{TypeMeta: controllerTypeMeta}}, &handler.EnqueueRequestForObject{}, opcontroller.WatchControllerPredicate())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reconcile reads that state of the cluster for a CephFilesystemSubVolumeGroup object and makes changes based on the state read
+// and what is in the CephFilesystemSubVolumeGroup.Spec
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *ReconcileCephFilesystemSubVolumeGroup) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	// workaround because the rook logging mechanism is not compatible with the controller-runtime loggin interface
+	reconcileResponse, err := r.reconcile(request)
+	if err != nil {
+		logger.Errorf("failed to reconcile %v", err)
+	}
+
+	return reconcileResponse, err
+}
+
+func (r *ReconcileCephFilesystemSubVolumeGroup) reconcile(request reconcile.Request) (reconcile.Result, error) {
+	// Fetch the CephFilesystemSubVolumeGroup instance
+	subVolumeGroup := &cephv1.CephFilesystemSubVolumeGroup{}
+	err := r.client.Get(context.TODO(), request.NamespacedName, subVolumeGroup)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephFilesystemSubVolumeGroup resource not found. Ignoring since object must be deleted.")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, errors.Wrap(err, "failed to get CephFilesystemSubVolumeGroup")
+	}
+
+	// The CR was just created, initializing status fields
+	if subVolumeGroup.Status == nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.Created, nil)
+	}
+
+	// Make sure a CephCluster is present otherwise do nothing
+	cephCluster, isReadyToReconcile, cephClusterExists, reconcileResponse := opcontroller.IsReadyToReconcile(r.client, r.context, request.NamespacedName, controllerName)
+	if !isReadyToReconcile {
+		// This handles the case where the Ceph Cluster is gone and we want to delete that CR
+		// We skip the deleteSubVolumeGroup() function since everything is gone already
+		//
+		// Also, only remove the finalizer if the CephCluster is gone
+		// If not, we should wait for it to be ready
+		// This handles the case where the operator is not ready to accept Ceph command but the cluster exists
+		if !subVolumeGroup.GetDeletionTimestamp().IsZero() && !cephClusterExists {
+			// Remove finalizer
+			err = opcontroller.RemoveFinalizer(r.client, subVolumeGroup)
+			if err != nil {
+				return reconcile.Result{}, errors.Wrap(err, "failed to remove finalizer")
+			}
+
+			// Return and do not requeue. Successful deletion.
+			return reconcile.Result{}, nil
+		}
+		return reconcileResponse, nil
+	}
+
+	// Set a finalizer so we can do cleanup before the object goes away
+	err = opcontroller.AddFinalizerIfNotPresent(r.client, subVolumeGroup)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "failed to add finalizer")
+	}
+
+	// Get CephCluster version
+	cephVersion, err := opcontroller.GetImageVersion(cephCluster)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to fetch ceph version from cephcluster %q", cephCluster.Name)
+	}
+
+	// DELETE: the CR was deleted
+	if !subVolumeGroup.GetDeletionTimestamp().IsZero() {
+		logger.Debugf("deleting subvolume group %q", subVolumeGroup.Name)
+
+		// Stop the status check of the subvolume group
+		r.stopMonitoring(request.NamespacedName)
+
+		err := deleteSubVolumeGroup(r.context, subVolumeGroup, *cephVersion)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrapf(err, "failed to delete subvolume group %q. ", subVolumeGroup.Name)
+		}
+
+		// Remove finalizer
+		err = opcontroller.RemoveFinalizer(r.client, subVolumeGroup)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to remove finalizer")
+		}
+
+		// Return and do not requeue. Successful deletion.
+		return reconcile.Result{}, nil
+	}
+
+	// validate the subvolume group settings
+	if err := validateSubVolumeGroup(subVolumeGroup, *cephVersion); err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "invalid subvolume group CR %q spec", subVolumeGroup.Name)
+	}
+
+	updateStatus(r.client, request.NamespacedName, k8sutil.ReconcilingStatus, nil)
+
+	// CREATE/UPDATE
+	groupPath, err := createSubVolumeGroup(r.context, subVolumeGroup, *cephVersion)
+	if err != nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+		return reconcile.Result{}, errors.Wrapf(err, "failed to create subvolume group %q.", subVolumeGroup.Name)
+	}
+
+	// SNAPSHOT SCHEDULES
+	var snapshotScheduleStatus *cephv1.SnapshotScheduleStatusSpec
+	if subVolumeGroup.Status != nil {
+		snapshotScheduleStatus = subVolumeGroup.Status.SnapshotScheduleStatus
+	}
+	err = file.ReconcileSnapshotSchedules(r.context, subVolumeGroup.Namespace, subVolumeGroup.Spec.FilesystemName, groupPath, *cephVersion, subVolumeGroup.Spec.SnapshotSchedules, snapshotScheduleStatus)
+	if err != nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+		return reconcile.Result{}, errors.Wrapf(err, "failed to configure snapshot schedules of subvolume group %q.", subVolumeGroup.Name)
+	}
+
+	// Start the status check of the snapshot schedules
+	if subVolumeGroup.Spec.SnapshotSchedules.IsEnabled() {
+		r.startMonitoring(request.NamespacedName)
+	} else {
+		r.stopMonitoring(request.NamespacedName)
+	}
+
+	// Set Ready status, we are done reconciling
+	updateStatus(r.client, request.NamespacedName, k8sutil.ReadyStatus, map[string]string{SubVolumeGroupPathKey: groupPath})
+
+	// Return and do not requeue
+	logger.Debug("done reconciling")
+	return reconcile.Result{}, nil
+}
+
+// validateSubVolumeGroup validates the subvolume group arguments
+func validateSubVolumeGroup(g *cephv1.CephFilesystemSubVolumeGroup, cephVersion cephver.CephVersion) error {
+	if g.Name == "" {
+		return errors.New("missing name")
+	}
+	if g.Namespace == "" {
+		return errors.New("missing namespace")
+	}
+	if g.Spec.FilesystemName == "" {
+		return errors.New("missing filesystemName")
+	}
+
+	pinning := g.Spec.Pinning
+	if pinning.IsEnabled() {
+		if !cephVersion.IsAtLeastPacific() {
+			return errors.New("subvolume group pinning is only supported from Ceph Pacific")
+		}
+		settings := 0
+		for _, set := range []bool{pinning.Export != nil, pinning.Distributed != nil, pinning.Random != nil} {
+			if set {
+				settings++
+			}
+		}
+		if settings > 1 {
+			return errors.New("only one of the export, distributed or random pinning can be set")
+		}
+		if pinning.Distributed != nil && *pinning.Distributed != 0 && *pinning.Distributed != 1 {
+			return errors.Errorf("distributed pinning must be 0 or 1, not %d", *pinning.Distributed)
+		}
+		if pinning.Random != nil && (*pinning.Random < 0 || *pinning.Random > 1) {
+			return errors.Errorf("random pinning must be between 0 and 1, not %g", *pinning.Random)
+		}
+	}
+
+	if g.Spec.Quota != nil {
+		if !cephVersion.IsAtLeast(resizeMinVersion) {
+			return errors.New("subvolume group quota is only supported from Ceph 17.2.1")
+		}
+		if g.Spec.Quota.Value() <= 0 {
+			return errors.Errorf("quota must be positive, not %q", g.Spec.Quota.String())
+		}
+	}
+
+	if err := file.ValidateSnapshotSchedules(g.Spec.SnapshotSchedules); err != nil {
+		return errors.Wrap(err, "invalid snapshot schedules")
+	}
+
+	return nil
+}
+
+// createSubVolumeGroup creates the subvolume group, applies its pinning and quota and returns its path
+func createSubVolumeGroup(context *clusterd.Context, g *cephv1.CephFilesystemSubVolumeGroup, cephVersion cephver.CephVersion) (string, error) {
+	fsName := g.Spec.FilesystemName
+	if err := cephclient.CreateSubVolumeGroup(context, g.Namespace, fsName, g.Name, g.Spec.DataPoolName); err != nil {
+		return "", err
+	}
+
+	pinning := g.Spec.Pinning
+	var err error
+	switch {
+	case pinning.Export != nil:
+		err = cephclient.PinSubVolumeGroup(context, g.Namespace, fsName, g.Name, "export", strconv.Itoa(*pinning.Export))
+	case pinning.Distributed != nil:
+		err = cephclient.PinSubVolumeGroup(context, g.Namespace, fsName, g.Name, "distributed", strconv.Itoa(*pinning.Distributed))
+	case pinning.Random != nil:
+		err = cephclient.PinSubVolumeGroup(context, g.Namespace, fsName, g.Name, "random", strconv.FormatFloat(*pinning.Random, 'f', -1, 64))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// the quota is removed when it is not set, unless the version of Ceph can't resize subvolume groups
+	if cephVersion.IsAtLeast(resizeMinVersion) {
+		var quota int64
+		if g.Spec.Quota != nil {
+			quota = g.Spec.Quota.Value()
+		}
+		if err := cephclient.ResizeSubVolumeGroup(context, g.Namespace, fsName, g.Name, quota); err != nil {
+			return "", err
+		}
+	}
+
+	return cephclient.GetSubVolumeGroupPath(context, g.Namespace, fsName, g.Name)
+}
+
+// deleteSubVolumeGroup removes the snapshot schedules of the subvolume group then deletes it
+func deleteSubVolumeGroup(context *clusterd.Context, g *cephv1.CephFilesystemSubVolumeGroup, cephVersion cephver.CephVersion) error {
+	if g.Status != nil && g.Status.SnapshotScheduleStatus != nil {
+		groupPath := g.Status.Info[SubVolumeGroupPathKey]
+		err := file.ReconcileSnapshotSchedules(context, g.Namespace, g.Spec.FilesystemName, groupPath, cephVersion, cephv1.SnapshotSchedulesSpec{}, g.Status.SnapshotScheduleStatus)
+		if err != nil {
+			return errors.Wrapf(err, "failed to remove snapshot schedules of subvolume group %q", g.Name)
+		}
+	}
+
+	return cephclient.DeleteSubVolumeGroup(context, g.Namespace, g.Spec.FilesystemName, g.Name)
+}
+
+// updateStatus updates a subvolume group CR with the given status and info if not nil
+func updateStatus(client client.Client, name types.NamespacedName, status string, info map[string]string) {
+	subVolumeGroup := &cephv1.CephFilesystemSubVolumeGroup{}
+	err := client.Get(context.TODO(), name, subVolumeGroup)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephFilesystemSubVolumeGroup resource not found. Ignoring since object must be deleted.")
+			return
+		}
+		logger.Warningf("failed to retrieve subvolume group %q to update status to %q. %v", name, status, err)
+		return
+	}
+
+	if subVolumeGroup.Status == nil {
+		subVolumeGroup.Status = &cephv1.CephFilesystemSubVolumeGroupStatus{}
+	}
+
+	subVolumeGroup.Status.Phase = status
+	if info != nil {
+		subVolumeGroup.Status.Info = info
+	}
+	if !subVolumeGroup.Spec.SnapshotSchedules.IsEnabled() {
+		subVolumeGroup.Status.SnapshotScheduleStatus = nil
+	}
+	if err := opcontroller.UpdateStatus(client, subVolumeGroup); err != nil {
+		logger.Warningf("failed to set subvolume group %q status to %q. %v", subVolumeGroup.Name, status, err)
+		return
+	}
+	logger.Debugf("subvolume group %q status updated to %q", name, status)
+}
+
+func (r *ReconcileCephFilesystemSubVolumeGroup) startMonitoring(name types.NamespacedName) {
+	// Initialize the channel for this subvolume group
+	// This allows us to track multiple subvolume groups in the same namespace
+	channel, ok := r.subVolumeGroupChannels[name.String()]
+	if !ok {
+		channel = &subVolumeGroupHealth{stopChan: make(chan struct{})}
+		r.subVolumeGroupChannels[name.String()] = channel
+	}
+
+	if channel.monitoringRunning {
+		logger.Debugf("subvolume group %q monitoring go routine already running", name.Name)
+		return
+	}
+
+	// Set the monitoring flag so we don't start more than one go routine
+	channel.monitoringRunning = true
+
+	checker := newSubVolumeGroupStatusChecker(r.context, r.client, name)
+	logger.Infof("starting status check of subvolume group %q", name.Name)
+	go checker.checkSubVolumeGroupStatus(channel.stopChan)
+}
+
+func (r *ReconcileCephFilesystemSubVolumeGroup) stopMonitoring(name types.NamespacedName) {
+	channel, ok := r.subVolumeGroupChannels[name.String()]
+	if !ok {
+		return
+	}
+
+	// Close the channel to stop the status check of the subvolume group
+	close(channel.stopChan)
+
+	// Remove the subvolume group from the map
+	delete(r.subVolumeGroupChannels, name.String())
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subvolumegroup
+
+import (
+	"context"
+	"testing"
+
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	rookclient "github.com/rook/rook/pkg/client/clientset/versioned/fake"
+	"github.com/rook/rook/pkg/clusterd"
+	cephver "github.com/rook/rook/pkg/operator/ceph/version"
+	"github.com/rook/rook/pkg/operator/k8sutil"
+	exectest "github.com/rook/rook/pkg/util/exec/test"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestValidateSubVolumeGroup(t *testing.T) {
+	g := &cephv1.CephFilesystemSubVolumeGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "csi", Namespace: "rook-ceph"},
+		Spec:       cephv1.CephFilesystemSubVolumeGroupSpec{FilesystemName: "myfs"},
+	}
+	assert.NoError(t, validateSubVolumeGroup(g, cephver.Octopus))
+
+	// the filesystem is required
+	g.Spec.FilesystemName = ""
+	assert.Error(t, validateSubVolumeGroup(g, cephver.Octopus))
+	g.Spec.FilesystemName = "myfs"
+
+	// pinning requires pacific
+	export := 1
+	g.Spec.Pinning.Export = &export
+	assert.Error(t, validateSubVolumeGroup(g, cephver.Octopus))
+	assert.NoError(t, validateSubVolumeGroup(g, cephver.Pacific))
+
+	// only one pinning can be set
+	random := 0.5
+	g.Spec.Pinning.Random = &random
+	assert.Error(t, validateSubVolumeGroup(g, cephver.Pacific))
+	g.Spec.Pinning.Export = nil
+	assert.NoError(t, validateSubVolumeGroup(g, cephver.Pacific))
+
+	// random pinning is a probability
+	random = 1.5
+	assert.Error(t, validateSubVolumeGroup(g, cephver.Pacific))
+	g.Spec.Pinning.Random = nil
+
+	// distributed pinning is either on or off
+	distributed := 2
+	g.Spec.Pinning.Distributed = &distributed
+	assert.Error(t, validateSubVolumeGroup(g, cephver.Pacific))
+	g.Spec.Pinning.Distributed = nil
+
+	// the quota must be positive
+	quota := resource.MustParse("0")
+	g.Spec.Quota = &quota
+	assert.Error(t, validateSubVolumeGroup(g, resizeMinVersion))
+	quota = resource.MustParse("10Gi")
+	assert.NoError(t, validateSubVolumeGroup(g, resizeMinVersion))
+
+	// the quota requires a version able to resize subvolume groups
+	assert.Error(t, validateSubVolumeGroup(g, cephver.Pacific))
+	g.Spec.Quota = nil
+
+	// the snapshot schedules are validated
+	g.Spec.SnapshotSchedules.Retention = []cephv1.SnapshotScheduleRetentionSpec{{Duration: "forever"}}
+	assert.Error(t, validateSubVolumeGroup(g, cephver.Pacific))
+}
+
+func TestCephFilesystemSubVolumeGroupController(t *testing.T) {
+	//
+	// TEST 1 SETUP
+	//
+	// FAILURE because no CephCluster
+	//
+	var (
+		name      = "csi"
+		namespace = "rook-ceph"
+	)
+
+	quota := resource.MustParse("10Gi")
+	distributed := 1
+	subVolumeGroup := &cephv1.CephFilesystemSubVolumeGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: cephv1.CephFilesystemSubVolumeGroupSpec{
+			FilesystemName: "myfs",
+			DataPoolName:   "myfs-data0",
+			Pinning:        cephv1.SubVolumeGroupPinningSpec{Distributed: &distributed},
+			Quota:          &quota,
+		},
+		Status: &cephv1.CephFilesystemSubVolumeGroupStatus{
+			Phase: "",
+		},
+	}
+
+	// Objects to track in the fake client.
+	object := []runtime.Object{
+		subVolumeGroup,
+	}
+
+	var fsArgs [][]string
+	var quotaArg string
+	executor := &exectest.MockExecutor{
+		MockExecuteCommandWithOutputFile: func(command, outfile string, args ...string) (string, error) {
+			if args[0] == "status" {
+				return `{"fsid":"c47cac40-9bee-4d52-823b-ccd803ba5bfe","health":{"checks":{},"status":"HEALTH_OK"},"pgmap":{"num_pgs":100,"pgs_by_state":[{"state_name":"active+clean","count":100}]}}`, nil
+			}
+			if args[0] == "fs" && args[1] == "subvolumegroup" {
+				fsArgs = append(fsArgs, args[:3])
+				if args[2] == "resize" {
+					quotaArg = args[5]
+				}
+				if args[2] == "getpath" {
+					return "/volumes/csi", nil
+				}
+			}
+
+			return "", nil
+		},
+	}
+	c := &clusterd.Context{
+		Executor:      executor,
+		RookClientset: rookclient.NewSimpleClientset()}
+
+	// Register operator types with the runtime scheme.
+	s := scheme.Scheme
+	s.AddKnownTypes(cephv1.SchemeGroupVersion, subVolumeGroup, &cephv1.CephCluster{}, &cephv1.CephClusterList{})
+
+	// Create a fake client to mock API calls.
+	cl := fake.NewFakeClient(object...)
+	// Create a ReconcileCephFilesystemSubVolumeGroup object with the scheme and fake client.
+	r := &ReconcileCephFilesystemSubVolumeGroup{client: cl, scheme: s, context: c, subVolumeGroupChannels: make(map[string]*subVolumeGroupHealth)}
+
+	// Mock request to simulate Reconcile() being called on an event for a
+	// watched resource .
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+
+	res, err := r.Reconcile(req)
+	assert.NoError(t, err)
+	assert.True(t, res.Requeue)
+
+	//
+	// TEST 2:
+	//
+	// FAILURE pinning requires pacific
+	//
+	cephCluster := &cephv1.CephCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      namespace,
+			Namespace: namespace,
+		},
+		Status: cephv1.ClusterStatus{
+			Phase: k8sutil.ReadyStatus,
+			CephVersion: &cephv1.ClusterVersion{
+				Version: "15.2.4-0",
+			},
+			CephStatus: &cephv1.CephStatus{
+				Health: "HEALTH_OK",
+			},
+		},
+	}
+	object = append(object, cephCluster)
+	cl = fake.NewFakeClient(object...)
+	r = &ReconcileCephFilesystemSubVolumeGroup{client: cl, scheme: s, context: c, subVolumeGroupChannels: make(map[string]*subVolumeGroupHealth)}
+
+	_, err = r.Reconcile(req)
+	assert.Error(t, err)
+	assert.Empty(t, fsArgs)
+
+	//
+	// TEST 3:
+	//
+	// FAILURE quota requires a Ceph version able to resize subvolume groups
+	//
+	cephCluster.Status.CephVersion.Version = "16.0.0-0"
+	err = r.client.Update(context.TODO(), cephCluster)
+	assert.NoError(t, err)
+
+	_, err = r.Reconcile(req)
+	assert.Error(t, err)
+	assert.Empty(t, fsArgs)
+
+	//
+	// TEST 4:
+	//
+	// SUCCESS! The subvolume group is created, pinned and resized
+	//
+	cephCluster.Status.CephVersion.Version = "17.2.1-0"
+	err = r.client.Update(context.TODO(), cephCluster)
+	assert.NoError(t, err)
+
+	res, err = r.Reconcile(req)
+	assert.NoError(t, err)
+	assert.False(t, res.Requeue)
+	assert.Equal(t, [][]string{
+		{"fs", "subvolumegroup", "create"},
+		{"fs", "subvolumegroup", "pin"},
+		{"fs", "subvolumegroup", "resize"},
+		{"fs", "subvolumegroup", "getpath"},
+	}, fsArgs)
+	assert.Equal(t, "10737418240", quotaArg)
+
+	//
+	// TEST 5:
+	//
+	// SUCCESS! The quota removed from the spec is reset to unlimited
+	//
+	g := &cephv1.CephFilesystemSubVolumeGroup{}
+	err = r.client.Get(context.TODO(), req.NamespacedName, g)
+	assert.NoError(t, err)
+	g.Spec.Quota = nil
+	err = r.client.Update(context.TODO(), g)
+	assert.NoError(t, err)
+
+	fsArgs = nil
+	res, err = r.Reconcile(req)
+	assert.NoError(t, err)
+	assert.False(t, res.Requeue)
+	assert.Contains(t, fsArgs, []string{"fs", "subvolumegroup", "resize"})
+	assert.Equal(t, "inf", quotaArg)
+
+	g = &cephv1.CephFilesystemSubVolumeGroup{}
+	err = r.client.Get(context.TODO(), req.NamespacedName, g)
+	assert.NoError(t, err)
+	assert.Equal(t, "Ready", g.Status.Phase)
+	assert.Equal(t, "/volumes/csi", g.Status.Info[SubVolumeGroupPathKey])
+	assert.Nil(t, g.Status.SnapshotScheduleStatus)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subvolumegroup
+
+import (
+	"context"
+	"time"
+
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	opcontroller "github.com/rook/rook/pkg/operator/ceph/controller"
+	"github.com/rook/rook/pkg/operator/ceph/file"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultStatusCheckInterval = 1 * time.Minute
+)
+
+// subVolumeGroupStatusChecker aggregates the subvolume group info needed to check its snapshot schedules
+type subVolumeGroupStatusChecker struct {
+	context        *clusterd.Context
+	interval       time.Duration
+	client         client.Client
+	namespacedName types.NamespacedName
+}
+
+// newSubVolumeGroupStatusChecker creates a new subVolumeGroupStatusChecker object
+func newSubVolumeGroupStatusChecker(context *clusterd.Context, client client.Client, namespacedName types.NamespacedName) *subVolumeGroupStatusChecker {
+	return &subVolumeGroupStatusChecker{
+		context:        context,
+		interval:       defaultStatusCheckInterval,
+		client:         client,
+		namespacedName: namespacedName,
+	}
+}
+
+// checkSubVolumeGroupStatus periodically checks the snapshot schedules of the subvolume group
+func (c *subVolumeGroupStatusChecker) checkSubVolumeGroupStatus(stopCh chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			logger.Infof("stopping monitoring of subvolume group %q", c.namespacedName.Name)
+			return
+
+		case <-time.After(c.interval):
+			logger.Debugf("checking status of subvolume group %q", c.namespacedName.Name)
+			c.checkStatus()
+		}
+	}
+}
+
+// checkStatus queries the snapshot schedules of the subvolume group then updates the CR status
+func (c *subVolumeGroupStatusChecker) checkStatus() {
+	subVolumeGroup := &cephv1.CephFilesystemSubVolumeGroup{}
+	if err := c.client.Get(context.TODO(), c.namespacedName, subVolumeGroup); err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephFilesystemSubVolumeGroup resource not found. Ignoring since object must be deleted.")
+			return
+		}
+		logger.Warningf("failed to retrieve subvolume group %q to update status. %v", c.namespacedName, err)
+		return
+	}
+
+	// the path of the subvolume group is known once it is reconciled
+	if subVolumeGroup.Status == nil || subVolumeGroup.Status.Info[SubVolumeGroupPathKey] == "" {
+		logger.Debugf("subvolume group %q is not created yet", c.namespacedName.Name)
+		return
+	}
+
+	if subVolumeGroup.Spec.SnapshotSchedules.IsEnabled() {
+		groupPath := subVolumeGroup.Status.Info[SubVolumeGroupPathKey]
+		subVolumeGroup.Status.SnapshotScheduleStatus = file.GetSnapshotScheduleStatus(c.context, c.namespacedName.Namespace, subVolumeGroup.Spec.FilesystemName, groupPath, subVolumeGroup.Spec.SnapshotSchedules)
+	} else {
+		subVolumeGroup.Status.SnapshotScheduleStatus = nil
+	}
+
+	if err := opcontroller.UpdateStatus(c.client, subVolumeGroup); err != nil {
+		logger.Errorf("failed to update subvolume group %q status. %v", c.namespacedName, err)
+		return
+	}
+	logger.Debugf("subvolume group %q status updated", c.namespacedName)
+}
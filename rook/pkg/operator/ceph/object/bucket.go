@@ -211,6 +211,23 @@ func GetBucket(c *Context, bucket string) (*ObjectBucket, int, error) {
 	return &ObjectBucket{Name: bucket, ObjectBucketMetadata: ObjectBucketMetadata{Owner: metadata.Owner, CreatedAt: metadata.CreatedAt}, ObjectBucketStats: *stat}, RGWErrorNone, nil
 }
 
+// NewS3AgentForBucketOwner returns an s3 agent authenticated as the owner of the bucket
+func NewS3AgentForBucketOwner(c *Context, bucket, endpoint string) (*S3Agent, error) {
+	stats, _, err := GetBucket(c, bucket)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get bucket %q", bucket)
+	}
+	owner, _, err := GetUser(c, stats.Owner)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get owner %q of bucket %q", stats.Owner, bucket)
+	}
+	if owner.AccessKey == nil || owner.SecretKey == nil {
+		return nil, errors.Errorf("owner %q of bucket %q has no s3 keys", stats.Owner, bucket)
+	}
+
+	return NewS3Agent(*owner.AccessKey, *owner.SecretKey, endpoint)
+}
+
 func DeleteObjectBucket(c *Context, bucketName string, purge bool) (int, error) {
 	options := []string{"bucket", "rm", "--bucket", bucketName}
 	if purge {
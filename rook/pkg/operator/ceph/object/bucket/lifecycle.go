@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	bktv1alpha1 "github.com/kube-object-storage/lib-bucket-provisioner/pkg/apis/objectbucket.io/v1alpha1"
+	claimClient "github.com/kube-object-storage/lib-bucket-provisioner/pkg/client/clientset/versioned"
+	"github.com/pkg/errors"
+	cephObject "github.com/rook/rook/pkg/operator/ceph/object"
+	"github.com/rook/rook/pkg/operator/k8sutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// obcResource is the ObjectBucketClaim resource of the bucket library
+var obcResource = k8sutil.CustomResource{
+	Name:    "objectbucketclaim",
+	Plural:  "objectbucketclaims",
+	Group:   bktv1alpha1.SchemeGroupVersion.Group,
+	Version: bktv1alpha1.SchemeGroupVersion.Version,
+	Kind:    bktv1alpha1.ObjectBucketClaimKind,
+}
+
+// StartLifecycleWatch watches the OBCs in all namespaces and applies the bucket lifecycle of the bound
+// OBCs of the provisioner when it is updated. It must be called before the bucket controller runs.
+func (p *Provisioner) StartLifecycleWatch(stopCh chan struct{}) {
+	clientset, err := claimClient.NewForConfig(p.context.KubeConfig)
+	if err != nil {
+		logger.Errorf("failed to create the OBC clientset, bucket lifecycle updates are not applied. %v", err)
+		return
+	}
+	p.claimClientset = clientset
+
+	resourceHandlerFuncs := cache.ResourceEventHandlerFuncs{
+		UpdateFunc: p.onClaimUpdate,
+	}
+
+	logger.Info("start watching OBC bucket lifecycle updates")
+	go k8sutil.WatchCR(obcResource, "", resourceHandlerFuncs, clientset.ObjectbucketV1alpha1().RESTClient(), &bktv1alpha1.ObjectBucketClaim{}, stopCh)
+}
+
+func (p *Provisioner) onClaimUpdate(oldObj, newObj interface{}) {
+	oldClaim, ok := oldObj.(*bktv1alpha1.ObjectBucketClaim)
+	if !ok {
+		return
+	}
+	newClaim, ok := newObj.(*bktv1alpha1.ObjectBucketClaim)
+	if !ok {
+		return
+	}
+	if !bucketLifecycleChanged(oldClaim, newClaim) {
+		return
+	}
+
+	// work on a copy since the provisioner fields are set for each bucket
+	provisioner := *p
+	if err := provisioner.updateBucketLifecycle(newClaim); err != nil {
+		logger.Errorf("failed to update bucket lifecycle of OBC %q in namespace %q. %v", newClaim.Name, newClaim.Namespace, err)
+	}
+}
+
+// bucketLifecycleChanged returns whether the bucket lifecycle of a bound OBC was updated, the lifecycle
+// of an OBC that is not bound yet is applied when its bucket is provisioned
+func bucketLifecycleChanged(oldClaim, newClaim *bktv1alpha1.ObjectBucketClaim) bool {
+	if newClaim.DeletionTimestamp != nil || newClaim.Status.Phase != bktv1alpha1.ObjectBucketClaimStatusPhaseBound {
+		return false
+	}
+	// a claim becoming bound had its lifecycle applied when it was provisioned
+	if oldClaim.Status.Phase != bktv1alpha1.ObjectBucketClaimStatusPhaseBound {
+		return false
+	}
+	oldLifecycle, oldOK := oldClaim.Spec.AdditionalConfig[bucketLifecycleKey]
+	newLifecycle, newOK := newClaim.Spec.AdditionalConfig[bucketLifecycleKey]
+	return oldOK != newOK || oldLifecycle != newLifecycle
+}
+
+// updateBucketLifecycle sets the bucket lifecycle of a bound OBC on its bucket, or removes it if the OBC
+// no longer has one
+func (p *Provisioner) updateBucketLifecycle(obc *bktv1alpha1.ObjectBucketClaim) error {
+	sc, err := p.getStorageClassWithBackoff(obc.Spec.StorageClassName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get storage class %q", obc.Spec.StorageClassName)
+	}
+	if sc.Provisioner != cephObject.GetObjectBucketProvisioner(p.context, p.namespace) {
+		return nil
+	}
+
+	err = p.setBucketLifecycle(obc.Spec.AdditionalConfig)
+	if err != nil {
+		return errors.Wrap(err, "invalid bucket lifecycle")
+	}
+
+	ob, err := p.claimClientset.ObjectbucketV1alpha1().ObjectBuckets().Get(obc.Spec.ObjectBucketName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get OB %q", obc.Spec.ObjectBucketName)
+	}
+	err = p.initializeDeleteOrRevoke(ob)
+	if err != nil {
+		return err
+	}
+
+	s3svc, err := cephObject.NewS3AgentForBucketOwner(p.objectContext, p.bucketName, p.getObjectStoreEndpoint())
+	if err != nil {
+		return err
+	}
+	if p.bucketLifecycle == nil {
+		return s3svc.DeleteBucketLifecycle(p.bucketName)
+	}
+	return s3svc.PutBucketLifecycle(p.bucketName, p.bucketLifecycle)
+}
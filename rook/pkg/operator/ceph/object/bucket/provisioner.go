@@ -17,6 +17,7 @@ limitations under the License.
 package bucket
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -58,6 +59,7 @@ type Provisioner struct {
 	secretName           string
 	secretNamespace      string
 	additionalConfigData map[string]string
+	bucketLifecycle      *s3.BucketLifecycleConfiguration
 }
 
 var _ apibkt.Provisioner = &Provisioner{}
@@ -66,7 +68,11 @@ func NewProvisioner(context *clusterd.Context, namespace, runRgwCmdAsUser string
 	return &Provisioner{context: context, namespace: namespace, RunRgwCmdAsUser: runRgwCmdAsUser}
 }
 
-const maxBuckets = 1
+const (
+	maxBuckets = 1
+	// the OBC additional config key of the bucket lifecycle configuration, in the json format of the S3 API
+	bucketLifecycleKey = "bucketLifecycle"
+)
 
 // Provision creates an s3 bucket and returns a connection info
 // representing the bucket's endpoint and user access credentials.
@@ -105,6 +111,14 @@ func (p Provisioner) Provision(options *apibkt.BucketOptions) (*bktv1alpha1.Obje
 	}
 	logger.Infof("set user %q bucket max to %d", p.cephUserName, maxBuckets)
 
+	if p.bucketLifecycle != nil {
+		err = s3svc.PutBucketLifecycle(p.bucketName, p.bucketLifecycle)
+		if err != nil {
+			p.deleteOBCResource(p.bucketName)
+			return nil, err
+		}
+	}
+
 	return p.composeObjectBucket(), nil
 }
 
@@ -185,6 +199,14 @@ func (p Provisioner) Grant(options *apibkt.BucketOptions) (*bktv1alpha1.ObjectBu
 		p.deleteOBCResource("")
 		return nil, err
 	}
+
+	if p.bucketLifecycle != nil {
+		err = s3svc.PutBucketLifecycle(p.bucketName, p.bucketLifecycle)
+		if err != nil {
+			p.deleteOBCResource("")
+			return nil, err
+		}
+	}
 	// returned ob with connection info
 	return p.composeObjectBucket(), nil
 }
@@ -325,6 +347,10 @@ func (p *Provisioner) initializeCreateOrGrant(options *apibkt.BucketOptions) err
 	p.setObjectStoreNamespace(sc)
 	p.setRegion(sc)
 	p.setAdditionalConfigData(obc.Spec.AdditionalConfig)
+	err = p.setBucketLifecycle(obc.Spec.AdditionalConfig)
+	if err != nil {
+		return errors.Wrapf(err, "invalid bucket lifecycle of OBC %q in namespace %q", obc.Name, obc.Namespace)
+	}
 	p.setEndpoint(sc)
 	err = p.setObjectContext()
 	if err != nil {
@@ -456,6 +482,29 @@ func (p *Provisioner) setAdditionalConfigData(additionalConfigData map[string]st
 	p.additionalConfigData = additionalConfigData
 }
 
+// setBucketLifecycle parses the bucket lifecycle configuration from the OBC additional config
+func (p *Provisioner) setBucketLifecycle(additionalConfigData map[string]string) error {
+	p.bucketLifecycle = nil
+	lifecycle, ok := additionalConfigData[bucketLifecycleKey]
+	if !ok {
+		return nil
+	}
+
+	config := &s3.BucketLifecycleConfiguration{}
+	if err := json.Unmarshal([]byte(lifecycle), config); err != nil {
+		return errors.Wrap(err, "failed to parse bucket lifecycle")
+	}
+	if len(config.Rules) == 0 {
+		return errors.New("bucket lifecycle has no rules")
+	}
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	p.bucketLifecycle = config
+	return nil
+}
+
 func (p *Provisioner) setEndpoint(sc *storagev1.StorageClass) {
 	p.endpoint = sc.Parameters[objectStoreEndpoint]
 }
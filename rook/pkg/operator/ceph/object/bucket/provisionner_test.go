@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"testing"
 
+	bktv1alpha1 "github.com/kube-object-storage/lib-bucket-provisioner/pkg/apis/objectbucket.io/v1alpha1"
 	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
 	rookclient "github.com/rook/rook/pkg/client/clientset/versioned/fake"
 	"github.com/rook/rook/pkg/clusterd"
@@ -105,3 +106,65 @@ func TestPopulateDomainAndPort(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "rook-ceph-rgw-my-store.rook-ceph", p.storeDomainName)
 }
+
+func TestSetBucketLifecycle(t *testing.T) {
+	p := NewProvisioner(&clusterd.Context{}, namespace, client.AdminUsername)
+
+	// no lifecycle
+	err := p.setBucketLifecycle(map[string]string{"foo": "bar"})
+	assert.NoError(t, err)
+	assert.Nil(t, p.bucketLifecycle)
+
+	// valid lifecycle
+	lifecycle := `{"Rules": [{"ID": "expire-logs", "Status": "Enabled", "Filter": {"Prefix": "logs/"}, "Expiration": {"Days": 30}},
+		{"ID": "abort-uploads", "Status": "Enabled", "Filter": {"Prefix": ""}, "AbortIncompleteMultipartUpload": {"DaysAfterInitiation": 7}}]}`
+	err = p.setBucketLifecycle(map[string]string{bucketLifecycleKey: lifecycle})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(p.bucketLifecycle.Rules))
+	assert.Equal(t, "expire-logs", *p.bucketLifecycle.Rules[0].ID)
+	assert.Equal(t, "logs/", *p.bucketLifecycle.Rules[0].Filter.Prefix)
+	assert.Equal(t, int64(30), *p.bucketLifecycle.Rules[0].Expiration.Days)
+	assert.Equal(t, int64(7), *p.bucketLifecycle.Rules[1].AbortIncompleteMultipartUpload.DaysAfterInitiation)
+
+	// invalid json
+	err = p.setBucketLifecycle(map[string]string{bucketLifecycleKey: "Rules: []"})
+	assert.Error(t, err)
+	assert.Nil(t, p.bucketLifecycle)
+
+	// no rules
+	err = p.setBucketLifecycle(map[string]string{bucketLifecycleKey: `{"Rules": []}`})
+	assert.Error(t, err)
+
+	// a rule without status
+	err = p.setBucketLifecycle(map[string]string{bucketLifecycleKey: `{"Rules": [{"ID": "expire", "Expiration": {"Days": 30}}]}`})
+	assert.Error(t, err)
+}
+
+func TestBucketLifecycleChanged(t *testing.T) {
+	lifecycle := `{"Rules":[{"ID":"expire","Status":"Enabled","Filter":{"Prefix":""},"Expiration":{"Days":30}}]}`
+	newClaim := func(phase string, config map[string]string) *bktv1alpha1.ObjectBucketClaim {
+		return &bktv1alpha1.ObjectBucketClaim{
+			Spec:   bktv1alpha1.ObjectBucketClaimSpec{AdditionalConfig: config},
+			Status: bktv1alpha1.ObjectBucketClaimStatus{Phase: bktv1alpha1.ObjectBucketClaimStatusPhase(phase)},
+		}
+	}
+	bound := string(bktv1alpha1.ObjectBucketClaimStatusPhaseBound)
+	pending := string(bktv1alpha1.ObjectBucketClaimStatusPhasePending)
+
+	// the lifecycle is added, updated or removed on a bound claim
+	assert.True(t, bucketLifecycleChanged(newClaim(bound, nil), newClaim(bound, map[string]string{bucketLifecycleKey: lifecycle})))
+	assert.True(t, bucketLifecycleChanged(newClaim(bound, map[string]string{bucketLifecycleKey: "{}"}), newClaim(bound, map[string]string{bucketLifecycleKey: lifecycle})))
+	assert.True(t, bucketLifecycleChanged(newClaim(bound, map[string]string{bucketLifecycleKey: lifecycle}), newClaim(bound, map[string]string{})))
+
+	// the lifecycle is unchanged
+	assert.False(t, bucketLifecycleChanged(newClaim(bound, map[string]string{bucketLifecycleKey: lifecycle}), newClaim(bound, map[string]string{bucketLifecycleKey: lifecycle, "maxObjects": "10"})))
+
+	// the claim is not bound or just got bound, the lifecycle is applied when provisioning
+	assert.False(t, bucketLifecycleChanged(newClaim(pending, nil), newClaim(pending, map[string]string{bucketLifecycleKey: lifecycle})))
+	assert.False(t, bucketLifecycleChanged(newClaim(pending, nil), newClaim(bound, map[string]string{bucketLifecycleKey: lifecycle})))
+
+	// the claim is deleted
+	deleted := newClaim(bound, map[string]string{bucketLifecycleKey: lifecycle})
+	deleted.DeletionTimestamp = &metav1.Time{}
+	assert.False(t, bucketLifecycleChanged(newClaim(bound, nil), deleted))
+}
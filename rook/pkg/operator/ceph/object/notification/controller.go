@@ -0,0 +1,420 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notification to manage the bucket notifications of a rook object store.
+package notification
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/coreos/pkg/capnslog"
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	"github.com/rook/rook/pkg/operator/ceph/cluster/mon"
+	opcontroller "github.com/rook/rook/pkg/operator/ceph/controller"
+	"github.com/rook/rook/pkg/operator/ceph/object"
+	"github.com/rook/rook/pkg/operator/k8sutil"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	controllerName = "ceph-bucket-notification-controller"
+)
+
+var logger = capnslog.NewPackageLogger("github.com/rook/rook", controllerName)
+
+var cephBucketNotificationKind = reflect.TypeOf(cephv1.CephBucketNotification{}).Name()
+
+// Sets the type meta for the controller main object
+var controllerTypeMeta = metav1.TypeMeta{
+	Kind:       cephBucketNotificationKind,
+	APIVersion: fmt.Sprintf("%s/%s", cephv1.CustomResourceGroup, cephv1.Version),
+}
+
+// the object events supported by the rgw bucket notifications
+var supportedEvents = []cephv1.BucketNotificationEvent{
+	"s3:ObjectCreated:*",
+	"s3:ObjectCreated:Put",
+	"s3:ObjectCreated:Post",
+	"s3:ObjectCreated:Copy",
+	"s3:ObjectCreated:CompleteMultipartUpload",
+	"s3:ObjectRemoved:*",
+	"s3:ObjectRemoved:Delete",
+	"s3:ObjectRemoved:DeleteMarkerCreated",
+}
+
+var _ reconcile.Reconciler = &ReconcileBucketNotification{}
+
+// ReconcileBucketNotification reconciles a CephBucketNotification object
+type ReconcileBucketNotification struct {
+	client  client.Client
+	scheme  *runtime.Scheme
+	context *clusterd.Context
+}
+
+// Add creates a new CephBucketNotification Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager, context *clusterd.Context) error {
+	return add(mgr, newReconciler(mgr, context))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager, context *clusterd.Context) reconcile.Reconciler {
+	// Add the cephv1 scheme to the manager scheme so that the controller knows about it
+	mgrScheme := mgr.GetScheme()
+	cephv1.AddToScheme(mgr.GetScheme())
+
+	return &ReconcileBucketNotification{
+		client:  mgr.GetClient(),
+		scheme:  mgrScheme,
+		context: context,
+	}
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New(controllerName, mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+	logger.Info("successfully started")
+
+	// Watch for changes on the CephBucketNotification CRD object
+	err = c.Watch(&source.Kind{Type: &cephv1.CephBucketNotification{TypeMeta: controllerTypeMeta}}, &handler.EnqueueRequestForObject{}, opcontroller.WatchControllerPredicate())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reconcile reads that state of the cluster for a CephBucketNotification object and makes changes based on the state read
+// and what is in the CephBucketNotification.Spec
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *ReconcileBucketNotification) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	// workaround because the rook logging mechanism is not compatible with the controller-runtime loggin interface
+	reconcileResponse, err := r.reconcile(request)
+	if err != nil {
+		logger.Errorf("failed to reconcile %v", err)
+	}
+
+	return reconcileResponse, err
+}
+
+func (r *ReconcileBucketNotification) reconcile(request reconcile.Request) (reconcile.Result, error) {
+	// Fetch the CephBucketNotification instance
+	notification := &cephv1.CephBucketNotification{}
+	err := r.client.Get(context.TODO(), request.NamespacedName, notification)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephBucketNotification resource not found. Ignoring since object must be deleted.")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, errors.Wrap(err, "failed to get CephBucketNotification")
+	}
+
+	// The CR was just created, initializing status fields
+	if notification.Status == nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.Created, nil)
+	}
+
+	// Make sure a CephCluster is present otherwise do nothing
+	cephCluster, isReadyToReconcile, cephClusterExists, reconcileResponse := opcontroller.IsReadyToReconcile(r.client, r.context, request.NamespacedName, controllerName)
+	if !isReadyToReconcile {
+		// This handles the case where the Ceph Cluster is gone and we want to delete that CR
+		// We skip the deleteNotification() function since everything is gone already
+		//
+		// Also, only remove the finalizer if the CephCluster is gone
+		// If not, we should wait for it to be ready
+		// This handles the case where the operator is not ready to accept Ceph command but the cluster exists
+		if !notification.GetDeletionTimestamp().IsZero() && !cephClusterExists {
+			// Remove finalizer
+			err = opcontroller.RemoveFinalizer(r.client, notification)
+			if err != nil {
+				return reconcile.Result{}, errors.Wrap(err, "failed to remove finalizer")
+			}
+
+			// Return and do not requeue. Successful deletion.
+			return reconcile.Result{}, nil
+		}
+		return reconcileResponse, nil
+	}
+
+	// Set a finalizer so we can do cleanup before the object goes away
+	err = opcontroller.AddFinalizerIfNotPresent(r.client, notification)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "failed to add finalizer")
+	}
+
+	var configuredBuckets []string
+	if notification.Status != nil {
+		configuredBuckets = notification.Status.Buckets
+	}
+
+	// DELETE: the CR was deleted
+	if !notification.GetDeletionTimestamp().IsZero() {
+		logger.Debugf("deleting bucket notification %q", notification.Name)
+		if len(configuredBuckets) > 0 {
+			err := r.deleteNotification(&cephCluster, notification, configuredBuckets)
+			if err != nil {
+				return reconcile.Result{}, errors.Wrapf(err, "failed to delete bucket notification %q", notification.Name)
+			}
+		}
+
+		// Remove finalizer
+		err = opcontroller.RemoveFinalizer(r.client, notification)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to remove finalizer")
+		}
+
+		// Return and do not requeue. Successful deletion.
+		return reconcile.Result{}, nil
+	}
+
+	// validate the notification settings
+	err = validateNotification(notification)
+	if err != nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+		return reconcile.Result{}, errors.Wrapf(err, "invalid bucket notification CR %q spec", notification.Name)
+	}
+
+	// The topic must be created before its ARN can be referenced
+	topic, objContext, endpoint, err := r.getTopic(&cephCluster, notification)
+	if err != nil || topic.Status == nil || topic.Status.ARN == nil {
+		logger.Debugf("bucket topic %q of bucket notification %q not ready, retrying in %q. %v",
+			notification.Spec.Topic, notification.Name, opcontroller.WaitForRequeueIfCephClusterNotReady.RequeueAfter.String(), err)
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+		return opcontroller.WaitForRequeueIfCephClusterNotReady, nil
+	}
+
+	// CREATE/UPDATE
+	config := generateTopicConfiguration(notification, *topic.Status.ARN)
+	for _, bucket := range notification.Spec.Buckets {
+		s3Agent, err := object.NewS3AgentForBucketOwner(objContext, bucket, endpoint)
+		if err == nil {
+			err = s3Agent.PutBucketNotification(bucket, config)
+		}
+		if err != nil {
+			// keep track of the buckets configured so far so they are cleaned up later
+			updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, configuredBuckets)
+			return reconcile.Result{}, errors.Wrapf(err, "failed to configure bucket notification %q on bucket %q", notification.Name, bucket)
+		}
+		if !contains(configuredBuckets, bucket) {
+			configuredBuckets = append(configuredBuckets, bucket)
+		}
+	}
+
+	// Remove the notification from the buckets that are no longer listed
+	removedBuckets := []string{}
+	for _, bucket := range configuredBuckets {
+		if !contains(notification.Spec.Buckets, bucket) {
+			removedBuckets = append(removedBuckets, bucket)
+		}
+	}
+	err = removeNotification(objContext, endpoint, notification.Name, removedBuckets)
+	if err != nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+		return reconcile.Result{}, errors.Wrapf(err, "failed to remove bucket notification %q", notification.Name)
+	}
+
+	// Set Ready status, we are done reconciling
+	updateStatus(r.client, request.NamespacedName, k8sutil.ReadyStatus, notification.Spec.Buckets)
+
+	// Return and do not requeue
+	logger.Debug("done reconciling")
+	return reconcile.Result{}, nil
+}
+
+// validateNotification validates the notification arguments
+func validateNotification(n *cephv1.CephBucketNotification) error {
+	if n.Name == "" {
+		return errors.New("missing name")
+	}
+	if n.Namespace == "" {
+		return errors.New("missing namespace")
+	}
+	if n.Spec.Topic == "" {
+		return errors.New("missing topic")
+	}
+	if len(n.Spec.Buckets) == 0 {
+		return errors.New("missing buckets")
+	}
+	for _, event := range n.Spec.Events {
+		if !isSupportedEvent(event) {
+			return errors.Errorf("unsupported event %q", event)
+		}
+	}
+	if n.Spec.Filter != nil {
+		for _, rule := range n.Spec.Filter.KeyFilters {
+			switch rule.Name {
+			case "prefix", "suffix", "regex":
+			default:
+				return errors.Errorf("invalid key filter %q, must be one of prefix, suffix or regex", rule.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isSupportedEvent(event cephv1.BucketNotificationEvent) bool {
+	for _, supported := range supportedEvents {
+		if event == supported {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// generateTopicConfiguration returns the s3 notification configuration of the notification, the
+// notification name is used as the ID so the notification can be updated and removed
+func generateTopicConfiguration(n *cephv1.CephBucketNotification, topicARN string) *s3.TopicConfiguration {
+	config := &s3.TopicConfiguration{
+		Id:       aws.String(n.Name),
+		TopicArn: aws.String(topicARN),
+	}
+
+	events := n.Spec.Events
+	if len(events) == 0 {
+		events = []cephv1.BucketNotificationEvent{"s3:ObjectCreated:*", "s3:ObjectRemoved:*"}
+	}
+	for _, event := range events {
+		config.Events = append(config.Events, aws.String(string(event)))
+	}
+
+	if n.Spec.Filter != nil && len(n.Spec.Filter.KeyFilters) > 0 {
+		rules := []*s3.FilterRule{}
+		for _, rule := range n.Spec.Filter.KeyFilters {
+			rules = append(rules, &s3.FilterRule{Name: aws.String(rule.Name), Value: aws.String(rule.Value)})
+		}
+		config.Filter = &s3.NotificationConfigurationFilter{Key: &s3.KeyFilter{FilterRules: rules}}
+	}
+
+	return config
+}
+
+// getTopic returns the topic of the notification with the context and endpoint of its object store
+func (r *ReconcileBucketNotification) getTopic(cephCluster *cephv1.CephCluster, n *cephv1.CephBucketNotification) (*cephv1.CephBucketTopic, *object.Context, string, error) {
+	topic := &cephv1.CephBucketTopic{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{Name: n.Spec.Topic, Namespace: n.Namespace}, topic)
+	if err != nil {
+		return nil, nil, "", errors.Wrapf(err, "failed to get bucket topic %q", n.Spec.Topic)
+	}
+
+	store := &cephv1.CephObjectStore{}
+	err = r.client.Get(context.TODO(), types.NamespacedName{Name: topic.Spec.ObjectStoreName, Namespace: n.Namespace}, store)
+	if err != nil {
+		return nil, nil, "", errors.Wrapf(err, "failed to get object store %q", topic.Spec.ObjectStoreName)
+	}
+	endpoint, err := object.BuildStoreEndpoint(store)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	objContext := object.NewContext(r.context, store.Name, store.Namespace)
+	// Set the cephx external username if the CephCluster is external
+	if cephCluster.Spec.External.Enable {
+		clusterInfo := mon.PopulateExternalClusterInfo(r.context, n.Namespace)
+		objContext.RunAsUser = clusterInfo.ExternalCred.Username
+	}
+
+	return topic, objContext, endpoint, nil
+}
+
+// deleteNotification removes the notification from all the buckets it was configured on
+func (r *ReconcileBucketNotification) deleteNotification(cephCluster *cephv1.CephCluster, n *cephv1.CephBucketNotification, buckets []string) error {
+	_, objContext, endpoint, err := r.getTopic(cephCluster, n)
+	if err != nil {
+		if kerrors.IsNotFound(errors.Cause(err)) {
+			logger.Warningf("bucket topic %q or its object store is gone, skipping removal of bucket notification %q from buckets %v", n.Spec.Topic, n.Name, buckets)
+			return nil
+		}
+		return err
+	}
+
+	return removeNotification(objContext, endpoint, n.Name, buckets)
+}
+
+func removeNotification(objContext *object.Context, endpoint, name string, buckets []string) error {
+	for _, bucket := range buckets {
+		s3Agent, err := object.NewS3AgentForBucketOwner(objContext, bucket, endpoint)
+		if err != nil {
+			// the notifications are gone with the bucket
+			if _, code, _ := object.GetBucket(objContext, bucket); code == object.RGWErrorNotFound {
+				logger.Infof("bucket %q of bucket notification %q is gone", bucket, name)
+				continue
+			}
+			return errors.Wrapf(err, "failed to connect to bucket %q", bucket)
+		}
+		if err := s3Agent.DeleteBucketNotification(bucket, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateStatus updates a bucket notification CR with the given status and configured buckets if not nil
+func updateStatus(client client.Client, name types.NamespacedName, status string, buckets []string) {
+	notification := &cephv1.CephBucketNotification{}
+	err := client.Get(context.TODO(), name, notification)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephBucketNotification resource not found. Ignoring since object must be deleted.")
+			return
+		}
+		logger.Warningf("failed to retrieve bucket notification %q to update status to %q. %v", name, status, err)
+		return
+	}
+
+	if notification.Status == nil {
+		notification.Status = &cephv1.BucketNotificationStatus{}
+	}
+
+	notification.Status.Phase = status
+	if buckets != nil {
+		notification.Status.Buckets = buckets
+	}
+	if err := opcontroller.UpdateStatus(client, notification); err != nil {
+		logger.Warningf("failed to set bucket notification %q status to %q. %v", notification.Name, status, err)
+		return
+	}
+	logger.Debugf("bucket notification %q status updated to %q", name, status)
+}
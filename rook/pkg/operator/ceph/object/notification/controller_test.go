@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateNotification(t *testing.T) {
+	notification := &cephv1.CephBucketNotification{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-notification", Namespace: "rook-ceph"},
+		Spec: cephv1.BucketNotificationSpec{
+			Topic:   "my-topic",
+			Buckets: []string{"my-bucket"},
+		},
+	}
+	assert.NoError(t, validateNotification(notification))
+
+	// missing topic
+	notification.Spec.Topic = ""
+	assert.Error(t, validateNotification(notification))
+	notification.Spec.Topic = "my-topic"
+
+	// missing buckets
+	notification.Spec.Buckets = nil
+	assert.Error(t, validateNotification(notification))
+	notification.Spec.Buckets = []string{"my-bucket"}
+
+	// events
+	notification.Spec.Events = []cephv1.BucketNotificationEvent{"s3:ObjectCreated:Put", "s3:ObjectRemoved:*"}
+	assert.NoError(t, validateNotification(notification))
+	notification.Spec.Events = append(notification.Spec.Events, "s3:ObjectRestore:Post")
+	assert.Error(t, validateNotification(notification))
+	notification.Spec.Events = nil
+
+	// filters
+	notification.Spec.Filter = &cephv1.NotificationFilterSpec{KeyFilters: []cephv1.NotificationKeyFilterRule{{Name: "prefix", Value: "logs/"}}}
+	assert.NoError(t, validateNotification(notification))
+	notification.Spec.Filter.KeyFilters = append(notification.Spec.Filter.KeyFilters, cephv1.NotificationKeyFilterRule{Name: "tag", Value: "x"})
+	assert.Error(t, validateNotification(notification))
+}
+
+func TestGenerateTopicConfiguration(t *testing.T) {
+	arn := "arn:aws:sns:my-store::my-topic"
+	notification := &cephv1.CephBucketNotification{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-notification", Namespace: "rook-ceph"},
+		Spec: cephv1.BucketNotificationSpec{
+			Topic:   "my-topic",
+			Buckets: []string{"my-bucket"},
+		},
+	}
+
+	// all events by default
+	config := generateTopicConfiguration(notification, arn)
+	assert.Equal(t, "my-notification", aws.StringValue(config.Id))
+	assert.Equal(t, arn, aws.StringValue(config.TopicArn))
+	assert.Equal(t, []*string{aws.String("s3:ObjectCreated:*"), aws.String("s3:ObjectRemoved:*")}, config.Events)
+	assert.Nil(t, config.Filter)
+
+	notification.Spec.Events = []cephv1.BucketNotificationEvent{"s3:ObjectCreated:Put"}
+	notification.Spec.Filter = &cephv1.NotificationFilterSpec{KeyFilters: []cephv1.NotificationKeyFilterRule{{Name: "suffix", Value: ".log"}}}
+	config = generateTopicConfiguration(notification, arn)
+	assert.Equal(t, []*string{aws.String("s3:ObjectCreated:Put")}, config.Events)
+	assert.Equal(t, []*s3.FilterRule{{Name: aws.String("suffix"), Value: aws.String(".log")}}, config.Filter.Key.FilterRules)
+}
@@ -365,3 +365,12 @@ func emptyPool(pool cephv1.PoolSpec) bool {
 func BuildDomainName(name, namespace string) string {
 	return fmt.Sprintf("%s-%s.%s", AppName, name, namespace)
 }
+
+// BuildStoreEndpoint builds the endpoint of the rgw service of the object store, it only supports
+// the insecure port of the gateway
+func BuildStoreEndpoint(store *cephv1.CephObjectStore) (string, error) {
+	if store.Spec.Gateway.Port == 0 {
+		return "", errors.Errorf("object store %q has no insecure gateway port", store.Name)
+	}
+	return fmt.Sprintf("%s:%d", BuildDomainName(store.Name, store.Namespace), store.Spec.Gateway.Port), nil
+}
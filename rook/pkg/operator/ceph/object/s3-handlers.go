@@ -25,8 +25,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/pkg/errors"
 )
 
@@ -35,10 +37,37 @@ type S3Agent struct {
 	Client *s3.S3
 }
 
+// TopicAgent wraps the sns.SNS structure to manage the bucket notification topics of the rgw
+type TopicAgent struct {
+	Client *sns.SNS
+}
+
 func NewS3Agent(accessKey, secretKey, endpoint string) (*S3Agent, error) {
+	sess, err := newSession(accessKey, secretKey, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	svc := s3.New(sess)
+	return &S3Agent{
+		Client: svc,
+	}, nil
+}
+
+// NewTopicAgent returns an agent for the topic API of the rgw reachable at the given endpoint
+func NewTopicAgent(accessKey, secretKey, endpoint string) (*TopicAgent, error) {
+	sess, err := newSession(accessKey, secretKey, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &TopicAgent{
+		Client: sns.New(sess),
+	}, nil
+}
+
+func newSession(accessKey, secretKey, endpoint string) (*session.Session, error) {
 	const cephRegion = "us-east-1"
 
-	sess, err := session.NewSession(
+	return session.NewSession(
 		aws.NewConfig().
 			WithRegion(cephRegion).
 			WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, "")).
@@ -50,13 +79,6 @@ func NewS3Agent(accessKey, secretKey, endpoint string) (*S3Agent, error) {
 				Timeout: time.Second * 15,
 			}),
 	)
-	if err != nil {
-		return nil, err
-	}
-	svc := s3.New(sess)
-	return &S3Agent{
-		Client: svc,
-	}, nil
 }
 
 // CreateBucket creates a bucket with the given name
@@ -154,3 +176,127 @@ func (s *S3Agent) DeleteObjectInBucket(bucketname string, key string) (bool, err
 	}
 	return true, nil
 }
+
+// PutBucketLifecycle sets the lifecycle configuration of the given bucket
+func (s *S3Agent) PutBucketLifecycle(bucketname string, lifecycle *s3.BucketLifecycleConfiguration) error {
+	logger.Infof("setting lifecycle configuration of bucket %q", bucketname)
+	_, err := s.Client.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+		Bucket:                 aws.String(bucketname),
+		LifecycleConfiguration: lifecycle,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to set lifecycle configuration of bucket %q", bucketname)
+	}
+	return nil
+}
+
+// DeleteBucketLifecycle removes the lifecycle configuration of the given bucket
+func (s *S3Agent) DeleteBucketLifecycle(bucketname string) error {
+	logger.Infof("deleting lifecycle configuration of bucket %q", bucketname)
+	_, err := s.Client.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(bucketname),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete lifecycle configuration of bucket %q", bucketname)
+	}
+	return nil
+}
+
+// PutBucketNotification adds the topic notification to the notifications of the given bucket,
+// an existing notification with the same ID is replaced
+func (s *S3Agent) PutBucketNotification(bucketname string, notification *s3.TopicConfiguration) error {
+	logger.Infof("setting notification %q of bucket %q", aws.StringValue(notification.Id), bucketname)
+	current, err := s.Client.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucketname),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get notifications of bucket %q", bucketname)
+	}
+
+	topics := []*s3.TopicConfiguration{notification}
+	for _, topic := range current.TopicConfigurations {
+		if aws.StringValue(topic.Id) != aws.StringValue(notification.Id) {
+			topics = append(topics, topic)
+		}
+	}
+
+	_, err = s.Client.PutBucketNotificationConfiguration(&s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucketname),
+		NotificationConfiguration: &s3.NotificationConfiguration{
+			TopicConfigurations: topics,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to set notification %q of bucket %q", aws.StringValue(notification.Id), bucketname)
+	}
+	return nil
+}
+
+type deleteBucketNotificationInput struct {
+	_ struct{} `type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	Notification *string `location:"querystring" locationName:"notification" type:"string"`
+}
+
+type deleteBucketNotificationOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// DeleteBucketNotification removes the notification with the given ID from the bucket. The S3 API
+// has no call for it, so the rgw specific "DELETE /<bucket>?notification=<id>" request is sent.
+func (s *S3Agent) DeleteBucketNotification(bucketname, id string) error {
+	logger.Infof("deleting notification %q of bucket %q", id, bucketname)
+	op := &request.Operation{
+		Name:       "DeleteBucketNotification",
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/{Bucket}",
+	}
+	input := &deleteBucketNotificationInput{
+		Bucket:       aws.String(bucketname),
+		Notification: aws.String(id),
+	}
+	req := s.Client.NewRequest(op, input, &deleteBucketNotificationOutput{})
+	if err := req.Send(); err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == s3.ErrCodeNoSuchBucket || aerr.Code() == s3.ErrCodeNoSuchKey) {
+			logger.Debugf("notification %q of bucket %q does not exist", id, bucketname)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to delete notification %q of bucket %q", id, bucketname)
+	}
+	return nil
+}
+
+// CreateTopic creates or updates the topic with the given attributes and returns its ARN
+func (t *TopicAgent) CreateTopic(name string, attributes map[string]string) (string, error) {
+	logger.Infof("creating topic %q", name)
+	attrs := map[string]*string{}
+	for key, value := range attributes {
+		attrs[key] = aws.String(value)
+	}
+	out, err := t.Client.CreateTopic(&sns.CreateTopicInput{
+		Name:       aws.String(name),
+		Attributes: attrs,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create topic %q", name)
+	}
+	return aws.StringValue(out.TopicArn), nil
+}
+
+// DeleteTopic deletes the topic with the given ARN
+func (t *TopicAgent) DeleteTopic(arn string) error {
+	logger.Infof("deleting topic %q", arn)
+	_, err := t.Client.DeleteTopic(&sns.DeleteTopicInput{
+		TopicArn: aws.String(arn),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == sns.ErrCodeNotFoundException {
+			logger.Debugf("topic %q does not exist", arn)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to delete topic %q", arn)
+	}
+	return nil
+}
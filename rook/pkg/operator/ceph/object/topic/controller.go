@@ -0,0 +1,371 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package topic to manage the bucket notification topics of a rook object store.
+package topic
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/pkg/capnslog"
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	"github.com/rook/rook/pkg/operator/ceph/cluster/mon"
+	opcontroller "github.com/rook/rook/pkg/operator/ceph/controller"
+	"github.com/rook/rook/pkg/operator/ceph/object"
+	cephver "github.com/rook/rook/pkg/operator/ceph/version"
+	"github.com/rook/rook/pkg/operator/k8sutil"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	controllerName = "ceph-bucket-topic-controller"
+
+	// the rgw user the operator manages the topics with
+	topicUserName = "rook-ceph-internal-topic-user"
+)
+
+var logger = capnslog.NewPackageLogger("github.com/rook/rook", controllerName)
+
+var cephBucketTopicKind = reflect.TypeOf(cephv1.CephBucketTopic{}).Name()
+
+// Sets the type meta for the controller main object
+var controllerTypeMeta = metav1.TypeMeta{
+	Kind:       cephBucketTopicKind,
+	APIVersion: fmt.Sprintf("%s/%s", cephv1.CustomResourceGroup, cephv1.Version),
+}
+
+var _ reconcile.Reconciler = &ReconcileBucketTopic{}
+
+// ReconcileBucketTopic reconciles a CephBucketTopic object
+type ReconcileBucketTopic struct {
+	client  client.Client
+	scheme  *runtime.Scheme
+	context *clusterd.Context
+}
+
+// Add creates a new CephBucketTopic Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager, context *clusterd.Context) error {
+	return add(mgr, newReconciler(mgr, context))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager, context *clusterd.Context) reconcile.Reconciler {
+	// Add the cephv1 scheme to the manager scheme so that the controller knows about it
+	mgrScheme := mgr.GetScheme()
+	cephv1.AddToScheme(mgr.GetScheme())
+
+	return &ReconcileBucketTopic{
+		client:  mgr.GetClient(),
+		scheme:  mgrScheme,
+		context: context,
+	}
+}
+
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New(controllerName, mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+	logger.Info("successfully started")
+
+	// Watch for changes on the CephBucketTopic CRD object
+	err = c.Watch(&source.Kind{Type: &cephv1.CephBucketTopic{TypeMeta: controllerTypeMeta}}, &handler.EnqueueRequestForObject{}, opcontroller.WatchControllerPredicate())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reconcile reads that state of the cluster for a CephBucketTopic object and makes changes based on the state read
+// and what is in the CephBucketTopic.Spec
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *ReconcileBucketTopic) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	// workaround because the rook logging mechanism is not compatible with the controller-runtime loggin interface
+	reconcileResponse, err := r.reconcile(request)
+	if err != nil {
+		logger.Errorf("failed to reconcile %v", err)
+	}
+
+	return reconcileResponse, err
+}
+
+func (r *ReconcileBucketTopic) reconcile(request reconcile.Request) (reconcile.Result, error) {
+	// Fetch the CephBucketTopic instance
+	topic := &cephv1.CephBucketTopic{}
+	err := r.client.Get(context.TODO(), request.NamespacedName, topic)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephBucketTopic resource not found. Ignoring since object must be deleted.")
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, errors.Wrap(err, "failed to get CephBucketTopic")
+	}
+
+	// The CR was just created, initializing status fields
+	if topic.Status == nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.Created, nil)
+	}
+
+	// Make sure a CephCluster is present otherwise do nothing
+	cephCluster, isReadyToReconcile, cephClusterExists, reconcileResponse := opcontroller.IsReadyToReconcile(r.client, r.context, request.NamespacedName, controllerName)
+	if !isReadyToReconcile {
+		// This handles the case where the Ceph Cluster is gone and we want to delete that CR
+		// We skip the deleteTopic() function since everything is gone already
+		//
+		// Also, only remove the finalizer if the CephCluster is gone
+		// If not, we should wait for it to be ready
+		// This handles the case where the operator is not ready to accept Ceph command but the cluster exists
+		if !topic.GetDeletionTimestamp().IsZero() && !cephClusterExists {
+			// Remove finalizer
+			err = opcontroller.RemoveFinalizer(r.client, topic)
+			if err != nil {
+				return reconcile.Result{}, errors.Wrap(err, "failed to remove finalizer")
+			}
+
+			// Return and do not requeue. Successful deletion.
+			return reconcile.Result{}, nil
+		}
+		return reconcileResponse, nil
+	}
+
+	// Set a finalizer so we can do cleanup before the object goes away
+	err = opcontroller.AddFinalizerIfNotPresent(r.client, topic)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "failed to add finalizer")
+	}
+
+	// Get CephCluster version
+	cephVersion, err := opcontroller.GetImageVersion(cephCluster)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to fetch ceph version from cephcluster %q", cephCluster.Name)
+	}
+
+	// DELETE: the CR was deleted
+	if !topic.GetDeletionTimestamp().IsZero() {
+		logger.Debugf("deleting bucket topic %q", topic.Name)
+		if topic.Status != nil && topic.Status.ARN != nil {
+			err := r.deleteTopic(&cephCluster, topic)
+			if err != nil {
+				return reconcile.Result{}, errors.Wrapf(err, "failed to delete bucket topic %q", topic.Name)
+			}
+		}
+
+		// Remove finalizer
+		err = opcontroller.RemoveFinalizer(r.client, topic)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to remove finalizer")
+		}
+
+		// Return and do not requeue. Successful deletion.
+		return reconcile.Result{}, nil
+	}
+
+	// validate the topic settings
+	err = validateTopic(topic, *cephVersion)
+	if err != nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+		return reconcile.Result{}, errors.Wrapf(err, "invalid bucket topic CR %q spec", topic.Name)
+	}
+
+	// CREATE/UPDATE
+	agent, err := r.newTopicAgent(&cephCluster, topic)
+	if err != nil {
+		logger.Debugf("object store %q of bucket topic %q not ready, retrying in %q. %v",
+			topic.Spec.ObjectStoreName, topic.Name, opcontroller.WaitForRequeueIfCephClusterNotReady.RequeueAfter.String(), err)
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+		return opcontroller.WaitForRequeueIfCephClusterNotReady, nil
+	}
+
+	arn, err := agent.CreateTopic(topic.Name, generateTopicAttributes(topic))
+	if err != nil {
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+		return reconcile.Result{}, errors.Wrapf(err, "failed to create bucket topic %q", topic.Name)
+	}
+	logger.Infof("created bucket topic %q with ARN %q", topic.Name, arn)
+
+	// Set Ready status, we are done reconciling
+	updateStatus(r.client, request.NamespacedName, k8sutil.ReadyStatus, &arn)
+
+	// Return and do not requeue
+	logger.Debug("done reconciling")
+	return reconcile.Result{}, nil
+}
+
+// validateTopic validates the topic arguments
+func validateTopic(t *cephv1.CephBucketTopic, cephVersion cephver.CephVersion) error {
+	if t.Name == "" {
+		return errors.New("missing name")
+	}
+	if t.Namespace == "" {
+		return errors.New("missing namespace")
+	}
+	if t.Spec.ObjectStoreName == "" {
+		return errors.New("missing objectStoreName")
+	}
+	if t.Spec.Persistent && !cephVersion.IsAtLeastPacific() {
+		return errors.New("persistent bucket topics are only supported from Ceph Pacific")
+	}
+
+	endpoint := t.Spec.Endpoint
+	if (endpoint.HTTP == nil) == (endpoint.AMQP == nil) {
+		return errors.New("exactly one of the http or amqp endpoint must be set")
+	}
+	if endpoint.HTTP != nil {
+		if !strings.HasPrefix(endpoint.HTTP.URI, "http://") && !strings.HasPrefix(endpoint.HTTP.URI, "https://") {
+			return errors.Errorf("invalid http endpoint uri %q", endpoint.HTTP.URI)
+		}
+	}
+	if endpoint.AMQP != nil {
+		if !strings.HasPrefix(endpoint.AMQP.URI, "amqp://") && !strings.HasPrefix(endpoint.AMQP.URI, "amqps://") {
+			return errors.Errorf("invalid amqp endpoint uri %q", endpoint.AMQP.URI)
+		}
+		if endpoint.AMQP.Exchange == "" {
+			return errors.New("missing amqp exchange")
+		}
+		switch endpoint.AMQP.AckLevel {
+		case "", "none", "broker", "routable":
+		default:
+			return errors.Errorf("invalid amqp ack level %q, must be one of none, broker or routable", endpoint.AMQP.AckLevel)
+		}
+	}
+
+	return nil
+}
+
+// generateTopicAttributes returns the attributes of the topic in the rgw topic API
+func generateTopicAttributes(t *cephv1.CephBucketTopic) map[string]string {
+	attributes := map[string]string{
+		"persistent": strconv.FormatBool(t.Spec.Persistent),
+	}
+	if t.Spec.OpaqueData != "" {
+		attributes["OpaqueData"] = t.Spec.OpaqueData
+	}
+
+	endpoint := t.Spec.Endpoint
+	if endpoint.HTTP != nil {
+		attributes["push-endpoint"] = endpoint.HTTP.URI
+		attributes["verify-ssl"] = strconv.FormatBool(!endpoint.HTTP.DisableVerifySSL)
+	}
+	if endpoint.AMQP != nil {
+		attributes["push-endpoint"] = endpoint.AMQP.URI
+		attributes["verify-ssl"] = strconv.FormatBool(!endpoint.AMQP.DisableVerifySSL)
+		attributes["amqp-exchange"] = endpoint.AMQP.Exchange
+		ackLevel := endpoint.AMQP.AckLevel
+		if ackLevel == "" {
+			ackLevel = "broker"
+		}
+		attributes["amqp-ack-level"] = ackLevel
+	}
+
+	return attributes
+}
+
+// newTopicAgent returns an agent for the topic API of the object store of the topic
+func (r *ReconcileBucketTopic) newTopicAgent(cephCluster *cephv1.CephCluster, t *cephv1.CephBucketTopic) (*object.TopicAgent, error) {
+	store := &cephv1.CephObjectStore{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{Name: t.Spec.ObjectStoreName, Namespace: t.Namespace}, store)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get object store %q", t.Spec.ObjectStoreName)
+	}
+	endpoint, err := object.BuildStoreEndpoint(store)
+	if err != nil {
+		return nil, err
+	}
+
+	objContext := object.NewContext(r.context, store.Name, store.Namespace)
+	// Set the cephx external username if the CephCluster is external
+	if cephCluster.Spec.External.Enable {
+		clusterInfo := mon.PopulateExternalClusterInfo(r.context, t.Namespace)
+		objContext.RunAsUser = clusterInfo.ExternalCred.Username
+	}
+
+	displayName := topicUserName
+	userConfig := object.ObjectUser{UserID: topicUserName, DisplayName: &displayName}
+	user, rgwerr, err := object.CreateUser(objContext, userConfig)
+	if err != nil {
+		if rgwerr != object.ErrorCodeFileExists {
+			return nil, errors.Wrapf(err, "failed to create object user %q. error code %d", topicUserName, rgwerr)
+		}
+		user, _, err = object.GetUser(objContext, topicUserName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get object user %q", topicUserName)
+		}
+	}
+
+	return object.NewTopicAgent(*user.AccessKey, *user.SecretKey, endpoint)
+}
+
+// deleteTopic deletes the topic from the rgw
+func (r *ReconcileBucketTopic) deleteTopic(cephCluster *cephv1.CephCluster, t *cephv1.CephBucketTopic) error {
+	agent, err := r.newTopicAgent(cephCluster, t)
+	if err != nil {
+		if kerrors.IsNotFound(errors.Cause(err)) {
+			logger.Infof("object store %q of bucket topic %q is gone, skipping topic deletion", t.Spec.ObjectStoreName, t.Name)
+			return nil
+		}
+		return err
+	}
+
+	return agent.DeleteTopic(*t.Status.ARN)
+}
+
+// updateStatus updates a bucket topic CR with the given status and ARN if not nil
+func updateStatus(client client.Client, name types.NamespacedName, status string, arn *string) {
+	topic := &cephv1.CephBucketTopic{}
+	err := client.Get(context.TODO(), name, topic)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephBucketTopic resource not found. Ignoring since object must be deleted.")
+			return
+		}
+		logger.Warningf("failed to retrieve bucket topic %q to update status to %q. %v", name, status, err)
+		return
+	}
+
+	if topic.Status == nil {
+		topic.Status = &cephv1.BucketTopicStatus{}
+	}
+
+	topic.Status.Phase = status
+	if arn != nil {
+		topic.Status.ARN = arn
+	}
+	if err := opcontroller.UpdateStatus(client, topic); err != nil {
+		logger.Warningf("failed to set bucket topic %q status to %q. %v", topic.Name, status, err)
+		return
+	}
+	logger.Debugf("bucket topic %q status updated to %q", name, status)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topic
+
+import (
+	"testing"
+
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	cephver "github.com/rook/rook/pkg/operator/ceph/version"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateTopic(t *testing.T) {
+	topic := &cephv1.CephBucketTopic{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-topic", Namespace: "rook-ceph"},
+		Spec: cephv1.BucketTopicSpec{
+			ObjectStoreName: "my-store",
+			Endpoint: cephv1.TopicEndpointSpec{
+				HTTP: &cephv1.HTTPEndpointSpec{URI: "http://my-endpoint:8080"},
+			},
+		},
+	}
+	assert.NoError(t, validateTopic(topic, cephver.Nautilus))
+
+	// missing store
+	topic.Spec.ObjectStoreName = ""
+	assert.Error(t, validateTopic(topic, cephver.Nautilus))
+	topic.Spec.ObjectStoreName = "my-store"
+
+	// persistent topics need pacific
+	topic.Spec.Persistent = true
+	assert.Error(t, validateTopic(topic, cephver.Octopus))
+	assert.NoError(t, validateTopic(topic, cephver.Pacific))
+	topic.Spec.Persistent = false
+
+	// invalid http uri
+	topic.Spec.Endpoint.HTTP.URI = "amqp://my-endpoint"
+	assert.Error(t, validateTopic(topic, cephver.Nautilus))
+
+	// two endpoints
+	topic.Spec.Endpoint.HTTP.URI = "https://my-endpoint"
+	topic.Spec.Endpoint.AMQP = &cephv1.AMQPEndpointSpec{URI: "amqp://my-broker:5672", Exchange: "ex"}
+	assert.Error(t, validateTopic(topic, cephver.Nautilus))
+
+	// no endpoint
+	topic.Spec.Endpoint = cephv1.TopicEndpointSpec{}
+	assert.Error(t, validateTopic(topic, cephver.Nautilus))
+
+	// amqp endpoint
+	topic.Spec.Endpoint.AMQP = &cephv1.AMQPEndpointSpec{URI: "amqps://my-broker:5671", Exchange: "ex", AckLevel: "routable"}
+	assert.NoError(t, validateTopic(topic, cephver.Nautilus))
+	topic.Spec.Endpoint.AMQP.AckLevel = "all"
+	assert.Error(t, validateTopic(topic, cephver.Nautilus))
+	topic.Spec.Endpoint.AMQP.AckLevel = ""
+	topic.Spec.Endpoint.AMQP.Exchange = ""
+	assert.Error(t, validateTopic(topic, cephver.Nautilus))
+}
+
+func TestGenerateTopicAttributes(t *testing.T) {
+	topic := &cephv1.CephBucketTopic{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-topic", Namespace: "rook-ceph"},
+		Spec: cephv1.BucketTopicSpec{
+			ObjectStoreName: "my-store",
+			OpaqueData:      "my-data",
+			Endpoint: cephv1.TopicEndpointSpec{
+				HTTP: &cephv1.HTTPEndpointSpec{URI: "https://my-endpoint", DisableVerifySSL: true},
+			},
+		},
+	}
+	assert.Equal(t, map[string]string{
+		"persistent":    "false",
+		"OpaqueData":    "my-data",
+		"push-endpoint": "https://my-endpoint",
+		"verify-ssl":    "false",
+	}, generateTopicAttributes(topic))
+
+	topic.Spec.OpaqueData = ""
+	topic.Spec.Persistent = true
+	topic.Spec.Endpoint = cephv1.TopicEndpointSpec{
+		AMQP: &cephv1.AMQPEndpointSpec{URI: "amqp://my-broker:5672", Exchange: "ex"},
+	}
+	assert.Equal(t, map[string]string{
+		"persistent":     "true",
+		"push-endpoint":  "amqp://my-broker:5672",
+		"verify-ssl":     "true",
+		"amqp-exchange":  "ex",
+		"amqp-ack-level": "broker",
+	}, generateTopicAttributes(topic))
+}
@@ -18,6 +18,8 @@ package object
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"syscall"
@@ -42,6 +44,7 @@ type ObjectUser struct {
 	Email       *string `json:"email"`
 	AccessKey   *string `json:"accessKey"`
 	SecretKey   *string `json:"secretKey"`
+	MaxBuckets  *int    `json:"maxBuckets"`
 }
 
 // ListUsers lists the object pool users.
@@ -63,10 +66,15 @@ type rgwUserInfo struct {
 	UserID      string `json:"user_id"`
 	DisplayName string `json:"display_name"`
 	Email       string `json:"email"`
+	MaxBuckets  int    `json:"max_buckets"`
 	Keys        []struct {
 		AccessKey string `json:"access_key"`
 		SecretKey string `json:"secret_key"`
 	}
+	Caps []struct {
+		Type string `json:"type"`
+		Perm string `json:"perm"`
+	}
 }
 
 func decodeUser(data string) (*ObjectUser, int, error) {
@@ -76,7 +84,7 @@ func decodeUser(data string) (*ObjectUser, int, error) {
 		return nil, RGWErrorParse, errors.Wrapf(err, "failed to unmarshal json. %s", data)
 	}
 
-	rookUser := ObjectUser{UserID: user.UserID, DisplayName: &user.DisplayName, Email: &user.Email}
+	rookUser := ObjectUser{UserID: user.UserID, DisplayName: &user.DisplayName, Email: &user.Email, MaxBuckets: &user.MaxBuckets}
 
 	if len(user.Keys) > 0 {
 		rookUser.AccessKey = &user.Keys[0].AccessKey
@@ -123,6 +131,9 @@ func CreateUser(c *Context, user ObjectUser) (*ObjectUser, int, error) {
 	if user.Email != nil {
 		args = append(args, "--email", *user.Email)
 	}
+	if user.MaxBuckets != nil {
+		args = append(args, "--max-buckets", strconv.Itoa(*user.MaxBuckets))
+	}
 
 	result, err := runAdminCommand(c, args...)
 	if err != nil {
@@ -153,6 +164,9 @@ func UpdateUser(c *Context, user ObjectUser) (*ObjectUser, int, error) {
 	if user.Email != nil {
 		args = append(args, "--email", *user.Email)
 	}
+	if user.MaxBuckets != nil {
+		args = append(args, "--max-buckets", strconv.Itoa(*user.MaxBuckets))
+	}
 
 	body, err := runAdminCommand(c, args...)
 	if err != nil {
@@ -202,6 +216,97 @@ func setUserQuota(c *Context, id string, args []string) (string, int, error) {
 	return result, RGWErrorNone, err
 }
 
+// SetUserQuota sets and enables the quota of the user. A negative value means the limit is not
+// enforced, the quota is disabled when neither the size nor the object limit is enforced.
+func SetUserQuota(c *Context, id string, maxSize, maxObjects int64) (string, int, error) {
+	if maxSize < 0 && maxObjects < 0 {
+		logger.Infof("disabling quota of user %q", id)
+		result, err := runAdminCommand(c, "quota", "disable", "--quota-scope", "user", "--uid", id)
+		if err != nil {
+			return result, RGWErrorUnknown, errors.Wrapf(err, "failed to disable quota for user %q", id)
+		}
+		return result, RGWErrorNone, nil
+	}
+
+	logger.Infof("setting quota of user %q to max size %d and max objects %d", id, maxSize, maxObjects)
+	args := []string{"--quota-scope", "user", "--max-size", strconv.FormatInt(maxSize, 10), "--max-objects", strconv.FormatInt(maxObjects, 10)}
+	result, _, err := setUserQuota(c, id, args)
+	if err != nil {
+		return result, RGWErrorUnknown, errors.Wrapf(err, "failed to set quota for user %q", id)
+	}
+
+	result, err = runAdminCommand(c, "quota", "enable", "--quota-scope", "user", "--uid", id)
+	if err != nil {
+		return result, RGWErrorUnknown, errors.Wrapf(err, "failed to enable quota for user %q", id)
+	}
+	return result, RGWErrorNone, nil
+}
+
+// SetUserCaps sets the admin capabilities of the user. The caps map the capability type (e.g.
+// "users" or "buckets") to its permission, capabilities of the user that are not listed are removed.
+func SetUserCaps(c *Context, id string, caps map[string]string) error {
+	result, err := runAdminCommand(c, "user", "info", "--uid", id)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get info of user %q. %s", id, result)
+	}
+	var user rgwUserInfo
+	if err := json.Unmarshal([]byte(result), &user); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal info of user %q. %s", id, result)
+	}
+
+	current := map[string]string{}
+	for _, cap := range user.Caps {
+		current[cap.Type] = normalizeCapPerm(cap.Perm)
+	}
+	desired := map[string]string{}
+	for capType, perm := range caps {
+		desired[capType] = normalizeCapPerm(perm)
+	}
+
+	for _, capType := range sortedCapTypes(current) {
+		if desired[capType] == current[capType] {
+			continue
+		}
+		logger.Infof("removing cap %q from user %q", capType, id)
+		result, err := runAdminCommand(c, "caps", "rm", "--uid", id, "--caps", fmt.Sprintf("%s=%s", capType, current[capType]))
+		if err != nil {
+			return errors.Wrapf(err, "failed to remove cap %q from user %q. %s", capType, id, result)
+		}
+	}
+
+	for _, capType := range sortedCapTypes(desired) {
+		if desired[capType] == current[capType] {
+			continue
+		}
+		logger.Infof("adding cap %q with permission %q to user %q", capType, desired[capType], id)
+		result, err := runAdminCommand(c, "caps", "add", "--uid", id, "--caps", fmt.Sprintf("%s=%s", capType, desired[capType]))
+		if err != nil {
+			return errors.Wrapf(err, "failed to add cap %q to user %q. %s", capType, id, result)
+		}
+	}
+
+	return nil
+}
+
+// normalizeCapPerm returns the permission the way the rgw reports it, where both read and write
+// access is reported as "*"
+func normalizeCapPerm(perm string) string {
+	perm = strings.Replace(perm, " ", "", -1)
+	if perm == "read,write" || perm == "write,read" {
+		return "*"
+	}
+	return perm
+}
+
+func sortedCapTypes(caps map[string]string) []string {
+	capTypes := []string{}
+	for capType := range caps {
+		capTypes = append(capTypes, capType)
+	}
+	sort.Strings(capTypes)
+	return capTypes
+}
+
 func LinkUser(c *Context, id, bucket string) (string, int, error) {
 	logger.Infof("Linking (user: %s) (bucket: %s)", id, bucket)
 	args := []string{"bucket", "link", "--uid", id, "--bucket", bucket}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/rook/rook/pkg/operator/ceph/cluster/mon"
 	opcontroller "github.com/rook/rook/pkg/operator/ceph/controller"
@@ -276,17 +277,45 @@ func (r *ReconcileObjectStoreUser) reconcileCephUser(cephObjectStoreUser *cephv1
 		return reconcile.Result{}, errors.Wrapf(err, "failed to create object store user %q", cephObjectStoreUser.Name)
 	}
 
+	err = r.setUserQuota(cephObjectStoreUser)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to set quota of object store user %q", cephObjectStoreUser.Name)
+	}
+
+	err = object.SetUserCaps(r.objContext, r.userConfig.UserID, generateUserCaps(cephObjectStoreUser))
+	if err != nil {
+		return reconcile.Result{}, errors.Wrapf(err, "failed to set capabilities of object store user %q", cephObjectStoreUser.Name)
+	}
+
 	return reconcile.Result{}, nil
 }
 
+func (r *ReconcileObjectStoreUser) setUserQuota(u *cephv1.CephObjectStoreUser) error {
+	// a negative limit is not enforced by the rgw
+	maxSize := int64(-1)
+	maxObjects := int64(-1)
+	if u.Spec.Quotas != nil {
+		if u.Spec.Quotas.MaxSize != nil {
+			maxSize = u.Spec.Quotas.MaxSize.Value()
+		}
+		if u.Spec.Quotas.MaxObjects != nil {
+			maxObjects = *u.Spec.Quotas.MaxObjects
+		}
+	}
+
+	_, _, err := object.SetUserQuota(r.objContext, r.userConfig.UserID, maxSize, maxObjects)
+	return err
+}
+
 func (r *ReconcileObjectStoreUser) createCephUser(u *cephv1.CephObjectStoreUser) error {
 	logger.Infof("creating ceph object user %q in namespace %q", u.Name, u.Namespace)
 	user, rgwerr, err := object.CreateUser(r.objContext, r.userConfig)
 	if err != nil {
 		if rgwerr == object.ErrorCodeFileExists {
-			objectUser, _, err := object.GetUser(r.objContext, r.userConfig.UserID)
+			// update the existing user so that changes of the spec are applied
+			objectUser, _, err := object.UpdateUser(r.objContext, r.userConfig)
 			if err != nil {
-				return errors.Wrapf(err, "failed to get details from ceph object user %q", objectUser.UserID)
+				return errors.Wrapf(err, "failed to update ceph object user %q", r.userConfig.UserID)
 			}
 
 			// Set access and secret key
@@ -336,9 +365,35 @@ func generateUserConfig(user *cephv1.CephObjectStoreUser) object.ObjectUser {
 		DisplayName: &displayName,
 	}
 
+	if user.Spec.Quotas != nil && user.Spec.Quotas.MaxBuckets != nil {
+		userConfig.MaxBuckets = user.Spec.Quotas.MaxBuckets
+	}
+
 	return userConfig
 }
 
+// generateUserCaps returns the rgw capabilities of the user, by capability type
+func generateUserCaps(user *cephv1.CephObjectStoreUser) map[string]string {
+	caps := map[string]string{}
+	if user.Spec.Capabilities == nil {
+		return caps
+	}
+
+	for capType, perm := range map[string]string{
+		"users":    user.Spec.Capabilities.User,
+		"buckets":  user.Spec.Capabilities.Bucket,
+		"metadata": user.Spec.Capabilities.MetaData,
+		"usage":    user.Spec.Capabilities.Usage,
+		"zone":     user.Spec.Capabilities.Zone,
+	} {
+		if perm != "" {
+			caps[capType] = perm
+		}
+	}
+
+	return caps
+}
+
 func (r *ReconcileObjectStoreUser) generateCephUserSecret(u *cephv1.CephObjectStoreUser) *v1.Secret {
 	// Store the keys in a secret
 	secrets := map[string]string{
@@ -474,9 +529,33 @@ func (r *ReconcileObjectStoreUser) validateUser(u *cephv1.CephObjectStoreUser) e
 			return errors.New("missing store")
 		}
 	}
+	if u.Spec.Quotas != nil {
+		if u.Spec.Quotas.MaxBuckets != nil && *u.Spec.Quotas.MaxBuckets < 0 {
+			return errors.Errorf("invalid max buckets %d, must not be negative", *u.Spec.Quotas.MaxBuckets)
+		}
+		if u.Spec.Quotas.MaxSize != nil && u.Spec.Quotas.MaxSize.Sign() < 0 {
+			return errors.Errorf("invalid max size %q, must not be negative", u.Spec.Quotas.MaxSize.String())
+		}
+		if u.Spec.Quotas.MaxObjects != nil && *u.Spec.Quotas.MaxObjects < 0 {
+			return errors.Errorf("invalid max objects %d, must not be negative", *u.Spec.Quotas.MaxObjects)
+		}
+	}
+	for capType, perm := range generateUserCaps(u) {
+		if !validCapPerm(perm) {
+			return errors.Errorf("invalid permission %q for capability %q, must be one of \"*\", \"read\", \"write\" or \"read, write\"", perm, capType)
+		}
+	}
 	return nil
 }
 
+func validCapPerm(perm string) bool {
+	switch strings.Replace(perm, " ", "", -1) {
+	case "*", "read", "write", "read,write":
+		return true
+	}
+	return false
+}
+
 func labelsForRgw(name string) map[string]string {
 	return map[string]string{"rgw": name, k8sutil.AppAttr: appName}
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -293,3 +294,55 @@ func TestCephObjectStoreUserController(t *testing.T) {
 	assert.Equal(t, "Ready", objectUser.Status.Phase, objectUser)
 	logger.Info("PHASE 5 DONE")
 }
+
+func TestValidateUser(t *testing.T) {
+	r := &ReconcileObjectStoreUser{cephClusterSpec: &cephv1.ClusterSpec{}}
+	u := &cephv1.CephObjectStoreUser{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       cephv1.ObjectStoreUserSpec{Store: store},
+	}
+	assert.NoError(t, r.validateUser(u))
+
+	// quotas
+	maxBuckets := 10
+	maxObjects := int64(1000)
+	maxSize := resource.MustParse("10Gi")
+	u.Spec.Quotas = &cephv1.ObjectUserQuotaSpec{MaxBuckets: &maxBuckets, MaxObjects: &maxObjects, MaxSize: &maxSize}
+	assert.NoError(t, r.validateUser(u))
+	maxBuckets = -1
+	assert.Error(t, r.validateUser(u))
+	maxBuckets = 10
+	maxObjects = -1
+	assert.Error(t, r.validateUser(u))
+	maxObjects = 1000
+	maxSize = resource.MustParse("-1Gi")
+	assert.Error(t, r.validateUser(u))
+	maxSize = resource.MustParse("10Gi")
+
+	// capabilities
+	u.Spec.Capabilities = &cephv1.ObjectUserCapSpec{User: "*", Bucket: "read", MetaData: "write", Usage: "read, write"}
+	assert.NoError(t, r.validateUser(u))
+	u.Spec.Capabilities.Zone = "delete"
+	assert.Error(t, r.validateUser(u))
+}
+
+func TestGenerateUser(t *testing.T) {
+	maxBuckets := 5
+	u := &cephv1.CephObjectStoreUser{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: cephv1.ObjectStoreUserSpec{
+			Store:        store,
+			Quotas:       &cephv1.ObjectUserQuotaSpec{MaxBuckets: &maxBuckets},
+			Capabilities: &cephv1.ObjectUserCapSpec{User: "read", Zone: "*"},
+		},
+	}
+
+	userConfig := generateUserConfig(u)
+	assert.Equal(t, name, userConfig.UserID)
+	assert.Equal(t, name, *userConfig.DisplayName)
+	assert.Equal(t, 5, *userConfig.MaxBuckets)
+
+	assert.Equal(t, map[string]string{"users": "read", "zone": "*"}, generateUserCaps(u))
+	u.Spec.Capabilities = nil
+	assert.Empty(t, generateUserCaps(u))
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"testing"
+
+	"github.com/rook/rook/pkg/clusterd"
+	exectest "github.com/rook/rook/pkg/util/exec/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetUserQuota(t *testing.T) {
+	var commands [][]string
+	executor := &exectest.MockExecutor{
+		MockExecuteCommandWithOutput: func(command string, args ...string) (string, error) {
+			commands = append(commands, args)
+			return "", nil
+		},
+	}
+	objContext := NewContext(&clusterd.Context{Executor: executor}, "my-store", "rook-ceph")
+
+	// both limits set
+	_, code, err := SetUserQuota(objContext, "my-user", 1024, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, RGWErrorNone, code)
+	assert.Equal(t, 2, len(commands))
+	assert.Equal(t, []string{"quota", "set", "--uid", "my-user", "--quota-scope", "user", "--max-size", "1024", "--max-objects", "10"}, commands[0][:10])
+	assert.Equal(t, []string{"quota", "enable", "--quota-scope", "user", "--uid", "my-user"}, commands[1][:6])
+
+	// only the object limit is set
+	commands = nil
+	_, _, err = SetUserQuota(objContext, "my-user", -1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(commands))
+	assert.Equal(t, []string{"--max-size", "-1", "--max-objects", "10"}, commands[0][6:10])
+
+	// no limit disables the quota
+	commands = nil
+	_, _, err = SetUserQuota(objContext, "my-user", -1, -1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(commands))
+	assert.Equal(t, []string{"quota", "disable", "--quota-scope", "user", "--uid", "my-user"}, commands[0][:6])
+}
+
+func TestSetUserCaps(t *testing.T) {
+	userInfo := `{"user_id":"my-user","display_name":"my-user","caps":[{"type":"buckets","perm":"*"},{"type":"usage","perm":"read"},{"type":"users","perm":"read"}]}`
+	var commands [][]string
+	executor := &exectest.MockExecutor{
+		MockExecuteCommandWithOutput: func(command string, args ...string) (string, error) {
+			if args[0] == "user" {
+				return userInfo, nil
+			}
+			commands = append(commands, args[:6])
+			return "", nil
+		},
+	}
+	objContext := NewContext(&clusterd.Context{Executor: executor}, "my-store", "rook-ceph")
+
+	// buckets is unchanged, usage is removed, users is changed and zone is added
+	err := SetUserCaps(objContext, "my-user", map[string]string{"buckets": "read, write", "users": "write", "zone": "read"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"caps", "rm", "--uid", "my-user", "--caps", "usage=read"},
+		{"caps", "rm", "--uid", "my-user", "--caps", "users=read"},
+		{"caps", "add", "--uid", "my-user", "--caps", "users=write"},
+		{"caps", "add", "--uid", "my-user", "--caps", "zone=read"},
+	}, commands)
+
+	// no change
+	commands = nil
+	err = SetUserCaps(objContext, "my-user", map[string]string{"buckets": "*", "usage": "read", "users": "read"})
+	assert.NoError(t, err)
+	assert.Empty(t, commands)
+
+	// all caps are removed
+	commands = nil
+	err = SetUserCaps(objContext, "my-user", map[string]string{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(commands))
+}
+
+func TestNormalizeCapPerm(t *testing.T) {
+	assert.Equal(t, "*", normalizeCapPerm("*"))
+	assert.Equal(t, "*", normalizeCapPerm("read, write"))
+	assert.Equal(t, "*", normalizeCapPerm("write,read"))
+	assert.Equal(t, "read", normalizeCapPerm("read"))
+	assert.Equal(t, "write", normalizeCapPerm(" write"))
+}
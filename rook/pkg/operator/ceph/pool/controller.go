@@ -64,9 +64,15 @@ var _ reconcile.Reconciler = &ReconcileCephBlockPool{}
 
 // ReconcileCephBlockPool reconciles a CephBlockPool object
 type ReconcileCephBlockPool struct {
-	client  client.Client
-	scheme  *runtime.Scheme
-	context *clusterd.Context
+	client            client.Client
+	scheme            *runtime.Scheme
+	context           *clusterd.Context
+	blockPoolChannels map[string]*blockPoolHealth
+}
+
+type blockPoolHealth struct {
+	stopChan          chan struct{}
+	monitoringRunning bool
 }
 
 // Add creates a new CephBlockPool Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -82,9 +88,10 @@ func newReconciler(mgr manager.Manager, context *clusterd.Context) reconcile.Rec
 	cephv1.AddToScheme(mgr.GetScheme())
 
 	return &ReconcileCephBlockPool{
-		client:  mgr.GetClient(),
-		scheme:  mgrScheme,
-		context: context,
+		client:            mgr.GetClient(),
+		scheme:            mgrScheme,
+		context:           context,
+		blockPoolChannels: make(map[string]*blockPoolHealth),
 	}
 }
 
@@ -134,7 +141,7 @@ func (r *ReconcileCephBlockPool) reconcile(request reconcile.Request) (reconcile
 
 	// The CR was just created, initializing status fields
 	if cephBlockPool.Status == nil {
-		updateStatus(r.client, request.NamespacedName, k8sutil.Created)
+		updateStatus(r.client, request.NamespacedName, k8sutil.Created, nil)
 	}
 
 	// Make sure a CephCluster is present otherwise do nothing
@@ -168,6 +175,10 @@ func (r *ReconcileCephBlockPool) reconcile(request reconcile.Request) (reconcile
 	// DELETE: the CR was deleted
 	if !cephBlockPool.GetDeletionTimestamp().IsZero() {
 		logger.Debugf("deleting pool %q", cephBlockPool.Name)
+
+		// Stop the status check of the pool
+		r.stopMonitoring(request.NamespacedName)
+
 		err := deletePool(r.context, cephBlockPool)
 		if err != nil {
 			return reconcile.Result{}, errors.Wrapf(err, "failed to delete pool %q. ", cephBlockPool.Name)
@@ -188,7 +199,7 @@ func (r *ReconcileCephBlockPool) reconcile(request reconcile.Request) (reconcile
 		return reconcile.Result{}, errors.Wrapf(err, "invalid pool CR %q spec", cephBlockPool.Name)
 	}
 
-	updateStatus(r.client, request.NamespacedName, k8sutil.ReconcilingStatus)
+	updateStatus(r.client, request.NamespacedName, k8sutil.ReconcilingStatus, nil)
 
 	// Get CephCluster version
 	cephVersion, err := opcontroller.GetImageVersion(cephCluster)
@@ -211,12 +222,31 @@ func (r *ReconcileCephBlockPool) reconcile(request reconcile.Request) (reconcile
 	// CREATE/UPDATE
 	reconcileResponse, err = r.reconcileCreatePool(cephBlockPool)
 	if err != nil {
-		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus)
+		updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
 		return reconcileResponse, errors.Wrapf(err, "failed to create pool %q.", cephBlockPool.GetName())
 	}
 
+	// MIRRORING
+	var info map[string]string
+	if cephBlockPool.Spec.IsMirroringEnabled() {
+		info, err = r.reconcileMirroring(cephBlockPool, cephVersion)
+		if err != nil {
+			updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+			return reconcile.Result{}, errors.Wrapf(err, "failed to configure mirroring for pool %q.", cephBlockPool.GetName())
+		}
+	} else {
+		err = r.disableMirroring(cephBlockPool)
+		if err != nil {
+			updateStatus(r.client, request.NamespacedName, k8sutil.ReconcileFailedStatus, nil)
+			return reconcile.Result{}, errors.Wrapf(err, "failed to disable mirroring for pool %q.", cephBlockPool.GetName())
+		}
+	}
+
+	// Start the status check of the pool
+	r.startMonitoring(request.NamespacedName)
+
 	// Set Ready status, we are done reconciling
-	updateStatus(r.client, request.NamespacedName, k8sutil.ReadyStatus)
+	updateStatus(r.client, request.NamespacedName, k8sutil.ReadyStatus, info)
 
 	// Return and do not requeue
 	logger.Debug("done reconciling")
@@ -264,8 +294,8 @@ func deletePool(context *clusterd.Context, p *cephv1.CephBlockPool) error {
 	return nil
 }
 
-// updateStatus updates a pool CR with the given status
-func updateStatus(client client.Client, poolName types.NamespacedName, status string) {
+// updateStatus updates a pool CR with the given status and info if not nil
+func updateStatus(client client.Client, poolName types.NamespacedName, status string, info map[string]string) {
 	pool := &cephv1.CephBlockPool{}
 	err := client.Get(context.TODO(), poolName, pool)
 	if err != nil {
@@ -278,13 +308,51 @@ func updateStatus(client client.Client, poolName types.NamespacedName, status st
 	}
 
 	if pool.Status == nil {
-		pool.Status = &cephv1.Status{}
+		pool.Status = &cephv1.CephBlockPoolStatus{}
 	}
 
 	pool.Status.Phase = status
+	if info != nil {
+		pool.Status.Info = info
+	}
 	if err := opcontroller.UpdateStatus(client, pool); err != nil {
 		logger.Warningf("failed to set pool %q status to %q. %v", pool.Name, status, err)
 		return
 	}
 	logger.Debugf("pool %q status updated to %q", poolName, status)
 }
+
+func (r *ReconcileCephBlockPool) startMonitoring(poolName types.NamespacedName) {
+	// Initialize the channel for this pool
+	// This allows us to track multiple pools in the same namespace
+	channel, ok := r.blockPoolChannels[poolName.String()]
+	if !ok {
+		channel = &blockPoolHealth{stopChan: make(chan struct{})}
+		r.blockPoolChannels[poolName.String()] = channel
+	}
+
+	if channel.monitoringRunning {
+		logger.Debugf("pool %q monitoring go routine already running", poolName.Name)
+		return
+	}
+
+	// Set the monitoring flag so we don't start more than one go routine
+	channel.monitoringRunning = true
+
+	checker := newPoolStatusChecker(r.context, r.client, poolName)
+	logger.Infof("starting status check of pool %q", poolName.Name)
+	go checker.checkPoolStatus(channel.stopChan)
+}
+
+func (r *ReconcileCephBlockPool) stopMonitoring(poolName types.NamespacedName) {
+	channel, ok := r.blockPoolChannels[poolName.String()]
+	if !ok {
+		return
+	}
+
+	// Close the channel to stop the status check of the pool
+	close(channel.stopChan)
+
+	// Remove the pool from the map
+	delete(r.blockPoolChannels, poolName.String())
+}
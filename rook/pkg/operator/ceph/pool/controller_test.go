@@ -28,6 +28,7 @@ import (
 	"github.com/rook/rook/pkg/clusterd"
 	exectest "github.com/rook/rook/pkg/util/exec/test"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -116,6 +117,36 @@ func TestValidatePool(t *testing.T) {
 	p.Spec.CompressionMode = "passive"
 	err = ValidatePool(context, &p)
 	assert.Nil(t, err)
+
+	// succeed with mirroring enabled
+	p = cephv1.CephBlockPool{ObjectMeta: metav1.ObjectMeta{Name: "mypool", Namespace: "myns"}}
+	p.Spec.Replicated.Size = 3
+	p.Spec.Mirroring.Enabled = true
+	p.Spec.Mirroring.Mode = "image"
+	p.Spec.Mirroring.SnapshotSchedules = []cephv1.SnapshotScheduleSpec{{Interval: "24h", StartTime: "14:00:00-05:00"}}
+	err = ValidatePool(context, &p)
+	assert.Nil(t, err)
+
+	// fail with mirroring mode "unsupported"
+	p.Spec.Mirroring.Mode = "unsupported"
+	err = ValidatePool(context, &p)
+	assert.Error(t, err)
+
+	// fail with a snapshot schedule without interval
+	p.Spec.Mirroring.Mode = "pool"
+	p.Spec.Mirroring.SnapshotSchedules = []cephv1.SnapshotScheduleSpec{{StartTime: "14:00:00-05:00"}}
+	err = ValidatePool(context, &p)
+	assert.Error(t, err)
+
+	// fail with mirroring in the pool spec of another CR
+	p.Spec.Mirroring.SnapshotSchedules = nil
+	err = ValidatePoolSpec(context, p.Namespace, &p.Spec)
+	assert.Error(t, err)
+
+	// succeed without mirroring in the pool spec of another CR
+	p.Spec.Mirroring = cephv1.MirroringSpec{}
+	err = ValidatePoolSpec(context, p.Namespace, &p.Spec)
+	assert.Nil(t, err)
 }
 
 func TestValidateCrushProperties(t *testing.T) {
@@ -260,7 +291,7 @@ func TestCephBlockPoolController(t *testing.T) {
 				Size: replicas,
 			},
 		},
-		Status: &cephv1.Status{
+		Status: &cephv1.CephBlockPoolStatus{
 			Phase: "",
 		},
 	}
@@ -366,17 +397,87 @@ func TestCephBlockPoolController(t *testing.T) {
 
 			return "", nil
 		},
+		MockExecuteCommandWithOutput: func(command string, args ...string) (string, error) {
+			if command == "rbd" && args[0] == "mirror" && args[1] == "pool" && args[2] == "info" {
+				return `{"mode":"disabled"}`, nil
+			}
+
+			return "", errors.Errorf("unexpected rbd command %q", args)
+		},
 	}
 	c.Executor = executor
 
 	// Create a ReconcileCephBlockPool object with the scheme and fake client.
-	r = &ReconcileCephBlockPool{client: cl, scheme: s, context: c}
+	r = &ReconcileCephBlockPool{client: cl, scheme: s, context: c, blockPoolChannels: make(map[string]*blockPoolHealth)}
+
+	res, err = r.Reconcile(req)
+	assert.NoError(t, err)
+	assert.False(t, res.Requeue)
+
+	err = r.client.Get(context.TODO(), req.NamespacedName, pool)
+	assert.NoError(t, err)
+	assert.Equal(t, "Ready", pool.Status.Phase)
+
+	//
+	// TEST 4: Mirroring
+	//
+	// FAILURE mirroring requires octopus
+	//
+	pool.Spec.Mirroring.Enabled = true
+	pool.Spec.Mirroring.Mode = "image"
+	pool.Spec.Mirroring.SnapshotSchedules = []cephv1.SnapshotScheduleSpec{{Interval: "24h"}}
+	err = r.client.Update(context.TODO(), pool)
+	assert.NoError(t, err)
+
+	_, err = r.Reconcile(req)
+	assert.Error(t, err)
+
+	//
+	// TEST 5: Mirroring
+	//
+	// SUCCESS! The pool is mirrored and its bootstrap peer token is stored
+	//
+	cephCluster.Status.CephVersion.Version = "15.2.4-0"
+	err = r.client.Update(context.TODO(), cephCluster)
+	assert.NoError(t, err)
+
+	var mirrorArgs [][]string
+	executor.MockExecuteCommandWithOutput = func(command string, args ...string) (string, error) {
+		if command != "rbd" || args[0] != "mirror" {
+			return "", errors.Errorf("unexpected rbd command %q", args)
+		}
+		mirrorArgs = append(mirrorArgs, args[:4])
+		switch {
+		case args[1] == "pool" && args[2] == "info":
+			return `{"mode":"disabled"}`, nil
+		case args[1] == "pool" && args[2] == "peer" && args[4] == "create":
+			return "eyJmc2lkIjoiYzQ3Y2FjNDAifQ==", nil
+		case args[1] == "snapshot" && args[3] == "ls":
+			return "", nil
+		}
+		return "", nil
+	}
 
 	res, err = r.Reconcile(req)
 	assert.NoError(t, err)
 	assert.False(t, res.Requeue)
+	assert.Equal(t, [][]string{
+		{"mirror", "pool", "info", name},
+		{"mirror", "pool", "enable", name},
+		{"mirror", "pool", "peer", "bootstrap"},
+		{"mirror", "snapshot", "schedule", "ls"},
+		{"mirror", "snapshot", "schedule", "add"},
+	}, mirrorArgs)
 
 	err = r.client.Get(context.TODO(), req.NamespacedName, pool)
 	assert.NoError(t, err)
 	assert.Equal(t, "Ready", pool.Status.Phase)
+	assert.Equal(t, "pool-peer-token-my-pool", pool.Status.Info[RBDMirrorBootstrapPeerSecretName])
+
+	secret := &v1.Secret{}
+	err = r.client.Get(context.TODO(), types.NamespacedName{Name: "pool-peer-token-my-pool", Namespace: namespace}, secret)
+	assert.NoError(t, err)
+	assert.Equal(t, "eyJmc2lkIjoiYzQ3Y2FjNDAifQ==", string(secret.Data[PeerTokenSecretKey]))
+	assert.Equal(t, name, string(secret.Data[PeerPoolSecretKey]))
+	r.stopMonitoring(req.NamespacedName)
 }
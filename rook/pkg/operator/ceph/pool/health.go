@@ -0,0 +1,221 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pool
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	cephclient "github.com/rook/rook/pkg/daemon/ceph/client"
+	opcontroller "github.com/rook/rook/pkg/operator/ceph/controller"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultStatusCheckInterval = 1 * time.Minute
+)
+
+// poolStatusChecker aggregates the pool info needed to check the usage and the mirroring health of a pool
+type poolStatusChecker struct {
+	context        *clusterd.Context
+	interval       time.Duration
+	client         client.Client
+	namespacedName types.NamespacedName
+}
+
+// newPoolStatusChecker creates a new poolStatusChecker object
+func newPoolStatusChecker(context *clusterd.Context, client client.Client, namespacedName types.NamespacedName) *poolStatusChecker {
+	return &poolStatusChecker{
+		context:        context,
+		interval:       defaultStatusCheckInterval,
+		client:         client,
+		namespacedName: namespacedName,
+	}
+}
+
+// checkPoolStatus periodically checks the usage and the mirroring health of the pool
+func (c *poolStatusChecker) checkPoolStatus(stopCh chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			logger.Infof("stopping monitoring of pool %q", c.namespacedName.Name)
+			return
+
+		case <-time.After(c.interval):
+			logger.Debugf("checking status of pool %q", c.namespacedName.Name)
+			c.checkStatus()
+		}
+	}
+}
+
+// checkStatus queries the usage and the mirroring health of the pool then updates the CR status
+func (c *poolStatusChecker) checkStatus() {
+	pool := &cephv1.CephBlockPool{}
+	if err := c.client.Get(context.TODO(), c.namespacedName, pool); err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephBlockPool resource not found. Ignoring since object must be deleted.")
+			return
+		}
+		logger.Warningf("failed to retrieve pool %q to update status. %v", c.namespacedName, err)
+		return
+	}
+
+	if pool.Status == nil {
+		pool.Status = &cephv1.CephBlockPoolStatus{}
+	}
+
+	pool.Status.PoolStatus = c.getPoolStatus()
+
+	if pool.Spec.IsMirroringEnabled() {
+		mirrorStatus, err := cephclient.GetPoolMirroringStatus(c.context, c.namespacedName.Namespace, c.namespacedName.Name)
+		if err != nil {
+			logger.Warningf("failed to check mirroring health of pool %q. %v", c.namespacedName.Name, err)
+			pool.Status.MirroringStatus = toMirroringStatus(pool.Status.MirroringStatus, nil, err.Error())
+		} else {
+			pool.Status.MirroringStatus = toMirroringStatus(pool.Status.MirroringStatus, mirrorStatus, "")
+		}
+	} else {
+		pool.Status.MirroringStatus = nil
+	}
+
+	if err := opcontroller.UpdateStatus(c.client, pool); err != nil {
+		logger.Errorf("failed to update pool %q status. %v", c.namespacedName, err)
+		return
+	}
+	logger.Debugf("pool %q status updated", c.namespacedName)
+}
+
+// getPoolStatus returns the usage, placement group states and pg autoscaler status of the pool. Failures to query one
+// of them are reported in the details of the status.
+func (c *poolStatusChecker) getPoolStatus() *cephv1.PoolStatus {
+	s := &cephv1.PoolStatus{
+		LastChecked: time.Now().UTC().Format(time.RFC3339),
+	}
+	var details []string
+
+	usage, err := getPoolUsage(c.context, c.namespacedName.Namespace, c.namespacedName.Name)
+	if err != nil {
+		logger.Warningf("failed to get usage of pool %q. %v", c.namespacedName.Name, err)
+		details = append(details, err.Error())
+	}
+	s.Usage = usage
+
+	states, err := cephclient.GetPoolPGStates(c.context, c.namespacedName.Namespace, c.namespacedName.Name)
+	if err != nil {
+		logger.Warningf("failed to get placement group states of pool %q. %v", c.namespacedName.Name, err)
+		details = append(details, err.Error())
+	} else {
+		s.PGs = toPGStatus(states)
+	}
+
+	// the pg autoscaler status is only available if the mgr module is enabled
+	autoscale, err := cephclient.GetPoolAutoscaleStatus(c.context, c.namespacedName.Namespace, c.namespacedName.Name)
+	if err != nil {
+		logger.Debugf("failed to get pg autoscaler status of pool %q. %v", c.namespacedName.Name, err)
+	} else {
+		s.Autoscale = toAutoscaleStatus(autoscale)
+	}
+
+	s.Details = strings.Join(details, "; ")
+	return s
+}
+
+func getPoolUsage(context *clusterd.Context, namespace, poolName string) (*cephv1.PoolUsageStatus, error) {
+	stats, err := cephclient.GetPoolStats(context, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pool := range stats.Pools {
+		if pool.Name != poolName {
+			continue
+		}
+
+		usage := &cephv1.PoolUsageStatus{
+			StoredBytes:       uint64(pool.Stats.Stored),
+			UsedBytes:         uint64(pool.Stats.BytesUsed),
+			MaxAvailableBytes: uint64(pool.Stats.MaxAvail),
+			Objects:           uint64(pool.Stats.Objects),
+			// ceph reports the used capacity as a ratio
+			PercentUsed:     toPercent(pool.Stats.PercentUsed, 1),
+			QuotaMaxBytes:   uint64(pool.Stats.QuotaBytes),
+			QuotaMaxObjects: uint64(pool.Stats.QuotaObjects),
+		}
+		if usage.QuotaMaxBytes > 0 {
+			usage.PercentOfQuotaBytes = toPercent(pool.Stats.Stored, pool.Stats.QuotaBytes)
+		}
+		if usage.QuotaMaxObjects > 0 {
+			usage.PercentOfQuotaObjects = toPercent(pool.Stats.Objects, pool.Stats.QuotaObjects)
+		}
+		return usage, nil
+	}
+
+	return nil, errors.Errorf("pool %q not found in pool stats", poolName)
+}
+
+// toPercent returns the percentage of value in total rounded to two decimals
+func toPercent(value, total float64) float64 {
+	return float64(int64(value/total*10000+0.5)) / 100
+}
+
+func toPGStatus(states map[string]int) *cephv1.PoolPGStatus {
+	s := &cephv1.PoolPGStatus{States: states}
+	for _, count := range states {
+		s.Total += count
+	}
+	return s
+}
+
+func toAutoscaleStatus(status *cephclient.CephStoragePoolAutoscaleStatus) *cephv1.PoolAutoscaleStatus {
+	return &cephv1.PoolAutoscaleStatus{
+		Mode:        status.Mode,
+		PgNumTarget: status.PgNumTarget,
+		PgNumIdeal:  status.PgNumIdeal,
+		TargetBytes: status.TargetBytes,
+		TargetRatio: status.TargetRatio,
+		WouldAdjust: status.WouldAdjust,
+	}
+}
+
+func toMirroringStatus(currentStatus *cephv1.MirroringStatusSpec, mirrorStatus *cephclient.PoolMirroringStatus, details string) *cephv1.MirroringStatusSpec {
+	s := &cephv1.MirroringStatusSpec{
+		LastChecked: time.Now().UTC().Format(time.RFC3339),
+		Details:     details,
+	}
+	if mirrorStatus != nil {
+		s.Health = mirrorStatus.Summary.Health
+		s.DaemonHealth = mirrorStatus.Summary.DaemonHealth
+		s.ImageHealth = mirrorStatus.Summary.ImageHealth
+		s.States = mirrorStatus.Summary.States
+	}
+
+	if currentStatus != nil {
+		s.LastChanged = currentStatus.LastChanged
+		if currentStatus.Health != s.Health || currentStatus.Details != s.Details {
+			s.LastChanged = s.LastChecked
+		}
+	} else {
+		s.LastChanged = s.LastChecked
+	}
+	return s
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	cephclient "github.com/rook/rook/pkg/daemon/ceph/client"
+	exectest "github.com/rook/rook/pkg/util/exec/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCheckPoolStatus(t *testing.T) {
+	pool := &cephv1.CephBlockPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "mypool", Namespace: "rook-ceph"},
+		Spec: cephv1.PoolSpec{
+			Replicated: cephv1.ReplicatedSpec{Size: 3},
+			Mirroring:  cephv1.MirroringSpec{Enabled: true, Mode: "image"},
+		},
+		Status: &cephv1.CephBlockPoolStatus{Phase: "Ready"},
+	}
+	s := scheme.Scheme
+	s.AddKnownTypes(cephv1.SchemeGroupVersion, pool)
+	cl := fake.NewFakeClientWithScheme(s, []runtime.Object{pool}...)
+
+	executor := &exectest.MockExecutor{
+		MockExecuteCommandWithOutputFile: func(command, outfile string, args ...string) (string, error) {
+			logger.Infof("Command: %s %v", command, args)
+			switch {
+			case args[0] == "df":
+				return `{"pools":[{"name":"mypool","id":1,"stats":{"stored":5368709120,"objects":250,"bytes_used":16106127360,` +
+					`"percent_used":0.123456,"max_avail":94489280512,"quota_objects":1000,"quota_bytes":10737418240}}]}`, nil
+			case args[0] == "pg":
+				return `{"pg_stats":[{"pgid":"1.0","state":"active+clean"},{"pgid":"1.1","state":"active+clean+scrubbing"}]}`, nil
+			case args[0] == "osd" && args[2] == "autoscale-status":
+				return "", errors.New("module 'pg_autoscaler' is not enabled")
+			}
+			return "", errors.Errorf("unexpected ceph command %q", args)
+		},
+		MockExecuteCommandWithOutput: func(command string, args ...string) (string, error) {
+			logger.Infof("Command: %s %v", command, args)
+			if command == "rbd" && args[0] == "mirror" && args[2] == "status" {
+				return `{"summary":{"health":"OK","daemon_health":"OK","image_health":"OK","states":{"replaying":1}}}`, nil
+			}
+			return "", errors.Errorf("unexpected rbd command %q", args)
+		},
+	}
+	c := &clusterd.Context{Executor: executor}
+
+	name := types.NamespacedName{Name: "mypool", Namespace: "rook-ceph"}
+	checker := newPoolStatusChecker(c, cl, name)
+	checker.checkStatus()
+
+	err := cl.Get(context.TODO(), name, pool)
+	assert.NoError(t, err)
+	assert.Equal(t, "Ready", pool.Status.Phase)
+
+	poolStatus := pool.Status.PoolStatus
+	assert.NotNil(t, poolStatus)
+	assert.Equal(t, "", poolStatus.Details)
+	assert.NotEmpty(t, poolStatus.LastChecked)
+	assert.Equal(t, &cephv1.PoolUsageStatus{
+		StoredBytes:           5368709120,
+		UsedBytes:             16106127360,
+		MaxAvailableBytes:     94489280512,
+		Objects:               250,
+		PercentUsed:           12.35,
+		QuotaMaxBytes:         10737418240,
+		QuotaMaxObjects:       1000,
+		PercentOfQuotaBytes:   50,
+		PercentOfQuotaObjects: 25,
+	}, poolStatus.Usage)
+	assert.Equal(t, &cephv1.PoolPGStatus{Total: 2, States: map[string]int{"active+clean": 1, "active+clean+scrubbing": 1}}, poolStatus.PGs)
+	assert.Nil(t, poolStatus.Autoscale)
+
+	assert.NotNil(t, pool.Status.MirroringStatus)
+	assert.Equal(t, "OK", pool.Status.MirroringStatus.Health)
+	assert.Equal(t, map[string]int{"replaying": 1}, pool.Status.MirroringStatus.States)
+
+	// the mirroring status is removed once mirroring is disabled
+	pool.Spec.Mirroring.Enabled = false
+	err = cl.Update(context.TODO(), pool)
+	assert.NoError(t, err)
+	checker.checkStatus()
+
+	pool = &cephv1.CephBlockPool{}
+	err = cl.Get(context.TODO(), name, pool)
+	assert.NoError(t, err)
+	assert.Nil(t, pool.Status.MirroringStatus)
+	assert.NotNil(t, pool.Status.PoolStatus.Usage)
+}
+
+func TestToMirroringStatus(t *testing.T) {
+	mirrorStatus := &cephclient.PoolMirroringStatus{}
+	mirrorStatus.Summary.Health = "OK"
+
+	s := toMirroringStatus(nil, mirrorStatus, "")
+	assert.Equal(t, "OK", s.Health)
+	assert.Equal(t, s.LastChecked, s.LastChanged)
+
+	// the last changed time is kept while the health is the same
+	current := &cephv1.MirroringStatusSpec{Health: "OK", LastChanged: "2020-08-19T09:21:42Z"}
+	s = toMirroringStatus(current, mirrorStatus, "")
+	assert.Equal(t, "2020-08-19T09:21:42Z", s.LastChanged)
+
+	// the last changed time is updated on failures
+	s = toMirroringStatus(current, nil, "failed")
+	assert.Equal(t, "", s.Health)
+	assert.Equal(t, "failed", s.Details)
+	assert.Equal(t, s.LastChecked, s.LastChanged)
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2020 The Rook Authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	cephv1 "github.com/rook/rook/pkg/apis/ceph.rook.io/v1"
+	"github.com/rook/rook/pkg/clusterd"
+	cephclient "github.com/rook/rook/pkg/daemon/ceph/client"
+	opcontroller "github.com/rook/rook/pkg/operator/ceph/controller"
+	cephver "github.com/rook/rook/pkg/operator/ceph/version"
+	"github.com/rook/rook/pkg/operator/k8sutil"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// PeerTokenSecretKey is the key of the bootstrap peer token in the peer token secrets
+	PeerTokenSecretKey = "token"
+	// PeerPoolSecretKey is the key of the pool name in the peer token secrets
+	PeerPoolSecretKey = "pool"
+	// RBDMirrorBootstrapPeerSecretName is the key of the bootstrap peer token secret name in the pool status info
+	RBDMirrorBootstrapPeerSecretName = "rbdMirrorBootstrapPeerSecretName"
+
+	peerTokenSecretNamePrefix = "pool-peer-token"
+)
+
+// reconcileMirroring enables mirroring on the pool, creates the bootstrap peer token of the pool and imports the
+// bootstrap peer tokens of the peer clusters. It returns the status info of the pool.
+func (r *ReconcileCephBlockPool) reconcileMirroring(cephBlockPool *cephv1.CephBlockPool, cephVersion *cephver.CephVersion) (map[string]string, error) {
+	if !cephVersion.IsAtLeastOctopus() {
+		return nil, errors.Errorf("pool mirroring requires ceph octopus or newer, running %q", cephVersion.String())
+	}
+
+	poolName := cephBlockPool.Name
+	namespace := cephBlockPool.Namespace
+	mirroring := cephBlockPool.Spec.Mirroring
+
+	mirrorInfo, err := cephclient.GetPoolMirroringInfo(r.context, namespace, poolName)
+	if err != nil {
+		return nil, err
+	}
+	if mirrorInfo.Mode != mirroring.Mode {
+		if err := cephclient.EnablePoolMirroring(r.context, namespace, poolName, mirroring.Mode); err != nil {
+			return nil, err
+		}
+	}
+
+	// The fsid of the cluster is used as site name so peers can tell whether a token was already imported
+	status, err := cephclient.Status(r.context, namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cluster fsid")
+	}
+	siteName := status.FSID
+
+	secretName, err := r.reconcileBootstrapPeerSecret(cephBlockPool, siteName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.importBootstrapPeers(cephBlockPool, siteName, mirrorInfo.Peers); err != nil {
+		return nil, err
+	}
+
+	if err := reconcileSnapshotSchedules(r.context, namespace, poolName, mirroring.SnapshotSchedules); err != nil {
+		return nil, err
+	}
+
+	return map[string]string{RBDMirrorBootstrapPeerSecretName: secretName}, nil
+}
+
+// disableMirroring disables mirroring on the pool if it is mirrored
+func (r *ReconcileCephBlockPool) disableMirroring(cephBlockPool *cephv1.CephBlockPool) error {
+	mirrorInfo, err := cephclient.GetPoolMirroringInfo(r.context, cephBlockPool.Namespace, cephBlockPool.Name)
+	if err != nil {
+		return err
+	}
+	if mirrorInfo.Mode == cephclient.MirroringModeDisabled {
+		return nil
+	}
+
+	if err := cephclient.DisablePoolMirroring(r.context, cephBlockPool.Namespace, cephBlockPool.Name); err != nil {
+		return err
+	}
+	clearMirroringStatus(r.client, types.NamespacedName{Name: cephBlockPool.Name, Namespace: cephBlockPool.Namespace})
+
+	return nil
+}
+
+// reconcileBootstrapPeerSecret stores the bootstrap peer token of the pool in a secret to be imported by the peer
+// clusters and returns the name of the secret
+func (r *ReconcileCephBlockPool) reconcileBootstrapPeerSecret(cephBlockPool *cephv1.CephBlockPool, siteName string) (string, error) {
+	token, err := cephclient.CreateRBDMirrorBootstrapPeer(r.context, cephBlockPool.Namespace, cephBlockPool.Name, siteName)
+	if err != nil {
+		return "", err
+	}
+
+	secret := generateBootstrapPeerSecret(cephBlockPool, token)
+
+	// Set owner ref to the pool object
+	err = controllerutil.SetControllerReference(cephBlockPool, secret, r.scheme)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to set owner reference for bootstrap peer secret %q", secret.Name)
+	}
+
+	err = opcontroller.CreateOrUpdateObject(r.client, secret)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create or update bootstrap peer secret %q", secret.Name)
+	}
+
+	return secret.Name, nil
+}
+
+func generateBootstrapPeerSecret(cephBlockPool *cephv1.CephBlockPool, token []byte) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      buildBootstrapPeerSecretName(cephBlockPool.Name),
+			Namespace: cephBlockPool.Namespace,
+		},
+		Data: map[string][]byte{
+			PeerTokenSecretKey: token,
+			PeerPoolSecretKey:  []byte(cephBlockPool.Name),
+		},
+		Type: k8sutil.RookType,
+	}
+}
+
+func buildBootstrapPeerSecretName(poolName string) string {
+	return fmt.Sprintf("%s-%s", peerTokenSecretNamePrefix, poolName)
+}
+
+// importBootstrapPeers imports the bootstrap peer tokens of the peer secrets that are not already peers of the pool
+func (r *ReconcileCephBlockPool) importBootstrapPeers(cephBlockPool *cephv1.CephBlockPool, siteName string, peers []cephclient.PoolMirroringPeer) error {
+	for _, secretName := range cephBlockPool.Spec.Mirroring.Peers.SecretNames {
+		secret, err := r.context.Clientset.CoreV1().Secrets(cephBlockPool.Namespace).Get(secretName, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get peer secret %q", secretName)
+		}
+
+		token, ok := secret.Data[PeerTokenSecretKey]
+		if !ok {
+			return errors.Errorf("peer secret %q has no %q key", secretName, PeerTokenSecretKey)
+		}
+
+		peerToken, err := cephclient.DecodePeerToken(token)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode token of peer secret %q", secretName)
+		}
+		if isPeerImported(peers, peerToken.ClusterFSID) {
+			logger.Debugf("peer of secret %q already imported for pool %q", secretName, cephBlockPool.Name)
+			continue
+		}
+
+		if err := cephclient.ImportRBDMirrorBootstrapPeer(r.context, cephBlockPool.Namespace, cephBlockPool.Name, siteName, token); err != nil {
+			return errors.Wrapf(err, "failed to import token of peer secret %q", secretName)
+		}
+	}
+
+	return nil
+}
+
+func isPeerImported(peers []cephclient.PoolMirroringPeer, siteName string) bool {
+	for _, peer := range peers {
+		if peer.SiteName == siteName {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileSnapshotSchedules adds the snapshot schedules of the spec missing from the pool and removes the others
+func reconcileSnapshotSchedules(context *clusterd.Context, namespace, poolName string, specs []cephv1.SnapshotScheduleSpec) error {
+	current, err := cephclient.ListSnapshotSchedules(context, namespace, poolName)
+	if err != nil {
+		return err
+	}
+
+	desired := make([]cephclient.SnapshotSchedule, 0, len(specs))
+	for _, spec := range specs {
+		desired = append(desired, cephclient.SnapshotSchedule{Interval: spec.Interval, StartTime: spec.StartTime})
+	}
+
+	for _, schedule := range desired {
+		if !containsSnapshotSchedule(current, schedule) {
+			if err := cephclient.AddSnapshotSchedule(context, namespace, poolName, schedule); err != nil {
+				return err
+			}
+		}
+	}
+	for _, schedule := range current {
+		if !containsSnapshotSchedule(desired, schedule) {
+			if err := cephclient.RemoveSnapshotSchedule(context, namespace, poolName, schedule); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func containsSnapshotSchedule(schedules []cephclient.SnapshotSchedule, schedule cephclient.SnapshotSchedule) bool {
+	for _, s := range schedules {
+		if s == schedule {
+			return true
+		}
+	}
+	return false
+}
+
+// clearMirroringStatus removes the mirroring status and info of a pool no longer mirrored
+func clearMirroringStatus(client client.Client, poolName types.NamespacedName) {
+	pool := &cephv1.CephBlockPool{}
+	err := client.Get(context.TODO(), poolName, pool)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Debug("CephBlockPool resource not found. Ignoring since object must be deleted.")
+			return
+		}
+		logger.Warningf("failed to retrieve pool %q to clear mirroring status. %v", poolName, err)
+		return
+	}
+
+	if pool.Status == nil {
+		return
+	}
+
+	pool.Status.MirroringStatus = nil
+	delete(pool.Status.Info, RBDMirrorBootstrapPeerSecretName)
+	if err := opcontroller.UpdateStatus(client, pool); err != nil {
+		logger.Warningf("failed to clear pool %q mirroring status. %v", poolName, err)
+		return
+	}
+	logger.Debugf("pool %q mirroring status cleared", poolName)
+}
@@ -33,14 +33,28 @@ func ValidatePool(context *clusterd.Context, p *cephv1.CephBlockPool) error {
 	if p.Namespace == "" {
 		return errors.New("missing namespace")
 	}
-	if err := ValidatePoolSpec(context, p.Namespace, &p.Spec); err != nil {
+	if err := validatePoolSpec(context, p.Namespace, &p.Spec); err != nil {
+		return err
+	}
+	if err := validateMirroring(&p.Spec.Mirroring); err != nil {
 		return err
 	}
 	return nil
 }
 
-// ValidatePoolSpec validates the Ceph block pool spec CR
+// ValidatePoolSpec validates the spec of the pools of the other CRs, e.g. the filesystem and object store
+// pools, which can't be mirrored
 func ValidatePoolSpec(context *clusterd.Context, namespace string, p *cephv1.PoolSpec) error {
+	if err := validatePoolSpec(context, namespace, p); err != nil {
+		return err
+	}
+	if isMirroringSet(&p.Mirroring) {
+		return errors.New("mirroring is only supported by the CephBlockPool CR")
+	}
+	return nil
+}
+
+func validatePoolSpec(context *clusterd.Context, namespace string, p *cephv1.PoolSpec) error {
 	if p.IsReplicated() && p.IsErasureCoded() {
 		return errors.New("both replication and erasure code settings cannot be specified")
 	}
@@ -99,3 +113,28 @@ func ValidatePoolSpec(context *clusterd.Context, namespace string, p *cephv1.Poo
 
 	return nil
 }
+
+// validateMirroring validates the mirroring settings of a block pool
+func validateMirroring(m *cephv1.MirroringSpec) error {
+	if !m.Enabled {
+		return nil
+	}
+
+	switch m.Mode {
+	case cephclient.MirroringModePool, cephclient.MirroringModeImage:
+		break
+	default:
+		return errors.Errorf("unrecognized mirroring mode %q. only 'pool' and 'image' are supported", m.Mode)
+	}
+
+	for _, schedule := range m.SnapshotSchedules {
+		if schedule.Interval == "" {
+			return errors.New("mirroring snapshot schedule interval must be specified")
+		}
+	}
+	return nil
+}
+
+func isMirroringSet(m *cephv1.MirroringSpec) bool {
+	return m.Enabled || m.Mode != "" || len(m.SnapshotSchedules) > 0 || len(m.Peers.SecretNames) > 0
+}
@@ -578,10 +578,13 @@ func (h *CephInstaller) UninstallRookFromMultipleNS(systemNamespace string, name
 		"cephblockpools.ceph.rook.io",
 		"cephobjectstores.ceph.rook.io",
 		"cephobjectstoreusers.ceph.rook.io",
+		"cephbuckettopics.ceph.rook.io",
+		"cephbucketnotifications.ceph.rook.io",
 		"cephobjectrealms.ceph.rook.io",
 		"cephobjectzonegroups.ceph.rook.io",
 		"cephobjectzones.ceph.rook.io",
 		"cephfilesystems.ceph.rook.io",
+		"cephfilesystemsubvolumegroups.ceph.rook.io",
 		"cephnfses.ceph.rook.io",
 		"cephclients.ceph.rook.io",
 		"volumes.rook.io",
@@ -242,6 +242,12 @@ spec:
                 confirmation:
                   type: string
                   pattern: ^$|^yes-really-destroy-data$
+            cephConfig:
+              type: object
+              additionalProperties:
+                type: object
+                additionalProperties:
+                  type: string
             placement: {}
             resources: {}
   additionalPrinterColumns:
@@ -521,6 +527,128 @@ spec:
 ---
 apiVersion: apiextensions.k8s.io/v1beta1
 kind: CustomResourceDefinition
+metadata:
+  name: cephbuckettopics.ceph.rook.io
+spec:
+  group: ceph.rook.io
+  names:
+    kind: CephBucketTopic
+    listKind: CephBucketTopicList
+    plural: cephbuckettopics
+    singular: cephbuckettopic
+  scope: Namespaced
+  version: v1
+  validation:
+    openAPIV3Schema:
+      properties:
+        spec:
+          properties:
+            objectStoreName:
+              type: string
+            opaqueData:
+              type: string
+            persistent:
+              type: boolean
+            endpoint:
+              properties:
+                http:
+                  properties:
+                    uri:
+                      type: string
+                    disableVerifySSL:
+                      type: boolean
+                amqp:
+                  properties:
+                    uri:
+                      type: string
+                    exchange:
+                      type: string
+                    disableVerifySSL:
+                      type: boolean
+                    ackLevel:
+                      type: string
+                      enum:
+                      - none
+                      - broker
+                      - routable
+          required:
+          - objectStoreName
+          - endpoint
+  additionalPrinterColumns:
+    - name: ObjectStore
+      type: string
+      description: Name of the object store of the topic
+      JSONPath: .spec.objectStoreName
+    - name: Phase
+      type: string
+      JSONPath: .status.phase
+    - name: Age
+      type: date
+      JSONPath: .metadata.creationTimestamp
+  subresources:
+    status: {}
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: cephbucketnotifications.ceph.rook.io
+spec:
+  group: ceph.rook.io
+  names:
+    kind: CephBucketNotification
+    listKind: CephBucketNotificationList
+    plural: cephbucketnotifications
+    singular: cephbucketnotification
+  scope: Namespaced
+  version: v1
+  validation:
+    openAPIV3Schema:
+      properties:
+        spec:
+          properties:
+            topic:
+              type: string
+            buckets:
+              type: array
+              items:
+                type: string
+            events:
+              type: array
+              items:
+                type: string
+            filter:
+              properties:
+                keyFilters:
+                  type: array
+                  items:
+                    properties:
+                      name:
+                        type: string
+                        enum:
+                        - prefix
+                        - suffix
+                        - regex
+                      value:
+                        type: string
+          required:
+          - topic
+          - buckets
+  additionalPrinterColumns:
+    - name: Topic
+      type: string
+      description: Name of the topic the notifications are sent to
+      JSONPath: .spec.topic
+    - name: Phase
+      type: string
+      JSONPath: .status.phase
+    - name: Age
+      type: date
+      JSONPath: .metadata.creationTimestamp
+  subresources:
+    status: {}
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
 metadata:
   name: cephobjectrealms.ceph.rook.io
 spec:
@@ -734,6 +862,79 @@ spec:
               type: integer
               minimum: 1
               maximum: 100
+  subresources:
+    status: {}
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: cephfilesystemsubvolumegroups.ceph.rook.io
+spec:
+  group: ceph.rook.io
+  names:
+    kind: CephFilesystemSubVolumeGroup
+    listKind: CephFilesystemSubVolumeGroupList
+    plural: cephfilesystemsubvolumegroups
+    singular: cephfilesystemsubvolumegroup
+  scope: Namespaced
+  version: v1
+  validation:
+    openAPIV3Schema:
+      properties:
+        spec:
+          properties:
+            filesystemName:
+              type: string
+            dataPoolName:
+              type: string
+            pinning:
+              properties:
+                export:
+                  minimum: -1
+                  type: integer
+                distributed:
+                  minimum: 0
+                  maximum: 1
+                  type: integer
+                random:
+                  minimum: 0
+                  maximum: 1
+                  type: number
+            quota: {}
+            snapshotSchedules:
+              properties:
+                schedules:
+                  type: array
+                  items:
+                    properties:
+                      path:
+                        type: string
+                      interval:
+                        type: string
+                      startTime:
+                        type: string
+                retention:
+                  type: array
+                  items:
+                    properties:
+                      path:
+                        type: string
+                      duration:
+                        type: string
+                        pattern: ^([0-9]+[hdwmyn])+$
+          required:
+          - filesystemName
+  additionalPrinterColumns:
+    - name: Filesystem
+      type: string
+      description: Name of the filesystem of the subvolume group
+      JSONPath: .spec.filesystemName
+    - name: Phase
+      type: string
+      JSONPath: .status.phase
+    - name: Age
+      type: date
+      JSONPath: .metadata.creationTimestamp
   subresources:
     status: {}`
 }